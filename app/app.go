@@ -67,6 +67,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
@@ -120,6 +121,7 @@ import (
 	makertypes "github.com/elysiumstation/blackfury/x/maker/types"
 	"github.com/elysiumstation/blackfury/x/oracle"
 	oracleclient "github.com/elysiumstation/blackfury/x/oracle/client"
+	oracleibc "github.com/elysiumstation/blackfury/x/oracle/ibc"
 	oraclekeeper "github.com/elysiumstation/blackfury/x/oracle/keeper"
 	oracletypes "github.com/elysiumstation/blackfury/x/oracle/types"
 	customstaking "github.com/elysiumstation/blackfury/x/staking"
@@ -128,6 +130,7 @@ import (
 	vekeeper "github.com/elysiumstation/blackfury/x/ve/keeper"
 	vetypes "github.com/elysiumstation/blackfury/x/ve/types"
 	customvesting "github.com/elysiumstation/blackfury/x/vesting"
+	customvestingclient "github.com/elysiumstation/blackfury/x/vesting/client"
 	customvestingkeeper "github.com/elysiumstation/blackfury/x/vesting/keeper"
 	customvestingtypes "github.com/elysiumstation/blackfury/x/vesting/types"
 	"github.com/elysiumstation/blackfury/x/voter"
@@ -171,7 +174,11 @@ func getGovProposalHandlers() []govclient.ProposalHandler {
 		makerclient.SetCollateralProposalHandler,
 		makerclient.BatchSetBackingProposalHandler,
 		makerclient.BatchSetCollateralProposalHandler,
+		makerclient.CoverBadDebtProposalHandler,
 		oracleclient.RegisterTargetProposalHandler,
+		customvestingclient.AddVestingBucketProposalHandler,
+		customvestingclient.SetVestingBucketDestinationProposalHandler,
+		customvestingclient.StrategicReserveSpendProposalHandler,
 	)
 
 	return govProposalHandlers
@@ -216,25 +223,26 @@ var (
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:     nil,
-		distrtypes.ModuleName:          nil,
-		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:            {authtypes.Burner},
-		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
-		evmtypes.ModuleName:            {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
-		erc20types.ModuleName:          {authtypes.Minter, authtypes.Burner},
-		oracletypes.ModuleName:         nil,
-		makertypes.ModuleName:          {authtypes.Minter, authtypes.Burner, authtypes.Staking},
-		nfttypes.ModuleName:            nil,
-		vetypes.ModuleName:             {authtypes.Burner},
-		vetypes.EmissionPoolName:       {authtypes.Minter},
-		vetypes.DistributionPoolName:   nil,
-		gaugetypes.ModuleName:          nil,
-		votertypes.ModuleName:          nil,
-		customvestingtypes.ModuleName:  {authtypes.Minter},
-		gravitytypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
-		mgravitytypes.ModuleName:       {authtypes.Minter, authtypes.Burner},
+		authtypes.FeeCollectorName:                  nil,
+		distrtypes.ModuleName:                       nil,
+		stakingtypes.BondedPoolName:                 {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:              {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:                         {authtypes.Burner},
+		ibctransfertypes.ModuleName:                 {authtypes.Minter, authtypes.Burner},
+		evmtypes.ModuleName:                         {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
+		erc20types.ModuleName:                       {authtypes.Minter, authtypes.Burner},
+		oracletypes.ModuleName:                      nil,
+		makertypes.ModuleName:                       {authtypes.Minter, authtypes.Burner, authtypes.Staking},
+		nfttypes.ModuleName:                         nil,
+		vetypes.ModuleName:                          {authtypes.Burner},
+		vetypes.EmissionPoolName:                    {authtypes.Minter},
+		vetypes.DistributionPoolName:                nil,
+		gaugetypes.ModuleName:                       nil,
+		votertypes.ModuleName:                       nil,
+		customvestingtypes.ModuleName:               {authtypes.Minter},
+		customvestingtypes.StrategicReservePoolName: nil,
+		gravitytypes.ModuleName:                     {authtypes.Minter, authtypes.Burner},
+		mgravitytypes.ModuleName:                    {authtypes.Minter, authtypes.Burner},
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -270,6 +278,7 @@ type Blackfury struct {
 	cdc               *codec.LegacyAmino
 	appCodec          codec.Codec
 	interfaceRegistry types.InterfaceRegistry
+	txConfig          client.TxConfig
 
 	invCheckPeriod uint
 
@@ -297,6 +306,7 @@ type Blackfury struct {
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
 	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
+	ScopedOracleKeeper   capabilitykeeper.ScopedKeeper
 
 	// Ethermint keepers
 	EvmKeeper       *evmkeeper.Keeper
@@ -373,6 +383,7 @@ func NewBlackfury(
 		cdc:               cdc,
 		appCodec:          appCodec,
 		interfaceRegistry: interfaceRegistry,
+		txConfig:          encodingConfig.TxConfig,
 		invCheckPeriod:    invCheckPeriod,
 		keys:              keys,
 		tkeys:             tkeys,
@@ -390,6 +401,7 @@ func NewBlackfury(
 	// grant capabilities for the ibc and ibc-transfer modules
 	scopedIBCKeeper := app.CapabilityKeeper.ScopeToModule(ibchost.ModuleName)
 	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
+	scopedOracleKeeper := app.CapabilityKeeper.ScopeToModule(oracletypes.ModuleName)
 
 	app.CapabilityKeeper.Seal()
 
@@ -410,7 +422,10 @@ func NewBlackfury(
 		return veKeeper
 	}
 	nftKeeper := nftkeeper.NewKeeper(keys[nfttypes.StoreKey], appCodec, app.AccountKeeper, app.BankKeeper)
-	app.NftKeeper = vekeeper.NewNftKeeper(nftKeeper, getVeKeeper)
+	getErc721Keeper := func() vetypes.Erc721Keeper {
+		return erc20Keeper
+	}
+	app.NftKeeper = vekeeper.NewNftKeeper(nftKeeper, getVeKeeper, getErc721Keeper)
 
 	app.VeKeeper = *vekeeper.NewKeeper(appCodec, keys[vetypes.StoreKey], keys[vetypes.MemStoreKey], app.GetSubspace(vetypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.NftKeeper)
 	veKeeper = app.VeKeeper
@@ -484,6 +499,7 @@ func NewBlackfury(
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.EvmKeeper,
+		app.NftKeeper,
 	)
 	erc20Keeper = app.Erc20Keeper
 	erc20Module := erc20.NewAppModule(appCodec, app.Erc20Keeper, app.AccountKeeper, app.BankKeeper)
@@ -496,9 +512,15 @@ func NewBlackfury(
 		app.BankKeeper,
 		app.DistrKeeper,
 		app.StakingKeeper,
+		app.SlashingKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ConnectionKeeper,
+		&app.IBCKeeper.PortKeeper,
+		scopedOracleKeeper,
 		distrtypes.ModuleName,
 	)
 	oracleModule := oracle.NewAppModule(appCodec, app.OracleKeeper, app.AccountKeeper, app.BankKeeper)
+	oracleIBCModule := oracleibc.NewIBCModule(app.OracleKeeper)
 
 	app.MakerKeeper = *makerkeeper.NewKeeper(
 		appCodec,
@@ -509,6 +531,7 @@ func NewBlackfury(
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.OracleKeeper,
+		app.MsgServiceRouter(),
 	)
 	makerModule := maker.NewAppModule(appCodec, app.MakerKeeper, app.AccountKeeper, app.BankKeeper)
 
@@ -516,8 +539,11 @@ func NewBlackfury(
 
 	veModule := ve.NewAppModule(appCodec, app.VeKeeper, app.AccountKeeper, app.BankKeeper)
 
+	getVoterKeeper := func() gaugetypes.VoterKeeper {
+		return app.VoterKeeper
+	}
 	app.GaugeKeeper = *gaugekeeper.NewKeeper(appCodec, keys[gaugetypes.StoreKey], keys[gaugetypes.MemStoreKey],
-		app.GetSubspace(gaugetypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.NftKeeper, app.VeKeeper)
+		app.GetSubspace(gaugetypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.NftKeeper, app.VeKeeper, getVoterKeeper)
 	gaugeModule := gauge.NewAppModule(appCodec, app.GaugeKeeper, app.AccountKeeper, app.BankKeeper)
 
 	app.VoterKeeper = *voterkeeper.NewKeeper(appCodec, keys[votertypes.StoreKey], keys[votertypes.MemStoreKey],
@@ -567,6 +593,7 @@ func NewBlackfury(
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
 		AddRoute(makertypes.RouterKey, maker.NewMakerProposalHandler(app.MakerKeeper)).
 		AddRoute(oracletypes.RouterKey, oracle.NewOracleProposalHandler(app.OracleKeeper)).
+		AddRoute(customvestingtypes.RouterKey, customvesting.NewVestingProposalHandler(app.VestingKeeper)).
 		AddRoute(banktypes.RouterKey, custombank.NewBankProposalHandler(app.BankKeeper)).
 		AddRoute(mgravitytypes.RouterKey, mgravitykeeper.NewGravityProposalHandler(app.GravityKeeper)).
 		AddRoute(bech32ibctypes.RouterKey, bech32ibc.NewBech32IBCProposalHandler(app.Bech32IbcKeeper))
@@ -579,6 +606,7 @@ func NewBlackfury(
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := ibcporttypes.NewRouter()
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferIBCModule)
+	ibcRouter.AddRoute(oracletypes.ModuleName, oracleIBCModule)
 	app.IBCKeeper.SetRouter(ibcRouter)
 
 	/****  Module Options ****/
@@ -794,6 +822,7 @@ func NewBlackfury(
 
 	app.ScopedIBCKeeper = scopedIBCKeeper
 	app.ScopedTransferKeeper = scopedTransferKeeper
+	app.ScopedOracleKeeper = scopedOracleKeeper
 
 	app.tpsCounter = newTPSCounter(logger)
 	go func() {
@@ -923,6 +952,34 @@ func (app *Blackfury) GetSubspace(moduleName string) paramstypes.Subspace {
 	return subspace
 }
 
+// GetStakingKeeper returns the embedded staking keeper.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *Blackfury) GetStakingKeeper() stakingkeeper.Keeper {
+	return app.StakingKeeper.Keeper
+}
+
+// GetIBCKeeper returns the IBC keeper.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *Blackfury) GetIBCKeeper() *ibckeeper.Keeper {
+	return app.IBCKeeper
+}
+
+// GetScopedIBCKeeper returns the scoped IBC keeper.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *Blackfury) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
+	return app.ScopedIBCKeeper
+}
+
+// GetTxConfig returns the TxConfig.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *Blackfury) GetTxConfig() client.TxConfig {
+	return app.txConfig
+}
+
 // RegisterAPIRoutes registers all application module routes with the provided
 // API server.
 func (app *Blackfury) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig) {
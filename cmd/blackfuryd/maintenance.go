@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elysiumstation/blackfury/app"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/node"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmstore "github.com/tendermint/tendermint/store"
+)
+
+// MaintenanceCmd returns the node maintenance commands, which work on the local
+// databases and require the node to be stopped
+func (a appCreator) MaintenanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "maintenance",
+		Short: "Node maintenance subcommands (the node must be stopped)",
+	}
+
+	cmd.AddCommand(a.vePrunableCmd())
+
+	return cmd
+}
+
+func (a appCreator) vePrunableCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ve-prunable",
+		Short: "Report the counts of obsolete ve history keys that can be pruned",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+			home := config.RootDir
+
+			db, err := sdk.NewLevelDB("application", filepath.Join(home, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: config})
+			if err != nil {
+				return err
+			}
+			defer blockStoreDB.Close()
+
+			exportableApp := a.buildApp(
+				serverCtx.Logger,
+				db,
+				nil,
+				true,
+				map[int64]bool{},
+				home,
+				uint(1),
+				a.encodingConfig,
+				serverCtx.Viper,
+			)
+			blackfuryApp, ok := exportableApp.(*app.Blackfury)
+			if !ok {
+				return fmt.Errorf("unexpected app type %T", exportableApp)
+			}
+
+			height := blackfuryApp.LastBlockHeight()
+			blockMeta := tmstore.NewBlockStore(blockStoreDB).LoadBlockMeta(height)
+			if blockMeta == nil {
+				return fmt.Errorf("block %d not found in block store", height)
+			}
+
+			ctx := blackfuryApp.NewContext(true, tmproto.Header{
+				ChainID: blockMeta.Header.ChainID,
+				Height:  height,
+				Time:    blockMeta.Header.Time,
+			})
+			slopeChanges, userCheckpoints := blackfuryApp.VeKeeper.CountPrunableHistory(ctx)
+
+			cmd.Printf("height: %d\n", height)
+			cmd.Printf("history retention: %d seconds\n", blackfuryApp.VeKeeper.HistoryRetention(ctx))
+			cmd.Printf("prunable slope changes: %d\n", slopeChanges)
+			cmd.Printf("prunable user checkpoints: %d\n", userCheckpoints)
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -28,6 +28,7 @@ import (
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 	"github.com/elysiumstation/blackfury/app"
 	blackfury "github.com/elysiumstation/blackfury/types"
+	oraclefeeder "github.com/elysiumstation/blackfury/x/oracle/client/feeder"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -234,6 +235,8 @@ func initRootCmd(
 		queryCommand(moduleBasics),
 		txCommand(moduleBasics),
 		ethermintclient.KeyCommands(defaultNodeHome),
+		oraclefeeder.NewOracleFeederCmd(),
+		a.MaintenanceCmd(),
 	)
 
 	// add user given sub commands.
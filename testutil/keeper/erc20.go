@@ -132,6 +132,7 @@ func Erc20Keeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		accountKeeper,
 		nil,
 		nil,
+		nil,
 	)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
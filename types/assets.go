@@ -17,6 +17,10 @@ const (
 
 	AttoFuryDenom  = "afury" // 1e-18
 	MicroFUSDDenom = "ufusd" // 1e-6
+
+	// MicroUSDDenom defines the reference quote denomination of the oracle exchange rates,
+	// which is not a token.
+	MicroUSDDenom = "uusd" // 1e-6
 )
 
 var (
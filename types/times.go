@@ -5,6 +5,7 @@ const (
 	SecondsPerHour   = 60 * SecondsPerMinute
 	SecondsPerDay    = 24 * SecondsPerHour
 	SecondsPerWeek   = 7 * SecondsPerDay
+	SecondsPerYear   = 365 * SecondsPerDay
 	DaysPer4Years    = 365*4 + 1
 	SecondsPer4Years = DaysPer4Years * SecondsPerDay
 )
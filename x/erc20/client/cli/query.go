@@ -25,6 +25,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(GetTokenPairsCmd())
 	cmd.AddCommand(GetTokenPairCmd())
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryVeNftContract())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -122,3 +123,28 @@ func CmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+// CmdQueryVeNftContract queries the ERC721 contract mirroring the ve NFTs
+func CmdQueryVeNftContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ve-nft-contract",
+		Short: "shows the ERC721 contract mirroring the ve NFTs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VeNftContract(context.Background(), &types.QueryVeNftContractRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
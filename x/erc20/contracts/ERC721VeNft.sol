@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity 0.8.10;
+
+import "./@openzeppelin/contracts/access/Ownable.sol";
+import "./@openzeppelin/contracts/token/ERC721/ERC721.sol";
+
+/**
+ * @dev {ERC721} token mirroring the ve NFTs of the x/ve module.
+ *
+ * The account that deploys the contract (the erc20 module account) is the
+ * owner, which syncs the token owners from the nft module by {syncOwner}.
+ * Users transfer the tokens by the standard ERC-721 methods, and the
+ * {Transfer} events are mirrored back into the nft module by the EVM hooks
+ * of the erc20 module.
+ */
+contract ERC721VeNft is ERC721, Ownable {
+  constructor() ERC721("veNFT", "veNFT") {}
+
+  /**
+   * @dev Sets the owner of `tokenId` to `to`, which mints the token if it
+   * does not exist, and burns the token if `to` is the zero address.
+   *
+   * Requirements:
+   *
+   * - the caller must be the owner of the contract.
+   */
+  function syncOwner(uint256 tokenId, address to) public virtual onlyOwner {
+    if (!_exists(tokenId)) {
+      if (to != address(0)) {
+        _mint(to, tokenId);
+      }
+      return;
+    }
+
+    if (to == address(0)) {
+      _burn(tokenId);
+      return;
+    }
+
+    address from = ownerOf(tokenId);
+    if (from != to) {
+      _transfer(from, to, tokenId);
+    }
+  }
+}
@@ -0,0 +1,27 @@
+package contracts
+
+import (
+	_ "embed" // embed compiled smart contract
+	"encoding/json"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+var (
+	//go:embed compiled_contracts/ERC721VeNft.json
+	ERC721VeNftJSON []byte // nolint: golint
+
+	// ERC721VeNftContract is the compiled ERC721 contract mirroring the ve NFTs
+	ERC721VeNftContract evmtypes.CompiledContract
+)
+
+func init() {
+	err := json.Unmarshal(ERC721VeNftJSON, &ERC721VeNftContract)
+	if err != nil {
+		panic(err)
+	}
+
+	if len(ERC721VeNftContract.Bin) == 0 {
+		panic("load contract failed")
+	}
+}
@@ -0,0 +1,86 @@
+package contracts
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/stretchr/testify/require"
+)
+
+// TestERC721VeNftContract runs the compiled contract in a bare EVM
+func TestERC721VeNftContract(t *testing.T) {
+	erc721 := ERC721VeNftContract.ABI
+	module := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	alice := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	bob := common.HexToAddress("0x3000000000000000000000000000000000000003")
+	tokenID := big.NewInt(7)
+
+	cfg := &runtime.Config{Origin: module}
+	_, contract, _, err := runtime.Create(ERC721VeNftContract.Bin, cfg)
+	require.NoError(t, err)
+
+	call := func(from common.Address, method string, args ...interface{}) ([]interface{}, error) {
+		input, err := erc721.Pack(method, args...)
+		require.NoError(t, err)
+		cfg.Origin = from
+		ret, _, err := runtime.Call(contract, input, cfg)
+		if err != nil {
+			return nil, err
+		}
+		return erc721.Unpack(method, ret)
+	}
+	ownerOf := func() common.Address {
+		res, err := call(module, "ownerOf", tokenID)
+		require.NoError(t, err)
+		return res[0].(common.Address)
+	}
+	// lastTransfer checks the last log is the Transfer event, which the EVM hooks mirror into the nft module
+	lastTransfer := func(from, to common.Address) {
+		logs := cfg.State.Logs()
+		require.NotEmpty(t, logs)
+		log := logs[len(logs)-1]
+		require.Equal(t, contract, log.Address)
+		require.Equal(t, []common.Hash{
+			erc721.Events["Transfer"].ID,
+			common.BytesToHash(from.Bytes()),
+			common.BytesToHash(to.Bytes()),
+			common.BigToHash(tokenID),
+		}, log.Topics)
+	}
+
+	res, err := call(module, "owner")
+	require.NoError(t, err)
+	require.Equal(t, module, res[0])
+	res, err = call(module, "name")
+	require.NoError(t, err)
+	require.Equal(t, "veNFT", res[0])
+
+	// only the deployer can sync owners
+	_, err = call(alice, "syncOwner", tokenID, alice)
+	require.Error(t, err)
+	_, err = call(module, "ownerOf", tokenID)
+	require.Error(t, err)
+
+	_, err = call(module, "syncOwner", tokenID, alice)
+	require.NoError(t, err)
+	require.Equal(t, alice, ownerOf())
+	lastTransfer(common.Address{}, alice)
+
+	_, err = call(bob, "transferFrom", alice, bob, tokenID)
+	require.Error(t, err)
+	_, err = call(alice, "transferFrom", alice, bob, tokenID)
+	require.NoError(t, err)
+	require.Equal(t, bob, ownerOf())
+	lastTransfer(alice, bob)
+	res, err = call(module, "balanceOf", alice)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), res[0].(*big.Int).Int64())
+
+	_, err = call(module, "syncOwner", tokenID, common.Address{})
+	require.NoError(t, err)
+	lastTransfer(bob, common.Address{})
+	_, err = call(module, "ownerOf", tokenID)
+	require.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/elysiumstation/blackfury/x/erc20/keeper"
 	"github.com/elysiumstation/blackfury/x/erc20/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // InitGenesis initializes the capability module's state from a provided genesis
@@ -29,6 +30,12 @@ func InitGenesis(
 		k.SetDenomMap(ctx, pair.Denom, id)
 		k.SetERC20Map(ctx, pair.GetERC20Contract(), id)
 	}
+
+	if genState.VeNftContract != "" {
+		k.SetVeNftContract(ctx, common.HexToAddress(genState.VeNftContract))
+	} else if _, err := k.DeployVeNftContract(ctx); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -36,6 +43,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 	genesis.TokenPairs = k.GetAllTokenPairs(ctx)
+	if contract, found := k.GetVeNftContract(ctx); found {
+		genesis.VeNftContract = contract.Hex()
+	}
 
 	// this line is used by starport scaffolding # genesis/module/export
 
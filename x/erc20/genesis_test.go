@@ -40,6 +40,13 @@ func (suite *GenesisTestSuite) TestERC20InitGenesis() {
 			"default genesis",
 			*types.DefaultGenesis(),
 		},
+		{
+			"ve nft contract genesis",
+			types.GenesisState{
+				Params:        types.DefaultParams(),
+				VeNftContract: "0x5FD55A1B9FC24967C4dB09C513C3BA0DFa7FF687",
+			},
+		},
 		{
 			"custom genesis",
 			types.GenesisState{
@@ -69,6 +76,12 @@ func (suite *GenesisTestSuite) TestERC20InitGenesis() {
 		} else {
 			suite.Require().Len(tc.genesisState.TokenPairs, 0)
 		}
+
+		contract, found := suite.app.Erc20Keeper.GetVeNftContract(suite.ctx)
+		suite.Require().True(found)
+		if tc.genesisState.VeNftContract != "" {
+			suite.Require().Equal(tc.genesisState.VeNftContract, contract.Hex())
+		}
 	}
 }
 
@@ -113,6 +126,9 @@ func (suite *GenesisTestSuite) TestErc20ExportGenesis() {
 			} else {
 				suite.Require().Len(genesisExported.TokenPairs, 0)
 			}
+
+			contract, _ := suite.app.Erc20Keeper.GetVeNftContract(suite.ctx)
+			suite.Require().Equal(contract.Hex(), genesisExported.VeNftContract)
 		})
 	}
 }
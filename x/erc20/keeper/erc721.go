@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/elysiumstation/blackfury/x/erc20/contracts"
+	"github.com/elysiumstation/blackfury/x/erc20/types"
+)
+
+// GetVeNftContract gets the address of the ERC721 contract mirroring the ve NFTs
+func (k Keeper) GetVeNftContract(ctx sdk.Context) (common.Address, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixVeNftContract)
+	if bz == nil {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(bz), true
+}
+
+// SetVeNftContract sets the address of the ERC721 contract mirroring the ve NFTs
+func (k Keeper) SetVeNftContract(ctx sdk.Context, contract common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefixVeNftContract, contract.Bytes())
+}
+
+// DeployVeNftContract deploys the ERC721 contract mirroring the ve NFTs on the EVM
+// with the erc20 module account as owner, and mirrors the owners of all existing ve NFTs.
+func (k Keeper) DeployVeNftContract(ctx sdk.Context) (common.Address, error) {
+	nonce, err := k.accountKeeper.GetSequence(ctx, types.ModuleAddress.Bytes())
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	contractAddr := crypto.CreateAddress(types.ModuleAddress, nonce)
+	if len(ctx.BlockHeader().ProposerAddress) == 0 {
+		_, err = k.CallEVMWithDataOnGenesis(ctx, types.ModuleAddress, nil, contracts.ERC721VeNftContract.Bin)
+	} else {
+		_, err = k.CallEVMWithData(ctx, types.ModuleAddress, nil, contracts.ERC721VeNftContract.Bin)
+	}
+	if err != nil {
+		return common.Address{}, sdkerrors.Wrap(err, "failed to deploy contract for ve NFTs")
+	}
+
+	k.SetVeNftContract(ctx, contractAddr)
+
+	k.nftKeeper.IterateVeNfts(ctx, func(veID uint64, owner sdk.AccAddress) (stop bool) {
+		err = k.SyncVeNftOwner(ctx, veID, owner)
+		return err != nil
+	})
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return contractAddr, nil
+}
+
+// SyncVeNftOwner sets the owner of the ve NFT in the ERC721 contract.
+// The NFT is minted if it does not exist in the contract, and burned if the owner is empty.
+// It does nothing before the contract is deployed on genesis, since the deployment
+// mirrors the owners of all existing ve NFTs.
+func (k Keeper) SyncVeNftOwner(ctx sdk.Context, veID uint64, owner sdk.AccAddress) error {
+	contract, found := k.GetVeNftContract(ctx)
+	if !found {
+		return nil
+	}
+
+	erc721 := contracts.ERC721VeNftContract.ABI
+	_, err := k.CallEVM(ctx, erc721, types.ModuleAddress, contract, "syncOwner", new(big.Int).SetUint64(veID), common.BytesToAddress(owner))
+	return err
+}
+
+// processVeNftLog mirrors the ERC721 Transfer event of ve NFT into the nft module
+func (h EvmHooks) processVeNftLog(ctx sdk.Context, log *ethtypes.Log) error {
+	erc721 := contracts.ERC721VeNftContract.ABI
+
+	// We only care about event Transfer(from, to, tokenId)
+	if len(log.Topics) != 4 || log.Topics[0] != erc721.Events[types.ERC721EventTransfer].ID {
+		return nil
+	}
+
+	from := common.BytesToAddress(log.Topics[1].Bytes())
+	to := common.BytesToAddress(log.Topics[2].Bytes())
+	tokenID := log.Topics[3].Big()
+
+	// Mint and burn are only done by the module via syncing, which does not trigger the hooks
+	if from == (common.Address{}) || to == (common.Address{}) {
+		return nil
+	}
+
+	if !tokenID.IsUint64() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid ve NFT token id %s", tokenID)
+	}
+
+	return h.k.nftKeeper.TransferVeNftFromEVM(ctx, tokenID.Uint64(), sdk.AccAddress(from.Bytes()), sdk.AccAddress(to.Bytes()))
+}
@@ -0,0 +1,217 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	nfttypes "github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/tharsis/ethermint/tests"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/elysiumstation/blackfury/app"
+	"github.com/elysiumstation/blackfury/x/erc20/contracts"
+	"github.com/elysiumstation/blackfury/x/erc20/keeper"
+	erc20types "github.com/elysiumstation/blackfury/x/erc20/types"
+	vetypes "github.com/elysiumstation/blackfury/x/ve/types"
+)
+
+func (suite *KeeperTestSuite) callVeNft(from common.Address, method string, args ...interface{}) (*evmtypes.MsgEthereumTxResponse, error) {
+	contract, found := suite.app.Erc20Keeper.GetVeNftContract(suite.ctx)
+	suite.Require().True(found)
+	return suite.app.Erc20Keeper.CallEVM(suite.ctx, contracts.ERC721VeNftContract.ABI, from, contract, method, args...)
+}
+
+func (suite *KeeperTestSuite) veNftOwnerOf(veID uint64) (common.Address, error) {
+	res, err := suite.callVeNft(erc20types.ModuleAddress, "ownerOf", new(big.Int).SetUint64(veID))
+	if err != nil {
+		return common.Address{}, err
+	}
+	unpacked, err := contracts.ERC721VeNftContract.ABI.Unpack("ownerOf", res.Ret)
+	suite.Require().NoError(err)
+	return unpacked[0].(common.Address), nil
+}
+
+func (suite *KeeperTestSuite) veNftBalanceOf(owner common.Address) uint64 {
+	res, err := suite.callVeNft(erc20types.ModuleAddress, "balanceOf", owner)
+	suite.Require().NoError(err)
+	unpacked, err := contracts.ERC721VeNftContract.ABI.Unpack("balanceOf", res.Ret)
+	suite.Require().NoError(err)
+	return unpacked[0].(*big.Int).Uint64()
+}
+
+// transferVeNftInEVM calls the ERC721 contract like an EVM tx and runs the EVM hooks over the logs
+func (suite *KeeperTestSuite) transferVeNftInEVM(from common.Address, method string, args ...interface{}) error {
+	res, err := suite.callVeNft(from, method, args...)
+	if err != nil {
+		return err
+	}
+	receipt := &ethtypes.Receipt{Logs: evmtypes.LogsToEthereum(res.Logs)}
+	return suite.app.Erc20Keeper.EvmHooks().PostTxProcessing(suite.ctx, nil, receipt)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_VeNftMirror() {
+	suite.SetupTest()
+	require := suite.Require()
+
+	alice, _ := tests.NewAddrKey()
+	bob, _ := tests.NewAddrKey()
+	carol, _ := tests.NewAddrKey()
+	veKeeper := suite.app.VeKeeper
+	amount := sdk.NewCoin(veKeeper.LockDenom(suite.ctx), sdk.NewInt(1000000))
+	for _, addr := range []common.Address{alice, bob, carol} {
+		err := app.FundAccount(suite.app.BankKeeper, suite.ctx, addr.Bytes(), sdk.NewCoins(amount.Add(amount)))
+		require.NoError(err)
+	}
+
+	// locking mints the ve NFT in the EVM
+	veID, _, err := veKeeper.CreateLock(suite.ctx, alice.Bytes(), alice.Bytes(), amount, vetypes.MaxLockTime)
+	require.NoError(err)
+	owner, err := suite.veNftOwnerOf(veID)
+	require.NoError(err)
+	require.Equal(alice, owner)
+	require.Equal(uint64(1), suite.veNftBalanceOf(alice))
+	contractRes, err := suite.app.Erc20Keeper.VeNftContract(sdk.WrapSDKContext(suite.ctx), &erc20types.QueryVeNftContractRequest{})
+	require.NoError(err)
+	contract := common.HexToAddress(contractRes.Contract)
+	require.NotEqual(common.Address{}, contract)
+
+	res, err := suite.callVeNft(erc20types.ModuleAddress, "name")
+	require.NoError(err)
+	name, err := contracts.ERC721VeNftContract.ABI.Unpack("name", res.Ret)
+	require.NoError(err)
+	require.Equal(vetypes.VeNftClass.Name, name[0])
+	for _, tc := range []struct {
+		interfaceID [4]byte
+		supported   bool
+	}{
+		{[4]byte{0x01, 0xff, 0xc9, 0xa7}, true},
+		{[4]byte{0x80, 0xac, 0x58, 0xcd}, true},
+		{[4]byte{0xff, 0xff, 0xff, 0xff}, false},
+	} {
+		res, err = suite.callVeNft(erc20types.ModuleAddress, "supportsInterface", tc.interfaceID)
+		require.NoError(err)
+		supported, err := contracts.ERC721VeNftContract.ABI.Unpack("supportsInterface", res.Ret)
+		require.NoError(err)
+		require.Equal(tc.supported, supported[0])
+	}
+
+	// only the module can sync owners
+	_, err = suite.callVeNft(alice, "syncOwner", new(big.Int).SetUint64(veID), bob)
+	require.Error(err)
+
+	// sending in the nft module is mirrored into the EVM
+	nftID := vetypes.VeIDFromUint64(veID)
+	_, err = suite.app.NftKeeper.Send(sdk.WrapSDKContext(suite.ctx), &nfttypes.MsgSend{
+		ClassId:  vetypes.VeNftClass.Id,
+		Id:       nftID,
+		Sender:   sdk.AccAddress(alice.Bytes()).String(),
+		Receiver: sdk.AccAddress(bob.Bytes()).String(),
+	})
+	require.NoError(err)
+	owner, err = suite.veNftOwnerOf(veID)
+	require.NoError(err)
+	require.Equal(bob, owner)
+	require.Equal(uint64(0), suite.veNftBalanceOf(alice))
+	require.Equal(uint64(1), suite.veNftBalanceOf(bob))
+
+	// transfers in the EVM are mirrored into the nft module
+	err = suite.transferVeNftInEVM(alice, "transferFrom", bob, alice, new(big.Int).SetUint64(veID))
+	require.Error(err, "not owner nor approved")
+	err = suite.transferVeNftInEVM(bob, "transferFrom", bob, carol, new(big.Int).SetUint64(veID))
+	require.NoError(err)
+	require.Equal(sdk.AccAddress(carol.Bytes()), suite.app.NftKeeper.GetOwner(suite.ctx, vetypes.VeNftClass.Id, nftID))
+
+	err = suite.transferVeNftInEVM(carol, "approve", alice, new(big.Int).SetUint64(veID))
+	require.NoError(err)
+	err = suite.transferVeNftInEVM(alice, "safeTransferFrom", carol, alice, new(big.Int).SetUint64(veID))
+	require.NoError(err)
+	require.Equal(sdk.AccAddress(alice.Bytes()), suite.app.NftKeeper.GetOwner(suite.ctx, vetypes.VeNftClass.Id, nftID))
+	err = suite.transferVeNftInEVM(carol, "transferFrom", alice, carol, new(big.Int).SetUint64(veID))
+	require.Error(err, "approval cleared by transfer")
+
+	err = suite.transferVeNftInEVM(alice, "setApprovalForAll", bob, true)
+	require.NoError(err)
+	err = suite.transferVeNftInEVM(bob, "safeTransferFrom0", alice, bob, new(big.Int).SetUint64(veID), []byte("data"))
+	require.NoError(err)
+	require.Equal(sdk.AccAddress(bob.Bytes()), suite.app.NftKeeper.GetOwner(suite.ctx, vetypes.VeNftClass.Id, nftID))
+	require.Equal(uint64(0), suite.veNftBalanceOf(alice))
+	require.Equal(uint64(0), suite.veNftBalanceOf(carol))
+	require.Equal(uint64(1), suite.veNftBalanceOf(bob))
+
+	// safe transfers check the receiver contract
+	err = suite.transferVeNftInEVM(bob, "safeTransferFrom", bob, contract, new(big.Int).SetUint64(veID))
+	require.Error(err, "not ERC721 receiver")
+
+	// attached ve can not be transferred in the EVM
+	veKeeper.SetVeAttached(suite.ctx, veID, 1)
+	err = suite.transferVeNftInEVM(bob, "transferFrom", bob, carol, new(big.Int).SetUint64(veID))
+	require.ErrorIs(err, vetypes.ErrVeAttached)
+	veKeeper.SetVeAttached(suite.ctx, veID, 0)
+
+	// the nft module must agree on the owner
+	err = suite.app.Erc20Keeper.EvmHooks().PostTxProcessing(suite.ctx, nil, &ethtypes.Receipt{Logs: []*ethtypes.Log{{
+		Address: contract,
+		Topics: []common.Hash{
+			contracts.ERC721VeNftContract.ABI.Events["Transfer"].ID,
+			common.BytesToHash(carol.Bytes()),
+			common.BytesToHash(alice.Bytes()),
+			common.BigToHash(new(big.Int).SetUint64(veID)),
+		},
+	}}})
+	require.ErrorIs(err, vetypes.ErrInvalidVeOwner)
+
+	// burning in the nft module is mirrored into the EVM
+	err = suite.app.NftKeeper.Burn(suite.ctx, vetypes.VeNftClass.Id, nftID)
+	require.NoError(err)
+	_, err = suite.veNftOwnerOf(veID)
+	require.Error(err)
+	require.Equal(uint64(0), suite.veNftBalanceOf(bob))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_VeNftMirrorMigration() {
+	suite.SetupTest()
+	require := suite.Require()
+
+	alice, _ := tests.NewAddrKey()
+	bob, _ := tests.NewAddrKey()
+	veKeeper := suite.app.VeKeeper
+	amount := sdk.NewCoin(veKeeper.LockDenom(suite.ctx), sdk.NewInt(1000000))
+	for _, addr := range []common.Address{alice, bob} {
+		err := app.FundAccount(suite.app.BankKeeper, suite.ctx, addr.Bytes(), sdk.NewCoins(amount))
+		require.NoError(err)
+	}
+
+	// the contract is deployed on genesis
+	genesisContract, found := suite.app.Erc20Keeper.GetVeNftContract(suite.ctx)
+	require.True(found)
+	veID1, _, err := veKeeper.CreateLock(suite.ctx, alice.Bytes(), alice.Bytes(), amount, vetypes.MaxLockTime)
+	require.NoError(err)
+
+	// ve created before the upgrade are not mirrored
+	suite.ctx.KVStore(suite.app.GetKey(erc20types.StoreKey)).Delete(erc20types.KeyPrefixVeNftContract)
+	veID2, _, err := veKeeper.CreateLock(suite.ctx, bob.Bytes(), bob.Bytes(), amount, vetypes.MaxLockTime)
+	require.NoError(err)
+
+	// the migration deploys the contract with the owners of all existing ve
+	err = keeper.NewMigrator(suite.app.Erc20Keeper).Migrate2to3(suite.ctx)
+	require.NoError(err)
+	contract, found := suite.app.Erc20Keeper.GetVeNftContract(suite.ctx)
+	require.True(found)
+	require.NotEqual(genesisContract, contract)
+	owner, err := suite.veNftOwnerOf(veID1)
+	require.NoError(err)
+	require.Equal(alice, owner)
+	owner, err = suite.veNftOwnerOf(veID2)
+	require.NoError(err)
+	require.Equal(bob, owner)
+	require.Equal(uint64(1), suite.veNftBalanceOf(alice))
+	require.Equal(uint64(1), suite.veNftBalanceOf(bob))
+
+	// the migration does not deploy twice
+	err = keeper.NewMigrator(suite.app.Erc20Keeper).Migrate2to3(suite.ctx)
+	require.NoError(err)
+	contract2, _ := suite.app.Erc20Keeper.GetVeNftContract(suite.ctx)
+	require.Equal(contract, contract2)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/tharsis/ethermint/server/config"
+	"github.com/tharsis/ethermint/x/evm/statedb"
 	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 
 	"github.com/elysiumstation/blackfury/x/erc20/types"
@@ -181,3 +182,53 @@ func (k Keeper) CallEVMWithData(
 
 	return res, nil
 }
+
+// CallEVMWithDataOnGenesis performs a EVM transaction with the given data like CallEVMWithData,
+// but on genesis, where there is no block proposer to estimate the gas and to obtain the coinbase
+// address from. The gas limit is the default gas cap and the coinbase is the empty address.
+func (k Keeper) CallEVMWithDataOnGenesis(
+	ctx sdk.Context,
+	from common.Address,
+	contract *common.Address,
+	data []byte,
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	nonce, err := k.accountKeeper.GetSequence(ctx, from.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	params := k.evmKeeper.GetParams(ctx)
+	ethCfg := params.ChainConfig.EthereumConfig(k.evmKeeper.ChainID())
+	cfg := &evmtypes.EVMConfig{
+		Params:      params,
+		ChainConfig: ethCfg,
+		CoinBase:    common.Address{},
+		BaseFee:     k.evmKeeper.GetBaseFee(ctx, ethCfg),
+	}
+
+	msg := ethtypes.NewMessage(
+		from,
+		contract,
+		nonce,
+		big.NewInt(0),        // amount
+		config.DefaultGasCap, // gasLimit
+		big.NewInt(0),        // gasFeeCap
+		big.NewInt(0),        // gasTipCap
+		big.NewInt(0),        // gasPrice
+		data,
+		ethtypes.AccessList{}, // AccessList
+		true,                  // checkNonce
+	)
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	res, err := k.evmKeeper.ApplyMessageWithConfig(ctx, msg, evmtypes.NewNoOpTracer(), true, cfg, txConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Failed() {
+		return nil, sdkerrors.Wrap(evmtypes.ErrVMExecution, res.VmError)
+	}
+
+	return res, nil
+}
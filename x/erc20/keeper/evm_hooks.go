@@ -34,9 +34,21 @@ func (h EvmHooks) PostTxProcessing(
 	receipt *ethtypes.Receipt,
 ) error {
 	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	veNftContract, veNftFound := h.k.GetVeNftContract(ctx)
 
 	// We only care about event Transfer(sender, recipient, amount)
 	for i, log := range receipt.Logs {
+		if veNftFound && log.Address == veNftContract {
+			if err := h.processVeNftLog(ctx, log); err != nil {
+				h.k.Logger(ctx).Error(
+					"failed to process EVM hook for ve NFT transfer",
+					"txHash", receipt.TxHash.Hex(), "logIndex", i, "error", err.Error(),
+				)
+				return err
+			}
+			continue
+		}
+
 		if len(log.Topics) < 3 {
 			continue
 		}
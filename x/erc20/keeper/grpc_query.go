@@ -82,3 +82,16 @@ func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types
 
 	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
 }
+
+func (k Keeper) VeNftContract(c context.Context, req *types.QueryVeNftContractRequest) (*types.QueryVeNftContractResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	contract, found := k.GetVeNftContract(ctx)
+	if !found {
+		return &types.QueryVeNftContractResponse{}, nil
+	}
+	return &types.QueryVeNftContractResponse{Contract: contract.Hex()}, nil
+}
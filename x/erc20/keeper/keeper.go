@@ -19,6 +19,7 @@ type Keeper struct {
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
 	evmKeeper     types.EVMKeeper
+	nftKeeper     types.NftKeeper
 }
 
 func NewKeeper(
@@ -28,6 +29,7 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	evmKeeper types.EVMKeeper,
+	nftKeeper types.NftKeeper,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -46,6 +48,7 @@ func NewKeeper(
 		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
 		evmKeeper:     evmKeeper,
+		nftKeeper:     nftKeeper,
 	}
 }
 
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+// It deploys the ERC721 contract mirroring the ve NFTs, with the owners of all existing ve NFTs.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	if _, found := m.keeper.GetVeNftContract(ctx); found {
+		return nil
+	}
+	_, err := m.keeper.DeployVeNftContract(ctx)
+	return err
+}
@@ -5,6 +5,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	blackfury "github.com/elysiumstation/blackfury/types"
 	erc20types "github.com/elysiumstation/blackfury/x/erc20/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 )
 
@@ -28,15 +29,20 @@ func (suite *KeeperTestSuite) TestKeeper_RegisterCoin() {
 	_, err = suite.app.Erc20Keeper.RegisterCoin(suite.ctx, "uusd")
 	require.Error(t, err, sdkerrors.Wrapf(erc20types.ErrTokenPairAlreadyExists, "coin denomination already registered: %s", suite.coinMetadata.Base))
 
+	// the contract address derives from the nonce of the module account, which the ve NFT contract deployed on genesis has used
+	nonce, err := suite.app.AccountKeeper.GetSequence(suite.ctx, erc20types.ModuleAddress.Bytes())
+	require.NoError(t, err)
+	expected := crypto.CreateAddress(erc20types.ModuleAddress, nonce)
+
 	addr, err := suite.app.Erc20Keeper.DeployERC20Contract(suite.ctx, suite.coinMetadata)
 	require.NoError(t, err)
-	require.Equal(t, "0xd567B3d7B8FE3C79a1AD8dA978812cfC4Fa05e75", addr.String())
+	require.Equal(t, expected, addr)
 
 	tokenPair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, addr)
 	require.NoError(t, err)
 
-	require.Equal(t, "0xd567B3d7B8FE3C79a1AD8dA978812cfC4Fa05e75", tokenPair.Erc20Address)
-	require.Equal(t, "erc20/0xd567B3d7B8FE3C79a1AD8dA978812cfC4Fa05e75", tokenPair.Denom)
+	require.Equal(t, expected.String(), tokenPair.Erc20Address)
+	require.Equal(t, "erc20/"+expected.String(), tokenPair.Denom)
 	require.Equal(t, erc20types.Owner(2), tokenPair.ContractOwner)
 
 	// QueryERC20
@@ -2,5 +2,6 @@ package types
 
 // erc20 events
 const (
-	ERC20EventTransfer = "Transfer"
+	ERC20EventTransfer  = "Transfer"
+	ERC721EventTransfer = "Transfer"
 )
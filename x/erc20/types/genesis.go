@@ -1,7 +1,8 @@
 package types
 
 import (
-// this line is used by starport scaffolding # genesis/types/import
+	// this line is used by starport scaffolding # genesis/types/import
+	ethermint "github.com/tharsis/ethermint/types"
 )
 
 // DefaultIndex is the default capability global index
@@ -20,5 +21,11 @@ func DefaultGenesis() *GenesisState {
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
 
+	if gs.VeNftContract != "" {
+		if err := ethermint.ValidateAddress(gs.VeNftContract); err != nil {
+			return err
+		}
+	}
+
 	return gs.Params.Validate()
 }
@@ -29,6 +29,9 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// registered token pairs
 	TokenPairs []TokenPair `protobuf:"bytes,2,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs"`
+	// address of the ERC721 contract mirroring the ve NFTs, which is deployed
+	// on genesis if empty
+	VeNftContract string `protobuf:"bytes,3,opt,name=ve_nft_contract,json=veNftContract,proto3" json:"ve_nft_contract,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +81,13 @@ func (m *GenesisState) GetTokenPairs() []TokenPair {
 	return nil
 }
 
+func (m *GenesisState) GetVeNftContract() string {
+	if m != nil {
+		return m.VeNftContract
+	}
+	return ""
+}
+
 // Params defines the erc20 module params
 type Params struct {
 }
@@ -122,24 +132,26 @@ func init() {
 func init() { proto.RegisterFile("blackfury/erc20/v1/genesis.proto", fileDescriptor_a27404ade59a9419) }
 
 var fileDescriptor_a27404ade59a9419 = []byte{
-	// 259 bytes of a gzipped FileDescriptorProto
+	// 292 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xca, 0x49, 0x4c,
 	0xce, 0x4e, 0x2b, 0x2d, 0xaa, 0xd4, 0x4f, 0x2d, 0x4a, 0x36, 0x32, 0xd0, 0x2f, 0x33, 0xd4, 0x4f,
 	0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0xab,
 	0xd0, 0x03, 0xab, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83,
-	0x58, 0x10, 0x95, 0x52, 0x72, 0x58, 0xcc, 0x82, 0x68, 0x01, 0xcb, 0x2b, 0xf5, 0x31, 0x72, 0xf1,
-	0xb8, 0x43, 0xcc, 0x0e, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0xb2, 0xe0, 0x62, 0x2b, 0x48, 0x2c, 0x4a,
-	0xcc, 0x2d, 0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd2, 0xc3, 0xb4, 0x4b, 0x2f, 0x00,
-	0xac, 0xc2, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0xa8, 0x7a, 0x21, 0x17, 0x2e, 0xee, 0x92,
-	0xfc, 0xec, 0xd4, 0xbc, 0xf8, 0x82, 0xc4, 0xcc, 0xa2, 0x62, 0x09, 0x26, 0x05, 0x66, 0x0d, 0x6e,
-	0x23, 0x59, 0x6c, 0xda, 0x43, 0x40, 0xca, 0x02, 0x12, 0x33, 0x8b, 0xa0, 0x26, 0x70, 0x95, 0xc0,
-	0x04, 0x8a, 0x95, 0xf8, 0xb8, 0xd8, 0x20, 0xa6, 0x5b, 0xb1, 0xcc, 0x58, 0x20, 0xcf, 0xe0, 0xe4,
-	0x7d, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c,
-	0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x86, 0xe9, 0x99, 0x25, 0x19,
-	0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xa9, 0x39, 0x95, 0xc5, 0x99, 0xa5, 0xb9, 0xc5, 0x25,
-	0x89, 0x25, 0x99, 0xf9, 0x79, 0xfa, 0x08, 0x4f, 0x57, 0x40, 0xbd, 0x5d, 0x52, 0x59, 0x90, 0x5a,
-	0x9c, 0xc4, 0x06, 0xf6, 0xb4, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xce, 0x3f, 0x0f, 0xf8, 0x62,
-	0x01, 0x00, 0x00,
+	0x58, 0x10, 0x95, 0x52, 0x72, 0x58, 0xcc, 0x82, 0x68, 0x01, 0xcb, 0x2b, 0x6d, 0x63, 0xe4, 0xe2,
+	0x71, 0x87, 0x98, 0x1d, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xc1, 0xc5, 0x56, 0x90, 0x58, 0x94,
+	0x98, 0x5b, 0x2c, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa5, 0x87, 0x69, 0x97, 0x5e, 0x00,
+	0x58, 0x85, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0xf5, 0x42, 0x2e, 0x5c, 0xdc, 0x25,
+	0xf9, 0xd9, 0xa9, 0x79, 0xf1, 0x05, 0x89, 0x99, 0x45, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc,
+	0x46, 0xb2, 0xd8, 0xb4, 0x87, 0x80, 0x94, 0x05, 0x24, 0x66, 0x16, 0x41, 0x4d, 0xe0, 0x2a, 0x81,
+	0x09, 0x14, 0x0b, 0xa9, 0x71, 0xf1, 0x97, 0xa5, 0xc6, 0xe7, 0xa5, 0x95, 0xc4, 0x27, 0xe7, 0xe7,
+	0x95, 0x14, 0x25, 0x26, 0x97, 0x48, 0x30, 0x2b, 0x30, 0x6a, 0x70, 0x06, 0xf1, 0x96, 0xa5, 0xfa,
+	0xa5, 0x95, 0x38, 0x43, 0x05, 0x95, 0xf8, 0xb8, 0xd8, 0x20, 0xae, 0xb0, 0x62, 0x99, 0xb1, 0x40,
+	0x9e, 0xc1, 0xc9, 0xfb, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63,
+	0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x0c, 0xd3,
+	0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x53, 0x73, 0x2a, 0x8b, 0x33, 0x4b,
+	0x73, 0x8b, 0x4b, 0x12, 0x4b, 0x32, 0xf3, 0xf3, 0xf4, 0x11, 0x81, 0x53, 0x01, 0x0d, 0x9e, 0x92,
+	0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0xe0, 0x18, 0x03, 0x06, 0x00, 0x14, 0xdb, 0x29, 0xcf,
+	0x8a, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -162,6 +174,13 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VeNftContract) > 0 {
+		i -= len(m.VeNftContract)
+		copy(dAtA[i:], m.VeNftContract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.VeNftContract)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.TokenPairs) > 0 {
 		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -237,6 +256,10 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = len(m.VeNftContract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -351,6 +374,38 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeNftContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeNftContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -38,6 +38,7 @@ const (
 	prefixTokenPair = iota + 1
 	prefixTokenPairByERC20
 	prefixTokenPairByDenom
+	prefixVeNftContract
 )
 
 // KVStore key prefixes
@@ -45,4 +46,5 @@ var (
 	KeyPrefixTokenPair        = []byte{prefixTokenPair}
 	KeyPrefixTokenPairByERC20 = []byte{prefixTokenPairByERC20}
 	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
+	KeyPrefixVeNftContract    = []byte{prefixVeNftContract}
 )
@@ -308,6 +308,91 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryVeNftContractRequest is the request type for the Query/VeNftContract RPC
+// method.
+type QueryVeNftContractRequest struct {
+}
+
+func (m *QueryVeNftContractRequest) Reset()         { *m = QueryVeNftContractRequest{} }
+func (m *QueryVeNftContractRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVeNftContractRequest) ProtoMessage()    {}
+func (*QueryVeNftContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_106dd9c999c42c12, []int{6}
+}
+func (m *QueryVeNftContractRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeNftContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeNftContractRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeNftContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeNftContractRequest.Merge(m, src)
+}
+func (m *QueryVeNftContractRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeNftContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeNftContractRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeNftContractRequest proto.InternalMessageInfo
+
+// QueryVeNftContractResponse is the response type for the Query/VeNftContract
+// RPC method.
+type QueryVeNftContractResponse struct {
+	// hex address of the ERC721 contract, empty if not deployed yet
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *QueryVeNftContractResponse) Reset()         { *m = QueryVeNftContractResponse{} }
+func (m *QueryVeNftContractResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVeNftContractResponse) ProtoMessage()    {}
+func (*QueryVeNftContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_106dd9c999c42c12, []int{7}
+}
+func (m *QueryVeNftContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeNftContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeNftContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeNftContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeNftContractResponse.Merge(m, src)
+}
+func (m *QueryVeNftContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeNftContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeNftContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeNftContractResponse proto.InternalMessageInfo
+
+func (m *QueryVeNftContractResponse) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryTokenPairsRequest)(nil), "blackfury.erc20.v1.QueryTokenPairsRequest")
 	proto.RegisterType((*QueryTokenPairsResponse)(nil), "blackfury.erc20.v1.QueryTokenPairsResponse")
@@ -315,45 +400,51 @@ func init() {
 	proto.RegisterType((*QueryTokenPairResponse)(nil), "blackfury.erc20.v1.QueryTokenPairResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "blackfury.erc20.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "blackfury.erc20.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryVeNftContractRequest)(nil), "blackfury.erc20.v1.QueryVeNftContractRequest")
+	proto.RegisterType((*QueryVeNftContractResponse)(nil), "blackfury.erc20.v1.QueryVeNftContractResponse")
 }
 
 func init() { proto.RegisterFile("blackfury/erc20/v1/query.proto", fileDescriptor_106dd9c999c42c12) }
 
 var fileDescriptor_106dd9c999c42c12 = []byte{
-	// 515 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xeb, 0x8d, 0x55, 0xea, 0xeb, 0xcd, 0x14, 0x98, 0xa2, 0x91, 0x95, 0x1c, 0xd6, 0xad,
-	0x08, 0x9b, 0x94, 0x0b, 0xe7, 0x0a, 0xc1, 0x81, 0x03, 0xa5, 0xe2, 0x84, 0x90, 0xc0, 0xa9, 0x4c,
-	0x88, 0xd6, 0xc6, 0x59, 0xec, 0x54, 0x54, 0x68, 0x17, 0x3e, 0x00, 0x42, 0xda, 0x97, 0xe0, 0xc2,
-	0xf7, 0xd8, 0x71, 0x12, 0x17, 0x4e, 0x08, 0xb5, 0x7c, 0x10, 0x14, 0xdb, 0x4d, 0x1b, 0x16, 0xd4,
-	0xde, 0x92, 0xe7, 0xf7, 0xfe, 0xff, 0xdf, 0x7b, 0xcf, 0x32, 0xb8, 0xc1, 0x98, 0x8d, 0x4e, 0xdf,
-	0x67, 0xe9, 0x8c, 0xf2, 0x74, 0xd4, 0x7b, 0x48, 0xa7, 0x3e, 0x3d, 0xcb, 0x78, 0x3a, 0x23, 0x49,
-	0x2a, 0x94, 0xc0, 0xb8, 0x38, 0x27, 0xfa, 0x9c, 0x4c, 0x7d, 0xe7, 0x20, 0x14, 0x22, 0x1c, 0x73,
-	0xca, 0x92, 0x88, 0xb2, 0x38, 0x16, 0x8a, 0xa9, 0x48, 0xc4, 0xd2, 0x54, 0x38, 0xad, 0x50, 0x84,
-	0x42, 0x7f, 0xd2, 0xfc, 0xcb, 0x46, 0xbb, 0x23, 0x21, 0x27, 0x42, 0xd2, 0x80, 0x49, 0x6e, 0x0c,
-	0xe8, 0xd4, 0x0f, 0xb8, 0x62, 0x3e, 0x4d, 0x58, 0x18, 0xc5, 0x5a, 0xc2, 0xe6, 0xb6, 0x2b, 0x98,
-	0x42, 0x1e, 0x73, 0x19, 0x2d, 0x3d, 0xaa, 0xa8, 0x0d, 0x9e, 0x3e, 0xf7, 0xde, 0xc1, 0xed, 0x97,
-	0xb9, 0xc7, 0x2b, 0x71, 0xca, 0xe3, 0x01, 0x8b, 0x52, 0x39, 0xe4, 0x67, 0x19, 0x97, 0x0a, 0x3f,
-	0x05, 0x58, 0xf9, 0xed, 0xa3, 0x36, 0x3a, 0x6e, 0xf6, 0x8e, 0x88, 0x81, 0x23, 0x39, 0x1c, 0x31,
-	0xdd, 0x5b, 0x38, 0x32, 0x60, 0x21, 0xb7, 0xb5, 0xc3, 0xb5, 0x4a, 0xef, 0x1b, 0x82, 0x3b, 0xd7,
-	0x2c, 0x64, 0x22, 0x62, 0xc9, 0xf1, 0x13, 0x68, 0xaa, 0x3c, 0xfa, 0x36, 0xc9, 0xc3, 0xfb, 0xa8,
-	0xbd, 0x7b, 0xdc, 0xec, 0xdd, 0x25, 0xd7, 0x27, 0x49, 0x8a, 0xe2, 0xfe, 0x8d, 0xcb, 0x5f, 0x87,
-	0xb5, 0x21, 0xa8, 0x42, 0x0d, 0x3f, 0x2b, 0x91, 0xee, 0x68, 0xd2, 0xce, 0x46, 0x52, 0x83, 0x50,
-	0x42, 0x7d, 0x00, 0xb7, 0xca, 0xa4, 0xcb, 0x59, 0xb4, 0x60, 0x4f, 0xfb, 0xe9, 0x31, 0x34, 0x86,
-	0xe6, 0xc7, 0x7b, 0xf3, 0xef, 0xec, 0x8a, 0xbe, 0xfa, 0x00, 0xab, 0xbe, 0xec, 0xec, 0xb6, 0x6a,
-	0xab, 0x51, 0xb4, 0xe5, 0xb5, 0x00, 0x6b, 0xf5, 0x01, 0x4b, 0xd9, 0x64, 0xb9, 0x15, 0xef, 0x05,
-	0xdc, 0x2c, 0x45, 0xad, 0xe1, 0x63, 0xa8, 0x27, 0x3a, 0x62, 0xcd, 0x9c, 0x2a, 0x33, 0x53, 0x63,
-	0x9d, 0x6c, 0x7e, 0xef, 0xfb, 0x2e, 0xec, 0x69, 0x45, 0xfc, 0x05, 0x01, 0xac, 0x76, 0x84, 0xbb,
-	0x55, 0x12, 0xd5, 0x77, 0xc5, 0xb9, 0xbf, 0x55, 0xae, 0x61, 0xf5, 0x3a, 0x9f, 0x7f, 0xfc, 0xb9,
-	0xd8, 0xb9, 0x87, 0x0f, 0x69, 0xc5, 0xdd, 0x5c, 0xbb, 0x0e, 0xf8, 0x02, 0x41, 0xa3, 0xa8, 0xc7,
-	0x27, 0x9b, 0x3d, 0x96, 0x38, 0xdd, 0x6d, 0x52, 0x2d, 0x0d, 0xd5, 0x34, 0x27, 0xb8, 0xb3, 0x81,
-	0x86, 0x7e, 0xd2, 0x3f, 0xe7, 0xf8, 0x1c, 0xea, 0x66, 0x90, 0xf8, 0xe8, 0xbf, 0x36, 0xa5, 0x9d,
-	0x39, 0x9d, 0x8d, 0x79, 0x96, 0xc5, 0xd3, 0x2c, 0x07, 0xd8, 0xa9, 0x62, 0x31, 0xfb, 0xea, 0x3f,
-	0xbf, 0x9c, 0xbb, 0xe8, 0x6a, 0xee, 0xa2, 0xdf, 0x73, 0x17, 0x7d, 0x5d, 0xb8, 0xb5, 0xab, 0x85,
-	0x5b, 0xfb, 0xb9, 0x70, 0x6b, 0xaf, 0xfd, 0x30, 0x52, 0x1f, 0xb2, 0x80, 0x8c, 0xc4, 0x84, 0xf2,
-	0xf1, 0x4c, 0x46, 0xd9, 0x44, 0x9a, 0x07, 0x67, 0x4d, 0xee, 0xa3, 0x15, 0x54, 0xb3, 0x84, 0xcb,
-	0xa0, 0xae, 0x1f, 0x81, 0x47, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x37, 0x66, 0xfb, 0xb8, 0xdc,
-	0x04, 0x00, 0x00,
+	// 581 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcd, 0x6e, 0x13, 0x31,
+	0x10, 0xc7, 0xb3, 0xa5, 0xad, 0xe8, 0x44, 0x5c, 0x4c, 0x80, 0xb2, 0x94, 0x6d, 0x58, 0x44, 0xd3,
+	0xa6, 0xaa, 0x4d, 0xc2, 0xa5, 0xe7, 0x80, 0xe0, 0x80, 0x04, 0x21, 0x42, 0x1c, 0x10, 0x52, 0x70,
+	0x56, 0xce, 0xb2, 0x6a, 0xb2, 0xde, 0xae, 0x9d, 0x88, 0x08, 0xf5, 0xc2, 0x03, 0x20, 0xa4, 0x1e,
+	0x79, 0x01, 0x1e, 0xa5, 0x12, 0x97, 0x4a, 0x5c, 0x38, 0x21, 0x94, 0xf0, 0x20, 0x28, 0xb6, 0x77,
+	0x93, 0xa5, 0x5b, 0x25, 0x37, 0x7b, 0x3c, 0x33, 0xff, 0xdf, 0x7c, 0xc8, 0xe0, 0x74, 0x7a, 0xd4,
+	0x3b, 0xea, 0x0e, 0xe2, 0x11, 0x61, 0xb1, 0x57, 0x7f, 0x48, 0x86, 0x35, 0x72, 0x3c, 0x60, 0xf1,
+	0x08, 0x47, 0x31, 0x97, 0x1c, 0xa1, 0xf4, 0x1d, 0xab, 0x77, 0x3c, 0xac, 0xd9, 0x5b, 0x3e, 0xe7,
+	0x7e, 0x8f, 0x11, 0x1a, 0x05, 0x84, 0x86, 0x21, 0x97, 0x54, 0x06, 0x3c, 0x14, 0x3a, 0xc2, 0x2e,
+	0xf9, 0xdc, 0xe7, 0xea, 0x48, 0xa6, 0x27, 0x63, 0xad, 0x7a, 0x5c, 0xf4, 0xb9, 0x20, 0x1d, 0x2a,
+	0x98, 0x16, 0x20, 0xc3, 0x5a, 0x87, 0x49, 0x5a, 0x23, 0x11, 0xf5, 0x83, 0x50, 0xa5, 0x30, 0xbe,
+	0xe5, 0x1c, 0x26, 0x9f, 0x85, 0x4c, 0x04, 0x89, 0x46, 0x1e, 0xb5, 0x3a, 0xe8, 0x77, 0xf7, 0x3d,
+	0xdc, 0x7c, 0x35, 0xd5, 0x78, 0xcd, 0x8f, 0x58, 0xd8, 0xa4, 0x41, 0x2c, 0x5a, 0xec, 0x78, 0xc0,
+	0x84, 0x44, 0x4f, 0x01, 0x66, 0x7a, 0x9b, 0x56, 0xd9, 0xda, 0x2d, 0xd6, 0x77, 0xb0, 0x86, 0xc3,
+	0x53, 0x38, 0xac, 0xab, 0x37, 0x70, 0xb8, 0x49, 0x7d, 0x66, 0x62, 0x5b, 0x73, 0x91, 0xee, 0x77,
+	0x0b, 0x6e, 0x5d, 0x90, 0x10, 0x11, 0x0f, 0x05, 0x43, 0x4f, 0xa0, 0x28, 0xa7, 0xd6, 0x76, 0x34,
+	0x35, 0x6f, 0x5a, 0xe5, 0x2b, 0xbb, 0xc5, 0xfa, 0x5d, 0x7c, 0xb1, 0x93, 0x38, 0x0d, 0x6e, 0xac,
+	0x9e, 0xfd, 0xde, 0x2e, 0xb4, 0x40, 0xa6, 0xd9, 0xd0, 0xb3, 0x0c, 0xe9, 0x8a, 0x22, 0xad, 0x2c,
+	0x24, 0xd5, 0x08, 0x19, 0xd4, 0x03, 0xb8, 0x91, 0x25, 0x4d, 0x7a, 0x51, 0x82, 0x35, 0xa5, 0xa7,
+	0xda, 0xb0, 0xd1, 0xd2, 0x17, 0xf7, 0xdd, 0xff, 0xbd, 0x4b, 0xeb, 0x6a, 0x00, 0xcc, 0xea, 0x32,
+	0xbd, 0x5b, 0xaa, 0xac, 0x8d, 0xb4, 0x2c, 0xb7, 0x04, 0x48, 0x65, 0x6f, 0xd2, 0x98, 0xf6, 0x93,
+	0xa9, 0xb8, 0x2f, 0xe1, 0x7a, 0xc6, 0x6a, 0x04, 0x0f, 0x61, 0x3d, 0x52, 0x16, 0x23, 0x66, 0xe7,
+	0x89, 0xe9, 0x18, 0xa3, 0x64, 0xfc, 0xdd, 0x3b, 0x70, 0x5b, 0x25, 0x7c, 0xc3, 0x5e, 0x74, 0xe5,
+	0x63, 0x1e, 0xca, 0x98, 0x7a, 0x32, 0x51, 0x3b, 0x04, 0x3b, 0xef, 0xd1, 0x88, 0xda, 0x70, 0xd5,
+	0x33, 0x36, 0xd3, 0x98, 0xf4, 0x5e, 0xff, 0xb1, 0x0a, 0x6b, 0x2a, 0x14, 0x7d, 0xb1, 0x00, 0x66,
+	0xa3, 0x47, 0xd5, 0x3c, 0xb2, 0xfc, 0x15, 0xb4, 0xf7, 0x97, 0xf2, 0xd5, 0x34, 0x6e, 0xe5, 0xf3,
+	0xcf, 0xbf, 0xa7, 0x2b, 0xf7, 0xd0, 0x36, 0xc9, 0x59, 0xf9, 0xb9, 0x2d, 0x43, 0xa7, 0x16, 0x6c,
+	0xa4, 0xf1, 0x68, 0x6f, 0xb1, 0x46, 0x82, 0x53, 0x5d, 0xc6, 0xd5, 0xd0, 0x10, 0x45, 0xb3, 0x87,
+	0x2a, 0x0b, 0x68, 0xc8, 0x27, 0x75, 0x39, 0x41, 0x27, 0xb0, 0xae, 0xe7, 0x83, 0x76, 0x2e, 0x95,
+	0xc9, 0xac, 0x82, 0x5d, 0x59, 0xe8, 0x67, 0x58, 0x5c, 0xc5, 0xb2, 0x85, 0xec, 0x3c, 0x16, 0xbd,
+	0x06, 0xe8, 0x9b, 0x05, 0xd7, 0x32, 0x53, 0x46, 0x07, 0x97, 0xa6, 0xcf, 0x5b, 0x15, 0x1b, 0x2f,
+	0xeb, 0x6e, 0xa0, 0xf6, 0x15, 0xd4, 0x03, 0x74, 0x3f, 0x0f, 0x6a, 0xc8, 0xda, 0x61, 0x57, 0xb6,
+	0x93, 0x6d, 0x6a, 0x3c, 0x3f, 0x1b, 0x3b, 0xd6, 0xf9, 0xd8, 0xb1, 0xfe, 0x8c, 0x1d, 0xeb, 0xeb,
+	0xc4, 0x29, 0x9c, 0x4f, 0x9c, 0xc2, 0xaf, 0x89, 0x53, 0x78, 0x5b, 0xf3, 0x03, 0xf9, 0x61, 0xd0,
+	0xc1, 0x1e, 0xef, 0x13, 0xd6, 0x1b, 0x89, 0x60, 0xd0, 0x17, 0xfa, 0x97, 0x9d, 0xcb, 0xfb, 0xd1,
+	0x64, 0x96, 0xa3, 0x88, 0x89, 0xce, 0xba, 0xfa, 0xf9, 0x1e, 0xfd, 0x1b, 0x00, 0x7b, 0x9d, 0x8d,
+	0xe9, 0xd1, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -374,6 +465,8 @@ type QueryClient interface {
 	TokenPair(ctx context.Context, in *QueryTokenPairRequest, opts ...grpc.CallOption) (*QueryTokenPairResponse, error)
 	// Params retrieves the erc20 module params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// VeNftContract retrieves the ERC721 contract mirroring the ve NFTs
+	VeNftContract(ctx context.Context, in *QueryVeNftContractRequest, opts ...grpc.CallOption) (*QueryVeNftContractResponse, error)
 }
 
 type queryClient struct {
@@ -411,6 +504,15 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) VeNftContract(ctx context.Context, in *QueryVeNftContractRequest, opts ...grpc.CallOption) (*QueryVeNftContractResponse, error) {
+	out := new(QueryVeNftContractResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.erc20.v1.Query/VeNftContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Retrieves registered token pairs
@@ -419,6 +521,8 @@ type QueryServer interface {
 	TokenPair(context.Context, *QueryTokenPairRequest) (*QueryTokenPairResponse, error)
 	// Params retrieves the erc20 module params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// VeNftContract retrieves the ERC721 contract mirroring the ve NFTs
+	VeNftContract(context.Context, *QueryVeNftContractRequest) (*QueryVeNftContractResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -434,6 +538,9 @@ func (*UnimplementedQueryServer) TokenPair(ctx context.Context, req *QueryTokenP
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) VeNftContract(ctx context.Context, req *QueryVeNftContractRequest) (*QueryVeNftContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VeNftContract not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -493,6 +600,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VeNftContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVeNftContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VeNftContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.erc20.v1.Query/VeNftContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VeNftContract(ctx, req.(*QueryVeNftContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "blackfury.erc20.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -509,6 +634,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "VeNftContract",
+			Handler:    _Query_VeNftContract_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "blackfury/erc20/v1/query.proto",
@@ -717,6 +846,59 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryVeNftContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeNftContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeNftContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeNftContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeNftContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeNftContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -804,6 +986,28 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryVeNftContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryVeNftContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1314,6 +1518,138 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryVeNftContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeNftContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeNftContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeNftContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeNftContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeNftContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
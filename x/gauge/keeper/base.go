@@ -265,6 +265,15 @@ func (b *Base) claimReward(ctx sdk.Context, veID uint64) (err error) {
 
 		if rewardAmount.IsPositive() {
 			coin := sdk.NewCoin(rewardDenom, rewardAmount)
+			// deposit into the lock of ve if it compounds rewards
+			var compounded bool
+			compounded, err = b.keeper.veKeeper.CompoundReward(ctx, veID, pool.GetAddress(), coin)
+			if err != nil {
+				return err
+			}
+			if compounded {
+				continue
+			}
 			err = b.keeper.bankKeeper.SendCoins(ctx, pool.GetAddress(), owner, sdk.NewCoins(coin))
 			if err != nil {
 				return err
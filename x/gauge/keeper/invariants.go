@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/elysiumstation/blackfury/x/gauge/types"
+)
+
+// RegisterInvariants registers the gauge module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "escrow-balance", EscrowBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "total-amounts", TotalAmountsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the gauge module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			EscrowBalanceInvariant(k),
+			TotalAmountsInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// EscrowBalanceInvariant checks that the escrow pool of every gauge and bribe holds at least
+// the total deposited amount plus the remaining and accrued rewards
+func EscrowBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		for _, denom := range k.GetGauges(ctx) {
+			gauge := k.Gauge(ctx, denom)
+			bribe := k.Bribe(ctx, denom)
+			for _, base := range []*Base{&gauge.Base, &bribe.Base} {
+				expected := sdk.NewCoins()
+				if base.isGauge {
+					// only gauge holds the deposited coins, while bribe deposits are votes
+					expected = expected.Add(sdk.NewCoin(denom, base.GetTotalDepositedAmount(ctx)))
+				}
+				base.IterateRewards(ctx, func(reward types.Reward) bool {
+					expected = expected.Add(sdk.NewCoin(reward.Denom, base.RemainingReward(ctx, reward.Denom).Add(reward.AccruedAmount)))
+					return false
+				})
+
+				// do not call EscrowPool, which creates the account if not existing
+				pool := authtypes.NewModuleAddress(base.PoolName())
+				balances := sdk.NewCoins()
+				for _, coin := range expected {
+					balances = balances.Add(k.bankKeeper.GetBalance(ctx, pool, coin.Denom))
+				}
+				if !balances.IsAllGTE(expected) {
+					count++
+					msg += fmt.Sprintf("\t%s escrow pool has balances %s, less than %s\n", base.PoolName(), balances, expected)
+				}
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "escrow-balance",
+			fmt.Sprintf("insufficient escrow pools found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// TotalAmountsInvariant checks that the total deposited and derived amounts of every gauge and bribe
+// equal the sums of the amounts of all users
+func TotalAmountsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		sum := func(iterate func(ctx sdk.Context, handler func(veID uint64, amount sdk.Int) (stop bool))) sdk.Int {
+			total := sdk.ZeroInt()
+			iterate(ctx, func(_ uint64, amount sdk.Int) bool {
+				total = total.Add(amount)
+				return false
+			})
+			return total
+		}
+
+		for _, denom := range k.GetGauges(ctx) {
+			gauge := k.Gauge(ctx, denom)
+			bribe := k.Bribe(ctx, denom)
+			for _, base := range []*Base{&gauge.Base, &bribe.Base} {
+				totalDeposited := base.GetTotalDepositedAmount(ctx)
+				deposited := sum(base.IterateDepositedAmountsByUser)
+				if !totalDeposited.Equal(deposited) {
+					count++
+					msg += fmt.Sprintf("\t%s total deposited amount %s, but sum of users %s\n", base.PoolName(), totalDeposited, deposited)
+				}
+				if !base.isGauge {
+					continue
+				}
+				totalDerived := base.GetTotalDerivedAmount(ctx)
+				derived := sum(base.IterateDerivedAmountsByUser)
+				if !totalDerived.Equal(derived) {
+					count++
+					msg += fmt.Sprintf("\t%s total derived amount %s, but sum of users %s\n", base.PoolName(), totalDerived, derived)
+				}
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "total-amounts",
+			fmt.Sprintf("inconsistent total amounts found %d\n%s", count, msg),
+		), broken
+	}
+}
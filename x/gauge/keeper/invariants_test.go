@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tharsis/ethermint/tests"
+
+	"github.com/elysiumstation/blackfury/app"
+	blackfurytypes "github.com/elysiumstation/blackfury/types"
+	"github.com/elysiumstation/blackfury/x/gauge/keeper"
+	vekeeper "github.com/elysiumstation/blackfury/x/ve/keeper"
+	vetypes "github.com/elysiumstation/blackfury/x/ve/types"
+)
+
+func TestInvariants(t *testing.T) {
+	blackfury := app.Setup(false)
+	ctx := blackfury.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+	k := blackfury.GaugeKeeper
+
+	// a bonded validator as the block proposer is required for registering minted coins as ERC20 tokens
+	valConsPk := simapp.CreateTestPubKeys(1)[0]
+	app.FundTestAddrs(blackfury, ctx, []sdk.AccAddress{sdk.AccAddress(valConsPk.Address())}, sdk.NewInt(1234))
+	ctx = ctx.WithProposer(sdk.ConsAddress(valConsPk.Address()))
+	tstaking := teststaking.NewHelper(t, ctx, blackfury.StakingKeeper.Keeper)
+	tstaking.Denom = blackfurytypes.AttoFuryDenom
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidator(sdk.ValAddress(valConsPk.Address()), valConsPk, sdk.NewInt(100), true)
+
+	addr, _ := tests.NewAddrKey()
+	sender := sdk.AccAddress(addr.Bytes())
+	amount := sdk.NewCoin("afury", sdk.NewInt(1e18))
+	deposit := sdk.NewCoin("uatom", sdk.NewInt(1_000000))
+	blackfury.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:       "uatom",
+		Display:    "ATOM",
+		Name:       "ATOM",
+		Symbol:     "ATOM",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "uatom", Exponent: 0}, {Denom: "ATOM", Exponent: 6}},
+	})
+	require.NoError(t, app.FundAccount(blackfury.BankKeeper, ctx, sender, sdk.NewCoins(amount.Add(amount), deposit)))
+
+	veServer := vekeeper.NewMsgServerImpl(blackfury.VeKeeper)
+	res, err := veServer.Create(sdk.WrapSDKContext(ctx), &vetypes.MsgCreate{
+		Sender:       sender.String(),
+		Amount:       amount,
+		LockDuration: vetypes.MaxLockTime,
+	})
+	require.NoError(t, err)
+	veID := vetypes.Uint64FromVeID(res.VeId)
+
+	blackfury.VoterKeeper.CreateGauge(ctx, "uatom")
+	gauge := k.Gauge(ctx, "uatom")
+	bribe := k.Bribe(ctx, "uatom")
+
+	requireNotBroken := func() {
+		msg, broken := keeper.AllInvariants(k)(ctx)
+		require.False(t, broken, msg)
+	}
+	requireNotBroken()
+
+	require.NoError(t, gauge.Deposit(ctx, veID, deposit.Amount))
+	require.NoError(t, gauge.DepositReward(ctx, sender, "afury", amount.Amount))
+	require.NoError(t, blackfury.VoterKeeper.Vote(ctx, veID, map[string]sdk.Dec{"uatom": sdk.OneDec()}))
+	requireNotBroken()
+
+	// claim part of the rewards
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(3 * 24 * time.Hour))
+	require.NoError(t, gauge.ClaimReward(ctx, veID, blackfury.VoterKeeper))
+	requireNotBroken()
+
+	require.NoError(t, gauge.Withdraw(ctx, veID, deposit.Amount.QuoRaw(2)))
+	requireNotBroken()
+
+	testCases := []struct {
+		name      string
+		invariant sdk.Invariant
+		malleate  func(ctx sdk.Context)
+	}{
+		{
+			"escrow pool insufficient",
+			keeper.EscrowBalanceInvariant(k),
+			func(ctx sdk.Context) {
+				pool := authtypes.NewModuleAddress(gauge.PoolName())
+				err := blackfury.BankKeeper.SendCoins(ctx, pool, sender, sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1))))
+				require.NoError(t, err)
+			},
+		},
+		{
+			"total derived drifts",
+			keeper.TotalAmountsInvariant(k),
+			func(ctx sdk.Context) {
+				gauge.SetTotalDerivedAmount(ctx, gauge.GetTotalDerivedAmount(ctx).AddRaw(1))
+			},
+		},
+		{
+			"bribe total deposited drifts",
+			keeper.TotalAmountsInvariant(k),
+			func(ctx sdk.Context) {
+				bribe.SetTotalDepositedAmount(ctx, bribe.GetTotalDepositedAmount(ctx).SubRaw(1))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		cacheCtx, _ := ctx.CacheContext()
+		tc.malleate(cacheCtx)
+		_, broken := tc.invariant(cacheCtx)
+		require.True(t, broken, tc.name)
+		_, broken = keeper.AllInvariants(k)(cacheCtx)
+		require.True(t, broken, tc.name)
+	}
+}
@@ -22,6 +22,7 @@ type (
 		bankKeeper    types.BankKeeper
 		nftKeeper     types.NftKeeper
 		veKeeper      types.VeKeeper
+		voterKeeper   func() types.VoterKeeper
 	}
 )
 
@@ -34,6 +35,7 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	nftKeeper types.NftKeeper,
 	veKeeper types.VeKeeper,
+	voterKeeper func() types.VoterKeeper,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -49,6 +51,7 @@ func NewKeeper(
 		bankKeeper:    bankKeeper,
 		nftKeeper:     nftKeeper,
 		veKeeper:      veKeeper,
+		voterKeeper:   voterKeeper,
 	}
 }
 
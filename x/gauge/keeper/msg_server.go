@@ -1,7 +1,12 @@
 package keeper
 
 import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/elysiumstation/blackfury/x/gauge/types"
+	vetypes "github.com/elysiumstation/blackfury/x/ve/types"
 )
 
 type msgServer struct {
@@ -15,3 +20,65 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 }
 
 var _ types.MsgServer = msgServer{}
+
+func (m msgServer) ClaimGaugeReward(c context.Context, msg *types.MsgClaimGaugeReward) (*types.MsgClaimGaugeRewardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	veID, err := m.checkClaim(ctx, msg.Sender, msg.VeId, msg.PoolDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	// reward is sent to the owner of ve, whoever claims
+	err = m.Keeper.Gauge(ctx, msg.PoolDenom).ClaimReward(ctx, veID, m.Keeper.voterKeeper())
+	if err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx)
+
+	return &types.MsgClaimGaugeRewardResponse{}, nil
+}
+
+func (m msgServer) ClaimBribeReward(c context.Context, msg *types.MsgClaimBribeReward) (*types.MsgClaimBribeRewardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	veID, err := m.checkClaim(ctx, msg.Sender, msg.VeId, msg.PoolDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	// reward is sent to the owner of ve, whoever claims
+	err = m.Keeper.Bribe(ctx, msg.PoolDenom).ClaimReward(ctx, veID)
+	if err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx)
+
+	return &types.MsgClaimBribeRewardResponse{}, nil
+}
+
+// checkClaim checks whether the gauge exists and the sender is authorized to claim for ve
+func (m msgServer) checkClaim(ctx sdk.Context, senderStr string, veIDStr string, poolDenom string) (veID uint64, err error) {
+	sender, err := sdk.AccAddressFromBech32(senderStr)
+	if err != nil {
+		return
+	}
+	if !m.Keeper.HasGauge(ctx, poolDenom) {
+		err = sdkerrors.Wrapf(types.ErrGaugeNotFound, "pool denom %s", poolDenom)
+		return
+	}
+	veID = vetypes.Uint64FromVeID(veIDStr)
+	err = m.Keeper.veKeeper.CheckVoterAuthorized(ctx, veID, sender)
+	return
+}
+
+func emitMessageEvent(ctx sdk.Context) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+}
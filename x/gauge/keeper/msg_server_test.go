@@ -3,14 +3,93 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tharsis/ethermint/tests"
+
+	"github.com/elysiumstation/blackfury/app"
 	keepertest "github.com/elysiumstation/blackfury/testutil/keeper"
+	blackfurytypes "github.com/elysiumstation/blackfury/types"
 	"github.com/elysiumstation/blackfury/x/gauge/keeper"
 	"github.com/elysiumstation/blackfury/x/gauge/types"
+	vetypes "github.com/elysiumstation/blackfury/x/ve/types"
 )
 
 func setupMsgServer(t testing.TB) (types.MsgServer, context.Context) {
 	k, ctx := keepertest.GaugeKeeper(t)
 	return keeper.NewMsgServerImpl(*k), sdk.WrapSDKContext(ctx)
 }
+
+func TestMsgServer_ClaimRewardByApprovedVoter(t *testing.T) {
+	blackfury := app.Setup(false)
+	ctx := blackfury.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+	k := blackfury.GaugeKeeper
+	impl := keeper.NewMsgServerImpl(k)
+
+	// a bonded validator as the block proposer is required for mirroring ve NFTs into the EVM
+	valConsPk := simapp.CreateTestPubKeys(1)[0]
+	app.FundTestAddrs(blackfury, ctx, []sdk.AccAddress{sdk.AccAddress(valConsPk.Address())}, sdk.NewInt(1234))
+	ctx = ctx.WithProposer(sdk.ConsAddress(valConsPk.Address()))
+	tstaking := teststaking.NewHelper(t, ctx, blackfury.StakingKeeper.Keeper)
+	tstaking.Denom = blackfurytypes.AttoFuryDenom
+	tstaking.CreateValidator(sdk.ValAddress(valConsPk.Address()), valConsPk, sdk.NewInt(100), true)
+
+	newAddr := func() sdk.AccAddress {
+		addr, _ := tests.NewAddrKey()
+		return sdk.AccAddress(addr.Bytes())
+	}
+	owner, voter := newAddr(), newAddr()
+	amount := sdk.NewCoin("afury", sdk.NewInt(1e18))
+	deposit := sdk.NewCoin("uatom", sdk.NewInt(1_000000))
+	blackfury.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:       "uatom",
+		Display:    "ATOM",
+		Name:       "ATOM",
+		Symbol:     "ATOM",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "uatom", Exponent: 0}, {Denom: "ATOM", Exponent: 6}},
+	})
+	require.NoError(t, app.FundAccount(blackfury.BankKeeper, ctx, owner, sdk.NewCoins(amount.Add(amount), deposit)))
+	veID, _, err := blackfury.VeKeeper.CreateLock(ctx, owner, owner, amount, vetypes.MaxLockTime)
+	require.NoError(t, err)
+	id := vetypes.VeIDFromUint64(veID)
+
+	blackfury.VoterKeeper.CreateGauge(ctx, "uatom")
+	gauge := k.Gauge(ctx, "uatom")
+	require.NoError(t, gauge.Deposit(ctx, veID, deposit.Amount))
+	require.NoError(t, gauge.DepositReward(ctx, owner, "afury", amount.Amount))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(3 * 24 * time.Hour))
+	c := sdk.WrapSDKContext(ctx)
+
+	_, err = impl.ClaimGaugeReward(c, &types.MsgClaimGaugeReward{Sender: voter.String(), VeId: id, PoolDenom: "uatom"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = impl.ClaimBribeReward(c, &types.MsgClaimBribeReward{Sender: voter.String(), VeId: id, PoolDenom: "uatom"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// reward claimed by the approved voter goes to the owner
+	blackfury.VeKeeper.SetApprovedVoter(ctx, veID, voter)
+	_, err = impl.ClaimGaugeReward(c, &types.MsgClaimGaugeReward{Sender: voter.String(), VeId: id, PoolDenom: "uosmo"})
+	require.ErrorIs(t, err, types.ErrGaugeNotFound)
+	_, err = impl.ClaimGaugeReward(c, &types.MsgClaimGaugeReward{Sender: voter.String(), VeId: id, PoolDenom: "uatom"})
+	require.NoError(t, err)
+	require.True(t, blackfury.BankKeeper.GetBalance(ctx, owner, "afury").IsPositive())
+	require.True(t, blackfury.BankKeeper.GetBalance(ctx, voter, "afury").IsZero())
+	_, err = impl.ClaimBribeReward(c, &types.MsgClaimBribeReward{Sender: voter.String(), VeId: id, PoolDenom: "uatom"})
+	require.NoError(t, err)
+
+	// reward in the lock denom is deposited into the lock if compounding rewards
+	blackfury.VeKeeper.SetVeAutoCompound(ctx, veID, true)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(24 * time.Hour))
+	balance := blackfury.BankKeeper.GetBalance(ctx, owner, "afury")
+	_, err = impl.ClaimGaugeReward(sdk.WrapSDKContext(ctx), &types.MsgClaimGaugeReward{Sender: owner.String(), VeId: id, PoolDenom: "uatom"})
+	require.NoError(t, err)
+	require.Equal(t, balance, blackfury.BankKeeper.GetBalance(ctx, owner, "afury"))
+	require.True(t, blackfury.VeKeeper.GetLockedAmountByUser(ctx, veID).Amount.GT(amount.Amount))
+}
@@ -72,6 +72,11 @@ func (b *Base) DeleteDepositedAmountByUser(ctx sdk.Context, veID uint64) {
 	store.Delete(types.DepositedAmountByUserKey(b.prefixKey, veID))
 }
 
+// IterateDepositedAmountsByUser iterates over the deposited amounts of all users, until the handler returns true
+func (b *Base) IterateDepositedAmountsByUser(ctx sdk.Context, handler func(veID uint64, amount sdk.Int) (stop bool)) {
+	b.iterateAmountsByUser(ctx, append(types.KeyPrefixDepositedAmountByUser, b.prefixKey...), handler)
+}
+
 func (b *Base) SetTotalDerivedAmount(ctx sdk.Context, amount sdk.Int) {
 	store := ctx.KVStore(b.keeper.storeKey)
 	bz := b.keeper.cdc.MustMarshal(&sdk.IntProto{amount})
@@ -106,6 +111,29 @@ func (b *Base) GetDerivedAmountByUser(ctx sdk.Context, veID uint64) sdk.Int {
 	return amount.Int
 }
 
+// IterateDerivedAmountsByUser iterates over the derived amounts of all users, until the handler returns true
+func (b *Base) IterateDerivedAmountsByUser(ctx sdk.Context, handler func(veID uint64, amount sdk.Int) (stop bool)) {
+	b.iterateAmountsByUser(ctx, append(types.KeyPrefixDerivedAmountByUser, b.prefixKey...), handler)
+}
+
+func (b *Base) iterateAmountsByUser(ctx sdk.Context, prefix []byte, handler func(veID uint64, amount sdk.Int) (stop bool)) {
+	store := ctx.KVStore(b.keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[len(prefix):]
+		if len(key) != 8 {
+			// belongs to another denom which has this denom as prefix
+			continue
+		}
+		var amount sdk.IntProto
+		b.keeper.cdc.MustUnmarshal(iter.Value(), &amount)
+		if handler(sdk.BigEndianToUint64(key), amount.Int) {
+			break
+		}
+	}
+}
+
 func (b *Base) SetReward(ctx sdk.Context, rewardDenom string, reward types.Reward) {
 	store := ctx.KVStore(b.keeper.storeKey)
 	bz := b.keeper.cdc.MustMarshal(&reward)
@@ -12,4 +12,5 @@ var (
 	ErrInvalidAmount        = sdkerrors.Register(ModuleName, 3, "invalid amount")
 	ErrTooSmallRewardAmount = sdkerrors.Register(ModuleName, 4, "too small reward amount")
 	ErrTooLargeAmount       = sdkerrors.Register(ModuleName, 5, "too large amount")
+	ErrGaugeNotFound        = sdkerrors.Register(ModuleName, 6, "gauge not found")
 )
@@ -29,6 +29,8 @@ type VeKeeper interface {
 	GetVotingPower(ctx sdk.Context, veID uint64, atTime uint64, atBlock int64) sdk.Int
 	IncVeAttached(ctx sdk.Context, veID uint64)
 	DecVeAttached(ctx sdk.Context, veID uint64)
+	CheckVoterAuthorized(ctx sdk.Context, veID uint64, addr sdk.AccAddress) error
+	CompoundReward(ctx sdk.Context, veID uint64, sender sdk.AccAddress, coin sdk.Coin) (bool, error)
 }
 
 type VoterKeeper interface {
@@ -0,0 +1,78 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vetypes "github.com/elysiumstation/blackfury/x/ve/types"
+)
+
+const (
+	TypeMsgClaimGaugeReward = "claim_gauge_reward"
+	TypeMsgClaimBribeReward = "claim_bribe_reward"
+)
+
+var (
+	_ sdk.Msg = &MsgClaimGaugeReward{}
+	_ sdk.Msg = &MsgClaimBribeReward{}
+)
+
+// Route implements sdk.Msg
+func (m *MsgClaimGaugeReward) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgClaimGaugeReward) Type() string { return TypeMsgClaimGaugeReward }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgClaimGaugeReward) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgClaimGaugeReward) ValidateBasic() error {
+	return validateClaim(m.Sender, m.VeId, m.PoolDenom)
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgClaimGaugeReward) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgClaimBribeReward) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgClaimBribeReward) Type() string { return TypeMsgClaimBribeReward }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgClaimBribeReward) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgClaimBribeReward) ValidateBasic() error {
+	return validateClaim(m.Sender, m.VeId, m.PoolDenom)
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgClaimBribeReward) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func validateClaim(sender string, veID string, poolDenom string) error {
+	_, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if vetypes.Uint64FromVeID(veID) == vetypes.EmptyVeID {
+		return vetypes.ErrInvalidVeID
+	}
+	return sdk.ValidateDenom(poolDenom)
+}
@@ -6,10 +6,15 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
 	math "math"
+	math_bits "math/bits"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -23,19 +28,193 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type MsgClaimGaugeReward struct {
+	// sender must be the owner, the approved voter or an operator of the veNFT;
+	// the reward is always sent to the owner
+	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	VeId      string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty" yaml:"ve_id"`
+	PoolDenom string `protobuf:"bytes,3,opt,name=pool_denom,json=poolDenom,proto3" json:"pool_denom,omitempty" yaml:"pool_denom"`
+}
+
+func (m *MsgClaimGaugeReward) Reset()         { *m = MsgClaimGaugeReward{} }
+func (m *MsgClaimGaugeReward) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimGaugeReward) ProtoMessage()    {}
+func (*MsgClaimGaugeReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc888e73e6e73e81, []int{0}
+}
+func (m *MsgClaimGaugeReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimGaugeReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimGaugeReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimGaugeReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimGaugeReward.Merge(m, src)
+}
+func (m *MsgClaimGaugeReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimGaugeReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimGaugeReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimGaugeReward proto.InternalMessageInfo
+
+type MsgClaimGaugeRewardResponse struct {
+}
+
+func (m *MsgClaimGaugeRewardResponse) Reset()         { *m = MsgClaimGaugeRewardResponse{} }
+func (m *MsgClaimGaugeRewardResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimGaugeRewardResponse) ProtoMessage()    {}
+func (*MsgClaimGaugeRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc888e73e6e73e81, []int{1}
+}
+func (m *MsgClaimGaugeRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimGaugeRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimGaugeRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimGaugeRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimGaugeRewardResponse.Merge(m, src)
+}
+func (m *MsgClaimGaugeRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimGaugeRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimGaugeRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimGaugeRewardResponse proto.InternalMessageInfo
+
+type MsgClaimBribeReward struct {
+	// sender must be the owner, the approved voter or an operator of the veNFT;
+	// the reward is always sent to the owner
+	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	VeId      string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty" yaml:"ve_id"`
+	PoolDenom string `protobuf:"bytes,3,opt,name=pool_denom,json=poolDenom,proto3" json:"pool_denom,omitempty" yaml:"pool_denom"`
+}
+
+func (m *MsgClaimBribeReward) Reset()         { *m = MsgClaimBribeReward{} }
+func (m *MsgClaimBribeReward) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimBribeReward) ProtoMessage()    {}
+func (*MsgClaimBribeReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc888e73e6e73e81, []int{2}
+}
+func (m *MsgClaimBribeReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimBribeReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimBribeReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimBribeReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimBribeReward.Merge(m, src)
+}
+func (m *MsgClaimBribeReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimBribeReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimBribeReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimBribeReward proto.InternalMessageInfo
+
+type MsgClaimBribeRewardResponse struct {
+}
+
+func (m *MsgClaimBribeRewardResponse) Reset()         { *m = MsgClaimBribeRewardResponse{} }
+func (m *MsgClaimBribeRewardResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimBribeRewardResponse) ProtoMessage()    {}
+func (*MsgClaimBribeRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc888e73e6e73e81, []int{3}
+}
+func (m *MsgClaimBribeRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimBribeRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimBribeRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimBribeRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimBribeRewardResponse.Merge(m, src)
+}
+func (m *MsgClaimBribeRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimBribeRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimBribeRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimBribeRewardResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgClaimGaugeReward)(nil), "blackfury.gauge.v1.MsgClaimGaugeReward")
+	proto.RegisterType((*MsgClaimGaugeRewardResponse)(nil), "blackfury.gauge.v1.MsgClaimGaugeRewardResponse")
+	proto.RegisterType((*MsgClaimBribeReward)(nil), "blackfury.gauge.v1.MsgClaimBribeReward")
+	proto.RegisterType((*MsgClaimBribeRewardResponse)(nil), "blackfury.gauge.v1.MsgClaimBribeRewardResponse")
+}
+
 func init() { proto.RegisterFile("blackfury/gauge/v1/tx.proto", fileDescriptor_bc888e73e6e73e81) }
 
 var fileDescriptor_bc888e73e6e73e81 = []byte{
-	// 141 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4e, 0xca, 0x49, 0x4c,
-	0xce, 0x4e, 0x2b, 0x2d, 0xaa, 0xd4, 0x4f, 0x4f, 0x2c, 0x4d, 0x4f, 0xd5, 0x2f, 0x33, 0xd4, 0x2f,
-	0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0x4b, 0xea, 0x81, 0x25, 0xf5, 0xca,
-	0x0c, 0x8d, 0x58, 0xb9, 0x98, 0x7d, 0x8b, 0xd3, 0x9d, 0xbc, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0,
-	0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8,
-	0xf1, 0x58, 0x8e, 0x21, 0xca, 0x30, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57,
-	0x3f, 0x35, 0xa7, 0xb2, 0x38, 0xb3, 0x34, 0xb7, 0xb8, 0x24, 0xb1, 0x24, 0x33, 0x3f, 0x4f, 0x1f,
-	0x61, 0x57, 0x05, 0xd4, 0xb6, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x75, 0xc6, 0x80,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x28, 0xa8, 0x56, 0x72, 0x8d, 0x00, 0x00, 0x00,
+	// 352 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x92, 0x3d, 0x4b, 0xc3, 0x40,
+	0x1c, 0xc6, 0x73, 0x56, 0x8b, 0x3d, 0x14, 0xda, 0xa8, 0x50, 0x5a, 0x4c, 0x24, 0x20, 0xea, 0x92,
+	0xa3, 0xea, 0xd4, 0xb1, 0x0a, 0x22, 0xd2, 0x25, 0xa3, 0x4b, 0x49, 0x9a, 0xf3, 0x0c, 0x26, 0xb9,
+	0x90, 0xbb, 0xc4, 0xe6, 0x1b, 0x38, 0xfa, 0x11, 0xba, 0xf9, 0x55, 0x1c, 0x3b, 0x3a, 0x15, 0x6d,
+	0x17, 0xe7, 0x7e, 0x02, 0xc9, 0xa5, 0x2f, 0x91, 0x16, 0xe9, 0xea, 0x76, 0xf7, 0x7f, 0x7e, 0xf0,
+	0xfc, 0x5f, 0x1e, 0x58, 0xb7, 0x5c, 0xb3, 0xfb, 0xf4, 0x10, 0x85, 0x09, 0x22, 0x66, 0x44, 0x30,
+	0x8a, 0x1b, 0x88, 0xf7, 0xf4, 0x20, 0xa4, 0x9c, 0xca, 0xf2, 0x5c, 0xd4, 0x85, 0xa8, 0xc7, 0x8d,
+	0xda, 0x3e, 0xa1, 0x84, 0x0a, 0x19, 0xa5, 0xaf, 0x8c, 0xd4, 0xde, 0x00, 0xdc, 0x6b, 0x33, 0x72,
+	0xe5, 0x9a, 0x8e, 0x77, 0x93, 0xa2, 0x06, 0x7e, 0x36, 0x43, 0x5b, 0x3e, 0x83, 0x45, 0x86, 0x7d,
+	0x1b, 0x87, 0x55, 0x70, 0x04, 0x4e, 0x4b, 0xad, 0xca, 0x64, 0xa8, 0xee, 0x26, 0xa6, 0xe7, 0x36,
+	0xb5, 0xac, 0xae, 0x19, 0x53, 0x40, 0x3e, 0x86, 0x5b, 0x31, 0xee, 0x38, 0x76, 0x75, 0x43, 0x90,
+	0xe5, 0xc9, 0x50, 0xdd, 0xc9, 0x48, 0x51, 0xd6, 0x8c, 0xcd, 0x18, 0xdf, 0xda, 0xf2, 0x25, 0x84,
+	0x01, 0xa5, 0x6e, 0xc7, 0xc6, 0x3e, 0xf5, 0xaa, 0x05, 0xc1, 0x1e, 0x4c, 0x86, 0x6a, 0x25, 0x63,
+	0x17, 0x9a, 0x66, 0x94, 0xd2, 0xcf, 0x75, 0xfa, 0x6e, 0x6e, 0xbf, 0xf4, 0x55, 0xe9, 0xbb, 0xaf,
+	0x4a, 0xda, 0x21, 0xac, 0xaf, 0x68, 0xd4, 0xc0, 0x2c, 0xa0, 0x3e, 0xc3, 0xbf, 0x06, 0x69, 0x85,
+	0x8e, 0xf5, 0x2f, 0x06, 0xc9, 0x35, 0x3a, 0x1b, 0xe4, 0xfc, 0x0b, 0xc0, 0x42, 0x9b, 0x11, 0xd9,
+	0x85, 0xe5, 0xa5, 0xab, 0x9c, 0xe8, 0xcb, 0x87, 0xd5, 0x57, 0x6c, 0xa5, 0x86, 0xd6, 0x04, 0x67,
+	0xae, 0x73, 0xb7, 0xfc, 0xea, 0xfe, 0x74, 0xcb, 0x81, 0x35, 0xb4, 0x26, 0x38, 0x73, 0x6b, 0xdd,
+	0xbd, 0x8f, 0x14, 0x30, 0x18, 0x29, 0xe0, 0x73, 0xa4, 0x80, 0xd7, 0xb1, 0x22, 0x0d, 0xc6, 0x8a,
+	0xf4, 0x31, 0x56, 0xa4, 0xfb, 0x06, 0x71, 0xf8, 0x63, 0x64, 0xe9, 0x5d, 0xea, 0x21, 0xec, 0x26,
+	0xcc, 0x89, 0x3c, 0xc6, 0x4d, 0xee, 0x50, 0x1f, 0x2d, 0x02, 0xdf, 0x9b, 0x46, 0x9e, 0x27, 0x01,
+	0x66, 0x56, 0x51, 0x24, 0xf9, 0xe2, 0x67, 0x00, 0x97, 0xb3, 0x8d, 0xcf, 0x12, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -50,6 +229,10 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
+	// ClaimGaugeReward claims the gauge reward of a veNFT for a pool.
+	ClaimGaugeReward(ctx context.Context, in *MsgClaimGaugeReward, opts ...grpc.CallOption) (*MsgClaimGaugeRewardResponse, error)
+	// ClaimBribeReward claims the bribe reward of a veNFT for a pool.
+	ClaimBribeReward(ctx context.Context, in *MsgClaimBribeReward, opts ...grpc.CallOption) (*MsgClaimBribeRewardResponse, error)
 }
 
 type msgClient struct {
@@ -60,22 +243,784 @@ func NewMsgClient(cc grpc1.ClientConn) MsgClient {
 	return &msgClient{cc}
 }
 
+func (c *msgClient) ClaimGaugeReward(ctx context.Context, in *MsgClaimGaugeReward, opts ...grpc.CallOption) (*MsgClaimGaugeRewardResponse, error) {
+	out := new(MsgClaimGaugeRewardResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.gauge.v1.Msg/ClaimGaugeReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimBribeReward(ctx context.Context, in *MsgClaimBribeReward, opts ...grpc.CallOption) (*MsgClaimBribeRewardResponse, error) {
+	out := new(MsgClaimBribeRewardResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.gauge.v1.Msg/ClaimBribeReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
+	// ClaimGaugeReward claims the gauge reward of a veNFT for a pool.
+	ClaimGaugeReward(context.Context, *MsgClaimGaugeReward) (*MsgClaimGaugeRewardResponse, error)
+	// ClaimBribeReward claims the bribe reward of a veNFT for a pool.
+	ClaimBribeReward(context.Context, *MsgClaimBribeReward) (*MsgClaimBribeRewardResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
 type UnimplementedMsgServer struct {
 }
 
+func (*UnimplementedMsgServer) ClaimGaugeReward(ctx context.Context, req *MsgClaimGaugeReward) (*MsgClaimGaugeRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimGaugeReward not implemented")
+}
+func (*UnimplementedMsgServer) ClaimBribeReward(ctx context.Context, req *MsgClaimBribeReward) (*MsgClaimBribeRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimBribeReward not implemented")
+}
+
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
+func _Msg_ClaimGaugeReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimGaugeReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimGaugeReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.gauge.v1.Msg/ClaimGaugeReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimGaugeReward(ctx, req.(*MsgClaimGaugeReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimBribeReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimBribeReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimBribeReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.gauge.v1.Msg/ClaimBribeReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimBribeReward(ctx, req.(*MsgClaimBribeReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "blackfury.gauge.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
-	Methods:     []grpc.MethodDesc{},
-	Streams:     []grpc.StreamDesc{},
-	Metadata:    "blackfury/gauge/v1/tx.proto",
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ClaimGaugeReward",
+			Handler:    _Msg_ClaimGaugeReward_Handler,
+		},
+		{
+			MethodName: "ClaimBribeReward",
+			Handler:    _Msg_ClaimBribeReward_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "blackfury/gauge/v1/tx.proto",
+}
+
+func (m *MsgClaimGaugeReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimGaugeReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimGaugeReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolDenom) > 0 {
+		i -= len(m.PoolDenom)
+		copy(dAtA[i:], m.PoolDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PoolDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimGaugeRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimGaugeRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimGaugeRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimBribeReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimBribeReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimBribeReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolDenom) > 0 {
+		i -= len(m.PoolDenom)
+		copy(dAtA[i:], m.PoolDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PoolDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimBribeRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimBribeRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
+
+func (m *MsgClaimBribeRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgClaimGaugeReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PoolDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimGaugeRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimBribeReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PoolDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimBribeRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgClaimGaugeReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimGaugeReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimGaugeReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimGaugeRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimGaugeRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimGaugeRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimBribeReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimBribeReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimBribeReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimBribeRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimBribeRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimBribeRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -13,5 +13,6 @@ import (
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
+	k.AccrueAllInterest(ctx)
 	k.AdjustBackingRatio(ctx)
 }
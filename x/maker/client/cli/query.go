@@ -34,9 +34,12 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		GetBackingPoolCmd(),
 		GetCollateralPoolCmd(),
 		GetCollateralOfAccountCmd(),
+		GetAccountPositionsCmd(),
 		GetTotalBackingCmd(),
 		GetTotalCollateralCmd(),
 		GetBackingRatioCmd(),
+		GetAllTreasuryLedgersCmd(),
+		GetTreasuryLedgerCmd(),
 		GetParamsCmd(),
 	)
 
@@ -246,6 +249,36 @@ func GetCollateralOfAccountCmd() *cobra.Command {
 	return cmd
 }
 
+func GetAccountPositionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-positions [account]",
+		Short: "Gets all collateral positions of an account and its health factor",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAccountPositionsRequest{
+				Account: args[0],
+			}
+
+			res, err := queryClient.AccountPositions(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func GetTotalBackingCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "backing-total",
@@ -330,6 +363,66 @@ func GetBackingRatioCmd() *cobra.Command {
 	return cmd
 }
 
+func GetAllTreasuryLedgersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-treasury-ledgers",
+		Short: "Gets the treasury ledgers of all the pools",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAllTreasuryLedgersRequest{}
+
+			res, err := queryClient.AllTreasuryLedgers(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetTreasuryLedgerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "treasury-ledger [asset-class] [denom]",
+		Short: "Gets the treasury ledger of a backing or collateral pool, or of black",
+		Long:  "Gets the treasury ledger of a denom in the asset class, which is one of backing, collateral or black",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTreasuryLedgerRequest{
+				AssetClass: args[0],
+				Denom:      args[1],
+			}
+
+			res, err := queryClient.TreasuryLedger(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func GetParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "params",
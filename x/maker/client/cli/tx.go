@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"io/ioutil"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -10,6 +11,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/elysiumstation/blackfury/x/maker/types"
@@ -37,6 +40,9 @@ func GetTxCmd() *cobra.Command {
 		NewDepositCollateralCmd(),
 		NewRedeemCollateralCmd(),
 		NewLiquidateCollateralCmd(),
+		NewSetCrossMarginCmd(),
+		NewFlashMintCmd(),
+		NewBuyFuryCmd(),
 	)
 
 	return cmd
@@ -533,6 +539,146 @@ func NewLiquidateCollateralCmd() *cobra.Command {
 	return cmd
 }
 
+func NewSetCrossMarginCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-cross-margin [enabled]",
+		Short: "Enable or disable cross-margin across all collateral positions of the sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress().String()
+
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetCrossMargin{
+				Sender:  sender,
+				Enabled: enabled,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewFlashMintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "flash-mint [amount] [msg_tx_json_file]",
+		Short: "Flash mint black, execute the messages of the tx file, and burn the minted black plus fee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Flash mint black, execute the messages of the tx file, and burn the minted black plus fee.
+The messages must be signed by the sender only. The tx file can be generated by --generate-only.
+
+Example:
+$ %s tx maker flash-mint 1000000000ufusd tx.json --from mykey
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			theTx, err := authclient.ReadTxFromFile(cliCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgFlashMint(cliCtx.GetFromAddress(), amount, theTx.GetMsgs())
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewBuyFuryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buy-fury [collateral_denom] [black_in] [receiver]",
+		Short: "Buy fury from the treasury fury reserve by spending black, which is burned against the bad debt of the collateral pool",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress().String()
+
+			blackIn, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			var receiver string
+			if len(args) == 3 {
+				receiver = args[2]
+				if _, err := sdk.AccAddressFromBech32(receiver); err != nil {
+					return fmt.Errorf("invalid receiver bech32 address %w", err)
+				}
+			} else {
+				receiver = sender
+			}
+
+			furyOutMinStr, err := cmd.Flags().GetString(FlagFuryOutMin)
+			if err != nil {
+				return err
+			}
+			furyOutMin, err := sdk.ParseCoinNormalized(furyOutMinStr)
+			if err != nil {
+				return fmt.Errorf("--%s: %w", FlagFuryOutMin, err)
+			}
+
+			msg := &types.MsgBuyFury{
+				Sender:          sender,
+				To:              receiver,
+				CollateralDenom: args[0],
+				BlackIn:         blackIn,
+				FuryOutMin:      furyOutMin,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagFuryOutMin, "", "Minimum fury-out coin")
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func NewRegisterBackingProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "register-backing [proposal-file]",
@@ -821,6 +967,54 @@ The proposal details must be supplied via a JSON file.`,
 	return cmd
 }
 
+func NewCoverBadDebtProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cover-bad-debt [collateral-denom] [max-fury-mint]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to mint fury for covering bad debt",
+		Long: strings.TrimSpace(
+			`Submit a proposal to mint fury for covering the bad debt of a collateral pool
+along with an initial deposit. The bad debt is covered by surplus first.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			title, description, deposit, err := getProposalArgs(cmd)
+			if err != nil {
+				return err
+			}
+
+			maxFuryMint, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			content := &types.CoverBadDebtProposal{
+				Title:           title,
+				Description:     description,
+				CollateralDenom: args[0],
+				MaxFuryMint:     maxFuryMint,
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func parseProposalContent(cdc codec.JSONCodec, proposalFile string, proposal proto.Message) error {
 	content, err := ioutil.ReadFile(proposalFile)
 	if err != nil {
@@ -13,4 +13,5 @@ var (
 	SetCollateralProposalHandler      = govclient.NewProposalHandler(cli.NewSetCollateralProposalCmd, rest.SetCollateralProposalRESTHandler)
 	BatchSetBackingProposalHandler    = govclient.NewProposalHandler(cli.NewBatchSetBackingProposalCmd, rest.BatchSetBackingProposalRESTHandler)
 	BatchSetCollateralProposalHandler = govclient.NewProposalHandler(cli.NewBatchSetCollateralProposalCmd, rest.BatchSetCollateralProposalRESTHandler)
+	CoverBadDebtProposalHandler       = govclient.NewProposalHandler(cli.NewCoverBadDebtProposalCmd, rest.CoverBadDebtProposalRESTHandler)
 )
@@ -60,6 +60,15 @@ type BatchSetCollateralProposalRequest struct {
 	RiskParams  []types.CollateralRiskParams `json:"risk_params" yaml:"risk_params"`
 }
 
+type CoverBadDebtProposalRequest struct {
+	BaseReq         rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title           string       `json:"title" yaml:"title"`
+	Description     string       `json:"description" yaml:"description"`
+	Deposit         sdk.Coins    `json:"deposit" yaml:"deposit"`
+	CollateralDenom string       `json:"collateral_denom" yaml:"collateral_denom"`
+	MaxFuryMint     sdk.Coin     `json:"max_fury_mint" yaml:"max_fury_mint"`
+}
+
 func RegisterBackingProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
 	return govrest.ProposalRESTHandler{
 		SubRoute: types.ModuleName,
@@ -299,3 +308,44 @@ func BatchSetCollateralProposalRESTHandler(clientCtx client.Context) govrest.Pro
 		},
 	}
 }
+
+func CoverBadDebtProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: types.ModuleName,
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req CoverBadDebtProposalRequest
+
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			req.BaseReq = req.BaseReq.Sanitize()
+			if !req.BaseReq.ValidateBasic(w) {
+				return
+			}
+
+			from, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+
+			content := &types.CoverBadDebtProposal{
+				Title:           req.Title,
+				Description:     req.Description,
+				CollateralDenom: req.CollateralDenom,
+				MaxFuryMint:     req.MaxFuryMint,
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, from)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+
+			if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+				return
+			}
+
+			tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+		},
+	}
+}
@@ -45,6 +45,15 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgLiquidateCollateral:
 			res, err := msgServer.LiquidateCollateral(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetCrossMargin:
+			res, err := msgServer.SetCrossMargin(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgFlashMint:
+			res, err := msgServer.FlashMint(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgBuyFury:
+			res, err := msgServer.BuyFury(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -67,6 +76,8 @@ func NewMakerProposalHandler(k keeper.Keeper) govtypes.Handler {
 			return keeper.HandleBatchSetBackingRiskParamsProposal(ctx, k, c)
 		case *types.BatchSetCollateralRiskParamsProposal:
 			return keeper.HandleBatchSetCollateralRiskParamsProposal(ctx, k, c)
+		case *types.CoverBadDebtProposal:
+			return keeper.HandleCoverBadDebtProposal(ctx, k, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
 		}
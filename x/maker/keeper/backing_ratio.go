@@ -2,41 +2,27 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	blackfury "github.com/elysiumstation/blackfury/types"
 	"github.com/elysiumstation/blackfury/x/maker/types"
 )
 
-// AdjustBackingRatio dynamically adjusts the backing ratio, according to black price change.
+// AdjustBackingRatio dynamically adjusts the backing ratio, by the backing ratio controller selected by params.
 func (k Keeper) AdjustBackingRatio(ctx sdk.Context) {
+	controller := k.GetBackingRatioController(ctx)
+	controller.Observe(ctx, k)
+
 	// check cooldown period since last update
 	if ctx.BlockHeight()-k.GetBackingRatioLastBlock(ctx) < k.BackingRatioCooldownPeriod(ctx) {
 		return
 	}
 
-	ratioStep := k.BackingRatioStep(ctx)
-	if ratioStep.IsZero() {
-		return
-	}
-	backingRatio := k.GetBackingRatio(ctx)
-	priceBand := blackfury.MicroFUSDTarget.Mul(k.BackingRatioPriceBand(ctx))
-
-	blackPrice, err := k.oracleKeeper.GetExchangeRate(ctx, blackfury.MicroFUSDDenom)
+	backingRatio, ok, err := controller.NextBackingRatio(ctx, k, k.GetBackingRatio(ctx))
 	if err != nil {
 		panic(err)
 	}
-
-	if blackPrice.GT(blackfury.MicroFUSDTarget.Add(priceBand)) {
-		// black price is too high
-		// decrease backing ratio; min 0%
-		backingRatio = sdk.MaxDec(backingRatio.Sub(ratioStep), sdk.ZeroDec())
-	} else if blackPrice.LT(blackfury.MicroFUSDTarget.Sub(priceBand)) {
-		// black price is too low
-		// increase backing ratio; max 100%
-		backingRatio = sdk.MinDec(backingRatio.Add(ratioStep), sdk.OneDec())
+	if !ok {
+		return
 	}
 
-	// TODO: consider adjusting BR based on total minted Black, even though Black price is within the band
-
 	k.SetBackingRatio(ctx, backingRatio)
 	k.SetBackingRatioLastBlock(ctx, ctx.BlockHeight())
 }
@@ -0,0 +1,266 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	blackfury "github.com/elysiumstation/blackfury/types"
+	"github.com/elysiumstation/blackfury/x/maker/types"
+)
+
+// BackingRatioController decides how the backing ratio is adjusted.
+type BackingRatioController interface {
+	// Observe is called at every block, before the backing ratio may be adjusted.
+	Observe(ctx sdk.Context, k Keeper)
+	// NextBackingRatio returns the backing ratio adjusted from the current one,
+	// or false if the backing ratio should not be adjusted.
+	NextBackingRatio(ctx sdk.Context, k Keeper, backingRatio sdk.Dec) (sdk.Dec, bool, error)
+}
+
+var backingRatioControllers = map[string]BackingRatioController{
+	types.BackingRatioControllerStep: StepController{},
+	types.BackingRatioControllerPID:  PIDController{},
+}
+
+// GetBackingRatioController returns the backing ratio controller selected by params.
+func (k Keeper) GetBackingRatioController(ctx sdk.Context) BackingRatioController {
+	name := k.BackingRatioController(ctx)
+	controller, ok := backingRatioControllers[name]
+	if !ok {
+		panic(fmt.Sprintf("unknown backing ratio controller: %s", name))
+	}
+	return controller
+}
+
+// StepController steps the backing ratio by a fixed step, whenever Black price is out of the price band.
+type StepController struct{}
+
+var _ BackingRatioController = StepController{}
+
+func (StepController) Observe(sdk.Context, Keeper) {}
+
+func (StepController) NextBackingRatio(ctx sdk.Context, k Keeper, backingRatio sdk.Dec) (sdk.Dec, bool, error) {
+	ratioStep := k.BackingRatioStep(ctx)
+	if ratioStep.IsZero() {
+		return backingRatio, false, nil
+	}
+	priceBand := blackfury.MicroFUSDTarget.Mul(k.BackingRatioPriceBand(ctx))
+
+	blackPrice, err := k.oracleKeeper.GetExchangeRate(ctx, blackfury.MicroFUSDDenom)
+	if err != nil {
+		return backingRatio, false, err
+	}
+
+	if blackPrice.GT(blackfury.MicroFUSDTarget.Add(priceBand)) {
+		// black price is too high
+		// decrease backing ratio; min 0%
+		backingRatio = sdk.MaxDec(backingRatio.Sub(ratioStep), sdk.ZeroDec())
+	} else if blackPrice.LT(blackfury.MicroFUSDTarget.Sub(priceBand)) {
+		// black price is too low
+		// increase backing ratio; max 100%
+		backingRatio = sdk.MinDec(backingRatio.Add(ratioStep), sdk.OneDec())
+	}
+
+	return backingRatio, true, nil
+}
+
+// PIDController drives the backing ratio by the deviation of Black TWAP from the target price,
+// and by the growth of Black supply weighted by the share of supply issued by backing.
+type PIDController struct{}
+
+var _ BackingRatioController = PIDController{}
+
+// Observe records Black price for computing its TWAP.
+func (PIDController) Observe(ctx sdk.Context, k Keeper) {
+	blackPrice, err := k.oracleKeeper.GetExchangeRate(ctx, blackfury.MicroFUSDDenom)
+	if err != nil {
+		// no price to observe, e.g., the oracle has not voted yet
+		return
+	}
+
+	now := ctx.BlockTime().Unix()
+	cumulative := sdk.ZeroDec()
+	if last, found := k.GetLastPriceObservation(ctx); found && last.Time <= now {
+		cumulative = last.PriceCumulative.Add(last.Price.MulInt64(now - last.Time))
+	}
+
+	k.SetPriceObservation(ctx, types.PriceObservation{
+		Time:            now,
+		Price:           blackPrice,
+		PriceCumulative: cumulative,
+	})
+	k.prunePriceObservations(ctx, now-k.PIDControllerParams(ctx).TwapWindow)
+}
+
+func (PIDController) NextBackingRatio(ctx sdk.Context, k Keeper, backingRatio sdk.Dec) (sdk.Dec, bool, error) {
+	params := k.PIDControllerParams(ctx)
+
+	twap, err := k.GetBlackTWAP(ctx, params.TwapWindow)
+	if err != nil {
+		return backingRatio, false, err
+	}
+
+	// positive error means black price is too low, which calls for a higher backing ratio
+	priceError := blackfury.MicroFUSDTarget.Sub(twap).Quo(blackfury.MicroFUSDTarget)
+	if priceError.Abs().LTE(k.BackingRatioPriceBand(ctx)) {
+		priceError = sdk.ZeroDec()
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, blackfury.MicroFUSDDenom).Amount
+	state, found := k.GetPIDControllerState(ctx)
+	if !found {
+		state = types.PIDControllerState{
+			Integral:   sdk.ZeroDec(),
+			LastError:  sdk.ZeroDec(),
+			LastSupply: supply,
+		}
+	}
+
+	integral := clampDec(state.Integral.Add(priceError), params.IntegralLimit.Neg(), params.IntegralLimit)
+	derivative := priceError.Sub(state.LastError)
+	supplyGrowth := sdk.ZeroDec()
+	if state.LastSupply.IsPositive() {
+		supplyGrowth = supply.Sub(state.LastSupply).ToDec().Quo(state.LastSupply.ToDec())
+	}
+
+	delta := params.Kp.Mul(priceError).
+		Add(params.Ki.Mul(integral)).
+		Add(params.Kd.Mul(derivative)).
+		Add(params.SupplyGrowthGain.Mul(supplyGrowth).Mul(k.backingShare(ctx)))
+	delta = clampDec(delta, params.MaxStep.Neg(), params.MaxStep)
+
+	k.SetPIDControllerState(ctx, types.PIDControllerState{
+		Integral:   integral,
+		LastError:  priceError,
+		LastSupply: supply,
+	})
+
+	return clampDec(backingRatio.Add(delta), sdk.ZeroDec(), sdk.OneDec()), true, nil
+}
+
+// GetBlackTWAP returns the time-weighted average Black price over the window up to the current block time.
+// If the observations do not cover the window, the average is over the observed period.
+func (k Keeper) GetBlackTWAP(ctx sdk.Context, window int64) (sdk.Dec, error) {
+	observations := k.GetAllPriceObservations(ctx)
+	if len(observations) == 0 {
+		return k.oracleKeeper.GetExchangeRate(ctx, blackfury.MicroFUSDDenom)
+	}
+
+	now := ctx.BlockTime().Unix()
+	last := observations[len(observations)-1]
+	cumulativeNow := last.PriceCumulative.Add(last.Price.MulInt64(now - last.Time))
+
+	// the latest observation at or before the start of the window
+	start := now - window
+	first := observations[0]
+	for _, obs := range observations {
+		if obs.Time > start {
+			break
+		}
+		first = obs
+	}
+	if first.Time < start {
+		first.PriceCumulative = first.PriceCumulative.Add(first.Price.MulInt64(start - first.Time))
+		first.Time = start
+	}
+
+	if now <= first.Time {
+		return last.Price, nil
+	}
+	return cumulativeNow.Sub(first.PriceCumulative).QuoInt64(now - first.Time), nil
+}
+
+// backingShare returns the share of Black supply issued by backing rather than by collateral.
+func (k Keeper) backingShare(ctx sdk.Context) sdk.Dec {
+	backed := sdk.ZeroInt()
+	if totalBacking, found := k.GetTotalBacking(ctx); found && totalBacking.BlackMinted.Amount.IsPositive() {
+		backed = totalBacking.BlackMinted.Amount
+	}
+	issued := backed
+	if totalColl, found := k.GetTotalCollateral(ctx); found {
+		issued = issued.Add(totalColl.BlackDebt.Amount)
+	}
+	if !issued.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	return backed.ToDec().Quo(issued.ToDec())
+}
+
+func (k Keeper) SetPriceObservation(ctx sdk.Context, obs types.PriceObservation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPriceObservation)
+	bz := k.cdc.MustMarshal(&obs)
+	store.Set(sdk.Uint64ToBigEndian(uint64(obs.Time)), bz)
+}
+
+func (k Keeper) GetLastPriceObservation(ctx sdk.Context) (types.PriceObservation, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPriceObservation)
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	var obs types.PriceObservation
+	if !iterator.Valid() {
+		return obs, false
+	}
+	k.cdc.MustUnmarshal(iterator.Value(), &obs)
+	return obs, true
+}
+
+func (k Keeper) GetAllPriceObservations(ctx sdk.Context) []types.PriceObservation {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixPriceObservation)
+	defer iterator.Close()
+
+	var observations []types.PriceObservation
+	for ; iterator.Valid(); iterator.Next() {
+		var obs types.PriceObservation
+		k.cdc.MustUnmarshal(iterator.Value(), &obs)
+
+		observations = append(observations, obs)
+	}
+
+	return observations
+}
+
+// prunePriceObservations deletes the observations before the time,
+// except the latest one of them, which is still needed for the TWAP starting at the time.
+func (k Keeper) prunePriceObservations(ctx sdk.Context, before int64) {
+	if before <= 0 {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPriceObservation)
+	iterator := store.ReverseIterator(nil, sdk.Uint64ToBigEndian(uint64(before)))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	if len(keys) > 0 {
+		keys = keys[1:]
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) SetPIDControllerState(ctx sdk.Context, state types.PIDControllerState) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&state)
+	store.Set(types.KeyPrefixPIDControllerState, bz)
+}
+
+func (k Keeper) GetPIDControllerState(ctx sdk.Context) (types.PIDControllerState, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixPIDControllerState)
+	var state types.PIDControllerState
+	if len(bz) == 0 {
+		return state, false
+	}
+	k.cdc.MustUnmarshal(bz, &state)
+	return state, true
+}
+
+func clampDec(d, min, max sdk.Dec) sdk.Dec {
+	return sdk.MinDec(sdk.MaxDec(d, min), max)
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	blackfury "github.com/elysiumstation/blackfury/types"
+	"github.com/elysiumstation/blackfury/x/maker/types"
+)
+
+// simulateBackingRatio runs the backing ratio controller over blocks of 5 seconds, in a market where
+// black price is given by the block height and the backing ratio, and returns the backing ratio of every adjustment.
+func (suite *KeeperTestSuite) simulateBackingRatio(controller string, backingRatio sdk.Dec, blocks int, market func(height int64, backingRatio sdk.Dec) sdk.Dec) []sdk.Dec {
+	suite.SetupTest()
+
+	params := suite.app.MakerKeeper.GetParams(suite.ctx)
+	params.BackingRatioController = controller
+	params.BackingRatioCooldownPeriod = 10
+	params.PidControllerParams.TwapWindow = 50
+	suite.app.MakerKeeper.SetParams(suite.ctx, params)
+	suite.app.MakerKeeper.SetBackingRatio(suite.ctx, backingRatio)
+
+	var ratios []sdk.Dec
+	for i := 0; i < blocks; i++ {
+		suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1).WithBlockTime(suite.ctx.BlockTime().Add(5 * time.Second))
+		price := market(suite.ctx.BlockHeight(), suite.app.MakerKeeper.GetBackingRatio(suite.ctx))
+		suite.app.OracleKeeper.SetExchangeRate(suite.ctx, blackfury.MicroFUSDDenom, price)
+
+		suite.app.MakerKeeper.AdjustBackingRatio(suite.ctx)
+		if suite.app.MakerKeeper.GetBackingRatioLastBlock(suite.ctx) == suite.ctx.BlockHeight() {
+			ratios = append(ratios, suite.app.MakerKeeper.GetBackingRatio(suite.ctx))
+		}
+	}
+	return ratios
+}
+
+func (suite *KeeperTestSuite) TestSimulateBackingRatioDepeg() {
+	// black is traded below the target price, until backing ratio recovers to 90%
+	market := func(_ int64, backingRatio sdk.Dec) sdk.Dec {
+		return sdk.OneDec().Add(backingRatio.Sub(sdk.NewDecWithPrec(9, 1)).QuoInt64(2))
+	}
+	recovered := func(ratios []sdk.Dec) int {
+		for i, ratio := range ratios {
+			if market(0, ratio).Sub(sdk.OneDec()).Abs().LTE(types.DefaultBackingRatioPriceBand) {
+				return i + 1
+			}
+		}
+		return len(ratios) + 1
+	}
+
+	stepRatios := suite.simulateBackingRatio(types.BackingRatioControllerStep, sdk.NewDecWithPrec(7, 1), 1000, market)
+	pidRatios := suite.simulateBackingRatio(types.BackingRatioControllerPID, sdk.NewDecWithPrec(7, 1), 1000, market)
+	suite.Require().Len(stepRatios, 100)
+	suite.Require().Len(pidRatios, 100)
+
+	// the pid controller recovers the peg several times faster
+	stepRecovered, pidRecovered := recovered(stepRatios), recovered(pidRatios)
+	suite.Require().Equal(76, stepRecovered)
+	suite.Require().Less(pidRecovered*3, stepRecovered)
+
+	// and both settle within the price band
+	for _, ratios := range [][]sdk.Dec{stepRatios, pidRatios} {
+		final := ratios[len(ratios)-1]
+		suite.Require().True(market(0, final).Sub(sdk.OneDec()).Abs().LTE(types.DefaultBackingRatioPriceBand), final.String())
+	}
+}
+
+func (suite *KeeperTestSuite) TestSimulateBackingRatioPriceSpikes() {
+	// black is traded at the target price, except for short drops at the blocks adjusting backing ratio
+	market := func(height int64, _ sdk.Dec) sdk.Dec {
+		if height%10 == 0 {
+			return sdk.NewDecWithPrec(97, 2)
+		}
+		return sdk.OneDec()
+	}
+
+	stepRatios := suite.simulateBackingRatio(types.BackingRatioControllerStep, sdk.NewDecWithPrec(7, 1), 1000, market)
+	pidRatios := suite.simulateBackingRatio(types.BackingRatioControllerPID, sdk.NewDecWithPrec(7, 1), 1000, market)
+
+	// the step controller follows every spike, while the twap smooths them out
+	suite.Require().Equal(sdk.NewDecWithPrec(95, 2), stepRatios[len(stepRatios)-1])
+	for _, ratio := range pidRatios {
+		suite.Require().Equal(sdk.NewDecWithPrec(7, 1), ratio)
+	}
+}
+
+func (suite *KeeperTestSuite) TestSimulateBackingRatioSupplyGrowth() {
+	suite.SetupTest()
+	suite.setupProposerValidator()
+
+	// black in the target price, but its supply issued by backing grows by 10% between adjustments
+	params := suite.app.MakerKeeper.GetParams(suite.ctx)
+	params.BackingRatioController = types.BackingRatioControllerPID
+	suite.app.MakerKeeper.SetParams(suite.ctx, params)
+	suite.app.MakerKeeper.SetBackingRatio(suite.ctx, sdk.NewDecWithPrec(7, 1))
+	suite.app.MakerKeeper.SetTotalBacking(suite.ctx, types.TotalBacking{
+		BackingValue: sdk.ZeroInt(),
+		BlackMinted:  sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.NewInt(1_000000)),
+		FuryBurned:   sdk.NewCoin(blackfury.AttoFuryDenom, sdk.ZeroInt()),
+	})
+	suite.app.OracleKeeper.SetExchangeRate(suite.ctx, blackfury.MicroFUSDDenom, sdk.OneDec())
+
+	controller := suite.app.MakerKeeper.GetBackingRatioController(suite.ctx)
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.NewInt(1_000000)))))
+	backingRatio, ok, err := controller.NextBackingRatio(suite.ctx, suite.app.MakerKeeper, sdk.NewDecWithPrec(7, 1))
+	suite.Require().NoError(err)
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.NewDecWithPrec(7, 1), backingRatio)
+
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.NewInt(100_000)))))
+	backingRatio, ok, err = controller.NextBackingRatio(suite.ctx, suite.app.MakerKeeper, backingRatio)
+	suite.Require().NoError(err)
+	suite.Require().True(ok)
+	// 0.05 * 10% growth * 100% backing share
+	suite.Require().Equal(sdk.NewDecWithPrec(705, 3), backingRatio)
+}
@@ -573,22 +573,26 @@ func (k Keeper) calculateMintByCollateral(
 		return
 	}
 
+	// accrue pool interest
+	err = k.accrueInterest(ctx, collateralDenom, *collateralParams.InterestFee)
+	if err != nil {
+		return
+	}
+
 	totalColl, poolColl, accColl, err = k.getCollateral(ctx, account, collateralDenom)
 	if err != nil {
 		return
 	}
 
 	// settle interest fee
-	settleInterestFee(ctx, &accColl, &poolColl, &totalColl, *collateralParams.InterestFee)
+	settleInterestFee(ctx, &accColl, &poolColl)
 
 	// compute mint total
 	mintFee = computeFee(mintOut, collateralParams.MintFee)
 	mintTotal := mintOut.Add(mintFee)
 
 	// update black debt
-	accColl.BlackDebt = accColl.BlackDebt.Add(mintTotal)
-	poolColl.BlackDebt = poolColl.BlackDebt.Add(mintTotal)
-	totalColl.BlackDebt = totalColl.BlackDebt.Add(mintTotal)
+	increaseDebt(&accColl, &poolColl, &totalColl, mintTotal)
 
 	if collateralParams.MaxBlackMint != nil && poolColl.BlackDebt.Amount.GT(*collateralParams.MaxBlackMint) {
 		err = sdkerrors.Wrapf(types.ErrBlackCeiling, "")
@@ -611,7 +615,20 @@ func (k Keeper) calculateMintByCollateral(
 	}
 	availableDebtMax := collateralValue.Mul(availableLTV).Quo(blackfury.MicroFUSDTarget).TruncateInt()
 
-	if availableDebtMax.LT(accColl.BlackDebt.Amount) {
+	if k.IsCrossMargin(ctx, account) {
+		// other positions of the account support this one
+		var others accountHealth
+		others, err = k.getAccountHealth(ctx, account, collateralDenom)
+		if err != nil {
+			return
+		}
+		maxDebtValue := collateralValue.Mul(availableLTV).Add(others.maxDebtValue)
+		debtValue := accColl.BlackDebt.Amount.ToDec().Mul(blackfury.MicroFUSDTarget).Add(others.debtValue)
+		if maxDebtValue.LT(debtValue) {
+			err = sdkerrors.Wrapf(types.ErrAccountInsufficientCollateral, "")
+			return
+		}
+	} else if availableDebtMax.LT(accColl.BlackDebt.Amount) {
 		err = sdkerrors.Wrapf(types.ErrAccountInsufficientCollateral, "")
 		return
 	}
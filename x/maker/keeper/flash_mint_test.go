@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	blackfury "github.com/elysiumstation/blackfury/types"
+	"github.com/elysiumstation/blackfury/x/maker/keeper"
+	"github.com/elysiumstation/blackfury/x/maker/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestFlashMint() {
+	amount := sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.NewInt(10_000))
+	other := sdk.AccAddress(suite.consAddress)
+
+	testCases := []struct {
+		name   string
+		amount sdk.Coin
+		msgs   func() []sdk.Msg
+		expErr error
+	}{
+		{
+			name:   "repaid with fee",
+			amount: amount,
+			msgs: func() []sdk.Msg {
+				return []sdk.Msg{banktypes.NewMsgSend(suite.accAddress, suite.accAddress, sdk.NewCoins(amount))}
+			},
+		},
+		{
+			name:   "not repaid",
+			amount: amount,
+			msgs: func() []sdk.Msg {
+				return []sdk.Msg{banktypes.NewMsgSend(suite.accAddress, other, sdk.NewCoins(amount))}
+			},
+			expErr: sdkerrors.ErrInsufficientFunds,
+		},
+		{
+			name:   "over ceiling",
+			amount: sdk.NewCoin(blackfury.MicroFUSDDenom, types.DefaultFlashMintCeiling.AddRaw(1)),
+			msgs: func() []sdk.Msg {
+				return []sdk.Msg{banktypes.NewMsgSend(suite.accAddress, suite.accAddress, sdk.NewCoins(amount))}
+			},
+			expErr: types.ErrFlashMintCeiling,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.setupProposerValidator()
+
+			// fund the fee
+			fee := sdk.NewCoins(sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.NewInt(9)))
+			suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, fee))
+			suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, suite.accAddress, fee))
+			supply := suite.app.BankKeeper.GetSupply(suite.ctx, blackfury.MicroFUSDDenom)
+
+			msg, err := types.NewMsgFlashMint(suite.accAddress, tc.amount, tc.msgs())
+			suite.Require().NoError(err)
+			suite.Require().NoError(msg.ValidateBasic())
+
+			ctx, _ := suite.ctx.CacheContext()
+			msgServer := keeper.NewMsgServerImpl(suite.app.MakerKeeper)
+			res, err := msgServer.FlashMint(sdk.WrapSDKContext(ctx), msg)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Len(res.Results, 1)
+			// 0.09% fee, rounded up
+			suite.Require().Equal(sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.NewInt(9)), res.Fee)
+
+			// minted black is burned, and fee is collected as surplus
+			suite.Require().True(suite.app.BankKeeper.GetBalance(ctx, suite.accAddress, blackfury.MicroFUSDDenom).IsZero())
+			suite.Require().Equal(supply, suite.app.BankKeeper.GetSupply(ctx, blackfury.MicroFUSDDenom))
+			ledger, found := suite.app.MakerKeeper.GetTreasuryLedger(ctx, types.AssetClassBlack, blackfury.MicroFUSDDenom)
+			suite.Require().True(found)
+			suite.Require().Equal(sdk.NewInt(9), ledger.TotalSurplus.AmountOf(blackfury.MicroFUSDDenom))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgFlashMintValidateBasic() {
+	suite.SetupTest()
+	amount := sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.NewInt(10_000))
+	other := sdk.AccAddress(suite.consAddress)
+
+	nested, err := types.NewMsgFlashMint(suite.accAddress, amount, []sdk.Msg{banktypes.NewMsgSend(suite.accAddress, suite.accAddress, sdk.NewCoins(amount))})
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name   string
+		amount sdk.Coin
+		msgs   []sdk.Msg
+		expErr error
+	}{
+		{"no nested messages", amount, nil, types.ErrInvalidFlashMintMsg},
+		{"invalid denom", sdk.NewCoin(blackfury.AttoFuryDenom, sdk.NewInt(1)), []sdk.Msg{banktypes.NewMsgSend(suite.accAddress, other, sdk.NewCoins(amount))}, sdkerrors.ErrInvalidCoins},
+		{"signed by others", amount, []sdk.Msg{banktypes.NewMsgSend(other, suite.accAddress, sdk.NewCoins(amount))}, types.ErrInvalidFlashMintMsg},
+		{"nested flash mint", amount, []sdk.Msg{nested}, types.ErrInvalidFlashMintMsg},
+		{"nested ethereum tx", amount, []sdk.Msg{&evmtypes.MsgEthereumTx{}}, types.ErrInvalidFlashMintMsg},
+		{"nested authz exec", amount, []sdk.Msg{&authz.MsgExec{Grantee: suite.accAddress.String()}}, types.ErrInvalidFlashMintMsg},
+		{"malformed", amount, []sdk.Msg{&banktypes.MsgSend{FromAddress: "invalid", ToAddress: other.String(), Amount: sdk.NewCoins(amount)}}, types.ErrInvalidFlashMintMsg},
+		{"not allowed", amount, []sdk.Msg{stakingtypes.NewMsgDelegate(suite.accAddress, sdk.ValAddress(suite.accAddress), amount)}, types.ErrInvalidFlashMintMsg},
+	}
+
+	for _, tc := range testCases {
+		msg, err := types.NewMsgFlashMint(suite.accAddress, tc.amount, tc.msgs)
+		suite.Require().NoError(err)
+		suite.Require().ErrorIs(msg.ValidateBasic(), tc.expErr, tc.name)
+	}
+}
+
+func (suite *KeeperTestSuite) TestFlashMintNotAllowedMsg() {
+	suite.SetupTest()
+	amount := sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.NewInt(10_000))
+
+	// nested messages are checked on execution as well, without relying on the basic validation
+	msg, err := types.NewMsgFlashMint(suite.accAddress, amount, []sdk.Msg{
+		stakingtypes.NewMsgDelegate(suite.accAddress, sdk.ValAddress(suite.accAddress), amount),
+	})
+	suite.Require().NoError(err)
+
+	ctx, _ := suite.ctx.CacheContext()
+	msgServer := keeper.NewMsgServerImpl(suite.app.MakerKeeper)
+	_, err = msgServer.FlashMint(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidFlashMintMsg)
+}
@@ -93,6 +93,12 @@ func (k Keeper) CollateralOfAccount(c context.Context, req *types.QueryCollatera
 			LastInterest:        sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.ZeroInt()),
 			LastSettlementBlock: ctx.BlockHeight(),
 		}
+	} else if params, found := k.GetCollateralRiskParams(ctx, req.CollateralDenom); found {
+		if pool, found := k.GetPoolCollateral(ctx, req.CollateralDenom); found {
+			// project interest accrued up to the current block time, without persisting
+			accruePoolInterest(&pool, *params.InterestFee, ctx.BlockTime())
+			settleInterestFee(ctx, &collateral, &pool)
+		}
 	}
 
 	return &types.QueryCollateralOfAccountResponse{
@@ -100,6 +106,33 @@ func (k Keeper) CollateralOfAccount(c context.Context, req *types.QueryCollatera
 	}, nil
 }
 
+func (k Keeper) AccountPositions(c context.Context, req *types.QueryAccountPositionsRequest) (*types.QueryAccountPositionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	health, err := k.getAccountHealth(ctx, account, "")
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryAccountPositionsResponse{
+		Positions:            health.positions,
+		TotalCollateralValue: health.collateralValue,
+		TotalDebtValue:       health.debtValue,
+		CrossMargin:          k.IsCrossMargin(ctx, account),
+	}
+	if health.debtValue.IsPositive() {
+		healthFactor := health.liquidationValue.Quo(health.debtValue)
+		res.HealthFactor = &healthFactor
+	}
+
+	return res, nil
+}
+
 func (k Keeper) TotalBacking(c context.Context, req *types.QueryTotalBackingRequest) (*types.QueryTotalBackingResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -135,6 +168,30 @@ func (k Keeper) BackingRatio(c context.Context, req *types.QueryBackingRatioRequ
 	}, nil
 }
 
+func (k Keeper) AllTreasuryLedgers(c context.Context, req *types.QueryAllTreasuryLedgersRequest) (*types.QueryAllTreasuryLedgersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryAllTreasuryLedgersResponse{
+		Ledgers: k.GetAllTreasuryLedgers(ctx),
+	}, nil
+}
+
+func (k Keeper) TreasuryLedger(c context.Context, req *types.QueryTreasuryLedgerRequest) (*types.QueryTreasuryLedgerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, err := types.TreasuryLedgerKeyPrefix(req.AssetClass); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ledger, found := k.GetTreasuryLedger(ctx, req.AssetClass, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "treasury ledger with asset class '%s' and denom '%s'", req.AssetClass, req.Denom)
+	}
+
+	return &types.QueryTreasuryLedgerResponse{
+		Ledger: ledger,
+	}, nil
+}
+
 func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	blackfury "github.com/elysiumstation/blackfury/types"
+	"github.com/elysiumstation/blackfury/x/maker/types"
+)
+
+// AccrueAllInterest accrues interest of all the collateral pools up to the current block time.
+func (k Keeper) AccrueAllInterest(ctx sdk.Context) {
+	for _, params := range k.GetAllCollateralRiskParams(ctx) {
+		if err := k.accrueInterest(ctx, params.CollateralDenom, *params.InterestFee); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// accrueInterest brings the interest index of the collateral pool up to the current block time,
+// adds the accrued interest to the pool and total debt, and mints it as surplus.
+func (k Keeper) accrueInterest(ctx sdk.Context, denom string, apr sdk.Dec) error {
+	total, found := k.GetTotalCollateral(ctx)
+	if !found {
+		return nil
+	}
+	pool, found := k.GetPoolCollateral(ctx, denom)
+	if !found {
+		return nil
+	}
+
+	interest := accruePoolInterest(&pool, apr, ctx.BlockTime())
+	total.BlackDebt = total.BlackDebt.AddAmount(interest)
+
+	k.SetPoolCollateral(ctx, pool)
+	k.SetTotalCollateral(ctx, total)
+
+	if !interest.IsPositive() {
+		return nil
+	}
+
+	interestCoin := sdk.NewCoin(blackfury.MicroFUSDDenom, interest)
+	err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(interestCoin))
+	if err != nil {
+		return err
+	}
+	err = k.collectSurplus(ctx, types.AssetClassCollateral, denom, sdk.NewCoins(interestCoin))
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeAccrueInterest,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyInterestIndex, pool.InterestIndex.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, interestCoin.String()),
+		),
+	)
+	return nil
+}
+
+// sendToSurplusDestination sends coins from the maker module account to the surplus destination,
+// which may be either a module account name or an account address.
+func (k Keeper) sendToSurplusDestination(ctx sdk.Context, coins sdk.Coins) error {
+	dest := k.SurplusDestination(ctx)
+	if dest == types.ModuleName {
+		return nil
+	}
+	if addr, err := sdk.AccAddressFromBech32(dest); err == nil {
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins)
+	}
+	if k.accountKeeper.GetModuleAddress(dest) == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", dest)
+	}
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, dest, coins)
+}
+
+// initPoolInterest fills in the interest fields of a pool which was created without them.
+func initPoolInterest(pool *types.PoolCollateral, now time.Time) {
+	if pool.InterestIndex == nil || !pool.InterestIndex.IsPositive() {
+		index := sdk.OneDec()
+		pool.InterestIndex = &index
+	}
+	if pool.NormalizedDebt == nil {
+		normalized := pool.BlackDebt.Amount.ToDec().Quo(*pool.InterestIndex)
+		pool.NormalizedDebt = &normalized
+	}
+	if pool.LastAccrualTime == 0 {
+		pool.LastAccrualTime = now.Unix()
+	}
+}
+
+// accruePoolInterest compounds the interest index of the pool per second since the last accrual,
+// i.e., index = index * (1 + apr / secondsPerYear) ^ elapsedSeconds, and returns the interest
+// by which the pool debt has increased.
+func accruePoolInterest(pool *types.PoolCollateral, apr sdk.Dec, now time.Time) sdk.Int {
+	initPoolInterest(pool, now)
+
+	elapsed := now.Unix() - pool.LastAccrualTime
+	if elapsed <= 0 {
+		// short circuit
+		return sdk.ZeroInt()
+	}
+	pool.LastAccrualTime = now.Unix()
+	if !apr.IsPositive() {
+		return sdk.ZeroInt()
+	}
+
+	ratePerSecond := sdk.OneDec().Add(apr.QuoInt64(blackfury.SecondsPerYear))
+	index := pool.InterestIndex.Mul(ratePerSecond.Power(uint64(elapsed)))
+
+	debtBefore := pool.NormalizedDebt.Mul(*pool.InterestIndex).RoundInt()
+	debtAfter := pool.NormalizedDebt.Mul(index).RoundInt()
+	interest := debtAfter.Sub(debtBefore)
+
+	pool.InterestIndex = &index
+	pool.BlackDebt = pool.BlackDebt.AddAmount(interest)
+	return interest
+}
+
+// settleInterestFee brings the account debt up to the interest index of the pool,
+// which must have been accrued to the current block time.
+func settleInterestFee(ctx sdk.Context, acc *types.AccountCollateral, pool *types.PoolCollateral) {
+	initPoolInterest(pool, ctx.BlockTime())
+	if acc.NormalizedDebt == nil {
+		normalized := acc.BlackDebt.Amount.ToDec().Quo(*pool.InterestIndex)
+		acc.NormalizedDebt = &normalized
+	}
+
+	debt := acc.NormalizedDebt.Mul(*pool.InterestIndex).RoundInt()
+	if debt.GT(acc.BlackDebt.Amount) {
+		interest := debt.Sub(acc.BlackDebt.Amount)
+		// update remaining interest accumulation
+		acc.LastInterest = acc.LastInterest.AddAmount(interest)
+		acc.BlackDebt = acc.BlackDebt.AddAmount(interest)
+	}
+	// update settlement block
+	acc.LastSettlementBlock = ctx.BlockHeight()
+}
+
+// increaseDebt adds newly minted black to the account, pool and total debt.
+func increaseDebt(acc *types.AccountCollateral, pool *types.PoolCollateral, total *types.TotalCollateral, amount sdk.Coin) {
+	normalized := amount.Amount.ToDec().Quo(*pool.InterestIndex)
+	accNormalized := acc.NormalizedDebt.Add(normalized)
+	poolNormalized := pool.NormalizedDebt.Add(normalized)
+	acc.NormalizedDebt = &accNormalized
+	pool.NormalizedDebt = &poolNormalized
+
+	acc.BlackDebt = acc.BlackDebt.Add(amount)
+	pool.BlackDebt = pool.BlackDebt.Add(amount)
+	total.BlackDebt = total.BlackDebt.Add(amount)
+}
+
+// decreaseDebt removes repaid black from the account, pool and total debt.
+// The account debt must have been settled and must not be less than the repaid amount.
+func decreaseDebt(acc *types.AccountCollateral, pool *types.PoolCollateral, total *types.TotalCollateral, amount sdk.Coin) {
+	normalized := *acc.NormalizedDebt
+	if amount.IsLT(acc.BlackDebt) {
+		normalized = sdk.MinDec(amount.Amount.ToDec().Quo(*pool.InterestIndex), normalized)
+	}
+	accNormalized := acc.NormalizedDebt.Sub(normalized)
+	poolNormalized := sdk.MaxDec(pool.NormalizedDebt.Sub(normalized), sdk.ZeroDec())
+	acc.NormalizedDebt = &accNormalized
+	pool.NormalizedDebt = &poolNormalized
+
+	acc.BlackDebt = acc.BlackDebt.Sub(amount)
+	// pool and total debt are accrued on the normalized debt as a whole, so they may fall
+	// below the sum of the individually rounded account debts by a few units
+	pool.BlackDebt.Amount = sdk.MaxInt(pool.BlackDebt.Amount.Sub(amount.Amount), sdk.ZeroInt())
+	total.BlackDebt.Amount = sdk.MaxInt(total.BlackDebt.Amount.Sub(amount.Amount), sdk.ZeroInt())
+}
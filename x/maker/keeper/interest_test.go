@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	blackfury "github.com/elysiumstation/blackfury/types"
+	"github.com/elysiumstation/blackfury/x/maker/types"
+)
+
+func (suite *KeeperTestSuite) setupInterestTest(apr sdk.Dec) {
+	suite.setupProposerValidator()
+
+	crp, _ := suite.dummyCollateralRiskParams()
+	crp.InterestFee = &apr
+	suite.app.MakerKeeper.SetCollateralRiskParams(suite.ctx, crp)
+
+	index := sdk.OneDec()
+	normalized := sdk.NewDec(1_000000)
+	suite.app.MakerKeeper.SetPoolCollateral(suite.ctx, types.PoolCollateral{
+		Collateral:         sdk.NewCoin(suite.bcDenom, sdk.NewInt(2_000000)),
+		BlackDebt:          sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.NewInt(1_000000)),
+		FuryCollateralized: sdk.NewCoin(blackfury.AttoFuryDenom, sdk.ZeroInt()),
+		InterestIndex:      &index,
+		LastAccrualTime:    suite.ctx.BlockTime().Unix(),
+		NormalizedDebt:     &normalized,
+	})
+	suite.app.MakerKeeper.SetTotalCollateral(suite.ctx, types.TotalCollateral{
+		BlackDebt:          sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.NewInt(1_000000)),
+		FuryCollateralized: sdk.NewCoin(blackfury.AttoFuryDenom, sdk.ZeroInt()),
+	})
+	suite.app.MakerKeeper.SetAccountCollateral(suite.ctx, suite.accAddress, types.AccountCollateral{
+		Account:             suite.accAddress.String(),
+		Collateral:          sdk.NewCoin(suite.bcDenom, sdk.NewInt(2_000000)),
+		BlackDebt:           sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.NewInt(1_000000)),
+		FuryCollateralized:  sdk.NewCoin(blackfury.AttoFuryDenom, sdk.ZeroInt()),
+		LastInterest:        sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.ZeroInt()),
+		LastSettlementBlock: suite.ctx.BlockHeight(),
+		NormalizedDebt:      &normalized,
+	})
+}
+
+func (suite *KeeperTestSuite) TestAccrueAllInterest() {
+	suite.SetupTest()
+	suite.setupInterestTest(sdk.NewDecWithPrec(10, 2))
+
+	// no time elapsed
+	suite.app.MakerKeeper.AccrueAllInterest(suite.ctx)
+	pool, found := suite.app.MakerKeeper.GetPoolCollateral(suite.ctx, suite.bcDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(1_000000), pool.BlackDebt.Amount)
+	suite.Require().Equal(sdk.OneDec(), *pool.InterestIndex)
+
+	// one year elapsed, compounded per second, i.e., about e^0.1
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(blackfury.SecondsPerYear * time.Second))
+	suite.app.MakerKeeper.AccrueAllInterest(suite.ctx)
+	pool, found = suite.app.MakerKeeper.GetPoolCollateral(suite.ctx, suite.bcDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(1_105171), pool.BlackDebt.Amount)
+	suite.Require().Equal(suite.ctx.BlockTime().Unix(), pool.LastAccrualTime)
+	suite.Require().True(pool.InterestIndex.Sub(sdk.MustNewDecFromStr("1.105170918")).Abs().LT(sdk.NewDecWithPrec(1, 6)))
+
+	total, found := suite.app.MakerKeeper.GetTotalCollateral(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(pool.BlackDebt, total.BlackDebt)
+
+	// interest is minted as surplus retained by the treasury
+	ledger, found := suite.app.MakerKeeper.GetTreasuryLedger(suite.ctx, types.AssetClassCollateral, suite.bcDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(105171), ledger.Surplus.AmountOf(blackfury.MicroFUSDDenom))
+	treasuryAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	surplus := suite.app.BankKeeper.GetBalance(suite.ctx, treasuryAddr, blackfury.MicroFUSDDenom)
+	suite.Require().Equal(sdk.NewInt(105171), surplus.Amount)
+
+	// account debt follows the pool index
+	res, err := suite.queryClient.CollateralOfAccount(suite.ctx.Context(), &types.QueryCollateralOfAccountRequest{
+		Account:         suite.accAddress.String(),
+		CollateralDenom: suite.bcDenom,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(pool.BlackDebt, res.AccountCollateral.BlackDebt)
+	suite.Require().Equal(sdk.NewInt(105171), res.AccountCollateral.LastInterest.Amount)
+}
+
+func (suite *KeeperTestSuite) TestAccrueAllInterestSurplusDestination() {
+	suite.SetupTest()
+	suite.setupInterestTest(sdk.NewDecWithPrec(10, 2))
+
+	params := suite.app.MakerKeeper.GetParams(suite.ctx)
+	params.SurplusDestination = suite.accAddress.String()
+	params.SurplusBuffer = sdk.ZeroInt()
+	suite.app.MakerKeeper.SetParams(suite.ctx, params)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(blackfury.SecondsPerDay * time.Second))
+	suite.app.MakerKeeper.AccrueAllInterest(suite.ctx)
+
+	pool, found := suite.app.MakerKeeper.GetPoolCollateral(suite.ctx, suite.bcDenom)
+	suite.Require().True(found)
+	interest := pool.BlackDebt.Amount.Sub(sdk.NewInt(1_000000))
+	suite.Require().True(interest.IsPositive())
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.accAddress, blackfury.MicroFUSDDenom)
+	suite.Require().Equal(interest, balance.Amount)
+}
+
+func (suite *KeeperTestSuite) TestAccrueAllInterestBlockedSurplusDestination() {
+	suite.SetupTest()
+	suite.setupInterestTest(sdk.NewDecWithPrec(10, 2))
+
+	// a blocked module address cannot receive the surplus
+	params := suite.app.MakerKeeper.GetParams(suite.ctx)
+	params.SurplusDestination = authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+	params.SurplusBuffer = sdk.ZeroInt()
+	suite.app.MakerKeeper.SetParams(suite.ctx, params)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(blackfury.SecondsPerDay * time.Second))
+	suite.Require().NotPanics(func() { suite.app.MakerKeeper.AccrueAllInterest(suite.ctx) })
+
+	// the surplus is kept by the treasury
+	pool, found := suite.app.MakerKeeper.GetPoolCollateral(suite.ctx, suite.bcDenom)
+	suite.Require().True(found)
+	interest := pool.BlackDebt.Amount.Sub(sdk.NewInt(1_000000))
+	suite.Require().True(interest.IsPositive())
+	ledger, found := suite.app.MakerKeeper.GetTreasuryLedger(suite.ctx, types.AssetClassCollateral, suite.bcDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(interest, ledger.Surplus.AmountOf(blackfury.MicroFUSDDenom))
+}
+
+func (suite *KeeperTestSuite) TestAccrueAllInterestZeroRate() {
+	suite.SetupTest()
+	suite.setupInterestTest(sdk.ZeroDec())
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(blackfury.SecondsPerYear * time.Second))
+	suite.app.MakerKeeper.AccrueAllInterest(suite.ctx)
+
+	pool, found := suite.app.MakerKeeper.GetPoolCollateral(suite.ctx, suite.bcDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(1_000000), pool.BlackDebt.Amount)
+	suite.Require().Equal(sdk.OneDec(), *pool.InterestIndex)
+	suite.Require().Equal(suite.ctx.BlockTime().Unix(), pool.LastAccrualTime)
+}
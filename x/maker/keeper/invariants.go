@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	blackfury "github.com/elysiumstation/blackfury/types"
+	"github.com/elysiumstation/blackfury/x/maker/types"
+)
+
+// RegisterInvariants registers the maker module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "total-backing", TotalBackingInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "total-collateral", TotalCollateralInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "pool-collateral", PoolCollateralInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-balance", ModuleBalanceInvariant(k))
+}
+
+// AllInvariants runs all invariants of the maker module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			TotalBackingInvariant(k),
+			TotalCollateralInvariant(k),
+			PoolCollateralInvariant(k),
+			ModuleBalanceInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// TotalBackingInvariant checks that the total backing equals the sum of all backing pools
+func TotalBackingInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		blackMinted := sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.ZeroInt())
+		furyBurned := sdk.NewCoin(blackfury.AttoFuryDenom, sdk.ZeroInt())
+		pools := k.GetAllPoolBacking(ctx)
+		for _, pool := range pools {
+			blackMinted = blackMinted.Add(pool.BlackMinted)
+			furyBurned = furyBurned.Add(pool.FuryBurned)
+		}
+
+		total, found := k.GetTotalBacking(ctx)
+		if !found {
+			total.BlackMinted = sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.ZeroInt())
+			total.FuryBurned = sdk.NewCoin(blackfury.AttoFuryDenom, sdk.ZeroInt())
+		}
+
+		broken := !total.BlackMinted.IsEqual(blackMinted) || !total.FuryBurned.IsEqual(furyBurned)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "total-backing",
+			fmt.Sprintf("\ttotal backing black minted: %s, fury burned: %s\n\tsum of %d pools black minted: %s, fury burned: %s\n",
+				total.BlackMinted, total.FuryBurned, len(pools), blackMinted, furyBurned),
+		), broken
+	}
+}
+
+// TotalCollateralInvariant checks that the total collateral equals the sum of all collateral pools
+func TotalCollateralInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		blackDebt := sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.ZeroInt())
+		furyCollateralized := sdk.NewCoin(blackfury.AttoFuryDenom, sdk.ZeroInt())
+		pools := k.GetAllPoolCollateral(ctx)
+		for _, pool := range pools {
+			blackDebt = blackDebt.Add(pool.BlackDebt)
+			furyCollateralized = furyCollateralized.Add(pool.FuryCollateralized)
+		}
+
+		total, found := k.GetTotalCollateral(ctx)
+		if !found {
+			total.BlackDebt = sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.ZeroInt())
+			total.FuryCollateralized = sdk.NewCoin(blackfury.AttoFuryDenom, sdk.ZeroInt())
+		}
+
+		// pool debt is floored at zero when repaid, so the total debt may fall below the sum
+		// of pool debts by a few units
+		broken := !withinDust(total.BlackDebt.Amount, blackDebt.Amount, int64(len(pools))) ||
+			!total.FuryCollateralized.IsEqual(furyCollateralized)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "total-collateral",
+			fmt.Sprintf("\ttotal collateral black debt: %s, fury collateralized: %s\n\tsum of %d pools black debt: %s, fury collateralized: %s\n",
+				total.BlackDebt, total.FuryCollateralized, len(pools), blackDebt, furyCollateralized),
+		), broken
+	}
+}
+
+// PoolCollateralInvariant checks that every collateral pool equals the sum of its account collaterals
+func PoolCollateralInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		type poolSum struct {
+			index              sdk.Dec
+			accounts           int64
+			collateral         sdk.Int
+			blackDebt          sdk.Dec
+			furyCollateralized sdk.Int
+		}
+
+		pools := k.GetAllPoolCollateral(ctx)
+		sums := make(map[string]*poolSum, len(pools))
+		for _, pool := range pools {
+			index := sdk.OneDec()
+			if pool.InterestIndex != nil && pool.InterestIndex.IsPositive() {
+				index = *pool.InterestIndex
+			}
+			sums[pool.Collateral.Denom] = &poolSum{
+				index:              index,
+				collateral:         sdk.ZeroInt(),
+				blackDebt:          sdk.ZeroDec(),
+				furyCollateralized: sdk.ZeroInt(),
+			}
+		}
+
+		var (
+			msg   string
+			count int
+		)
+
+		k.IterateAccountCollaterals(ctx, func(acc types.AccountCollateral) bool {
+			sum, ok := sums[acc.Collateral.Denom]
+			if !ok {
+				count++
+				msg += fmt.Sprintf("\t%s has collateral %s without pool\n", acc.Account, acc.Collateral)
+				return false
+			}
+			sum.accounts++
+			sum.collateral = sum.collateral.Add(acc.Collateral.Amount)
+			sum.furyCollateralized = sum.furyCollateralized.Add(acc.FuryCollateralized.Amount)
+			// account debt is settled lazily, so take the interest accrued since then into account
+			if acc.NormalizedDebt != nil {
+				sum.blackDebt = sum.blackDebt.Add(acc.NormalizedDebt.Mul(sum.index))
+			} else {
+				sum.blackDebt = sum.blackDebt.Add(acc.BlackDebt.Amount.ToDec())
+			}
+			return false
+		})
+
+		for _, pool := range pools {
+			sum := sums[pool.Collateral.Denom]
+			blackDebt := sum.blackDebt.RoundInt()
+			// account debts are rounded individually, so they may differ by a unit per account
+			if !pool.Collateral.Amount.Equal(sum.collateral) ||
+				!pool.FuryCollateralized.Amount.Equal(sum.furyCollateralized) ||
+				!withinDust(pool.BlackDebt.Amount, blackDebt, sum.accounts) {
+				count++
+				msg += fmt.Sprintf("\tpool %s has collateral: %s, black debt: %s, fury collateralized: %s, "+
+					"but sum of %d accounts collateral: %s, black debt: %s, fury collateralized: %s\n",
+					pool.Collateral.Denom, pool.Collateral.Amount, pool.BlackDebt.Amount, pool.FuryCollateralized.Amount,
+					sum.accounts, sum.collateral, blackDebt, sum.furyCollateralized)
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "pool-collateral",
+			fmt.Sprintf("inconsistent collateral pools found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// ModuleBalanceInvariant checks that the maker module account holds at least the backing
+// and collateral recorded in all pools, and the surplus and fury reserve of the treasury
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := sdk.NewCoins()
+		for _, pool := range k.GetAllPoolBacking(ctx) {
+			expected = expected.Add(pool.Backing)
+		}
+		for _, pool := range k.GetAllPoolCollateral(ctx) {
+			expected = expected.Add(pool.Collateral).Add(pool.FuryCollateralized)
+		}
+		for _, ledger := range k.GetAllTreasuryLedgers(ctx) {
+			expected = expected.Add(ledger.Surplus...)
+			if ledger.FuryReserve.IsValid() {
+				expected = expected.Add(ledger.FuryReserve)
+			}
+		}
+
+		moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		balances := sdk.NewCoins()
+		for _, coin := range expected {
+			balances = balances.Add(k.bankKeeper.GetBalance(ctx, moduleAddr, coin.Denom))
+		}
+
+		broken := !balances.IsAllGTE(expected)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "module-balance",
+			fmt.Sprintf("\tmodule account balances: %s\n\trecorded backing, collateral and treasury: %s\n", balances, expected),
+		), broken
+	}
+}
+
+// withinDust returns whether the two amounts differ by no more than the dust amount
+func withinDust(a, b sdk.Int, dust int64) bool {
+	return a.Sub(b).Abs().LTE(sdk.NewInt(dust))
+}
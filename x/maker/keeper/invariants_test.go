@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	blackfury "github.com/elysiumstation/blackfury/types"
+	"github.com/elysiumstation/blackfury/x/maker/keeper"
+	"github.com/elysiumstation/blackfury/x/maker/types"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	suite.SetupTest()
+	suite.setupInterestTest(sdk.NewDecWithPrec(10, 2))
+	k := suite.app.MakerKeeper
+
+	suite.app.BankKeeper.SetDenomMetaData(suite.ctx, banktypes.Metadata{
+		Base:       suite.bcDenom,
+		Display:    "DAI",
+		Name:       "DAI",
+		Symbol:     "DAI",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: suite.bcDenom, Exponent: 0}, {Denom: "DAI", Exponent: 6}},
+	})
+
+	// backing and collateral held by the module
+	backing := sdk.NewCoin(suite.bcDenom, sdk.NewInt(3_000000))
+	collateral := sdk.NewCoin(suite.bcDenom, sdk.NewInt(2_000000))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, sdk.NewCoins(backing.Add(collateral))))
+	k.SetPoolBacking(suite.ctx, types.PoolBacking{
+		BlackMinted: sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.NewInt(3_000000)),
+		Backing:     backing,
+		FuryBurned:  sdk.NewCoin(blackfury.AttoFuryDenom, sdk.ZeroInt()),
+	})
+	k.SetTotalBacking(suite.ctx, types.TotalBacking{
+		BackingValue: sdk.NewInt(3_000000),
+		BlackMinted:  sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.NewInt(3_000000)),
+		FuryBurned:   sdk.NewCoin(blackfury.AttoFuryDenom, sdk.ZeroInt()),
+	})
+
+	requireNotBroken := func() {
+		msg, broken := keeper.AllInvariants(k)(suite.ctx)
+		suite.Require().False(broken, msg)
+	}
+	requireNotBroken()
+
+	// pool debt accrues interest, while account debt is not settled yet
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(blackfury.SecondsPerYear * time.Second))
+	k.AccrueAllInterest(suite.ctx)
+	requireNotBroken()
+
+	// liquidate part of the collateral
+	suite.app.OracleKeeper.SetExchangeRate(suite.ctx, suite.bcDenom, sdk.NewDecWithPrec(4, 1))
+	repayIn := sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.NewInt(200_000))
+	liquidator := sdk.AccAddress(suite.consAddress)
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, sdk.NewCoins(repayIn)))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, liquidator, sdk.NewCoins(repayIn)))
+	msgServer := keeper.NewMsgServerImpl(k)
+	_, err := msgServer.LiquidateCollateral(sdk.WrapSDKContext(suite.ctx), &types.MsgLiquidateCollateral{
+		Sender:     liquidator.String(),
+		To:         liquidator.String(),
+		Debtor:     suite.accAddress.String(),
+		Collateral: sdk.NewCoin(suite.bcDenom, sdk.NewInt(500_000)),
+		RepayInMax: repayIn,
+	})
+	suite.Require().NoError(err)
+	requireNotBroken()
+
+	testCases := []struct {
+		name      string
+		invariant sdk.Invariant
+		malleate  func(ctx sdk.Context)
+	}{
+		{
+			"total backing drifts",
+			keeper.TotalBackingInvariant(k),
+			func(ctx sdk.Context) {
+				total, _ := k.GetTotalBacking(ctx)
+				total.BlackMinted = total.BlackMinted.AddAmount(sdk.NewInt(1))
+				k.SetTotalBacking(ctx, total)
+			},
+		},
+		{
+			"total collateral drifts",
+			keeper.TotalCollateralInvariant(k),
+			func(ctx sdk.Context) {
+				total, _ := k.GetTotalCollateral(ctx)
+				total.BlackDebt = total.BlackDebt.AddAmount(sdk.NewInt(100))
+				k.SetTotalCollateral(ctx, total)
+			},
+		},
+		{
+			"pool collateral drifts from accounts",
+			keeper.PoolCollateralInvariant(k),
+			func(ctx sdk.Context) {
+				pool, _ := k.GetPoolCollateral(ctx, suite.bcDenom)
+				pool.Collateral = pool.Collateral.AddAmount(sdk.NewInt(1))
+				k.SetPoolCollateral(ctx, pool)
+			},
+		},
+		{
+			"account collateral without pool",
+			keeper.PoolCollateralInvariant(k),
+			func(ctx sdk.Context) {
+				acc, _ := k.GetAccountCollateral(ctx, suite.accAddress, suite.bcDenom)
+				acc.Collateral.Denom = "unknown"
+				k.SetAccountCollateral(ctx, suite.accAddress, acc)
+			},
+		},
+		{
+			"module balance insufficient",
+			keeper.ModuleBalanceInvariant(k),
+			func(ctx sdk.Context) {
+				err := suite.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, liquidator, sdk.NewCoins(sdk.NewCoin(suite.bcDenom, sdk.NewInt(1))))
+				suite.Require().NoError(err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		cacheCtx, _ := suite.ctx.CacheContext()
+		tc.malleate(cacheCtx)
+		_, broken := tc.invariant(cacheCtx)
+		suite.Require().True(broken, tc.name)
+		_, broken = keeper.AllInvariants(k)(cacheCtx)
+		suite.Require().True(broken, tc.name)
+	}
+}
@@ -7,8 +7,12 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/elysiumstation/blackfury/app"
+	blackfury "github.com/elysiumstation/blackfury/types"
 	"github.com/elysiumstation/blackfury/x/maker/types"
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -71,6 +75,19 @@ func (suite *KeeperTestSuite) SetupTest() {
 	suite.bcDenom = "uDAI"
 }
 
+// setupProposerValidator creates a bonded validator as the block proposer,
+// which is required for minting coins that get registered as ERC20 tokens.
+func (suite *KeeperTestSuite) setupProposerValidator() {
+	valConsPk := simapp.CreateTestPubKeys(1)[0]
+	app.FundTestAddrs(suite.app, suite.ctx, []sdk.AccAddress{sdk.AccAddress(valConsPk.Address())}, sdk.NewInt(1234))
+	suite.ctx = suite.ctx.WithProposer(sdk.ConsAddress(valConsPk.Address()))
+
+	tstaking := teststaking.NewHelper(suite.T(), suite.ctx, suite.app.StakingKeeper.Keeper)
+	tstaking.Denom = blackfury.AttoFuryDenom
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidator(sdk.ValAddress(valConsPk.Address()), valConsPk, sdk.NewInt(100), true)
+}
+
 func (suite *KeeperTestSuite) Commit() {
 	suite.CommitAfter(time.Nanosecond)
 }
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elysiumstation/blackfury/x/maker/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+// It sets the params which were added since version 2 to their defaults,
+// e.g., the surplus destination and buffer of the treasury.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if !m.keeper.paramstore.Has(ctx, pair.Key) {
+			m.keeper.paramstore.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/elysiumstation/blackfury/x/maker/keeper"
+	"github.com/elysiumstation/blackfury/x/maker/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	suite.SetupTest()
+
+	// params added since version 2 do not exist before the migration
+	store := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	store.Delete(types.KeySurplusDestination)
+	store.Delete(types.KeySurplusBuffer)
+	suite.Require().Panics(func() { suite.app.MakerKeeper.GetParams(suite.ctx) })
+
+	suite.Require().NoError(keeper.NewMigrator(suite.app.MakerKeeper).Migrate2to3(suite.ctx))
+	suite.Require().Equal(types.DefaultParams(), suite.app.MakerKeeper.GetParams(suite.ctx))
+}
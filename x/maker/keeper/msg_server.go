@@ -2,12 +2,12 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	blackfury "github.com/elysiumstation/blackfury/types"
 	"github.com/elysiumstation/blackfury/x/maker/types"
-	oracletypes "github.com/elysiumstation/blackfury/x/oracle/types"
 )
 
 type msgServer struct {
@@ -77,12 +77,10 @@ func (m msgServer) MintBySwap(c context.Context, msg *types.MsgMintBySwap) (*typ
 	if err != nil {
 		return nil, err
 	}
-	// send black fee to oracle
-	if mintFee.IsPositive() {
-		err = m.Keeper.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, sdk.NewCoins(mintFee))
-		if err != nil {
-			return nil, err
-		}
+	// collect black fee as surplus
+	err = m.Keeper.collectSurplus(ctx, types.AssetClassBacking, backingIn.Denom, sdk.NewCoins(mintFee))
+	if err != nil {
+		return nil, err
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -152,8 +150,8 @@ func (m msgServer) BurnBySwap(c context.Context, msg *types.MsgBurnBySwap) (*typ
 	if err != nil {
 		return nil, err
 	}
-	// send black fee to oracle
-	err = m.Keeper.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, sdk.NewCoins(burnFee))
+	// collect black fee as surplus
+	err = m.Keeper.collectSurplus(ctx, types.AssetClassBacking, backingOut.Denom, sdk.NewCoins(burnFee))
 	if err != nil {
 		return nil, err
 	}
@@ -232,8 +230,8 @@ func (m msgServer) BuyBacking(c context.Context, msg *types.MsgBuyBacking) (*typ
 	if err != nil {
 		return nil, err
 	}
-	// send fee to oracle
-	err = m.Keeper.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, sdk.NewCoins(buybackFee))
+	// collect fee as surplus
+	err = m.Keeper.collectSurplus(ctx, types.AssetClassBacking, backingOut.Denom, sdk.NewCoins(buybackFee))
 	if err != nil {
 		return nil, err
 	}
@@ -304,8 +302,8 @@ func (m msgServer) SellBacking(c context.Context, msg *types.MsgSellBacking) (*t
 	if err != nil {
 		return nil, err
 	}
-	// send fee to oracle
-	err = m.Keeper.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, sdk.NewCoins(rebackFee))
+	// collect fee as surplus
+	err = m.Keeper.collectSurplus(ctx, types.AssetClassBacking, msg.BackingIn.Denom, sdk.NewCoins(rebackFee))
 	if err != nil {
 		return nil, err
 	}
@@ -355,12 +353,10 @@ func (m msgServer) MintByCollateral(c context.Context, msg *types.MsgMintByColla
 	if err != nil {
 		return nil, err
 	}
-	// send mint fee to oracle
-	if mintFee.IsPositive() {
-		err = m.Keeper.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, sdk.NewCoins(mintFee))
-		if err != nil {
-			return nil, err
-		}
+	// collect mint fee as surplus
+	err = m.Keeper.collectSurplus(ctx, types.AssetClassCollateral, msg.CollateralDenom, sdk.NewCoins(mintFee))
+	if err != nil {
+		return nil, err
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -393,12 +389,16 @@ func (m msgServer) BurnByCollateral(c context.Context, msg *types.MsgBurnByColla
 		return nil, err
 	}
 
+	if err := m.Keeper.accrueInterest(ctx, collateralDenom, *collateralParams.InterestFee); err != nil {
+		return nil, err
+	}
+
 	totalColl, poolColl, accColl, err := m.Keeper.getCollateral(ctx, sender, collateralDenom)
 	if err != nil {
 		return nil, err
 	}
 
-	settleInterestFee(ctx, &accColl, &poolColl, &totalColl, *collateralParams.InterestFee)
+	settleInterestFee(ctx, &accColl, &poolColl)
 
 	// compute burn-in, repay interest first
 	if !accColl.BlackDebt.IsPositive() {
@@ -406,13 +406,10 @@ func (m msgServer) BurnByCollateral(c context.Context, msg *types.MsgBurnByColla
 	}
 	repayIn := sdk.NewCoin(msg.RepayInMax.Denom, sdk.MinInt(accColl.BlackDebt.Amount, msg.RepayInMax.Amount))
 	repayInterest := sdk.NewCoin(msg.RepayInMax.Denom, sdk.MinInt(accColl.LastInterest.Amount, repayIn.Amount))
-	burn := repayIn.Sub(repayInterest)
 
 	// update debt
 	accColl.LastInterest = accColl.LastInterest.Sub(repayInterest)
-	accColl.BlackDebt = accColl.BlackDebt.Sub(repayIn)
-	poolColl.BlackDebt = poolColl.BlackDebt.Sub(repayIn)
-	totalColl.BlackDebt = totalColl.BlackDebt.Sub(repayIn)
+	decreaseDebt(&accColl, &poolColl, &totalColl, repayIn)
 
 	// eventually update collateral
 	m.Keeper.SetAccountCollateral(ctx, sender, accColl)
@@ -424,19 +421,10 @@ func (m msgServer) BurnByCollateral(c context.Context, msg *types.MsgBurnByColla
 	if err != nil {
 		return nil, err
 	}
-	// burn black
-	if burn.IsPositive() {
-		err = m.Keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(burn))
-		if err != nil {
-			return nil, err
-		}
-	}
-	// send fee to oracle
-	if repayInterest.IsPositive() {
-		err = m.Keeper.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, sdk.NewCoins(repayInterest))
-		if err != nil {
-			return nil, err
-		}
+	// burn black, including interest which has been minted as surplus at accrual
+	err = m.Keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(repayIn))
+	if err != nil {
+		return nil, err
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -470,12 +458,16 @@ func (m msgServer) DepositCollateral(c context.Context, msg *types.MsgDepositCol
 		return nil, err
 	}
 
+	if err := m.Keeper.accrueInterest(ctx, collateralDenom, *collateralParams.InterestFee); err != nil {
+		return nil, err
+	}
+
 	totalColl, poolColl, accColl, err := m.Keeper.getCollateral(ctx, receiver, collateralDenom, true)
 	if err != nil {
 		return nil, err
 	}
 
-	settleInterestFee(ctx, &accColl, &poolColl, &totalColl, *collateralParams.InterestFee)
+	settleInterestFee(ctx, &accColl, &poolColl)
 
 	accColl.Collateral = accColl.Collateral.Add(msg.CollateralIn)
 	poolColl.Collateral = poolColl.Collateral.Add(msg.CollateralIn)
@@ -525,12 +517,16 @@ func (m msgServer) RedeemCollateral(c context.Context, msg *types.MsgRedeemColla
 		return nil, err
 	}
 
+	if err := m.Keeper.accrueInterest(ctx, collateralDenom, *collateralParams.InterestFee); err != nil {
+		return nil, err
+	}
+
 	totalColl, poolColl, accColl, err := m.Keeper.getCollateral(ctx, sender, collateralDenom)
 	if err != nil {
 		return nil, err
 	}
 
-	settleInterestFee(ctx, &accColl, &poolColl, &totalColl, *collateralParams.InterestFee)
+	settleInterestFee(ctx, &accColl, &poolColl)
 
 	// update collateral
 	accColl.Collateral = accColl.Collateral.Sub(msg.CollateralOut)
@@ -543,8 +539,19 @@ func (m msgServer) RedeemCollateral(c context.Context, msg *types.MsgRedeemColla
 	if err != nil {
 		return nil, err
 	}
+	debtInUSD := accColl.BlackDebt.Amount.ToDec().Mul(blackfury.MicroFUSDTarget)
+
+	if m.Keeper.IsCrossMargin(ctx, sender) {
+		// other positions of the account support this one
+		others, err := m.Keeper.getAccountHealth(ctx, sender, collateralDenom)
+		if err != nil {
+			return nil, err
+		}
+		maxDebtInUSD = maxDebtInUSD.Add(others.maxDebtValue)
+		debtInUSD = debtInUSD.Add(others.debtValue)
+	}
 
-	if accColl.BlackDebt.Amount.ToDec().Mul(blackfury.MicroFUSDTarget).GT(maxDebtInUSD) {
+	if debtInUSD.GT(maxDebtInUSD) {
 		return nil, sdkerrors.Wrapf(types.ErrAccountInsufficientCollateral, "account collateral insufficient: %s", collateralDenom)
 	}
 
@@ -591,12 +598,16 @@ func (m msgServer) LiquidateCollateral(c context.Context, msg *types.MsgLiquidat
 		return nil, err
 	}
 
+	if err := m.Keeper.accrueInterest(ctx, collateralDenom, *collateralParams.InterestFee); err != nil {
+		return nil, err
+	}
+
 	totalColl, poolColl, accColl, err := m.Keeper.getCollateral(ctx, debtor, collateralDenom)
 	if err != nil {
 		return nil, err
 	}
 
-	settleInterestFee(ctx, &accColl, &poolColl, &totalColl, *collateralParams.InterestFee)
+	settleInterestFee(ctx, &accColl, &poolColl)
 
 	// get prices in usd
 	collateralPrice, err := m.Keeper.oracleKeeper.GetExchangeRate(ctx, collateralDenom)
@@ -606,7 +617,22 @@ func (m msgServer) LiquidateCollateral(c context.Context, msg *types.MsgLiquidat
 
 	// check whether undercollateralized
 	liquidationValue := accColl.Collateral.Amount.ToDec().Mul(collateralPrice).Mul(*collateralParams.LiquidationThreshold)
-	if accColl.BlackDebt.Amount.ToDec().Mul(blackfury.MicroFUSDTarget).LT(liquidationValue) {
+	debtValue := accColl.BlackDebt.Amount.ToDec().Mul(blackfury.MicroFUSDTarget)
+	crossMargin := m.Keeper.IsCrossMargin(ctx, debtor)
+	if crossMargin {
+		// positions are liquidated only for repaying debt, when the account is undercollateralized as a whole,
+		// so a position may be liquidated for repaying the debt of the other positions
+		others, err := m.Keeper.getAccountHealth(ctx, debtor, collateralDenom)
+		if err != nil {
+			return nil, err
+		}
+		liquidationValue = liquidationValue.Add(others.liquidationValue)
+		debtValue = debtValue.Add(others.debtValue)
+		if !debtValue.IsPositive() {
+			return nil, sdkerrors.Wrap(types.ErrAccountNoDebt, "")
+		}
+	}
+	if debtValue.LT(liquidationValue) {
 		return nil, sdkerrors.Wrap(types.ErrNotUndercollateralized, "")
 	}
 
@@ -630,17 +656,39 @@ func (m msgServer) LiquidateCollateral(c context.Context, msg *types.MsgLiquidat
 	repayInterest := sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.MinInt(accColl.LastInterest.Amount, repayDebt.Amount))
 	accColl.LastInterest = accColl.LastInterest.Sub(repayInterest)
 
-	accColl.BlackDebt = accColl.BlackDebt.Sub(repayDebt)
-	poolColl.BlackDebt = poolColl.BlackDebt.Sub(repayDebt)
-	totalColl.BlackDebt = totalColl.BlackDebt.Sub(repayDebt)
+	decreaseDebt(&accColl, &poolColl, &totalColl, repayDebt)
 	accColl.Collateral = accColl.Collateral.Sub(msg.Collateral)
 	poolColl.Collateral = poolColl.Collateral.Sub(msg.Collateral)
 
+	if !crossMargin {
+		// write off the remaining debt if no collateral left
+		err = m.Keeper.realizeBadDebt(ctx, &accColl, &poolColl, &totalColl)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// eventually persist collateral
 	m.Keeper.SetAccountCollateral(ctx, debtor, accColl)
 	m.Keeper.SetPoolCollateral(ctx, poolColl)
 	m.Keeper.SetTotalCollateral(ctx, totalColl)
 
+	if crossMargin {
+		// repay the debt of the other positions with the excess black
+		repayOthers, err := m.Keeper.repayCrossMarginDebt(ctx, debtor, collateralDenom, blackRefund)
+		if err != nil {
+			return nil, err
+		}
+		repayDebt = repayDebt.Add(repayOthers)
+		blackRefund = blackRefund.Sub(repayOthers)
+
+		// write off the remaining debt of all positions only if no collateral left in the account
+		err = m.Keeper.realizeCrossMarginBadDebt(ctx, debtor)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// take black from sender
 	err = m.Keeper.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(repayIn))
 	if err != nil {
@@ -664,8 +712,8 @@ func (m msgServer) LiquidateCollateral(c context.Context, msg *types.MsgLiquidat
 	if err != nil {
 		return nil, err
 	}
-	// send liquidation commission fee to oracle
-	err = m.Keeper.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, sdk.NewCoins(commissionFee))
+	// collect liquidation commission fee as surplus
+	err = m.Keeper.collectSurplus(ctx, types.AssetClassCollateral, collateralDenom, sdk.NewCoins(commissionFee))
 	if err != nil {
 		return nil, err
 	}
@@ -688,6 +736,197 @@ func (m msgServer) LiquidateCollateral(c context.Context, msg *types.MsgLiquidat
 	}, nil
 }
 
+func (m msgServer) SetCrossMargin(c context.Context, msg *types.MsgSetCrossMargin) (*types.MsgSetCrossMarginResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if !msg.Enabled && m.Keeper.IsCrossMargin(ctx, sender) {
+		// every position must be healthy on its own
+		for _, accColl := range m.Keeper.GetAllAccountCollateral(ctx, sender) {
+			position, _, err := m.Keeper.getAccountPosition(ctx, accColl)
+			if err != nil {
+				return nil, err
+			}
+			if position.DebtValue.GT(position.CollateralValue.Mul(position.MaxLoanToValue)) {
+				return nil, sdkerrors.Wrapf(types.ErrAccountInsufficientCollateral, "account collateral insufficient: %s", accColl.Collateral.Denom)
+			}
+		}
+	}
+
+	m.Keeper.SetCrossMargin(ctx, sender, msg.Enabled)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeSetCrossMargin,
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(msg.Enabled)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgSetCrossMarginResponse{}, nil
+}
+
+func (m msgServer) FlashMint(c context.Context, msg *types.MsgFlashMint) (*types.MsgFlashMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ceiling := m.Keeper.FlashMintCeiling(ctx)
+	if msg.Amount.Amount.GT(ceiling) {
+		return nil, sdkerrors.Wrapf(types.ErrFlashMintCeiling, "flash mint %s over ceiling %s", msg.Amount.Amount, ceiling)
+	}
+	fee := sdk.NewCoin(blackfury.MicroFUSDDenom, msg.Amount.Amount.ToDec().Mul(m.Keeper.FlashMintFee(ctx)).Ceil().TruncateInt())
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+	// nested messages skip the ante handler, so check them against the allowlist again
+	for i, nested := range msgs {
+		if err := types.ValidateFlashMintMsg(sender, nested); err != nil {
+			return nil, sdkerrors.Wrapf(err, "invalid nested message %d", i)
+		}
+	}
+
+	// mint black to sender
+	mintOut := sdk.NewCoins(msg.Amount)
+	err = m.Keeper.bankKeeper.MintCoins(ctx, types.ModuleName, mintOut)
+	if err != nil {
+		return nil, err
+	}
+	err = m.Keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, mintOut)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := m.Keeper.executeFlashMintMsgs(ctx, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	// take back the minted black plus fee, or revert the whole transaction
+	err = m.Keeper.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(msg.Amount.Add(fee)))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "flash mint %s not repaid with fee %s", msg.Amount, fee)
+	}
+	err = m.Keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, mintOut)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.Keeper.collectSurplus(ctx, types.AssetClassBlack, blackfury.MicroFUSDDenom, sdk.NewCoins(fee)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeFlashMint,
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyCoinOut, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgFlashMintResponse{
+		Fee:     fee,
+		Results: results,
+	}, nil
+}
+
+func (m msgServer) BuyFury(c context.Context, msg *types.MsgBuyFury) (*types.MsgBuyFuryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	sender, receiver, err := getSenderReceiver(msg.Sender, msg.To)
+	if err != nil {
+		return nil, err
+	}
+
+	ledger, found := m.Keeper.GetTreasuryLedger(ctx, types.AssetClassCollateral, msg.CollateralDenom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrTreasuryLedgerNotFound, "treasury ledger not found: %s", msg.CollateralDenom)
+	}
+
+	// take black-in
+	err = m.Keeper.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(msg.BlackIn))
+	if err != nil {
+		return nil, err
+	}
+	// burn black against the bad debt
+	furyOut, err := m.Keeper.sellFuryReserve(ctx, &ledger, msg.BlackIn)
+	if err != nil {
+		return nil, err
+	}
+	if furyOut.IsLT(msg.FuryOutMin) {
+		return nil, sdkerrors.Wrapf(types.ErrFuryCoinSlippage, "fury out: %s", furyOut)
+	}
+
+	m.Keeper.SetTreasuryLedger(ctx, ledger)
+
+	// send fury to receiver
+	err = m.Keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, sdk.NewCoins(furyOut))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeBuyFury,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.CollateralDenom),
+			sdk.NewAttribute(types.AttributeKeyCoinIn, msg.BlackIn.String()),
+			sdk.NewAttribute(types.AttributeKeyCoinOut, furyOut.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgBuyFuryResponse{
+		FuryOut: furyOut,
+	}, nil
+}
+
+// executeFlashMintMsgs executes the nested messages of flash mint, with gas limited by the flash mint gas.
+func (k Keeper) executeFlashMintMsgs(ctx sdk.Context, msgs []sdk.Msg) ([][]byte, error) {
+	gasMeter := sdk.NewGasMeter(k.FlashMintGas(ctx))
+	msgCtx := ctx.WithGasMeter(gasMeter)
+
+	results := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		handler := k.router.Handler(msg)
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", sdk.MsgTypeURL(msg))
+		}
+
+		res, err := handler(msgCtx, msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to execute nested message %d", i)
+		}
+		results[i] = res.Data
+
+		// emit the events of the nested message
+		events := make(sdk.Events, 0, len(res.GetEvents()))
+		for _, event := range res.GetEvents() {
+			events = append(events, sdk.Event(event))
+		}
+		ctx.EventManager().EmitEvents(events)
+	}
+
+	// charge the gas of nested messages to the transaction
+	ctx.GasMeter().ConsumeGas(gasMeter.GasConsumed(), "flash mint nested messages")
+	return results, nil
+}
+
 func (k Keeper) getBacking(ctx sdk.Context, denom string) (total types.TotalBacking, pool types.PoolBacking, err error) {
 	total, found := k.GetTotalBacking(ctx)
 	if !found {
@@ -716,6 +955,7 @@ func (k Keeper) getCollateral(ctx sdk.Context, account sdk.AccAddress, denom str
 	acc, found = k.GetAccountCollateral(ctx, account, denom)
 	if !found {
 		if len(allowNewAccount) > 0 && allowNewAccount[0] {
+			zeroDec := sdk.ZeroDec()
 			acc = types.AccountCollateral{
 				Account:             account.String(),
 				Collateral:          sdk.NewCoin(denom, sdk.ZeroInt()),
@@ -723,6 +963,7 @@ func (k Keeper) getCollateral(ctx sdk.Context, account sdk.AccAddress, denom str
 				FuryCollateralized:  sdk.NewCoin(blackfury.AttoFuryDenom, sdk.ZeroInt()),
 				LastInterest:        sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.ZeroInt()),
 				LastSettlementBlock: ctx.BlockHeight(),
+				NormalizedDebt:      &zeroDec,
 			}
 		} else {
 			err = sdkerrors.Wrapf(types.ErrAccountNoCollateral, "account has no collateral: %s", denom)
@@ -732,27 +973,6 @@ func (k Keeper) getCollateral(ctx sdk.Context, account sdk.AccAddress, denom str
 	return
 }
 
-func settleInterestFee(ctx sdk.Context, acc *types.AccountCollateral, pool *types.PoolCollateral, total *types.TotalCollateral, apr sdk.Dec) {
-	period := ctx.BlockHeight() - acc.LastSettlementBlock
-	if period == 0 {
-		// short circuit
-		return
-	}
-
-	// principal debt, excluding interest debt
-	principalDebt := acc.BlackDebt.Sub(acc.LastInterest)
-	interestOfPeriod := principalDebt.Amount.ToDec().Mul(apr).MulInt64(period).QuoInt64(int64(blackfury.BlocksPerYear)).RoundInt()
-
-	// update remaining interest accumulation
-	acc.LastInterest = acc.LastInterest.AddAmount(interestOfPeriod)
-	// update debt
-	acc.BlackDebt = acc.BlackDebt.AddAmount(interestOfPeriod)
-	pool.BlackDebt = pool.BlackDebt.AddAmount(interestOfPeriod)
-	total.BlackDebt = total.BlackDebt.AddAmount(interestOfPeriod)
-	// update settlement block
-	acc.LastSettlementBlock = ctx.BlockHeight()
-}
-
 func (k Keeper) maxLoanToValueForAccount(ctx sdk.Context, acc *types.AccountCollateral, collateralParams *types.CollateralRiskParams) (availableLTV, maxDebtInUSD sdk.Dec, err error) {
 	collateralPrice, err := k.oracleKeeper.GetExchangeRate(ctx, acc.Collateral.Denom)
 	if err != nil {
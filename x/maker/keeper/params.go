@@ -57,3 +57,45 @@ func (k Keeper) LiquidationCommissionFee(ctx sdk.Context) (res sdk.Dec) {
 	k.paramstore.Get(ctx, types.KeyLiquidationCommissionFee, &res)
 	return
 }
+
+// SurplusDestination is the module account name or address receiving surplus in excess of the surplus buffer
+func (k Keeper) SurplusDestination(ctx sdk.Context) (res string) {
+	k.paramstore.Get(ctx, types.KeySurplusDestination, &res)
+	return
+}
+
+// SurplusBuffer is the maximum Black surplus retained by the treasury per pool
+func (k Keeper) SurplusBuffer(ctx sdk.Context) (res sdk.Int) {
+	k.paramstore.Get(ctx, types.KeySurplusBuffer, &res)
+	return
+}
+
+// BackingRatioController is the name of the controller adjusting backing ratio
+func (k Keeper) BackingRatioController(ctx sdk.Context) (res string) {
+	k.paramstore.Get(ctx, types.KeyBackingRatioController, &res)
+	return
+}
+
+// PIDControllerParams is the parameters of the pid backing ratio controller
+func (k Keeper) PIDControllerParams(ctx sdk.Context) (res types.PIDControllerParams) {
+	k.paramstore.Get(ctx, types.KeyPIDControllerParams, &res)
+	return
+}
+
+// FlashMintCeiling is the maximum Black amount of a flash mint
+func (k Keeper) FlashMintCeiling(ctx sdk.Context) (res sdk.Int) {
+	k.paramstore.Get(ctx, types.KeyFlashMintCeiling, &res)
+	return
+}
+
+// FlashMintFee is flash mint fee ratio
+func (k Keeper) FlashMintFee(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyFlashMintFee, &res)
+	return
+}
+
+// FlashMintGas is the maximum gas which the nested messages of a flash mint can consume
+func (k Keeper) FlashMintGas(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyFlashMintGas, &res)
+	return
+}
@@ -124,6 +124,53 @@ func (k Keeper) GetAccountCollateral(ctx sdk.Context, addr sdk.AccAddress, denom
 	return collateral, true
 }
 
+func (k Keeper) GetAllAccountCollateral(ctx sdk.Context, addr sdk.AccAddress) []types.AccountCollateral {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCollateralAccount)
+	iterator := sdk.KVStorePrefixIterator(store, keyByAddrDenom(types.KeyPrefixCollateralAccount, addr, ""))
+	defer iterator.Close()
+
+	var collaterals []types.AccountCollateral
+	for ; iterator.Valid(); iterator.Next() {
+		var collateral types.AccountCollateral
+		k.cdc.MustUnmarshal(iterator.Value(), &collateral)
+
+		collaterals = append(collaterals, collateral)
+	}
+
+	return collaterals
+}
+
+// IterateAccountCollaterals iterates over the collaterals of all accounts and pools,
+// until the callback returns true.
+func (k Keeper) IterateAccountCollaterals(ctx sdk.Context, cb func(col types.AccountCollateral) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCollateralAccount)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixCollateralAccount)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var collateral types.AccountCollateral
+		k.cdc.MustUnmarshal(iterator.Value(), &collateral)
+
+		if cb(collateral) {
+			break
+		}
+	}
+}
+
+func (k Keeper) SetCrossMargin(ctx sdk.Context, addr sdk.AccAddress, enabled bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCrossMarginAccount)
+	if enabled {
+		store.Set(addr, []byte{1})
+	} else {
+		store.Delete(addr)
+	}
+}
+
+func (k Keeper) IsCrossMargin(ctx sdk.Context, addr sdk.AccAddress) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCrossMarginAccount)
+	return store.Has(addr)
+}
+
 func keyByAddrDenom(prefix []byte, addr sdk.AccAddress, denom string) (key []byte) {
 	key = append(prefix, address.MustLengthPrefix(addr)...)
 	return append(key, []byte(denom)...)
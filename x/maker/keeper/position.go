@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	blackfury "github.com/elysiumstation/blackfury/types"
+	"github.com/elysiumstation/blackfury/x/maker/types"
+)
+
+// accountHealth aggregates the collateral positions of an account, with values in USD.
+type accountHealth struct {
+	positions        []types.AccountPosition
+	collateralValue  sdk.Dec
+	debtValue        sdk.Dec
+	maxDebtValue     sdk.Dec // sum of collateral value weighted by available loan-to-value
+	liquidationValue sdk.Dec // sum of collateral value weighted by liquidation threshold
+}
+
+// getAccountHealth evaluates all the collateral positions of the account at the current prices,
+// except the one of excludeDenom, which the caller evaluates by itself.
+func (k Keeper) getAccountHealth(ctx sdk.Context, account sdk.AccAddress, excludeDenom string) (health accountHealth, err error) {
+	health = accountHealth{
+		collateralValue:  sdk.ZeroDec(),
+		debtValue:        sdk.ZeroDec(),
+		maxDebtValue:     sdk.ZeroDec(),
+		liquidationValue: sdk.ZeroDec(),
+	}
+
+	for _, acc := range k.GetAllAccountCollateral(ctx, account) {
+		if acc.Collateral.Denom == excludeDenom {
+			continue
+		}
+
+		position, liquidationThreshold, err := k.getAccountPosition(ctx, acc)
+		if err != nil {
+			return health, err
+		}
+
+		health.positions = append(health.positions, position)
+		health.collateralValue = health.collateralValue.Add(position.CollateralValue)
+		health.debtValue = health.debtValue.Add(position.DebtValue)
+		health.maxDebtValue = health.maxDebtValue.Add(position.CollateralValue.Mul(position.MaxLoanToValue))
+		health.liquidationValue = health.liquidationValue.Add(position.CollateralValue.Mul(liquidationThreshold))
+	}
+
+	return health, nil
+}
+
+// getAccountPosition settles the interest of the account collateral up to the current block time
+// without persisting, and evaluates the position at the current prices.
+func (k Keeper) getAccountPosition(ctx sdk.Context, acc types.AccountCollateral) (position types.AccountPosition, liquidationThreshold sdk.Dec, err error) {
+	denom := acc.Collateral.Denom
+
+	params, found := k.GetCollateralRiskParams(ctx, denom)
+	if !found {
+		err = sdkerrors.Wrapf(types.ErrCollateralCoinNotFound, "collateral coin denomination not found: %s", denom)
+		return
+	}
+	if pool, found := k.GetPoolCollateral(ctx, denom); found {
+		accruePoolInterest(&pool, *params.InterestFee, ctx.BlockTime())
+		settleInterestFee(ctx, &acc, &pool)
+	}
+
+	collateralPrice, err := k.oracleKeeper.GetExchangeRate(ctx, denom)
+	if err != nil {
+		return
+	}
+	availableLTV, _, err := k.maxLoanToValueForAccount(ctx, &acc, &params)
+	if err != nil {
+		return
+	}
+
+	collateralValue := acc.Collateral.Amount.ToDec().Mul(collateralPrice)
+	debtValue := acc.BlackDebt.Amount.ToDec().Mul(blackfury.MicroFUSDTarget)
+	liquidationThreshold = *params.LiquidationThreshold
+
+	ltv := sdk.ZeroDec()
+	if collateralValue.IsPositive() {
+		ltv = debtValue.Quo(collateralValue)
+	}
+	// undercollateralized if debt value >= collateral amount * price * liquidation threshold
+	liquidationPrice := sdk.ZeroDec()
+	if acc.Collateral.Amount.IsPositive() && liquidationThreshold.IsPositive() {
+		liquidationPrice = debtValue.Quo(acc.Collateral.Amount.ToDec().Mul(liquidationThreshold))
+	}
+
+	position = types.AccountPosition{
+		AccountCollateral: acc,
+		CollateralValue:   collateralValue,
+		DebtValue:         debtValue,
+		LoanToValue:       ltv,
+		MaxLoanToValue:    availableLTV,
+		LiquidationPrice:  liquidationPrice,
+	}
+	return
+}
+
+// settleAccountCollateral accrues the pool interest and settles the interest of the account collateral.
+func (k Keeper) settleAccountCollateral(ctx sdk.Context, account sdk.AccAddress, denom string) (total types.TotalCollateral, pool types.PoolCollateral, acc types.AccountCollateral, err error) {
+	params, found := k.GetCollateralRiskParams(ctx, denom)
+	if !found {
+		err = sdkerrors.Wrapf(types.ErrCollateralCoinNotFound, "collateral coin denomination not found: %s", denom)
+		return
+	}
+	if err = k.accrueInterest(ctx, denom, *params.InterestFee); err != nil {
+		return
+	}
+	total, pool, acc, err = k.getCollateral(ctx, account, denom)
+	if err != nil {
+		return
+	}
+	settleInterestFee(ctx, &acc, &pool)
+	return
+}
+
+// repayCrossMarginDebt repays the debt of the cross-margin positions of the account other than excludeDenom,
+// as much as possible, and returns the repaid amount, which must be burned by the caller.
+func (k Keeper) repayCrossMarginDebt(ctx sdk.Context, account sdk.AccAddress, excludeDenom string, amount sdk.Coin) (repaid sdk.Coin, err error) {
+	repaid = sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.ZeroInt())
+
+	for _, position := range k.GetAllAccountCollateral(ctx, account) {
+		denom := position.Collateral.Denom
+		if denom == excludeDenom || !position.BlackDebt.IsPositive() {
+			continue
+		}
+		if !amount.IsPositive() {
+			break
+		}
+
+		total, pool, acc, err := k.settleAccountCollateral(ctx, account, denom)
+		if err != nil {
+			return repaid, err
+		}
+
+		// repay interest first
+		repayDebt := sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.MinInt(acc.BlackDebt.Amount, amount.Amount))
+		repayInterest := sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.MinInt(acc.LastInterest.Amount, repayDebt.Amount))
+		acc.LastInterest = acc.LastInterest.Sub(repayInterest)
+		decreaseDebt(&acc, &pool, &total, repayDebt)
+
+		k.SetAccountCollateral(ctx, account, acc)
+		k.SetPoolCollateral(ctx, pool)
+		k.SetTotalCollateral(ctx, total)
+
+		amount = amount.Sub(repayDebt)
+		repaid = repaid.Add(repayDebt)
+	}
+
+	return repaid, nil
+}
+
+// realizeCrossMarginBadDebt writes off the remaining debt of all the cross-margin positions of the account,
+// only once the account has no collateral left in any position.
+func (k Keeper) realizeCrossMarginBadDebt(ctx sdk.Context, account sdk.AccAddress) error {
+	positions := k.GetAllAccountCollateral(ctx, account)
+	for _, position := range positions {
+		if position.Collateral.IsPositive() {
+			// the remaining debt is to be liquidated against the other positions
+			return nil
+		}
+	}
+
+	for _, position := range positions {
+		if !position.BlackDebt.IsPositive() {
+			continue
+		}
+
+		total, pool, acc, err := k.settleAccountCollateral(ctx, account, position.Collateral.Denom)
+		if err != nil {
+			return err
+		}
+		if err := k.realizeBadDebt(ctx, &acc, &pool, &total); err != nil {
+			return err
+		}
+
+		k.SetAccountCollateral(ctx, account, acc)
+		k.SetPoolCollateral(ctx, pool)
+		k.SetTotalCollateral(ctx, total)
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	blackfury "github.com/elysiumstation/blackfury/types"
+	"github.com/elysiumstation/blackfury/x/maker/keeper"
+	"github.com/elysiumstation/blackfury/x/maker/types"
+)
+
+func (suite *KeeperTestSuite) setupPositionTest() {
+	suite.setupInterestTest(sdk.ZeroDec())
+
+	suite.app.OracleKeeper.SetExchangeRate(suite.ctx, suite.bcDenom, sdk.OneDec())
+	suite.app.OracleKeeper.SetExchangeRate(suite.ctx, blackfury.AttoFuryDenom, sdk.NewDecWithPrec(100, 12))
+
+	// a second position of the account, with collateral but no debt
+	_, crp2 := suite.dummyCollateralRiskParams()
+	crp2.Enabled = true
+	suite.app.MakerKeeper.SetCollateralRiskParams(suite.ctx, crp2)
+	suite.app.OracleKeeper.SetExchangeRate(suite.ctx, crp2.CollateralDenom, sdk.NewDec(2))
+	suite.app.MakerKeeper.SetAccountCollateral(suite.ctx, suite.accAddress, types.AccountCollateral{
+		Account:             suite.accAddress.String(),
+		Collateral:          sdk.NewCoin(crp2.CollateralDenom, sdk.NewInt(500_000)),
+		BlackDebt:           sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.ZeroInt()),
+		FuryCollateralized:  sdk.NewCoin(blackfury.AttoFuryDenom, sdk.ZeroInt()),
+		LastInterest:        sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.ZeroInt()),
+		LastSettlementBlock: suite.ctx.BlockHeight(),
+	})
+}
+
+func (suite *KeeperTestSuite) TestAccountPositions() {
+	suite.SetupTest()
+	suite.setupPositionTest()
+
+	res, err := suite.queryClient.AccountPositions(suite.ctx.Context(), &types.QueryAccountPositionsRequest{Account: suite.accAddress.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Positions, 2)
+	suite.Require().False(res.CrossMargin)
+	suite.Require().Equal(sdk.NewDec(3_000000), res.TotalCollateralValue)
+	suite.Require().Equal(sdk.NewDec(1_000000), res.TotalDebtValue)
+	// (2_000000 * 0.90 + 1_000000 * 0.91) / 1_000000
+	suite.Require().Equal(sdk.NewDecWithPrec(271, 2), *res.HealthFactor)
+
+	for _, position := range res.Positions {
+		switch position.AccountCollateral.Collateral.Denom {
+		case suite.bcDenom:
+			suite.Require().Equal(sdk.NewDecWithPrec(5, 1), position.LoanToValue)
+			suite.Require().Equal(sdk.NewDecWithPrec(5, 1), position.MaxLoanToValue)
+			suite.Require().Equal(sdk.NewDec(1_000000).Quo(sdk.NewDec(1_800000)), position.LiquidationPrice)
+		default:
+			suite.Require().True(position.LoanToValue.IsZero())
+			suite.Require().True(position.LiquidationPrice.IsZero())
+		}
+	}
+
+	// no debt, no health factor
+	res, err = suite.queryClient.AccountPositions(suite.ctx.Context(), &types.QueryAccountPositionsRequest{Account: sdk.AccAddress(suite.consAddress).String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Positions)
+	suite.Require().Nil(res.HealthFactor)
+}
+
+func (suite *KeeperTestSuite) TestCrossMargin() {
+	suite.SetupTest()
+	suite.setupPositionTest()
+
+	suite.app.BankKeeper.SetDenomMetaData(suite.ctx, banktypes.Metadata{
+		Base:       suite.bcDenom,
+		Display:    "DAI",
+		Name:       "DAI",
+		Symbol:     "DAI",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: suite.bcDenom, Exponent: 0}, {Denom: "DAI", Exponent: 6}},
+	})
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(suite.bcDenom, sdk.NewInt(2_000000)))))
+
+	msgServer := keeper.NewMsgServerImpl(suite.app.MakerKeeper)
+	redeem := &types.MsgRedeemCollateral{
+		Sender:        suite.accAddress.String(),
+		CollateralOut: sdk.NewCoin(suite.bcDenom, sdk.NewInt(1_000000)),
+		FuryOut:       sdk.NewCoin(blackfury.AttoFuryDenom, sdk.ZeroInt()),
+	}
+
+	// isolated position is at its max loan-to-value
+	_, err := msgServer.RedeemCollateral(sdk.WrapSDKContext(suite.ctx), redeem)
+	suite.Require().ErrorIs(err, types.ErrAccountInsufficientCollateral)
+
+	_, err = msgServer.SetCrossMargin(sdk.WrapSDKContext(suite.ctx), &types.MsgSetCrossMargin{Sender: suite.accAddress.String(), Enabled: true})
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.MakerKeeper.IsCrossMargin(suite.ctx, suite.accAddress))
+
+	// the other position supports 1_000000 * 0.51 of debt
+	_, err = msgServer.RedeemCollateral(sdk.WrapSDKContext(suite.ctx), redeem)
+	suite.Require().NoError(err)
+	accColl, found := suite.app.MakerKeeper.GetAccountCollateral(suite.ctx, suite.accAddress, suite.bcDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(1_000000), accColl.Collateral.Amount)
+
+	// cannot go back to isolated positions while any of them is over its max loan-to-value
+	_, err = msgServer.SetCrossMargin(sdk.WrapSDKContext(suite.ctx), &types.MsgSetCrossMargin{Sender: suite.accAddress.String(), Enabled: false})
+	suite.Require().ErrorIs(err, types.ErrAccountInsufficientCollateral)
+	suite.Require().True(suite.app.MakerKeeper.IsCrossMargin(suite.ctx, suite.accAddress))
+
+	// not liquidatable while the account is healthy as a whole
+	liquidator := sdk.AccAddress(suite.consAddress)
+	_, err = msgServer.LiquidateCollateral(sdk.WrapSDKContext(suite.ctx), &types.MsgLiquidateCollateral{
+		Sender:     liquidator.String(),
+		Debtor:     suite.accAddress.String(),
+		Collateral: sdk.NewCoin(suite.bcDenom, sdk.NewInt(100_000)),
+		RepayInMax: sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.NewInt(100_000)),
+	})
+	suite.Require().ErrorIs(err, types.ErrNotUndercollateralized)
+}
+
+func (suite *KeeperTestSuite) TestCrossMarginLiquidation() {
+	suite.SetupTest()
+	suite.setupPositionTest()
+
+	_, crp2 := suite.dummyCollateralRiskParams()
+	ethDenom := crp2.CollateralDenom
+	zeroDec := sdk.ZeroDec()
+	suite.app.MakerKeeper.SetPoolCollateral(suite.ctx, types.PoolCollateral{
+		Collateral:         sdk.NewCoin(ethDenom, sdk.NewInt(500_000)),
+		BlackDebt:          sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.ZeroInt()),
+		FuryCollateralized: sdk.NewCoin(blackfury.AttoFuryDenom, sdk.ZeroInt()),
+		LastAccrualTime:    suite.ctx.BlockTime().Unix(),
+		NormalizedDebt:     &zeroDec,
+	})
+	for _, denom := range []string{suite.bcDenom, ethDenom} {
+		suite.app.BankKeeper.SetDenomMetaData(suite.ctx, banktypes.Metadata{
+			Base:       denom,
+			Display:    denom,
+			Name:       denom,
+			Symbol:     denom,
+			DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		})
+	}
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(suite.bcDenom, sdk.NewInt(2_000000)), sdk.NewCoin(ethDenom, sdk.NewInt(500_000)))))
+
+	msgServer := keeper.NewMsgServerImpl(suite.app.MakerKeeper)
+	_, err := msgServer.SetCrossMargin(sdk.WrapSDKContext(suite.ctx), &types.MsgSetCrossMargin{Sender: suite.accAddress.String(), Enabled: true})
+	suite.Require().NoError(err)
+
+	// fund liquidator
+	liquidator := sdk.AccAddress(suite.consAddress)
+	funds := sdk.NewCoins(sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.NewInt(1_000000)))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, funds))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, liquidator, funds))
+
+	// the account is undercollateralized as a whole: 2_000000 * 0.3 * 0.90 + 500_000 * 0.4 * 0.91 < 1_000000
+	suite.app.OracleKeeper.SetExchangeRate(suite.ctx, suite.bcDenom, sdk.NewDecWithPrec(3, 1))
+	suite.app.OracleKeeper.SetExchangeRate(suite.ctx, ethDenom, sdk.NewDecWithPrec(4, 1))
+
+	// liquidate all the collateral of the first position: repay 2_000000 * 0.90 * 0.3 of the 1_000000 debt
+	_, err = msgServer.LiquidateCollateral(sdk.WrapSDKContext(suite.ctx), &types.MsgLiquidateCollateral{
+		Sender:     liquidator.String(),
+		Debtor:     suite.accAddress.String(),
+		Collateral: sdk.NewCoin(suite.bcDenom, sdk.NewInt(2_000000)),
+		RepayInMax: sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.NewInt(540_000)),
+	})
+	suite.Require().NoError(err)
+
+	// the remaining debt is not written off while the other position still has collateral
+	accColl, found := suite.app.MakerKeeper.GetAccountCollateral(suite.ctx, suite.accAddress, suite.bcDenom)
+	suite.Require().True(found)
+	suite.Require().True(accColl.Collateral.IsZero())
+	suite.Require().Equal(sdk.NewInt(460_000), accColl.BlackDebt.Amount)
+	ledger, found := suite.app.MakerKeeper.GetTreasuryLedger(suite.ctx, types.AssetClassCollateral, suite.bcDenom)
+	suite.Require().True(found)
+	suite.Require().True(ledger.TotalBadDebt.IsZero())
+
+	// the other position has no debt of its own, but is liquidated for repaying the remaining debt:
+	// repay 500_000 * 0.89 * 0.4
+	res, err := msgServer.LiquidateCollateral(sdk.WrapSDKContext(suite.ctx), &types.MsgLiquidateCollateral{
+		Sender:     liquidator.String(),
+		Debtor:     suite.accAddress.String(),
+		Collateral: sdk.NewCoin(ethDenom, sdk.NewInt(500_000)),
+		RepayInMax: sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.NewInt(178_000)),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(178_000), res.RepayIn.Amount)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, suite.accAddress, blackfury.MicroFUSDDenom).IsZero())
+
+	// no collateral left in the account, so the remaining debt is written off
+	accColl, _ = suite.app.MakerKeeper.GetAccountCollateral(suite.ctx, suite.accAddress, suite.bcDenom)
+	suite.Require().True(accColl.BlackDebt.IsZero())
+	ledger, _ = suite.app.MakerKeeper.GetTreasuryLedger(suite.ctx, types.AssetClassCollateral, suite.bcDenom)
+	suite.Require().Equal(sdk.NewInt(282_000), ledger.TotalBadDebt.Amount)
+	pool, _ := suite.app.MakerKeeper.GetPoolCollateral(suite.ctx, suite.bcDenom)
+	suite.Require().True(pool.BlackDebt.IsZero())
+
+	// nothing left to liquidate
+	_, err = msgServer.LiquidateCollateral(sdk.WrapSDKContext(suite.ctx), &types.MsgLiquidateCollateral{
+		Sender:     liquidator.String(),
+		Debtor:     suite.accAddress.String(),
+		Collateral: sdk.NewCoin(ethDenom, sdk.NewInt(1)),
+		RepayInMax: sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.NewInt(1)),
+	})
+	suite.Require().ErrorIs(err, types.ErrAccountNoDebt)
+
+	_, stop := keeper.AllInvariants(suite.app.MakerKeeper)(suite.ctx)
+	suite.Require().False(stop)
+}
@@ -128,10 +128,15 @@ func HandleRegisterCollateralProposal(ctx sdk.Context, k Keeper, p *types.Regist
 		})
 	}
 
+	interestIndex := sdk.OneDec()
+	normalizedDebt := sdk.ZeroDec()
 	k.SetPoolCollateral(ctx, types.PoolCollateral{
 		Collateral:         sdk.NewCoin(params.CollateralDenom, sdk.ZeroInt()),
 		BlackDebt:            sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.ZeroInt()),
 		FuryCollateralized: sdk.NewCoin(blackfury.AttoFuryDenom, sdk.ZeroInt()),
+		InterestIndex:      &interestIndex,
+		LastAccrualTime:    ctx.BlockTime().Unix(),
+		NormalizedDebt:     &normalizedDebt,
 	})
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -211,6 +216,13 @@ func setCollateralRiskParamsProposal(ctx sdk.Context, k Keeper, patch *types.Col
 		return sdkerrors.Wrapf(types.ErrCollateralCoinNotFound, "collateral coin denomination not found: %s", params.CollateralDenom)
 	}
 
+	if patch.InterestFee != nil {
+		// interest accrued so far is charged at the previous rate
+		if err := k.accrueInterest(ctx, params.CollateralDenom, *params.InterestFee); err != nil {
+			return err
+		}
+	}
+
 	var updated uint8
 	if params.Enabled != patch.Enabled {
 		params.Enabled = patch.Enabled
@@ -288,3 +300,31 @@ func HandleBatchSetCollateralRiskParamsProposal(ctx sdk.Context, k Keeper, p *ty
 	}
 	return nil
 }
+
+func HandleCoverBadDebtProposal(ctx sdk.Context, k Keeper, p *types.CoverBadDebtProposal) error {
+	ledger, found := k.GetTreasuryLedger(ctx, types.AssetClassCollateral, p.CollateralDenom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrTreasuryLedgerNotFound, "treasury ledger not found: %s", p.CollateralDenom)
+	}
+	if !ledger.BadDebt.IsPositive() {
+		return sdkerrors.Wrapf(types.ErrNoBadDebt, "collateral pool has no bad debt: %s", p.CollateralDenom)
+	}
+
+	// surplus is always used first
+	if err := k.coverBadDebtBySurplus(ctx, &ledger); err != nil {
+		return err
+	}
+	if err := k.coverBadDebtByFury(ctx, &ledger, p.MaxFuryMint); err != nil {
+		return err
+	}
+
+	k.SetTreasuryLedger(ctx, ledger)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,308 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	blackfury "github.com/elysiumstation/blackfury/types"
+	"github.com/elysiumstation/blackfury/x/maker/types"
+)
+
+func (k Keeper) SetTreasuryLedger(ctx sdk.Context, ledger types.TreasuryLedger) {
+	keyPrefix, err := types.TreasuryLedgerKeyPrefix(ledger.AssetClass)
+	if err != nil {
+		panic(err)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	bz := k.cdc.MustMarshal(&ledger)
+	store.Set([]byte(ledger.Denom), bz)
+}
+
+func (k Keeper) GetTreasuryLedger(ctx sdk.Context, assetClass string, denom string) (types.TreasuryLedger, bool) {
+	var ledger types.TreasuryLedger
+	keyPrefix, err := types.TreasuryLedgerKeyPrefix(assetClass)
+	if err != nil {
+		return ledger, false
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return ledger, false
+	}
+	k.cdc.MustUnmarshal(bz, &ledger)
+	return ledger, true
+}
+
+func (k Keeper) GetAllTreasuryLedgers(ctx sdk.Context) []types.TreasuryLedger {
+	store := ctx.KVStore(k.storeKey)
+
+	var ledgers []types.TreasuryLedger
+	for _, keyPrefix := range [][]byte{types.KeyPrefixBackingTreasury, types.KeyPrefixCollateralTreasury, types.KeyPrefixBlackTreasury} {
+		iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+		for ; iterator.Valid(); iterator.Next() {
+			var ledger types.TreasuryLedger
+			k.cdc.MustUnmarshal(iterator.Value(), &ledger)
+
+			ledgers = append(ledgers, ledger)
+		}
+		iterator.Close()
+	}
+
+	return ledgers
+}
+
+// getTreasuryLedger returns the treasury ledger of the asset, or an empty one if not found.
+func (k Keeper) getTreasuryLedger(ctx sdk.Context, assetClass string, denom string) types.TreasuryLedger {
+	ledger, found := k.GetTreasuryLedger(ctx, assetClass, denom)
+	if !found {
+		ledger = types.TreasuryLedger{
+			AssetClass:        assetClass,
+			Denom:             denom,
+			BadDebt:           sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.ZeroInt()),
+			TotalBadDebt:      sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.ZeroInt()),
+			CoveredBySurplus:  sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.ZeroInt()),
+			CoveredByFury:     sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.ZeroInt()),
+			FuryMinted:        sdk.NewCoin(blackfury.AttoFuryDenom, sdk.ZeroInt()),
+			FuryReserve:       sdk.NewCoin(blackfury.AttoFuryDenom, sdk.ZeroInt()),
+			FurySeized:        sdk.NewCoin(blackfury.AttoFuryDenom, sdk.ZeroInt()),
+			FuryBurned:        sdk.NewCoin(blackfury.AttoFuryDenom, sdk.ZeroInt()),
+			FurySeizedReserve: sdk.NewCoin(blackfury.AttoFuryDenom, sdk.ZeroInt()),
+		}
+	}
+	return ledger
+}
+
+// collectSurplus records the surplus of the asset, which must have been held by the maker module account.
+// The surplus covers outstanding bad debt first, then Black surplus is retained by the treasury up to the
+// surplus buffer, and the rest is sent to the surplus destination.
+func (k Keeper) collectSurplus(ctx sdk.Context, assetClass string, denom string, coins sdk.Coins) error {
+	if coins.Empty() {
+		return nil
+	}
+
+	ledger := k.getTreasuryLedger(ctx, assetClass, denom)
+	ledger.Surplus = ledger.Surplus.Add(coins...)
+	ledger.TotalSurplus = ledger.TotalSurplus.Add(coins...)
+
+	if err := k.coverBadDebtBySurplus(ctx, &ledger); err != nil {
+		return err
+	}
+
+	if k.SurplusDestination(ctx) != types.ModuleName {
+		// retain black up to the surplus buffer, and send the rest
+		retained := sdk.NewCoins(sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.MinInt(ledger.Surplus.AmountOf(blackfury.MicroFUSDDenom), k.SurplusBuffer(ctx))))
+		excess := ledger.Surplus.Sub(retained)
+		if excess.Empty() {
+			ledger.Surplus = retained
+		} else if err := k.sendToSurplusDestination(ctx, excess); err != nil {
+			// keep the excess in the treasury rather than failing the caller
+			k.Logger(ctx).Error("failed to send surplus", "destination", k.SurplusDestination(ctx), "surplus", excess.String(), "error", err)
+		} else {
+			ledger.Surplus = retained
+		}
+	}
+
+	k.SetTreasuryLedger(ctx, ledger)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeCollectSurplus,
+			sdk.NewAttribute(types.AttributeKeyAssetClass, assetClass),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeySurplus, coins.String()),
+		),
+	)
+	return nil
+}
+
+// realizeBadDebt writes off the remaining debt of an account which has no collateral left,
+// records it as bad debt of the pool, and covers it by surplus as much as possible.
+// The fury collateral of the account is seized by the treasury for sale to cover the bad debt.
+func (k Keeper) realizeBadDebt(ctx sdk.Context, acc *types.AccountCollateral, pool *types.PoolCollateral, total *types.TotalCollateral) error {
+	if !acc.Collateral.IsZero() || !acc.BlackDebt.IsPositive() {
+		return nil
+	}
+
+	ledger := k.getTreasuryLedger(ctx, types.AssetClassCollateral, pool.Collateral.Denom)
+
+	seized := acc.FuryCollateralized
+	acc.FuryCollateralized = acc.FuryCollateralized.Sub(seized)
+	pool.FuryCollateralized = pool.FuryCollateralized.Sub(seized)
+	total.FuryCollateralized = total.FuryCollateralized.Sub(seized)
+	ledger.FuryReserve = ledger.FuryReserve.Add(seized)
+	ledger.FurySeized = ledger.FurySeized.Add(seized)
+	ledger.FurySeizedReserve = furySeizedReserve(&ledger).Add(seized)
+
+	badDebt := acc.BlackDebt
+	decreaseDebt(acc, pool, total, badDebt)
+	acc.LastInterest = sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.ZeroInt())
+
+	ledger.BadDebt = ledger.BadDebt.Add(badDebt)
+	ledger.TotalBadDebt = ledger.TotalBadDebt.Add(badDebt)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeRealizeBadDebt,
+			sdk.NewAttribute(types.AttributeKeyDenom, pool.Collateral.Denom),
+			sdk.NewAttribute(types.AttributeKeyBadDebt, badDebt.String()),
+			sdk.NewAttribute(types.AttributeKeyFurySeized, seized.String()),
+		),
+	)
+
+	if err := k.coverBadDebtBySurplus(ctx, &ledger); err != nil {
+		return err
+	}
+
+	k.SetTreasuryLedger(ctx, ledger)
+	return nil
+}
+
+// coverBadDebtBySurplus burns the Black surplus retained by the treasury to cover the outstanding bad debt.
+func (k Keeper) coverBadDebtBySurplus(ctx sdk.Context, ledger *types.TreasuryLedger) error {
+	amount := sdk.MinInt(ledger.BadDebt.Amount, ledger.Surplus.AmountOf(blackfury.MicroFUSDDenom))
+	if !amount.IsPositive() {
+		return nil
+	}
+	covered := sdk.NewCoin(blackfury.MicroFUSDDenom, amount)
+
+	err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(covered))
+	if err != nil {
+		return err
+	}
+
+	ledger.Surplus = ledger.Surplus.Sub(sdk.NewCoins(covered))
+	ledger.BadDebt = ledger.BadDebt.Sub(covered)
+	ledger.CoveredBySurplus = ledger.CoveredBySurplus.Add(covered)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeCoverBadDebt,
+			sdk.NewAttribute(types.AttributeKeyDenom, ledger.Denom),
+			sdk.NewAttribute(types.AttributeKeyBadDebt, covered.String()),
+		),
+	)
+
+	return k.burnFuryReserve(ctx, ledger)
+}
+
+// coverBadDebtByFury mints fury of value equal to the outstanding bad debt not yet covered by the fury reserve,
+// but no more than maxFuryMint, and adds it to the reserve for sale against Black.
+func (k Keeper) coverBadDebtByFury(ctx sdk.Context, ledger *types.TreasuryLedger, maxFuryMint sdk.Coin) error {
+	if !ledger.BadDebt.IsPositive() {
+		return nil
+	}
+
+	furyPrice, err := k.oracleKeeper.GetExchangeRate(ctx, blackfury.AttoFuryDenom)
+	if err != nil {
+		return err
+	}
+
+	furyNeeded := ledger.BadDebt.Amount.ToDec().Mul(blackfury.MicroFUSDTarget).Quo(furyPrice).Ceil().TruncateInt().Sub(ledger.FuryReserve.Amount)
+	furyMint := sdk.NewCoin(blackfury.AttoFuryDenom, sdk.MinInt(furyNeeded, maxFuryMint.Amount))
+	if !furyMint.IsPositive() {
+		return nil
+	}
+
+	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(furyMint))
+	if err != nil {
+		return err
+	}
+
+	ledger.FuryReserve = ledger.FuryReserve.Add(furyMint)
+	ledger.FuryMinted = ledger.FuryMinted.Add(furyMint)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeMintFuryReserve,
+			sdk.NewAttribute(types.AttributeKeyDenom, ledger.Denom),
+			sdk.NewAttribute(types.AttributeKeyFuryMinted, furyMint.String()),
+		),
+	)
+	return nil
+}
+
+// sellFuryReserve sells fury of the reserve at the oracle price for the Black, which must have been held by
+// the maker module account, and burns the Black to cover the outstanding bad debt.
+func (k Keeper) sellFuryReserve(ctx sdk.Context, ledger *types.TreasuryLedger, blackIn sdk.Coin) (furyOut sdk.Coin, err error) {
+	if !ledger.BadDebt.IsPositive() {
+		err = sdkerrors.Wrapf(types.ErrNoBadDebt, "collateral pool has no bad debt: %s", ledger.Denom)
+		return
+	}
+	if ledger.BadDebt.IsLT(blackIn) {
+		err = sdkerrors.Wrapf(types.ErrBlackOverBadDebt, "black in(%s) > bad debt(%s)", blackIn, ledger.BadDebt)
+		return
+	}
+
+	furyPrice, err := k.oracleKeeper.GetExchangeRate(ctx, blackfury.AttoFuryDenom)
+	if err != nil {
+		return
+	}
+	furyOut = sdk.NewCoin(blackfury.AttoFuryDenom, blackIn.Amount.ToDec().Mul(blackfury.MicroFUSDTarget).Quo(furyPrice).TruncateInt())
+	if ledger.FuryReserve.IsLT(furyOut) {
+		err = sdkerrors.Wrapf(types.ErrFuryCoinInsufficient, "fury out(%s) > fury reserve(%s)", furyOut, ledger.FuryReserve)
+		return
+	}
+
+	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(blackIn))
+	if err != nil {
+		return
+	}
+
+	// the seized fury collateral is sold first, so that more of the minted fury is left to be burned
+	seizedReserve := furySeizedReserve(ledger)
+	ledger.FurySeizedReserve = seizedReserve.SubAmount(sdk.MinInt(seizedReserve.Amount, furyOut.Amount))
+	ledger.FuryReserve = ledger.FuryReserve.Sub(furyOut)
+	ledger.BadDebt = ledger.BadDebt.Sub(blackIn)
+	ledger.CoveredByFury = ledger.CoveredByFury.Add(blackIn)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeCoverBadDebt,
+			sdk.NewAttribute(types.AttributeKeyDenom, ledger.Denom),
+			sdk.NewAttribute(types.AttributeKeyBadDebt, blackIn.String()),
+		),
+	)
+
+	err = k.burnFuryReserve(ctx, ledger)
+	return
+}
+
+// burnFuryReserve burns the minted fury left in the reserve once the bad debt has been fully covered,
+// since it no longer backs anything. The seized fury collateral left in the reserve was not minted by the
+// treasury, so it is retained as surplus instead.
+func (k Keeper) burnFuryReserve(ctx sdk.Context, ledger *types.TreasuryLedger) error {
+	if ledger.BadDebt.IsPositive() || !ledger.FuryReserve.IsPositive() {
+		return nil
+	}
+
+	seized := furySeizedReserve(ledger)
+	if seized.IsPositive() {
+		ledger.FuryReserve = ledger.FuryReserve.Sub(seized)
+		ledger.FurySeizedReserve = seized.SubAmount(seized.Amount)
+		ledger.Surplus = ledger.Surplus.Add(seized)
+		ledger.TotalSurplus = ledger.TotalSurplus.Add(seized)
+	}
+
+	burned := ledger.FuryReserve
+	if !burned.IsPositive() {
+		return nil
+	}
+	err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(burned))
+	if err != nil {
+		return err
+	}
+
+	ledger.FuryReserve = ledger.FuryReserve.Sub(burned)
+	ledger.FuryBurned = ledger.FuryBurned.Add(burned)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeBurnFuryReserve,
+			sdk.NewAttribute(types.AttributeKeyDenom, ledger.Denom),
+			sdk.NewAttribute(types.AttributeKeyFuryBurned, burned.String()),
+		),
+	)
+	return nil
+}
+
+// furySeizedReserve returns the seized fury collateral still held in the reserve of the ledger
+func furySeizedReserve(ledger *types.TreasuryLedger) sdk.Coin {
+	if len(ledger.FurySeizedReserve.Denom) == 0 || ledger.FurySeizedReserve.Amount.IsNil() {
+		return sdk.NewCoin(blackfury.AttoFuryDenom, sdk.ZeroInt())
+	}
+	return ledger.FurySeizedReserve
+}
@@ -0,0 +1,286 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	blackfury "github.com/elysiumstation/blackfury/types"
+	"github.com/elysiumstation/blackfury/x/maker/keeper"
+	"github.com/elysiumstation/blackfury/x/maker/types"
+	oracletypes "github.com/elysiumstation/blackfury/x/oracle/types"
+)
+
+func (suite *KeeperTestSuite) TestCollectSurplus() {
+	suite.SetupTest()
+	suite.setupInterestTest(sdk.NewDecWithPrec(10, 2))
+
+	params := suite.app.MakerKeeper.GetParams(suite.ctx)
+	params.SurplusBuffer = sdk.NewInt(50_000)
+	suite.app.MakerKeeper.SetParams(suite.ctx, params)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(blackfury.SecondsPerYear * time.Second))
+	suite.app.MakerKeeper.AccrueAllInterest(suite.ctx)
+
+	// surplus is retained up to the buffer, and the rest is sent to the surplus destination
+	ledger, found := suite.app.MakerKeeper.GetTreasuryLedger(suite.ctx, types.AssetClassCollateral, suite.bcDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(50_000), ledger.Surplus.AmountOf(blackfury.MicroFUSDDenom))
+	suite.Require().Equal(sdk.NewInt(105171), ledger.TotalSurplus.AmountOf(blackfury.MicroFUSDDenom))
+
+	oracleAddr := suite.app.AccountKeeper.GetModuleAddress(oracletypes.ModuleName)
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, oracleAddr, blackfury.MicroFUSDDenom)
+	suite.Require().Equal(sdk.NewInt(55171), balance.Amount)
+
+	res, err := suite.queryClient.AllTreasuryLedgers(suite.ctx.Context(), &types.QueryAllTreasuryLedgersRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Ledgers, 1)
+	suite.Require().Equal(types.AssetClassCollateral, res.Ledgers[0].AssetClass)
+	suite.Require().Equal(suite.bcDenom, res.Ledgers[0].Denom)
+}
+
+func (suite *KeeperTestSuite) TestRealizeAndCoverBadDebt() {
+	suite.SetupTest()
+	suite.setupInterestTest(sdk.NewDecWithPrec(10, 2))
+
+	suite.app.OracleKeeper.SetExchangeRate(suite.ctx, suite.bcDenom, sdk.NewDecWithPrec(4, 1))
+	suite.app.OracleKeeper.SetExchangeRate(suite.ctx, blackfury.AttoFuryDenom, sdk.NewDecWithPrec(100, 12))
+
+	// no ledger yet
+	_, err := suite.queryClient.TreasuryLedger(suite.ctx.Context(), &types.QueryTreasuryLedgerRequest{AssetClass: types.AssetClassCollateral, Denom: suite.bcDenom})
+	suite.Require().Error(err)
+	_, err = suite.queryClient.TreasuryLedger(suite.ctx.Context(), &types.QueryTreasuryLedgerRequest{AssetClass: "unknown", Denom: suite.bcDenom})
+	suite.Require().Error(err)
+
+	suite.app.BankKeeper.SetDenomMetaData(suite.ctx, banktypes.Metadata{
+		Base:       suite.bcDenom,
+		Display:    "DAI",
+		Name:       "DAI",
+		Symbol:     "DAI",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: suite.bcDenom, Exponent: 0}, {Denom: "DAI", Exponent: 6}},
+	})
+
+	// the account also has fury collateralized
+	furyColl := sdk.NewCoin(blackfury.AttoFuryDenom, sdk.NewInt(1e14))
+	accColl, _ := suite.app.MakerKeeper.GetAccountCollateral(suite.ctx, suite.accAddress, suite.bcDenom)
+	accColl.FuryCollateralized = furyColl
+	suite.app.MakerKeeper.SetAccountCollateral(suite.ctx, suite.accAddress, accColl)
+	pool, _ := suite.app.MakerKeeper.GetPoolCollateral(suite.ctx, suite.bcDenom)
+	pool.FuryCollateralized = furyColl
+	suite.app.MakerKeeper.SetPoolCollateral(suite.ctx, pool)
+	total, _ := suite.app.MakerKeeper.GetTotalCollateral(suite.ctx)
+	total.FuryCollateralized = furyColl
+	suite.app.MakerKeeper.SetTotalCollateral(suite.ctx, total)
+
+	// collateral held by the module, and surplus retained by the treasury
+	surplus := sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.NewInt(100_000))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(suite.bcDenom, sdk.NewInt(2_000000)), furyColl, surplus)))
+	suite.app.MakerKeeper.SetTreasuryLedger(suite.ctx, types.TreasuryLedger{
+		AssetClass:       types.AssetClassCollateral,
+		Denom:            suite.bcDenom,
+		Surplus:          sdk.NewCoins(surplus),
+		TotalSurplus:     sdk.NewCoins(surplus),
+		BadDebt:          sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.ZeroInt()),
+		TotalBadDebt:     sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.ZeroInt()),
+		CoveredBySurplus: sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.ZeroInt()),
+		CoveredByFury:    sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.ZeroInt()),
+		FuryMinted:       sdk.NewCoin(blackfury.AttoFuryDenom, sdk.ZeroInt()),
+		FuryReserve:      sdk.NewCoin(blackfury.AttoFuryDenom, sdk.ZeroInt()),
+		FurySeized:       sdk.NewCoin(blackfury.AttoFuryDenom, sdk.ZeroInt()),
+		FuryBurned:       sdk.NewCoin(blackfury.AttoFuryDenom, sdk.ZeroInt()),
+	})
+
+	// fund liquidator, who also buys the fury reserve later
+	repayIn := sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.NewInt(720_000))
+	liquidator := sdk.AccAddress(suite.consAddress)
+	funds := sdk.NewCoins(repayIn.AddAmount(sdk.NewInt(200_000)))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, funds))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, liquidator, funds))
+
+	// liquidate all the collateral: repay 720_000 of the 1_000000 debt
+	msgServer := keeper.NewMsgServerImpl(suite.app.MakerKeeper)
+	_, err = msgServer.LiquidateCollateral(sdk.WrapSDKContext(suite.ctx), &types.MsgLiquidateCollateral{
+		Sender:     liquidator.String(),
+		To:         liquidator.String(),
+		Debtor:     suite.accAddress.String(),
+		Collateral: sdk.NewCoin(suite.bcDenom, sdk.NewInt(2_000000)),
+		RepayInMax: repayIn,
+	})
+	suite.Require().NoError(err)
+
+	// the remaining 280_000 is written off and covered by 100_000 surplus, and the fury collateral is seized
+	accColl, found := suite.app.MakerKeeper.GetAccountCollateral(suite.ctx, suite.accAddress, suite.bcDenom)
+	suite.Require().True(found)
+	suite.Require().True(accColl.BlackDebt.IsZero())
+	suite.Require().True(accColl.FuryCollateralized.IsZero())
+	pool, found = suite.app.MakerKeeper.GetPoolCollateral(suite.ctx, suite.bcDenom)
+	suite.Require().True(found)
+	suite.Require().True(pool.BlackDebt.IsZero())
+	suite.Require().True(pool.FuryCollateralized.IsZero())
+
+	ledger, found := suite.app.MakerKeeper.GetTreasuryLedger(suite.ctx, types.AssetClassCollateral, suite.bcDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(280_000), ledger.TotalBadDebt.Amount)
+	suite.Require().Equal(sdk.NewInt(180_000), ledger.BadDebt.Amount)
+	suite.Require().Equal(sdk.NewInt(100_000), ledger.CoveredBySurplus.Amount)
+	suite.Require().True(ledger.Surplus.AmountOf(blackfury.MicroFUSDDenom).IsZero())
+	suite.Require().Equal(furyColl, ledger.FurySeized)
+	suite.Require().Equal(furyColl, ledger.FuryReserve)
+	suite.Require().Equal(furyColl, ledger.FurySeizedReserve)
+	// liquidation commission is not Black, so it is sent to the surplus destination
+	suite.Require().Equal(sdk.NewInt(20_000), ledger.TotalSurplus.AmountOf(suite.bcDenom))
+
+	// governance authorises minting fury worth no more than 100_000 ufusd into the reserve
+	err = keeper.HandleCoverBadDebtProposal(suite.ctx, suite.app.MakerKeeper, &types.CoverBadDebtProposal{
+		CollateralDenom: suite.bcDenom,
+		MaxFuryMint:     sdk.NewCoin(blackfury.AttoFuryDenom, sdk.NewInt(1e15)),
+	})
+	suite.Require().NoError(err)
+	ledger, _ = suite.app.MakerKeeper.GetTreasuryLedger(suite.ctx, types.AssetClassCollateral, suite.bcDenom)
+	suite.Require().Equal(sdk.NewInt(180_000), ledger.BadDebt.Amount)
+	suite.Require().Equal(sdk.NewInt(1e15), ledger.FuryMinted.Amount)
+	suite.Require().Equal(sdk.NewInt(11e14), ledger.FuryReserve.Amount)
+
+	buyFury := func(blackIn int64, furyOutMin int64) (*types.MsgBuyFuryResponse, error) {
+		// failed msgs are reverted as in a tx
+		cacheCtx, write := suite.ctx.CacheContext()
+		res, err := msgServer.BuyFury(sdk.WrapSDKContext(cacheCtx), &types.MsgBuyFury{
+			Sender:          liquidator.String(),
+			To:              liquidator.String(),
+			CollateralDenom: suite.bcDenom,
+			BlackIn:         sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.NewInt(blackIn)),
+			FuryOutMin:      sdk.NewCoin(blackfury.AttoFuryDenom, sdk.NewInt(furyOutMin)),
+		})
+		if err == nil {
+			write()
+		}
+		return res, err
+	}
+
+	// black in must not exceed the bad debt
+	_, err = buyFury(200_000, 0)
+	suite.Require().ErrorIs(err, types.ErrBlackOverBadDebt)
+
+	// the black paid for the fury is burned against the bad debt
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, blackfury.MicroFUSDDenom)
+	res, err := buyFury(100_000, 1e15)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(1e15), res.FuryOut.Amount)
+	suite.Require().Equal(supply.SubAmount(sdk.NewInt(100_000)), suite.app.BankKeeper.GetSupply(suite.ctx, blackfury.MicroFUSDDenom))
+	ledger, _ = suite.app.MakerKeeper.GetTreasuryLedger(suite.ctx, types.AssetClassCollateral, suite.bcDenom)
+	suite.Require().Equal(sdk.NewInt(80_000), ledger.BadDebt.Amount)
+	suite.Require().Equal(sdk.NewInt(100_000), ledger.CoveredByFury.Amount)
+	suite.Require().Equal(sdk.NewInt(1e14), ledger.FuryReserve.Amount)
+	// the seized fury collateral is sold first
+	suite.Require().True(ledger.FurySeizedReserve.IsZero())
+
+	// slippage, and not enough fury in the reserve
+	_, err = buyFury(10_000, 11e13)
+	suite.Require().ErrorIs(err, types.ErrFuryCoinSlippage)
+	_, err = buyFury(20_000, 0)
+	suite.Require().ErrorIs(err, types.ErrFuryCoinInsufficient)
+
+	// governance tops up the reserve to cover the rest
+	err = keeper.HandleCoverBadDebtProposal(suite.ctx, suite.app.MakerKeeper, &types.CoverBadDebtProposal{
+		CollateralDenom: suite.bcDenom,
+		MaxFuryMint:     sdk.NewCoin(blackfury.AttoFuryDenom, sdk.NewInt(1e18)),
+	})
+	suite.Require().NoError(err)
+	ledger, _ = suite.app.MakerKeeper.GetTreasuryLedger(suite.ctx, types.AssetClassCollateral, suite.bcDenom)
+	suite.Require().Equal(sdk.NewInt(17e14), ledger.FuryMinted.Amount)
+	suite.Require().Equal(sdk.NewInt(8e14), ledger.FuryReserve.Amount)
+
+	_, err = buyFury(30_000, 0)
+	suite.Require().NoError(err)
+
+	// newly collected surplus covers the rest, and the unsold fury is burned since it backs nothing
+	surplus = sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.NewInt(50_000))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, sdk.NewCoins(surplus)))
+	ledger, _ = suite.app.MakerKeeper.GetTreasuryLedger(suite.ctx, types.AssetClassCollateral, suite.bcDenom)
+	ledger.Surplus = ledger.Surplus.Add(surplus)
+	suite.app.MakerKeeper.SetTreasuryLedger(suite.ctx, ledger)
+	err = keeper.HandleCoverBadDebtProposal(suite.ctx, suite.app.MakerKeeper, &types.CoverBadDebtProposal{
+		CollateralDenom: suite.bcDenom,
+		MaxFuryMint:     sdk.NewCoin(blackfury.AttoFuryDenom, sdk.NewInt(1e18)),
+	})
+	suite.Require().NoError(err)
+	ledger, _ = suite.app.MakerKeeper.GetTreasuryLedger(suite.ctx, types.AssetClassCollateral, suite.bcDenom)
+	suite.Require().True(ledger.BadDebt.IsZero())
+	suite.Require().Equal(sdk.NewInt(130_000), ledger.CoveredByFury.Amount)
+	suite.Require().Equal(sdk.NewInt(150_000), ledger.CoveredBySurplus.Amount)
+	suite.Require().True(ledger.FuryReserve.IsZero())
+	suite.Require().Equal(sdk.NewInt(5e14), ledger.FuryBurned.Amount)
+
+	treasuryAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, treasuryAddr, blackfury.AttoFuryDenom).IsZero())
+	suite.Require().Equal(sdk.NewInt(13e14), suite.app.BankKeeper.GetBalance(suite.ctx, liquidator, blackfury.AttoFuryDenom).Amount)
+
+	// nothing left to cover
+	err = keeper.HandleCoverBadDebtProposal(suite.ctx, suite.app.MakerKeeper, &types.CoverBadDebtProposal{
+		CollateralDenom: suite.bcDenom,
+		MaxFuryMint:     sdk.NewCoin(blackfury.AttoFuryDenom, sdk.NewInt(1e18)),
+	})
+	suite.Require().ErrorIs(err, types.ErrNoBadDebt)
+	_, err = buyFury(1, 0)
+	suite.Require().ErrorIs(err, types.ErrNoBadDebt)
+
+	queryRes, err := suite.queryClient.TreasuryLedger(suite.ctx.Context(), &types.QueryTreasuryLedgerRequest{AssetClass: types.AssetClassCollateral, Denom: suite.bcDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(ledger.FuryBurned, queryRes.Ledger.FuryBurned)
+
+	_, stop := keeper.AllInvariants(suite.app.MakerKeeper)(suite.ctx)
+	suite.Require().False(stop)
+}
+
+func (suite *KeeperTestSuite) TestBurnFuryReserve_RetainsSeizedCollateral() {
+	suite.SetupTest()
+	suite.setupInterestTest(sdk.NewDecWithPrec(10, 2))
+
+	// the reserve holds 1e14 seized fury collateral and 2e14 minted fury
+	seized := sdk.NewCoin(blackfury.AttoFuryDenom, sdk.NewInt(1e14))
+	minted := sdk.NewCoin(blackfury.AttoFuryDenom, sdk.NewInt(2e14))
+	surplus := sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.NewInt(100_000))
+	suite.app.BankKeeper.SetDenomMetaData(suite.ctx, banktypes.Metadata{
+		Base:       suite.bcDenom,
+		Display:    "DAI",
+		Name:       "DAI",
+		Symbol:     "DAI",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: suite.bcDenom, Exponent: 0}, {Denom: "DAI", Exponent: 6}},
+	})
+	// collateral held by the module
+	coins := sdk.NewCoins(sdk.NewCoin(suite.bcDenom, sdk.NewInt(2_000000)), seized.Add(minted), surplus)
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+	suite.app.MakerKeeper.SetTreasuryLedger(suite.ctx, types.TreasuryLedger{
+		AssetClass:        types.AssetClassCollateral,
+		Denom:             suite.bcDenom,
+		Surplus:           sdk.NewCoins(surplus),
+		TotalSurplus:      sdk.NewCoins(surplus),
+		BadDebt:           sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.NewInt(100_000)),
+		TotalBadDebt:      sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.NewInt(100_000)),
+		CoveredBySurplus:  sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.ZeroInt()),
+		CoveredByFury:     sdk.NewCoin(blackfury.MicroFUSDDenom, sdk.ZeroInt()),
+		FuryMinted:        minted,
+		FuryReserve:       seized.Add(minted),
+		FurySeized:        seized,
+		FuryBurned:        sdk.NewCoin(blackfury.AttoFuryDenom, sdk.ZeroInt()),
+		FurySeizedReserve: seized,
+	})
+
+	// the surplus covers the bad debt, then only the minted fury is burned
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, blackfury.AttoFuryDenom)
+	err := keeper.HandleCoverBadDebtProposal(suite.ctx, suite.app.MakerKeeper, &types.CoverBadDebtProposal{
+		CollateralDenom: suite.bcDenom,
+		MaxFuryMint:     sdk.NewCoin(blackfury.AttoFuryDenom, sdk.ZeroInt()),
+	})
+	suite.Require().NoError(err)
+	ledger, _ := suite.app.MakerKeeper.GetTreasuryLedger(suite.ctx, types.AssetClassCollateral, suite.bcDenom)
+	suite.Require().True(ledger.BadDebt.IsZero())
+	suite.Require().True(ledger.FuryReserve.IsZero())
+	suite.Require().True(ledger.FurySeizedReserve.IsZero())
+	suite.Require().Equal(minted, ledger.FuryBurned)
+	suite.Require().Equal(sdk.NewCoins(seized), ledger.Surplus)
+	suite.Require().Equal(supply.Sub(minted), suite.app.BankKeeper.GetSupply(suite.ctx, blackfury.AttoFuryDenom))
+
+	_, stop := keeper.AllInvariants(suite.app.MakerKeeper)(suite.ctx)
+	suite.Require().False(stop)
+}
@@ -19,6 +19,9 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDepositCollateral{}, "blackfury/MsgDepositCollateral", nil)
 	cdc.RegisterConcrete(&MsgRedeemCollateral{}, "blackfury/MsgRedeemCollateral", nil)
 	cdc.RegisterConcrete(&MsgLiquidateCollateral{}, "blackfury/MsgLiquidateCollateral", nil)
+	cdc.RegisterConcrete(&MsgSetCrossMargin{}, "blackfury/MsgSetCrossMargin", nil)
+	cdc.RegisterConcrete(&MsgFlashMint{}, "blackfury/MsgFlashMint", nil)
+	cdc.RegisterConcrete(&MsgBuyFury{}, "blackfury/MsgBuyFury", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -30,6 +33,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&SetCollateralRiskParamsProposal{},
 		&BatchSetBackingRiskParamsProposal{},
 		&BatchSetCollateralRiskParamsProposal{},
+		&CoverBadDebtProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -41,4 +41,12 @@ var (
 
 	ErrLTVOutOfRange = sdkerrors.Register(ModuleName, 25, "LTV is out of range")
 	ErrOverSlippage  = sdkerrors.Register(ModuleName, 26, "over slippage")
+
+	ErrTreasuryLedgerNotFound = sdkerrors.Register(ModuleName, 27, "treasury ledger not found")
+	ErrNoBadDebt              = sdkerrors.Register(ModuleName, 28, "pool has no bad debt")
+
+	ErrFlashMintCeiling    = sdkerrors.Register(ModuleName, 29, "flash mint over ceiling")
+	ErrInvalidFlashMintMsg = sdkerrors.Register(ModuleName, 30, "invalid nested message of flash mint")
+
+	ErrBlackOverBadDebt = sdkerrors.Register(ModuleName, 31, "black over outstanding bad debt")
 )
@@ -11,6 +11,15 @@ const (
 	EventTypeDepositCollateral   = "deposit_collateral"
 	EventTypeRedeemCollateral    = "redeem_collateral"
 	EventTypeLiquidateCollateral = "liquidate_collateral"
+	EventTypeSetCrossMargin      = "set_cross_margin"
+	EventTypeFlashMint           = "flash_mint"
+	EventTypeAccrueInterest      = "accrue_interest"
+	EventTypeCollectSurplus      = "collect_surplus"
+	EventTypeRealizeBadDebt      = "realize_bad_debt"
+	EventTypeCoverBadDebt        = "cover_bad_debt"
+	EventTypeMintFuryReserve     = "mint_fury_reserve"
+	EventTypeBurnFuryReserve     = "burn_fury_reserve"
+	EventTypeBuyFury             = "buy_fury"
 
 	AttributeKeySender   = "sender"
 	AttributeKeyReceiver = "receiver"
@@ -18,6 +27,16 @@ const (
 	AttributeKeyCoinOut  = "coin_out"
 	AttributeKeyFee      = "fee"
 
+	AttributeKeyDenom         = "denom"
+	AttributeKeyEnabled       = "enabled"
+	AttributeKeyInterestIndex = "interest_index"
+	AttributeKeySurplus       = "surplus"
+	AttributeKeyBadDebt       = "bad_debt"
+	AttributeKeyFuryMinted    = "fury_minted"
+	AttributeKeyFurySeized    = "fury_seized"
+	AttributeKeyFuryBurned    = "fury_burned"
+	AttributeKeyAssetClass    = "asset_class"
+
 	EventTypeRegisterBacking         = "register_backing"
 	EventTypeRegisterCollateral      = "register_collateral"
 	EventTypeSetBackingRiskParams    = "set_backing_risk_params"
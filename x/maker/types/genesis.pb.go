@@ -86,6 +86,22 @@ type Params struct {
 	RebackBonus github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=reback_bonus,json=rebackBonus,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reback_bonus" yaml:"reback_bonus"`
 	// liquidation commission fee ratio
 	LiquidationCommissionFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=liquidation_commission_fee,json=liquidationCommissionFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_commission_fee" yaml:"liquidation_commission_fee"`
+	// module account name or bech32 address receiving protocol surplus in
+	// excess of the surplus buffer
+	SurplusDestination string `protobuf:"bytes,8,opt,name=surplus_destination,json=surplusDestination,proto3" json:"surplus_destination,omitempty" yaml:"surplus_destination"`
+	// maximum Black surplus retained by the treasury per pool for covering bad
+	// debt
+	SurplusBuffer github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=surplus_buffer,json=surplusBuffer,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"surplus_buffer" yaml:"surplus_buffer"`
+	// controller for adjusting backing ratio, one of "step" and "pid"
+	BackingRatioController string `protobuf:"bytes,10,opt,name=backing_ratio_controller,json=backingRatioController,proto3" json:"backing_ratio_controller,omitempty" yaml:"backing_ratio_controller"`
+	// parameters of the pid backing ratio controller
+	PidControllerParams PIDControllerParams `protobuf:"bytes,11,opt,name=pid_controller_params,json=pidControllerParams,proto3" json:"pid_controller_params" yaml:"pid_controller_params"`
+	// maximum Black amount of a flash mint; zero disables flash minting
+	FlashMintCeiling github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=flash_mint_ceiling,json=flashMintCeiling,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"flash_mint_ceiling" yaml:"flash_mint_ceiling"`
+	// flash mint fee ratio
+	FlashMintFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=flash_mint_fee,json=flashMintFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"flash_mint_fee" yaml:"flash_mint_fee"`
+	// maximum gas which the nested messages of a flash mint can consume
+	FlashMintGas uint64 `protobuf:"varint,14,opt,name=flash_mint_gas,json=flashMintGas,proto3" json:"flash_mint_gas,omitempty" yaml:"flash_mint_gas"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -127,49 +143,163 @@ func (m *Params) GetBackingRatioCooldownPeriod() int64 {
 	return 0
 }
 
+func (m *Params) GetSurplusDestination() string {
+	if m != nil {
+		return m.SurplusDestination
+	}
+	return ""
+}
+
+func (m *Params) GetBackingRatioController() string {
+	if m != nil {
+		return m.BackingRatioController
+	}
+	return ""
+}
+
+func (m *Params) GetPidControllerParams() PIDControllerParams {
+	if m != nil {
+		return m.PidControllerParams
+	}
+	return PIDControllerParams{}
+}
+
+func (m *Params) GetFlashMintGas() uint64 {
+	if m != nil {
+		return m.FlashMintGas
+	}
+	return 0
+}
+
+// PIDControllerParams defines the parameters of the pid backing ratio
+// controller, which drives backing ratio by the deviation of the Black TWAP
+// from its target price, and by the growth of Black supply issued by backing.
+type PIDControllerParams struct {
+	// proportional gain
+	Kp github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=kp,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"kp" yaml:"kp"`
+	// integral gain
+	Ki github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=ki,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ki" yaml:"ki"`
+	// derivative gain
+	Kd github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=kd,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"kd" yaml:"kd"`
+	// bound of the accumulated price error
+	IntegralLimit github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=integral_limit,json=integralLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"integral_limit" yaml:"integral_limit"`
+	// gain of Black supply growth, weighted by the share of supply issued by
+	// backing rather than by collateral
+	SupplyGrowthGain github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=supply_growth_gain,json=supplyGrowthGain,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"supply_growth_gain" yaml:"supply_growth_gain"`
+	// maximum change of backing ratio per adjustment
+	MaxStep github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_step,json=maxStep,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_step" yaml:"max_step"`
+	// time window of Black TWAP, in seconds
+	TwapWindow int64 `protobuf:"varint,7,opt,name=twap_window,json=twapWindow,proto3" json:"twap_window,omitempty" yaml:"twap_window"`
+}
+
+func (m *PIDControllerParams) Reset()         { *m = PIDControllerParams{} }
+func (m *PIDControllerParams) String() string { return proto.CompactTextString(m) }
+func (*PIDControllerParams) ProtoMessage()    {}
+func (*PIDControllerParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13c9e1f50fe955ba, []int{2}
+}
+func (m *PIDControllerParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PIDControllerParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PIDControllerParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PIDControllerParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PIDControllerParams.Merge(m, src)
+}
+func (m *PIDControllerParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *PIDControllerParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PIDControllerParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PIDControllerParams proto.InternalMessageInfo
+
+func (m *PIDControllerParams) GetTwapWindow() int64 {
+	if m != nil {
+		return m.TwapWindow
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "blackfury.maker.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "blackfury.maker.v1.Params")
+	proto.RegisterType((*PIDControllerParams)(nil), "blackfury.maker.v1.PIDControllerParams")
 }
 
 func init() { proto.RegisterFile("blackfury/maker/v1/genesis.proto", fileDescriptor_13c9e1f50fe955ba) }
 
 var fileDescriptor_13c9e1f50fe955ba = []byte{
-	// 530 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xb1, 0x6e, 0xd3, 0x40,
-	0x18, 0xc7, 0x73, 0xb4, 0x04, 0x7a, 0x4d, 0x04, 0x32, 0x05, 0x99, 0x48, 0xd8, 0xe1, 0x84, 0x50,
-	0x16, 0x6c, 0x05, 0x16, 0xd4, 0xd1, 0x85, 0x82, 0xd4, 0x25, 0x5c, 0x36, 0x16, 0xeb, 0x6c, 0x5f,
-	0xd3, 0x93, 0x63, 0x9f, 0xf1, 0x9d, 0x0b, 0x79, 0x05, 0x26, 0xd8, 0x18, 0xfb, 0x12, 0xbc, 0x43,
-	0xc7, 0x8e, 0x88, 0x21, 0x42, 0xc9, 0x02, 0x6b, 0x9f, 0x00, 0xdd, 0xd9, 0x34, 0x4e, 0x08, 0x43,
-	0xc4, 0x64, 0x7f, 0xf7, 0xfd, 0xef, 0xff, 0xfb, 0x7f, 0x37, 0x7c, 0xb0, 0x1b, 0x8c, 0x49, 0x18,
-	0x1f, 0x17, 0xf9, 0xc4, 0x4d, 0x48, 0x4c, 0x73, 0xf7, 0xb4, 0xef, 0x8e, 0x68, 0x4a, 0x05, 0x13,
-	0x4e, 0x96, 0x73, 0xc9, 0x0d, 0xe3, 0x4a, 0xe1, 0x68, 0x85, 0x73, 0xda, 0xef, 0xec, 0x8d, 0xf8,
-	0x88, 0xeb, 0xb6, 0xab, 0xfe, 0x4a, 0x25, 0xfa, 0x0a, 0x60, 0xeb, 0x55, 0x79, 0x77, 0x28, 0x89,
-	0xa4, 0xc6, 0x73, 0xd8, 0xcc, 0x48, 0x4e, 0x12, 0x61, 0x82, 0x2e, 0xe8, 0xed, 0x3e, 0xed, 0x38,
-	0x7f, 0x7b, 0x39, 0x03, 0xad, 0xf0, 0xb6, 0xcf, 0xa7, 0x76, 0x03, 0x57, 0x7a, 0x23, 0x86, 0xed,
-	0x80, 0x84, 0x31, 0x4b, 0x47, 0x7e, 0x4e, 0x24, 0xe3, 0xe6, 0xb5, 0x2e, 0xe8, 0xed, 0x78, 0x87,
-	0x4a, 0xf4, 0x7d, 0x6a, 0x3f, 0x1e, 0x31, 0x79, 0x52, 0x04, 0x4e, 0xc8, 0x13, 0x37, 0xe4, 0x22,
-	0xe1, 0xa2, 0xfa, 0x3c, 0x11, 0x51, 0xec, 0xca, 0x49, 0x46, 0x85, 0xf3, 0x82, 0x86, 0x97, 0x53,
-	0x7b, 0x6f, 0x42, 0x92, 0xf1, 0x3e, 0x5a, 0x32, 0x43, 0xb8, 0x55, 0xd5, 0x58, 0x97, 0xbf, 0x9a,
-	0xb0, 0x59, 0xa6, 0x30, 0x26, 0xd0, 0x58, 0x92, 0xfa, 0x42, 0xd2, 0x4c, 0xa7, 0xdf, 0xf1, 0x8e,
-	0x36, 0x86, 0xdf, 0x5f, 0x03, 0xd7, 0x8e, 0x08, 0xdf, 0xae, 0x27, 0x18, 0x4a, 0x9a, 0x19, 0x1f,
-	0x01, 0x34, 0x97, 0x95, 0x59, 0xce, 0x42, 0xea, 0x07, 0x24, 0x8d, 0xaa, 0xf1, 0xdf, 0x6c, 0x9c,
-	0xc0, 0x5e, 0x97, 0x60, 0xe1, 0x8b, 0xf0, 0xdd, 0x7a, 0x8e, 0x81, 0x6a, 0x78, 0x24, 0x8d, 0x8c,
-	0x18, 0x3e, 0x58, 0xbe, 0x13, 0x72, 0x3e, 0x8e, 0xf8, 0xfb, 0xd4, 0xcf, 0x68, 0xce, 0x78, 0x64,
-	0x6e, 0x75, 0x41, 0x6f, 0xcb, 0xeb, 0x5d, 0x4e, 0xed, 0x47, 0xeb, 0x10, 0x2b, 0x72, 0x84, 0x3b,
-	0x75, 0xce, 0x41, 0xd5, 0x1d, 0xe8, 0xa6, 0x91, 0xc1, 0x5b, 0x09, 0x4b, 0xe5, 0x9f, 0x5c, 0x8c,
-	0x08, 0x73, 0x5b, 0xcf, 0xfb, 0x7a, 0xe3, 0x79, 0xef, 0x95, 0x61, 0x56, 0xec, 0x10, 0x6e, 0xab,
-	0x93, 0x72, 0x3c, 0x46, 0x84, 0x22, 0x06, 0x45, 0x9e, 0xd6, 0x89, 0xd7, 0xff, 0x8f, 0xb8, 0x62,
-	0x87, 0x70, 0x5b, 0x9d, 0x2c, 0x88, 0x27, 0xb0, 0x95, 0x53, 0xf5, 0x06, 0x7e, 0xc0, 0xd3, 0x42,
-	0x98, 0x4d, 0x8d, 0x7b, 0xb9, 0x31, 0xee, 0x4e, 0x89, 0xab, 0x7b, 0x21, 0xbc, 0x5b, 0x96, 0x9e,
-	0xaa, 0x8c, 0xcf, 0x00, 0x76, 0xc6, 0xec, 0x5d, 0xc1, 0x22, 0xf5, 0xd4, 0xa9, 0x1f, 0xf2, 0x24,
-	0x61, 0x42, 0xa8, 0xdf, 0x63, 0x4a, 0xcd, 0x1b, 0x1a, 0x3c, 0xdc, 0x18, 0xfc, 0xb0, 0x04, 0xff,
-	0xdb, 0x19, 0x61, 0xb3, 0xd6, 0x3c, 0xb8, 0xea, 0x1d, 0x52, 0xba, 0x7f, 0xf3, 0xcb, 0x99, 0xdd,
-	0xf8, 0x79, 0x66, 0x03, 0xef, 0xe8, 0x7c, 0x66, 0x81, 0x8b, 0x99, 0x05, 0x7e, 0xcc, 0x2c, 0xf0,
-	0x69, 0x6e, 0x35, 0x2e, 0xe6, 0x56, 0xe3, 0xdb, 0xdc, 0x6a, 0xbc, 0xed, 0xd7, 0xa2, 0xd0, 0xf1,
-	0x44, 0xb0, 0x22, 0x11, 0x52, 0x7b, 0xb9, 0x8b, 0x1d, 0xf5, 0xa1, 0xda, 0x52, 0x3a, 0x59, 0xd0,
-	0xd4, 0x7b, 0xe7, 0xd9, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x75, 0x18, 0xc7, 0xc4, 0xc5, 0x04,
-	0x00, 0x00,
+	// 923 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0xdb, 0xb4, 0xdb, 0x4e, 0x93, 0x6e, 0x35, 0xdd, 0x56, 0xde, 0x08, 0xe2, 0x30, 0xac,
+	0x20, 0x17, 0x12, 0x15, 0x0e, 0xa0, 0x72, 0x40, 0xb8, 0x65, 0xc3, 0xaa, 0x20, 0xca, 0xf4, 0x80,
+	0x84, 0x40, 0xd6, 0xc4, 0x9e, 0xb8, 0xa3, 0xd8, 0x63, 0xe3, 0xb1, 0x37, 0xcd, 0x11, 0x89, 0x13,
+	0x27, 0xb8, 0x71, 0xdc, 0x3f, 0x81, 0xc4, 0x4f, 0xd8, 0xe3, 0x1e, 0x11, 0x87, 0x08, 0xb5, 0x17,
+	0xce, 0xf9, 0x05, 0x68, 0x66, 0x9c, 0xc6, 0x49, 0xd3, 0x83, 0xd5, 0x53, 0xf2, 0xde, 0xfb, 0xe6,
+	0xfb, 0xde, 0xf3, 0xbc, 0x79, 0x33, 0xa0, 0xd5, 0x0f, 0x88, 0x3b, 0x1c, 0x64, 0xc9, 0xb8, 0x1b,
+	0x92, 0x21, 0x4d, 0xba, 0x2f, 0x8f, 0xba, 0x3e, 0xe5, 0x54, 0x30, 0xd1, 0x89, 0x93, 0x28, 0x8d,
+	0x20, 0xbc, 0x45, 0x74, 0x14, 0xa2, 0xf3, 0xf2, 0xa8, 0xf1, 0xc4, 0x8f, 0xfc, 0x48, 0x85, 0xbb,
+	0xf2, 0x9f, 0x46, 0xa2, 0x3f, 0x0d, 0x50, 0xeb, 0xe9, 0xb5, 0x17, 0x29, 0x49, 0x29, 0xfc, 0x04,
+	0x6c, 0xc6, 0x24, 0x21, 0xa1, 0x30, 0x8d, 0x96, 0xd1, 0xde, 0xf9, 0xb0, 0xd1, 0xb9, 0xcb, 0xd5,
+	0x39, 0x57, 0x08, 0xbb, 0xfa, 0x7a, 0x62, 0x55, 0x70, 0x8e, 0x87, 0x43, 0x50, 0xef, 0x13, 0x77,
+	0xc8, 0xb8, 0xef, 0x24, 0x24, 0x65, 0x91, 0xb9, 0xd6, 0x32, 0xda, 0xdb, 0xf6, 0x73, 0x09, 0xfa,
+	0x67, 0x62, 0xbd, 0xe7, 0xb3, 0xf4, 0x32, 0xeb, 0x77, 0xdc, 0x28, 0xec, 0xba, 0x91, 0x08, 0x23,
+	0x91, 0xff, 0x7c, 0x20, 0xbc, 0x61, 0x37, 0x1d, 0xc7, 0x54, 0x74, 0x4e, 0xa9, 0x3b, 0x9d, 0x58,
+	0x4f, 0xc6, 0x24, 0x0c, 0x8e, 0xd1, 0x02, 0x19, 0xc2, 0xb5, 0xdc, 0xc6, 0xca, 0xfc, 0xab, 0x06,
+	0x36, 0x75, 0x16, 0x70, 0x0c, 0xe0, 0x02, 0xd4, 0x11, 0x29, 0x8d, 0x55, 0xf6, 0xdb, 0xf6, 0x59,
+	0x69, 0xf1, 0xa7, 0x2b, 0xc4, 0x15, 0x23, 0xc2, 0x7b, 0xc5, 0x0c, 0x2e, 0x52, 0x1a, 0xc3, 0x5f,
+	0x0d, 0x60, 0x2e, 0x22, 0xe3, 0x84, 0xb9, 0xd4, 0xe9, 0x13, 0xee, 0xe5, 0xe5, 0x7f, 0x5b, 0x3a,
+	0x03, 0x6b, 0x55, 0x06, 0x73, 0x5e, 0x84, 0x0f, 0x8a, 0x79, 0x9c, 0xcb, 0x80, 0x4d, 0xb8, 0x07,
+	0x87, 0xe0, 0xed, 0xc5, 0x35, 0x6e, 0x14, 0x05, 0x5e, 0x34, 0xe2, 0x4e, 0x4c, 0x13, 0x16, 0x79,
+	0xe6, 0x7a, 0xcb, 0x68, 0xaf, 0xdb, 0xed, 0xe9, 0xc4, 0x7a, 0xb6, 0x4a, 0x62, 0x09, 0x8e, 0x70,
+	0xa3, 0xa8, 0x73, 0x92, 0x47, 0xcf, 0x55, 0x10, 0xc6, 0xe0, 0x71, 0xc8, 0x78, 0x3a, 0xcb, 0x8b,
+	0x11, 0x61, 0x56, 0x55, 0xbd, 0x5f, 0x96, 0xae, 0xf7, 0x50, 0x27, 0xb3, 0x44, 0x87, 0x70, 0x5d,
+	0x7a, 0x74, 0x79, 0x8c, 0x08, 0xa9, 0xd8, 0xcf, 0x12, 0x5e, 0x54, 0xdc, 0x78, 0x98, 0xe2, 0x12,
+	0x1d, 0xc2, 0x75, 0xe9, 0x99, 0x2b, 0x5e, 0x82, 0x5a, 0x42, 0xe5, 0x37, 0x70, 0xfa, 0x11, 0xcf,
+	0x84, 0xb9, 0xa9, 0xe4, 0xbe, 0x28, 0x2d, 0xb7, 0xaf, 0xe5, 0x8a, 0x5c, 0x08, 0xef, 0x68, 0xd3,
+	0x96, 0x16, 0xfc, 0xdd, 0x00, 0x8d, 0x80, 0xfd, 0x94, 0x31, 0x4f, 0x7e, 0x6a, 0xee, 0xb8, 0x51,
+	0x18, 0x32, 0x21, 0xe4, 0xdf, 0x01, 0xa5, 0xe6, 0x23, 0x25, 0x7c, 0x51, 0x5a, 0xf8, 0x1d, 0x2d,
+	0x7c, 0x3f, 0x33, 0xc2, 0x66, 0x21, 0x78, 0x72, 0x1b, 0x7b, 0x4e, 0x29, 0xfc, 0x06, 0xec, 0x8b,
+	0x2c, 0x89, 0x83, 0x4c, 0x38, 0x1e, 0x15, 0x29, 0xe3, 0x0a, 0x63, 0x6e, 0xa9, 0x5c, 0x9a, 0xd3,
+	0x89, 0xd5, 0xd0, 0xec, 0x2b, 0x40, 0x08, 0xc3, 0xdc, 0x7b, 0x3a, 0x77, 0x42, 0x0e, 0x76, 0x67,
+	0xd8, 0x7e, 0x36, 0x18, 0xd0, 0xc4, 0xdc, 0x56, 0x5c, 0xbd, 0x12, 0x75, 0xbd, 0xe0, 0xe9, 0x74,
+	0x62, 0x1d, 0x2c, 0x2a, 0x6b, 0x36, 0x84, 0xeb, 0xb9, 0xc3, 0x56, 0x36, 0xfc, 0x71, 0xf9, 0x6c,
+	0xba, 0x11, 0x4f, 0x93, 0x28, 0x08, 0x68, 0x62, 0x02, 0xa5, 0xfc, 0xee, 0x7d, 0xa7, 0x6d, 0x8e,
+	0x44, 0xf8, 0x70, 0xf1, 0x14, 0xcc, 0x02, 0xf0, 0x67, 0x03, 0x1c, 0xc4, 0xcc, 0x2b, 0x60, 0x9d,
+	0x7c, 0x70, 0xee, 0xa8, 0xc1, 0xf9, 0xfe, 0xca, 0xc1, 0xf9, 0xe2, 0x74, 0x4e, 0x91, 0x4f, 0xd1,
+	0x67, 0xb2, 0xfe, 0xe9, 0xc4, 0x7a, 0x4b, 0x67, 0xb2, 0x92, 0x13, 0xe1, 0xfd, 0x98, 0x79, 0xcb,
+	0x4b, 0xe5, 0xe8, 0x1b, 0x04, 0x44, 0x5c, 0x3a, 0xea, 0xf0, 0xb8, 0x94, 0x05, 0x8c, 0xfb, 0x66,
+	0xad, 0xf4, 0xe8, 0xd3, 0x9f, 0x35, 0x1f, 0x7d, 0x77, 0x19, 0x11, 0xde, 0x53, 0xce, 0xaf, 0x19,
+	0x4f, 0x4f, 0xb4, 0x0b, 0x86, 0x60, 0xb7, 0x00, 0x94, 0x5d, 0x5a, 0x2f, 0xbd, 0x9b, 0xba, 0x4b,
+	0x0f, 0xee, 0xc8, 0xaa, 0xce, 0xac, 0xdd, 0x4a, 0xca, 0x6e, 0xfc, 0x6c, 0x41, 0xce, 0x27, 0xc2,
+	0xdc, 0x6d, 0x19, 0xed, 0xaa, 0xfd, 0x74, 0x25, 0x81, 0x4f, 0x44, 0x91, 0xa0, 0x47, 0xc4, 0xf1,
+	0xd6, 0x1f, 0xaf, 0xac, 0xca, 0x7f, 0xaf, 0x2c, 0x03, 0xfd, 0xb2, 0x01, 0xf6, 0x57, 0xec, 0x03,
+	0x3c, 0x03, 0x6b, 0xc3, 0xd9, 0xbd, 0xf1, 0x69, 0xe9, 0x2a, 0xb6, 0x75, 0x12, 0xc3, 0x18, 0xe1,
+	0xb5, 0x61, 0xac, 0xc8, 0x98, 0xb9, 0xf6, 0x40, 0x32, 0x26, 0xc9, 0x98, 0x22, 0xd3, 0xe3, 0xfb,
+	0x21, 0x64, 0x9e, 0x24, 0xf3, 0xe4, 0x31, 0x64, 0x3c, 0xa5, 0x7e, 0x42, 0x02, 0x27, 0x60, 0x21,
+	0x4b, 0xcd, 0xea, 0xc3, 0x36, 0x6e, 0x91, 0x0d, 0xe1, 0xfa, 0xcc, 0xf1, 0x95, 0xb4, 0x65, 0x8f,
+	0x8a, 0x2c, 0x8e, 0x83, 0xb1, 0xe3, 0x27, 0xd1, 0x28, 0xbd, 0x74, 0x7c, 0xc2, 0xb8, 0xb9, 0x51,
+	0xba, 0x47, 0x17, 0xae, 0xe7, 0xbb, 0x8c, 0x08, 0xef, 0x69, 0x67, 0x4f, 0xf9, 0x7a, 0x84, 0x71,
+	0xf8, 0x03, 0xd8, 0x0a, 0xc9, 0x95, 0x7e, 0x0f, 0xe8, 0xe1, 0xfd, 0x79, 0x69, 0xc1, 0xc7, 0xf9,
+	0xed, 0x94, 0xf3, 0x20, 0xfc, 0x28, 0x24, 0x57, 0xea, 0xf2, 0xff, 0x18, 0xec, 0xa4, 0x23, 0x12,
+	0x3b, 0x23, 0xc6, 0xbd, 0x68, 0xa4, 0x86, 0xf4, 0xba, 0x7d, 0x38, 0x9d, 0x58, 0x50, 0x2f, 0x29,
+	0x04, 0x11, 0x06, 0xd2, 0xfa, 0x4e, 0x19, 0xc7, 0x55, 0xd9, 0x86, 0xf6, 0xd9, 0xeb, 0xeb, 0xa6,
+	0xf1, 0xe6, 0xba, 0x69, 0xfc, 0x7b, 0xdd, 0x34, 0x7e, 0xbb, 0x69, 0x56, 0xde, 0xdc, 0x34, 0x2b,
+	0x7f, 0xdf, 0x34, 0x2b, 0xdf, 0x1f, 0x15, 0x92, 0xa3, 0xc1, 0x58, 0xb0, 0x2c, 0x14, 0xa9, 0x9a,
+	0xa1, 0xdd, 0xf9, 0xcb, 0xef, 0x2a, 0x7f, 0xfb, 0xa9, 0x5c, 0xfb, 0x9b, 0xea, 0x35, 0xf7, 0xd1,
+	0xff, 0x03, 0x00, 0x87, 0xfe, 0x74, 0xa3, 0x1b, 0x0a, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -212,6 +342,69 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.LiquidationCommissionFee.Equal(that1.LiquidationCommissionFee) {
 		return false
 	}
+	if this.SurplusDestination != that1.SurplusDestination {
+		return false
+	}
+	if !this.SurplusBuffer.Equal(that1.SurplusBuffer) {
+		return false
+	}
+	if this.BackingRatioController != that1.BackingRatioController {
+		return false
+	}
+	if !this.PidControllerParams.Equal(&that1.PidControllerParams) {
+		return false
+	}
+	if !this.FlashMintCeiling.Equal(that1.FlashMintCeiling) {
+		return false
+	}
+	if !this.FlashMintFee.Equal(that1.FlashMintFee) {
+		return false
+	}
+	if this.FlashMintGas != that1.FlashMintGas {
+		return false
+	}
+	return true
+}
+func (this *PIDControllerParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PIDControllerParams)
+	if !ok {
+		that2, ok := that.(PIDControllerParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Kp.Equal(that1.Kp) {
+		return false
+	}
+	if !this.Ki.Equal(that1.Ki) {
+		return false
+	}
+	if !this.Kd.Equal(that1.Kd) {
+		return false
+	}
+	if !this.IntegralLimit.Equal(that1.IntegralLimit) {
+		return false
+	}
+	if !this.SupplyGrowthGain.Equal(that1.SupplyGrowthGain) {
+		return false
+	}
+	if !this.MaxStep.Equal(that1.MaxStep) {
+		return false
+	}
+	if this.TwapWindow != that1.TwapWindow {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -277,6 +470,65 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FlashMintGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.FlashMintGas))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size := m.FlashMintFee.Size()
+		i -= size
+		if _, err := m.FlashMintFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.FlashMintCeiling.Size()
+		i -= size
+		if _, err := m.FlashMintCeiling.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.PidControllerParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.BackingRatioController) > 0 {
+		i -= len(m.BackingRatioController)
+		copy(dAtA[i:], m.BackingRatioController)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BackingRatioController)))
+		i--
+		dAtA[i] = 0x52
+	}
+	{
+		size := m.SurplusBuffer.Size()
+		i -= size
+		if _, err := m.SurplusBuffer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.SurplusDestination) > 0 {
+		i -= len(m.SurplusDestination)
+		copy(dAtA[i:], m.SurplusDestination)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.SurplusDestination)))
+		i--
+		dAtA[i] = 0x42
+	}
 	{
 		size := m.LiquidationCommissionFee.Size()
 		i -= size
@@ -345,6 +597,94 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PIDControllerParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PIDControllerParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PIDControllerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TwapWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TwapWindow))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.MaxStep.Size()
+		i -= size
+		if _, err := m.MaxStep.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SupplyGrowthGain.Size()
+		i -= size
+		if _, err := m.SupplyGrowthGain.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.IntegralLimit.Size()
+		i -= size
+		if _, err := m.IntegralLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Kd.Size()
+		i -= size
+		if _, err := m.Kd.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Ki.Size()
+		i -= size
+		if _, err := m.Ki.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Kp.Size()
+		i -= size
+		if _, err := m.Kp.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -390,6 +730,49 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.LiquidationCommissionFee.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.SurplusDestination)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.SurplusBuffer.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.BackingRatioController)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.PidControllerParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.FlashMintCeiling.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.FlashMintFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.FlashMintGas != 0 {
+		n += 1 + sovGenesis(uint64(m.FlashMintGas))
+	}
+	return n
+}
+
+func (m *PIDControllerParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Kp.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Ki.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Kd.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.IntegralLimit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SupplyGrowthGain.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxStep.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.TwapWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.TwapWindow))
+	}
 	return n
 }
 
@@ -768,6 +1151,497 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SurplusDestination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SurplusDestination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SurplusBuffer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SurplusBuffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingRatioController", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BackingRatioController = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PidControllerParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PidControllerParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlashMintCeiling", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlashMintCeiling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlashMintFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlashMintFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlashMintGas", wireType)
+			}
+			m.FlashMintGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlashMintGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PIDControllerParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PIDControllerParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PIDControllerParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Kp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ki", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ki.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Kd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntegralLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IntegralLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyGrowthGain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupplyGrowthGain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStep", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapWindow", wireType)
+			}
+			m.TwapWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TwapWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/elysiumstation/blackfury/x/maker/types"
 	"github.com/stretchr/testify/require"
 )
@@ -18,6 +19,24 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: types.DefaultGenesis(),
 			valid:    true,
 		},
+		{
+			desc: "unknown surplus destination module",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.Params.SurplusDestination = "oracel"
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "surplus destination address",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.Params.SurplusDestination = sdk.AccAddress([]byte("surplus_destination")).String()
+				return genState
+			}(),
+			valid: true,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -28,6 +28,12 @@ const (
 	prefixCollateralPool
 	prefixBackingAccount
 	prefixCollateralAccount
+	prefixCollateralTreasuryLedger
+	prefixCrossMarginAccount
+	prefixPriceObservation
+	prefixPIDControllerState
+	prefixBackingTreasuryLedger
+	prefixBlackTreasuryLedger
 )
 
 var (
@@ -41,4 +47,10 @@ var (
 	KeyPrefixCollateralPool        = []byte{prefixCollateralPool}
 	KeyPrefixBackingAccount        = []byte{prefixBackingAccount}
 	KeyPrefixCollateralAccount     = []byte{prefixCollateralAccount}
+	KeyPrefixCollateralTreasury    = []byte{prefixCollateralTreasuryLedger}
+	KeyPrefixCrossMarginAccount    = []byte{prefixCrossMarginAccount}
+	KeyPrefixPriceObservation      = []byte{prefixPriceObservation}
+	KeyPrefixPIDControllerState    = []byte{prefixPIDControllerState}
+	KeyPrefixBackingTreasury       = []byte{prefixBackingTreasuryLedger}
+	KeyPrefixBlackTreasury         = []byte{prefixBlackTreasuryLedger}
 )
@@ -649,6 +649,81 @@ func (m *BatchSetCollateralRiskParamsProposal) GetRiskParams() []CollateralRiskP
 	return nil
 }
 
+// CoverBadDebtProposal is a gov Content type to authorise minting fury for
+// covering the bad debt of a collateral pool which cannot be covered by
+// surplus. The minted fury is held by the treasury for sale against Black.
+type CoverBadDebtProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// collateral denom of the pool
+	CollateralDenom string `protobuf:"bytes,3,opt,name=collateral_denom,json=collateralDenom,proto3" json:"collateral_denom,omitempty"`
+	// maximum fury to be minted
+	MaxFuryMint types.Coin `protobuf:"bytes,4,opt,name=max_fury_mint,json=maxFuryMint,proto3" json:"max_fury_mint"`
+}
+
+func (m *CoverBadDebtProposal) Reset()         { *m = CoverBadDebtProposal{} }
+func (m *CoverBadDebtProposal) String() string { return proto.CompactTextString(m) }
+func (*CoverBadDebtProposal) ProtoMessage()    {}
+func (*CoverBadDebtProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5319d55af8eebdc, []int{10}
+}
+func (m *CoverBadDebtProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CoverBadDebtProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CoverBadDebtProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CoverBadDebtProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoverBadDebtProposal.Merge(m, src)
+}
+func (m *CoverBadDebtProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CoverBadDebtProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoverBadDebtProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CoverBadDebtProposal proto.InternalMessageInfo
+
+func (m *CoverBadDebtProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *CoverBadDebtProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CoverBadDebtProposal) GetCollateralDenom() string {
+	if m != nil {
+		return m.CollateralDenom
+	}
+	return ""
+}
+
+func (m *CoverBadDebtProposal) GetMaxFuryMint() types.Coin {
+	if m != nil {
+		return m.MaxFuryMint
+	}
+	return types.Coin{}
+}
+
 type TotalBacking struct {
 	// total backing value in uUSD
 	BackingValue github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=backing_value,json=backingValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"backing_value"`
@@ -662,7 +737,7 @@ func (m *TotalBacking) Reset()         { *m = TotalBacking{} }
 func (m *TotalBacking) String() string { return proto.CompactTextString(m) }
 func (*TotalBacking) ProtoMessage()    {}
 func (*TotalBacking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5319d55af8eebdc, []int{10}
+	return fileDescriptor_e5319d55af8eebdc, []int{11}
 }
 func (m *TotalBacking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -718,7 +793,7 @@ func (m *PoolBacking) Reset()         { *m = PoolBacking{} }
 func (m *PoolBacking) String() string { return proto.CompactTextString(m) }
 func (*PoolBacking) ProtoMessage()    {}
 func (*PoolBacking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5319d55af8eebdc, []int{11}
+	return fileDescriptor_e5319d55af8eebdc, []int{12}
 }
 func (m *PoolBacking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -775,7 +850,7 @@ func (m *AccountBacking) Reset()         { *m = AccountBacking{} }
 func (m *AccountBacking) String() string { return proto.CompactTextString(m) }
 func (*AccountBacking) ProtoMessage()    {}
 func (*AccountBacking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5319d55af8eebdc, []int{12}
+	return fileDescriptor_e5319d55af8eebdc, []int{13}
 }
 func (m *AccountBacking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -816,7 +891,7 @@ func (m *TotalCollateral) Reset()         { *m = TotalCollateral{} }
 func (m *TotalCollateral) String() string { return proto.CompactTextString(m) }
 func (*TotalCollateral) ProtoMessage()    {}
 func (*TotalCollateral) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5319d55af8eebdc, []int{13}
+	return fileDescriptor_e5319d55af8eebdc, []int{14}
 }
 func (m *TotalCollateral) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -867,13 +942,19 @@ type PoolCollateral struct {
 	BlackDebt types.Coin `protobuf:"bytes,2,opt,name=black_debt,json=blackDebt,proto3" json:"black_debt"`
 	// total collateralized fury
 	FuryCollateralized types.Coin `protobuf:"bytes,3,opt,name=fury_collateralized,json=furyCollateralized,proto3" json:"fury_collateralized"`
+	// cumulative interest rate index, starting from 1 at registration
+	InterestIndex *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=interest_index,json=interestIndex,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"interest_index,omitempty"`
+	// block time (unix seconds) of last interest accrual
+	LastAccrualTime int64 `protobuf:"varint,5,opt,name=last_accrual_time,json=lastAccrualTime,proto3" json:"last_accrual_time,omitempty"`
+	// total black debt divided by the interest index at which it was taken
+	NormalizedDebt *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=normalized_debt,json=normalizedDebt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"normalized_debt,omitempty"`
 }
 
 func (m *PoolCollateral) Reset()         { *m = PoolCollateral{} }
 func (m *PoolCollateral) String() string { return proto.CompactTextString(m) }
 func (*PoolCollateral) ProtoMessage()    {}
 func (*PoolCollateral) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5319d55af8eebdc, []int{14}
+	return fileDescriptor_e5319d55af8eebdc, []int{15}
 }
 func (m *PoolCollateral) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -923,6 +1004,13 @@ func (m *PoolCollateral) GetFuryCollateralized() types.Coin {
 	return types.Coin{}
 }
 
+func (m *PoolCollateral) GetLastAccrualTime() int64 {
+	if m != nil {
+		return m.LastAccrualTime
+	}
+	return 0
+}
+
 type AccountCollateral struct {
 	// account who owns collateral
 	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
@@ -937,13 +1025,15 @@ type AccountCollateral struct {
 	LastInterest types.Coin `protobuf:"bytes,5,opt,name=last_interest,json=lastInterest,proto3" json:"last_interest"`
 	// the block of last settlement
 	LastSettlementBlock int64 `protobuf:"varint,6,opt,name=last_settlement_block,json=lastSettlementBlock,proto3" json:"last_settlement_block,omitempty"`
+	// black debt divided by the pool interest index at which it was taken
+	NormalizedDebt *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=normalized_debt,json=normalizedDebt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"normalized_debt,omitempty"`
 }
 
 func (m *AccountCollateral) Reset()         { *m = AccountCollateral{} }
 func (m *AccountCollateral) String() string { return proto.CompactTextString(m) }
 func (*AccountCollateral) ProtoMessage()    {}
 func (*AccountCollateral) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5319d55af8eebdc, []int{15}
+	return fileDescriptor_e5319d55af8eebdc, []int{16}
 }
 func (m *AccountCollateral) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1014,6 +1104,314 @@ func (m *AccountCollateral) GetLastSettlementBlock() int64 {
 	return 0
 }
 
+// TreasuryLedger records the protocol surplus and bad debt of a backing or
+// collateral pool, or of the Black stablecoin itself.
+type TreasuryLedger struct {
+	// backing, collateral or Black denom of the ledger
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// surplus retained by the treasury and available for covering bad debt
+	Surplus github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=surplus,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"surplus"`
+	// total surplus ever collected from fees, interest and liquidation
+	// commissions
+	TotalSurplus github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total_surplus,json=totalSurplus,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_surplus"`
+	// outstanding bad debt
+	BadDebt types.Coin `protobuf:"bytes,4,opt,name=bad_debt,json=badDebt,proto3" json:"bad_debt"`
+	// total bad debt ever realized
+	TotalBadDebt types.Coin `protobuf:"bytes,5,opt,name=total_bad_debt,json=totalBadDebt,proto3" json:"total_bad_debt"`
+	// total bad debt covered by surplus
+	CoveredBySurplus types.Coin `protobuf:"bytes,6,opt,name=covered_by_surplus,json=coveredBySurplus,proto3" json:"covered_by_surplus"`
+	// total bad debt covered by selling fury for Black, which is burned
+	CoveredByFury types.Coin `protobuf:"bytes,7,opt,name=covered_by_fury,json=coveredByFury,proto3" json:"covered_by_fury"`
+	// total fury minted for covering bad debt
+	FuryMinted types.Coin `protobuf:"bytes,8,opt,name=fury_minted,json=furyMinted,proto3" json:"fury_minted"`
+	// asset class of the ledger, i.e., backing, collateral or black
+	AssetClass string `protobuf:"bytes,9,opt,name=asset_class,json=assetClass,proto3" json:"asset_class,omitempty"`
+	// fury held by the treasury for sale to cover the outstanding bad debt
+	FuryReserve types.Coin `protobuf:"bytes,10,opt,name=fury_reserve,json=furyReserve,proto3" json:"fury_reserve"`
+	// total fury collateral seized from accounts whose debt was written off
+	FurySeized types.Coin `protobuf:"bytes,11,opt,name=fury_seized,json=furySeized,proto3" json:"fury_seized"`
+	// total fury burned from the reserve after the bad debt was covered
+	FuryBurned types.Coin `protobuf:"bytes,12,opt,name=fury_burned,json=furyBurned,proto3" json:"fury_burned"`
+	// seized fury collateral still held in the reserve, which is retained as
+	// surplus instead of burned after the bad debt was covered
+	FurySeizedReserve types.Coin `protobuf:"bytes,13,opt,name=fury_seized_reserve,json=furySeizedReserve,proto3" json:"fury_seized_reserve"`
+}
+
+func (m *TreasuryLedger) Reset()         { *m = TreasuryLedger{} }
+func (m *TreasuryLedger) String() string { return proto.CompactTextString(m) }
+func (*TreasuryLedger) ProtoMessage()    {}
+func (*TreasuryLedger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5319d55af8eebdc, []int{17}
+}
+func (m *TreasuryLedger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreasuryLedger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreasuryLedger.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TreasuryLedger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreasuryLedger.Merge(m, src)
+}
+func (m *TreasuryLedger) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreasuryLedger) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreasuryLedger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreasuryLedger proto.InternalMessageInfo
+
+func (m *TreasuryLedger) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TreasuryLedger) GetSurplus() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Surplus
+	}
+	return nil
+}
+
+func (m *TreasuryLedger) GetTotalSurplus() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalSurplus
+	}
+	return nil
+}
+
+func (m *TreasuryLedger) GetBadDebt() types.Coin {
+	if m != nil {
+		return m.BadDebt
+	}
+	return types.Coin{}
+}
+
+func (m *TreasuryLedger) GetTotalBadDebt() types.Coin {
+	if m != nil {
+		return m.TotalBadDebt
+	}
+	return types.Coin{}
+}
+
+func (m *TreasuryLedger) GetCoveredBySurplus() types.Coin {
+	if m != nil {
+		return m.CoveredBySurplus
+	}
+	return types.Coin{}
+}
+
+func (m *TreasuryLedger) GetCoveredByFury() types.Coin {
+	if m != nil {
+		return m.CoveredByFury
+	}
+	return types.Coin{}
+}
+
+func (m *TreasuryLedger) GetFuryMinted() types.Coin {
+	if m != nil {
+		return m.FuryMinted
+	}
+	return types.Coin{}
+}
+
+func (m *TreasuryLedger) GetAssetClass() string {
+	if m != nil {
+		return m.AssetClass
+	}
+	return ""
+}
+
+func (m *TreasuryLedger) GetFuryReserve() types.Coin {
+	if m != nil {
+		return m.FuryReserve
+	}
+	return types.Coin{}
+}
+
+func (m *TreasuryLedger) GetFurySeized() types.Coin {
+	if m != nil {
+		return m.FurySeized
+	}
+	return types.Coin{}
+}
+
+func (m *TreasuryLedger) GetFuryBurned() types.Coin {
+	if m != nil {
+		return m.FuryBurned
+	}
+	return types.Coin{}
+}
+
+func (m *TreasuryLedger) GetFurySeizedReserve() types.Coin {
+	if m != nil {
+		return m.FurySeizedReserve
+	}
+	return types.Coin{}
+}
+
+// AccountPosition represents a collateral position of an account evaluated at
+// the current prices.
+type AccountPosition struct {
+	// collateral of the account, with interest settled up to the current block
+	AccountCollateral AccountCollateral `protobuf:"bytes,1,opt,name=account_collateral,json=accountCollateral,proto3" json:"account_collateral"`
+	// collateral value in USD
+	CollateralValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=collateral_value,json=collateralValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"collateral_value"`
+	// black debt value in USD
+	DebtValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=debt_value,json=debtValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"debt_value"`
+	// current loan-to-value
+	LoanToValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=loan_to_value,json=loanToValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"loan_to_value"`
+	// maximum available loan-to-value, depending on the collateralized fury
+	MaxLoanToValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_loan_to_value,json=maxLoanToValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_loan_to_value"`
+	// collateral price in USD below which the position is undercollateralized
+	LiquidationPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=liquidation_price,json=liquidationPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_price"`
+}
+
+func (m *AccountPosition) Reset()         { *m = AccountPosition{} }
+func (m *AccountPosition) String() string { return proto.CompactTextString(m) }
+func (*AccountPosition) ProtoMessage()    {}
+func (*AccountPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5319d55af8eebdc, []int{18}
+}
+func (m *AccountPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountPosition.Merge(m, src)
+}
+func (m *AccountPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountPosition proto.InternalMessageInfo
+
+func (m *AccountPosition) GetAccountCollateral() AccountCollateral {
+	if m != nil {
+		return m.AccountCollateral
+	}
+	return AccountCollateral{}
+}
+
+// PriceObservation is a sample of Black price for computing its TWAP.
+type PriceObservation struct {
+	// block time in unix seconds
+	Time int64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	// Black price at the block
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// time-weighted cumulative Black price up to the block
+	PriceCumulative github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price_cumulative,json=priceCumulative,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_cumulative"`
+}
+
+func (m *PriceObservation) Reset()         { *m = PriceObservation{} }
+func (m *PriceObservation) String() string { return proto.CompactTextString(m) }
+func (*PriceObservation) ProtoMessage()    {}
+func (*PriceObservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5319d55af8eebdc, []int{19}
+}
+func (m *PriceObservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceObservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceObservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceObservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceObservation.Merge(m, src)
+}
+func (m *PriceObservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceObservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceObservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceObservation proto.InternalMessageInfo
+
+func (m *PriceObservation) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+// PIDControllerState is the state of the pid backing ratio controller, as of
+// its last adjustment.
+type PIDControllerState struct {
+	// accumulated price error
+	Integral github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=integral,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"integral"`
+	// price error
+	LastError github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=last_error,json=lastError,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_error"`
+	// Black supply
+	LastSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=last_supply,json=lastSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"last_supply"`
+}
+
+func (m *PIDControllerState) Reset()         { *m = PIDControllerState{} }
+func (m *PIDControllerState) String() string { return proto.CompactTextString(m) }
+func (*PIDControllerState) ProtoMessage()    {}
+func (*PIDControllerState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5319d55af8eebdc, []int{20}
+}
+func (m *PIDControllerState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PIDControllerState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PIDControllerState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PIDControllerState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PIDControllerState.Merge(m, src)
+}
+func (m *PIDControllerState) XXX_Size() int {
+	return m.Size()
+}
+func (m *PIDControllerState) XXX_DiscardUnknown() {
+	xxx_messageInfo_PIDControllerState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PIDControllerState proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*BackingRiskParams)(nil), "blackfury.maker.v1.BackingRiskParams")
 	proto.RegisterType((*CollateralRiskParams)(nil), "blackfury.maker.v1.CollateralRiskParams")
@@ -1025,83 +1423,122 @@ func init() {
 	proto.RegisterType((*BatchSetBackingRiskParamsProposal)(nil), "blackfury.maker.v1.BatchSetBackingRiskParamsProposal")
 	proto.RegisterType((*BatchCollateralRiskParams)(nil), "blackfury.maker.v1.BatchCollateralRiskParams")
 	proto.RegisterType((*BatchSetCollateralRiskParamsProposal)(nil), "blackfury.maker.v1.BatchSetCollateralRiskParamsProposal")
+	proto.RegisterType((*CoverBadDebtProposal)(nil), "blackfury.maker.v1.CoverBadDebtProposal")
 	proto.RegisterType((*TotalBacking)(nil), "blackfury.maker.v1.TotalBacking")
 	proto.RegisterType((*PoolBacking)(nil), "blackfury.maker.v1.PoolBacking")
 	proto.RegisterType((*AccountBacking)(nil), "blackfury.maker.v1.AccountBacking")
 	proto.RegisterType((*TotalCollateral)(nil), "blackfury.maker.v1.TotalCollateral")
 	proto.RegisterType((*PoolCollateral)(nil), "blackfury.maker.v1.PoolCollateral")
 	proto.RegisterType((*AccountCollateral)(nil), "blackfury.maker.v1.AccountCollateral")
+	proto.RegisterType((*TreasuryLedger)(nil), "blackfury.maker.v1.TreasuryLedger")
+	proto.RegisterType((*AccountPosition)(nil), "blackfury.maker.v1.AccountPosition")
+	proto.RegisterType((*PriceObservation)(nil), "blackfury.maker.v1.PriceObservation")
+	proto.RegisterType((*PIDControllerState)(nil), "blackfury.maker.v1.PIDControllerState")
 }
 
 func init() { proto.RegisterFile("blackfury/maker/v1/maker.proto", fileDescriptor_e5319d55af8eebdc) }
 
 var fileDescriptor_e5319d55af8eebdc = []byte{
-	// 1037 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xd6, 0x6e, 0x1c, 0xbf, 0x75, 0x92, 0x76, 0xe2, 0x16, 0x37, 0x42, 0x4e, 0x28, 0x7f,
-	0x14, 0x90, 0x58, 0xcb, 0xe1, 0x04, 0x07, 0xfe, 0x38, 0x21, 0x52, 0x94, 0x96, 0x9a, 0x75, 0x84,
-	0x04, 0x42, 0x5a, 0xcd, 0xae, 0xa7, 0xce, 0xc8, 0xbb, 0x3b, 0x66, 0x67, 0x1c, 0xc5, 0x9c, 0xf8,
-	0x08, 0x7c, 0x01, 0x24, 0x2e, 0x1c, 0x80, 0x03, 0x5c, 0xf8, 0x0e, 0x3d, 0xf6, 0xc0, 0x01, 0x81,
-	0x54, 0xa1, 0xe4, 0x02, 0x27, 0xbe, 0x02, 0x9a, 0xd9, 0x59, 0x7b, 0x93, 0x18, 0xd5, 0x6b, 0x9b,
-	0xaa, 0xa7, 0x78, 0xdf, 0xec, 0xef, 0x97, 0xdf, 0x7b, 0xf3, 0xde, 0xcc, 0xcf, 0x86, 0x9a, 0xeb,
-	0x63, 0xaf, 0xf7, 0x70, 0x10, 0x0d, 0xeb, 0x01, 0xee, 0x91, 0xa8, 0x7e, 0xd2, 0x88, 0x3f, 0x58,
-	0xfd, 0x88, 0x09, 0x86, 0xd0, 0x68, 0xdd, 0x8a, 0xc3, 0x27, 0x8d, 0x8d, 0x4a, 0x97, 0x75, 0x99,
-	0x5a, 0xae, 0xcb, 0x4f, 0xf1, 0x9b, 0x1b, 0x35, 0x8f, 0xf1, 0x80, 0xf1, 0xba, 0x8b, 0x39, 0xa9,
-	0x9f, 0x34, 0x5c, 0x22, 0x70, 0xa3, 0xee, 0x31, 0x1a, 0xc6, 0xeb, 0x77, 0xbf, 0x29, 0xc0, 0xcd,
-	0x26, 0xf6, 0x7a, 0x34, 0xec, 0xda, 0x94, 0xf7, 0x5a, 0x38, 0xc2, 0x01, 0x47, 0x2f, 0xc3, 0x8a,
-	0x1b, 0x07, 0x9d, 0x0e, 0x09, 0x59, 0x50, 0x35, 0xb6, 0x8c, 0xed, 0x92, 0x5d, 0xd6, 0xc1, 0x3d,
-	0x19, 0x43, 0x55, 0x28, 0x92, 0x10, 0xbb, 0x3e, 0xe9, 0x54, 0xaf, 0x6d, 0x19, 0xdb, 0xcb, 0x76,
-	0xf2, 0x88, 0x0e, 0xc1, 0x0c, 0xf0, 0xa9, 0xa3, 0xdf, 0xae, 0xe6, 0x25, 0xb8, 0xf9, 0xc6, 0xef,
-	0x4f, 0x36, 0x5f, 0xeb, 0x52, 0x71, 0x3c, 0x70, 0x2d, 0x8f, 0x05, 0x75, 0x2d, 0x2c, 0xfe, 0xf3,
-	0x26, 0xef, 0xf4, 0xea, 0x62, 0xd8, 0x27, 0xdc, 0x3a, 0x08, 0x85, 0x0d, 0x01, 0x3e, 0xd5, 0xaa,
-	0x50, 0x0b, 0x56, 0x15, 0x99, 0xcc, 0xd8, 0x09, 0x68, 0x28, 0xaa, 0x85, 0xcc, 0x7c, 0x65, 0xc9,
-	0x27, 0x09, 0xee, 0xd3, 0x50, 0xa0, 0x0f, 0x61, 0x59, 0xf2, 0x38, 0x0f, 0x09, 0xa9, 0x5e, 0xcf,
-	0xc4, 0xb5, 0x47, 0x3c, 0xbb, 0x28, 0xb1, 0xfb, 0x84, 0x48, 0x1a, 0x77, 0x10, 0x85, 0x8a, 0x66,
-	0x29, 0x3b, 0x8d, 0xc4, 0x4a, 0x9a, 0x43, 0x30, 0xdd, 0xc1, 0x50, 0xd6, 0x4a, 0x31, 0x15, 0x33,
-	0x33, 0x81, 0x86, 0x4b, 0xb2, 0x03, 0x80, 0x88, 0x8c, 0xb8, 0x96, 0x33, 0x73, 0x95, 0x62, 0xf4,
-	0x3e, 0x21, 0xef, 0x14, 0xfe, 0xfa, 0x76, 0x33, 0x77, 0xf7, 0x8f, 0x25, 0xa8, 0xec, 0x32, 0xdf,
-	0xc7, 0x82, 0x44, 0xd8, 0x4f, 0xb5, 0xc8, 0xeb, 0x70, 0xc3, 0x1b, 0xc5, 0x2f, 0x74, 0xc9, 0xda,
-	0x38, 0xfe, 0xb4, 0x46, 0xf9, 0x38, 0xde, 0xdb, 0x31, 0x60, 0x86, 0x5e, 0x59, 0x09, 0xf0, 0xe9,
-	0x58, 0xe1, 0xff, 0xd0, 0x2e, 0x0e, 0xdc, 0xf2, 0xe9, 0x17, 0x03, 0xda, 0xc1, 0x82, 0xb2, 0xd0,
-	0x11, 0xc7, 0x11, 0xe1, 0xc7, 0xcc, 0xef, 0xcc, 0xd0, 0x3b, 0x95, 0x14, 0xd1, 0x51, 0xc2, 0x83,
-	0x3e, 0x82, 0x15, 0x9f, 0xe1, 0xd0, 0x11, 0xcc, 0x39, 0xc1, 0xfe, 0x60, 0x96, 0x6e, 0x32, 0x25,
-	0xc1, 0x11, 0xfb, 0x44, 0xc2, 0xd1, 0xa7, 0xb0, 0xee, 0x62, 0x4e, 0x3d, 0xe7, 0x22, 0x6b, 0xf6,
-	0xce, 0xba, 0xa1, 0x68, 0xee, 0xa5, 0xa8, 0x3f, 0x87, 0x8a, 0x87, 0x05, 0xf6, 0x87, 0x82, 0x7a,
-	0x8e, 0x3c, 0x7f, 0x9c, 0x48, 0x26, 0x33, 0x43, 0xa7, 0xa1, 0x11, 0xcf, 0xfe, 0x20, 0x1a, 0xda,
-	0x92, 0x05, 0xb5, 0x61, 0x2d, 0x5d, 0x69, 0xd9, 0xc2, 0xa5, 0xcc, 0xc4, 0xab, 0x29, 0x0a, 0x3d,
-	0xa6, 0xa3, 0x69, 0x87, 0xd9, 0xa7, 0xfd, 0x3e, 0x94, 0x69, 0x28, 0x48, 0x44, 0x78, 0x4c, 0x65,
-	0x66, 0xdf, 0xa3, 0x04, 0x3f, 0x9e, 0xae, 0xef, 0x0c, 0x78, 0xc1, 0x26, 0x5d, 0xca, 0x05, 0x89,
-	0xf4, 0x79, 0xd7, 0x8a, 0x58, 0x9f, 0x71, 0xec, 0xa3, 0x0a, 0x5c, 0x17, 0x54, 0xf8, 0x44, 0x4f,
-	0x55, 0xfc, 0x80, 0xb6, 0xc0, 0xec, 0x10, 0xee, 0x45, 0xb4, 0x2f, 0xf3, 0x53, 0xf3, 0x54, 0xb2,
-	0xd3, 0x21, 0x74, 0x0f, 0xcc, 0x88, 0xf2, 0x9e, 0xd3, 0x57, 0x73, 0xaa, 0x06, 0xca, 0xdc, 0x79,
-	0xd5, 0xba, 0x7a, 0x63, 0x58, 0x57, 0xce, 0xfd, 0x66, 0xe1, 0xd1, 0x93, 0xcd, 0x9c, 0x0d, 0xd1,
-	0x28, 0xa2, 0x75, 0xfe, 0x68, 0xc0, 0x46, 0xa2, 0x73, 0x3c, 0x6b, 0x73, 0x4b, 0x7d, 0x30, 0x49,
-	0xea, 0xf6, 0x24, 0xa9, 0x93, 0x8e, 0xa0, 0xff, 0x54, 0xfb, 0x83, 0x01, 0x2f, 0xb6, 0x89, 0xb8,
-	0x92, 0xde, 0x73, 0x59, 0xda, 0x9f, 0x0d, 0xd8, 0x6c, 0x13, 0x31, 0x29, 0xc1, 0xe7, 0xb5, 0xbe,
-	0x3e, 0xdc, 0x6e, 0x62, 0xe1, 0x1d, 0x5f, 0xf5, 0x0d, 0x97, 0x0a, 0x64, 0x6c, 0xe5, 0xe7, 0x2f,
-	0xd0, 0x4f, 0x06, 0xbc, 0xa4, 0xfe, 0xdd, 0xb3, 0xd9, 0xd2, 0x05, 0x28, 0x8e, 0xe0, 0x8e, 0x12,
-	0x3c, 0xf1, 0xde, 0x7c, 0x30, 0xa9, 0x44, 0xf3, 0xef, 0xc9, 0x2f, 0x06, 0xbc, 0x92, 0x54, 0xe9,
-	0xd9, 0xf4, 0xd2, 0x62, 0x74, 0xff, 0x63, 0x40, 0xf9, 0x88, 0x09, 0xec, 0x27, 0x76, 0xaf, 0x3d,
-	0xb6, 0x9e, 0xf1, 0xb5, 0xa5, 0x74, 0x36, 0x2d, 0x89, 0xcf, 0x72, 0x85, 0x6b, 0x92, 0xf8, 0xda,
-	0x6a, 0x42, 0x79, 0x6c, 0x08, 0xb4, 0x0d, 0x31, 0x77, 0xee, 0x58, 0x31, 0xd4, 0x92, 0xe6, 0xd8,
-	0xd2, 0xe6, 0xd8, 0xda, 0x65, 0x34, 0xd4, 0x72, 0x4d, 0x37, 0x31, 0x01, 0xa4, 0x83, 0xde, 0x07,
-	0x53, 0x5d, 0x78, 0xd2, 0xb7, 0x91, 0x8e, 0x1e, 0xa6, 0xa7, 0x52, 0x80, 0xc4, 0x34, 0x15, 0x44,
-	0x67, 0xfc, 0xab, 0x01, 0x66, 0x8b, 0xb1, 0x51, 0xc2, 0x97, 0xb5, 0x19, 0x33, 0x68, 0x7b, 0x1b,
-	0x8a, 0x89, 0xd9, 0x9e, 0x32, 0xb5, 0xe4, 0xfd, 0x85, 0xa5, 0x75, 0x1b, 0x56, 0x3f, 0xf0, 0x3c,
-	0x36, 0x08, 0x93, 0x21, 0xd5, 0xf1, 0xef, 0x0d, 0x58, 0x53, 0x1b, 0x9c, 0xf2, 0x68, 0xef, 0x02,
-	0xc4, 0x29, 0x77, 0x88, 0x2b, 0xa6, 0x4d, 0xb8, 0xa4, 0x20, 0x7b, 0xc4, 0x15, 0xa8, 0x05, 0xeb,
-	0x4a, 0xf3, 0xd8, 0x37, 0xd2, 0x2f, 0xa7, 0xdf, 0x55, 0x24, 0xb1, 0xbb, 0x17, 0xa0, 0x5a, 0xeb,
-	0xdf, 0x06, 0xac, 0xca, 0xad, 0x49, 0x49, 0x7d, 0x0f, 0x20, 0xe5, 0x4e, 0xa7, 0x94, 0x9a, 0x82,
-	0x5c, 0xca, 0xf5, 0xda, 0xa2, 0x72, 0xcd, 0xcf, 0x9b, 0xeb, 0x57, 0x79, 0xb8, 0xa9, 0x37, 0x2c,
-	0x95, 0x6e, 0x15, 0x8a, 0x38, 0x0e, 0xea, 0xf3, 0x21, 0x79, 0xbc, 0x54, 0x88, 0x6b, 0xf3, 0x16,
-	0x22, 0xbf, 0xa8, 0x42, 0x14, 0x66, 0x2e, 0x04, 0xda, 0x83, 0x15, 0x1f, 0x73, 0xe1, 0x24, 0xbe,
-	0x4c, 0x19, 0xfa, 0x29, 0xb8, 0xca, 0x12, 0x75, 0xa0, 0x41, 0x68, 0x07, 0x6e, 0x29, 0x16, 0x4e,
-	0x84, 0xf0, 0x49, 0x40, 0x42, 0xe1, 0xb8, 0x3e, 0xf3, 0x7a, 0xca, 0xc5, 0xe7, 0xed, 0x75, 0xb9,
-	0xd8, 0x1e, 0xad, 0x35, 0xe5, 0x52, 0xbc, 0x05, 0xcd, 0xc3, 0x47, 0x67, 0x35, 0xe3, 0xf1, 0x59,
-	0xcd, 0xf8, 0xf3, 0xac, 0x66, 0x7c, 0x7d, 0x5e, 0xcb, 0x3d, 0x3e, 0xaf, 0xe5, 0x7e, 0x3b, 0xaf,
-	0xe5, 0x3e, 0x6b, 0xa4, 0x4e, 0x39, 0xe2, 0x0f, 0x39, 0x1d, 0x04, 0x5c, 0x28, 0x47, 0x5b, 0x1f,
-	0xff, 0x32, 0x70, 0xaa, 0x7f, 0x1b, 0x50, 0x87, 0x9e, 0xbb, 0xa4, 0xbe, 0xcf, 0xbf, 0xf5, 0x6f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xb4, 0xb4, 0x41, 0x5a, 0x3b, 0x10, 0x00, 0x00,
+	// 1581 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0x4f, 0x6f, 0x1c, 0xc5,
+	0x12, 0xc0, 0x3d, 0xde, 0xb5, 0xd7, 0xae, 0xdd, 0xf5, 0x9f, 0xb6, 0x93, 0xb7, 0x89, 0x9e, 0xd6,
+	0x7e, 0x79, 0x7f, 0xe4, 0x17, 0xe9, 0xed, 0x3e, 0x87, 0x13, 0x39, 0x00, 0x59, 0x3b, 0x41, 0x26,
+	0x31, 0x5e, 0x76, 0x2d, 0xa4, 0x04, 0xa4, 0x51, 0xcf, 0x6c, 0xc7, 0x1e, 0x79, 0x76, 0x66, 0xe9,
+	0xee, 0xb1, 0xbc, 0x7c, 0x00, 0xce, 0x7c, 0x00, 0x90, 0xb8, 0x70, 0x20, 0x1c, 0xe0, 0xc2, 0x91,
+	0x1b, 0x87, 0x5c, 0x90, 0x72, 0x00, 0x09, 0x81, 0x14, 0x50, 0x72, 0xe1, 0xc6, 0x57, 0x40, 0xd5,
+	0xdd, 0x33, 0x3b, 0xb6, 0x37, 0xb0, 0xb3, 0x36, 0x51, 0x4e, 0x9e, 0xe9, 0x76, 0xfd, 0xba, 0xaa,
+	0xab, 0xba, 0xaa, 0x7a, 0x16, 0xaa, 0x8e, 0x4f, 0xdd, 0x83, 0xfb, 0x11, 0xef, 0xd7, 0xbb, 0xf4,
+	0x80, 0xf1, 0xfa, 0xe1, 0xba, 0x7e, 0xa8, 0xf5, 0x78, 0x28, 0x43, 0x42, 0x92, 0xf9, 0x9a, 0x1e,
+	0x3e, 0x5c, 0xbf, 0xbc, 0xbc, 0x17, 0xee, 0x85, 0x6a, 0xba, 0x8e, 0x4f, 0xfa, 0x3f, 0x2f, 0x57,
+	0xdd, 0x50, 0x74, 0x43, 0x51, 0x77, 0xa8, 0x60, 0xf5, 0xc3, 0x75, 0x87, 0x49, 0xba, 0x5e, 0x77,
+	0x43, 0x2f, 0xd0, 0xf3, 0x57, 0x3e, 0xce, 0xc3, 0x62, 0x83, 0xba, 0x07, 0x5e, 0xb0, 0xd7, 0xf2,
+	0xc4, 0x41, 0x93, 0x72, 0xda, 0x15, 0xe4, 0x9f, 0x50, 0x76, 0xf4, 0xa0, 0xdd, 0x61, 0x41, 0xd8,
+	0xad, 0x58, 0xab, 0xd6, 0xda, 0x6c, 0xab, 0x64, 0x06, 0x37, 0x71, 0x8c, 0x54, 0xa0, 0xc0, 0x02,
+	0xea, 0xf8, 0xac, 0x53, 0x99, 0x5c, 0xb5, 0xd6, 0x66, 0x5a, 0xf1, 0x2b, 0xb9, 0x0d, 0xc5, 0x2e,
+	0x3d, 0xb2, 0xcd, 0x7f, 0x57, 0x72, 0x28, 0xdc, 0xb8, 0xfa, 0xe3, 0xe3, 0x95, 0xff, 0xec, 0x79,
+	0x72, 0x3f, 0x72, 0x6a, 0x6e, 0xd8, 0xad, 0x1b, 0xc5, 0xf4, 0x9f, 0xff, 0x89, 0xce, 0x41, 0x5d,
+	0xf6, 0x7b, 0x4c, 0xd4, 0xb6, 0x02, 0xd9, 0x82, 0x2e, 0x3d, 0x32, 0x5a, 0x91, 0x26, 0xcc, 0x29,
+	0x18, 0x5a, 0x6c, 0x77, 0xbd, 0x40, 0x56, 0xf2, 0x99, 0x79, 0x25, 0xe4, 0x21, 0x60, 0xdb, 0x0b,
+	0x24, 0xb9, 0x09, 0x33, 0xc8, 0xb1, 0xef, 0x33, 0x56, 0x99, 0xca, 0xc4, 0xda, 0x64, 0x6e, 0xab,
+	0x80, 0xb2, 0xb7, 0x18, 0x43, 0x8c, 0x13, 0xf1, 0x40, 0x61, 0xa6, 0xb3, 0x63, 0x50, 0x16, 0x31,
+	0xb7, 0xa1, 0xe8, 0x44, 0x7d, 0xdc, 0x2b, 0x45, 0x2a, 0x64, 0x26, 0x81, 0x11, 0x47, 0xd8, 0x16,
+	0x00, 0x67, 0x09, 0x6b, 0x26, 0x33, 0x6b, 0x56, 0x4b, 0xdf, 0x62, 0xec, 0x7a, 0xfe, 0xd7, 0x4f,
+	0x56, 0x26, 0xae, 0xfc, 0x34, 0x0d, 0xcb, 0x1b, 0xa1, 0xef, 0x53, 0xc9, 0x38, 0xf5, 0x53, 0x21,
+	0xf2, 0x5f, 0x58, 0x70, 0x93, 0xf1, 0x63, 0x51, 0x32, 0x3f, 0x18, 0xff, 0xb3, 0x40, 0x79, 0x4b,
+	0xfb, 0x76, 0x20, 0x30, 0x46, 0xac, 0x94, 0xbb, 0xf4, 0x68, 0xa0, 0xe1, 0x5f, 0x10, 0x2e, 0x36,
+	0x5c, 0xf0, 0xbd, 0xf7, 0x22, 0xaf, 0x43, 0xa5, 0x17, 0x06, 0xb6, 0xdc, 0xe7, 0x4c, 0xec, 0x87,
+	0x7e, 0x67, 0x8c, 0xd8, 0x59, 0x4e, 0x81, 0x76, 0x63, 0x0e, 0x79, 0x13, 0xca, 0x7e, 0x48, 0x03,
+	0x5b, 0x86, 0xf6, 0x21, 0xf5, 0xa3, 0x71, 0xa2, 0xa9, 0x88, 0x80, 0xdd, 0xf0, 0x6d, 0x14, 0x27,
+	0x77, 0x61, 0xc9, 0xa1, 0xc2, 0x73, 0xed, 0xe3, 0xd4, 0xec, 0x91, 0xb5, 0xa0, 0x30, 0x77, 0x52,
+	0xe8, 0x77, 0x61, 0xd9, 0xa5, 0x92, 0xfa, 0x7d, 0xe9, 0xb9, 0x36, 0xe6, 0x1f, 0x9b, 0xa3, 0x31,
+	0x63, 0x44, 0x1a, 0x49, 0x38, 0xb7, 0x22, 0xde, 0x6f, 0x21, 0x85, 0xb4, 0x61, 0x3e, 0xbd, 0xd3,
+	0x18, 0xc2, 0xb3, 0x99, 0xc1, 0x73, 0x29, 0x84, 0x39, 0xa6, 0xc9, 0x69, 0x87, 0xf1, 0x4f, 0xfb,
+	0x36, 0x94, 0xbc, 0x40, 0x32, 0xce, 0x84, 0x46, 0x15, 0xb3, 0xfb, 0x28, 0x96, 0x1f, 0x9c, 0xae,
+	0x4f, 0x2d, 0xf8, 0x5b, 0x8b, 0xed, 0x79, 0x42, 0x32, 0x6e, 0xf2, 0x5d, 0x93, 0x87, 0xbd, 0x50,
+	0x50, 0x9f, 0x2c, 0xc3, 0x94, 0xf4, 0xa4, 0xcf, 0xcc, 0xa9, 0xd2, 0x2f, 0x64, 0x15, 0x8a, 0x1d,
+	0x26, 0x5c, 0xee, 0xf5, 0xd0, 0x3e, 0x75, 0x9e, 0x66, 0x5b, 0xe9, 0x21, 0x72, 0x07, 0x8a, 0xdc,
+	0x13, 0x07, 0x76, 0x4f, 0x9d, 0x53, 0x75, 0xa0, 0x8a, 0xd7, 0xfe, 0x5d, 0x3b, 0x5d, 0x31, 0x6a,
+	0xa7, 0xf2, 0x7e, 0x23, 0xff, 0xf0, 0xf1, 0xca, 0x44, 0x0b, 0x78, 0x32, 0x62, 0xf4, 0xfc, 0xdc,
+	0x82, 0xcb, 0xb1, 0x9e, 0x83, 0xb3, 0x76, 0x66, 0x55, 0x77, 0x86, 0xa9, 0xba, 0x36, 0x4c, 0xd5,
+	0x61, 0x29, 0xe8, 0x99, 0xda, 0x3e, 0xb0, 0xe0, 0xef, 0x6d, 0x26, 0x4f, 0x99, 0xf7, 0x42, 0x6e,
+	0xed, 0x97, 0x16, 0xac, 0xb4, 0x99, 0x1c, 0x66, 0xe0, 0x8b, 0xba, 0xbf, 0x3e, 0x5c, 0x6c, 0x50,
+	0xe9, 0xee, 0x9f, 0xee, 0x1b, 0x4e, 0x6c, 0x90, 0xb5, 0x9a, 0x3b, 0xfb, 0x06, 0x7d, 0x61, 0xc1,
+	0x3f, 0xd4, 0x72, 0xcf, 0xc7, 0xa5, 0xe7, 0xa0, 0x31, 0x87, 0x4b, 0x4a, 0xe1, 0xa1, 0x75, 0x73,
+	0x67, 0xd8, 0x16, 0x9d, 0xdd, 0x27, 0x5f, 0x59, 0xf0, 0xaf, 0x78, 0x97, 0x9e, 0x4f, 0x2c, 0x9d,
+	0x8f, 0xde, 0xdf, 0x58, 0xd8, 0x5f, 0x1c, 0x62, 0xfa, 0xeb, 0x6c, 0x32, 0x47, 0x9e, 0x59, 0xcf,
+	0x61, 0x7d, 0x49, 0x6e, 0x78, 0x5f, 0xb2, 0x01, 0xd8, 0x3b, 0xe8, 0x32, 0x96, 0x74, 0x0a, 0xc5,
+	0x6b, 0x97, 0x6a, 0x3a, 0x7d, 0xd7, 0x1c, 0x2a, 0x58, 0xcd, 0xf4, 0xcc, 0xb5, 0x8d, 0xd0, 0x0b,
+	0x8c, 0x15, 0xd8, 0xdc, 0x62, 0xd1, 0xc2, 0xee, 0xc0, 0x98, 0xf1, 0x9b, 0x05, 0xa5, 0xdd, 0x50,
+	0x52, 0x3f, 0xee, 0x5a, 0xdb, 0x83, 0x0e, 0x5a, 0x57, 0x5f, 0x65, 0x46, 0xa3, 0x86, 0x80, 0x2c,
+	0x9d, 0x88, 0x81, 0xe8, 0xea, 0xdb, 0x80, 0xd2, 0xa0, 0xaf, 0x31, 0xdd, 0xd4, 0x28, 0xfa, 0x3a,
+	0x71, 0x2f, 0xc3, 0x3a, 0xe4, 0x35, 0x28, 0x2a, 0x83, 0xb1, 0xfd, 0x64, 0x9d, 0x4a, 0x6e, 0x34,
+	0x04, 0xa0, 0x4c, 0x43, 0x89, 0x18, 0x8b, 0xbf, 0xb3, 0xa0, 0xd8, 0x0c, 0xc3, 0xc4, 0xe0, 0x93,
+	0xba, 0x59, 0x63, 0xe8, 0xf6, 0x32, 0x14, 0xe2, 0x3b, 0xc3, 0x88, 0xa6, 0xc5, 0xff, 0x7f, 0x6e,
+	0x66, 0x5d, 0x84, 0xb9, 0x1b, 0xae, 0x1b, 0x46, 0x41, 0x9c, 0x6b, 0xcc, 0xf8, 0x67, 0x16, 0xcc,
+	0x2b, 0x07, 0xa7, 0x5a, 0xcd, 0x57, 0x00, 0xb4, 0xc9, 0x1d, 0xe6, 0xc8, 0x51, 0x0d, 0x9e, 0x55,
+	0x22, 0x18, 0xea, 0xa4, 0x09, 0x4b, 0x4a, 0xe7, 0x41, 0x5c, 0x7a, 0xef, 0x8f, 0xee, 0x55, 0x82,
+	0xb2, 0x1b, 0xc7, 0x44, 0x8d, 0xae, 0x5f, 0xe7, 0x60, 0x0e, 0x5d, 0x93, 0x52, 0xf5, 0x55, 0x80,
+	0xc1, 0x2a, 0xa3, 0xaa, 0x0a, 0xee, 0xb3, 0x6c, 0x9d, 0x3c, 0x2f, 0x5b, 0x73, 0x63, 0xdb, 0x8a,
+	0x77, 0x87, 0xa4, 0x21, 0xf3, 0x82, 0x0e, 0x3b, 0xaa, 0xe4, 0x33, 0xb7, 0x64, 0xe5, 0x98, 0xb0,
+	0x85, 0x00, 0x72, 0x15, 0x16, 0x7d, 0x2a, 0xa4, 0x4d, 0x5d, 0x97, 0x47, 0xd4, 0xb7, 0xa5, 0xd7,
+	0xd5, 0x37, 0xc4, 0x5c, 0x6b, 0x1e, 0x27, 0x6e, 0xe8, 0xf1, 0x5d, 0xaf, 0xcb, 0xb0, 0x57, 0x0d,
+	0x42, 0xde, 0xd5, 0xca, 0xe8, 0x5d, 0xc9, 0xde, 0xb6, 0xcf, 0x0d, 0x10, 0xb8, 0x4b, 0xc6, 0x7f,
+	0xdf, 0xe7, 0x60, 0xd1, 0x04, 0x61, 0xca, 0x85, 0x15, 0x28, 0x50, 0x3d, 0x68, 0x52, 0x62, 0xfc,
+	0x7a, 0xc2, 0xb9, 0x93, 0x67, 0x75, 0x6e, 0xee, 0xbc, 0x9c, 0x9b, 0x1f, 0xdf, 0xb9, 0x9b, 0x50,
+	0x56, 0x9e, 0x88, 0xfd, 0x53, 0x99, 0x1a, 0x8d, 0x55, 0x42, 0xa9, 0x2d, 0x23, 0x44, 0xae, 0xc1,
+	0x05, 0x45, 0x11, 0x4c, 0x4a, 0x9f, 0x75, 0x59, 0x20, 0x6d, 0xc7, 0x0f, 0xdd, 0x03, 0xe5, 0xa9,
+	0x5c, 0x6b, 0x09, 0x27, 0xdb, 0xc9, 0x5c, 0x03, 0xa7, 0x86, 0xf9, 0xb5, 0x70, 0x4e, 0x7e, 0xfd,
+	0xa8, 0x00, 0x73, 0xbb, 0x9c, 0x51, 0x11, 0xf1, 0xfe, 0x1d, 0xd6, 0xd9, 0x63, 0x1c, 0xab, 0x5c,
+	0xfa, 0xea, 0xac, 0x5f, 0x08, 0x83, 0x82, 0x88, 0x78, 0xcf, 0x8f, 0x44, 0x65, 0x72, 0x35, 0xf7,
+	0xc7, 0x76, 0xff, 0x1f, 0xed, 0x7e, 0xf0, 0xf3, 0xca, 0xda, 0x08, 0xaa, 0xa1, 0x80, 0x68, 0xc5,
+	0x6c, 0xd2, 0x83, 0xb2, 0xc4, 0x94, 0x66, 0xc7, 0x8b, 0xe5, 0xce, 0x7f, 0xb1, 0x92, 0x5a, 0xa1,
+	0x6d, 0x56, 0xbc, 0x0e, 0x33, 0x0e, 0x35, 0xbb, 0x9a, 0x1f, 0x39, 0xc3, 0xab, 0x3d, 0x24, 0x37,
+	0x61, 0x4e, 0x6b, 0x9b, 0x10, 0x46, 0x8d, 0x09, 0xa9, 0x0b, 0xb3, 0xc6, 0x6c, 0x03, 0x71, 0xb1,
+	0xdf, 0x60, 0x1d, 0xdb, 0xe9, 0x27, 0x96, 0x4f, 0x8f, 0x86, 0x5a, 0x30, 0xa2, 0x8d, 0x7e, 0x6c,
+	0xd1, 0xeb, 0x30, 0x9f, 0xc2, 0x61, 0x24, 0x57, 0x0a, 0xa3, 0xb1, 0xca, 0x09, 0x0b, 0x7b, 0x89,
+	0xa4, 0x80, 0x99, 0xf2, 0x39, 0x93, 0xa1, 0x80, 0x99, 0xea, 0xb9, 0x02, 0x45, 0x2a, 0x04, 0x93,
+	0xb6, 0xeb, 0x53, 0x21, 0xf4, 0xcd, 0xb9, 0x05, 0x6a, 0x68, 0x03, 0x47, 0xb0, 0x44, 0xab, 0x25,
+	0x38, 0x13, 0x8c, 0x1f, 0xea, 0xdb, 0xf0, 0x28, 0x25, 0x1a, 0x85, 0x5a, 0x5a, 0x26, 0x51, 0x53,
+	0x30, 0x75, 0xc4, 0x8b, 0x19, 0xd4, 0x6c, 0x2b, 0x91, 0x93, 0x95, 0xba, 0x94, 0xb9, 0x52, 0x93,
+	0x1d, 0x58, 0x4a, 0xe9, 0x90, 0x98, 0x53, 0x1e, 0x8d, 0xb4, 0x38, 0xd0, 0xc5, 0x18, 0x15, 0x97,
+	0xf8, 0x3c, 0xcc, 0x9b, 0xb4, 0xdb, 0x0c, 0x85, 0xa7, 0xba, 0xc9, 0x7b, 0x40, 0x4c, 0x96, 0xb5,
+	0x4f, 0xd5, 0xcf, 0xa1, 0xb7, 0x84, 0x53, 0x79, 0x3b, 0x5e, 0x95, 0x9e, 0x9c, 0x20, 0x77, 0x8f,
+	0x75, 0xaa, 0xba, 0x4b, 0x9c, 0xcc, 0xdc, 0x25, 0x62, 0xba, 0x49, 0x75, 0xb6, 0xba, 0x51, 0xdc,
+	0x06, 0xc0, 0x13, 0x62, 0xa0, 0xb9, 0xb1, 0xa0, 0xb3, 0x48, 0xd0, 0xb8, 0xd6, 0xc9, 0x0f, 0x54,
+	0xf9, 0xb1, 0x88, 0x27, 0x3e, 0x52, 0x2d, 0x62, 0xf3, 0x7d, 0x9c, 0x3b, 0x35, 0x16, 0x17, 0x3f,
+	0xf8, 0xa5, 0x3f, 0x52, 0xbd, 0x03, 0x8b, 0xe9, 0xcf, 0x48, 0x3d, 0xee, 0xb9, 0xf1, 0x37, 0xb5,
+	0xac, 0xe8, 0x85, 0x14, 0xa8, 0x89, 0x1c, 0x13, 0x2b, 0xdf, 0x5a, 0xb0, 0xa0, 0xde, 0x77, 0x1c,
+	0x0c, 0x21, 0x35, 0x4f, 0x08, 0xe4, 0x55, 0xc7, 0x60, 0xa9, 0xea, 0xa2, 0x9e, 0xc9, 0x26, 0x4c,
+	0xe9, 0xf5, 0xc7, 0xf3, 0xac, 0x16, 0xc6, 0x50, 0x51, 0x0f, 0xb6, 0x1b, 0x75, 0x23, 0x9f, 0x4a,
+	0xef, 0x70, 0x5c, 0xaf, 0xce, 0x2b, 0xce, 0x46, 0x82, 0x31, 0xf6, 0x7c, 0x30, 0x09, 0xa4, 0xb9,
+	0xb5, 0xb9, 0x11, 0x06, 0x92, 0x87, 0xbe, 0xcf, 0x78, 0x5b, 0x52, 0xc9, 0xc8, 0x1b, 0x30, 0x83,
+	0xb9, 0x65, 0x2f, 0x0e, 0xfa, 0xec, 0xeb, 0x25, 0xf2, 0x18, 0x93, 0xaa, 0x18, 0x33, 0xce, 0x43,
+	0x3e, 0xe6, 0x76, 0xcc, 0x22, 0xe1, 0x26, 0x02, 0xf0, 0x3e, 0xaa, 0x70, 0x22, 0xea, 0xf5, 0xfc,
+	0x7e, 0x25, 0x37, 0xd6, 0xf5, 0x4a, 0x69, 0xd4, 0x56, 0x04, 0xbd, 0x11, 0x8d, 0xdb, 0x0f, 0x9f,
+	0x54, 0xad, 0x47, 0x4f, 0xaa, 0xd6, 0x2f, 0x4f, 0xaa, 0xd6, 0x87, 0x4f, 0xab, 0x13, 0x8f, 0x9e,
+	0x56, 0x27, 0x7e, 0x78, 0x5a, 0x9d, 0xb8, 0xb7, 0x9e, 0x62, 0x32, 0xbf, 0x2f, 0xbc, 0xa8, 0x2b,
+	0xa4, 0x72, 0x7c, 0x7d, 0xf0, 0x6b, 0xcd, 0x91, 0xf9, 0xbd, 0x46, 0x2d, 0xe1, 0x4c, 0xab, 0xdf,
+	0x58, 0x5e, 0xfa, 0x7d, 0x00, 0x5c, 0x2d, 0xf3, 0x1d, 0xcf, 0x19, 0x00, 0x00,
 }
 
 func (m *BackingRiskParams) Marshal() (dAtA []byte, err error) {
@@ -1728,7 +2165,7 @@ func (m *BatchSetCollateralRiskParamsProposal) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *TotalBacking) Marshal() (dAtA []byte, err error) {
+func (m *CoverBadDebtProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1738,18 +2175,18 @@ func (m *TotalBacking) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TotalBacking) MarshalTo(dAtA []byte) (int, error) {
+func (m *CoverBadDebtProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TotalBacking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CoverBadDebtProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.FuryBurned.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.MaxFuryMint.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1757,29 +2194,83 @@ func (m *TotalBacking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintMaker(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.BlackMinted.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMaker(dAtA, i, uint64(size))
+	dAtA[i] = 0x22
+	if len(m.CollateralDenom) > 0 {
+		i -= len(m.CollateralDenom)
+		copy(dAtA[i:], m.CollateralDenom)
+		i = encodeVarintMaker(dAtA, i, uint64(len(m.CollateralDenom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.BackingValue.Size()
-		i -= size
-		if _, err := m.BackingValue.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMaker(dAtA, i, uint64(size))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintMaker(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintMaker(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TotalBacking) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TotalBacking) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TotalBacking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FuryBurned.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.BlackMinted.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.BackingValue.Size()
+		i -= size
+		if _, err := m.BackingValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
 
 func (m *PoolBacking) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -1920,6 +2411,35 @@ func (m *PoolCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NormalizedDebt != nil {
+		{
+			size := m.NormalizedDebt.Size()
+			i -= size
+			if _, err := m.NormalizedDebt.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintMaker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LastAccrualTime != 0 {
+		i = encodeVarintMaker(dAtA, i, uint64(m.LastAccrualTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InterestIndex != nil {
+		{
+			size := m.InterestIndex.Size()
+			i -= size
+			if _, err := m.InterestIndex.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintMaker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	{
 		size, err := m.FuryCollateralized.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1973,6 +2493,18 @@ func (m *AccountCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NormalizedDebt != nil {
+		{
+			size := m.NormalizedDebt.Size()
+			i -= size
+			if _, err := m.NormalizedDebt.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintMaker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.LastSettlementBlock != 0 {
 		i = encodeVarintMaker(dAtA, i, uint64(m.LastSettlementBlock))
 		i--
@@ -2028,85 +2560,424 @@ func (m *AccountCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMaker(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMaker(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TreasuryLedger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *BackingRiskParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TreasuryLedger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreasuryLedger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BackingDenom)
-	if l > 0 {
-		n += 1 + l + sovMaker(uint64(l))
-	}
-	if m.Enabled {
-		n += 2
-	}
-	if m.MaxBacking != nil {
-		l = m.MaxBacking.Size()
-		n += 1 + l + sovMaker(uint64(l))
+	{
+		size, err := m.FurySeizedReserve.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMaker(dAtA, i, uint64(size))
 	}
-	if m.MaxBlackMint != nil {
-		l = m.MaxBlackMint.Size()
-		n += 1 + l + sovMaker(uint64(l))
+	i--
+	dAtA[i] = 0x6a
+	{
+		size, err := m.FuryBurned.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMaker(dAtA, i, uint64(size))
 	}
-	if m.MintFee != nil {
-		l = m.MintFee.Size()
-		n += 1 + l + sovMaker(uint64(l))
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.FurySeized.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMaker(dAtA, i, uint64(size))
 	}
-	if m.BurnFee != nil {
-		l = m.BurnFee.Size()
-		n += 1 + l + sovMaker(uint64(l))
+	i--
+	dAtA[i] = 0x5a
+	{
+		size, err := m.FuryReserve.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMaker(dAtA, i, uint64(size))
 	}
-	if m.BuybackFee != nil {
-		l = m.BuybackFee.Size()
-		n += 1 + l + sovMaker(uint64(l))
+	i--
+	dAtA[i] = 0x52
+	if len(m.AssetClass) > 0 {
+		i -= len(m.AssetClass)
+		copy(dAtA[i:], m.AssetClass)
+		i = encodeVarintMaker(dAtA, i, uint64(len(m.AssetClass)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.RebackFee != nil {
-		l = m.RebackFee.Size()
-		n += 1 + l + sovMaker(uint64(l))
+	{
+		size, err := m.FuryMinted.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMaker(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *CollateralRiskParams) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.CoveredByFury.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMaker(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.CollateralDenom)
-	if l > 0 {
-		n += 1 + l + sovMaker(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.CoveredBySurplus.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMaker(dAtA, i, uint64(size))
 	}
-	if m.Enabled {
-		n += 2
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.TotalBadDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMaker(dAtA, i, uint64(size))
 	}
-	if m.MaxCollateral != nil {
-		l = m.MaxCollateral.Size()
-		n += 1 + l + sovMaker(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.BadDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMaker(dAtA, i, uint64(size))
 	}
-	if m.MaxBlackMint != nil {
-		l = m.MaxBlackMint.Size()
-		n += 1 + l + sovMaker(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if len(m.TotalSurplus) > 0 {
+		for iNdEx := len(m.TotalSurplus) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalSurplus[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMaker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.LiquidationThreshold != nil {
-		l = m.LiquidationThreshold.Size()
-		n += 1 + l + sovMaker(uint64(l))
+	if len(m.Surplus) > 0 {
+		for iNdEx := len(m.Surplus) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Surplus[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMaker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.LoanToValue != nil {
-		l = m.LoanToValue.Size()
-		n += 1 + l + sovMaker(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMaker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LiquidationPrice.Size()
+		i -= size
+		if _, err := m.LiquidationPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxLoanToValue.Size()
+		i -= size
+		if _, err := m.MaxLoanToValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.LoanToValue.Size()
+		i -= size
+		if _, err := m.LoanToValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.DebtValue.Size()
+		i -= size
+		if _, err := m.DebtValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CollateralValue.Size()
+		i -= size
+		if _, err := m.CollateralValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.AccountCollateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceObservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceObservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PriceCumulative.Size()
+		i -= size
+		if _, err := m.PriceCumulative.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Time != 0 {
+		i = encodeVarintMaker(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PIDControllerState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PIDControllerState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PIDControllerState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LastSupply.Size()
+		i -= size
+		if _, err := m.LastSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LastError.Size()
+		i -= size
+		if _, err := m.LastError.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Integral.Size()
+		i -= size
+		if _, err := m.Integral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMaker(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMaker(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BackingRiskParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BackingDenom)
+	if l > 0 {
+		n += 1 + l + sovMaker(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.MaxBacking != nil {
+		l = m.MaxBacking.Size()
+		n += 1 + l + sovMaker(uint64(l))
+	}
+	if m.MaxBlackMint != nil {
+		l = m.MaxBlackMint.Size()
+		n += 1 + l + sovMaker(uint64(l))
+	}
+	if m.MintFee != nil {
+		l = m.MintFee.Size()
+		n += 1 + l + sovMaker(uint64(l))
+	}
+	if m.BurnFee != nil {
+		l = m.BurnFee.Size()
+		n += 1 + l + sovMaker(uint64(l))
+	}
+	if m.BuybackFee != nil {
+		l = m.BuybackFee.Size()
+		n += 1 + l + sovMaker(uint64(l))
+	}
+	if m.RebackFee != nil {
+		l = m.RebackFee.Size()
+		n += 1 + l + sovMaker(uint64(l))
+	}
+	return n
+}
+
+func (m *CollateralRiskParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralDenom)
+	if l > 0 {
+		n += 1 + l + sovMaker(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.MaxCollateral != nil {
+		l = m.MaxCollateral.Size()
+		n += 1 + l + sovMaker(uint64(l))
+	}
+	if m.MaxBlackMint != nil {
+		l = m.MaxBlackMint.Size()
+		n += 1 + l + sovMaker(uint64(l))
+	}
+	if m.LiquidationThreshold != nil {
+		l = m.LiquidationThreshold.Size()
+		n += 1 + l + sovMaker(uint64(l))
+	}
+	if m.LoanToValue != nil {
+		l = m.LoanToValue.Size()
+		n += 1 + l + sovMaker(uint64(l))
 	}
 	if m.BasicLoanToValue != nil {
 		l = m.BasicLoanToValue.Size()
@@ -2283,6 +3154,29 @@ func (m *BatchSetCollateralRiskParamsProposal) Size() (n int) {
 	return n
 }
 
+func (m *CoverBadDebtProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovMaker(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovMaker(uint64(l))
+	}
+	l = len(m.CollateralDenom)
+	if l > 0 {
+		n += 1 + l + sovMaker(uint64(l))
+	}
+	l = m.MaxFuryMint.Size()
+	n += 1 + l + sovMaker(uint64(l))
+	return n
+}
+
 func (m *TotalBacking) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2347,8 +3241,19 @@ func (m *PoolCollateral) Size() (n int) {
 	n += 1 + l + sovMaker(uint64(l))
 	l = m.FuryCollateralized.Size()
 	n += 1 + l + sovMaker(uint64(l))
-	return n
-}
+	if m.InterestIndex != nil {
+		l = m.InterestIndex.Size()
+		n += 1 + l + sovMaker(uint64(l))
+	}
+	if m.LastAccrualTime != 0 {
+		n += 1 + sovMaker(uint64(m.LastAccrualTime))
+	}
+	if m.NormalizedDebt != nil {
+		l = m.NormalizedDebt.Size()
+		n += 1 + l + sovMaker(uint64(l))
+	}
+	return n
+}
 
 func (m *AccountCollateral) Size() (n int) {
 	if m == nil {
@@ -2371,6 +3276,109 @@ func (m *AccountCollateral) Size() (n int) {
 	if m.LastSettlementBlock != 0 {
 		n += 1 + sovMaker(uint64(m.LastSettlementBlock))
 	}
+	if m.NormalizedDebt != nil {
+		l = m.NormalizedDebt.Size()
+		n += 1 + l + sovMaker(uint64(l))
+	}
+	return n
+}
+
+func (m *TreasuryLedger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMaker(uint64(l))
+	}
+	if len(m.Surplus) > 0 {
+		for _, e := range m.Surplus {
+			l = e.Size()
+			n += 1 + l + sovMaker(uint64(l))
+		}
+	}
+	if len(m.TotalSurplus) > 0 {
+		for _, e := range m.TotalSurplus {
+			l = e.Size()
+			n += 1 + l + sovMaker(uint64(l))
+		}
+	}
+	l = m.BadDebt.Size()
+	n += 1 + l + sovMaker(uint64(l))
+	l = m.TotalBadDebt.Size()
+	n += 1 + l + sovMaker(uint64(l))
+	l = m.CoveredBySurplus.Size()
+	n += 1 + l + sovMaker(uint64(l))
+	l = m.CoveredByFury.Size()
+	n += 1 + l + sovMaker(uint64(l))
+	l = m.FuryMinted.Size()
+	n += 1 + l + sovMaker(uint64(l))
+	l = len(m.AssetClass)
+	if l > 0 {
+		n += 1 + l + sovMaker(uint64(l))
+	}
+	l = m.FuryReserve.Size()
+	n += 1 + l + sovMaker(uint64(l))
+	l = m.FurySeized.Size()
+	n += 1 + l + sovMaker(uint64(l))
+	l = m.FuryBurned.Size()
+	n += 1 + l + sovMaker(uint64(l))
+	l = m.FurySeizedReserve.Size()
+	n += 1 + l + sovMaker(uint64(l))
+	return n
+}
+
+func (m *AccountPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AccountCollateral.Size()
+	n += 1 + l + sovMaker(uint64(l))
+	l = m.CollateralValue.Size()
+	n += 1 + l + sovMaker(uint64(l))
+	l = m.DebtValue.Size()
+	n += 1 + l + sovMaker(uint64(l))
+	l = m.LoanToValue.Size()
+	n += 1 + l + sovMaker(uint64(l))
+	l = m.MaxLoanToValue.Size()
+	n += 1 + l + sovMaker(uint64(l))
+	l = m.LiquidationPrice.Size()
+	n += 1 + l + sovMaker(uint64(l))
+	return n
+}
+
+func (m *PriceObservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Time != 0 {
+		n += 1 + sovMaker(uint64(m.Time))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovMaker(uint64(l))
+	l = m.PriceCumulative.Size()
+	n += 1 + l + sovMaker(uint64(l))
+	return n
+}
+
+func (m *PIDControllerState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Integral.Size()
+	n += 1 + l + sovMaker(uint64(l))
+	l = m.LastError.Size()
+	n += 1 + l + sovMaker(uint64(l))
+	l = m.LastSupply.Size()
+	n += 1 + l + sovMaker(uint64(l))
 	return n
 }
 
@@ -3124,7 +4132,1587 @@ func (m *CollateralRiskParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterBackingProposal) Unmarshal(dAtA []byte) error {
+func (m *RegisterBackingProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterBackingProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterBackingProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RiskParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RiskParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterCollateralProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterCollateralProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterCollateralProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RiskParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RiskParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetBackingRiskParamsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetBackingRiskParamsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetBackingRiskParamsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RiskParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RiskParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetCollateralRiskParamsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetCollateralRiskParamsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetCollateralRiskParamsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RiskParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RiskParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchBackingRiskParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchBackingRiskParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchBackingRiskParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RiskParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RiskParams = append(m.RiskParams, BackingRiskParams{})
+			if err := m.RiskParams[len(m.RiskParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchSetBackingRiskParamsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchSetBackingRiskParamsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchSetBackingRiskParamsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RiskParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RiskParams = append(m.RiskParams, BackingRiskParams{})
+			if err := m.RiskParams[len(m.RiskParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchCollateralRiskParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchCollateralRiskParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchCollateralRiskParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RiskParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RiskParams = append(m.RiskParams, CollateralRiskParams{})
+			if err := m.RiskParams[len(m.RiskParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchSetCollateralRiskParamsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchSetCollateralRiskParamsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchSetCollateralRiskParamsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RiskParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RiskParams = append(m.RiskParams, CollateralRiskParams{})
+			if err := m.RiskParams[len(m.RiskParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CoverBadDebtProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoverBadDebtProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoverBadDebtProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFuryMint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFuryMint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TotalBacking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TotalBacking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalBacking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BackingValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlackMinted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlackMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FuryBurned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FuryBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolBacking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolBacking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolBacking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlackMinted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlackMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Backing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FuryBurned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FuryBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountBacking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountBacking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountBacking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TotalCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3147,17 +5735,17 @@ func (m *RegisterBackingProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterBackingProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: TotalCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterBackingProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TotalCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlackDebt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaker
@@ -3167,59 +5755,28 @@ func (m *RegisterBackingProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMaker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMaker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMaker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMaker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.BlackDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RiskParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FuryCollateralized", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3246,7 +5803,7 @@ func (m *RegisterBackingProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RiskParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FuryCollateralized.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3271,7 +5828,7 @@ func (m *RegisterBackingProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterCollateralProposal) Unmarshal(dAtA []byte) error {
+func (m *PoolCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3294,17 +5851,17 @@ func (m *RegisterCollateralProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterCollateralProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterCollateralProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaker
@@ -3314,29 +5871,30 @@ func (m *RegisterCollateralProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMaker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlackDebt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaker
@@ -3346,27 +5904,28 @@ func (m *RegisterCollateralProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMaker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if err := m.BlackDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RiskParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FuryCollateralized", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3393,63 +5952,13 @@ func (m *RegisterCollateralProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RiskParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FuryCollateralized.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMaker(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMaker
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SetBackingRiskParamsProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMaker
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SetBackingRiskParamsProposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetBackingRiskParamsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestIndex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3477,13 +5986,17 @@ func (m *SetBackingRiskParamsProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.InterestIndex = &v
+			if err := m.InterestIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAccrualTime", wireType)
 			}
-			var stringLen uint64
+			m.LastAccrualTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaker
@@ -3493,29 +6006,16 @@ func (m *SetBackingRiskParamsProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LastAccrualTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMaker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMaker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RiskParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedDebt", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaker
@@ -3525,22 +6025,25 @@ func (m *SetBackingRiskParamsProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMaker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RiskParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.NormalizedDebt = &v
+			if err := m.NormalizedDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3565,7 +6068,7 @@ func (m *SetBackingRiskParamsProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetCollateralRiskParamsProposal) Unmarshal(dAtA []byte) error {
+func (m *AccountCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3588,17 +6091,82 @@ func (m *SetCollateralRiskParamsProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetCollateralRiskParamsProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetCollateralRiskParamsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlackDebt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaker
@@ -3608,29 +6176,30 @@ func (m *SetCollateralRiskParamsProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMaker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			if err := m.BlackDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FuryCollateralized", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaker
@@ -3640,27 +6209,28 @@ func (m *SetCollateralRiskParamsProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMaker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if err := m.FuryCollateralized.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RiskParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInterest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3687,65 +6257,34 @@ func (m *SetCollateralRiskParamsProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RiskParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastInterest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMaker(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMaker
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BatchBackingRiskParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMaker
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSettlementBlock", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.LastSettlementBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSettlementBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BatchBackingRiskParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchBackingRiskParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RiskParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedDebt", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaker
@@ -3755,23 +6294,25 @@ func (m *BatchBackingRiskParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMaker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RiskParams = append(m.RiskParams, BackingRiskParams{})
-			if err := m.RiskParams[len(m.RiskParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.NormalizedDebt = &v
+			if err := m.NormalizedDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3796,7 +6337,7 @@ func (m *BatchBackingRiskParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchSetBackingRiskParamsProposal) Unmarshal(dAtA []byte) error {
+func (m *TreasuryLedger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3819,15 +6360,15 @@ func (m *BatchSetBackingRiskParamsProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchSetBackingRiskParamsProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: TreasuryLedger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchSetBackingRiskParamsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TreasuryLedger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3855,13 +6396,13 @@ func (m *BatchSetBackingRiskParamsProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Surplus", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaker
@@ -3871,27 +6412,29 @@ func (m *BatchSetBackingRiskParamsProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMaker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Surplus = append(m.Surplus, types.Coin{})
+			if err := m.Surplus[len(m.Surplus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RiskParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSurplus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3918,64 +6461,14 @@ func (m *BatchSetBackingRiskParamsProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RiskParams = append(m.RiskParams, BackingRiskParams{})
-			if err := m.RiskParams[len(m.RiskParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalSurplus = append(m.TotalSurplus, types.Coin{})
+			if err := m.TotalSurplus[len(m.TotalSurplus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMaker(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMaker
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BatchCollateralRiskParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMaker
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BatchCollateralRiskParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchCollateralRiskParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RiskParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3997,71 +6490,20 @@ func (m *BatchCollateralRiskParams) Unmarshal(dAtA []byte) error {
 			}
 			postIndex := iNdEx + msglen
 			if postIndex < 0 {
-				return ErrInvalidLengthMaker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RiskParams = append(m.RiskParams, CollateralRiskParams{})
-			if err := m.RiskParams[len(m.RiskParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMaker(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMaker
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BatchSetCollateralRiskParamsProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMaker
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BatchSetCollateralRiskParamsProposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchSetCollateralRiskParamsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBadDebt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaker
@@ -4071,29 +6513,30 @@ func (m *BatchSetCollateralRiskParamsProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMaker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalBadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoveredBySurplus", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaker
@@ -4103,27 +6546,28 @@ func (m *BatchSetCollateralRiskParamsProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMaker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if err := m.CoveredBySurplus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RiskParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoveredByFury", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4150,66 +6594,15 @@ func (m *BatchSetCollateralRiskParamsProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RiskParams = append(m.RiskParams, CollateralRiskParams{})
-			if err := m.RiskParams[len(m.RiskParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CoveredByFury.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMaker(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMaker
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TotalBacking) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMaker
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TotalBacking: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalBacking: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackingValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FuryMinted", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaker
@@ -4219,31 +6612,30 @@ func (m *TotalBacking) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMaker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BackingValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FuryMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlackMinted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetClass", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaker
@@ -4253,28 +6645,27 @@ func (m *TotalBacking) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMaker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlackMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AssetClass = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FuryBurned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FuryReserve", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4301,63 +6692,13 @@ func (m *TotalBacking) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FuryBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FuryReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMaker(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMaker
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PoolBacking) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMaker
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PoolBacking: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolBacking: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlackMinted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FurySeized", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4384,13 +6725,13 @@ func (m *PoolBacking) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlackMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FurySeized.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Backing", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FuryBurned", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4417,13 +6758,13 @@ func (m *PoolBacking) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Backing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FuryBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FuryBurned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FurySeizedReserve", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4450,7 +6791,7 @@ func (m *PoolBacking) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FuryBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FurySeizedReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4475,7 +6816,7 @@ func (m *PoolBacking) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountBacking) Unmarshal(dAtA []byte) error {
+func (m *AccountPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4498,67 +6839,50 @@ func (m *AccountBacking) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountBacking: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountPosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountBacking: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountPosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMaker(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountCollateral", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMaker
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthMaker
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TotalCollateral) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMaker
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.AccountCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TotalCollateral: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlackDebt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralValue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaker
@@ -4568,30 +6892,31 @@ func (m *TotalCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMaker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlackDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CollateralValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FuryCollateralized", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtValue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaker
@@ -4601,80 +6926,31 @@ func (m *TotalCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMaker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FuryCollateralized.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DebtValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMaker(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMaker
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PoolCollateral) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMaker
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PoolCollateral: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LoanToValue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaker
@@ -4684,30 +6960,31 @@ func (m *PoolCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMaker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LoanToValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlackDebt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLoanToValue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaker
@@ -4717,30 +6994,31 @@ func (m *PoolCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMaker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlackDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxLoanToValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FuryCollateralized", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaker
@@ -4750,22 +7028,23 @@ func (m *PoolCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMaker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FuryCollateralized.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidationPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4790,7 +7069,7 @@ func (m *PoolCollateral) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountCollateral) Unmarshal(dAtA []byte) error {
+func (m *PriceObservation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4813,17 +7092,17 @@ func (m *AccountCollateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountCollateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceObservation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceObservation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			m.Time = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaker
@@ -4833,29 +7112,16 @@ func (m *AccountCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Time |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMaker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMaker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaker
@@ -4865,30 +7131,31 @@ func (m *AccountCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMaker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlackDebt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceCumulative", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaker
@@ -4898,30 +7165,81 @@ func (m *AccountCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMaker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlackDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PriceCumulative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PIDControllerState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PIDControllerState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PIDControllerState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FuryCollateralized", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Integral", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaker
@@ -4931,30 +7249,31 @@ func (m *AccountCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMaker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FuryCollateralized.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Integral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastInterest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaker
@@ -4964,30 +7283,31 @@ func (m *AccountCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMaker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastInterest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastSettlementBlock", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSupply", wireType)
 			}
-			m.LastSettlementBlock = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaker
@@ -4997,11 +7317,26 @@ func (m *AccountCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastSettlementBlock |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMaker(dAtA[iNdEx:])
@@ -1,9 +1,13 @@
 package types
 
 import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	blackfury "github.com/elysiumstation/blackfury/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 )
 
 const (
@@ -16,6 +20,9 @@ const (
 	TypeMsgBuyBacking          = "buy_backing"
 	TypeMsgSellBacking         = "sell_backing"
 	TypeMsgLiquidateCollateral = "liquidate_collateral"
+	TypeMsgSetCrossMargin      = "set_cross_margin"
+	TypeMsgFlashMint           = "flash_mint"
+	TypeMsgBuyFury             = "buy_fury"
 )
 
 var (
@@ -28,6 +35,11 @@ var (
 	_ sdk.Msg = &MsgBuyBacking{}
 	_ sdk.Msg = &MsgSellBacking{}
 	_ sdk.Msg = &MsgLiquidateCollateral{}
+	_ sdk.Msg = &MsgSetCrossMargin{}
+	_ sdk.Msg = &MsgFlashMint{}
+	_ sdk.Msg = &MsgBuyFury{}
+
+	_ codectypes.UnpackInterfacesMessage = &MsgFlashMint{}
 )
 
 // Route implements sdk.Msg
@@ -441,3 +453,219 @@ func (m *MsgLiquidateCollateral) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+// Route implements sdk.Msg
+func (m *MsgSetCrossMargin) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgSetCrossMargin) Type() string { return TypeMsgSetCrossMargin }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgSetCrossMargin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgSetCrossMargin) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgSetCrossMargin) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgFlashMint creates a new MsgFlashMint of the nested messages.
+func NewMsgFlashMint(sender sdk.AccAddress, amount sdk.Coin, msgs []sdk.Msg) (*MsgFlashMint, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+	return &MsgFlashMint{
+		Sender: sender.String(),
+		Amount: amount,
+		Msgs:   anys,
+	}, nil
+}
+
+// GetMessages returns the cached nested messages.
+func (m *MsgFlashMint) GetMessages() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(m.Msgs))
+	for i, any := range m.Msgs {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "message contains %T which is not a sdk.Msg", any.GetCachedValue())
+		}
+		msgs[i] = msg
+	}
+	return msgs, nil
+}
+
+// Route implements sdk.Msg
+func (m *MsgFlashMint) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgFlashMint) Type() string { return TypeMsgFlashMint }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgFlashMint) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgFlashMint) ValidateBasic() error {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if m.Amount.Denom != blackfury.MicroFUSDDenom {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid coin: %s", m.Amount.Denom)
+	}
+	if !m.Amount.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+	if len(m.Msgs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidFlashMintMsg, "no nested messages")
+	}
+
+	msgs, err := m.GetMessages()
+	if err != nil {
+		return err
+	}
+	for _, msg := range msgs {
+		if err := ValidateFlashMintMsg(sender, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// flashMintAllowedMsgs are the type urls of the messages which can be nested in flash mint.
+// Nested messages skip the ante handler, so only messages of the maker and bank modules,
+// which need nothing but the signature of the sender, are allowed.
+// The erc20 module routes no messages yet.
+var flashMintAllowedMsgs = map[string]bool{
+	sdk.MsgTypeURL(&MsgMintBySwap{}):          true,
+	sdk.MsgTypeURL(&MsgBurnBySwap{}):          true,
+	sdk.MsgTypeURL(&MsgBuyBacking{}):          true,
+	sdk.MsgTypeURL(&MsgSellBacking{}):         true,
+	sdk.MsgTypeURL(&MsgMintByCollateral{}):    true,
+	sdk.MsgTypeURL(&MsgBurnByCollateral{}):    true,
+	sdk.MsgTypeURL(&MsgDepositCollateral{}):   true,
+	sdk.MsgTypeURL(&MsgRedeemCollateral{}):    true,
+	sdk.MsgTypeURL(&MsgLiquidateCollateral{}): true,
+	sdk.MsgTypeURL(&MsgSetCrossMargin{}):      true,
+	sdk.MsgTypeURL(&MsgBuyFury{}):             true,
+	sdk.MsgTypeURL(&banktypes.MsgSend{}):      true,
+	sdk.MsgTypeURL(&banktypes.MsgMultiSend{}): true,
+}
+
+// ValidateFlashMintMsg validates the message nested in flash mint, which must be allowed and signed by the sender only.
+func ValidateFlashMintMsg(sender sdk.AccAddress, msg sdk.Msg) (err error) {
+	// malformed messages may panic in GetSigners or ValidateBasic
+	defer func() {
+		if r := recover(); r != nil {
+			err = sdkerrors.Wrapf(ErrInvalidFlashMintMsg, "malformed nested message %s: %v", sdk.MsgTypeURL(msg), r)
+		}
+	}()
+
+	switch msg.(type) {
+	case *MsgFlashMint:
+		return sdkerrors.Wrap(ErrInvalidFlashMintMsg, "flash mint cannot be nested")
+	case *evmtypes.MsgEthereumTx:
+		return sdkerrors.Wrap(ErrInvalidFlashMintMsg, "ethereum tx cannot be nested")
+	case *authz.MsgExec:
+		return sdkerrors.Wrap(ErrInvalidFlashMintMsg, "authz exec cannot be nested")
+	}
+	if !flashMintAllowedMsgs[sdk.MsgTypeURL(msg)] {
+		return sdkerrors.Wrapf(ErrInvalidFlashMintMsg, "message not allowed: %s", sdk.MsgTypeURL(msg))
+	}
+
+	signers := msg.GetSigners()
+	if len(signers) != 1 || !signers[0].Equals(sender) {
+		return sdkerrors.Wrap(ErrInvalidFlashMintMsg, "nested messages must be signed by the sender only")
+	}
+	return msg.ValidateBasic()
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgFlashMint) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// UnpackInterfaces implements codectypes.UnpackInterfacesMessage
+func (m MsgFlashMint) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range m.Msgs {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Route implements sdk.Msg
+func (m *MsgBuyFury) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgBuyFury) Type() string { return TypeMsgBuyFury }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgBuyFury) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgBuyFury) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if len(m.To) > 0 {
+		_, err = sdk.AccAddressFromBech32(m.To)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
+		}
+	}
+	if err = sdk.ValidateDenom(m.CollateralDenom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if m.BlackIn.Denom != blackfury.MicroFUSDDenom {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid coin: %s", m.BlackIn.Denom)
+	}
+	if !m.BlackIn.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.BlackIn.String())
+	}
+	if m.FuryOutMin.Denom != blackfury.AttoFuryDenom {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid coin: %s", m.FuryOutMin.Denom)
+	}
+	if m.FuryOutMin.Amount.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.FuryOutMin.String())
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgBuyFury) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -2,13 +2,20 @@ package types
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	blackfury "github.com/elysiumstation/blackfury/types"
+	oracletypes "github.com/elysiumstation/blackfury/x/oracle/types"
 	"gopkg.in/yaml.v2"
 )
 
+// SurplusDestinationModules are the module accounts which may receive the surplus
+var SurplusDestinationModules = []string{ModuleName, oracletypes.ModuleName, authtypes.FeeCollectorName}
+
 // Parameter keys
 var (
 	KeyBackingRatioStep           = []byte("BackingRatioStep")
@@ -18,6 +25,21 @@ var (
 	KeyBurnPriceBias              = []byte("BurnPriceBias")
 	KeyRebackBonus                = []byte("RebackBonus")
 	KeyLiquidationCommissionFee   = []byte("LiquidationCommissionFee")
+	KeySurplusDestination         = []byte("SurplusDestination")
+	KeySurplusBuffer              = []byte("SurplusBuffer")
+	KeyBackingRatioController     = []byte("BackingRatioController")
+	KeyPIDControllerParams        = []byte("PIDControllerParams")
+	KeyFlashMintCeiling           = []byte("FlashMintCeiling")
+	KeyFlashMintFee               = []byte("FlashMintFee")
+	KeyFlashMintGas               = []byte("FlashMintGas")
+)
+
+// Backing ratio controllers
+const (
+	// BackingRatioControllerStep steps backing ratio when Black price is out of the price band
+	BackingRatioControllerStep = "step"
+	// BackingRatioControllerPID drives backing ratio by Black TWAP and supply growth
+	BackingRatioControllerPID = "pid"
 )
 
 // Default parameter values
@@ -29,6 +51,21 @@ var (
 	DefaultBurnPriceBias              = sdk.NewDecWithPrec(1, 2)       // 1%
 	DefaultRebackBonus                = sdk.NewDecWithPrec(75, 4)      // 0.75%
 	DefaultLiquidationCommissionFee   = sdk.NewDecWithPrec(10, 2)      // 10%
+	DefaultSurplusDestination         = oracletypes.ModuleName         // oracle module account
+	DefaultSurplusBuffer              = sdk.NewInt(100_000_000000)     // 100,000 Black
+	DefaultBackingRatioController     = BackingRatioControllerStep
+	DefaultPIDControllerParams        = PIDControllerParams{
+		Kp:               sdk.NewDecWithPrec(5, 1),   // 0.5
+		Ki:               sdk.NewDecWithPrec(5, 2),   // 0.05
+		Kd:               sdk.NewDecWithPrec(1, 1),   // 0.1
+		IntegralLimit:    sdk.NewDecWithPrec(1, 1),   // 0.1
+		SupplyGrowthGain: sdk.NewDecWithPrec(5, 2),   // 0.05
+		MaxStep:          sdk.NewDecWithPrec(1, 2),   // 1%
+		TwapWindow:       int64(time.Hour.Seconds()), // 1 hour
+	}
+	DefaultFlashMintCeiling = sdk.NewInt(10_000_000_000000) // 10,000,000 Black
+	DefaultFlashMintFee     = sdk.NewDecWithPrec(9, 4)      // 0.09%
+	DefaultFlashMintGas     = uint64(2_000_000)
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -48,6 +85,13 @@ func DefaultParams() Params {
 		BurnPriceBias:              DefaultBurnPriceBias,
 		RebackBonus:                DefaultRebackBonus,
 		LiquidationCommissionFee:   DefaultLiquidationCommissionFee,
+		SurplusDestination:         DefaultSurplusDestination,
+		SurplusBuffer:              DefaultSurplusBuffer,
+		BackingRatioController:     DefaultBackingRatioController,
+		PidControllerParams:        DefaultPIDControllerParams,
+		FlashMintCeiling:           DefaultFlashMintCeiling,
+		FlashMintFee:               DefaultFlashMintFee,
+		FlashMintGas:               DefaultFlashMintGas,
 	}
 }
 
@@ -61,6 +105,13 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyBurnPriceBias, &p.BurnPriceBias, validateMintBurnPriceBias),
 		paramtypes.NewParamSetPair(KeyRebackBonus, &p.RebackBonus, validateRebackBonus),
 		paramtypes.NewParamSetPair(KeyLiquidationCommissionFee, &p.LiquidationCommissionFee, validateLiquidationCommissionFee),
+		paramtypes.NewParamSetPair(KeySurplusDestination, &p.SurplusDestination, validateSurplusDestination),
+		paramtypes.NewParamSetPair(KeySurplusBuffer, &p.SurplusBuffer, validateSurplusBuffer),
+		paramtypes.NewParamSetPair(KeyBackingRatioController, &p.BackingRatioController, validateBackingRatioController),
+		paramtypes.NewParamSetPair(KeyPIDControllerParams, &p.PidControllerParams, validatePIDControllerParams),
+		paramtypes.NewParamSetPair(KeyFlashMintCeiling, &p.FlashMintCeiling, validateFlashMintCeiling),
+		paramtypes.NewParamSetPair(KeyFlashMintFee, &p.FlashMintFee, validateFlashMintFee),
+		paramtypes.NewParamSetPair(KeyFlashMintGas, &p.FlashMintGas, validateFlashMintGas),
 	}
 }
 
@@ -87,6 +138,27 @@ func (p Params) Validate() error {
 	if p.LiquidationCommissionFee.IsNegative() || p.LiquidationCommissionFee.GT(sdk.OneDec()) {
 		return fmt.Errorf("liquidation commission fee ratio should be a value between [0,1], is %s", p.LiquidationCommissionFee)
 	}
+	if err := validateSurplusDestination(p.SurplusDestination); err != nil {
+		return err
+	}
+	if p.SurplusBuffer.IsNil() || p.SurplusBuffer.IsNegative() {
+		return fmt.Errorf("surplus buffer should be positive or zero, is %s", p.SurplusBuffer)
+	}
+	if err := validateBackingRatioController(p.BackingRatioController); err != nil {
+		return err
+	}
+	if err := validatePIDControllerParams(p.PidControllerParams); err != nil {
+		return err
+	}
+	if p.FlashMintCeiling.IsNil() || p.FlashMintCeiling.IsNegative() {
+		return fmt.Errorf("flash mint ceiling should be positive or zero, is %s", p.FlashMintCeiling)
+	}
+	if p.FlashMintFee.IsNil() || p.FlashMintFee.IsNegative() || p.FlashMintFee.GT(sdk.OneDec()) {
+		return fmt.Errorf("flash mint fee ratio should be a value between [0,1], is %s", p.FlashMintFee)
+	}
+	if p.FlashMintGas == 0 {
+		return fmt.Errorf("flash mint gas should be positive, is %d", p.FlashMintGas)
+	}
 	return nil
 }
 
@@ -193,3 +265,127 @@ func validateLiquidationCommissionFee(i interface{}) error {
 
 	return nil
 }
+
+func validateSurplusDestination(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if strings.TrimSpace(v) == "" {
+		return fmt.Errorf("surplus destination cannot be blank")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(v); err == nil {
+		return nil
+	}
+	for _, module := range SurplusDestinationModules {
+		if v == module {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("surplus destination must be an account address or one of the module accounts %v: %s", SurplusDestinationModules, v)
+}
+
+func validateSurplusBuffer(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("surplus buffer must be positive or zero: %s", v)
+	}
+
+	return nil
+}
+
+func validateBackingRatioController(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	switch v {
+	case BackingRatioControllerStep, BackingRatioControllerPID:
+		return nil
+	default:
+		return fmt.Errorf("unknown backing ratio controller: %s", v)
+	}
+}
+
+func validatePIDControllerParams(i interface{}) error {
+	v, ok := i.(PIDControllerParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for _, gain := range []struct {
+		name  string
+		value sdk.Dec
+	}{
+		{"kp", v.Kp},
+		{"ki", v.Ki},
+		{"kd", v.Kd},
+		{"integral limit", v.IntegralLimit},
+		{"supply growth gain", v.SupplyGrowthGain},
+	} {
+		if gain.value.IsNil() || gain.value.IsNegative() {
+			return fmt.Errorf("pid controller %s must be positive or zero: %s", gain.name, gain.value)
+		}
+	}
+
+	if v.MaxStep.IsNil() || !v.MaxStep.IsPositive() || v.MaxStep.GT(sdk.OneDec()) {
+		return fmt.Errorf("pid controller max step should be a value between (0,1], is %s", v.MaxStep)
+	}
+
+	if v.TwapWindow <= 0 {
+		return fmt.Errorf("pid controller twap window must be positive: %d", v.TwapWindow)
+	}
+
+	return nil
+}
+
+func validateFlashMintCeiling(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("flash mint ceiling must be positive or zero: %s", v)
+	}
+
+	return nil
+}
+
+func validateFlashMintFee(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("flash mint fee ratio must be positive or zero: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("flash mint fee ratio is too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateFlashMintGas(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("flash mint gas must be positive: %d", v)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	blackfury "github.com/elysiumstation/blackfury/types"
 )
 
 const (
@@ -14,6 +15,7 @@ const (
 	ProposalTypeSetCollateralRiskParams      = "SetCollateralRiskParams"
 	ProposalTypeBatchSetBackingRiskParams    = "BatchSetBackingRiskParams"
 	ProposalTypeBatchSetCollateralRiskParams = "BatchSetCollateralRiskParams"
+	ProposalTypeCoverBadDebt                 = "CoverBadDebt"
 )
 
 var (
@@ -23,6 +25,7 @@ var (
 	_ govtypes.Content = &SetCollateralRiskParamsProposal{}
 	_ govtypes.Content = &BatchSetBackingRiskParamsProposal{}
 	_ govtypes.Content = &BatchSetCollateralRiskParamsProposal{}
+	_ govtypes.Content = &CoverBadDebtProposal{}
 )
 
 func init() {
@@ -32,12 +35,14 @@ func init() {
 	govtypes.RegisterProposalType(ProposalTypeSetCollateralRiskParams)
 	govtypes.RegisterProposalType(ProposalTypeBatchSetBackingRiskParams)
 	govtypes.RegisterProposalType(ProposalTypeBatchSetCollateralRiskParams)
+	govtypes.RegisterProposalType(ProposalTypeCoverBadDebt)
 	govtypes.RegisterProposalTypeCodec(&RegisterBackingProposal{}, "maker/RegisterBackingProposal")
 	govtypes.RegisterProposalTypeCodec(&RegisterCollateralProposal{}, "maker/RegisterCollateralProposal")
 	govtypes.RegisterProposalTypeCodec(&SetBackingRiskParamsProposal{}, "maker/SetBackingRiskParamsProposal")
 	govtypes.RegisterProposalTypeCodec(&SetCollateralRiskParamsProposal{}, "maker/SetCollateralRiskParamsProposal")
 	govtypes.RegisterProposalTypeCodec(&BatchSetBackingRiskParamsProposal{}, "maker/BatchSetBackingRiskParamsProposal")
 	govtypes.RegisterProposalTypeCodec(&BatchSetCollateralRiskParamsProposal{}, "maker/BatchSetCollateralRiskParamsProposal")
+	govtypes.RegisterProposalTypeCodec(&CoverBadDebtProposal{}, "maker/CoverBadDebtProposal")
 }
 
 func (m *RegisterBackingProposal) ProposalRoute() string {
@@ -122,6 +127,27 @@ func (m *BatchSetCollateralRiskParamsProposal) ValidateBasic() error {
 	return nil
 }
 
+func (m *CoverBadDebtProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *CoverBadDebtProposal) ProposalType() string {
+	return ProposalTypeCoverBadDebt
+}
+
+func (m *CoverBadDebtProposal) ValidateBasic() error {
+	if err := sdk.ValidateDenom(m.CollateralDenom); err != nil {
+		return err
+	}
+	if m.MaxFuryMint.Denom != blackfury.AttoFuryDenom {
+		return fmt.Errorf("max fury mint must be in %s", blackfury.AttoFuryDenom)
+	}
+	if !m.MaxFuryMint.IsValid() || !m.MaxFuryMint.IsPositive() {
+		return fmt.Errorf("max fury mint must be positive")
+	}
+	return nil
+}
+
 func validateBackingRiskParams(params *BackingRiskParams) error {
 	if params.MaxBacking != nil && params.MaxBacking.IsNegative() {
 		return fmt.Errorf("max backing value must be not negative")
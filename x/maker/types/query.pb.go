@@ -623,6 +623,110 @@ func (m *QueryCollateralOfAccountResponse) GetAccountCollateral() AccountCollate
 	return AccountCollateral{}
 }
 
+type QueryAccountPositionsRequest struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *QueryAccountPositionsRequest) Reset()         { *m = QueryAccountPositionsRequest{} }
+func (m *QueryAccountPositionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountPositionsRequest) ProtoMessage()    {}
+func (*QueryAccountPositionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0bf218de20f75e7e, []int{14}
+}
+func (m *QueryAccountPositionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountPositionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountPositionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountPositionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountPositionsRequest.Merge(m, src)
+}
+func (m *QueryAccountPositionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountPositionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountPositionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountPositionsRequest proto.InternalMessageInfo
+
+func (m *QueryAccountPositionsRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+type QueryAccountPositionsResponse struct {
+	Positions []AccountPosition `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions"`
+	// total collateral value in USD
+	TotalCollateralValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=total_collateral_value,json=totalCollateralValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_collateral_value"`
+	// total black debt value in USD
+	TotalDebtValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=total_debt_value,json=totalDebtValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_debt_value"`
+	// sum of collateral value weighted by liquidation threshold divided by total
+	// debt value; empty if no debt
+	HealthFactor *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=health_factor,json=healthFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"health_factor,omitempty"`
+	// whether the account is in cross-margin mode
+	CrossMargin bool `protobuf:"varint,5,opt,name=cross_margin,json=crossMargin,proto3" json:"cross_margin,omitempty"`
+}
+
+func (m *QueryAccountPositionsResponse) Reset()         { *m = QueryAccountPositionsResponse{} }
+func (m *QueryAccountPositionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountPositionsResponse) ProtoMessage()    {}
+func (*QueryAccountPositionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0bf218de20f75e7e, []int{15}
+}
+func (m *QueryAccountPositionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountPositionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountPositionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountPositionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountPositionsResponse.Merge(m, src)
+}
+func (m *QueryAccountPositionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountPositionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountPositionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountPositionsResponse proto.InternalMessageInfo
+
+func (m *QueryAccountPositionsResponse) GetPositions() []AccountPosition {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+func (m *QueryAccountPositionsResponse) GetCrossMargin() bool {
+	if m != nil {
+		return m.CrossMargin
+	}
+	return false
+}
+
 type QueryTotalBackingRequest struct {
 }
 
@@ -630,7 +734,7 @@ func (m *QueryTotalBackingRequest) Reset()         { *m = QueryTotalBackingReque
 func (m *QueryTotalBackingRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalBackingRequest) ProtoMessage()    {}
 func (*QueryTotalBackingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0bf218de20f75e7e, []int{14}
+	return fileDescriptor_0bf218de20f75e7e, []int{16}
 }
 func (m *QueryTotalBackingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -667,7 +771,7 @@ func (m *QueryTotalBackingResponse) Reset()         { *m = QueryTotalBackingResp
 func (m *QueryTotalBackingResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalBackingResponse) ProtoMessage()    {}
 func (*QueryTotalBackingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0bf218de20f75e7e, []int{15}
+	return fileDescriptor_0bf218de20f75e7e, []int{17}
 }
 func (m *QueryTotalBackingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -710,7 +814,7 @@ func (m *QueryTotalCollateralRequest) Reset()         { *m = QueryTotalCollatera
 func (m *QueryTotalCollateralRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalCollateralRequest) ProtoMessage()    {}
 func (*QueryTotalCollateralRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0bf218de20f75e7e, []int{16}
+	return fileDescriptor_0bf218de20f75e7e, []int{18}
 }
 func (m *QueryTotalCollateralRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -747,7 +851,7 @@ func (m *QueryTotalCollateralResponse) Reset()         { *m = QueryTotalCollater
 func (m *QueryTotalCollateralResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalCollateralResponse) ProtoMessage()    {}
 func (*QueryTotalCollateralResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0bf218de20f75e7e, []int{17}
+	return fileDescriptor_0bf218de20f75e7e, []int{19}
 }
 func (m *QueryTotalCollateralResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -790,7 +894,7 @@ func (m *QueryBackingRatioRequest) Reset()         { *m = QueryBackingRatioReque
 func (m *QueryBackingRatioRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBackingRatioRequest) ProtoMessage()    {}
 func (*QueryBackingRatioRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0bf218de20f75e7e, []int{18}
+	return fileDescriptor_0bf218de20f75e7e, []int{20}
 }
 func (m *QueryBackingRatioRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -828,7 +932,7 @@ func (m *QueryBackingRatioResponse) Reset()         { *m = QueryBackingRatioResp
 func (m *QueryBackingRatioResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBackingRatioResponse) ProtoMessage()    {}
 func (*QueryBackingRatioResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0bf218de20f75e7e, []int{19}
+	return fileDescriptor_0bf218de20f75e7e, []int{21}
 }
 func (m *QueryBackingRatioResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -864,6 +968,183 @@ func (m *QueryBackingRatioResponse) GetLastUpdateBlock() int64 {
 	return 0
 }
 
+type QueryAllTreasuryLedgersRequest struct {
+}
+
+func (m *QueryAllTreasuryLedgersRequest) Reset()         { *m = QueryAllTreasuryLedgersRequest{} }
+func (m *QueryAllTreasuryLedgersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTreasuryLedgersRequest) ProtoMessage()    {}
+func (*QueryAllTreasuryLedgersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0bf218de20f75e7e, []int{22}
+}
+func (m *QueryAllTreasuryLedgersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTreasuryLedgersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTreasuryLedgersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllTreasuryLedgersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTreasuryLedgersRequest.Merge(m, src)
+}
+func (m *QueryAllTreasuryLedgersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTreasuryLedgersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTreasuryLedgersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllTreasuryLedgersRequest proto.InternalMessageInfo
+
+type QueryAllTreasuryLedgersResponse struct {
+	Ledgers []TreasuryLedger `protobuf:"bytes,1,rep,name=ledgers,proto3" json:"ledgers"`
+}
+
+func (m *QueryAllTreasuryLedgersResponse) Reset()         { *m = QueryAllTreasuryLedgersResponse{} }
+func (m *QueryAllTreasuryLedgersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTreasuryLedgersResponse) ProtoMessage()    {}
+func (*QueryAllTreasuryLedgersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0bf218de20f75e7e, []int{23}
+}
+func (m *QueryAllTreasuryLedgersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTreasuryLedgersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTreasuryLedgersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllTreasuryLedgersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTreasuryLedgersResponse.Merge(m, src)
+}
+func (m *QueryAllTreasuryLedgersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTreasuryLedgersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTreasuryLedgersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllTreasuryLedgersResponse proto.InternalMessageInfo
+
+func (m *QueryAllTreasuryLedgersResponse) GetLedgers() []TreasuryLedger {
+	if m != nil {
+		return m.Ledgers
+	}
+	return nil
+}
+
+type QueryTreasuryLedgerRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// asset class of the ledger, i.e., backing, collateral or black
+	AssetClass string `protobuf:"bytes,2,opt,name=asset_class,json=assetClass,proto3" json:"asset_class,omitempty"`
+}
+
+func (m *QueryTreasuryLedgerRequest) Reset()         { *m = QueryTreasuryLedgerRequest{} }
+func (m *QueryTreasuryLedgerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryLedgerRequest) ProtoMessage()    {}
+func (*QueryTreasuryLedgerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0bf218de20f75e7e, []int{24}
+}
+func (m *QueryTreasuryLedgerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryLedgerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryLedgerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTreasuryLedgerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryLedgerRequest.Merge(m, src)
+}
+func (m *QueryTreasuryLedgerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryLedgerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryLedgerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTreasuryLedgerRequest proto.InternalMessageInfo
+
+func (m *QueryTreasuryLedgerRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryTreasuryLedgerRequest) GetAssetClass() string {
+	if m != nil {
+		return m.AssetClass
+	}
+	return ""
+}
+
+type QueryTreasuryLedgerResponse struct {
+	Ledger TreasuryLedger `protobuf:"bytes,1,opt,name=ledger,proto3" json:"ledger"`
+}
+
+func (m *QueryTreasuryLedgerResponse) Reset()         { *m = QueryTreasuryLedgerResponse{} }
+func (m *QueryTreasuryLedgerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryLedgerResponse) ProtoMessage()    {}
+func (*QueryTreasuryLedgerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0bf218de20f75e7e, []int{25}
+}
+func (m *QueryTreasuryLedgerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryLedgerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryLedgerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTreasuryLedgerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryLedgerResponse.Merge(m, src)
+}
+func (m *QueryTreasuryLedgerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryLedgerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryLedgerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTreasuryLedgerResponse proto.InternalMessageInfo
+
+func (m *QueryTreasuryLedgerResponse) GetLedger() TreasuryLedger {
+	if m != nil {
+		return m.Ledger
+	}
+	return TreasuryLedger{}
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -872,7 +1153,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0bf218de20f75e7e, []int{20}
+	return fileDescriptor_0bf218de20f75e7e, []int{26}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -911,7 +1192,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0bf218de20f75e7e, []int{21}
+	return fileDescriptor_0bf218de20f75e7e, []int{27}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -957,7 +1238,7 @@ func (m *EstimateMintBySwapInRequest) Reset()         { *m = EstimateMintBySwapI
 func (m *EstimateMintBySwapInRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateMintBySwapInRequest) ProtoMessage()    {}
 func (*EstimateMintBySwapInRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0bf218de20f75e7e, []int{22}
+	return fileDescriptor_0bf218de20f75e7e, []int{28}
 }
 func (m *EstimateMintBySwapInRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1017,7 +1298,7 @@ func (m *EstimateMintBySwapInResponse) Reset()         { *m = EstimateMintBySwap
 func (m *EstimateMintBySwapInResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateMintBySwapInResponse) ProtoMessage()    {}
 func (*EstimateMintBySwapInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0bf218de20f75e7e, []int{23}
+	return fileDescriptor_0bf218de20f75e7e, []int{29}
 }
 func (m *EstimateMintBySwapInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1077,7 +1358,7 @@ func (m *EstimateMintBySwapOutRequest) Reset()         { *m = EstimateMintBySwap
 func (m *EstimateMintBySwapOutRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateMintBySwapOutRequest) ProtoMessage()    {}
 func (*EstimateMintBySwapOutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0bf218de20f75e7e, []int{24}
+	return fileDescriptor_0bf218de20f75e7e, []int{30}
 }
 func (m *EstimateMintBySwapOutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1138,7 +1419,7 @@ func (m *EstimateMintBySwapOutResponse) Reset()         { *m = EstimateMintBySwa
 func (m *EstimateMintBySwapOutResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateMintBySwapOutResponse) ProtoMessage()    {}
 func (*EstimateMintBySwapOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0bf218de20f75e7e, []int{25}
+	return fileDescriptor_0bf218de20f75e7e, []int{31}
 }
 func (m *EstimateMintBySwapOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1204,7 +1485,7 @@ func (m *EstimateBurnBySwapInRequest) Reset()         { *m = EstimateBurnBySwapI
 func (m *EstimateBurnBySwapInRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateBurnBySwapInRequest) ProtoMessage()    {}
 func (*EstimateBurnBySwapInRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0bf218de20f75e7e, []int{26}
+	return fileDescriptor_0bf218de20f75e7e, []int{32}
 }
 func (m *EstimateBurnBySwapInRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1258,7 +1539,7 @@ func (m *EstimateBurnBySwapInResponse) Reset()         { *m = EstimateBurnBySwap
 func (m *EstimateBurnBySwapInResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateBurnBySwapInResponse) ProtoMessage()    {}
 func (*EstimateBurnBySwapInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0bf218de20f75e7e, []int{27}
+	return fileDescriptor_0bf218de20f75e7e, []int{33}
 }
 func (m *EstimateBurnBySwapInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1324,7 +1605,7 @@ func (m *EstimateBurnBySwapOutRequest) Reset()         { *m = EstimateBurnBySwap
 func (m *EstimateBurnBySwapOutRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateBurnBySwapOutRequest) ProtoMessage()    {}
 func (*EstimateBurnBySwapOutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0bf218de20f75e7e, []int{28}
+	return fileDescriptor_0bf218de20f75e7e, []int{34}
 }
 func (m *EstimateBurnBySwapOutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1377,7 +1658,7 @@ func (m *EstimateBurnBySwapOutResponse) Reset()         { *m = EstimateBurnBySwa
 func (m *EstimateBurnBySwapOutResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateBurnBySwapOutResponse) ProtoMessage()    {}
 func (*EstimateBurnBySwapOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0bf218de20f75e7e, []int{29}
+	return fileDescriptor_0bf218de20f75e7e, []int{35}
 }
 func (m *EstimateBurnBySwapOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1435,7 +1716,7 @@ func (m *EstimateBuyBackingInRequest) Reset()         { *m = EstimateBuyBackingI
 func (m *EstimateBuyBackingInRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateBuyBackingInRequest) ProtoMessage()    {}
 func (*EstimateBuyBackingInRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0bf218de20f75e7e, []int{30}
+	return fileDescriptor_0bf218de20f75e7e, []int{36}
 }
 func (m *EstimateBuyBackingInRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1480,7 +1761,7 @@ func (m *EstimateBuyBackingInResponse) Reset()         { *m = EstimateBuyBacking
 func (m *EstimateBuyBackingInResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateBuyBackingInResponse) ProtoMessage()    {}
 func (*EstimateBuyBackingInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0bf218de20f75e7e, []int{31}
+	return fileDescriptor_0bf218de20f75e7e, []int{37}
 }
 func (m *EstimateBuyBackingInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1532,7 +1813,7 @@ func (m *EstimateBuyBackingOutRequest) Reset()         { *m = EstimateBuyBacking
 func (m *EstimateBuyBackingOutRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateBuyBackingOutRequest) ProtoMessage()    {}
 func (*EstimateBuyBackingOutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0bf218de20f75e7e, []int{32}
+	return fileDescriptor_0bf218de20f75e7e, []int{38}
 }
 func (m *EstimateBuyBackingOutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1584,7 +1865,7 @@ func (m *EstimateBuyBackingOutResponse) Reset()         { *m = EstimateBuyBackin
 func (m *EstimateBuyBackingOutResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateBuyBackingOutResponse) ProtoMessage()    {}
 func (*EstimateBuyBackingOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0bf218de20f75e7e, []int{33}
+	return fileDescriptor_0bf218de20f75e7e, []int{39}
 }
 func (m *EstimateBuyBackingOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1636,7 +1917,7 @@ func (m *EstimateSellBackingInRequest) Reset()         { *m = EstimateSellBackin
 func (m *EstimateSellBackingInRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateSellBackingInRequest) ProtoMessage()    {}
 func (*EstimateSellBackingInRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0bf218de20f75e7e, []int{34}
+	return fileDescriptor_0bf218de20f75e7e, []int{40}
 }
 func (m *EstimateSellBackingInRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1688,7 +1969,7 @@ func (m *EstimateSellBackingInResponse) Reset()         { *m = EstimateSellBacki
 func (m *EstimateSellBackingInResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateSellBackingInResponse) ProtoMessage()    {}
 func (*EstimateSellBackingInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0bf218de20f75e7e, []int{35}
+	return fileDescriptor_0bf218de20f75e7e, []int{41}
 }
 func (m *EstimateSellBackingInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1739,7 +2020,7 @@ func (m *EstimateSellBackingOutRequest) Reset()         { *m = EstimateSellBacki
 func (m *EstimateSellBackingOutRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateSellBackingOutRequest) ProtoMessage()    {}
 func (*EstimateSellBackingOutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0bf218de20f75e7e, []int{36}
+	return fileDescriptor_0bf218de20f75e7e, []int{42}
 }
 func (m *EstimateSellBackingOutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1784,7 +2065,7 @@ func (m *EstimateSellBackingOutResponse) Reset()         { *m = EstimateSellBack
 func (m *EstimateSellBackingOutResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateSellBackingOutResponse) ProtoMessage()    {}
 func (*EstimateSellBackingOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0bf218de20f75e7e, []int{37}
+	return fileDescriptor_0bf218de20f75e7e, []int{43}
 }
 func (m *EstimateSellBackingOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1842,12 +2123,18 @@ func init() {
 	proto.RegisterType((*QueryCollateralPoolResponse)(nil), "blackfury.maker.v1.QueryCollateralPoolResponse")
 	proto.RegisterType((*QueryCollateralOfAccountRequest)(nil), "blackfury.maker.v1.QueryCollateralOfAccountRequest")
 	proto.RegisterType((*QueryCollateralOfAccountResponse)(nil), "blackfury.maker.v1.QueryCollateralOfAccountResponse")
+	proto.RegisterType((*QueryAccountPositionsRequest)(nil), "blackfury.maker.v1.QueryAccountPositionsRequest")
+	proto.RegisterType((*QueryAccountPositionsResponse)(nil), "blackfury.maker.v1.QueryAccountPositionsResponse")
 	proto.RegisterType((*QueryTotalBackingRequest)(nil), "blackfury.maker.v1.QueryTotalBackingRequest")
 	proto.RegisterType((*QueryTotalBackingResponse)(nil), "blackfury.maker.v1.QueryTotalBackingResponse")
 	proto.RegisterType((*QueryTotalCollateralRequest)(nil), "blackfury.maker.v1.QueryTotalCollateralRequest")
 	proto.RegisterType((*QueryTotalCollateralResponse)(nil), "blackfury.maker.v1.QueryTotalCollateralResponse")
 	proto.RegisterType((*QueryBackingRatioRequest)(nil), "blackfury.maker.v1.QueryBackingRatioRequest")
 	proto.RegisterType((*QueryBackingRatioResponse)(nil), "blackfury.maker.v1.QueryBackingRatioResponse")
+	proto.RegisterType((*QueryAllTreasuryLedgersRequest)(nil), "blackfury.maker.v1.QueryAllTreasuryLedgersRequest")
+	proto.RegisterType((*QueryAllTreasuryLedgersResponse)(nil), "blackfury.maker.v1.QueryAllTreasuryLedgersResponse")
+	proto.RegisterType((*QueryTreasuryLedgerRequest)(nil), "blackfury.maker.v1.QueryTreasuryLedgerRequest")
+	proto.RegisterType((*QueryTreasuryLedgerResponse)(nil), "blackfury.maker.v1.QueryTreasuryLedgerResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "blackfury.maker.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "blackfury.maker.v1.QueryParamsResponse")
 	proto.RegisterType((*EstimateMintBySwapInRequest)(nil), "blackfury.maker.v1.EstimateMintBySwapInRequest")
@@ -1871,115 +2158,133 @@ func init() {
 func init() { proto.RegisterFile("blackfury/maker/v1/query.proto", fileDescriptor_0bf218de20f75e7e) }
 
 var fileDescriptor_0bf218de20f75e7e = []byte{
-	// 1715 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0xcf, 0x73, 0x14, 0x45,
-	0x14, 0xc7, 0xd3, 0x09, 0x26, 0xf0, 0x36, 0x10, 0x68, 0xa2, 0x84, 0x25, 0x6c, 0x96, 0x09, 0x09,
-	0xe1, 0x47, 0x66, 0x48, 0x02, 0x4a, 0x79, 0x40, 0x59, 0x40, 0x8c, 0x48, 0x05, 0x02, 0x5e, 0xb8,
-	0x6c, 0xcd, 0x6e, 0x26, 0x61, 0xcc, 0xec, 0xcc, 0xb2, 0x33, 0x13, 0xd8, 0x03, 0x5a, 0xe5, 0x5f,
-	0xa0, 0x58, 0xe5, 0xc1, 0xb2, 0x2c, 0x95, 0x93, 0x17, 0xb1, 0xb4, 0xca, 0xf2, 0x0f, 0xe0, 0x80,
-	0x37, 0xaa, 0xf4, 0xa0, 0x1e, 0x28, 0x0b, 0xfc, 0x43, 0xac, 0xee, 0xe9, 0x99, 0xe9, 0xd9, 0xe9,
-	0x99, 0xf4, 0x10, 0x0e, 0x9e, 0x20, 0xd3, 0xfd, 0xba, 0x3f, 0xef, 0xfb, 0xde, 0xeb, 0x99, 0x7e,
-	0x0b, 0x95, 0x86, 0xa5, 0x37, 0xd7, 0x57, 0xfd, 0x4e, 0x57, 0x6b, 0xe9, 0xeb, 0x46, 0x47, 0xdb,
-	0x98, 0xd3, 0x6e, 0xfb, 0x46, 0xa7, 0xab, 0xb6, 0x3b, 0x8e, 0xe7, 0x60, 0x1c, 0x8d, 0xab, 0x74,
-	0x5c, 0xdd, 0x98, 0x2b, 0x8f, 0xae, 0x39, 0x6b, 0x0e, 0x1d, 0xd6, 0xc8, 0xff, 0x82, 0x99, 0xe5,
-	0xf1, 0x35, 0xc7, 0x59, 0xb3, 0x0c, 0x4d, 0x6f, 0x9b, 0x9a, 0x6e, 0xdb, 0x8e, 0xa7, 0x7b, 0xa6,
-	0x63, 0xbb, 0x6c, 0xb4, 0x2a, 0xd8, 0x67, 0xcd, 0xb0, 0x0d, 0xd7, 0x0c, 0x67, 0x88, 0x48, 0x82,
-	0x2d, 0xd9, 0x78, 0xd3, 0x71, 0x5b, 0x8e, 0xab, 0x35, 0x74, 0xd7, 0xd0, 0x36, 0xe6, 0x1a, 0x86,
-	0xa7, 0xcf, 0x69, 0x4d, 0xc7, 0xb4, 0x83, 0x71, 0x45, 0x81, 0xea, 0x35, 0x02, 0x7e, 0xce, 0xb2,
-	0x6a, 0x7a, 0x73, 0xdd, 0xb4, 0xd7, 0x96, 0x4d, 0x77, 0xfd, 0xaa, 0xde, 0xd1, 0x5b, 0xee, 0xb2,
-	0x71, 0xdb, 0x37, 0x5c, 0x4f, 0xb9, 0x0d, 0x87, 0x72, 0xe6, 0xb8, 0x6d, 0xc7, 0x76, 0x0d, 0xfc,
-	0x3e, 0x94, 0x3a, 0xa6, 0xbb, 0x5e, 0x6f, 0xd3, 0xc7, 0x63, 0xa8, 0x3a, 0x30, 0x53, 0x9a, 0x9f,
-	0x52, 0xd3, 0x42, 0xa8, 0xa9, 0x35, 0x6a, 0xdb, 0x1e, 0x3f, 0x9d, 0xe8, 0x5b, 0x86, 0x4e, 0xf4,
-	0x44, 0x99, 0x82, 0xc9, 0x70, 0xcb, 0xf3, 0x8e, 0x65, 0xe9, 0x9e, 0xd1, 0xd1, 0xad, 0x34, 0xd9,
-	0x1d, 0x38, 0x9c, 0x3f, 0x8d, 0xc1, 0x2d, 0x89, 0xe0, 0x66, 0x44, 0x70, 0xa2, 0x65, 0x04, 0x7c,
-	0x07, 0xe1, 0x40, 0x8f, 0x24, 0x57, 0x1d, 0xc7, 0x8a, 0xb8, 0x3e, 0x84, 0x71, 0xf1, 0x30, 0xe3,
-	0x79, 0x0f, 0x76, 0x36, 0x82, 0xe7, 0xf5, 0x36, 0x19, 0x60, 0x44, 0x13, 0x22, 0x22, 0x62, 0xc9,
-	0x16, 0x61, 0x20, 0xc3, 0x0d, 0x6e, 0x4d, 0xa5, 0x0a, 0x95, 0xb4, 0x06, 0x09, 0x9a, 0x0d, 0x98,
-	0xc8, 0x9c, 0xc1, 0x80, 0xae, 0xc3, 0xee, 0x66, 0x34, 0x94, 0x60, 0x52, 0xb2, 0x98, 0xe2, 0xa5,
-	0x18, 0xd6, 0x48, 0x33, 0xb9, 0xb8, 0x72, 0x16, 0xf6, 0xd1, 0x7d, 0x39, 0x09, 0x18, 0x12, 0x9e,
-	0x8c, 0x05, 0x58, 0x31, 0x6c, 0xa7, 0x35, 0x86, 0xaa, 0x68, 0x66, 0x47, 0xe4, 0xd9, 0x05, 0xf2,
-	0x4c, 0x59, 0x81, 0xb1, 0xb4, 0x3d, 0x03, 0x7e, 0x17, 0x86, 0x79, 0x05, 0xa9, 0xbd, 0xb4, 0x80,
-	0x25, 0x4e, 0x40, 0xe5, 0x12, 0x94, 0xe9, 0x2e, 0x49, 0x69, 0x42, 0xd0, 0xa3, 0x09, 0x61, 0x78,
-	0x56, 0xce, 0xdd, 0x00, 0xb7, 0xcd, 0x72, 0xa2, 0x77, 0x21, 0x46, 0x7c, 0x0d, 0x46, 0x7a, 0x24,
-	0x66, 0xd0, 0xf2, 0x0a, 0xef, 0x4a, 0x2a, 0xac, 0xac, 0xb2, 0xc0, 0xc6, 0x13, 0x97, 0x56, 0xcf,
-	0x35, 0x9b, 0x8e, 0x6f, 0x7b, 0x21, 0xff, 0x18, 0x0c, 0xe9, 0xc1, 0x13, 0x86, 0x1d, 0xfe, 0x29,
-	0xf4, 0xac, 0x5f, 0xec, 0xd9, 0x47, 0xec, 0x90, 0x10, 0xee, 0xc3, 0xdc, 0xbb, 0x09, 0x98, 0xad,
-	0x5c, 0x8f, 0xcd, 0x99, 0x87, 0xc2, 0x63, 0x80, 0x2d, 0x90, 0x72, 0x72, 0x8f, 0xde, 0x3b, 0xa0,
-	0x94, 0x59, 0x22, 0xdc, 0x70, 0x3c, 0x3d, 0x3a, 0x82, 0x58, 0x72, 0xdf, 0x82, 0xfd, 0x82, 0x31,
-	0x06, 0x75, 0x19, 0x76, 0x7a, 0xe4, 0x79, 0x9d, 0x05, 0x9c, 0xf1, 0x54, 0x45, 0x3c, 0xfc, 0x02,
-	0x61, 0xa1, 0x79, 0xdc, 0xb3, 0xa8, 0xe6, 0xe9, 0x44, 0xee, 0x9c, 0x60, 0x20, 0x1e, 0xab, 0xf9,
-	0xd4, 0x30, 0x63, 0xb9, 0x01, 0xbb, 0x03, 0x96, 0x94, 0x3c, 0x93, 0x99, 0x38, 0xe9, 0x1a, 0xf3,
-	0x92, 0x8f, 0x23, 0x69, 0x42, 0xcf, 0xc9, 0xeb, 0x23, 0x24, 0xfa, 0x0a, 0x31, 0x6d, 0x92, 0x83,
-	0x51, 0xc9, 0x47, 0x25, 0xd8, 0x21, 0x03, 0x41, 0x7e, 0xd4, 0x54, 0xb2, 0xcf, 0xdf, 0x4f, 0x27,
-	0xa6, 0xd7, 0x4c, 0xef, 0x96, 0xdf, 0x50, 0x9b, 0x4e, 0x4b, 0x63, 0xef, 0x90, 0xe0, 0x9f, 0x59,
-	0x77, 0x65, 0x5d, 0xf3, 0xba, 0x6d, 0xc3, 0x55, 0x2f, 0x18, 0xcd, 0xa8, 0x64, 0xe9, 0xe2, 0xf8,
-	0x18, 0xec, 0xb1, 0x74, 0xd7, 0xab, 0xfb, 0xed, 0x15, 0xdd, 0x33, 0xea, 0x0d, 0xcb, 0x69, 0xae,
-	0xd3, 0xac, 0x1a, 0x58, 0x1e, 0x21, 0x03, 0x1f, 0xd0, 0xe7, 0x35, 0xf2, 0x58, 0x19, 0x05, 0x4c,
-	0xe9, 0x92, 0x47, 0xfa, 0x12, 0xec, 0x4d, 0x3c, 0x65, 0xb4, 0x67, 0x60, 0x30, 0x3a, 0xbc, 0x89,
-	0x66, 0x65, 0x61, 0xd1, 0xf0, 0xc7, 0x35, 0x9b, 0xaf, 0x7c, 0x8b, 0xe0, 0xc0, 0x45, 0xd7, 0x33,
-	0x5b, 0xba, 0x67, 0x5c, 0x31, 0x6d, 0xaf, 0xd6, 0xbd, 0x7e, 0x47, 0x6f, 0x2f, 0xda, 0x61, 0x85,
-	0xbc, 0x09, 0xdb, 0x5b, 0xa6, 0xed, 0xd5, 0x1d, 0xdf, 0x63, 0x6b, 0xef, 0x57, 0x03, 0x4f, 0x55,
-	0xf2, 0xd2, 0x54, 0xd9, 0x4b, 0x53, 0x3d, 0xef, 0x98, 0x36, 0x5b, 0x7a, 0x88, 0x18, 0x2c, 0xf9,
-	0x82, 0x63, 0xac, 0x3f, 0x7d, 0x8c, 0xe1, 0x43, 0x30, 0xbc, 0xea, 0x5b, 0x71, 0x0e, 0x0e, 0x54,
-	0xd1, 0xcc, 0xf6, 0xe5, 0x12, 0x79, 0x16, 0xa6, 0xd6, 0x1f, 0x08, 0xc6, 0xc5, 0x8c, 0xcc, 0xfd,
-	0xb3, 0x00, 0xe1, 0x46, 0xa6, 0x2d, 0x8b, 0xb9, 0x83, 0x99, 0x2c, 0xda, 0xf8, 0x0c, 0x0c, 0x11,
-	0xa9, 0x88, 0x71, 0xbf, 0x9c, 0xf1, 0x20, 0x99, 0xbf, 0x68, 0x47, 0xf2, 0xac, 0x1a, 0x06, 0x25,
-	0x97, 0x95, 0xe7, 0x1d, 0xc3, 0x50, 0x7e, 0x13, 0xba, 0xb5, 0xe4, 0x47, 0xa7, 0xd3, 0x45, 0xd8,
-	0x15, 0xbb, 0x55, 0x6f, 0xe9, 0x77, 0x65, 0x5d, 0x1b, 0x8e, 0x5c, 0xbb, 0xa2, 0xdf, 0xc5, 0x6f,
-	0x41, 0x89, 0x79, 0x47, 0xd7, 0x90, 0xf4, 0x70, 0x47, 0xe0, 0x21, 0x59, 0x40, 0x22, 0x44, 0x9f,
-	0xf5, 0xc3, 0xc1, 0x0c, 0x5f, 0xfe, 0x37, 0x31, 0x22, 0x29, 0x3c, 0x50, 0x30, 0x85, 0xf9, 0xf8,
-	0x6e, 0x2b, 0x18, 0xdf, 0xef, 0xb9, 0xd2, 0xaa, 0xf9, 0x1d, 0xbb, 0xb7, 0xb4, 0x2e, 0xc1, 0x48,
-	0xa8, 0x88, 0xe3, 0x7b, 0x45, 0xe2, 0x1b, 0x96, 0xd5, 0x92, 0xef, 0x91, 0xf8, 0x9c, 0x23, 0xf1,
-	0xe9, 0x74, 0xa3, 0x55, 0x24, 0xf5, 0x01, 0x62, 0x14, 0x2c, 0xa1, 0xdc, 0xef, 0x8f, 0x73, 0x31,
-	0xc9, 0x1a, 0x9d, 0x30, 0x43, 0x0d, 0xbf, 0x63, 0x17, 0x88, 0xdd, 0x20, 0x99, 0xbf, 0x68, 0xe3,
-	0xb7, 0xa1, 0xc4, 0xb9, 0x29, 0x0d, 0x17, 0xbb, 0x48, 0x82, 0x10, 0xfa, 0x27, 0x1d, 0x40, 0xe6,
-	0x1b, 0xb1, 0xa5, 0xdc, 0x45, 0x02, 0x48, 0x0c, 0x48, 0x00, 0xef, 0x89, 0x34, 0xe1, 0xea, 0xf3,
-	0xc5, 0x35, 0x91, 0x39, 0x19, 0x95, 0xbf, 0x50, 0x5c, 0x53, 0x3d, 0xfb, 0xb3, 0xa0, 0xf4, 0x48,
-	0x8b, 0xb6, 0x26, 0x6d, 0xff, 0x16, 0xa4, 0x1d, 0x28, 0x28, 0x6d, 0x9d, 0x2f, 0x8d, 0xf0, 0x0d,
-	0x1c, 0x97, 0xc6, 0x96, 0x1d, 0x53, 0xbe, 0x44, 0x7c, 0xf0, 0xf8, 0x1d, 0xe2, 0x84, 0x0e, 0xcf,
-	0x13, 0x54, 0xec, 0x3c, 0x21, 0x70, 0x7e, 0x97, 0xec, 0x45, 0x5d, 0x97, 0x4e, 0xe8, 0xc0, 0x26,
-	0x95, 0x58, 0x21, 0x5b, 0x32, 0xb1, 0x5e, 0x90, 0x4d, 0x2a, 0xb1, 0x1e, 0x24, 0x12, 0x2b, 0xb1,
-	0xff, 0x4b, 0x4b, 0xac, 0xad, 0x8b, 0xf4, 0x71, 0x2c, 0xd2, 0x75, 0x23, 0x7a, 0xd3, 0x24, 0xbe,
-	0x4c, 0xa2, 0xd4, 0x45, 0x05, 0x53, 0xb7, 0xb0, 0x4c, 0x3d, 0x04, 0x2f, 0xe9, 0x9d, 0x56, 0x83,
-	0x61, 0xd7, 0xb0, 0xac, 0xa2, 0x2a, 0x95, 0x42, 0xa3, 0xa0, 0x92, 0x44, 0x90, 0x5c, 0x32, 0x6d,
-	0x11, 0x52, 0xf9, 0x06, 0x41, 0x25, 0x6b, 0x07, 0xa6, 0xc3, 0x56, 0x42, 0xf1, 0x12, 0x34, 0x98,
-	0x7f, 0xb4, 0x1f, 0x5e, 0xa1, 0x9f, 0xc5, 0xf8, 0x57, 0x04, 0xa3, 0xa2, 0x46, 0x0c, 0x3e, 0x25,
-	0xfa, 0x22, 0xde, 0xac, 0xb7, 0x53, 0x3e, 0x5d, 0xd0, 0x2a, 0xd0, 0x43, 0x59, 0xf8, 0xe4, 0xf7,
-	0x7f, 0x3f, 0xef, 0x9f, 0xc5, 0xc7, 0x35, 0x41, 0xff, 0x49, 0x8f, 0xbf, 0xa4, 0xea, 0x5c, 0xdb,
-	0x05, 0x3f, 0x42, 0xb0, 0x2f, 0xa3, 0x53, 0x83, 0xdf, 0xc8, 0xe3, 0xc8, 0x69, 0x01, 0x95, 0xcf,
-	0x14, 0x37, 0x64, 0x3e, 0xbc, 0x4e, 0x7d, 0x38, 0x89, 0xd5, 0x2c, 0x1f, 0xb8, 0xeb, 0x31, 0xef,
-	0xc6, 0x03, 0x04, 0x23, 0x3d, 0x8d, 0x1d, 0xac, 0x49, 0xc8, 0xc8, 0xf7, 0x64, 0xca, 0x27, 0xe5,
-	0x0d, 0x18, 0xee, 0x2c, 0xc5, 0x3d, 0x82, 0xa7, 0x36, 0x93, 0x9c, 0x76, 0x6f, 0xf0, 0x43, 0x04,
-	0x38, 0xdd, 0xf0, 0xc1, 0xf3, 0x72, 0x72, 0x25, 0x58, 0x17, 0x0a, 0xd9, 0x30, 0xdc, 0x93, 0x14,
-	0xf7, 0x18, 0x9e, 0x91, 0x50, 0x37, 0x20, 0xbe, 0x8f, 0xa0, 0xc4, 0x79, 0x8e, 0x8f, 0x67, 0x6e,
-	0x9b, 0x6e, 0x28, 0x95, 0x4f, 0xc8, 0x4d, 0x66, 0x70, 0x33, 0x14, 0x4e, 0xc1, 0x55, 0x11, 0x1c,
-	0xaf, 0x23, 0xfe, 0x1a, 0xc1, 0xae, 0xa4, 0x8b, 0x58, 0xcd, 0xdc, 0x4a, 0xd8, 0x42, 0x2a, 0x6b,
-	0xd2, 0xf3, 0x19, 0xdd, 0x71, 0x4a, 0x37, 0x85, 0x27, 0x45, 0x74, 0x3d, 0xb2, 0xe1, 0x1f, 0x11,
-	0xec, 0x15, 0xf4, 0x65, 0xf0, 0x82, 0xc4, 0xae, 0xbd, 0xdd, 0xa2, 0xf2, 0xa9, 0x62, 0x46, 0x8c,
-	0x57, 0xa5, 0xbc, 0x33, 0x78, 0x7a, 0x13, 0xde, 0xb0, 0xf3, 0xf4, 0x05, 0x82, 0x61, 0xbe, 0xdb,
-	0x82, 0xb3, 0x83, 0x27, 0xe8, 0xf8, 0x94, 0x67, 0x25, 0x67, 0x33, 0xba, 0xa3, 0x94, 0x6e, 0x12,
-	0x1f, 0x12, 0xd1, 0x25, 0xba, 0x43, 0xf8, 0x3b, 0x04, 0x23, 0x3d, 0x7d, 0x97, 0x9c, 0xca, 0x16,
-	0xf7, 0x81, 0x72, 0x2a, 0x3b, 0xa3, 0x33, 0xa4, 0x9c, 0xa0, 0x84, 0xd3, 0xf8, 0x70, 0x36, 0x61,
-	0xac, 0x22, 0x55, 0x8f, 0x6f, 0xe8, 0xe0, 0x4d, 0x53, 0x9f, 0x6f, 0x0a, 0xe5, 0xa8, 0x27, 0xea,
-	0x12, 0xe5, 0xab, 0x97, 0xe8, 0x1f, 0xe1, 0x7b, 0x30, 0xc8, 0x0e, 0xf3, 0xe9, 0xcc, 0x3d, 0x92,
-	0x67, 0xf7, 0x91, 0x4d, 0xe7, 0x31, 0x0a, 0x85, 0x52, 0x8c, 0xe3, 0xb2, 0x88, 0x82, 0x1d, 0xcb,
-	0x0f, 0x11, 0x8c, 0x8a, 0x7a, 0x28, 0xe2, 0x08, 0xe6, 0x74, 0x84, 0xc4, 0x11, 0xcc, 0x6b, 0xcf,
-	0x28, 0xa7, 0x28, 0x9f, 0x8a, 0x4f, 0x88, 0xf8, 0x0c, 0x66, 0x59, 0xa7, 0xf7, 0xec, 0x46, 0xb7,
-	0xee, 0xde, 0xd1, 0xdb, 0x75, 0xd3, 0xc6, 0x3f, 0x23, 0x78, 0x55, 0xd8, 0x52, 0xc0, 0x92, 0x04,
-	0xf1, 0x37, 0x50, 0x79, 0xae, 0x80, 0x05, 0x83, 0x3e, 0x4d, 0xa1, 0x35, 0x3c, 0x2b, 0x0f, 0xed,
-	0xf8, 0x5e, 0x42, 0x67, 0xfe, 0x22, 0x9d, 0xaf, 0xb3, 0xa0, 0x3d, 0x90, 0xaf, 0xb3, 0xe8, 0x8e,
-	0x2e, 0xa9, 0x33, 0xbd, 0xb3, 0x65, 0xe8, 0x9c, 0xb8, 0x66, 0x62, 0x49, 0x02, 0x59, 0x9d, 0x85,
-	0x77, 0x58, 0x49, 0x9d, 0x13, 0xd0, 0x44, 0xe7, 0x1f, 0x12, 0x3a, 0xc7, 0xf7, 0xbb, 0xcd, 0x74,
-	0x4e, 0xdd, 0x35, 0x37, 0xd3, 0x39, 0x7d, 0x75, 0xcc, 0xff, 0xbc, 0xe3, 0x90, 0xbb, 0xf5, 0xf8,
-	0xc3, 0x1b, 0xff, 0x94, 0x90, 0x99, 0xbb, 0x74, 0x61, 0x49, 0x00, 0x79, 0x99, 0x05, 0x37, 0x3a,
-	0xe9, 0xdc, 0x88, 0x99, 0x89, 0xca, 0x3c, 0x74, 0xe2, 0x0a, 0x94, 0x0f, 0x2d, 0xba, 0xaf, 0xe5,
-	0x43, 0x0b, 0xef, 0x57, 0x92, 0xd0, 0xe4, 0x26, 0xc0, 0x2b, 0xfd, 0x0b, 0x82, 0xd7, 0xc4, 0x17,
-	0x16, 0x2c, 0xcb, 0xc0, 0x69, 0x3d, 0x5f, 0xc4, 0xa4, 0x50, 0x4e, 0x27, 0xb8, 0x1d, 0xdf, 0xab,
-	0x5d, 0x7e, 0xfc, 0xac, 0x82, 0x9e, 0x3c, 0xab, 0xa0, 0x7f, 0x9e, 0x55, 0xd0, 0xa7, 0xcf, 0x2b,
-	0x7d, 0x4f, 0x9e, 0x57, 0xfa, 0xfe, 0x7c, 0x5e, 0xe9, 0xbb, 0x39, 0xc7, 0xfd, 0xdc, 0x60, 0x58,
-	0x5d, 0xd7, 0xf4, 0x5b, 0x6e, 0xf0, 0x5b, 0x38, 0xb7, 0xc3, 0x5d, 0xb6, 0x07, 0xfd, 0xf5, 0xa1,
-	0x31, 0x48, 0x7f, 0xc1, 0x5e, 0xf8, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x0a, 0xe2, 0xa5, 0xd8, 0x8d,
-	0x1f, 0x00, 0x00,
+	// 2005 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x9a, 0xcf, 0x6f, 0xdc, 0xc6,
+	0x15, 0xc7, 0x4d, 0xc9, 0x96, 0xe2, 0xb7, 0x6b, 0x4b, 0x99, 0xa8, 0x89, 0x4c, 0x49, 0xab, 0x35,
+	0x15, 0x29, 0x8a, 0x6d, 0x91, 0x5a, 0xc9, 0x69, 0x85, 0x1e, 0xd2, 0x78, 0xed, 0xc4, 0x55, 0x13,
+	0x43, 0x8e, 0xa4, 0x16, 0x45, 0x2e, 0x04, 0x77, 0x35, 0x5a, 0xb1, 0xe2, 0x92, 0x6b, 0xfe, 0x90,
+	0xbd, 0x87, 0xb4, 0x40, 0xff, 0x82, 0x36, 0x05, 0x7a, 0x28, 0x82, 0xa2, 0x6d, 0x80, 0x02, 0xbd,
+	0x34, 0x45, 0x0b, 0x14, 0x3d, 0xf6, 0xd0, 0x43, 0x7a, 0x0b, 0xd0, 0x1e, 0xda, 0x1e, 0x8c, 0xc2,
+	0xee, 0x1f, 0x52, 0xcc, 0x70, 0x48, 0x0e, 0x77, 0x87, 0xab, 0xa1, 0xe4, 0x43, 0x4e, 0xb6, 0x66,
+	0xe6, 0xbd, 0xf9, 0xbc, 0xef, 0xcc, 0xbc, 0x19, 0x3e, 0x09, 0x6a, 0x2d, 0xc7, 0x6a, 0x1f, 0x1f,
+	0x46, 0x7e, 0xdf, 0xe8, 0x5a, 0xc7, 0xd8, 0x37, 0x4e, 0x1a, 0xc6, 0xa3, 0x08, 0xfb, 0x7d, 0xbd,
+	0xe7, 0x7b, 0xa1, 0x87, 0x50, 0xda, 0xaf, 0xd3, 0x7e, 0xfd, 0xa4, 0xa1, 0xce, 0x74, 0xbc, 0x8e,
+	0x47, 0xbb, 0x0d, 0xf2, 0xbf, 0x78, 0xa4, 0x3a, 0xdf, 0xf1, 0xbc, 0x8e, 0x83, 0x0d, 0xab, 0x67,
+	0x1b, 0x96, 0xeb, 0x7a, 0xa1, 0x15, 0xda, 0x9e, 0x1b, 0xb0, 0xde, 0xba, 0x60, 0x9e, 0x0e, 0x76,
+	0x71, 0x60, 0x27, 0x23, 0x44, 0x24, 0xf1, 0x94, 0xac, 0xbf, 0xed, 0x05, 0x5d, 0x2f, 0x30, 0x5a,
+	0x56, 0x80, 0x8d, 0x93, 0x46, 0x0b, 0x87, 0x56, 0xc3, 0x68, 0x7b, 0xb6, 0x1b, 0xf7, 0x6b, 0x1a,
+	0xd4, 0x3f, 0x24, 0xe0, 0x77, 0x1c, 0xa7, 0x69, 0xb5, 0x8f, 0x6d, 0xb7, 0xb3, 0x6b, 0x07, 0xc7,
+	0x0f, 0x2d, 0xdf, 0xea, 0x06, 0xbb, 0xf8, 0x51, 0x84, 0x83, 0x50, 0x7b, 0x04, 0xd7, 0x47, 0x8c,
+	0x09, 0x7a, 0x9e, 0x1b, 0x60, 0xf4, 0x01, 0x54, 0x7c, 0x3b, 0x38, 0x36, 0x7b, 0xb4, 0x79, 0x56,
+	0xa9, 0x8f, 0xaf, 0x56, 0x36, 0x96, 0xf5, 0x61, 0x21, 0xf4, 0x21, 0x1f, 0xcd, 0x8b, 0x5f, 0x3c,
+	0x5d, 0xbc, 0xb0, 0x0b, 0x7e, 0xda, 0xa2, 0x2d, 0xc3, 0x52, 0x32, 0xe5, 0x5d, 0xcf, 0x71, 0xac,
+	0x10, 0xfb, 0x96, 0x33, 0x4c, 0xf6, 0x18, 0x5e, 0x1f, 0x3d, 0x8c, 0xc1, 0xed, 0x88, 0xe0, 0x56,
+	0x45, 0x70, 0x22, 0x37, 0x02, 0xbe, 0x05, 0x98, 0x1b, 0x90, 0xe4, 0xa1, 0xe7, 0x39, 0x29, 0xd7,
+	0x0f, 0x60, 0x5e, 0xdc, 0xcd, 0x78, 0xbe, 0x03, 0x57, 0x5a, 0x71, 0xbb, 0xd9, 0x23, 0x1d, 0x8c,
+	0x68, 0x51, 0x44, 0x44, 0x2c, 0x99, 0x13, 0x06, 0x52, 0x6d, 0x71, 0x3e, 0xb5, 0x3a, 0xd4, 0x86,
+	0x35, 0xc8, 0xd1, 0x9c, 0xc0, 0x62, 0xe1, 0x08, 0x06, 0xb4, 0x07, 0xd3, 0xed, 0xb4, 0x2b, 0xc7,
+	0xa4, 0x15, 0x31, 0x65, 0xae, 0x18, 0xd6, 0x54, 0x3b, 0xef, 0x5c, 0x7b, 0x1b, 0x5e, 0xa3, 0xf3,
+	0x72, 0x12, 0x30, 0x24, 0xb4, 0x94, 0x09, 0x70, 0x80, 0x5d, 0xaf, 0x3b, 0xab, 0xd4, 0x95, 0xd5,
+	0xcb, 0x69, 0x64, 0xf7, 0x48, 0x9b, 0x76, 0x00, 0xb3, 0xc3, 0xf6, 0x0c, 0xf8, 0xdb, 0x50, 0xe5,
+	0x15, 0xa4, 0xf6, 0xd2, 0x02, 0x56, 0x38, 0x01, 0xb5, 0xfb, 0xa0, 0xd2, 0x59, 0xf2, 0xd2, 0x24,
+	0xa0, 0x6f, 0xe6, 0x84, 0xe1, 0x59, 0xb9, 0x70, 0x63, 0xdc, 0x1e, 0xcc, 0x09, 0x1d, 0x31, 0xe2,
+	0x0f, 0x61, 0x6a, 0x40, 0x62, 0x06, 0x2d, 0xaf, 0xf0, 0xd5, 0xbc, 0xc2, 0xda, 0x21, 0x5b, 0xd8,
+	0x6c, 0xe0, 0xce, 0xe1, 0x9d, 0x76, 0xdb, 0x8b, 0xdc, 0x30, 0xe1, 0x9f, 0x85, 0x49, 0x2b, 0x6e,
+	0x61, 0xd8, 0xc9, 0x8f, 0xc2, 0xc8, 0xc6, 0xc4, 0x91, 0xfd, 0x10, 0xea, 0xc5, 0xf3, 0xb0, 0xf0,
+	0x3e, 0x02, 0xc4, 0x3c, 0x9b, 0x99, 0x39, 0x8b, 0x50, 0x98, 0x06, 0x98, 0x83, 0xa1, 0x20, 0x5f,
+	0xb6, 0x06, 0x3b, 0xb4, 0xad, 0xe4, 0x38, 0xc5, 0x3d, 0x0f, 0xbd, 0xc0, 0xa6, 0x59, 0xf2, 0xd4,
+	0x20, 0xb5, 0x4f, 0xc7, 0x61, 0xa1, 0xc0, 0x94, 0x71, 0xdf, 0x87, 0xcb, 0xbd, 0xa4, 0x91, 0x6d,
+	0xf9, 0xa5, 0x11, 0xb8, 0x89, 0x03, 0x06, 0x9b, 0xd9, 0xa2, 0x03, 0x78, 0x35, 0xf4, 0x42, 0xcb,
+	0xe1, 0xc2, 0x37, 0x4f, 0x2c, 0x27, 0xc2, 0xb1, 0xaa, 0x4d, 0x9d, 0x18, 0xfc, 0xe7, 0xe9, 0xe2,
+	0x4a, 0xc7, 0x0e, 0x8f, 0xa2, 0x96, 0xde, 0xf6, 0xba, 0x06, 0x4b, 0xce, 0xf1, 0x3f, 0x6b, 0xc1,
+	0xc1, 0xb1, 0x11, 0xf6, 0x7b, 0x38, 0xd0, 0xef, 0xe1, 0xf6, 0xee, 0x0c, 0xf5, 0x96, 0xa9, 0xf0,
+	0x3d, 0xe2, 0x0b, 0x7d, 0x1f, 0xa6, 0xe3, 0x59, 0x0e, 0x70, 0x2b, 0x64, 0xfe, 0xc7, 0xcf, 0xe4,
+	0xff, 0x2a, 0xf5, 0x73, 0x0f, 0xb7, 0xc2, 0xd8, 0xf3, 0x0e, 0x5c, 0x39, 0xc2, 0x96, 0x13, 0x1e,
+	0x99, 0x87, 0x56, 0x3b, 0xf4, 0xfc, 0xd9, 0x8b, 0xd4, 0xed, 0x8d, 0x12, 0x2e, 0xab, 0xb1, 0x83,
+	0xf7, 0xa8, 0x3d, 0xba, 0x0e, 0xd5, 0xb6, 0xef, 0x05, 0x81, 0xd9, 0xb5, 0xfc, 0x8e, 0xed, 0xce,
+	0x5e, 0xaa, 0x2b, 0xab, 0x2f, 0xed, 0x56, 0x68, 0xdb, 0x03, 0xda, 0xa4, 0xa9, 0xec, 0x84, 0xef,
+	0x13, 0x94, 0xe4, 0x5e, 0x60, 0x59, 0xeb, 0x08, 0xae, 0x09, 0xfa, 0xd8, 0xaa, 0xbd, 0x0f, 0x57,
+	0x62, 0x19, 0xd8, 0x49, 0x66, 0x1b, 0xad, 0x2e, 0x5a, 0x39, 0xde, 0x41, 0x92, 0x41, 0x43, 0xae,
+	0x2d, 0x4d, 0xe6, 0xfb, 0x79, 0xc1, 0x13, 0x90, 0x10, 0xe6, 0xc5, 0xdd, 0x8c, 0x65, 0x1f, 0xa6,
+	0x07, 0x17, 0x9e, 0xe1, 0x2c, 0x15, 0xe2, 0x0c, 0x27, 0xcf, 0x81, 0xd5, 0x4e, 0xa5, 0x49, 0x22,
+	0x27, 0xef, 0x82, 0x84, 0xe8, 0x53, 0x05, 0xae, 0x09, 0x3a, 0xd3, 0x5c, 0x9e, 0xe6, 0x56, 0x9f,
+	0x74, 0xcc, 0x2a, 0x67, 0xda, 0x1f, 0xd5, 0x16, 0xe7, 0x1c, 0xdd, 0x80, 0x97, 0x1d, 0x2b, 0x08,
+	0xcd, 0xa8, 0x77, 0x60, 0x85, 0xd8, 0x6c, 0x39, 0x5e, 0xfb, 0x98, 0x6e, 0xec, 0xf1, 0xdd, 0x29,
+	0xd2, 0xf1, 0x5d, 0xda, 0xde, 0x24, 0xcd, 0xfc, 0x8d, 0xb4, 0xef, 0x63, 0x2b, 0x88, 0xfc, 0xfe,
+	0x07, 0xf8, 0xa0, 0x83, 0xfd, 0xf4, 0x46, 0xc2, 0xb0, 0x58, 0x38, 0x82, 0x45, 0xd1, 0x84, 0x49,
+	0x27, 0x6e, 0x1a, 0x75, 0x11, 0xe5, 0xad, 0x99, 0x96, 0x89, 0xa1, 0xb6, 0xc7, 0x52, 0x7b, 0x7e,
+	0x54, 0x92, 0x35, 0x66, 0xe0, 0x12, 0x9f, 0xcf, 0xe3, 0x1f, 0xd0, 0x22, 0x54, 0xac, 0x20, 0xc0,
+	0xa1, 0xd9, 0x76, 0xac, 0x20, 0x60, 0x19, 0x11, 0x68, 0xd3, 0x5d, 0xd2, 0xa2, 0x99, 0x30, 0x27,
+	0x74, 0xca, 0xb8, 0xdf, 0x81, 0x89, 0x78, 0xfa, 0x51, 0xd9, 0x5d, 0x88, 0xcd, 0xec, 0xb4, 0x19,
+	0x40, 0x74, 0x82, 0xfc, 0x53, 0x67, 0x07, 0x5e, 0xc9, 0xb5, 0xb2, 0xe9, 0xb6, 0x60, 0x22, 0x7d,
+	0xd4, 0x90, 0xe9, 0x54, 0xe1, 0x65, 0xc2, 0x3f, 0x63, 0xd8, 0x78, 0xed, 0xd7, 0x0a, 0xcc, 0xbd,
+	0x1b, 0x84, 0x76, 0xd7, 0x0a, 0xf1, 0x03, 0xdb, 0x0d, 0x9b, 0xfd, 0xbd, 0xc7, 0x56, 0x6f, 0xdb,
+	0x4d, 0xe4, 0xf9, 0x26, 0xbc, 0xd4, 0xb5, 0xdd, 0xd0, 0xf4, 0xa2, 0x90, 0xf9, 0xbe, 0xa6, 0xc7,
+	0x1b, 0x45, 0x6f, 0x59, 0x01, 0xd6, 0xd9, 0x63, 0x52, 0xbf, 0xeb, 0xd9, 0x49, 0x36, 0x9c, 0x24,
+	0x06, 0x3b, 0x91, 0xe0, 0x7a, 0x1f, 0x1b, 0xbe, 0xde, 0x49, 0x7e, 0x38, 0x8c, 0x9c, 0xec, 0x08,
+	0x8f, 0xc7, 0xf9, 0x81, 0xb4, 0x25, 0x27, 0xf3, 0x9f, 0x0a, 0xcc, 0x8b, 0x19, 0x59, 0xf8, 0x6f,
+	0x03, 0x24, 0x13, 0xd9, 0xae, 0x2c, 0xe6, 0x65, 0x66, 0xb2, 0xed, 0xa2, 0x2d, 0x98, 0x24, 0x52,
+	0x11, 0xe3, 0x31, 0x39, 0xe3, 0x09, 0x32, 0x7e, 0xdb, 0x4d, 0xe5, 0x39, 0xc4, 0x71, 0x02, 0x96,
+	0x95, 0xe7, 0x3d, 0x8c, 0xb5, 0xbf, 0x0b, 0xc3, 0xda, 0x89, 0xd2, 0x5b, 0xfb, 0x5d, 0xb8, 0x9a,
+	0x85, 0x65, 0x76, 0xad, 0x27, 0xb2, 0xa1, 0x55, 0xd3, 0xd0, 0x1e, 0x58, 0x4f, 0xd0, 0xb7, 0xa0,
+	0xc2, 0xa2, 0xa3, 0x3e, 0x24, 0x23, 0xbc, 0x1c, 0x47, 0x48, 0x1c, 0x48, 0x2c, 0xd1, 0x4f, 0xc7,
+	0x60, 0xa1, 0x20, 0x96, 0xaf, 0xcc, 0x1a, 0x91, 0x2d, 0x3c, 0x5e, 0x72, 0x0b, 0xf3, 0xeb, 0x7b,
+	0xb1, 0xe4, 0xfa, 0xfe, 0x8e, 0x3b, 0x5a, 0xcd, 0xc8, 0x77, 0x07, 0x8f, 0xd6, 0x7d, 0x98, 0x4a,
+	0x14, 0xf1, 0xa2, 0xb0, 0xcc, 0xfa, 0x26, 0xc7, 0x6a, 0x27, 0x0a, 0xc9, 0xfa, 0xdc, 0x21, 0xeb,
+	0xe3, 0xf7, 0x53, 0x2f, 0x92, 0xfa, 0x00, 0x31, 0x8a, 0x5d, 0x68, 0x9f, 0x8c, 0xc1, 0xbc, 0x98,
+	0x35, 0xcd, 0x30, 0x93, 0xad, 0xc8, 0x77, 0x4b, 0xac, 0xdd, 0x04, 0x19, 0xbf, 0xed, 0xa2, 0x77,
+	0xa0, 0xc2, 0x85, 0x29, 0x0d, 0x97, 0x85, 0x48, 0x16, 0x21, 0x89, 0x4f, 0x7a, 0x01, 0x59, 0x6c,
+	0xc4, 0x96, 0x72, 0x97, 0x59, 0x40, 0x62, 0x40, 0x16, 0xf0, 0x63, 0x91, 0x26, 0xdc, 0xf9, 0x3c,
+	0xbb, 0x26, 0x32, 0x99, 0x51, 0xfb, 0xb7, 0x02, 0x0b, 0x05, 0xf3, 0xa7, 0xb7, 0x4c, 0x4e, 0x5a,
+	0xe5, 0x7c, 0xd2, 0x8e, 0x9d, 0x43, 0xda, 0xf1, 0x92, 0xd2, 0x9a, 0xfc, 0xd1, 0x48, 0x1e, 0x30,
+	0xd9, 0xd1, 0x38, 0x77, 0x60, 0xda, 0x2f, 0x14, 0x98, 0x17, 0xcf, 0x90, 0x6d, 0xe8, 0x24, 0x9f,
+	0x28, 0xe5, 0xf2, 0x09, 0x81, 0x8b, 0xfa, 0x64, 0x2e, 0x1a, 0xba, 0xf4, 0x86, 0x8e, 0x6d, 0x86,
+	0x36, 0x56, 0xc2, 0x96, 0xdf, 0x58, 0x67, 0x64, 0x93, 0xda, 0x58, 0x9f, 0xe5, 0x36, 0x56, 0x6e,
+	0xfe, 0x17, 0xb6, 0xb1, 0xce, 0x2f, 0xd2, 0x8f, 0x32, 0x91, 0xf6, 0xb0, 0xe3, 0x70, 0x2b, 0x98,
+	0xbe, 0x4c, 0xd2, 0xad, 0xab, 0x94, 0xdc, 0xba, 0xa5, 0x65, 0x1a, 0x20, 0x78, 0x41, 0x77, 0x5a,
+	0x13, 0xaa, 0x01, 0x76, 0x9c, 0xb2, 0x2a, 0x55, 0x12, 0xa3, 0xf8, 0x24, 0x89, 0x20, 0xb9, 0xcd,
+	0x74, 0x4e, 0x48, 0xed, 0x57, 0x0a, 0xd4, 0x8a, 0x66, 0x60, 0x3a, 0x9c, 0x67, 0x29, 0x5e, 0x80,
+	0x06, 0x1b, 0x7f, 0x5d, 0x80, 0x4b, 0xf4, 0x59, 0x8c, 0xfe, 0xa2, 0xc0, 0x8c, 0xa8, 0x40, 0x89,
+	0x6e, 0x8b, 0x5e, 0xc4, 0xa7, 0xd5, 0x3c, 0xd5, 0xb7, 0x4a, 0x5a, 0xc5, 0x7a, 0x68, 0x9b, 0x3f,
+	0xfe, 0xc7, 0xff, 0x7e, 0x36, 0xb6, 0x86, 0x6e, 0x1a, 0x82, 0xba, 0xac, 0x95, 0xbd, 0xa4, 0x4c,
+	0xae, 0x1c, 0x89, 0xfe, 0xa6, 0xc0, 0x6b, 0x05, 0x15, 0x4c, 0xf4, 0x8d, 0x51, 0x1c, 0x23, 0x4a,
+	0xa3, 0xea, 0x56, 0x79, 0x43, 0x16, 0xc3, 0xd7, 0x69, 0x0c, 0xeb, 0x48, 0x2f, 0x8a, 0x81, 0x2b,
+	0x70, 0xf0, 0x61, 0x7c, 0xa6, 0xc0, 0xd4, 0x40, 0xc1, 0x13, 0x19, 0x12, 0x32, 0xf2, 0xb5, 0x4a,
+	0x75, 0x5d, 0xde, 0x80, 0xe1, 0xae, 0x51, 0xdc, 0x37, 0xd0, 0xf2, 0x69, 0x92, 0xd3, 0xaa, 0x26,
+	0xfa, 0x5c, 0x01, 0x34, 0x5c, 0x08, 0x45, 0x1b, 0x72, 0x72, 0xe5, 0x58, 0x37, 0x4b, 0xd9, 0x30,
+	0xdc, 0x75, 0x8a, 0x7b, 0x03, 0xad, 0x4a, 0xa8, 0x1b, 0x13, 0x7f, 0xa2, 0x40, 0x85, 0x8b, 0x1c,
+	0xdd, 0x2c, 0x9c, 0x76, 0xb8, 0xd0, 0xaa, 0xde, 0x92, 0x1b, 0xcc, 0xe0, 0x56, 0x29, 0x9c, 0x86,
+	0xea, 0x22, 0x38, 0x5e, 0x47, 0xf4, 0x4b, 0x05, 0xae, 0xe6, 0x43, 0x44, 0x7a, 0xe1, 0x54, 0xc2,
+	0xd2, 0xaa, 0x6a, 0x48, 0x8f, 0x67, 0x74, 0x37, 0x29, 0xdd, 0x32, 0x5a, 0x12, 0xd1, 0x0d, 0xc8,
+	0x86, 0xfe, 0xa0, 0xc0, 0x2b, 0x82, 0x7a, 0x25, 0xda, 0x94, 0x98, 0x75, 0xb0, 0x8a, 0xaa, 0xde,
+	0x2e, 0x67, 0xc4, 0x78, 0x75, 0xca, 0xbb, 0x8a, 0x56, 0x4e, 0xe1, 0x4d, 0x2a, 0xb2, 0xbf, 0x55,
+	0x60, 0x7a, 0xb0, 0x4e, 0x89, 0x46, 0x1c, 0x08, 0x71, 0x35, 0x54, 0x6d, 0x94, 0xb0, 0x90, 0x3a,
+	0x43, 0xb1, 0x95, 0x99, 0x95, 0x3a, 0x7f, 0xae, 0x40, 0x95, 0xaf, 0xaa, 0xa1, 0xe2, 0x5d, 0x26,
+	0xa8, 0xec, 0xa9, 0x6b, 0x92, 0xa3, 0x19, 0xdc, 0x9b, 0x14, 0x6e, 0x09, 0x5d, 0x17, 0xc1, 0xe5,
+	0xaa, 0x80, 0xe8, 0x37, 0x0a, 0x4c, 0x0d, 0xd4, 0xd7, 0x46, 0xa4, 0x20, 0x71, 0xbd, 0x4f, 0x5d,
+	0x97, 0x37, 0x60, 0x84, 0xb7, 0x28, 0xe1, 0x0a, 0x7a, 0xbd, 0x98, 0x30, 0x5b, 0x6e, 0xaa, 0x1e,
+	0x5f, 0xb8, 0x43, 0xa7, 0x9e, 0x51, 0xbe, 0xf8, 0xa7, 0xae, 0x49, 0x8e, 0x96, 0x51, 0x2f, 0x57,
+	0x27, 0x4c, 0x52, 0xe3, 0x40, 0x45, 0x6e, 0x74, 0x6a, 0x14, 0x17, 0xf8, 0xd4, 0xcd, 0x52, 0x36,
+	0xb2, 0xa9, 0x31, 0x64, 0x86, 0x26, 0x2b, 0xf0, 0xd1, 0x2c, 0x94, 0xf7, 0x36, 0x22, 0x0b, 0x09,
+	0xab, 0x80, 0xaa, 0x21, 0x3d, 0x5e, 0x26, 0x0b, 0x0d, 0x10, 0xa2, 0x8f, 0x61, 0x82, 0x5d, 0xe4,
+	0x2b, 0x85, 0xf3, 0xe4, 0xef, 0xed, 0x37, 0x4e, 0x1d, 0xc7, 0x38, 0x34, 0xca, 0x31, 0x8f, 0x54,
+	0x11, 0x07, 0xbb, 0x92, 0x3f, 0x57, 0x60, 0x46, 0x54, 0x3f, 0x13, 0x1f, 0x8a, 0x11, 0xd5, 0x40,
+	0x75, 0x5d, 0xde, 0x80, 0xf1, 0xdd, 0xa6, 0x7c, 0x3a, 0xba, 0x25, 0xe2, 0xc3, 0xcc, 0xd2, 0xa4,
+	0x35, 0x96, 0x56, 0xdf, 0x0c, 0x1e, 0x5b, 0x3d, 0xd3, 0x76, 0xd1, 0x9f, 0x14, 0xf8, 0x9a, 0xb0,
+	0x9c, 0x84, 0x24, 0x09, 0xb2, 0xf7, 0xaf, 0xda, 0x28, 0x61, 0xc1, 0xa0, 0xdf, 0xa2, 0xd0, 0x06,
+	0x5a, 0x93, 0x87, 0xf6, 0xa2, 0x30, 0xa7, 0x33, 0x5f, 0x44, 0x19, 0xad, 0xb3, 0xa0, 0x34, 0xa4,
+	0xae, 0xcb, 0x1b, 0x94, 0xd2, 0x99, 0x7e, 0xaf, 0x17, 0xe8, 0x9c, 0x2b, 0x31, 0x20, 0x49, 0x02,
+	0x59, 0x9d, 0x85, 0xf5, 0x0b, 0x49, 0x9d, 0x73, 0xd0, 0x44, 0xe7, 0xdf, 0xe7, 0x74, 0xce, 0xbe,
+	0xed, 0x4f, 0xd3, 0x79, 0xa8, 0xce, 0xa0, 0xae, 0xcb, 0x1b, 0xc8, 0x3c, 0xed, 0x39, 0xe4, 0xbe,
+	0x99, 0x7d, 0x74, 0xa1, 0x3f, 0xe6, 0x64, 0xe6, 0x3e, 0xb8, 0x91, 0x24, 0x80, 0xbc, 0xcc, 0x82,
+	0xaf, 0x79, 0xe9, 0xbd, 0x91, 0x31, 0x13, 0x95, 0x79, 0xe8, 0xdc, 0xe7, 0xef, 0x68, 0x68, 0xd1,
+	0xb7, 0xba, 0xda, 0x28, 0x61, 0x51, 0x0a, 0x9a, 0x7c, 0x05, 0xf2, 0x4a, 0xff, 0x59, 0x81, 0x57,
+	0xc5, 0x1f, 0xab, 0x48, 0x96, 0x81, 0xd3, 0x7a, 0xa3, 0x8c, 0x49, 0xa9, 0x3d, 0x9d, 0xe3, 0xf6,
+	0xa2, 0xb0, 0xf9, 0xfe, 0x17, 0xcf, 0x6a, 0xca, 0x97, 0xcf, 0x6a, 0xca, 0x7f, 0x9f, 0xd5, 0x94,
+	0x9f, 0x3c, 0xaf, 0x5d, 0xf8, 0xf2, 0x79, 0xed, 0xc2, 0xbf, 0x9e, 0xd7, 0x2e, 0x7c, 0xd4, 0xe0,
+	0x7e, 0x53, 0x87, 0x9d, 0x7e, 0x60, 0x47, 0xdd, 0x20, 0xfe, 0xfb, 0x20, 0x6e, 0x86, 0x27, 0x6c,
+	0x0e, 0xfa, 0x8b, 0xbb, 0xd6, 0x04, 0xfd, 0xab, 0x9e, 0xcd, 0xff, 0x0f, 0x00, 0x96, 0xd2, 0x38,
+	0x50, 0xa1, 0x24, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2008,12 +2313,18 @@ type QueryClient interface {
 	CollateralPool(ctx context.Context, in *QueryCollateralPoolRequest, opts ...grpc.CallOption) (*QueryCollateralPoolResponse, error)
 	// CollateralOfAccount queries the collateral of an account.
 	CollateralOfAccount(ctx context.Context, in *QueryCollateralOfAccountRequest, opts ...grpc.CallOption) (*QueryCollateralOfAccountResponse, error)
+	// AccountPositions queries all the collateral positions of an account.
+	AccountPositions(ctx context.Context, in *QueryAccountPositionsRequest, opts ...grpc.CallOption) (*QueryAccountPositionsResponse, error)
 	// TotalBacking queries the total backing.
 	TotalBacking(ctx context.Context, in *QueryTotalBackingRequest, opts ...grpc.CallOption) (*QueryTotalBackingResponse, error)
 	// TotalCollateral queries the total collateral.
 	TotalCollateral(ctx context.Context, in *QueryTotalCollateralRequest, opts ...grpc.CallOption) (*QueryTotalCollateralResponse, error)
 	// BackingRatio queries the backing ratio.
 	BackingRatio(ctx context.Context, in *QueryBackingRatioRequest, opts ...grpc.CallOption) (*QueryBackingRatioResponse, error)
+	// AllTreasuryLedgers queries the treasury ledgers of all the pools.
+	AllTreasuryLedgers(ctx context.Context, in *QueryAllTreasuryLedgersRequest, opts ...grpc.CallOption) (*QueryAllTreasuryLedgersResponse, error)
+	// TreasuryLedger queries the treasury ledger of a pool.
+	TreasuryLedger(ctx context.Context, in *QueryTreasuryLedgerRequest, opts ...grpc.CallOption) (*QueryTreasuryLedgerResponse, error)
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// EstimateMintBySwapIn estimates input of minting by swap.
@@ -2105,6 +2416,15 @@ func (c *queryClient) CollateralOfAccount(ctx context.Context, in *QueryCollater
 	return out, nil
 }
 
+func (c *queryClient) AccountPositions(ctx context.Context, in *QueryAccountPositionsRequest, opts ...grpc.CallOption) (*QueryAccountPositionsResponse, error) {
+	out := new(QueryAccountPositionsResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.maker.v1.Query/AccountPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TotalBacking(ctx context.Context, in *QueryTotalBackingRequest, opts ...grpc.CallOption) (*QueryTotalBackingResponse, error) {
 	out := new(QueryTotalBackingResponse)
 	err := c.cc.Invoke(ctx, "/blackfury.maker.v1.Query/TotalBacking", in, out, opts...)
@@ -2132,6 +2452,24 @@ func (c *queryClient) BackingRatio(ctx context.Context, in *QueryBackingRatioReq
 	return out, nil
 }
 
+func (c *queryClient) AllTreasuryLedgers(ctx context.Context, in *QueryAllTreasuryLedgersRequest, opts ...grpc.CallOption) (*QueryAllTreasuryLedgersResponse, error) {
+	out := new(QueryAllTreasuryLedgersResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.maker.v1.Query/AllTreasuryLedgers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TreasuryLedger(ctx context.Context, in *QueryTreasuryLedgerRequest, opts ...grpc.CallOption) (*QueryTreasuryLedgerResponse, error) {
+	out := new(QueryTreasuryLedgerResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.maker.v1.Query/TreasuryLedger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/blackfury.maker.v1.Query/Params", in, out, opts...)
@@ -2229,12 +2567,18 @@ type QueryServer interface {
 	CollateralPool(context.Context, *QueryCollateralPoolRequest) (*QueryCollateralPoolResponse, error)
 	// CollateralOfAccount queries the collateral of an account.
 	CollateralOfAccount(context.Context, *QueryCollateralOfAccountRequest) (*QueryCollateralOfAccountResponse, error)
+	// AccountPositions queries all the collateral positions of an account.
+	AccountPositions(context.Context, *QueryAccountPositionsRequest) (*QueryAccountPositionsResponse, error)
 	// TotalBacking queries the total backing.
 	TotalBacking(context.Context, *QueryTotalBackingRequest) (*QueryTotalBackingResponse, error)
 	// TotalCollateral queries the total collateral.
 	TotalCollateral(context.Context, *QueryTotalCollateralRequest) (*QueryTotalCollateralResponse, error)
 	// BackingRatio queries the backing ratio.
 	BackingRatio(context.Context, *QueryBackingRatioRequest) (*QueryBackingRatioResponse, error)
+	// AllTreasuryLedgers queries the treasury ledgers of all the pools.
+	AllTreasuryLedgers(context.Context, *QueryAllTreasuryLedgersRequest) (*QueryAllTreasuryLedgersResponse, error)
+	// TreasuryLedger queries the treasury ledger of a pool.
+	TreasuryLedger(context.Context, *QueryTreasuryLedgerRequest) (*QueryTreasuryLedgerResponse, error)
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// EstimateMintBySwapIn estimates input of minting by swap.
@@ -2280,6 +2624,9 @@ func (*UnimplementedQueryServer) CollateralPool(ctx context.Context, req *QueryC
 func (*UnimplementedQueryServer) CollateralOfAccount(ctx context.Context, req *QueryCollateralOfAccountRequest) (*QueryCollateralOfAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CollateralOfAccount not implemented")
 }
+func (*UnimplementedQueryServer) AccountPositions(ctx context.Context, req *QueryAccountPositionsRequest) (*QueryAccountPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountPositions not implemented")
+}
 func (*UnimplementedQueryServer) TotalBacking(ctx context.Context, req *QueryTotalBackingRequest) (*QueryTotalBackingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalBacking not implemented")
 }
@@ -2289,6 +2636,12 @@ func (*UnimplementedQueryServer) TotalCollateral(ctx context.Context, req *Query
 func (*UnimplementedQueryServer) BackingRatio(ctx context.Context, req *QueryBackingRatioRequest) (*QueryBackingRatioResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BackingRatio not implemented")
 }
+func (*UnimplementedQueryServer) AllTreasuryLedgers(ctx context.Context, req *QueryAllTreasuryLedgersRequest) (*QueryAllTreasuryLedgersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllTreasuryLedgers not implemented")
+}
+func (*UnimplementedQueryServer) TreasuryLedger(ctx context.Context, req *QueryTreasuryLedgerRequest) (*QueryTreasuryLedgerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TreasuryLedger not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -2447,6 +2800,24 @@ func _Query_CollateralOfAccount_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AccountPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.maker.v1.Query/AccountPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountPositions(ctx, req.(*QueryAccountPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TotalBacking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTotalBackingRequest)
 	if err := dec(in); err != nil {
@@ -2501,45 +2872,81 @@ func _Query_BackingRatio_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
+func _Query_AllTreasuryLedgers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTreasuryLedgersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+		return srv.(QueryServer).AllTreasuryLedgers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/blackfury.maker.v1.Query/Params",
+		FullMethod: "/blackfury.maker.v1.Query/AllTreasuryLedgers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+		return srv.(QueryServer).AllTreasuryLedgers(ctx, req.(*QueryAllTreasuryLedgersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_EstimateMintBySwapIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateMintBySwapInRequest)
+func _Query_TreasuryLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTreasuryLedgerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).EstimateMintBySwapIn(ctx, in)
+		return srv.(QueryServer).TreasuryLedger(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/blackfury.maker.v1.Query/EstimateMintBySwapIn",
+		FullMethod: "/blackfury.maker.v1.Query/TreasuryLedger",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateMintBySwapIn(ctx, req.(*EstimateMintBySwapInRequest))
+		return srv.(QueryServer).TreasuryLedger(ctx, req.(*QueryTreasuryLedgerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_EstimateMintBySwapOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateMintBySwapOutRequest)
-	if err := dec(in); err != nil {
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.maker.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateMintBySwapIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateMintBySwapInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateMintBySwapIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.maker.v1.Query/EstimateMintBySwapIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateMintBySwapIn(ctx, req.(*EstimateMintBySwapInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateMintBySwapOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateMintBySwapOutRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
@@ -2695,6 +3102,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CollateralOfAccount",
 			Handler:    _Query_CollateralOfAccount_Handler,
 		},
+		{
+			MethodName: "AccountPositions",
+			Handler:    _Query_AccountPositions_Handler,
+		},
 		{
 			MethodName: "TotalBacking",
 			Handler:    _Query_TotalBacking_Handler,
@@ -2707,6 +3118,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BackingRatio",
 			Handler:    _Query_BackingRatio_Handler,
 		},
+		{
+			MethodName: "AllTreasuryLedgers",
+			Handler:    _Query_AllTreasuryLedgers_Handler,
+		},
+		{
+			MethodName: "TreasuryLedger",
+			Handler:    _Query_TreasuryLedger_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -3184,6 +3603,115 @@ func (m *QueryCollateralOfAccountResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAccountPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CrossMargin {
+		i--
+		if m.CrossMargin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.HealthFactor != nil {
+		{
+			size := m.HealthFactor.Size()
+			i -= size
+			if _, err := m.HealthFactor.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.TotalDebtValue.Size()
+		i -= size
+		if _, err := m.TotalDebtValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalCollateralValue.Size()
+		i -= size
+		if _, err := m.TotalCollateralValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryTotalBackingRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3357,6 +3885,136 @@ func (m *QueryBackingRatioResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAllTreasuryLedgersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTreasuryLedgersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTreasuryLedgersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTreasuryLedgersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTreasuryLedgersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTreasuryLedgersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ledgers) > 0 {
+		for iNdEx := len(m.Ledgers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ledgers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasuryLedgerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryLedgerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryLedgerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssetClass) > 0 {
+		i -= len(m.AssetClass)
+		copy(dAtA[i:], m.AssetClass)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetClass)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasuryLedgerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryLedgerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryLedgerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Ledger.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4332,27 +4990,46 @@ func (m *QueryCollateralOfAccountResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryTotalBackingRequest) Size() (n int) {
+func (m *QueryAccountPositionsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryTotalBackingResponse) Size() (n int) {
+func (m *QueryAccountPositionsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.TotalBacking.Size()
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalCollateralValue.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalDebtValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.HealthFactor != nil {
+		l = m.HealthFactor.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CrossMargin {
+		n += 2
+	}
 	return n
 }
 
-func (m *QueryTotalCollateralRequest) Size() (n int) {
+func (m *QueryTotalBackingRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4361,7 +5038,27 @@ func (m *QueryTotalCollateralRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryTotalCollateralResponse) Size() (n int) {
+func (m *QueryTotalBackingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalBacking.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalCollateralRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalCollateralResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4395,6 +5092,58 @@ func (m *QueryBackingRatioResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAllTreasuryLedgersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllTreasuryLedgersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ledgers) > 0 {
+		for _, e := range m.Ledgers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTreasuryLedgerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AssetClass)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTreasuryLedgerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Ledger.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5714,7 +6463,7 @@ func (m *QueryCollateralOfAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalBackingRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountPositionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5737,12 +6486,44 @@ func (m *QueryTotalBackingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalBackingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountPositionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalBackingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5764,7 +6545,7 @@ func (m *QueryTotalBackingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalBackingResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountPositionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5787,15 +6568,15 @@ func (m *QueryTotalBackingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalBackingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountPositionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalBackingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalBacking", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5822,10 +6603,135 @@ func (m *QueryTotalBackingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalBacking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Positions = append(m.Positions, AccountPosition{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCollateralValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalCollateralValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDebtValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDebtValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.HealthFactor = &v
+			if err := m.HealthFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossMargin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CrossMargin = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5847,7 +6753,7 @@ func (m *QueryTotalBackingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalCollateralRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalBackingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5870,10 +6776,10 @@ func (m *QueryTotalCollateralRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalCollateralRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalBackingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalCollateralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalBackingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5897,7 +6803,7 @@ func (m *QueryTotalCollateralRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalBackingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5920,15 +6826,15 @@ func (m *QueryTotalCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalBackingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalBackingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalCollateral", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBacking", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5955,7 +6861,7 @@ func (m *QueryTotalCollateralResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalBacking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5980,7 +6886,7 @@ func (m *QueryTotalCollateralResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBackingRatioRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalCollateralRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6003,10 +6909,10 @@ func (m *QueryBackingRatioRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBackingRatioRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalCollateralRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBackingRatioRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalCollateralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6030,7 +6936,7 @@ func (m *QueryBackingRatioRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBackingRatioResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6053,17 +6959,17 @@ func (m *QueryBackingRatioResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBackingRatioResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBackingRatioResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackingRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCollateral", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6073,16 +6979,149 @@ func (m *QueryBackingRatioResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBackingRatioRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBackingRatioRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBackingRatioRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBackingRatioResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBackingRatioResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBackingRatioResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
@@ -6133,6 +7172,337 @@ func (m *QueryBackingRatioResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAllTreasuryLedgersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTreasuryLedgersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTreasuryLedgersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTreasuryLedgersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTreasuryLedgersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTreasuryLedgersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ledgers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ledgers = append(m.Ledgers, TreasuryLedger{})
+			if err := m.Ledgers[len(m.Ledgers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTreasuryLedgerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTreasuryLedgerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTreasuryLedgerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetClass", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetClass = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTreasuryLedgerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTreasuryLedgerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTreasuryLedgerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ledger", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ledger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
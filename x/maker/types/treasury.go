@@ -0,0 +1,24 @@
+package types
+
+import "fmt"
+
+// Asset classes of the treasury ledgers
+const (
+	AssetClassBacking    = "backing"
+	AssetClassCollateral = "collateral"
+	AssetClassBlack      = "black"
+)
+
+// TreasuryLedgerKeyPrefix returns the store prefix of the treasury ledgers of the asset class.
+func TreasuryLedgerKeyPrefix(assetClass string) ([]byte, error) {
+	switch assetClass {
+	case AssetClassBacking:
+		return KeyPrefixBackingTreasury, nil
+	case AssetClassCollateral:
+		return KeyPrefixCollateralTreasury, nil
+	case AssetClassBlack:
+		return KeyPrefixBlackTreasury, nil
+	default:
+		return nil, fmt.Errorf("invalid asset class: %s", assetClass)
+	}
+}
@@ -6,10 +6,12 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/regen-network/cosmos-proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -860,6 +862,268 @@ func (m *MsgLiquidateCollateralResponse) GetCollateralOut() types.Coin {
 	return types.Coin{}
 }
 
+// MsgSetCrossMargin represents a message to enable or disable the cross-margin
+// mode of an account.
+type MsgSetCrossMargin struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender" yaml:"sender"`
+	Enabled bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+}
+
+func (m *MsgSetCrossMargin) Reset()         { *m = MsgSetCrossMargin{} }
+func (m *MsgSetCrossMargin) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCrossMargin) ProtoMessage()    {}
+func (*MsgSetCrossMargin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30d534b23e24b800, []int{18}
+}
+func (m *MsgSetCrossMargin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCrossMargin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCrossMargin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCrossMargin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCrossMargin.Merge(m, src)
+}
+func (m *MsgSetCrossMargin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCrossMargin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCrossMargin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCrossMargin proto.InternalMessageInfo
+
+// MsgSetCrossMarginResponse defines the Msg/SetCrossMargin response type.
+type MsgSetCrossMarginResponse struct {
+}
+
+func (m *MsgSetCrossMarginResponse) Reset()         { *m = MsgSetCrossMarginResponse{} }
+func (m *MsgSetCrossMarginResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCrossMarginResponse) ProtoMessage()    {}
+func (*MsgSetCrossMarginResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30d534b23e24b800, []int{19}
+}
+func (m *MsgSetCrossMarginResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCrossMarginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCrossMarginResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCrossMarginResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCrossMarginResponse.Merge(m, src)
+}
+func (m *MsgSetCrossMarginResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCrossMarginResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCrossMarginResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCrossMarginResponse proto.InternalMessageInfo
+
+// MsgFlashMint represents a message to flash mint Black stablecoins.
+type MsgFlashMint struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender" yaml:"sender"`
+	// amount of Black to mint, which must be repaid with the fee by the end
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount" yaml:"amount"`
+	// messages to execute with the minted Black, all signed by the sender
+	Msgs []*types1.Any `protobuf:"bytes,3,rep,name=msgs,proto3" json:"msgs,omitempty"`
+}
+
+func (m *MsgFlashMint) Reset()         { *m = MsgFlashMint{} }
+func (m *MsgFlashMint) String() string { return proto.CompactTextString(m) }
+func (*MsgFlashMint) ProtoMessage()    {}
+func (*MsgFlashMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30d534b23e24b800, []int{20}
+}
+func (m *MsgFlashMint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFlashMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFlashMint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFlashMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFlashMint.Merge(m, src)
+}
+func (m *MsgFlashMint) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFlashMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFlashMint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFlashMint proto.InternalMessageInfo
+
+// MsgFlashMintResponse defines the Msg/FlashMint response type.
+type MsgFlashMintResponse struct {
+	// fee paid on top of the minted amount
+	Fee types.Coin `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee"`
+	// results of the nested messages
+	Results [][]byte `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (m *MsgFlashMintResponse) Reset()         { *m = MsgFlashMintResponse{} }
+func (m *MsgFlashMintResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFlashMintResponse) ProtoMessage()    {}
+func (*MsgFlashMintResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30d534b23e24b800, []int{21}
+}
+func (m *MsgFlashMintResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFlashMintResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFlashMintResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFlashMintResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFlashMintResponse.Merge(m, src)
+}
+func (m *MsgFlashMintResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFlashMintResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFlashMintResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFlashMintResponse proto.InternalMessageInfo
+
+func (m *MsgFlashMintResponse) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
+func (m *MsgFlashMintResponse) GetResults() [][]byte {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// MsgBuyFury represents a message to buy Fury coins held by the treasury.
+type MsgBuyFury struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender" yaml:"sender"`
+	To     string `protobuf:"bytes,2,opt,name=to,proto3" json:"to" yaml:"to"`
+	// collateral denom of the pool whose bad debt is covered
+	CollateralDenom string     `protobuf:"bytes,3,opt,name=collateral_denom,json=collateralDenom,proto3" json:"collateral_denom,omitempty" yaml:"collateral_denom"`
+	BlackIn         types.Coin `protobuf:"bytes,4,opt,name=black_in,json=blackIn,proto3" json:"black_in" yaml:"black_in"`
+	FuryOutMin      types.Coin `protobuf:"bytes,5,opt,name=fury_out_min,json=furyOutMin,proto3" json:"fury_out_min" yaml:"fury_out_min"`
+}
+
+func (m *MsgBuyFury) Reset()         { *m = MsgBuyFury{} }
+func (m *MsgBuyFury) String() string { return proto.CompactTextString(m) }
+func (*MsgBuyFury) ProtoMessage()    {}
+func (*MsgBuyFury) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30d534b23e24b800, []int{22}
+}
+func (m *MsgBuyFury) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBuyFury) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBuyFury.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBuyFury) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBuyFury.Merge(m, src)
+}
+func (m *MsgBuyFury) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBuyFury) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBuyFury.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBuyFury proto.InternalMessageInfo
+
+// MsgBuyFuryResponse defines the Msg/BuyFury response type.
+type MsgBuyFuryResponse struct {
+	FuryOut types.Coin `protobuf:"bytes,1,opt,name=fury_out,json=furyOut,proto3" json:"fury_out" yaml:"fury_out"`
+}
+
+func (m *MsgBuyFuryResponse) Reset()         { *m = MsgBuyFuryResponse{} }
+func (m *MsgBuyFuryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBuyFuryResponse) ProtoMessage()    {}
+func (*MsgBuyFuryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30d534b23e24b800, []int{23}
+}
+func (m *MsgBuyFuryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBuyFuryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBuyFuryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBuyFuryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBuyFuryResponse.Merge(m, src)
+}
+func (m *MsgBuyFuryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBuyFuryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBuyFuryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBuyFuryResponse proto.InternalMessageInfo
+
+func (m *MsgBuyFuryResponse) GetFuryOut() types.Coin {
+	if m != nil {
+		return m.FuryOut
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*MsgMintBySwap)(nil), "blackfury.maker.v1.MsgMintBySwap")
 	proto.RegisterType((*MsgMintBySwapResponse)(nil), "blackfury.maker.v1.MsgMintBySwapResponse")
@@ -879,95 +1143,121 @@ func init() {
 	proto.RegisterType((*MsgRedeemCollateralResponse)(nil), "blackfury.maker.v1.MsgRedeemCollateralResponse")
 	proto.RegisterType((*MsgLiquidateCollateral)(nil), "blackfury.maker.v1.MsgLiquidateCollateral")
 	proto.RegisterType((*MsgLiquidateCollateralResponse)(nil), "blackfury.maker.v1.MsgLiquidateCollateralResponse")
+	proto.RegisterType((*MsgSetCrossMargin)(nil), "blackfury.maker.v1.MsgSetCrossMargin")
+	proto.RegisterType((*MsgSetCrossMarginResponse)(nil), "blackfury.maker.v1.MsgSetCrossMarginResponse")
+	proto.RegisterType((*MsgFlashMint)(nil), "blackfury.maker.v1.MsgFlashMint")
+	proto.RegisterType((*MsgFlashMintResponse)(nil), "blackfury.maker.v1.MsgFlashMintResponse")
+	proto.RegisterType((*MsgBuyFury)(nil), "blackfury.maker.v1.MsgBuyFury")
+	proto.RegisterType((*MsgBuyFuryResponse)(nil), "blackfury.maker.v1.MsgBuyFuryResponse")
 }
 
 func init() { proto.RegisterFile("blackfury/maker/v1/tx.proto", fileDescriptor_30d534b23e24b800) }
 
 var fileDescriptor_30d534b23e24b800 = []byte{
-	// 1328 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xae, 0x53, 0x27, 0x9e, 0xfc, 0xec, 0x26, 0x4d, 0x53, 0x27, 0xb5, 0xc3, 0x94, 0x2a,
-	0x69, 0x22, 0xbc, 0x75, 0x72, 0xeb, 0xd1, 0xad, 0x90, 0x1a, 0xb0, 0x90, 0x26, 0x80, 0xa2, 0x0a,
-	0x61, 0xad, 0xed, 0x89, 0x59, 0xc5, 0xde, 0x31, 0xbb, 0xeb, 0x10, 0x5f, 0x39, 0x00, 0xaa, 0x84,
-	0x84, 0xc4, 0x1d, 0xaa, 0xc2, 0x05, 0xfe, 0x06, 0xee, 0xf4, 0x18, 0xa9, 0x12, 0xe2, 0x64, 0xa1,
-	0x04, 0x21, 0x94, 0x63, 0xce, 0x1c, 0xd0, 0xcc, 0xce, 0xee, 0xce, 0xae, 0xbd, 0x59, 0xa7, 0x8e,
-	0x73, 0xdb, 0x99, 0x79, 0xef, 0xed, 0x37, 0xdf, 0xfb, 0x66, 0xde, 0xcc, 0x80, 0xe5, 0x4a, 0x43,
-	0xab, 0x1e, 0xec, 0xb7, 0xcd, 0x8e, 0xda, 0xd4, 0x0e, 0xb0, 0xa9, 0x1e, 0x16, 0x54, 0xfb, 0x28,
-	0xdf, 0x32, 0x89, 0x4d, 0x14, 0xc5, 0x1b, 0xcc, 0xb3, 0xc1, 0xfc, 0x61, 0x21, 0xb3, 0x52, 0x27,
-	0xa4, 0xde, 0xc0, 0xaa, 0xd6, 0xd2, 0x55, 0xcd, 0x30, 0x88, 0xad, 0xd9, 0x3a, 0x31, 0x2c, 0xc7,
-	0x23, 0xb3, 0x50, 0x27, 0x75, 0xc2, 0x3e, 0x55, 0xfa, 0xc5, 0x7b, 0xb3, 0x55, 0x62, 0x35, 0x89,
-	0xa5, 0x56, 0x34, 0x0b, 0xab, 0x87, 0x85, 0x0a, 0xb6, 0xb5, 0x82, 0x5a, 0x25, 0xba, 0xe1, 0x8c,
-	0xc3, 0xe3, 0x24, 0x98, 0x2e, 0x59, 0xf5, 0x92, 0x6e, 0xd8, 0xc5, 0xce, 0xee, 0x17, 0x5a, 0x4b,
-	0xd9, 0x06, 0x29, 0x0b, 0x1b, 0x35, 0x6c, 0x2e, 0x49, 0xab, 0xd2, 0x7a, 0xba, 0xb8, 0x7c, 0xd6,
-	0xcd, 0xf1, 0x9e, 0xf3, 0x6e, 0x6e, 0xba, 0xa3, 0x35, 0x1b, 0x8f, 0xa0, 0xd3, 0x86, 0x88, 0x0f,
-	0x28, 0xf7, 0x80, 0x6c, 0x93, 0x25, 0x99, 0x39, 0xcc, 0x9f, 0x75, 0x73, 0xb2, 0x4d, 0xce, 0xbb,
-	0xb9, 0xb4, 0x63, 0x6c, 0x13, 0x88, 0x64, 0x9b, 0x28, 0x9f, 0x82, 0x99, 0x8a, 0x56, 0x3d, 0xd0,
-	0x8d, 0x7a, 0x59, 0x37, 0xca, 0x4d, 0xed, 0x68, 0x29, 0xb9, 0x2a, 0xad, 0x4f, 0x6e, 0xdd, 0xc9,
-	0x3b, 0x20, 0xf3, 0x14, 0x64, 0x9e, 0x83, 0xcc, 0x3f, 0x26, 0xba, 0x51, 0xbc, 0xfb, 0xaa, 0x9b,
-	0x4b, 0x9c, 0x77, 0x73, 0xb7, 0x9c, 0x48, 0x41, 0x77, 0x88, 0xa6, 0x78, 0xc7, 0x53, 0xa3, 0xa4,
-	0x1d, 0x29, 0x1f, 0x81, 0x49, 0x4a, 0x98, 0x1b, 0x7c, 0x2c, 0x2e, 0x78, 0x86, 0x07, 0x57, 0x9c,
-	0xe0, 0x82, 0x2f, 0x44, 0x69, 0xda, 0x72, 0xc2, 0xee, 0x81, 0xa9, 0xa6, 0x6e, 0xd8, 0x65, 0xd2,
-	0xb6, 0xcb, 0x4d, 0xdd, 0x58, 0xba, 0x11, 0x17, 0x77, 0x99, 0xc7, 0x9d, 0x77, 0xe2, 0x8a, 0xce,
-	0x10, 0x01, 0xda, 0xfc, 0xa0, 0x6d, 0x97, 0x74, 0x43, 0xd9, 0x01, 0x53, 0xfb, 0xed, 0x46, 0xa3,
-	0xcc, 0x67, 0xb1, 0x94, 0x5a, 0x95, 0xd6, 0x27, 0x8a, 0x6b, 0x67, 0xdd, 0x5c, 0xa0, 0xdf, 0x0f,
-	0x25, 0xf6, 0x42, 0x34, 0x49, 0x9b, 0x45, 0xa7, 0xf5, 0x68, 0xe2, 0x9b, 0x17, 0xb9, 0xc4, 0xbf,
-	0x2f, 0x72, 0x09, 0xf8, 0x87, 0x0c, 0x6e, 0x05, 0x52, 0x8a, 0xb0, 0xd5, 0x22, 0x86, 0x85, 0x95,
-	0x5d, 0x00, 0x7c, 0x06, 0x59, 0x7a, 0x2f, 0x9c, 0xc7, 0x1d, 0x3e, 0x8f, 0x9b, 0x61, 0xf2, 0x21,
-	0x4a, 0x7b, 0xc4, 0x2b, 0x3b, 0x60, 0x9c, 0x33, 0xc7, 0xf2, 0x7f, 0x61, 0xc4, 0x45, 0x1e, 0x71,
-	0x26, 0xc0, 0x38, 0x44, 0x29, 0x87, 0x6d, 0xa5, 0x04, 0x26, 0x5c, 0xb6, 0xe2, 0xb5, 0x71, 0x9b,
-	0x07, 0x9b, 0x0d, 0xd2, 0x0c, 0xd1, 0x38, 0xa7, 0xd8, 0x0b, 0xb7, 0x8f, 0x71, 0xbc, 0x1a, 0xfa,
-	0x85, 0xdb, 0xc7, 0x98, 0x87, 0x7b, 0x17, 0x63, 0xf8, 0x9f, 0xcc, 0xd6, 0x4a, 0xb1, 0x6d, 0x1a,
-	0x23, 0x5f, 0x2b, 0x3b, 0x60, 0xbc, 0xd2, 0x36, 0x0d, 0xca, 0x6a, 0xf2, 0x92, 0xac, 0x72, 0x3f,
-	0x88, 0x52, 0xf4, 0xeb, 0xa9, 0xa1, 0x68, 0x60, 0xd6, 0xcd, 0x9d, 0xab, 0xe1, 0x58, 0x36, 0xb2,
-	0x3c, 0xe6, 0x62, 0x30, 0xf7, 0x9e, 0x8c, 0xa7, 0x79, 0x0f, 0x57, 0xf2, 0x1e, 0x55, 0xb2, 0xd9,
-	0x79, 0xe3, 0x35, 0x22, 0x3a, 0x43, 0x04, 0x68, 0xd3, 0x89, 0x2c, 0xe8, 0xfa, 0x5b, 0x47, 0xd7,
-	0x3e, 0xfd, 0x9e, 0xae, 0x3f, 0x06, 0x93, 0x02, 0xc0, 0x78, 0x61, 0x87, 0x16, 0xbe, 0xe0, 0x0b,
-	0x11, 0xf0, 0x27, 0x46, 0xf5, 0xe3, 0x02, 0x8b, 0xd7, 0x76, 0x48, 0x3f, 0xae, 0x23, 0x44, 0xe3,
-	0x7c, 0x36, 0x34, 0x1c, 0xcb, 0x0d, 0x95, 0xe3, 0x65, 0xd5, 0xed, 0x3a, 0x42, 0xc4, 0x74, 0x41,
-	0xe5, 0xf8, 0xd2, 0x95, 0x63, 0x87, 0xef, 0x01, 0xa3, 0x95, 0xa3, 0xbb, 0xc8, 0x93, 0xc3, 0x2e,
-	0xf2, 0xd1, 0xcb, 0x51, 0x10, 0xcd, 0xef, 0x12, 0x17, 0x8d, 0x4b, 0xd2, 0xc8, 0x45, 0x43, 0xe3,
-	0xb6, 0x3b, 0xb4, 0x83, 0x25, 0x5a, 0xbe, 0x6c, 0x5c, 0xdf, 0x97, 0xc6, 0x75, 0x5a, 0x34, 0xdd,
-	0x3f, 0xc9, 0x60, 0xa6, 0x64, 0xd5, 0x77, 0xb1, 0xb7, 0xe7, 0x8f, 0x30, 0xdf, 0xc1, 0x4a, 0x91,
-	0xbc, 0x9a, 0x4a, 0x11, 0xde, 0x24, 0xc6, 0x46, 0xb0, 0x49, 0xfc, 0x26, 0x81, 0xc5, 0x20, 0x4b,
-	0x5e, 0xc2, 0xc5, 0xd5, 0x2c, 0x0d, 0xbf, 0x9a, 0x77, 0x01, 0x30, 0xf1, 0xe0, 0x69, 0x0e, 0x51,
-	0xe4, 0xbb, 0x42, 0x94, 0x76, 0x1a, 0x34, 0xc9, 0x3f, 0xcb, 0x60, 0xde, 0xab, 0xdd, 0x8f, 0x49,
-	0xa3, 0xa1, 0xd9, 0xd8, 0xd4, 0x1a, 0x23, 0xcc, 0xf4, 0x33, 0x30, 0x57, 0xf5, 0xfe, 0x53, 0xae,
-	0x61, 0x83, 0x34, 0x59, 0xbe, 0xd3, 0x45, 0xf5, 0xac, 0x9b, 0xeb, 0x19, 0x3b, 0xef, 0xe6, 0x6e,
-	0x3b, 0x01, 0xc2, 0x23, 0x10, 0xcd, 0xfa, 0x5d, 0x4f, 0x68, 0x4f, 0xa0, 0x9c, 0x8f, 0x0d, 0x5d,
-	0xce, 0x85, 0x2c, 0x37, 0xc0, 0x72, 0x1f, 0x96, 0xc4, 0x4c, 0x7b, 0x75, 0x5f, 0x1a, 0xbe, 0xee,
-	0x3f, 0x77, 0x92, 0xe2, 0x14, 0x9e, 0x61, 0x93, 0xd2, 0x8f, 0x6f, 0xf9, 0x8a, 0xf8, 0xde, 0x03,
-	0x53, 0x26, 0x6e, 0x69, 0x9d, 0x81, 0x8f, 0xd7, 0xa1, 0x05, 0x26, 0x3a, 0x43, 0x04, 0x58, 0x93,
-	0x9d, 0x81, 0x7b, 0xa8, 0x0f, 0x73, 0x21, 0x52, 0xef, 0x46, 0xb9, 0x34, 0xf5, 0xae, 0x23, 0x44,
-	0xe3, 0xfc, 0xd7, 0x74, 0x3d, 0x2c, 0x94, 0xac, 0xfa, 0x13, 0xdc, 0x22, 0x96, 0x6e, 0x5f, 0xcb,
-	0x82, 0xf8, 0x04, 0x4c, 0x0b, 0x54, 0x0f, 0xb2, 0xfb, 0xad, 0xf0, 0x69, 0x2c, 0xf4, 0x24, 0x8a,
-	0xce, 0x65, 0xca, 0x6f, 0x07, 0x4f, 0xcb, 0x63, 0x43, 0x16, 0x52, 0x21, 0x29, 0x59, 0xb0, 0xd2,
-	0x8f, 0x25, 0x37, 0x2b, 0xf0, 0x17, 0x47, 0xc1, 0x08, 0xd7, 0x30, 0x6e, 0x5e, 0x0b, 0x8b, 0x65,
-	0x30, 0x23, 0xf0, 0x30, 0xd0, 0x79, 0x3e, 0x74, 0xd7, 0x0b, 0xba, 0x43, 0x24, 0x64, 0x25, 0x7c,
-	0x36, 0x1b, 0x1b, 0x7a, 0x37, 0x17, 0xb8, 0xbc, 0xcb, 0x04, 0x1e, 0xa6, 0xca, 0xa3, 0xf2, 0xb5,
-	0xcc, 0x0a, 0xcc, 0xfb, 0xfa, 0xe7, 0x6d, 0xbd, 0xa6, 0xd9, 0xf8, 0x5a, 0xd8, 0xbc, 0x0f, 0x52,
-	0x35, 0x5c, 0xb1, 0x89, 0xc9, 0xb7, 0xe6, 0xe9, 0xa0, 0x09, 0x1f, 0x54, 0x3e, 0x04, 0xc0, 0x27,
-	0x29, 0x9e, 0x95, 0x50, 0x49, 0xf2, 0x5d, 0x21, 0x12, 0xe2, 0xf4, 0xec, 0x2a, 0x37, 0x46, 0xb0,
-	0xab, 0x1c, 0x4b, 0x20, 0xdb, 0x9f, 0xd5, 0x11, 0xed, 0x2c, 0x7d, 0x04, 0x2a, 0x5f, 0xa9, 0x40,
-	0xb7, 0xfe, 0x01, 0x20, 0x59, 0xb2, 0xea, 0xca, 0xd7, 0x12, 0x00, 0xc2, 0xf3, 0xca, 0x5b, 0xf9,
-	0xde, 0x97, 0x9d, 0x7c, 0xe0, 0xba, 0x9e, 0x79, 0x10, 0x6b, 0xe2, 0xa9, 0x71, 0xf3, 0xcb, 0xd7,
-	0x7f, 0x7f, 0x2f, 0xdf, 0x57, 0xee, 0xa9, 0x7d, 0x1f, 0x93, 0x54, 0x56, 0xcd, 0x2a, 0x9d, 0xb2,
-	0x45, 0x7f, 0x4d, 0x91, 0x08, 0x97, 0xd7, 0x28, 0x24, 0xbe, 0x49, 0x24, 0x92, 0xde, 0x3b, 0x58,
-	0x2c, 0x12, 0x76, 0x81, 0x71, 0x91, 0x7c, 0xc5, 0x90, 0x78, 0xf7, 0x96, 0x68, 0x24, 0xae, 0xc9,
-	0x05, 0x48, 0xc2, 0x07, 0x7b, 0xb8, 0xc1, 0x90, 0xbc, 0xad, 0xc0, 0x48, 0x24, 0x1d, 0xf7, 0x0d,
-	0x45, 0x79, 0x2e, 0x81, 0x49, 0xf1, 0x44, 0x0d, 0x23, 0x7e, 0x23, 0xd8, 0x64, 0x36, 0xe2, 0x6d,
-	0x06, 0x66, 0xc5, 0xc2, 0xfe, 0x83, 0x8e, 0xf2, 0xa3, 0x04, 0xe6, 0x7a, 0x4e, 0x7e, 0x6b, 0x17,
-	0x8a, 0xc1, 0x37, 0xcc, 0xa8, 0x03, 0x1a, 0x7a, 0xd8, 0x0a, 0x0c, 0xdb, 0xa6, 0xf2, 0x20, 0x46,
-	0x3b, 0xc2, 0x56, 0x40, 0x11, 0xf6, 0x1c, 0x83, 0xd6, 0x2e, 0x14, 0xc9, 0x00, 0x08, 0xa3, 0x0e,
-	0x13, 0xb1, 0x08, 0x5d, 0x4d, 0x09, 0x08, 0x5f, 0x4a, 0xe0, 0x66, 0xef, 0x69, 0x61, 0x3d, 0xe2,
-	0xcf, 0x3d, 0x96, 0x99, 0x87, 0x83, 0x5a, 0x0e, 0x0c, 0xb2, 0xe6, 0x78, 0x8a, 0x20, 0x7f, 0x90,
-	0xc0, 0x5c, 0x4f, 0x2d, 0x8e, 0xa2, 0x31, 0x6c, 0x18, 0x49, 0x63, 0x64, 0xc9, 0x7a, 0xc8, 0x10,
-	0x6e, 0x28, 0xeb, 0x11, 0x08, 0x4d, 0xe6, 0x28, 0x02, 0xfc, 0x55, 0x02, 0xf3, 0xfd, 0x2a, 0x5c,
-	0x94, 0xf4, 0xfb, 0xd8, 0x66, 0xb6, 0x06, 0xb7, 0xf5, 0x90, 0x6e, 0x33, 0xa4, 0xef, 0x28, 0x9b,
-	0x11, 0x48, 0x1b, 0xae, 0xaf, 0x00, 0xb6, 0xf8, 0xde, 0xab, 0x93, 0xac, 0x74, 0x7c, 0x92, 0x95,
-	0xfe, 0x3a, 0xc9, 0x4a, 0xdf, 0x9d, 0x66, 0x13, 0xc7, 0xa7, 0xd9, 0xc4, 0x9f, 0xa7, 0xd9, 0xc4,
-	0xb3, 0x42, 0x5d, 0xb7, 0x3f, 0x6b, 0x57, 0xf2, 0x55, 0xd2, 0x54, 0x71, 0xa3, 0x63, 0xe9, 0xed,
-	0xa6, 0xe5, 0x3c, 0x9a, 0x0b, 0xf1, 0x8f, 0xf8, 0x1f, 0xec, 0x4e, 0x0b, 0x5b, 0x95, 0x14, 0x7b,
-	0x16, 0xdf, 0xfe, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x3a, 0x96, 0x70, 0xaa, 0x9d, 0x17, 0x00, 0x00,
+	// 1645 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4f, 0x6c, 0x1b, 0x45,
+	0x17, 0xcf, 0xae, 0xdd, 0x38, 0x79, 0xf9, 0xd3, 0x76, 0x92, 0xb4, 0x8e, 0xd3, 0xda, 0xe9, 0xf4,
+	0xeb, 0x17, 0xa7, 0xa5, 0xde, 0x26, 0x15, 0x97, 0xde, 0x70, 0xab, 0x88, 0x16, 0x2c, 0xa4, 0x0d,
+	0xa0, 0xaa, 0x42, 0x58, 0x6b, 0x7b, 0xe2, 0xae, 0xb2, 0xde, 0x0d, 0xbb, 0xeb, 0x12, 0x73, 0x82,
+	0x1e, 0x00, 0x55, 0x42, 0x42, 0xe2, 0xc0, 0x0d, 0xaa, 0xc2, 0x05, 0xce, 0x1c, 0xb9, 0x53, 0x71,
+	0x40, 0x91, 0x2a, 0x21, 0x4e, 0x16, 0xb4, 0x1c, 0x50, 0x8e, 0x39, 0x73, 0x40, 0xb3, 0x3b, 0xbb,
+	0x3b, 0x6b, 0x7b, 0xe3, 0x75, 0x5c, 0xe7, 0xe6, 0x99, 0x79, 0xef, 0xed, 0x6f, 0x7e, 0xef, 0xbd,
+	0x79, 0x6f, 0xc6, 0xb0, 0x54, 0xd1, 0x94, 0xea, 0xf6, 0x56, 0xd3, 0x6c, 0x49, 0x0d, 0x65, 0x9b,
+	0x98, 0xd2, 0x83, 0x35, 0xc9, 0xde, 0x2d, 0xec, 0x98, 0x86, 0x6d, 0x20, 0xe4, 0x2f, 0x16, 0x9c,
+	0xc5, 0xc2, 0x83, 0xb5, 0xcc, 0xb9, 0xba, 0x61, 0xd4, 0x35, 0x22, 0x29, 0x3b, 0xaa, 0xa4, 0xe8,
+	0xba, 0x61, 0x2b, 0xb6, 0x6a, 0xe8, 0x96, 0xab, 0x91, 0x99, 0xaf, 0x1b, 0x75, 0xc3, 0xf9, 0x29,
+	0xd1, 0x5f, 0x6c, 0x76, 0x91, 0xe9, 0x38, 0xa3, 0x4a, 0x73, 0x4b, 0x52, 0xf4, 0x96, 0xb7, 0x54,
+	0x35, 0xac, 0x86, 0x61, 0x95, 0x5d, 0x1d, 0x77, 0xc0, 0x96, 0xb2, 0xee, 0x48, 0xaa, 0x28, 0x16,
+	0x91, 0x1e, 0xac, 0x55, 0x88, 0xad, 0xac, 0x49, 0x55, 0x43, 0xd5, 0xdd, 0x75, 0xbc, 0x97, 0x80,
+	0x99, 0x92, 0x55, 0x2f, 0xa9, 0xba, 0x5d, 0x6c, 0x6d, 0x7e, 0xa8, 0xec, 0xa0, 0xeb, 0x30, 0x6e,
+	0x11, 0xbd, 0x46, 0xcc, 0xb4, 0xb0, 0x2c, 0xe4, 0x27, 0x8b, 0x4b, 0xfb, 0xed, 0x1c, 0x9b, 0x39,
+	0x68, 0xe7, 0x66, 0x5a, 0x4a, 0x43, 0xbb, 0x81, 0xdd, 0x31, 0x96, 0xd9, 0x02, 0xba, 0x08, 0xa2,
+	0x6d, 0xa4, 0x45, 0x47, 0x61, 0x6e, 0xbf, 0x9d, 0x13, 0x6d, 0xe3, 0xa0, 0x9d, 0x9b, 0x74, 0x85,
+	0x6d, 0x03, 0xcb, 0xa2, 0x6d, 0xa0, 0xf7, 0x61, 0xb6, 0xa2, 0x54, 0xb7, 0x55, 0xbd, 0x5e, 0x56,
+	0xf5, 0x72, 0x43, 0xd9, 0x4d, 0x27, 0x96, 0x85, 0xfc, 0xd4, 0xfa, 0x62, 0x81, 0x41, 0xa6, 0x20,
+	0x0b, 0x0c, 0x64, 0xe1, 0xa6, 0xa1, 0xea, 0xc5, 0xf3, 0x4f, 0xdb, 0xb9, 0xb1, 0x83, 0x76, 0x6e,
+	0xc1, 0xb5, 0x14, 0x56, 0xc7, 0xf2, 0x34, 0x9b, 0xb8, 0xad, 0x97, 0x94, 0x5d, 0xf4, 0x0e, 0x4c,
+	0x51, 0x9a, 0x3d, 0xe3, 0xc9, 0x7e, 0xc6, 0x33, 0xcc, 0x38, 0x72, 0x8d, 0x73, 0xba, 0x58, 0x9e,
+	0xa4, 0x23, 0xd7, 0xec, 0x5d, 0x98, 0x6e, 0xa8, 0xba, 0x5d, 0x36, 0x9a, 0x76, 0xb9, 0xa1, 0xea,
+	0xe9, 0x13, 0xfd, 0xec, 0x2e, 0x31, 0xbb, 0x73, 0xae, 0x5d, 0x5e, 0x19, 0xcb, 0x40, 0x87, 0x6f,
+	0x35, 0xed, 0x92, 0xaa, 0xa3, 0x3b, 0x30, 0xbd, 0xd5, 0xd4, 0xb4, 0x32, 0xdb, 0x45, 0x7a, 0x7c,
+	0x59, 0xc8, 0x4f, 0x14, 0x57, 0xf6, 0xdb, 0xb9, 0xd0, 0x7c, 0x60, 0x8a, 0x9f, 0xc5, 0xf2, 0x14,
+	0x1d, 0x16, 0xdd, 0xd1, 0x8d, 0x89, 0xcf, 0x1f, 0xe7, 0xc6, 0xfe, 0x79, 0x9c, 0x1b, 0xc3, 0xbf,
+	0x8b, 0xb0, 0x10, 0x72, 0xa9, 0x4c, 0xac, 0x1d, 0x43, 0xb7, 0x08, 0xda, 0x04, 0x08, 0x18, 0x4c,
+	0x0b, 0xfd, 0xf6, 0xb1, 0xc8, 0xf6, 0x71, 0xba, 0x93, 0x7c, 0x2c, 0x4f, 0xfa, 0xc4, 0xa3, 0x3b,
+	0x90, 0x62, 0xcc, 0xa5, 0xc5, 0x7e, 0x16, 0xcf, 0x30, 0x8b, 0xb3, 0x21, 0xc6, 0xb1, 0x3c, 0xee,
+	0xb2, 0x8d, 0x4a, 0x30, 0xe1, 0xb1, 0xd5, 0x3f, 0x36, 0xce, 0x32, 0x63, 0x27, 0xc3, 0x34, 0x63,
+	0x39, 0xc5, 0x28, 0xf6, 0xcd, 0x6d, 0x11, 0x92, 0x4e, 0x1e, 0xc5, 0xdc, 0x16, 0x21, 0xcc, 0xdc,
+	0x06, 0x21, 0xf8, 0x5f, 0xd1, 0xc9, 0x95, 0x62, 0xd3, 0xd4, 0x47, 0x9e, 0x2b, 0x77, 0x20, 0x55,
+	0x69, 0x9a, 0x3a, 0x65, 0x35, 0x31, 0x20, 0xab, 0x4c, 0x0f, 0xcb, 0xe3, 0xf4, 0xd7, 0x6d, 0x1d,
+	0x29, 0x70, 0xd2, 0xf3, 0x9d, 0x17, 0xc3, 0x7d, 0xd9, 0xc8, 0x32, 0x9b, 0x67, 0xc2, 0xbe, 0xf7,
+	0xc3, 0x78, 0x86, 0xcd, 0xb0, 0x48, 0xbe, 0x4b, 0x23, 0xd9, 0x6c, 0x1d, 0x39, 0x47, 0x78, 0x65,
+	0x2c, 0x03, 0x1d, 0xba, 0x96, 0xb9, 0xb8, 0xfe, 0xc2, 0x8d, 0xeb, 0x80, 0x7e, 0x3f, 0xae, 0xdf,
+	0x85, 0x29, 0x0e, 0x60, 0x5a, 0x18, 0x30, 0xf1, 0x39, 0x5d, 0x2c, 0x43, 0xb0, 0x31, 0x1a, 0x3f,
+	0x1e, 0xb0, 0xb4, 0x38, 0x60, 0xfc, 0x78, 0x8a, 0x58, 0x4e, 0xb1, 0xdd, 0x50, 0x73, 0x8e, 0x6f,
+	0x68, 0x38, 0x0e, 0x1a, 0xdd, 0x9e, 0x22, 0x96, 0x9d, 0xb8, 0xa0, 0xe1, 0xf8, 0xc4, 0x0b, 0xc7,
+	0x16, 0x3b, 0x03, 0x46, 0x1b, 0x8e, 0x5e, 0x92, 0x27, 0x86, 0x4d, 0xf2, 0xd1, 0x87, 0x23, 0x17,
+	0x34, 0xbf, 0x08, 0xb0, 0x10, 0x22, 0x69, 0xe4, 0x41, 0x43, 0xed, 0x36, 0x5b, 0x74, 0xc2, 0x71,
+	0xb4, 0x38, 0xa8, 0xdd, 0x40, 0x97, 0xda, 0x75, 0x47, 0xd4, 0xdd, 0xdf, 0x89, 0x30, 0x5b, 0xb2,
+	0xea, 0x9b, 0x44, 0xd3, 0x46, 0xef, 0xef, 0x70, 0xa5, 0x48, 0xbc, 0x9c, 0x4a, 0xd1, 0x79, 0x48,
+	0x24, 0x47, 0x70, 0x48, 0xfc, 0x2c, 0xc0, 0x99, 0x30, 0x4b, 0xbe, 0xc3, 0xf9, 0x6c, 0x16, 0x86,
+	0xcf, 0xe6, 0x4d, 0x00, 0x93, 0xc4, 0x77, 0x73, 0x07, 0x45, 0x81, 0x2a, 0x96, 0x27, 0x4d, 0xe2,
+	0x39, 0xf9, 0x7b, 0x11, 0xe6, 0xfc, 0xda, 0x7d, 0xd3, 0xd0, 0x34, 0xc5, 0x26, 0xa6, 0xa2, 0x8d,
+	0xd0, 0xd3, 0xf7, 0xe0, 0x54, 0xd5, 0xff, 0x4e, 0xb9, 0x46, 0x74, 0xa3, 0xe1, 0xf8, 0x7b, 0xb2,
+	0x28, 0xed, 0xb7, 0x73, 0x5d, 0x6b, 0x07, 0xed, 0xdc, 0x59, 0xd7, 0x40, 0xe7, 0x0a, 0x96, 0x4f,
+	0x06, 0x53, 0xb7, 0xe8, 0x4c, 0xa8, 0x9c, 0x27, 0x87, 0x2e, 0xe7, 0x9c, 0x97, 0x35, 0x58, 0xea,
+	0xc1, 0x12, 0xef, 0x69, 0xbf, 0xee, 0x0b, 0xc3, 0xd7, 0xfd, 0x47, 0xae, 0x53, 0xdc, 0xc2, 0x33,
+	0xac, 0x53, 0x7a, 0xf1, 0x2d, 0xbe, 0x24, 0xbe, 0xef, 0xc2, 0xb4, 0x49, 0x76, 0x94, 0x56, 0xec,
+	0xf6, 0xba, 0x23, 0xc1, 0x78, 0x65, 0x2c, 0x83, 0x33, 0x74, 0x7a, 0xe0, 0x2e, 0xea, 0x3b, 0xb9,
+	0xe0, 0xa9, 0xf7, 0xac, 0x0c, 0x4c, 0xbd, 0xa7, 0x88, 0xe5, 0x14, 0xfb, 0x34, 0xcd, 0x87, 0xf9,
+	0x92, 0x55, 0xbf, 0x45, 0x76, 0x0c, 0x4b, 0xb5, 0x8f, 0x25, 0x21, 0xde, 0x83, 0x19, 0x8e, 0xea,
+	0x38, 0xa7, 0xdf, 0x39, 0xb6, 0x8d, 0xf9, 0x2e, 0x47, 0xd1, 0xbd, 0x4c, 0x07, 0xe3, 0x70, 0xb7,
+	0x9c, 0x1c, 0xb2, 0x90, 0x72, 0x4e, 0xc9, 0xc2, 0xb9, 0x5e, 0x2c, 0x79, 0x5e, 0xc1, 0x3f, 0xb8,
+	0x11, 0x2c, 0x93, 0x1a, 0x21, 0x8d, 0x63, 0x61, 0xb1, 0x0c, 0xb3, 0x1c, 0x0f, 0xb1, 0xfa, 0xf9,
+	0x8e, 0xbb, 0x5e, 0x58, 0x1d, 0xcb, 0x9c, 0x57, 0x3a, 0x7b, 0xb3, 0xe4, 0xd0, 0xa7, 0x39, 0xc7,
+	0xe5, 0x79, 0x58, 0xea, 0x41, 0x95, 0x4f, 0xe5, 0x33, 0xd1, 0x29, 0x30, 0x6f, 0xaa, 0x1f, 0x34,
+	0xd5, 0x9a, 0x62, 0x93, 0x63, 0x61, 0xf3, 0x12, 0x8c, 0xd7, 0x48, 0xc5, 0x36, 0x4c, 0x76, 0x34,
+	0xcf, 0x84, 0x45, 0xd8, 0x22, 0x7a, 0x1b, 0x20, 0x20, 0x29, 0x9d, 0x1c, 0xb0, 0x24, 0x05, 0xaa,
+	0x58, 0xe6, 0xec, 0x74, 0x9d, 0x2a, 0x27, 0x46, 0x70, 0xaa, 0xec, 0x09, 0x90, 0xed, 0xcd, 0xea,
+	0x88, 0x4e, 0x96, 0x1e, 0x01, 0x2a, 0xbe, 0xd4, 0x00, 0xc5, 0x1f, 0x0b, 0x70, 0xda, 0xe9, 0x44,
+	0xec, 0x9b, 0xa6, 0x61, 0x59, 0x25, 0xc5, 0xac, 0xab, 0xfa, 0xd1, 0x62, 0xe4, 0x15, 0x48, 0x11,
+	0x5d, 0xa9, 0x68, 0xa4, 0xe6, 0x80, 0x9c, 0x28, 0xa2, 0xe0, 0x54, 0x60, 0x0b, 0x58, 0xf6, 0x44,
+	0x38, 0x56, 0x97, 0x60, 0xb1, 0x0b, 0x81, 0x1f, 0xc8, 0xbf, 0x09, 0x30, 0x5d, 0xb2, 0xea, 0x1b,
+	0x9a, 0x62, 0xdd, 0xa7, 0x95, 0xf4, 0x68, 0xd0, 0x5e, 0x87, 0x71, 0xa5, 0x61, 0x34, 0xf5, 0x18,
+	0xf4, 0x2d, 0x30, 0xfa, 0x98, 0x25, 0x57, 0x0d, 0xcb, 0x4c, 0x1f, 0xbd, 0x0a, 0xc9, 0x86, 0x55,
+	0xb7, 0xd2, 0x89, 0xe5, 0x44, 0x7e, 0x6a, 0x7d, 0xbe, 0xe0, 0x3e, 0x77, 0x15, 0xbc, 0xe7, 0xae,
+	0xc2, 0x6b, 0x7a, 0xab, 0x38, 0xf5, 0xeb, 0x4f, 0x57, 0x53, 0x56, 0x6d, 0xbb, 0x40, 0x33, 0xd4,
+	0x11, 0xe7, 0x76, 0x5b, 0x85, 0x79, 0x7e, 0x3f, 0x7e, 0xe0, 0xac, 0x41, 0x22, 0x56, 0x23, 0x90,
+	0xa4, 0xf8, 0x64, 0x2a, 0x8b, 0xd2, 0x90, 0x32, 0x89, 0xd5, 0xd4, 0x6c, 0x2b, 0x2d, 0x2e, 0x27,
+	0xf2, 0xd3, 0xb2, 0x37, 0xc4, 0x7f, 0x89, 0x00, 0xee, 0x7d, 0x62, 0xa3, 0x69, 0xb6, 0x46, 0x98,
+	0xf2, 0x1b, 0x91, 0x7d, 0xd9, 0xd2, 0xa0, 0x3d, 0x98, 0xf3, 0x00, 0x19, 0xab, 0xe2, 0x74, 0x5e,
+	0x3a, 0x99, 0x22, 0xbd, 0x74, 0xd2, 0x9f, 0xb7, 0x8f, 0xe7, 0xa2, 0x5f, 0x05, 0x14, 0x50, 0x3c,
+	0xa2, 0xf6, 0x7d, 0xfd, 0x93, 0x59, 0x48, 0x94, 0xac, 0x3a, 0xfa, 0x4c, 0x00, 0xe0, 0x5e, 0x3f,
+	0x2f, 0x14, 0xba, 0x9f, 0x6b, 0x0b, 0xa1, 0xd7, 0xb4, 0xcc, 0x6a, 0x5f, 0x11, 0x3f, 0xc7, 0xae,
+	0x3c, 0x7c, 0xf6, 0xf7, 0x57, 0xe2, 0x25, 0x74, 0x51, 0xea, 0xf9, 0x42, 0x2c, 0x39, 0xcd, 0x66,
+	0xa5, 0x55, 0xb6, 0xe8, 0xa7, 0x29, 0x12, 0xee, 0x6d, 0x29, 0x0a, 0x49, 0x20, 0x92, 0x59, 0xed,
+	0x2b, 0x12, 0x1b, 0x89, 0xf3, 0xbe, 0xe0, 0x21, 0xf9, 0xd4, 0x41, 0xe2, 0x3f, 0x2b, 0x44, 0x23,
+	0xf1, 0x44, 0x32, 0xab, 0x7d, 0x45, 0x7c, 0x24, 0x97, 0x1d, 0x24, 0xff, 0x43, 0x38, 0x12, 0x49,
+	0xcb, 0x7b, 0xe2, 0x44, 0x8f, 0x04, 0x98, 0xe2, 0x2f, 0xbc, 0x38, 0xe2, 0x33, 0x9c, 0x4c, 0xe6,
+	0x72, 0x7f, 0x99, 0xd8, 0xac, 0x58, 0x24, 0x78, 0x6f, 0x45, 0xdf, 0x0a, 0x70, 0xaa, 0xeb, 0x62,
+	0xb6, 0x72, 0x68, 0x30, 0x04, 0x82, 0x19, 0x29, 0xa6, 0xa0, 0x8f, 0x6d, 0xcd, 0xc1, 0x76, 0x05,
+	0xad, 0xf6, 0x89, 0x1d, 0xae, 0x52, 0x53, 0x84, 0x5d, 0xb7, 0x94, 0x95, 0x43, 0x83, 0x24, 0x06,
+	0xc2, 0xa8, 0x5e, 0xbf, 0x2f, 0x42, 0x2f, 0xa6, 0x38, 0x84, 0x4f, 0x04, 0x38, 0xdd, 0xdd, 0xcc,
+	0xe7, 0x23, 0xbe, 0xdc, 0x25, 0x99, 0xb9, 0x16, 0x57, 0x32, 0x36, 0xc8, 0x9a, 0xab, 0xc9, 0x83,
+	0xfc, 0x46, 0x80, 0x53, 0x5d, 0xad, 0x72, 0x14, 0x8d, 0x9d, 0x82, 0x19, 0x29, 0xa6, 0xa0, 0x8f,
+	0xf0, 0x9a, 0x83, 0xf0, 0x32, 0xca, 0x47, 0x20, 0x34, 0x1d, 0x45, 0x1e, 0xe0, 0x8f, 0x02, 0xcc,
+	0xf5, 0x6a, 0x40, 0xa3, 0x42, 0xbf, 0x87, 0x6c, 0x66, 0x3d, 0xbe, 0xac, 0x8f, 0xf4, 0xba, 0x83,
+	0xf4, 0x2a, 0xba, 0x12, 0x81, 0x54, 0xf3, 0x74, 0x79, 0xb0, 0x5f, 0x0b, 0x30, 0xdb, 0xd1, 0x04,
+	0x5d, 0x8a, 0x4c, 0x51, 0x5e, 0x2c, 0x73, 0x35, 0x96, 0x98, 0x8f, 0x4e, 0x72, 0xd0, 0xad, 0xa2,
+	0x95, 0xc8, 0x64, 0xb6, 0xcb, 0x55, 0xaa, 0x57, 0x6e, 0xb8, 0x30, 0x1e, 0x0a, 0x30, 0x19, 0xb4,
+	0x3f, 0xcb, 0x11, 0x5f, 0xf3, 0x25, 0x32, 0xf9, 0x7e, 0x12, 0x3e, 0x94, 0x55, 0x07, 0xca, 0x45,
+	0x74, 0x21, 0x02, 0xca, 0x16, 0xd5, 0xa0, 0x25, 0xd0, 0x46, 0x1f, 0x41, 0xca, 0x6b, 0x26, 0xb2,
+	0xd1, 0x87, 0x28, 0x5d, 0xcf, 0xfc, 0xff, 0xf0, 0x75, 0xff, 0xeb, 0x2b, 0xce, 0xd7, 0x2f, 0xa0,
+	0xdc, 0x21, 0x27, 0x2c, 0x9d, 0x2c, 0xbe, 0xf1, 0xf4, 0x79, 0x56, 0xd8, 0x7b, 0x9e, 0x15, 0xfe,
+	0x7c, 0x9e, 0x15, 0xbe, 0x7c, 0x91, 0x1d, 0xdb, 0x7b, 0x91, 0x1d, 0xfb, 0xe3, 0x45, 0x76, 0xec,
+	0xde, 0x5a, 0x5d, 0xb5, 0xef, 0x37, 0x2b, 0x85, 0xaa, 0xd1, 0x90, 0x88, 0xd6, 0xb2, 0xd4, 0x66,
+	0xc3, 0x72, 0xff, 0xa4, 0xe4, 0x6c, 0xee, 0x32, 0xab, 0x76, 0x6b, 0x87, 0x58, 0x95, 0x71, 0xa7,
+	0x53, 0xbb, 0xfe, 0xdf, 0x00, 0xe2, 0x61, 0x7e, 0x8f, 0x0d, 0x1d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1006,6 +1296,15 @@ type MsgClient interface {
 	// LiquidateCollateral liquidates collateral assets which is
 	// undercollateralized.
 	LiquidateCollateral(ctx context.Context, in *MsgLiquidateCollateral, opts ...grpc.CallOption) (*MsgLiquidateCollateralResponse, error)
+	// SetCrossMargin enables or disables the cross-margin mode of an account,
+	// in which all the collateral positions are evaluated together.
+	SetCrossMargin(ctx context.Context, in *MsgSetCrossMargin, opts ...grpc.CallOption) (*MsgSetCrossMarginResponse, error)
+	// FlashMint mints Black stablecoins without collateral, executes the nested
+	// messages, and then burns the minted amount plus a fee from the sender.
+	FlashMint(ctx context.Context, in *MsgFlashMint, opts ...grpc.CallOption) (*MsgFlashMintResponse, error)
+	// BuyFury buys Fury coins held by the treasury for covering the bad debt of
+	// a collateral pool by spending Black stablecoins, which are burned.
+	BuyFury(ctx context.Context, in *MsgBuyFury, opts ...grpc.CallOption) (*MsgBuyFuryResponse, error)
 }
 
 type msgClient struct {
@@ -1097,6 +1396,33 @@ func (c *msgClient) LiquidateCollateral(ctx context.Context, in *MsgLiquidateCol
 	return out, nil
 }
 
+func (c *msgClient) SetCrossMargin(ctx context.Context, in *MsgSetCrossMargin, opts ...grpc.CallOption) (*MsgSetCrossMarginResponse, error) {
+	out := new(MsgSetCrossMarginResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.maker.v1.Msg/SetCrossMargin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FlashMint(ctx context.Context, in *MsgFlashMint, opts ...grpc.CallOption) (*MsgFlashMintResponse, error) {
+	out := new(MsgFlashMintResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.maker.v1.Msg/FlashMint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BuyFury(ctx context.Context, in *MsgBuyFury, opts ...grpc.CallOption) (*MsgBuyFuryResponse, error) {
+	out := new(MsgBuyFuryResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.maker.v1.Msg/BuyFury", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// MintBySwap mints Black stablecoins by swapping in strong-backing assets and
@@ -1123,6 +1449,15 @@ type MsgServer interface {
 	// LiquidateCollateral liquidates collateral assets which is
 	// undercollateralized.
 	LiquidateCollateral(context.Context, *MsgLiquidateCollateral) (*MsgLiquidateCollateralResponse, error)
+	// SetCrossMargin enables or disables the cross-margin mode of an account,
+	// in which all the collateral positions are evaluated together.
+	SetCrossMargin(context.Context, *MsgSetCrossMargin) (*MsgSetCrossMarginResponse, error)
+	// FlashMint mints Black stablecoins without collateral, executes the nested
+	// messages, and then burns the minted amount plus a fee from the sender.
+	FlashMint(context.Context, *MsgFlashMint) (*MsgFlashMintResponse, error)
+	// BuyFury buys Fury coins held by the treasury for covering the bad debt of
+	// a collateral pool by spending Black stablecoins, which are burned.
+	BuyFury(context.Context, *MsgBuyFury) (*MsgBuyFuryResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1156,6 +1491,15 @@ func (*UnimplementedMsgServer) RedeemCollateral(ctx context.Context, req *MsgRed
 func (*UnimplementedMsgServer) LiquidateCollateral(ctx context.Context, req *MsgLiquidateCollateral) (*MsgLiquidateCollateralResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LiquidateCollateral not implemented")
 }
+func (*UnimplementedMsgServer) SetCrossMargin(ctx context.Context, req *MsgSetCrossMargin) (*MsgSetCrossMarginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCrossMargin not implemented")
+}
+func (*UnimplementedMsgServer) FlashMint(ctx context.Context, req *MsgFlashMint) (*MsgFlashMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlashMint not implemented")
+}
+func (*UnimplementedMsgServer) BuyFury(ctx context.Context, req *MsgBuyFury) (*MsgBuyFuryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyFury not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1323,6 +1667,60 @@ func _Msg_LiquidateCollateral_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetCrossMargin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCrossMargin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCrossMargin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.maker.v1.Msg/SetCrossMargin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCrossMargin(ctx, req.(*MsgSetCrossMargin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FlashMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFlashMint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FlashMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.maker.v1.Msg/FlashMint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FlashMint(ctx, req.(*MsgFlashMint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BuyFury_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBuyFury)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BuyFury(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.maker.v1.Msg/BuyFury",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BuyFury(ctx, req.(*MsgBuyFury))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "blackfury.maker.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1363,6 +1761,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LiquidateCollateral",
 			Handler:    _Msg_LiquidateCollateral_Handler,
 		},
+		{
+			MethodName: "SetCrossMargin",
+			Handler:    _Msg_SetCrossMargin_Handler,
+		},
+		{
+			MethodName: "FlashMint",
+			Handler:    _Msg_FlashMint_Handler,
+		},
+		{
+			MethodName: "BuyFury",
+			Handler:    _Msg_BuyFury_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "blackfury/maker/v1/tx.proto",
@@ -2262,47 +2672,303 @@ func (m *MsgLiquidateCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetCrossMargin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgMintBySwap) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.BackingInMax.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.FuryInMax.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MintOutMin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.FullBacking {
-		n += 2
-	}
-	return n
+
+func (m *MsgSetCrossMargin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMintBySwapResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetCrossMargin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCrossMarginResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCrossMarginResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCrossMarginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFlashMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFlashMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFlashMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFlashMintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFlashMintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFlashMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Results[iNdEx])
+			copy(dAtA[i:], m.Results[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Results[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBuyFury) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBuyFury) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBuyFury) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FuryOutMin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.BlackIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.CollateralDenom) > 0 {
+		i -= len(m.CollateralDenom)
+		copy(dAtA[i:], m.CollateralDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBuyFuryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBuyFuryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBuyFuryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FuryOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgMintBySwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BackingInMax.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.FuryInMax.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MintOutMin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.FullBacking {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgMintBySwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = m.BackingIn.Size()
@@ -2564,33 +3230,909 @@ func (m *MsgLiquidateCollateral) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Collateral.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.RepayInMax.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	l = m.Collateral.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.RepayInMax.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgLiquidateCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RepayIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.CollateralOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetCrossMargin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetCrossMarginResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFlashMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFlashMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Results) > 0 {
+		for _, b := range m.Results {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBuyFury) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CollateralDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BlackIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.FuryOutMin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBuyFuryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FuryOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgMintBySwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintBySwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintBySwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingInMax", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BackingInMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FuryInMax", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FuryInMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintOutMin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullBacking", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FullBacking = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintBySwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintBySwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintBySwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BackingIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FuryIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FuryIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnBySwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnBySwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnBySwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingOutMin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BackingOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FuryOutMin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FuryOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnBySwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnBySwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnBySwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BackingOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FuryOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FuryOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgLiquidateCollateralResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.RepayIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.CollateralOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgMintBySwap) Unmarshal(dAtA []byte) error {
+func (m *MsgBuyBacking) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2613,10 +4155,10 @@ func (m *MsgMintBySwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintBySwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBuyBacking: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintBySwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBuyBacking: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2685,7 +4227,7 @@ func (m *MsgMintBySwap) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackingInMax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FuryIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2712,13 +4254,13 @@ func (m *MsgMintBySwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BackingInMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FuryIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FuryInMax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingOutMin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2745,13 +4287,63 @@ func (m *MsgMintBySwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FuryInMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BackingOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBuyBackingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBuyBackingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBuyBackingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintOutMin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2778,15 +4370,15 @@ func (m *MsgMintBySwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MintOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BackingOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FullBacking", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuybackFee", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2796,12 +4388,25 @@ func (m *MsgMintBySwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FullBacking = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BuybackFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2823,7 +4428,7 @@ func (m *MsgMintBySwap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintBySwapResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSellBacking) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2846,13 +4451,77 @@ func (m *MsgMintBySwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintBySwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSellBacking: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintBySwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSellBacking: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BackingIn", wireType)
 			}
@@ -2885,9 +4554,9 @@ func (m *MsgMintBySwapResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FuryIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FuryOutMin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2914,13 +4583,63 @@ func (m *MsgMintBySwapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FuryIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if err := m.FuryOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSellBackingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSellBackingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSellBackingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FuryOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2947,13 +4666,13 @@ func (m *MsgMintBySwapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MintOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FuryOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RebackFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2980,7 +4699,7 @@ func (m *MsgMintBySwapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MintFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RebackFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3005,7 +4724,7 @@ func (m *MsgMintBySwapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnBySwap) Unmarshal(dAtA []byte) error {
+func (m *MsgMintByCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3028,10 +4747,10 @@ func (m *MsgBurnBySwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnBySwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMintByCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnBySwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMintByCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3100,9 +4819,9 @@ func (m *MsgBurnBySwap) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3112,61 +4831,27 @@ func (m *MsgBurnBySwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackingOutMin", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BackingOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FuryOutMin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3193,7 +4878,7 @@ func (m *MsgBurnBySwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FuryOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MintOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3218,7 +4903,7 @@ func (m *MsgBurnBySwap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnBySwapResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMintByCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3241,81 +4926,15 @@ func (m *MsgBurnBySwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnBySwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMintByCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnBySwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMintByCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackingOut", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BackingOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FuryOut", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.FuryOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3342,7 +4961,7 @@ func (m *MsgBurnBySwapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MintFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3367,7 +4986,7 @@ func (m *MsgBurnBySwapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBuyBacking) Unmarshal(dAtA []byte) error {
+func (m *MsgBurnByCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3390,10 +5009,10 @@ func (m *MsgBuyBacking) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBuyBacking: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurnByCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBuyBacking: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurnByCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3430,7 +5049,7 @@ func (m *MsgBuyBacking) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3458,44 +5077,11 @@ func (m *MsgBuyBacking) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FuryIn", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.FuryIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackingOutMin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepayInMax", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3522,7 +5108,7 @@ func (m *MsgBuyBacking) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BackingOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RepayInMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3547,7 +5133,7 @@ func (m *MsgBuyBacking) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBuyBackingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBurnByCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3570,48 +5156,15 @@ func (m *MsgBuyBackingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBuyBackingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurnByCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBuyBackingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurnByCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackingOut", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BackingOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuybackFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepayIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3638,7 +5191,7 @@ func (m *MsgBuyBackingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BuybackFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RepayIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3663,7 +5216,7 @@ func (m *MsgBuyBackingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSellBacking) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3686,10 +5239,10 @@ func (m *MsgSellBacking) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSellBacking: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSellBacking: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3758,7 +5311,7 @@ func (m *MsgSellBacking) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackingIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3785,13 +5338,13 @@ func (m *MsgSellBacking) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BackingIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CollateralIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FuryOutMin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FuryIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3818,7 +5371,7 @@ func (m *MsgSellBacking) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FuryOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FuryIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3843,7 +5396,7 @@ func (m *MsgSellBacking) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSellBackingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3866,78 +5419,12 @@ func (m *MsgSellBackingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSellBackingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSellBackingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FuryOut", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.FuryOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RebackFee", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.RebackFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3959,7 +5446,7 @@ func (m *MsgSellBackingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintByCollateral) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3982,10 +5469,10 @@ func (m *MsgMintByCollateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintByCollateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintByCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4054,9 +5541,9 @@ func (m *MsgMintByCollateral) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralOut", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4066,27 +5553,28 @@ func (m *MsgMintByCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.CollateralOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FuryOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4113,7 +5601,7 @@ func (m *MsgMintByCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MintOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FuryOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4138,7 +5626,7 @@ func (m *MsgMintByCollateral) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintByCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4161,45 +5649,12 @@ func (m *MsgMintByCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintByCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintByCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintFee", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MintFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4221,7 +5676,7 @@ func (m *MsgMintByCollateralResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnByCollateral) Unmarshal(dAtA []byte) error {
+func (m *MsgLiquidateCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4244,10 +5699,10 @@ func (m *MsgBurnByCollateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnByCollateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLiquidateCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnByCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLiquidateCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4284,7 +5739,7 @@ func (m *MsgBurnByCollateral) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4312,9 +5767,74 @@ func (m *MsgBurnByCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Debtor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Debtor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepayInMax", wireType)
 			}
@@ -4368,7 +5888,7 @@ func (m *MsgBurnByCollateral) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnByCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLiquidateCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4391,15 +5911,48 @@ func (m *MsgBurnByCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnByCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLiquidateCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnByCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLiquidateCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepayIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepayIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RepayIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4426,7 +5979,7 @@ func (m *MsgBurnByCollateralResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RepayIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CollateralOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4451,7 +6004,7 @@ func (m *MsgBurnByCollateralResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
+func (m *MsgSetCrossMargin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4474,10 +6027,10 @@ func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositCollateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetCrossMargin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetCrossMargin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4513,75 +6066,10 @@ func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.To = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralIn", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CollateralIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FuryIn", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4591,25 +6079,12 @@ func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.FuryIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4631,7 +6106,7 @@ func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetCrossMarginResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4654,10 +6129,10 @@ func (m *MsgDepositCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetCrossMarginResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetCrossMarginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4681,7 +6156,7 @@ func (m *MsgDepositCollateralResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRedeemCollateral) Unmarshal(dAtA []byte) error {
+func (m *MsgFlashMint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4704,10 +6179,10 @@ func (m *MsgRedeemCollateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedeemCollateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFlashMint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedeemCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFlashMint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4744,39 +6219,7 @@ func (m *MsgRedeemCollateral) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.To = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4803,13 +6246,13 @@ func (m *MsgRedeemCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CollateralOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FuryOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4836,7 +6279,8 @@ func (m *MsgRedeemCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FuryOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Msgs = append(m.Msgs, &types1.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4861,7 +6305,7 @@ func (m *MsgRedeemCollateral) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRedeemCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFlashMintResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4884,12 +6328,77 @@ func (m *MsgRedeemCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedeemCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFlashMintResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedeemCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFlashMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, make([]byte, postIndex-iNdEx))
+			copy(m.Results[len(m.Results)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4911,7 +6420,7 @@ func (m *MsgRedeemCollateralResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidateCollateral) Unmarshal(dAtA []byte) error {
+func (m *MsgBuyFury) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4934,10 +6443,10 @@ func (m *MsgLiquidateCollateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidateCollateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBuyFury: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidateCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBuyFury: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5006,7 +6515,7 @@ func (m *MsgLiquidateCollateral) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Debtor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5034,11 +6543,11 @@ func (m *MsgLiquidateCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Debtor = string(dAtA[iNdEx:postIndex])
+			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlackIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5065,13 +6574,13 @@ func (m *MsgLiquidateCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BlackIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepayInMax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FuryOutMin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5098,7 +6607,7 @@ func (m *MsgLiquidateCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RepayInMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FuryOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5123,7 +6632,7 @@ func (m *MsgLiquidateCollateral) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidateCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBuyFuryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5146,48 +6655,15 @@ func (m *MsgLiquidateCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidateCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBuyFuryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidateCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBuyFuryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepayIn", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.RepayIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FuryOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5214,7 +6690,7 @@ func (m *MsgLiquidateCollateralResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CollateralOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FuryOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -53,6 +53,9 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 			return false
 		})
 
+		// Restore the fresh exchange rates received from the interchain oracle
+		k.RestoreInterchainExchangeRates(ctx)
+
 		// Organize votes to ballot by denom
 		// NOTE: **Filter out inactive or jailed validators**
 		// NOTE: **Make abstain votes to have zero vote power**
@@ -94,6 +97,9 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 			}
 		}
 
+		// Derive the exchange rates denominated in the non-reference quote currencies
+		k.UpdateQuoteExchangeRates(ctx)
+
 		// ---------------------------
 		// Do miss counting & slashing
 		voteTargetsLen := len(voteTargets)
@@ -107,6 +113,9 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 			k.SetMissCounter(ctx, claim.Recipient, k.GetMissCounter(ctx, claim.Recipient)+1)
 		}
 
+		// Record voting statistics before the ballots are cleared
+		k.RecordValidatorPerformances(ctx, validatorClaimMap, voteTargetsLen)
+
 		// Distribute rewards to ballot winners
 		k.RewardBallotWinners(
 			ctx,
@@ -125,6 +134,7 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	// at the last block of slash window
 	if blackfury.IsPeriodLastBlock(ctx, params.SlashWindow) {
 		k.SlashAndResetMissCounters(ctx)
+		k.PruneValidatorPerformances(ctx)
 	}
 
 	return
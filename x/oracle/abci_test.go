@@ -185,6 +185,13 @@ func TestOracleTally(t *testing.T) {
 	}
 
 	for _, vote := range ballot {
+		if vote.ExchangeRate.IsPositive() {
+			key := vote.Voter.String()
+			claim := expectedValidatorClaimMap[key]
+			claim.AddDeviation(vote.ExchangeRate, weightedMedian)
+			expectedValidatorClaimMap[key] = claim
+		}
+
 		if (vote.ExchangeRate.GTE(weightedMedian.Sub(maxSpread)) &&
 			vote.ExchangeRate.LTE(weightedMedian.Add(maxSpread))) ||
 			!vote.ExchangeRate.IsPositive() {
@@ -360,6 +367,30 @@ func TestOracleExchangeRate(t *testing.T) {
 	require.Equal(t, expectedRewardAmt2, rewards.Rewards.AmountOf(blackfury.AttoFuryDenom).TruncateInt())
 }
 
+func TestOracleQuoteExchangeRate(t *testing.T) {
+	input, h := setup(t)
+
+	// denom1 is quoted in denom2, e.g., an EUR stablecoin in EUR
+	input.OracleKeeper.SetTargetQuote(input.Ctx, denom1, denom2)
+
+	rates := sdk.DecCoins{{Denom: denom1, Amount: sdk.NewDecWithPrec(22, 1)}, {Denom: denom2, Amount: sdk.NewDecWithPrec(11, 1)}}
+	for i := range keeper.ValAddrs[:3] {
+		makeAggregatePrevoteAndVote(t, input, h, 0, rates, i)
+	}
+
+	oracle.EndBlocker(input.Ctx.WithBlockHeight(1), input.OracleKeeper)
+
+	rate, err := input.OracleKeeper.GetQuoteExchangeRate(input.Ctx, denom1)
+	require.NoError(t, err)
+	require.Equal(t, denom2, rate.Quote)
+	require.Equal(t, sdk.NewDec(2), rate.ExchangeRate)
+
+	// the quote exchange rate is dropped together with the exchange rates
+	oracle.EndBlocker(input.Ctx.WithBlockHeight(2), input.OracleKeeper)
+	_, err = input.OracleKeeper.GetQuoteExchangeRate(input.Ctx, denom1)
+	require.Error(t, err)
+}
+
 func TestOracleEnsureSorted(t *testing.T) {
 	input, h := setup(t)
 
@@ -448,6 +479,9 @@ func TestOracleExchangeRateVal5(t *testing.T) {
 func TestInvalidVotesSlashing(t *testing.T) {
 	input, h := setup(t)
 	params := input.OracleKeeper.GetParams(input.Ctx)
+	// slash and jail on the first bad window
+	params.WarningWindows = 0
+	params.MinorSlashWindows = 0
 	input.OracleKeeper.SetParams(input.Ctx, params)
 	input.OracleKeeper.SetVoteTarget(input.Ctx, denom2)
 
@@ -585,6 +619,60 @@ func TestAbstainWithSmallStakingPower(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestValidatorPerformance(t *testing.T) {
+	input, h := setup(t)
+
+	input.OracleKeeper.ClearVoteTargets(input.Ctx)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, denom2)
+
+	input.Ctx = input.Ctx.WithBlockHeight(input.Ctx.BlockHeight() + 1)
+	window := uint64(input.Ctx.BlockHeight()) / input.OracleKeeper.SlashWindow(input.Ctx)
+
+	// Account 1, denom2
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: denom2, Amount: randomExchangeRate}}, 0)
+
+	// Account 2, denom2, abstain vote
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: denom2, Amount: sdk.ZeroDec()}}, 1)
+
+	// Account 3, denom2, deviates 10% from the weighted median
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: denom2, Amount: randomExchangeRate.Mul(sdk.NewDecWithPrec(11, 1))}}, 2)
+
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	performance, found := input.OracleKeeper.GetValidatorPerformance(input.Ctx, keeper.ValAddrs[0], window)
+	require.True(t, found)
+	require.Equal(t, uint64(1), performance.VotePeriods)
+	require.Equal(t, uint64(1), performance.Votes)
+	require.Equal(t, uint64(0), performance.Abstains)
+	require.Equal(t, uint64(1), performance.WinCount)
+	require.Equal(t, uint64(0), performance.Misses)
+	require.Equal(t, uint64(1), performance.DeviationCount)
+	require.Equal(t, sdk.ZeroDec(), performance.MeanDeviation)
+
+	performance, found = input.OracleKeeper.GetValidatorPerformance(input.Ctx, keeper.ValAddrs[1], window)
+	require.True(t, found)
+	require.Equal(t, uint64(1), performance.Votes)
+	require.Equal(t, uint64(1), performance.Abstains)
+	require.Equal(t, uint64(0), performance.Misses)
+	require.Equal(t, uint64(0), performance.DeviationCount)
+
+	performance, found = input.OracleKeeper.GetValidatorPerformance(input.Ctx, keeper.ValAddrs[2], window)
+	require.True(t, found)
+	require.Equal(t, uint64(1), performance.Votes)
+	require.Equal(t, uint64(1), performance.DeviationCount)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), performance.MeanDeviation)
+
+	// Nobody votes in the next vote period
+	input.Ctx = input.Ctx.WithBlockHeight(input.Ctx.BlockHeight() + 1)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	performance, _ = input.OracleKeeper.GetValidatorPerformance(input.Ctx, keeper.ValAddrs[0], window)
+	require.Equal(t, uint64(2), performance.VotePeriods)
+	require.Equal(t, uint64(1), performance.Votes)
+	require.Equal(t, uint64(1), performance.Misses)
+	require.Equal(t, sdk.ZeroDec(), performance.MeanDeviation)
+}
+
 func makeAggregatePrevoteAndVote(t *testing.T, input keeper.TestInput, h sdk.Handler, height int64, rates sdk.DecCoins, idx int) {
 	// Account 1, denom1
 	salt := "1"
@@ -26,10 +26,14 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		CmdQueryExchangeRates(),
+		CmdQueryCrossExchangeRate(),
+		CmdQueryQuoteExchangeRates(),
 		CmdQueryActives(),
 		CmdQueryVoteTargets(),
 		CmdQueryFeederDelegation(),
 		CmdQueryMissCounter(),
+		CmdQueryValidatorPerformance(),
+		CmdQueryValidatorPenalty(),
 		CmdQueryAggregatePrevote(),
 		CmdQueryAggregateVote(),
 		CmdQueryParams(),
@@ -89,6 +93,71 @@ $ blackfuryd query oracle exchange-rates uusd
 	return cmd
 }
 
+// CmdQueryCrossExchangeRate implements the query cross rate command.
+func CmdQueryCrossExchangeRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cross-exchange-rate [base] [quote]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the current exchange rate of an asset w.r.t another asset",
+		Long: strings.TrimSpace(`
+Query the current exchange rate of an asset w.r.t another asset, which is derived through the $uUSD exchange rates.
+
+$ blackfuryd query oracle cross-exchange-rate ufusd ueur
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CrossExchangeRate(
+				context.Background(),
+				&types.QueryCrossExchangeRateRequest{Base: args[0], Quote: args[1]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryQuoteExchangeRates implements the query quote exchange rates command.
+func CmdQueryQuoteExchangeRates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quote-exchange-rates",
+		Args:  cobra.NoArgs,
+		Short: "Query the current exchange rates of the assets w.r.t their quote currencies",
+		Long: strings.TrimSpace(`
+Query the current exchange rates of the assets which are quoted in currencies other than $uUSD, e.g., EUR or CPI.
+
+$ blackfuryd query oracle quote-exchange-rates
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QuoteExchangeRates(context.Background(), &types.QueryQuoteExchangeRatesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // CmdQueryActives implements the query actives command.
 func CmdQueryActives() *cobra.Command {
 	cmd := &cobra.Command{
@@ -230,6 +299,99 @@ $ blackfuryd query oracle miss did:fury:blackvaloper...
 	return cmd
 }
 
+// CmdQueryValidatorPerformance implements the query oracle performance of the validator command
+func CmdQueryValidatorPerformance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "performance [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the oracle voting statistics of a validator",
+		Long: strings.TrimSpace(`
+Query the oracle voting statistics of a validator in the recent slash windows,
+including the votes, abstains, ballot wins, misses and mean deviation from the weighted medians.
+
+$ blackfuryd query oracle performance did:fury:blackvaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorPerformance(
+				context.Background(),
+				&types.QueryValidatorPerformanceRequest{ValidatorAddr: validator.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryValidatorPenalty implements the query oracle penalty tier of the validator command
+func CmdQueryValidatorPenalty() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "penalty [validator]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the oracle penalty tier of a validator",
+		Long: strings.TrimSpace(`
+Query the oracle penalty tier of a validator, driven by the consecutive slash windows
+in which the validator missed the min valid vote rate.
+
+$ blackfuryd query oracle penalty did:fury:blackvaloper...
+
+Or, query the penalty tiers of all penalized validators
+
+$ blackfuryd query oracle penalty
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 0 {
+				res, err := queryClient.ValidatorPenalties(context.Background(), &types.QueryValidatorPenaltiesRequest{})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorPenalty(
+				context.Background(),
+				&types.QueryValidatorPenaltyRequest{ValidatorAddr: validator.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // CmdQueryAggregatePrevote implements the query aggregate prevote of the validator command
 func CmdQueryAggregatePrevote() *cobra.Command {
 	cmd := &cobra.Command{
@@ -0,0 +1,192 @@
+package feeder
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/elysiumstation/blackfury/x/oracle/types"
+)
+
+const (
+	FlagProvider     = "provider"
+	FlagValidator    = "validator"
+	FlagPollInterval = "poll-interval"
+)
+
+// NewOracleFeederCmd returns the command running the oracle price feeder of a validator
+func NewOracleFeederCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "oracle-feeder",
+		Args:  cobra.NoArgs,
+		Short: "Run the oracle price feeder submitting exchange rate prevotes and votes for a validator",
+		Long: strings.TrimSpace(`
+Run the oracle price feeder, which polls the price providers, takes the median price of every
+oracle vote target, and submits the aggregate exchange rate prevote and vote once per vote period.
+
+The --from key must be the feeder delegated by the validator (see "tx oracle set-feeder"),
+or the validator account itself if not delegated. Providers are HTTP(S) URLs or file paths
+serving JSON prices by denom, e.g. {"afury": "1.234", "ufusd": "0.99"}.
+
+$ blackfuryd oracle-feeder --from feeder --validator blackvaloper1... \
+    --provider https://prices.example.com/blackfury --provider /etc/blackfury/prices.json
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			// the feeder runs unattended
+			clientCtx = clientCtx.WithSkipConfirmation(true)
+
+			sources, err := cmd.Flags().GetStringSlice(FlagProvider)
+			if err != nil {
+				return err
+			}
+			if len(sources) == 0 {
+				return fmt.Errorf("no price provider given")
+			}
+			var providers []Provider
+			for _, source := range sources {
+				provider, err := NewProvider(source)
+				if err != nil {
+					return err
+				}
+				providers = append(providers, provider)
+			}
+
+			feeder := clientCtx.GetFromAddress()
+			validator := sdk.ValAddress(feeder)
+			validatorStr, err := cmd.Flags().GetString(FlagValidator)
+			if err != nil {
+				return err
+			}
+			if len(validatorStr) != 0 {
+				validator, err = sdk.ValAddressFromBech32(validatorStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			interval, err := cmd.Flags().GetDuration(FlagPollInterval)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			ctx := cmd.Context()
+
+			res, err := queryClient.FeederDelegation(ctx, &types.QueryFeederDelegationRequest{ValidatorAddr: validator.String()})
+			if err != nil {
+				return err
+			}
+			if res.FeederAddr != feeder.String() {
+				return fmt.Errorf("account %s is not the feeder of validator %s, but %s", feeder, validator, res.FeederAddr)
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+			f := NewFeeder(providers, validator, feeder, newBroadcastFunc(clientCtx, txf), logger)
+
+			logger.Info("starting oracle feeder", "validator", validator, "feeder", feeder, "providers", len(providers))
+
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				if err := step(ctx, clientCtx, queryClient, f); err != nil {
+					logger.Error("oracle feeder step failed", "err", err)
+				}
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-ticker.C:
+				}
+			}
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagProvider, nil, "Price provider, either an HTTP(S) URL or a file path serving JSON prices (repeatable)")
+	cmd.Flags().String(FlagValidator, "", "Validator to feed prices for, defaults to the validator of the --from account")
+	cmd.Flags().Duration(FlagPollInterval, 2*time.Second, "Interval of polling the latest block height")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// step queries the latest chain state and steps the feeder
+func step(ctx context.Context, clientCtx client.Context, queryClient types.QueryClient, f *Feeder) error {
+	status, err := clientCtx.Client.Status(ctx)
+	if err != nil {
+		return err
+	}
+	if status.SyncInfo.CatchingUp {
+		return fmt.Errorf("node is catching up")
+	}
+
+	params, err := queryClient.Params(ctx, &types.QueryParamsRequest{})
+	if err != nil {
+		return err
+	}
+	targets, err := queryClient.VoteTargets(ctx, &types.QueryVoteTargetsRequest{})
+	if err != nil {
+		return err
+	}
+
+	return f.Step(ctx, status.SyncInfo.LatestBlockHeight, params.Params.VotePeriod, targets.VoteTargets)
+}
+
+// newBroadcastFunc returns the BroadcastFunc signing with the --from key, which fails if the tx
+// is rejected by the node
+func newBroadcastFunc(clientCtx client.Context, txf tx.Factory) BroadcastFunc {
+	return func(_ context.Context, msgs ...sdk.Msg) error {
+		for _, msg := range msgs {
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+		}
+
+		// account number and sequence are queried every time, since txf is passed by value
+		txf, err := txf.Prepare(clientCtx)
+		if err != nil {
+			return err
+		}
+		if txf.SimulateAndExecute() {
+			_, adjusted, err := tx.CalculateGas(clientCtx, txf, msgs...)
+			if err != nil {
+				return err
+			}
+			txf = txf.WithGas(adjusted)
+		}
+
+		txBuilder, err := tx.BuildUnsignedTx(txf, msgs...)
+		if err != nil {
+			return err
+		}
+		txBuilder.SetFeeGranter(clientCtx.GetFeeGranterAddress())
+		if err := tx.Sign(txf, clientCtx.GetFromName(), txBuilder, true); err != nil {
+			return err
+		}
+		txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+		if err != nil {
+			return err
+		}
+
+		res, err := clientCtx.BroadcastTx(txBytes)
+		if err != nil {
+			return err
+		}
+		if res.Code != 0 {
+			return fmt.Errorf("tx %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,167 @@
+package feeder
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/elysiumstation/blackfury/x/oracle/types"
+)
+
+// BroadcastFunc signs and broadcasts the messages in a single tx
+type BroadcastFunc func(ctx context.Context, msgs ...sdk.Msg) error
+
+// prevote is the prevote submitted by the feeder, to be revealed in the next vote period
+type prevote struct {
+	period        uint64
+	salt          string
+	exchangeRates string
+}
+
+// Feeder submits the aggregate exchange rate prevotes and votes of a validator,
+// with the median prices polled from the providers
+type Feeder struct {
+	providers []Provider
+	validator sdk.ValAddress
+	feeder    sdk.AccAddress
+	broadcast BroadcastFunc
+	logger    log.Logger
+
+	prevote *prevote
+}
+
+// NewFeeder creates a new Feeder
+func NewFeeder(providers []Provider, validator sdk.ValAddress, feeder sdk.AccAddress, broadcast BroadcastFunc, logger log.Logger) *Feeder {
+	return &Feeder{
+		providers: providers,
+		validator: validator,
+		feeder:    feeder,
+		broadcast: broadcast,
+		logger:    logger,
+	}
+}
+
+// Step submits the vote revealing the prevote of the last vote period together with
+// a new prevote, at most once per vote period. The height is the latest committed
+// block height, so the tx is expected to be included in the next block.
+func (f *Feeder) Step(ctx context.Context, height int64, votePeriod uint64, voteTargets []string) error {
+	next := uint64(height) + 1
+	period := next / votePeriod
+	if f.prevote != nil && f.prevote.period >= period {
+		// already prevoted in this period
+		return nil
+	}
+	if votePeriod > 1 && next%votePeriod == votePeriod-1 {
+		// the tx may slip into the next period, which breaks the prevote and vote pairing.
+		// Every block is the last of its period when the vote period is one block, so it
+		// is submitted anyway, and a broken pairing is recovered in the following periods.
+		return nil
+	}
+
+	var msgs []sdk.Msg
+	if f.prevote != nil && f.prevote.period+1 == period {
+		msgs = append(msgs, types.NewMsgAggregateExchangeRateVote(f.prevote.salt, f.prevote.exchangeRates, f.feeder, f.validator))
+	}
+
+	var pending *prevote
+	tuples := f.FetchExchangeRates(ctx, voteTargets)
+	if len(tuples) != 0 {
+		salt, err := randomSalt()
+		if err != nil {
+			return err
+		}
+		exchangeRates := FormatExchangeRates(tuples)
+		hash := types.GetAggregateVoteHash(salt, exchangeRates, f.validator)
+		msgs = append(msgs, types.NewMsgAggregateExchangeRatePrevote(hash, f.feeder, f.validator))
+		pending = &prevote{
+			period:        period,
+			salt:          salt,
+			exchangeRates: exchangeRates,
+		}
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	// keep the last prevote on failure, so that it can still be revealed on retry
+	if err := f.broadcast(ctx, msgs...); err != nil {
+		return err
+	}
+	f.prevote = pending
+
+	f.logger.Info("submitted oracle messages", "height", next, "period", period, "msgs", len(msgs))
+	return nil
+}
+
+// FetchExchangeRates polls all providers and returns the median prices of the vote targets,
+// skipping the denoms not priced by any provider
+func (f *Feeder) FetchExchangeRates(ctx context.Context, voteTargets []string) types.ExchangeRateTuples {
+	pricesByDenom := make(map[string][]sdk.Dec, len(voteTargets))
+	for _, provider := range f.providers {
+		prices, err := provider.Prices(ctx)
+		if err != nil {
+			f.logger.Error("failed to fetch prices", "provider", provider.Name(), "err", err)
+			continue
+		}
+		for _, denom := range voteTargets {
+			if price, ok := prices[denom]; ok {
+				pricesByDenom[denom] = append(pricesByDenom[denom], price)
+			}
+		}
+	}
+
+	var tuples types.ExchangeRateTuples
+	for _, denom := range voteTargets {
+		prices := pricesByDenom[denom]
+		if len(prices) == 0 {
+			f.logger.Error("no price for vote target", "denom", denom)
+			continue
+		}
+		tuples = append(tuples, types.NewExchangeRateTuple(denom, Median(prices)))
+	}
+	return tuples
+}
+
+// Median returns the median of the prices, or the average of the two middle prices if
+// the count is even
+func Median(prices []sdk.Dec) sdk.Dec {
+	if len(prices) == 0 {
+		return sdk.ZeroDec()
+	}
+	sorted := make([]sdk.Dec, len(prices))
+	copy(sorted, prices)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].LT(sorted[j]) })
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+	return sorted[mid-1].Add(sorted[mid]).QuoInt64(2)
+}
+
+// FormatExchangeRates formats the exchange rates sorted by denom, as accepted by the vote message
+func FormatExchangeRates(tuples types.ExchangeRateTuples) string {
+	sorted := make(types.ExchangeRateTuples, len(tuples))
+	copy(sorted, tuples)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Denom < sorted[j].Denom })
+
+	strs := make([]string, len(sorted))
+	for i, tuple := range sorted {
+		strs[i] = tuple.Denom + ":" + tuple.ExchangeRate.String()
+	}
+	return strings.Join(strs, ",")
+}
+
+// randomSalt returns a random salt of 4 hex characters
+func randomSalt() (string, error) {
+	bz := make([]byte, 2)
+	if _, err := rand.Read(bz); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bz), nil
+}
@@ -0,0 +1,154 @@
+package feeder_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/elysiumstation/blackfury/x/oracle/client/feeder"
+	"github.com/elysiumstation/blackfury/x/oracle/types"
+)
+
+type staticProvider map[string]sdk.Dec
+
+func (p staticProvider) Name() string { return "static" }
+
+func (p staticProvider) Prices(_ context.Context) (map[string]sdk.Dec, error) {
+	return p, nil
+}
+
+type failingProvider struct{}
+
+func (failingProvider) Name() string { return "failing" }
+
+func (failingProvider) Prices(_ context.Context) (map[string]sdk.Dec, error) {
+	return nil, errors.New("unavailable")
+}
+
+func TestMedian(t *testing.T) {
+	dec := sdk.MustNewDecFromStr
+	require.Equal(t, dec("2"), feeder.Median([]sdk.Dec{dec("3"), dec("1"), dec("2")}))
+	require.Equal(t, dec("2.5"), feeder.Median([]sdk.Dec{dec("4"), dec("1"), dec("3"), dec("2")}))
+	require.Equal(t, dec("7"), feeder.Median([]sdk.Dec{dec("7")}))
+	require.True(t, feeder.Median(nil).IsZero())
+}
+
+func TestFetchExchangeRates(t *testing.T) {
+	dec := sdk.MustNewDecFromStr
+	providers := []feeder.Provider{
+		staticProvider{"afury": dec("1.0"), "ufusd": dec("0.98"), "uatom": dec("10")},
+		staticProvider{"afury": dec("1.2"), "ufusd": dec("1.00")},
+		staticProvider{"afury": dec("5.0")},
+		failingProvider{},
+	}
+	f := feeder.NewFeeder(providers, nil, nil, nil, log.NewNopLogger())
+
+	// uatom is not a vote target and ueth has no price
+	tuples := f.FetchExchangeRates(context.Background(), []string{"ufusd", "afury", "ueth"})
+	require.Equal(t, types.ExchangeRateTuples{
+		types.NewExchangeRateTuple("ufusd", dec("0.99")),
+		types.NewExchangeRateTuple("afury", dec("1.2")),
+	}, tuples)
+	require.Equal(t, "afury:1.200000000000000000,ufusd:0.990000000000000000", feeder.FormatExchangeRates(tuples))
+}
+
+func TestStep(t *testing.T) {
+	const votePeriod = 5
+	validator := sdk.ValAddress("validator")
+	feederAddr := sdk.AccAddress("feeder")
+	targets := []string{"afury", "ufusd"}
+	providers := []feeder.Provider{staticProvider{"afury": sdk.MustNewDecFromStr("1.5"), "ufusd": sdk.OneDec()}}
+
+	var (
+		broadcasted [][]sdk.Msg
+		fail        bool
+	)
+	broadcast := func(_ context.Context, msgs ...sdk.Msg) error {
+		if fail {
+			return errors.New("rejected")
+		}
+		for _, msg := range msgs {
+			require.NoError(t, msg.ValidateBasic())
+		}
+		broadcasted = append(broadcasted, msgs)
+		return nil
+	}
+	f := feeder.NewFeeder(providers, validator, feederAddr, broadcast, log.NewNopLogger())
+	ctx := context.Background()
+
+	// next block 4 is the last block of period 0
+	require.NoError(t, f.Step(ctx, 3, votePeriod, targets))
+	require.Len(t, broadcasted, 0)
+
+	// prevote in period 1, only once
+	require.NoError(t, f.Step(ctx, 4, votePeriod, targets))
+	require.NoError(t, f.Step(ctx, 5, votePeriod, targets))
+	require.Len(t, broadcasted, 1)
+	require.Len(t, broadcasted[0], 1)
+	prevote := broadcasted[0][0].(*types.MsgAggregateExchangeRatePrevote)
+
+	// vote and prevote in period 2
+	require.NoError(t, f.Step(ctx, 9, votePeriod, targets))
+	require.Len(t, broadcasted, 2)
+	require.Len(t, broadcasted[1], 2)
+	vote := broadcasted[1][0].(*types.MsgAggregateExchangeRateVote)
+	require.Equal(t, "afury:1.500000000000000000,ufusd:1.000000000000000000", vote.ExchangeRates)
+	require.Equal(t, prevote.Hash, types.GetAggregateVoteHash(vote.Salt, vote.ExchangeRates, validator).String())
+	require.Equal(t, feederAddr.String(), vote.Feeder)
+	require.Equal(t, validator.String(), vote.Validator)
+	_, ok := broadcasted[1][1].(*types.MsgAggregateExchangeRatePrevote)
+	require.True(t, ok)
+
+	// failed broadcast in period 3 is retried
+	fail = true
+	require.Error(t, f.Step(ctx, 14, votePeriod, targets))
+	fail = false
+	require.NoError(t, f.Step(ctx, 15, votePeriod, targets))
+	require.Len(t, broadcasted, 3)
+	require.Len(t, broadcasted[2], 2)
+
+	// period 4 is missed, so the prevote of period 3 is not revealed in period 5
+	require.NoError(t, f.Step(ctx, 25, votePeriod, targets))
+	require.Len(t, broadcasted, 4)
+	require.Len(t, broadcasted[3], 1)
+	_, ok = broadcasted[3][0].(*types.MsgAggregateExchangeRatePrevote)
+	require.True(t, ok)
+}
+
+func TestStepVotePeriodOne(t *testing.T) {
+	const votePeriod = 1
+	validator := sdk.ValAddress("validator")
+	feederAddr := sdk.AccAddress("feeder")
+	targets := []string{"afury", "ufusd"}
+	providers := []feeder.Provider{staticProvider{"afury": sdk.MustNewDecFromStr("1.5"), "ufusd": sdk.OneDec()}}
+
+	var broadcasted [][]sdk.Msg
+	broadcast := func(_ context.Context, msgs ...sdk.Msg) error {
+		broadcasted = append(broadcasted, msgs)
+		return nil
+	}
+	f := feeder.NewFeeder(providers, validator, feederAddr, broadcast, log.NewNopLogger())
+	ctx := context.Background()
+
+	// every block is a period, so prevote in the first one
+	require.NoError(t, f.Step(ctx, 3, votePeriod, targets))
+	require.Len(t, broadcasted, 1)
+	require.Len(t, broadcasted[0], 1)
+	prevote := broadcasted[0][0].(*types.MsgAggregateExchangeRatePrevote)
+
+	// only once per period
+	require.NoError(t, f.Step(ctx, 3, votePeriod, targets))
+	require.Len(t, broadcasted, 1)
+
+	// vote and prevote in the next block
+	require.NoError(t, f.Step(ctx, 4, votePeriod, targets))
+	require.Len(t, broadcasted, 2)
+	require.Len(t, broadcasted[1], 2)
+	vote := broadcasted[1][0].(*types.MsgAggregateExchangeRateVote)
+	require.Equal(t, prevote.Hash, types.GetAggregateVoteHash(vote.Salt, vote.ExchangeRates, validator).String())
+	require.IsType(t, &types.MsgAggregateExchangeRatePrevote{}, broadcasted[1][1])
+}
@@ -0,0 +1,141 @@
+package feeder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Provider provides the prices of denoms, quoted in the same unit as the oracle exchange rates
+type Provider interface {
+	// Name returns the name of the provider, used for logging
+	Name() string
+	// Prices returns the prices by denom
+	Prices(ctx context.Context) (map[string]sdk.Dec, error)
+}
+
+// NewProvider creates a provider from the given source, which is either an HTTP(S) URL
+// or a file path (optionally prefixed with "file://"), serving JSON prices like:
+//
+//	{"afury": "1.234", "ufusd": "0.99"}
+func NewProvider(source string) (Provider, error) {
+	switch {
+	case strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://"):
+		return NewHTTPProvider(source, 5*time.Second), nil
+	case strings.HasPrefix(source, "file://"):
+		return NewFileProvider(strings.TrimPrefix(source, "file://")), nil
+	case len(source) != 0:
+		return NewFileProvider(source), nil
+	default:
+		return nil, fmt.Errorf("empty price provider source")
+	}
+}
+
+// HTTPProvider fetches prices from an HTTP endpoint serving JSON prices
+type HTTPProvider struct {
+	url    string
+	client *http.Client
+}
+
+var _ Provider = HTTPProvider{}
+
+// NewHTTPProvider creates a new HTTPProvider
+func NewHTTPProvider(url string, timeout time.Duration) HTTPProvider {
+	return HTTPProvider{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Name implements Provider
+func (p HTTPProvider) Name() string {
+	return p.url
+}
+
+// Prices implements Provider
+func (p HTTPProvider) Prices(ctx context.Context) (map[string]sdk.Dec, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	bz, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePrices(bz)
+}
+
+// FileProvider reads prices from a static JSON file, which is read again on every poll
+type FileProvider struct {
+	path string
+}
+
+var _ Provider = FileProvider{}
+
+// NewFileProvider creates a new FileProvider
+func NewFileProvider(path string) FileProvider {
+	return FileProvider{path: path}
+}
+
+// Name implements Provider
+func (p FileProvider) Name() string {
+	return "file://" + p.path
+}
+
+// Prices implements Provider
+func (p FileProvider) Prices(_ context.Context) (map[string]sdk.Dec, error) {
+	bz, err := ioutil.ReadFile(p.path)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePrices(bz)
+}
+
+// ParsePrices parses JSON prices by denom, where prices are given as either decimal strings or numbers
+func ParsePrices(bz []byte) (map[string]sdk.Dec, error) {
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+
+	var raw map[string]interface{}
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("invalid prices: %w", err)
+	}
+
+	prices := make(map[string]sdk.Dec, len(raw))
+	for denom, value := range raw {
+		var str string
+		switch v := value.(type) {
+		case string:
+			str = v
+		case json.Number:
+			str = v.String()
+		default:
+			return nil, fmt.Errorf("invalid price of %s: %v", denom, value)
+		}
+		price, err := sdk.NewDecFromStr(str)
+		if err != nil {
+			return nil, fmt.Errorf("invalid price of %s: %w", denom, err)
+		}
+		if !price.IsPositive() {
+			return nil, fmt.Errorf("invalid price of %s: %s", denom, price)
+		}
+		prices[denom] = price
+	}
+	return prices, nil
+}
@@ -0,0 +1,79 @@
+package feeder_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elysiumstation/blackfury/x/oracle/client/feeder"
+)
+
+func TestHTTPProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/prices" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"afury": "1.234", "ufusd": 0.99}`)
+	}))
+	defer server.Close()
+
+	provider, err := feeder.NewProvider(server.URL + "/prices")
+	require.NoError(t, err)
+	prices, err := provider.Prices(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, map[string]sdk.Dec{
+		"afury": sdk.MustNewDecFromStr("1.234"),
+		"ufusd": sdk.MustNewDecFromStr("0.99"),
+	}, prices)
+
+	provider, err = feeder.NewProvider(server.URL + "/missing")
+	require.NoError(t, err)
+	_, err = provider.Prices(context.Background())
+	require.Error(t, err)
+}
+
+func TestFileProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prices.json")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{"afury": "2.5"}`), 0o600))
+
+	for _, source := range []string{path, "file://" + path} {
+		provider, err := feeder.NewProvider(source)
+		require.NoError(t, err)
+		prices, err := provider.Prices(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, map[string]sdk.Dec{"afury": sdk.MustNewDecFromStr("2.5")}, prices)
+	}
+
+	_, err := feeder.NewProvider("")
+	require.Error(t, err)
+}
+
+func TestParsePrices(t *testing.T) {
+	testCases := []struct {
+		name    string
+		json    string
+		expPass bool
+	}{
+		{"strings and numbers", `{"afury": "1.5", "ufusd": 1}`, true},
+		{"not an object", `["1.5"]`, false},
+		{"invalid decimal", `{"afury": "abc"}`, false},
+		{"non-positive price", `{"afury": "0"}`, false},
+		{"invalid type", `{"afury": true}`, false},
+	}
+	for _, tc := range testCases {
+		_, err := feeder.ParsePrices([]byte(tc.json))
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
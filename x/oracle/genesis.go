@@ -56,6 +56,36 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetAggregateExchangeRateVote(ctx, valAddr, av)
 	}
 
+	for _, vp := range genState.ValidatorPerformances {
+		operator, err := sdk.ValAddressFromBech32(vp.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		k.SetValidatorPerformance(ctx, operator, vp)
+	}
+
+	for _, vp := range genState.ValidatorPenalties {
+		operator, err := sdk.ValAddressFromBech32(vp.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		k.SetValidatorPenalty(ctx, operator, vp)
+	}
+
+	for _, tq := range genState.TargetQuotes {
+		k.SetTargetQuote(ctx, tq.Denom, tq.Quote)
+	}
+
+	for _, denom := range genState.InterchainTargets {
+		k.SetInterchainTarget(ctx, denom)
+	}
+
+	for _, rate := range genState.InterchainExchangeRates {
+		k.SetInterchainExchangeRate(ctx, rate)
+	}
+
 	k.SetParams(ctx, genState.Params)
 
 	// check if the module account exists
@@ -63,6 +93,14 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if moduleAcc == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
 	}
+
+	// Only try to bind to port if it is not already bound, since we may already own
+	// port capability from capability InitGenesis
+	if !k.IsBound(ctx, types.PortID) {
+		if err := k.BindPort(ctx, types.PortID); err != nil {
+			panic(fmt.Sprintf("could not claim port capability: %v", err))
+		}
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -104,10 +142,45 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		return false
 	})
 
+	validatorPerformances := []types.ValidatorPerformance{}
+	k.IterateValidatorPerformances(ctx, func(_ sdk.ValAddress, performance types.ValidatorPerformance) (stop bool) {
+		validatorPerformances = append(validatorPerformances, performance)
+		return false
+	})
+
+	validatorPenalties := []types.ValidatorPenalty{}
+	k.IterateValidatorPenalties(ctx, func(_ sdk.ValAddress, penalty types.ValidatorPenalty) (stop bool) {
+		validatorPenalties = append(validatorPenalties, penalty)
+		return false
+	})
+
+	targetQuotes := []types.TargetQuote{}
+	k.IterateTargetQuotes(ctx, func(denom string, quote string) (stop bool) {
+		targetQuotes = append(targetQuotes, types.TargetQuote{Denom: denom, Quote: quote})
+		return false
+	})
+
+	interchainTargets := []string{}
+	k.IterateInterchainTargets(ctx, func(denom string) (stop bool) {
+		interchainTargets = append(interchainTargets, denom)
+		return false
+	})
+
+	interchainExchangeRates := []types.InterchainExchangeRate{}
+	k.IterateInterchainExchangeRates(ctx, func(rate types.InterchainExchangeRate) (stop bool) {
+		interchainExchangeRates = append(interchainExchangeRates, rate)
+		return false
+	})
+
 	return types.NewGenesis(params,
 		exchangeRates,
 		feederDelegations,
 		missCounters,
 		aggregateExchangeRatePrevotes,
-		aggregateExchangeRateVotes)
+		aggregateExchangeRateVotes,
+		validatorPerformances,
+		validatorPenalties,
+		targetQuotes,
+		interchainTargets,
+		interchainExchangeRates)
 }
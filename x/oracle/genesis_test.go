@@ -18,7 +18,17 @@ func TestExportInitGenesis(t *testing.T) {
 	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, keeper.ValAddrs[0], types.NewAggregateExchangeRatePrevote(types.AggregateVoteHash{123}, keeper.ValAddrs[0], uint64(2)))
 	input.OracleKeeper.SetAggregateExchangeRateVote(input.Ctx, keeper.ValAddrs[0], types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{{Denom: "foo", ExchangeRate: sdk.NewDec(123)}}, keeper.ValAddrs[0]))
 	input.OracleKeeper.SetMissCounter(input.Ctx, keeper.ValAddrs[0], 10)
+	input.OracleKeeper.SetTargetQuote(input.Ctx, "foo", "denom")
+	input.OracleKeeper.SetInterchainTarget(input.Ctx, "bar")
+	input.OracleKeeper.SetInterchainExchangeRate(input.Ctx, types.InterchainExchangeRate{
+		Denom:        "bar",
+		ExchangeRate: sdk.NewDec(456),
+		Timestamp:    input.Ctx.BlockTime().UTC(),
+	})
 	genesis := oracle.ExportGenesis(input.Ctx, input.OracleKeeper)
+	require.Equal(t, []types.TargetQuote{{Denom: "foo", Quote: "denom"}}, genesis.TargetQuotes)
+	require.Equal(t, []string{"bar"}, genesis.InterchainTargets)
+	require.Len(t, genesis.InterchainExchangeRates, 1)
 
 	newInput := keeper.CreateTestInput(t)
 	oracle.InitGenesis(newInput.Ctx, newInput.OracleKeeper, *genesis)
@@ -0,0 +1,277 @@
+package ibc_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+	"github.com/tharsis/ethermint/encoding"
+
+	"github.com/elysiumstation/blackfury/app"
+	blackfury "github.com/elysiumstation/blackfury/types"
+	"github.com/elysiumstation/blackfury/x/oracle"
+	oracleibc "github.com/elysiumstation/blackfury/x/oracle/ibc"
+	"github.com/elysiumstation/blackfury/x/oracle/types"
+)
+
+const interchainDenom = "uatom"
+
+// testingApp adapts Blackfury to the ibc-go testing framework, which creates
+// its genesis validators and accounts with the default bond denom.
+type testingApp struct {
+	*app.Blackfury
+}
+
+func (a testingApp) InitChain(req abci.RequestInitChain) abci.ResponseInitChain {
+	genesisState := app.GenesisState{}
+	if err := json.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
+		panic(err)
+	}
+	cdc := a.AppCodec()
+
+	var bankGenesis banktypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[banktypes.ModuleName], &bankGenesis)
+	for i, balance := range bankGenesis.Balances {
+		bankGenesis.Balances[i].Coins = toBaseDenom(balance.Coins)
+	}
+	bankGenesis.Supply = toBaseDenom(bankGenesis.Supply)
+	genesisState[banktypes.ModuleName] = cdc.MustMarshalJSON(&bankGenesis)
+
+	var stakingGenesis stakingtypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[stakingtypes.ModuleName], &stakingGenesis)
+	stakingGenesis.Params.BondDenom = blackfury.BaseDenom
+	genesisState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(&stakingGenesis)
+
+	stateBytes, err := json.Marshal(genesisState)
+	if err != nil {
+		panic(err)
+	}
+	req.AppStateBytes = stateBytes
+
+	return a.Blackfury.InitChain(req)
+}
+
+func toBaseDenom(coins sdk.Coins) sdk.Coins {
+	amount := coins.AmountOf(sdk.DefaultBondDenom)
+	if amount.IsZero() {
+		return coins
+	}
+	stake := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+	return coins.Sub(sdk.NewCoins(stake)).Add(sdk.NewCoin(blackfury.BaseDenom, amount))
+}
+
+func setupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	blackfuryApp := app.NewBlackfury(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, app.DefaultNodeHome, 0, encoding.MakeConfig(app.ModuleBasics), simapp.EmptyAppOptions{})
+	return testingApp{blackfuryApp.(*app.Blackfury)}, app.NewDefaultGenesisState()
+}
+
+type IBCModuleTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// oracleChain sends the price packets, chainB receives them
+	oracleChain *ibctesting.TestChain
+	chainB      *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func TestIBCModuleTestSuite(t *testing.T) {
+	suite.Run(t, new(IBCModuleTestSuite))
+}
+
+func (suite *IBCModuleTestSuite) SetupTest() {
+	app.SetupConfig()
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+
+	// NOTE: ethermint requires chain ids of the form {identifier}_{EIP155}-{version}
+	suite.coordinator = &ibctesting.Coordinator{
+		T:           suite.T(),
+		CurrentTime: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		Chains:      make(map[string]*ibctesting.TestChain),
+	}
+	for _, chainID := range []string{"blackfury_5000-1", "blackfury_5001-1"} {
+		chain := ibctesting.NewTestChain(suite.T(), suite.coordinator, chainID)
+		suite.coordinator.Chains[chainID] = chain
+		suite.setupSender(chain)
+	}
+	suite.oracleChain = suite.coordinator.GetChain("blackfury_5000-1")
+	suite.chainB = suite.coordinator.GetChain("blackfury_5001-1")
+	suite.coordinator.CommitBlock(suite.oracleChain, suite.chainB)
+
+	suite.path = suite.newOraclePath()
+	suite.coordinator.SetupConnections(suite.path)
+
+	suite.setInterchainOracleParams(suite.path.EndpointA, "channel-0")
+	suite.setInterchainOracleParams(suite.path.EndpointB, "channel-0")
+	suite.coordinator.CommitBlock(suite.oracleChain, suite.chainB)
+
+	suite.coordinator.CreateChannels(suite.path)
+
+	ctx := suite.chainB.GetContext()
+	suite.getApp(suite.chainB).OracleKeeper.SetTarget(ctx, interchainDenom)
+	suite.getApp(suite.chainB).OracleKeeper.SetInterchainTarget(ctx, interchainDenom)
+	suite.coordinator.CommitBlock(suite.chainB)
+}
+
+// setupSender replaces the sender account of the chain with an eth_secp256k1
+// account, since the ante handler does not accept secp256k1 signatures.
+func (suite *IBCModuleTestSuite) setupSender(chain *ibctesting.TestChain) {
+	privKey, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	addr := sdk.AccAddress(privKey.PubKey().Address())
+
+	bapp := suite.getApp(chain)
+	ctx := chain.GetContext()
+	acc := bapp.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	bapp.AccountKeeper.SetAccount(ctx, acc)
+	err = app.FundAccount(bapp.BankKeeper, ctx, addr, sdk.NewCoins(sdk.NewCoin(blackfury.BaseDenom, sdk.NewInt(1e18))))
+	suite.Require().NoError(err)
+
+	chain.SenderPrivKey = privKey
+	chain.SenderAccount = bapp.AccountKeeper.GetAccount(ctx, addr)
+}
+
+func (suite *IBCModuleTestSuite) getApp(chain *ibctesting.TestChain) *app.Blackfury {
+	return chain.App.(testingApp).Blackfury
+}
+
+func (suite *IBCModuleTestSuite) newOraclePath() *ibctesting.Path {
+	path := ibctesting.NewPath(suite.oracleChain, suite.chainB)
+	path.EndpointA.ChannelConfig.PortID = types.PortID
+	path.EndpointB.ChannelConfig.PortID = types.PortID
+	path.EndpointA.ChannelConfig.Version = types.Version
+	path.EndpointB.ChannelConfig.Version = types.Version
+	return path
+}
+
+func (suite *IBCModuleTestSuite) setInterchainOracleParams(endpoint *ibctesting.Endpoint, channelID string) {
+	ctx := endpoint.Chain.GetContext()
+	oracleKeeper := suite.getApp(endpoint.Chain).OracleKeeper
+	params := oracleKeeper.GetParams(ctx)
+	params.InterchainOracleClientId = endpoint.ClientID
+	params.InterchainOracleChannelId = channelID
+	// end every block with a tally, which restores or expires the interchain exchange rates
+	params.VotePeriod = 1
+	oracleKeeper.SetParams(ctx, params)
+}
+
+// sendPricePacket sends the exchange rates from the oracle chain over the given path
+// and relays them to chainB, returning the acknowledgement written by chainB.
+func (suite *IBCModuleTestSuite) sendPricePacket(path *ibctesting.Path, rate sdk.Dec, timestamp time.Time, sequence uint64) channeltypes.Acknowledgement {
+	data := types.InterchainOraclePacketData{
+		ExchangeRates: types.ExchangeRateTuples{{Denom: interchainDenom, ExchangeRate: rate}},
+		Timestamp:     timestamp,
+	}
+	packet := channeltypes.NewPacket(
+		data.GetBytes(), sequence,
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+		clienttypes.NewHeight(1, 1000), 0,
+	)
+
+	suite.Require().NoError(path.EndpointA.SendPacket(packet))
+	res, err := path.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	bz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	var ack channeltypes.Acknowledgement
+	suite.Require().NoError(channeltypes.SubModuleCdc.UnmarshalJSON(bz, &ack))
+	return ack
+}
+
+func (suite *IBCModuleTestSuite) TestOnRecvPacket() {
+	oracleKeeper := suite.getApp(suite.chainB).OracleKeeper
+	rate := sdk.NewDecWithPrec(12345, 3)
+
+	ack := suite.sendPricePacket(suite.path, rate, suite.chainB.GetContext().BlockTime(), 1)
+	suite.Require().True(ack.Success(), ack.GetError())
+
+	ctx := suite.chainB.GetContext()
+	exchangeRate, err := oracleKeeper.GetExchangeRate(ctx, interchainDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(rate, exchangeRate)
+
+	interchainRate, found := oracleKeeper.GetInterchainExchangeRate(ctx, interchainDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(rate, interchainRate.ExchangeRate)
+
+	// the exchange rate survives the end of the vote period while it is fresh
+	// NOTE: the testing framework does not run end blockers
+	oracle.EndBlocker(ctx, oracleKeeper)
+	exchangeRate, err = oracleKeeper.GetExchangeRate(ctx, interchainDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(rate, exchangeRate)
+}
+
+func (suite *IBCModuleTestSuite) TestOnRecvPacketStale() {
+	oracleKeeper := suite.getApp(suite.chainB).OracleKeeper
+	maxPriceAge := oracleKeeper.InterchainMaxPriceAge(suite.chainB.GetContext())
+
+	timestamp := suite.chainB.GetContext().BlockTime().Add(-maxPriceAge - time.Second)
+	ack := suite.sendPricePacket(suite.path, sdk.OneDec(), timestamp, 1)
+	suite.Require().False(ack.Success())
+
+	_, err := oracleKeeper.GetExchangeRate(suite.chainB.GetContext(), interchainDenom)
+	suite.Require().Error(err)
+
+	// a fresh exchange rate expires once it is older than the max price age
+	ack = suite.sendPricePacket(suite.path, sdk.OneDec(), suite.chainB.GetContext().BlockTime(), 2)
+	suite.Require().True(ack.Success(), ack.GetError())
+
+	ctx := suite.chainB.GetContext()
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(maxPriceAge + time.Second))
+	oracle.EndBlocker(ctx, oracleKeeper)
+
+	_, err = oracleKeeper.GetExchangeRate(ctx, interchainDenom)
+	suite.Require().Error(err)
+	_, found := oracleKeeper.GetInterchainExchangeRate(ctx, interchainDenom)
+	suite.Require().False(found)
+}
+
+func (suite *IBCModuleTestSuite) TestOnRecvPacketUnknownChannel() {
+	// open a second channel over the same connection, which is not the configured one
+	path := suite.newOraclePath()
+	path.EndpointA.ClientID = suite.path.EndpointA.ClientID
+	path.EndpointB.ClientID = suite.path.EndpointB.ClientID
+	path.EndpointA.ConnectionID = suite.path.EndpointA.ConnectionID
+	path.EndpointB.ConnectionID = suite.path.EndpointB.ConnectionID
+	suite.coordinator.CreateChannels(path)
+	suite.Require().NotEqual(suite.path.EndpointB.ChannelID, path.EndpointB.ChannelID)
+
+	ack := suite.sendPricePacket(path, sdk.OneDec(), suite.chainB.GetContext().BlockTime(), 1)
+	suite.Require().False(ack.Success())
+
+	_, err := suite.getApp(suite.chainB).OracleKeeper.GetExchangeRate(suite.chainB.GetContext(), interchainDenom)
+	suite.Require().Error(err)
+}
+
+func (suite *IBCModuleTestSuite) TestChanOpenInitUnknownClient() {
+	// the counterparty client of a new connection is not the configured one
+	path := suite.newOraclePath()
+	suite.coordinator.SetupConnections(path)
+
+	ctx := suite.oracleChain.GetContext()
+	module := oracleibc.NewIBCModule(suite.getApp(suite.oracleChain).OracleKeeper)
+	err := module.OnChanOpenInit(
+		ctx, channeltypes.UNORDERED, []string{path.EndpointA.ConnectionID},
+		types.PortID, "channel-1", nil,
+		channeltypes.NewCounterparty(types.PortID, ""), types.Version,
+	)
+	suite.Require().ErrorIs(err, types.ErrInvalidChannel)
+}
@@ -54,6 +54,40 @@ func (k Keeper) ExchangeRates(c context.Context, req *types.QueryExchangeRatesRe
 	return &types.QueryExchangeRatesResponse{ExchangeRates: exchangeRates}, nil
 }
 
+func (k Keeper) CrossExchangeRate(c context.Context, req *types.QueryCrossExchangeRateRequest) (*types.QueryCrossExchangeRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Base) == 0 || len(req.Quote) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	exchangeRate, err := k.GetCrossExchangeRate(ctx, req.Base, req.Quote)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCrossExchangeRateResponse{ExchangeRate: exchangeRate}, nil
+}
+
+func (k Keeper) QuoteExchangeRates(c context.Context, req *types.QueryQuoteExchangeRatesRequest) (*types.QueryQuoteExchangeRatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var rates []types.QuoteExchangeRate
+	k.IterateQuoteExchangeRates(ctx, func(rate types.QuoteExchangeRate) (stop bool) {
+		rates = append(rates, rate)
+		return false
+	})
+
+	return &types.QueryQuoteExchangeRatesResponse{QuoteExchangeRates: rates}, nil
+}
+
 func (k Keeper) Actives(c context.Context, req *types.QueryActivesRequest) (*types.QueryActivesResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -120,6 +154,59 @@ func (k Keeper) MissCounter(c context.Context, req *types.QueryMissCounterReques
 	}, nil
 }
 
+func (k Keeper) ValidatorPerformance(c context.Context, req *types.QueryValidatorPerformanceRequest) (*types.QueryValidatorPerformanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	performances := []types.ValidatorPerformance{}
+	k.IterateValidatorPerformancesByValidator(ctx, valAddr, func(performance types.ValidatorPerformance) (stop bool) {
+		performances = append(performances, performance)
+		return false
+	})
+
+	return &types.QueryValidatorPerformanceResponse{
+		Performances: performances,
+	}, nil
+}
+
+func (k Keeper) ValidatorPenalty(c context.Context, req *types.QueryValidatorPenaltyRequest) (*types.QueryValidatorPenaltyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	penalty, _ := k.GetValidatorPenalty(ctx, valAddr)
+	return &types.QueryValidatorPenaltyResponse{
+		Penalty: penalty,
+	}, nil
+}
+
+func (k Keeper) ValidatorPenalties(c context.Context, _ *types.QueryValidatorPenaltiesRequest) (*types.QueryValidatorPenaltiesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	penalties := []types.ValidatorPenalty{}
+	k.IterateValidatorPenalties(ctx, func(_ sdk.ValAddress, penalty types.ValidatorPenalty) (stop bool) {
+		penalties = append(penalties, penalty)
+		return false
+	})
+
+	return &types.QueryValidatorPenaltiesResponse{
+		Penalties: penalties,
+	}, nil
+}
+
 func (k Keeper) AggregatePrevote(c context.Context, req *types.QueryAggregatePrevoteRequest) (*types.QueryAggregatePrevoteResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
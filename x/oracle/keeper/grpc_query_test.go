@@ -46,6 +46,35 @@ func TestQueryExchangeRate(t *testing.T) {
 	require.Equal(t, rate, res.ExchangeRate)
 }
 
+func TestQueryCrossExchangeRate(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	input.OracleKeeper.SetExchangeRate(input.Ctx, fooDenom1, sdk.NewDec(1700))
+	input.OracleKeeper.SetExchangeRate(input.Ctx, fooDenom2, sdk.NewDec(2))
+
+	// empty request
+	_, err := querier.CrossExchangeRate(ctx, nil)
+	require.Error(t, err)
+	_, err = querier.CrossExchangeRate(ctx, &types.QueryCrossExchangeRateRequest{Base: fooDenom1})
+	require.Error(t, err)
+
+	// Query to grpc
+	res, err := querier.CrossExchangeRate(ctx, &types.QueryCrossExchangeRateRequest{
+		Base:  fooDenom1,
+		Quote: fooDenom2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(850), res.ExchangeRate)
+
+	input.OracleKeeper.SetTargetQuote(input.Ctx, fooDenom1, fooDenom2)
+	input.OracleKeeper.UpdateQuoteExchangeRates(input.Ctx)
+	resAll, err := querier.QuoteExchangeRates(ctx, &types.QueryQuoteExchangeRatesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.QuoteExchangeRate{{Denom: fooDenom1, Quote: fooDenom2, ExchangeRate: sdk.NewDec(850)}}, resAll.QuoteExchangeRates)
+}
+
 func TestQueryMissCounter(t *testing.T) {
 	input := CreateTestInput(t)
 	ctx := sdk.WrapSDKContext(input.Ctx)
@@ -66,6 +95,70 @@ func TestQueryMissCounter(t *testing.T) {
 	require.Equal(t, missCounter, res.MissCounter)
 }
 
+func TestQueryValidatorPerformance(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	for _, window := range []uint64{2, 1} {
+		performance := types.NewValidatorPerformance(ValAddrs[0], window)
+		performance.Votes = window
+		input.OracleKeeper.SetValidatorPerformance(input.Ctx, ValAddrs[0], performance)
+	}
+	input.OracleKeeper.SetValidatorPerformance(input.Ctx, ValAddrs[1], types.NewValidatorPerformance(ValAddrs[1], 1))
+
+	// empty request
+	_, err := querier.ValidatorPerformance(ctx, nil)
+	require.Error(t, err)
+
+	// Query to grpc
+	res, err := querier.ValidatorPerformance(ctx, &types.QueryValidatorPerformanceRequest{
+		ValidatorAddr: ValAddrs[0].String(),
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Performances, 2)
+	require.Equal(t, uint64(1), res.Performances[0].Window)
+	require.Equal(t, uint64(1), res.Performances[0].Votes)
+	require.Equal(t, uint64(2), res.Performances[1].Window)
+	require.Equal(t, uint64(2), res.Performances[1].Votes)
+}
+
+func TestQueryValidatorPenalty(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	penalty := types.ValidatorPenalty{
+		ValidatorAddress:      ValAddrs[0].String(),
+		Tier:                  types.PENALTY_TIER_SLASH,
+		ConsecutiveBadWindows: 2,
+		LastBadWindow:         5,
+	}
+	input.OracleKeeper.SetValidatorPenalty(input.Ctx, ValAddrs[0], penalty)
+
+	// empty request
+	_, err := querier.ValidatorPenalty(ctx, nil)
+	require.Error(t, err)
+
+	// Query to grpc
+	res, err := querier.ValidatorPenalty(ctx, &types.QueryValidatorPenaltyRequest{
+		ValidatorAddr: ValAddrs[0].String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, penalty, res.Penalty)
+
+	// no penalty
+	res, err = querier.ValidatorPenalty(ctx, &types.QueryValidatorPenaltyRequest{
+		ValidatorAddr: ValAddrs[1].String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.PENALTY_TIER_NONE, res.Penalty.Tier)
+
+	resAll, err := querier.ValidatorPenalties(ctx, &types.QueryValidatorPenaltiesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.ValidatorPenalty{penalty}, resAll.Penalties)
+}
+
 func TestQueryExchangeRates(t *testing.T) {
 	input := CreateTestInput(t)
 	ctx := sdk.WrapSDKContext(input.Ctx)
@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/elysiumstation/blackfury/x/oracle/types"
+)
+
+// IsBound checks if the oracle module is already bound to the desired port
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// BindPort defines a wrapper function for the port Keeper's function in
+// order to expose it to module's InitGenesis function
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	capability := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, capability, host.PortPath(portID))
+}
+
+// AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
+func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
+	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
+}
+
+// ClaimCapability allows the oracle module to claim a capability that the IBC module
+// passes to it
+func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
+}
+
+// ValidateInterchainOracleClient checks that the given connection hops lead to
+// the client of the configured interchain oracle.
+func (k Keeper) ValidateInterchainOracleClient(ctx sdk.Context, connectionHops []string) error {
+	clientID := k.InterchainOracleClientID(ctx)
+	if clientID == "" {
+		return sdkerrors.Wrap(types.ErrInvalidChannel, "interchain oracle client is not configured")
+	}
+
+	if len(connectionHops) != 1 {
+		return sdkerrors.Wrapf(types.ErrInvalidChannel, "expected 1 connection hop, got %d", len(connectionHops))
+	}
+
+	connection, found := k.connectionKeeper.GetConnection(ctx, connectionHops[0])
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidChannel, "connection %s not found", connectionHops[0])
+	}
+	if connection.GetClientID() != clientID {
+		return sdkerrors.Wrapf(types.ErrInvalidChannel, "expected client %s, got %s", clientID, connection.GetClientID())
+	}
+
+	return nil
+}
+
+// ValidateInterchainOracleChannel checks that the given channel is the configured
+// interchain oracle channel and that it is built on the configured client.
+func (k Keeper) ValidateInterchainOracleChannel(ctx sdk.Context, portID, channelID string) error {
+	if portID != types.PortID {
+		return sdkerrors.Wrapf(types.ErrInvalidChannel, "expected port %s, got %s", types.PortID, portID)
+	}
+
+	expectedChannelID := k.InterchainOracleChannelID(ctx)
+	if expectedChannelID == "" {
+		return sdkerrors.Wrap(types.ErrInvalidChannel, "interchain oracle channel is not configured")
+	}
+	if channelID != expectedChannelID {
+		return sdkerrors.Wrapf(types.ErrInvalidChannel, "expected channel %s, got %s", expectedChannelID, channelID)
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidChannel, "channel %s not found", channelID)
+	}
+
+	return k.ValidateInterchainOracleClient(ctx, channel.ConnectionHops)
+}
+
+// OnRecvInterchainOraclePacket validates the price packet received from the
+// interchain oracle and stores its exchange rates.
+func (k Keeper) OnRecvInterchainOraclePacket(ctx sdk.Context, packet channeltypes.Packet, data types.InterchainOraclePacketData) error {
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := k.ValidateInterchainOracleChannel(ctx, packet.GetDestPort(), packet.GetDestChannel()); err != nil {
+		return err
+	}
+
+	return k.SetInterchainExchangeRates(ctx, data.ExchangeRates, data.Timestamp)
+}
+
+// SetInterchainExchangeRates stores the exchange rates observed by the interchain
+// oracle at the given time, after checking their freshness.
+func (k Keeper) SetInterchainExchangeRates(ctx sdk.Context, exchangeRates types.ExchangeRateTuples, timestamp time.Time) error {
+	if timestamp.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrInvalidPacket, "timestamp %s is in the future", timestamp)
+	}
+	if k.isInterchainExchangeRateStale(ctx, timestamp) {
+		return sdkerrors.Wrapf(types.ErrStaleExchangeRate, "timestamp %s is older than %s", timestamp, k.InterchainMaxPriceAge(ctx))
+	}
+
+	for _, tuple := range exchangeRates {
+		if !k.IsInterchainTarget(ctx, tuple.Denom) {
+			return sdkerrors.Wrapf(types.ErrUnknownDenom, "denom '%s' is not an interchain target", tuple.Denom)
+		}
+
+		if existing, found := k.GetInterchainExchangeRate(ctx, tuple.Denom); found && !timestamp.After(existing.Timestamp) {
+			return sdkerrors.Wrapf(types.ErrStaleExchangeRate, "denom '%s' has a newer exchange rate at %s", tuple.Denom, existing.Timestamp)
+		}
+	}
+
+	for _, tuple := range exchangeRates {
+		k.SetInterchainExchangeRate(ctx, types.InterchainExchangeRate{
+			Denom:        tuple.Denom,
+			ExchangeRate: tuple.ExchangeRate,
+			Timestamp:    timestamp,
+		})
+		k.SetExchangeRateWithEvent(ctx, tuple.Denom, tuple.ExchangeRate)
+	}
+
+	return nil
+}
+
+// RestoreInterchainExchangeRates sets the fresh interchain exchange rates as the
+// consensus exchange rates, and deletes the stale ones.
+func (k Keeper) RestoreInterchainExchangeRates(ctx sdk.Context) {
+	var staleDenoms []string
+	k.IterateInterchainExchangeRates(ctx, func(rate types.InterchainExchangeRate) (stop bool) {
+		if k.isInterchainExchangeRateStale(ctx, rate.Timestamp) {
+			staleDenoms = append(staleDenoms, rate.Denom)
+		} else {
+			k.SetExchangeRate(ctx, rate.Denom, rate.ExchangeRate)
+		}
+		return false
+	})
+
+	for _, denom := range staleDenoms {
+		k.DeleteInterchainExchangeRate(ctx, denom)
+		k.DeleteExchangeRate(ctx, denom)
+	}
+}
+
+func (k Keeper) isInterchainExchangeRateStale(ctx sdk.Context, timestamp time.Time) bool {
+	return ctx.BlockTime().Sub(timestamp) > k.InterchainMaxPriceAge(ctx)
+}
+
+// IsInterchainTarget returns existence of a denom in the interchain oracle target list.
+func (k Keeper) IsInterchainTarget(ctx sdk.Context, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetInterchainTargetKey(denom))
+}
+
+// SetInterchainTarget sets interchain oracle target for the denom.
+func (k Keeper) SetInterchainTarget(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetInterchainTargetKey(denom), []byte(denom))
+}
+
+// IterateInterchainTargets iterates over the interchain oracle targets in the store.
+func (k Keeper) IterateInterchainTargets(ctx sdk.Context, handler func(denom string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.InterchainTargetKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if handler(string(iter.Value())) {
+			break
+		}
+	}
+}
+
+// GetInterchainExchangeRate gets the exchange rate of denom received from the interchain oracle.
+func (k Keeper) GetInterchainExchangeRate(ctx sdk.Context, denom string) (rate types.InterchainExchangeRate, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetInterchainExchangeRateKey(denom))
+	if bz == nil {
+		return rate, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &rate)
+	return rate, true
+}
+
+// SetInterchainExchangeRate sets the exchange rate of denom received from the interchain oracle.
+func (k Keeper) SetInterchainExchangeRate(ctx sdk.Context, rate types.InterchainExchangeRate) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&rate)
+	store.Set(types.GetInterchainExchangeRateKey(rate.Denom), bz)
+}
+
+// DeleteInterchainExchangeRate deletes the exchange rate of denom received from the interchain oracle.
+func (k Keeper) DeleteInterchainExchangeRate(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetInterchainExchangeRateKey(denom))
+}
+
+// IterateInterchainExchangeRates iterates over the exchange rates received from the interchain oracle.
+func (k Keeper) IterateInterchainExchangeRates(ctx sdk.Context, handler func(rate types.InterchainExchangeRate) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.InterchainExchangeRateKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var rate types.InterchainExchangeRate
+		k.cdc.MustUnmarshal(iter.Value(), &rate)
+
+		if handler(rate) {
+			break
+		}
+	}
+}
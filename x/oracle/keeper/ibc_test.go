@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elysiumstation/blackfury/x/oracle/types"
+)
+
+func TestSetInterchainExchangeRates(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := input.Ctx
+	k := input.OracleKeeper
+
+	k.SetTarget(ctx, fooDenom1)
+	k.SetInterchainTarget(ctx, fooDenom1)
+
+	now := ctx.BlockTime()
+	maxPriceAge := k.InterchainMaxPriceAge(ctx)
+	rates := types.ExchangeRateTuples{{Denom: fooDenom1, ExchangeRate: sdk.NewDec(2)}}
+
+	// Not an interchain target
+	err := k.SetInterchainExchangeRates(ctx, types.ExchangeRateTuples{{Denom: fooDenom2, ExchangeRate: sdk.OneDec()}}, now)
+	require.ErrorIs(t, err, types.ErrUnknownDenom)
+
+	// Timestamp in the future
+	err = k.SetInterchainExchangeRates(ctx, rates, now.Add(time.Second))
+	require.ErrorIs(t, err, types.ErrInvalidPacket)
+
+	// Timestamp older than the max price age
+	err = k.SetInterchainExchangeRates(ctx, rates, now.Add(-maxPriceAge-time.Second))
+	require.ErrorIs(t, err, types.ErrStaleExchangeRate)
+
+	err = k.SetInterchainExchangeRates(ctx, rates, now.Add(-time.Second))
+	require.NoError(t, err)
+	rate, err := k.GetExchangeRate(ctx, fooDenom1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(2), rate)
+
+	// Not newer than the stored exchange rate
+	err = k.SetInterchainExchangeRates(ctx, types.ExchangeRateTuples{{Denom: fooDenom1, ExchangeRate: sdk.NewDec(3)}}, now.Add(-time.Second))
+	require.ErrorIs(t, err, types.ErrStaleExchangeRate)
+	rate, err = k.GetExchangeRate(ctx, fooDenom1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(2), rate)
+}
+
+func TestRestoreInterchainExchangeRates(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := input.Ctx
+	k := input.OracleKeeper
+
+	now := ctx.BlockTime()
+	maxPriceAge := k.InterchainMaxPriceAge(ctx)
+	k.SetInterchainExchangeRate(ctx, types.InterchainExchangeRate{Denom: fooDenom1, ExchangeRate: sdk.NewDec(2), Timestamp: now})
+	k.SetInterchainExchangeRate(ctx, types.InterchainExchangeRate{Denom: fooDenom2, ExchangeRate: sdk.NewDec(3), Timestamp: now.Add(-maxPriceAge - time.Second)})
+
+	k.RestoreInterchainExchangeRates(ctx)
+
+	rate, err := k.GetExchangeRate(ctx, fooDenom1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(2), rate)
+
+	_, err = k.GetExchangeRate(ctx, fooDenom2)
+	require.Error(t, err)
+	_, found := k.GetInterchainExchangeRate(ctx, fooDenom2)
+	require.False(t, found)
+}
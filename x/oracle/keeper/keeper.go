@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	blackfury "github.com/elysiumstation/blackfury/types"
 	"github.com/elysiumstation/blackfury/x/oracle/types"
 )
 
@@ -21,10 +22,16 @@ type (
 		storeKey   sdk.StoreKey
 		paramstore paramtypes.Subspace
 
-		accountKeeper types.AccountKeeper
-		bankKeeper    types.BankKeeper
-		distrKeeper   types.DistrKeeper
-		stakingKeeper types.StakingKeeper
+		accountKeeper  types.AccountKeeper
+		bankKeeper     types.BankKeeper
+		distrKeeper    types.DistrKeeper
+		stakingKeeper  types.StakingKeeper
+		slashingKeeper types.SlashingKeeper
+
+		channelKeeper    types.ChannelKeeper
+		connectionKeeper types.ConnectionKeeper
+		portKeeper       types.PortKeeper
+		scopedKeeper     types.ScopedKeeper
 
 		distrName string
 	}
@@ -38,6 +45,11 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	distrKeeper types.DistrKeeper,
 	stakingKeeper types.StakingKeeper,
+	slashingKeeper types.SlashingKeeper,
+	channelKeeper types.ChannelKeeper,
+	connectionKeeper types.ConnectionKeeper,
+	portKeeper types.PortKeeper,
+	scopedKeeper types.ScopedKeeper,
 	distrName string,
 ) *Keeper {
 	// Set KeyTable if it has not already been set
@@ -51,14 +63,21 @@ func NewKeeper(
 	}
 
 	return &Keeper{
-		cdc:           cdc,
-		storeKey:      storeKey,
-		paramstore:    ps,
-		accountKeeper: accountKeeper,
-		bankKeeper:    bankKeeper,
-		distrKeeper:   distrKeeper,
-		stakingKeeper: stakingKeeper,
-		distrName:     distrName,
+		cdc:            cdc,
+		storeKey:       storeKey,
+		paramstore:     ps,
+		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
+		distrKeeper:    distrKeeper,
+		stakingKeeper:  stakingKeeper,
+		slashingKeeper: slashingKeeper,
+
+		channelKeeper:    channelKeeper,
+		connectionKeeper: connectionKeeper,
+		portKeeper:       portKeeper,
+		scopedKeeper:     scopedKeeper,
+
+		distrName: distrName,
 	}
 }
 
@@ -141,6 +160,111 @@ func (k Keeper) IterateExchangeRates(ctx sdk.Context, handler func(denom string,
 	}
 }
 
+// GetCrossExchangeRate gets the exchange rate of base denominated in quote,
+// which is derived through the exchange rates denominated in the reference denom uUSD.
+func (k Keeper) GetCrossExchangeRate(ctx sdk.Context, base string, quote string) (sdk.Dec, error) {
+	baseRate, err := k.getReferenceExchangeRate(ctx, base)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+	quoteRate, err := k.getReferenceExchangeRate(ctx, quote)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+	if !quoteRate.IsPositive() {
+		return sdk.ZeroDec(), sdkerrors.Wrapf(types.ErrInvalidExchangeRate, "exchange rate of %s: %s", quote, quoteRate)
+	}
+	return baseRate.Quo(quoteRate), nil
+}
+
+// getReferenceExchangeRate gets the exchange rate of denom denominated in uUSD,
+// which is one for uUSD itself.
+func (k Keeper) getReferenceExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	if denom == blackfury.MicroUSDDenom {
+		return sdk.OneDec(), nil
+	}
+	return k.GetExchangeRate(ctx, denom)
+}
+
+// -----------------------------------
+// QuoteExchangeRate logic
+
+// GetQuoteExchangeRate gets the exchange rate of denom denominated in its quote currency from the store.
+func (k Keeper) GetQuoteExchangeRate(ctx sdk.Context, denom string) (types.QuoteExchangeRate, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetQuoteExchangeRateKey(denom))
+	if bz == nil {
+		return types.QuoteExchangeRate{}, sdkerrors.Wrap(types.ErrUnknownDenom, denom)
+	}
+
+	var rate types.QuoteExchangeRate
+	k.cdc.MustUnmarshal(bz, &rate)
+	return rate, nil
+}
+
+// SetQuoteExchangeRateWithEvent sets the exchange rate of denom denominated in its
+// quote currency to the store with ABCI event
+func (k Keeper) SetQuoteExchangeRateWithEvent(ctx sdk.Context, rate types.QuoteExchangeRate) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&rate)
+	store.Set(types.GetQuoteExchangeRateKey(rate.Denom), bz)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeQuoteExchangeRateUpdate,
+			sdk.NewAttribute(types.AttributeKeyDenom, rate.Denom),
+			sdk.NewAttribute(types.AttributeKeyQuote, rate.Quote),
+			sdk.NewAttribute(types.AttributeKeyExchangeRate, rate.ExchangeRate.String()),
+		),
+	)
+}
+
+// DeleteQuoteExchangeRate deletes the exchange rate of denom denominated in its quote currency from the store.
+func (k Keeper) DeleteQuoteExchangeRate(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetQuoteExchangeRateKey(denom))
+}
+
+// IterateQuoteExchangeRates iterates over quote exchange rates in the store.
+func (k Keeper) IterateQuoteExchangeRates(ctx sdk.Context, handler func(rate types.QuoteExchangeRate) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.QuoteExchangeRateKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var rate types.QuoteExchangeRate
+		k.cdc.MustUnmarshal(iter.Value(), &rate)
+		if handler(rate) {
+			break
+		}
+	}
+}
+
+// UpdateQuoteExchangeRates derives the exchange rates of all targets denominated in their
+// non-reference quote currencies from the current exchange rates denominated in uUSD.
+// The exchange rate of a target is dropped if it or its quote has no exchange rate.
+func (k Keeper) UpdateQuoteExchangeRates(ctx sdk.Context) {
+	var stale []string
+	k.IterateQuoteExchangeRates(ctx, func(rate types.QuoteExchangeRate) (stop bool) {
+		stale = append(stale, rate.Denom)
+		return false
+	})
+	for _, denom := range stale {
+		k.DeleteQuoteExchangeRate(ctx, denom)
+	}
+
+	k.IterateTargetQuotes(ctx, func(denom string, quote string) (stop bool) {
+		exchangeRate, err := k.GetCrossExchangeRate(ctx, denom, quote)
+		if err != nil {
+			return false
+		}
+		k.SetQuoteExchangeRateWithEvent(ctx, types.QuoteExchangeRate{
+			Denom:        denom,
+			Quote:        quote,
+			ExchangeRate: exchangeRate,
+		})
+		return false
+	})
+}
+
 // -----------------------------------
 // Oracle delegation logic
 
@@ -228,6 +352,120 @@ func (k Keeper) IterateMissCounters(ctx sdk.Context,
 	}
 }
 
+// -----------------------------------
+// ValidatorPerformance logic
+
+// GetValidatorPerformance retrieves the oracle voting statistics of the validator in the slash window.
+func (k Keeper) GetValidatorPerformance(ctx sdk.Context, operator sdk.ValAddress, window uint64) (performance types.ValidatorPerformance, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorPerformanceKey(operator, window))
+	if bz == nil {
+		return types.NewValidatorPerformance(operator, window), false
+	}
+
+	k.cdc.MustUnmarshal(bz, &performance)
+	return performance, true
+}
+
+// SetValidatorPerformance updates the oracle voting statistics of the validator in the slash window.
+func (k Keeper) SetValidatorPerformance(ctx sdk.Context, operator sdk.ValAddress, performance types.ValidatorPerformance) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&performance)
+	store.Set(types.GetValidatorPerformanceKey(operator, performance.Window), bz)
+}
+
+// DeleteValidatorPerformance removes the oracle voting statistics of the validator in the slash window.
+func (k Keeper) DeleteValidatorPerformance(ctx sdk.Context, operator sdk.ValAddress, window uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorPerformanceKey(operator, window))
+}
+
+// IterateValidatorPerformances iterates over the validator performances of all validators
+// and performs a callback function.
+func (k Keeper) IterateValidatorPerformances(ctx sdk.Context,
+	handler func(operator sdk.ValAddress, performance types.ValidatorPerformance) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorPerformanceKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		operator := sdk.ValAddress(iter.Key()[2 : len(iter.Key())-8])
+
+		var performance types.ValidatorPerformance
+		k.cdc.MustUnmarshal(iter.Value(), &performance)
+
+		if handler(operator, performance) {
+			break
+		}
+	}
+}
+
+// IterateValidatorPerformancesByValidator iterates over the validator performances of the validator
+// in the ascending order of slash windows and performs a callback function.
+func (k Keeper) IterateValidatorPerformancesByValidator(ctx sdk.Context, operator sdk.ValAddress,
+	handler func(performance types.ValidatorPerformance) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetValidatorPerformancePrefix(operator))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var performance types.ValidatorPerformance
+		k.cdc.MustUnmarshal(iter.Value(), &performance)
+
+		if handler(performance) {
+			break
+		}
+	}
+}
+
+// -----------------------------------
+// ValidatorPenalty logic
+
+// GetValidatorPenalty retrieves the oracle penalty tier of the validator.
+func (k Keeper) GetValidatorPenalty(ctx sdk.Context, operator sdk.ValAddress) (penalty types.ValidatorPenalty, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorPenaltyKey(operator))
+	if bz == nil {
+		// By default, no penalty
+		return types.ValidatorPenalty{ValidatorAddress: operator.String()}, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &penalty)
+	return penalty, true
+}
+
+// SetValidatorPenalty updates the oracle penalty tier of the validator.
+func (k Keeper) SetValidatorPenalty(ctx sdk.Context, operator sdk.ValAddress, penalty types.ValidatorPenalty) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&penalty)
+	store.Set(types.GetValidatorPenaltyKey(operator), bz)
+}
+
+// DeleteValidatorPenalty removes the oracle penalty tier of the validator.
+func (k Keeper) DeleteValidatorPenalty(ctx sdk.Context, operator sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorPenaltyKey(operator))
+}
+
+// IterateValidatorPenalties iterates over the validator penalties and performs a callback function.
+func (k Keeper) IterateValidatorPenalties(ctx sdk.Context,
+	handler func(operator sdk.ValAddress, penalty types.ValidatorPenalty) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorPenaltyKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		operator := sdk.ValAddress(iter.Key()[2:])
+
+		var penalty types.ValidatorPenalty
+		k.cdc.MustUnmarshal(iter.Value(), &penalty)
+
+		if handler(operator, penalty) {
+			break
+		}
+	}
+}
+
 // -----------------------------------
 // AggregateExchangeRatePrevote logic
 
@@ -407,6 +645,41 @@ func (k Keeper) GetTargets(ctx sdk.Context) (targets []string) {
 	return targets
 }
 
+// GetTargetQuote returns the quote currency of the target denom,
+// which is the reference denom uUSD by default.
+func (k Keeper) GetTargetQuote(ctx sdk.Context, denom string) string {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTargetQuoteKey(denom))
+	if bz == nil {
+		return blackfury.MicroUSDDenom
+	}
+	return string(bz)
+}
+
+// SetTargetQuote sets the quote currency of the target denom.
+func (k Keeper) SetTargetQuote(ctx sdk.Context, denom string, quote string) {
+	store := ctx.KVStore(k.storeKey)
+	if quote == "" || quote == blackfury.MicroUSDDenom {
+		store.Delete(types.GetTargetQuoteKey(denom))
+		return
+	}
+	store.Set(types.GetTargetQuoteKey(denom), []byte(quote))
+}
+
+// IterateTargetQuotes iterates over the targets with non-reference quote currencies in the store.
+func (k Keeper) IterateTargetQuotes(ctx sdk.Context, handler func(denom string, quote string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TargetQuoteKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		denom := string(iter.Key()[len(types.TargetQuoteKey):])
+
+		if handler(denom, string(iter.Value())) {
+			break
+		}
+	}
+}
+
 // ValidateFeeder return the given feeder is allowed to feed the message or not.
 func (k Keeper) ValidateFeeder(ctx sdk.Context, feederAddr sdk.AccAddress, validatorAddr sdk.ValAddress) error {
 	if !feederAddr.Equals(validatorAddr) {
@@ -3,11 +3,13 @@ package keeper
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	blackfury "github.com/elysiumstation/blackfury/types"
 	"github.com/elysiumstation/blackfury/x/oracle/types"
 	"github.com/stretchr/testify/require"
 )
@@ -60,6 +62,65 @@ func TestExchangeRate(t *testing.T) {
 	require.True(t, numExchangeRates == 2)
 }
 
+func TestCrossExchangeRate(t *testing.T) {
+	input := CreateTestInput(t)
+
+	input.OracleKeeper.SetExchangeRate(input.Ctx, fooDenom1, sdk.NewDec(3))
+	input.OracleKeeper.SetExchangeRate(input.Ctx, fooDenom2, sdk.NewDecWithPrec(12, 1))
+	input.OracleKeeper.SetExchangeRate(input.Ctx, fooDenom3, sdk.ZeroDec())
+
+	rate, err := input.OracleKeeper.GetCrossExchangeRate(input.Ctx, fooDenom1, fooDenom2)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(25, 1), rate)
+
+	// reference denom
+	rate, err = input.OracleKeeper.GetCrossExchangeRate(input.Ctx, fooDenom1, blackfury.MicroUSDDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(3), rate)
+	rate, err = input.OracleKeeper.GetCrossExchangeRate(input.Ctx, blackfury.MicroUSDDenom, fooDenom2)
+	require.NoError(t, err)
+	require.Equal(t, sdk.OneDec().Quo(sdk.NewDecWithPrec(12, 1)), rate)
+
+	// unknown or zero quote
+	_, err = input.OracleKeeper.GetCrossExchangeRate(input.Ctx, fooDenom1, fooDenom4)
+	require.Error(t, err)
+	_, err = input.OracleKeeper.GetCrossExchangeRate(input.Ctx, fooDenom4, fooDenom1)
+	require.Error(t, err)
+	_, err = input.OracleKeeper.GetCrossExchangeRate(input.Ctx, fooDenom1, fooDenom3)
+	require.Error(t, err)
+}
+
+func TestUpdateQuoteExchangeRates(t *testing.T) {
+	input := CreateTestInput(t)
+
+	input.OracleKeeper.SetTargetQuote(input.Ctx, fooDenom1, fooDenom2)
+	input.OracleKeeper.SetTargetQuote(input.Ctx, fooDenom3, fooDenom2)
+	input.OracleKeeper.SetTargetQuote(input.Ctx, fooDenom4, blackfury.MicroUSDDenom)
+	require.Equal(t, fooDenom2, input.OracleKeeper.GetTargetQuote(input.Ctx, fooDenom1))
+	require.Equal(t, blackfury.MicroUSDDenom, input.OracleKeeper.GetTargetQuote(input.Ctx, fooDenom4))
+	require.Equal(t, blackfury.MicroUSDDenom, input.OracleKeeper.GetTargetQuote(input.Ctx, fooDenom5))
+
+	input.OracleKeeper.SetExchangeRate(input.Ctx, fooDenom1, sdk.NewDec(3))
+	input.OracleKeeper.SetExchangeRate(input.Ctx, fooDenom2, sdk.NewDecWithPrec(12, 1))
+	input.OracleKeeper.SetExchangeRate(input.Ctx, fooDenom4, sdk.NewDec(5))
+
+	// fooDenom3 has no exchange rate
+	input.OracleKeeper.UpdateQuoteExchangeRates(input.Ctx)
+	rate, err := input.OracleKeeper.GetQuoteExchangeRate(input.Ctx, fooDenom1)
+	require.NoError(t, err)
+	require.Equal(t, types.QuoteExchangeRate{Denom: fooDenom1, Quote: fooDenom2, ExchangeRate: sdk.NewDecWithPrec(25, 1)}, rate)
+	_, err = input.OracleKeeper.GetQuoteExchangeRate(input.Ctx, fooDenom3)
+	require.Error(t, err)
+	_, err = input.OracleKeeper.GetQuoteExchangeRate(input.Ctx, fooDenom4)
+	require.Error(t, err)
+
+	// stale exchange rate is dropped
+	input.OracleKeeper.DeleteExchangeRate(input.Ctx, fooDenom2)
+	input.OracleKeeper.UpdateQuoteExchangeRates(input.Ctx)
+	_, err = input.OracleKeeper.GetQuoteExchangeRate(input.Ctx, fooDenom1)
+	require.Error(t, err)
+}
+
 func TestIterateExchangeRates(t *testing.T) {
 	input := CreateTestInput(t)
 
@@ -120,16 +181,32 @@ func TestParams(t *testing.T) {
 	slashFraction := sdk.NewDecWithPrec(1, 2)
 	slashWindow := uint64(1000)
 	minValidPerWindow := sdk.NewDecWithPrec(1, 4)
+	performanceWindows := uint64(2)
+	warningWindows := uint64(2)
+	minorSlashWindows := uint64(3)
+	minorSlashFraction := sdk.NewDecWithPrec(1, 3)
+	jailDuration := time.Hour
+	interchainOracleClientID := "07-tendermint-0"
+	interchainOracleChannelID := "channel-0"
+	interchainMaxPriceAge := time.Minute
 
 	// Should really test validateParams, but skipping because obvious
 	newParams := types.Params{
-		VotePeriod:               votePeriod,
-		VoteThreshold:            voteThreshold,
-		RewardBand:               oracleRewardBand,
-		RewardDistributionWindow: rewardDistributionWindow,
-		SlashFraction:            slashFraction,
-		SlashWindow:              slashWindow,
-		MinValidPerWindow:        minValidPerWindow,
+		VotePeriod:                votePeriod,
+		VoteThreshold:             voteThreshold,
+		RewardBand:                oracleRewardBand,
+		RewardDistributionWindow:  rewardDistributionWindow,
+		SlashFraction:             slashFraction,
+		SlashWindow:               slashWindow,
+		MinValidPerWindow:         minValidPerWindow,
+		PerformanceWindows:        performanceWindows,
+		WarningWindows:            warningWindows,
+		MinorSlashWindows:         minorSlashWindows,
+		MinorSlashFraction:        minorSlashFraction,
+		JailDuration:              jailDuration,
+		InterchainOracleClientId:  interchainOracleClientID,
+		InterchainOracleChannelId: interchainOracleChannelID,
+		InterchainMaxPriceAge:     interchainMaxPriceAge,
 	}
 	input.OracleKeeper.SetParams(input.Ctx, newParams)
 
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/elysiumstation/blackfury/x/oracle/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate2to3(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// params added since version 2 do not exist before the migration
+	store := prefix.NewStore(input.Ctx.KVStore(input.ParamsKey), []byte(types.ModuleName+"/"))
+	for _, key := range [][]byte{
+		types.KeyPerformanceWindows,
+		types.KeyWarningWindows,
+		types.KeyMinorSlashWindows,
+		types.KeyMinorSlashFraction,
+		types.KeyJailDuration,
+		types.KeyInterchainOracleClientID,
+		types.KeyInterchainOracleChannelID,
+		types.KeyInterchainMaxPriceAge,
+	} {
+		store.Delete(key)
+	}
+	require.Panics(t, func() { input.OracleKeeper.JailDuration(input.Ctx) })
+	require.Panics(t, func() { input.OracleKeeper.InterchainMaxPriceAge(input.Ctx) })
+	// the interchain oracle port is only bound at genesis
+	require.False(t, input.OracleKeeper.IsBound(input.Ctx, types.PortID))
+
+	require.NoError(t, NewMigrator(input.OracleKeeper).Migrate2to3(input.Ctx))
+	require.Equal(t, types.DefaultParams(), input.OracleKeeper.GetParams(input.Ctx))
+	require.True(t, input.OracleKeeper.IsBound(input.Ctx, types.PortID))
+
+	// binding is skipped if the port is already bound
+	require.NoError(t, NewMigrator(input.OracleKeeper).Migrate2to3(input.Ctx))
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/elysiumstation/blackfury/x/oracle/types"
 )
@@ -57,3 +59,52 @@ func (k Keeper) MinValidPerWindow(ctx sdk.Context) (res sdk.Dec) {
 	k.paramstore.Get(ctx, types.KeyMinValidPerWindow, &res)
 	return
 }
+
+// PerformanceWindows returns # of slash windows for which validator performances are kept.
+func (k Keeper) PerformanceWindows(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyPerformanceWindows, &res)
+	return
+}
+
+// WarningWindows returns # of consecutive bad slash windows in which a validator is only warned.
+func (k Keeper) WarningWindows(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyWarningWindows, &res)
+	return
+}
+
+// MinorSlashWindows returns # of consecutive bad slash windows after the warning ones
+// in which a validator is slashed by MinorSlashFraction without jailing.
+func (k Keeper) MinorSlashWindows(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMinorSlashWindows, &res)
+	return
+}
+
+// MinorSlashFraction returns oracle voting penalty rate of the minor slash tier.
+func (k Keeper) MinorSlashFraction(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMinorSlashFraction, &res)
+	return
+}
+
+// JailDuration returns the duration for which a validator is jailed in the slash and jail tier.
+func (k Keeper) JailDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyJailDuration, &res)
+	return
+}
+
+// InterchainOracleClientID returns the IBC light client of the counterparty oracle chain.
+func (k Keeper) InterchainOracleClientID(ctx sdk.Context) (res string) {
+	k.paramstore.Get(ctx, types.KeyInterchainOracleClientID, &res)
+	return
+}
+
+// InterchainOracleChannelID returns the IBC channel subscribing to the counterparty oracle chain.
+func (k Keeper) InterchainOracleChannelID(ctx sdk.Context) (res string) {
+	k.paramstore.Get(ctx, types.KeyInterchainOracleChannelID, &res)
+	return
+}
+
+// InterchainMaxPriceAge returns the max age of the exchange rates from the interchain oracle.
+func (k Keeper) InterchainMaxPriceAge(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyInterchainMaxPriceAge, &res)
+	return
+}
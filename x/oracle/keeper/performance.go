@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/elysiumstation/blackfury/x/oracle/types"
+)
+
+// RecordValidatorPerformances accumulates the oracle voting statistics of the active validators
+// in the current slash window. It must be called after tallying and before clearing the ballots.
+func (k Keeper) RecordValidatorPerformances(ctx sdk.Context, validatorClaimMap map[string]types.Claim, voteTargetsLen int) {
+	window := uint64(ctx.BlockHeight()) / k.SlashWindow(ctx)
+
+	// voter -> whether abstaining from all denoms
+	abstains := make(map[string]bool)
+	k.IterateAggregateExchangeRateVotes(ctx, func(_ sdk.ValAddress, vote types.AggregateExchangeRateVote) (stop bool) {
+		abstain := true
+		for _, tuple := range vote.ExchangeRateTuples {
+			if tuple.ExchangeRate.IsPositive() {
+				abstain = false
+				break
+			}
+		}
+		abstains[vote.Voter] = abstain
+		return false
+	})
+
+	for key, claim := range validatorClaimMap {
+		performance, _ := k.GetValidatorPerformance(ctx, claim.Recipient, window)
+		performance.VotePeriods++
+		if abstain, ok := abstains[key]; ok {
+			performance.Votes++
+			if abstain {
+				performance.Abstains++
+			}
+		}
+		performance.WinCount += uint64(claim.WinCount)
+		// Same as miss counting
+		if int(claim.WinCount) != voteTargetsLen {
+			performance.Misses++
+		}
+		if claim.DeviationCount > 0 {
+			performance.AddDeviations(claim.DeviationSum, uint64(claim.DeviationCount))
+		}
+
+		k.SetValidatorPerformance(ctx, claim.Recipient, performance)
+		setPerformanceGauges(performance)
+	}
+}
+
+// PruneValidatorPerformances removes the validator performances of the slash windows
+// older than the last PerformanceWindows windows, including the current one.
+func (k Keeper) PruneValidatorPerformances(ctx sdk.Context) {
+	window := uint64(ctx.BlockHeight()) / k.SlashWindow(ctx)
+	keep := k.PerformanceWindows(ctx)
+	if window+1 <= keep {
+		return
+	}
+	oldest := window + 1 - keep
+
+	var pruned []types.ValidatorPerformance
+	k.IterateValidatorPerformances(ctx, func(_ sdk.ValAddress, performance types.ValidatorPerformance) (stop bool) {
+		if performance.Window < oldest {
+			pruned = append(pruned, performance)
+		}
+		return false
+	})
+	for _, performance := range pruned {
+		operator, err := sdk.ValAddressFromBech32(performance.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.DeleteValidatorPerformance(ctx, operator, performance.Window)
+	}
+}
+
+// setPerformanceGauges sets the telemetry gauges of the validator performance in the current slash window
+func setPerformanceGauges(performance types.ValidatorPerformance) {
+	labels := []metrics.Label{telemetry.NewLabel("validator", performance.ValidatorAddress)}
+	meanDeviation, _ := performance.MeanDeviation.Float64()
+
+	for name, value := range map[string]float32{
+		"vote_periods":   float32(performance.VotePeriods),
+		"votes":          float32(performance.Votes),
+		"abstains":       float32(performance.Abstains),
+		"win_count":      float32(performance.WinCount),
+		"misses":         float32(performance.Misses),
+		"mean_deviation": float32(meanDeviation),
+	} {
+		telemetry.SetGaugeWithLabels([]string{types.ModuleName, "performance", name}, value, labels)
+	}
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elysiumstation/blackfury/x/oracle/types"
+)
+
+func TestValidatorPerformance(t *testing.T) {
+	input := CreateTestInput(t)
+
+	performance, found := input.OracleKeeper.GetValidatorPerformance(input.Ctx, ValAddrs[0], 1)
+	require.False(t, found)
+	require.Equal(t, types.NewValidatorPerformance(ValAddrs[0], 1), performance)
+
+	performance.Votes = 3
+	performance.AddDeviations(sdk.NewDecWithPrec(3, 2), 2)
+	performance.AddDeviations(sdk.NewDecWithPrec(6, 2), 1)
+	require.Equal(t, uint64(3), performance.DeviationCount)
+	require.Equal(t, sdk.NewDecWithPrec(3, 2), performance.MeanDeviation)
+
+	input.OracleKeeper.SetValidatorPerformance(input.Ctx, ValAddrs[0], performance)
+	stored, found := input.OracleKeeper.GetValidatorPerformance(input.Ctx, ValAddrs[0], 1)
+	require.True(t, found)
+	require.Equal(t, performance, stored)
+
+	input.OracleKeeper.DeleteValidatorPerformance(input.Ctx, ValAddrs[0], 1)
+	_, found = input.OracleKeeper.GetValidatorPerformance(input.Ctx, ValAddrs[0], 1)
+	require.False(t, found)
+}
+
+func TestPruneValidatorPerformances(t *testing.T) {
+	input := CreateTestInput(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.SlashWindow = 100
+	params.PerformanceWindows = 2
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	for window := uint64(0); window < 4; window++ {
+		for _, valAddr := range ValAddrs[:2] {
+			input.OracleKeeper.SetValidatorPerformance(input.Ctx, valAddr, types.NewValidatorPerformance(valAddr, window))
+		}
+	}
+
+	// the last block of window 3
+	input.Ctx = input.Ctx.WithBlockHeight(399)
+	input.OracleKeeper.PruneValidatorPerformances(input.Ctx)
+
+	for _, valAddr := range ValAddrs[:2] {
+		var windows []uint64
+		input.OracleKeeper.IterateValidatorPerformancesByValidator(input.Ctx, valAddr, func(performance types.ValidatorPerformance) (stop bool) {
+			require.Equal(t, valAddr.String(), performance.ValidatorAddress)
+			windows = append(windows, performance.Window)
+			return false
+		})
+		require.Equal(t, []uint64{2, 3}, windows)
+	}
+}
@@ -14,19 +14,27 @@ func HandleRegisterTargetProposal(ctx sdk.Context, k Keeper, p *types.RegisterTa
 		return sdkerrors.Wrapf(types.ErrExistingTarget, "existing target denom '%s'", params.Denom)
 	}
 
-	// Check if the coin exists by ensuring the supply is set
-	if !k.bankKeeper.HasSupply(ctx, params.Denom) && params.Denom != blackfury.MicroFUSDDenom {
+	// Check if the coin exists by ensuring the supply is set, except for off-chain currencies or indices
+	if !params.OffChain && !k.bankKeeper.HasSupply(ctx, params.Denom) && params.Denom != blackfury.MicroFUSDDenom {
 		return sdkerrors.Wrapf(
 			sdkerrors.ErrInvalidCoins,
 			"target denom '%s' cannot have a supply of 0", params.Denom,
 		)
 	}
 
+	// The quote currency must be the reference denom or a registered target
+	if params.Quote != "" && params.Quote != blackfury.MicroUSDDenom && !k.IsTarget(ctx, params.Quote) {
+		return sdkerrors.Wrapf(types.ErrUnknownDenom, "quote denom '%s' is not a target", params.Quote)
+	}
+
 	k.SetTarget(ctx, params.Denom)
+	k.SetTargetQuote(ctx, params.Denom, params.Quote)
 
 	switch params.Source {
 	case types.TARGET_SOURCE_VALIDATORS:
 		k.SetVoteTarget(ctx, params.Denom)
+	case types.TARGET_SOURCE_INTERCHAIN_ORACLE:
+		k.SetInterchainTarget(ctx, params.Denom)
 	default:
 		// TODO
 	}
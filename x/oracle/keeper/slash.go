@@ -2,13 +2,23 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/elysiumstation/blackfury/x/oracle/types"
 )
 
-// SlashAndResetMissCounters slashes any operator who over criteria and clears all operators' miss counter to zero.
+// SlashAndResetMissCounters penalizes any operator who over criteria by graduated tiers
+// and clears all operators' miss counter to zero.
+//
+// An operator missing the criteria in consecutive slash windows is warned for the first
+// WarningWindows windows, slashed by MinorSlashFraction for the next MinorSlashWindows windows,
+// and then slashed by SlashFraction and jailed for JailDuration. A slash window meeting the
+// criteria resets the penalty tier.
 func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 	stakingKeeper := k.StakingKeeper()
 	height := ctx.BlockHeight()
 	distributionHeight := height - sdk.ValidatorUpdateDelay - 1
+	window := uint64(height) / k.SlashWindow(ctx)
 
 	// slash_window / vote_period
 	votePeriodsPerWindow := uint64(
@@ -17,9 +27,8 @@ func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 			TruncateInt64(),
 	)
 	minValidPerWindow := k.MinValidPerWindow(ctx)
-	slashFraction := k.SlashFraction(ctx)
-	powerReduction := stakingKeeper.PowerReduction(ctx)
 
+	penalized := make(map[string]bool)
 	k.IterateMissCounters(ctx, func(operator sdk.ValAddress, missCounter uint64) bool {
 
 		// Calculate valid vote rate: (SlashWindow - MissCounter) / SlashWindow
@@ -31,20 +40,98 @@ func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 		if validVoteRate.LT(minValidPerWindow) {
 			validator := stakingKeeper.Validator(ctx, operator)
 			if validator.IsBonded() && !validator.IsJailed() {
-				consAddr, err := validator.GetConsAddr()
-				if err != nil {
-					panic(err)
-				}
-
-				stakingKeeper.Slash(
-					ctx, consAddr,
-					distributionHeight, validator.GetConsensusPower(powerReduction), slashFraction,
-				)
-				stakingKeeper.Jail(ctx, consAddr)
+				k.penalize(ctx, validator, window, validVoteRate, distributionHeight)
+				penalized[operator.String()] = true
 			}
 		}
 
 		k.DeleteMissCounter(ctx, operator)
 		return false
 	})
+
+	// Reset the penalty tiers of the active validators who met the criteria in this window
+	var recovered []sdk.ValAddress
+	k.IterateValidatorPenalties(ctx, func(operator sdk.ValAddress, _ types.ValidatorPenalty) bool {
+		if penalized[operator.String()] {
+			return false
+		}
+		// Keep the penalty tiers of the jailed or unbonded validators, who were not evaluated
+		validator := stakingKeeper.Validator(ctx, operator)
+		if validator != nil && (!validator.IsBonded() || validator.IsJailed()) {
+			return false
+		}
+		recovered = append(recovered, operator)
+		return false
+	})
+	for _, operator := range recovered {
+		k.DeleteValidatorPenalty(ctx, operator)
+		err := ctx.EventManager().EmitTypedEvent(&types.EventPenaltyReset{
+			Validator: operator.String(),
+			Window:    window,
+		})
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+// penalize escalates the penalty tier of the validator which missed the criteria in the slash window,
+// and applies the penalty of the tier
+func (k Keeper) penalize(ctx sdk.Context, validator stakingtypes.ValidatorI, window uint64, validVoteRate sdk.Dec, distributionHeight int64) {
+	stakingKeeper := k.StakingKeeper()
+	operator := validator.GetOperator()
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		panic(err)
+	}
+	power := validator.GetConsensusPower(stakingKeeper.PowerReduction(ctx))
+
+	penalty, _ := k.GetValidatorPenalty(ctx, operator)
+	penalty.ConsecutiveBadWindows++
+	penalty.LastBadWindow = window
+	penalty.Tier = types.PenaltyTierOf(penalty.ConsecutiveBadWindows, k.WarningWindows(ctx), k.MinorSlashWindows(ctx))
+	k.SetValidatorPenalty(ctx, operator, penalty)
+
+	switch penalty.Tier {
+	case types.PENALTY_TIER_WARNING:
+		err = ctx.EventManager().EmitTypedEvent(&types.EventPenaltyWarning{
+			Validator:             operator.String(),
+			Window:                window,
+			ConsecutiveBadWindows: penalty.ConsecutiveBadWindows,
+			ValidVoteRate:         validVoteRate,
+		})
+	case types.PENALTY_TIER_SLASH:
+		slashFraction := k.MinorSlashFraction(ctx)
+		stakingKeeper.Slash(ctx, consAddr, distributionHeight, power, slashFraction)
+
+		err = ctx.EventManager().EmitTypedEvent(&types.EventPenaltySlash{
+			Validator:             operator.String(),
+			Window:                window,
+			ConsecutiveBadWindows: penalty.ConsecutiveBadWindows,
+			ValidVoteRate:         validVoteRate,
+			SlashFraction:         slashFraction,
+		})
+	case types.PENALTY_TIER_SLASH_AND_JAIL:
+		slashFraction := k.SlashFraction(ctx)
+		stakingKeeper.Slash(ctx, consAddr, distributionHeight, power, slashFraction)
+		stakingKeeper.Jail(ctx, consAddr)
+
+		// the validator cannot unjail itself until the jail duration elapses
+		jailedUntil := ctx.BlockTime().Add(k.JailDuration(ctx))
+		if k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr) {
+			k.slashingKeeper.JailUntil(ctx, consAddr, jailedUntil)
+		}
+
+		err = ctx.EventManager().EmitTypedEvent(&types.EventPenaltySlashAndJail{
+			Validator:             operator.String(),
+			Window:                window,
+			ConsecutiveBadWindows: penalty.ConsecutiveBadWindows,
+			ValidVoteRate:         validVoteRate,
+			SlashFraction:         slashFraction,
+			JailedUntil:           jailedUntil,
+		})
+	}
+	if err != nil {
+		panic(err)
+	}
 }
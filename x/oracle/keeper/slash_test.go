@@ -7,6 +7,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
+
+	"github.com/elysiumstation/blackfury/x/oracle/types"
 )
 
 func TestSlashAndResetMissCounters(t *testing.T) {
@@ -36,6 +38,12 @@ func TestSlashAndResetMissCounters(t *testing.T) {
 	)
 	require.Equal(t, amt, input.StakingKeeper.Validator(ctx, addr1).GetBondedTokens())
 
+	// slash and jail on the first bad window
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.WarningWindows = 0
+	params.MinorSlashWindows = 0
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
 	votePeriodsPerWindow := sdk.NewDec(int64(input.OracleKeeper.SlashWindow(input.Ctx))).QuoInt64(int64(input.OracleKeeper.VotePeriod(input.Ctx))).TruncateInt64()
 	slashFraction := input.OracleKeeper.SlashFraction(input.Ctx)
 	minValidVotes := input.OracleKeeper.MinValidPerWindow(input.Ctx).MulInt64(votePeriodsPerWindow).TruncateInt64()
@@ -79,3 +87,99 @@ func TestSlashAndResetMissCounters(t *testing.T) {
 	validator, _ = input.StakingKeeper.GetValidator(input.Ctx, ValAddrs[0])
 	require.Equal(t, amt, validator.Tokens)
 }
+
+func TestGraduatedSlashing(t *testing.T) {
+	// initial setup
+	input := CreateTestInput(t)
+	addr, val := ValAddrs[0], ValPubKeys[0]
+	addr1, val1 := ValAddrs[1], ValPubKeys[1]
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	sh := staking.NewHandler(input.StakingKeeper)
+	ctx := input.Ctx
+
+	// Validator created
+	_, err := sh(ctx, NewTestMsgCreateValidator(addr, val, amt))
+	require.NoError(t, err)
+	_, err = sh(ctx, NewTestMsgCreateValidator(addr1, val1, amt))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	slashWindow := int64(input.OracleKeeper.SlashWindow(ctx))
+	votePeriodsPerWindow := sdk.NewDec(slashWindow).QuoInt64(int64(input.OracleKeeper.VotePeriod(ctx))).TruncateInt64()
+	minValidVotes := input.OracleKeeper.MinValidPerWindow(ctx).MulInt64(votePeriodsPerWindow).TruncateInt64()
+	badMissCounter := uint64(votePeriodsPerWindow - minValidVotes + 1)
+
+	// ends the slash window with the given miss counters
+	endWindow := func(window int64, missCounters map[int]uint64) sdk.Context {
+		ctx := input.Ctx.WithBlockHeight((window+1)*slashWindow - 1).WithEventManager(sdk.NewEventManager())
+		for i, missCounter := range missCounters {
+			input.OracleKeeper.SetMissCounter(ctx, ValAddrs[i], missCounter)
+		}
+		input.OracleKeeper.SlashAndResetMissCounters(ctx)
+		staking.EndBlocker(ctx, input.StakingKeeper)
+		return ctx
+	}
+	requireEvent := func(ctx sdk.Context, eventType string) {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == eventType {
+				return
+			}
+		}
+		require.Fail(t, "event not found", eventType)
+	}
+
+	// Window 0, warning
+	ctx = endWindow(0, map[int]uint64{0: badMissCounter, 1: badMissCounter})
+	requireEvent(ctx, "blackfury.oracle.v1.EventPenaltyWarning")
+	for _, valAddr := range ValAddrs[:2] {
+		penalty, found := input.OracleKeeper.GetValidatorPenalty(ctx, valAddr)
+		require.True(t, found)
+		require.Equal(t, types.PENALTY_TIER_WARNING, penalty.Tier)
+		require.Equal(t, uint64(1), penalty.ConsecutiveBadWindows)
+		require.Equal(t, uint64(0), penalty.LastBadWindow)
+
+		validator, _ := input.StakingKeeper.GetValidator(ctx, valAddr)
+		require.Equal(t, amt, validator.GetBondedTokens())
+	}
+
+	// Window 1, minor slash of validator 0, and validator 1 recovers
+	ctx = endWindow(1, map[int]uint64{0: badMissCounter, 1: 1})
+	requireEvent(ctx, "blackfury.oracle.v1.EventPenaltySlash")
+	requireEvent(ctx, "blackfury.oracle.v1.EventPenaltyReset")
+	penalty, _ := input.OracleKeeper.GetValidatorPenalty(ctx, ValAddrs[0])
+	require.Equal(t, types.PENALTY_TIER_SLASH, penalty.Tier)
+	require.Equal(t, uint64(2), penalty.ConsecutiveBadWindows)
+	require.Equal(t, uint64(1), penalty.LastBadWindow)
+	validator, _ := input.StakingKeeper.GetValidator(ctx, ValAddrs[0])
+	slashed := amt.Sub(input.OracleKeeper.MinorSlashFraction(ctx).MulInt(amt).TruncateInt())
+	require.Equal(t, slashed, validator.GetBondedTokens())
+	require.False(t, validator.IsJailed())
+
+	_, found := input.OracleKeeper.GetValidatorPenalty(ctx, ValAddrs[1])
+	require.False(t, found)
+
+	// Window 2, slash and jail of validator 0
+	ctx = endWindow(2, map[int]uint64{0: badMissCounter})
+	requireEvent(ctx, "blackfury.oracle.v1.EventPenaltySlashAndJail")
+	penalty, _ = input.OracleKeeper.GetValidatorPenalty(ctx, ValAddrs[0])
+	require.Equal(t, types.PENALTY_TIER_SLASH_AND_JAIL, penalty.Tier)
+	require.Equal(t, uint64(3), penalty.ConsecutiveBadWindows)
+	validator, _ = input.StakingKeeper.GetValidator(ctx, ValAddrs[0])
+	require.True(t, validator.IsJailed())
+	// the slash amount is based on the consensus power
+	power := sdk.TokensToConsensusPower(slashed, sdk.DefaultPowerReduction)
+	slashAmount := input.OracleKeeper.SlashFraction(ctx).MulInt(sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)).TruncateInt()
+	require.Equal(t, slashed.Sub(slashAmount), validator.Tokens)
+
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	info, found := input.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime().Add(input.OracleKeeper.JailDuration(ctx)), info.JailedUntil)
+
+	// Window 3, the penalty of the jailed validator is kept
+	ctx = endWindow(3, nil)
+	penalty, found = input.OracleKeeper.GetValidatorPenalty(ctx, ValAddrs[0])
+	require.True(t, found)
+	require.Equal(t, types.PENALTY_TIER_SLASH_AND_JAIL, penalty.Tier)
+}
@@ -12,6 +12,7 @@ import (
 	simparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	"github.com/cosmos/cosmos-sdk/std"
 	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -21,14 +22,20 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	portkeeper "github.com/cosmos/ibc-go/v3/modules/core/05-port/keeper"
+	ibchost "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	blackfury "github.com/elysiumstation/blackfury/types"
 	"github.com/elysiumstation/blackfury/x/oracle/types"
 	customstaking "github.com/elysiumstation/blackfury/x/staking"
@@ -114,13 +121,15 @@ var (
 
 // TestInput nolint
 type TestInput struct {
-	Ctx           sdk.Context
-	Cdc           *codec.LegacyAmino
-	AccountKeeper authkeeper.AccountKeeper
-	BankKeeper    bankkeeper.Keeper
-	OracleKeeper  Keeper
-	StakingKeeper stakingkeeper.Keeper
-	DistrKeeper   distrkeeper.Keeper
+	Ctx            sdk.Context
+	Cdc            *codec.LegacyAmino
+	AccountKeeper  authkeeper.AccountKeeper
+	BankKeeper     bankkeeper.Keeper
+	OracleKeeper   Keeper
+	StakingKeeper  stakingkeeper.Keeper
+	DistrKeeper    distrkeeper.Keeper
+	SlashingKeeper slashingkeeper.Keeper
+	ParamsKey      sdk.StoreKey
 }
 
 // CreateTestInput nolint
@@ -132,6 +141,9 @@ func CreateTestInput(t *testing.T) TestInput {
 	keyOracle := sdk.NewKVStoreKey(types.StoreKey)
 	keyStaking := sdk.NewKVStoreKey(stakingtypes.StoreKey)
 	keyDistr := sdk.NewKVStoreKey(distrtypes.StoreKey)
+	keySlashing := sdk.NewKVStoreKey(slashingtypes.StoreKey)
+	keyCapability := sdk.NewKVStoreKey(capabilitytypes.StoreKey)
+	memKeyCapability := storetypes.NewMemoryStoreKey(capabilitytypes.MemStoreKey)
 
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
@@ -146,6 +158,9 @@ func CreateTestInput(t *testing.T) TestInput {
 	ms.MountStoreWithDB(keyOracle, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyDistr, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySlashing, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyCapability, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(memKeyCapability, sdk.StoreTypeMemory, nil)
 
 	require.NoError(t, ms.LoadLatestVersion())
 
@@ -197,7 +212,10 @@ func CreateTestInput(t *testing.T) TestInput {
 	distrParams.BaseProposerReward = sdk.NewDecWithPrec(1, 2)
 	distrParams.BonusProposerReward = sdk.NewDecWithPrec(4, 2)
 	distrKeeper.SetParams(ctx, distrParams)
-	stakingKeeper.SetHooks(stakingtypes.NewMultiStakingHooks(distrKeeper.Hooks()))
+
+	slashingKeeper := slashingkeeper.NewKeeper(appCodec, keySlashing, &stakingKeeper, paramsKeeper.Subspace(slashingtypes.ModuleName))
+	slashingKeeper.SetParams(ctx, slashingtypes.DefaultParams())
+	stakingKeeper.SetHooks(stakingtypes.NewMultiStakingHooks(distrKeeper.Hooks(), slashingKeeper.Hooks()))
 
 	feeCollectorAcc := authtypes.NewEmptyModuleAccount(authtypes.FeeCollectorName)
 	notBondedPool := authtypes.NewEmptyModuleAccount(stakingtypes.NotBondedPoolName, authtypes.Burner, authtypes.Staking)
@@ -219,6 +237,12 @@ func CreateTestInput(t *testing.T) TestInput {
 		require.NoError(t, err)
 	}
 
+	capabilityKeeper := capabilitykeeper.NewKeeper(appCodec, keyCapability, memKeyCapability)
+	scopedIBCKeeper := capabilityKeeper.ScopeToModule(ibchost.ModuleName)
+	scopedOracleKeeper := capabilityKeeper.ScopeToModule(types.ModuleName)
+	capabilityKeeper.Seal()
+	portKeeper := portkeeper.NewKeeper(scopedIBCKeeper)
+
 	keeper := NewKeeper(
 		appCodec,
 		keyOracle,
@@ -227,6 +251,11 @@ func CreateTestInput(t *testing.T) TestInput {
 		bankKeeper,
 		distrKeeper,
 		stakingKeeper,
+		slashingKeeper,
+		nil,
+		nil,
+		&portKeeper,
+		scopedOracleKeeper,
 		distrtypes.ModuleName,
 	)
 
@@ -236,7 +265,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	keeper.SetVoteTarget(ctx, blackfury.AttoFuryDenom)
 	keeper.SetVoteTarget(ctx, blackfury.MicroFUSDDenom)
 
-	return TestInput{ctx, legacyAmino, accountKeeper, bankKeeper, *keeper, stakingKeeper, distrKeeper}
+	return TestInput{ctx, legacyAmino, accountKeeper, bankKeeper, *keeper, stakingKeeper, distrKeeper, slashingKeeper, keyParams}
 }
 
 // NewTestMsgCreateValidator test msg creator
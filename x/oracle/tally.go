@@ -21,6 +21,12 @@ func Tally(ctx sdk.Context, pb types.ExchangeRateBallot, rewardBand sdk.Dec, val
 	}
 
 	for _, vote := range pb {
+		// Record the deviation of valid votes
+		if claim, ok := validatorClaimMap[vote.Voter.String()]; ok && vote.ExchangeRate.IsPositive() {
+			claim.AddDeviation(vote.ExchangeRate, weightedMedian)
+			validatorClaimMap[vote.Voter.String()] = claim
+		}
+
 		// Filter ballot winners & abstain voters
 		if (vote.ExchangeRate.GTE(weightedMedian.Sub(rewardSpread)) &&
 			vote.ExchangeRate.LTE(weightedMedian.Add(rewardSpread))) ||
@@ -155,6 +155,12 @@ type Claim struct {
 	Weight    int64
 	WinCount  int64
 	Recipient sdk.ValAddress
+
+	// DeviationSum is the sum of relative deviations of the exchange rates from the weighted medians,
+	// which is nil until the first deviation is added
+	DeviationSum sdk.Dec
+	// DeviationCount is the # of exchange rates accumulated into DeviationSum
+	DeviationCount int64
 }
 
 // NewClaim creates a Claim instance.
@@ -166,3 +172,15 @@ func NewClaim(power, weight, winCount int64, recipient sdk.ValAddress) Claim {
 		Recipient: recipient,
 	}
 }
+
+// AddDeviation accumulates the relative deviation of the exchange rate from the weighted median.
+func (c *Claim) AddDeviation(exchangeRate, weightedMedian sdk.Dec) {
+	if !weightedMedian.IsPositive() {
+		return
+	}
+	if c.DeviationSum.IsNil() {
+		c.DeviationSum = sdk.ZeroDec()
+	}
+	c.DeviationSum = c.DeviationSum.Add(exchangeRate.Sub(weightedMedian).Abs().Quo(weightedMedian))
+	c.DeviationCount++
+}
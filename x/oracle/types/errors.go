@@ -25,4 +25,8 @@ var (
 	ErrNoVoteTarget          = sdkerrors.Register(ModuleName, 13, "no vote target")
 	ErrUnknownDenom          = sdkerrors.Register(ModuleName, 14, "unknown denom")
 	ErrExistingTarget        = sdkerrors.Register(ModuleName, 15, "existing denom")
+	ErrInvalidChannel        = sdkerrors.Register(ModuleName, 16, "invalid interchain oracle channel")
+	ErrInvalidVersion        = sdkerrors.Register(ModuleName, 17, "invalid interchain oracle version")
+	ErrInvalidPacket         = sdkerrors.Register(ModuleName, 18, "invalid interchain oracle packet")
+	ErrStaleExchangeRate     = sdkerrors.Register(ModuleName, 19, "stale exchange rate")
 )
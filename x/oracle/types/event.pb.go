@@ -0,0 +1,1379 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: blackfury/oracle/v1/event.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type EventPenaltyWarning struct {
+	Validator             string                                 `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Window                uint64                                 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	ConsecutiveBadWindows uint64                                 `protobuf:"varint,3,opt,name=consecutive_bad_windows,json=consecutiveBadWindows,proto3" json:"consecutive_bad_windows,omitempty"`
+	ValidVoteRate         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=valid_vote_rate,json=validVoteRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"valid_vote_rate"`
+}
+
+func (m *EventPenaltyWarning) Reset()         { *m = EventPenaltyWarning{} }
+func (m *EventPenaltyWarning) String() string { return proto.CompactTextString(m) }
+func (*EventPenaltyWarning) ProtoMessage()    {}
+func (*EventPenaltyWarning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6986724d3d08adac, []int{0}
+}
+func (m *EventPenaltyWarning) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPenaltyWarning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPenaltyWarning.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPenaltyWarning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPenaltyWarning.Merge(m, src)
+}
+func (m *EventPenaltyWarning) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPenaltyWarning) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPenaltyWarning.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPenaltyWarning proto.InternalMessageInfo
+
+func (m *EventPenaltyWarning) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *EventPenaltyWarning) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *EventPenaltyWarning) GetConsecutiveBadWindows() uint64 {
+	if m != nil {
+		return m.ConsecutiveBadWindows
+	}
+	return 0
+}
+
+type EventPenaltySlash struct {
+	Validator             string                                 `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Window                uint64                                 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	ConsecutiveBadWindows uint64                                 `protobuf:"varint,3,opt,name=consecutive_bad_windows,json=consecutiveBadWindows,proto3" json:"consecutive_bad_windows,omitempty"`
+	ValidVoteRate         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=valid_vote_rate,json=validVoteRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"valid_vote_rate"`
+	SlashFraction         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction"`
+}
+
+func (m *EventPenaltySlash) Reset()         { *m = EventPenaltySlash{} }
+func (m *EventPenaltySlash) String() string { return proto.CompactTextString(m) }
+func (*EventPenaltySlash) ProtoMessage()    {}
+func (*EventPenaltySlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6986724d3d08adac, []int{1}
+}
+func (m *EventPenaltySlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPenaltySlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPenaltySlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPenaltySlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPenaltySlash.Merge(m, src)
+}
+func (m *EventPenaltySlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPenaltySlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPenaltySlash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPenaltySlash proto.InternalMessageInfo
+
+func (m *EventPenaltySlash) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *EventPenaltySlash) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *EventPenaltySlash) GetConsecutiveBadWindows() uint64 {
+	if m != nil {
+		return m.ConsecutiveBadWindows
+	}
+	return 0
+}
+
+type EventPenaltySlashAndJail struct {
+	Validator             string                                 `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Window                uint64                                 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	ConsecutiveBadWindows uint64                                 `protobuf:"varint,3,opt,name=consecutive_bad_windows,json=consecutiveBadWindows,proto3" json:"consecutive_bad_windows,omitempty"`
+	ValidVoteRate         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=valid_vote_rate,json=validVoteRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"valid_vote_rate"`
+	SlashFraction         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction"`
+	JailedUntil           time.Time                              `protobuf:"bytes,6,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until"`
+}
+
+func (m *EventPenaltySlashAndJail) Reset()         { *m = EventPenaltySlashAndJail{} }
+func (m *EventPenaltySlashAndJail) String() string { return proto.CompactTextString(m) }
+func (*EventPenaltySlashAndJail) ProtoMessage()    {}
+func (*EventPenaltySlashAndJail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6986724d3d08adac, []int{2}
+}
+func (m *EventPenaltySlashAndJail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPenaltySlashAndJail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPenaltySlashAndJail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPenaltySlashAndJail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPenaltySlashAndJail.Merge(m, src)
+}
+func (m *EventPenaltySlashAndJail) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPenaltySlashAndJail) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPenaltySlashAndJail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPenaltySlashAndJail proto.InternalMessageInfo
+
+func (m *EventPenaltySlashAndJail) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *EventPenaltySlashAndJail) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *EventPenaltySlashAndJail) GetConsecutiveBadWindows() uint64 {
+	if m != nil {
+		return m.ConsecutiveBadWindows
+	}
+	return 0
+}
+
+func (m *EventPenaltySlashAndJail) GetJailedUntil() time.Time {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return time.Time{}
+}
+
+type EventPenaltyReset struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Window    uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *EventPenaltyReset) Reset()         { *m = EventPenaltyReset{} }
+func (m *EventPenaltyReset) String() string { return proto.CompactTextString(m) }
+func (*EventPenaltyReset) ProtoMessage()    {}
+func (*EventPenaltyReset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6986724d3d08adac, []int{3}
+}
+func (m *EventPenaltyReset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPenaltyReset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPenaltyReset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPenaltyReset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPenaltyReset.Merge(m, src)
+}
+func (m *EventPenaltyReset) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPenaltyReset) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPenaltyReset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPenaltyReset proto.InternalMessageInfo
+
+func (m *EventPenaltyReset) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *EventPenaltyReset) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventPenaltyWarning)(nil), "blackfury.oracle.v1.EventPenaltyWarning")
+	proto.RegisterType((*EventPenaltySlash)(nil), "blackfury.oracle.v1.EventPenaltySlash")
+	proto.RegisterType((*EventPenaltySlashAndJail)(nil), "blackfury.oracle.v1.EventPenaltySlashAndJail")
+	proto.RegisterType((*EventPenaltyReset)(nil), "blackfury.oracle.v1.EventPenaltyReset")
+}
+
+func init() { proto.RegisterFile("blackfury/oracle/v1/event.proto", fileDescriptor_6986724d3d08adac) }
+
+var fileDescriptor_6986724d3d08adac = []byte{
+	// 445 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xcd, 0xb6, 0x25, 0xa2, 0x5b, 0x3e, 0x84, 0xcb, 0x87, 0x15, 0x21, 0x3b, 0xca, 0x01, 0xe5,
+	0xc2, 0xae, 0x5a, 0x24, 0xee, 0x44, 0x7c, 0x08, 0xc4, 0x01, 0x19, 0xda, 0x4a, 0x5c, 0xac, 0xb5,
+	0x3d, 0x71, 0x97, 0xae, 0x77, 0x23, 0xef, 0xd8, 0x25, 0x37, 0x7e, 0x42, 0x7f, 0x0a, 0x3f, 0xa3,
+	0xc7, 0x1e, 0x11, 0x48, 0x05, 0x25, 0x7f, 0x04, 0x79, 0x9d, 0xd0, 0x20, 0x24, 0x0e, 0x3d, 0x22,
+	0x4e, 0xf6, 0xf8, 0xbd, 0x79, 0xa3, 0xf7, 0xe4, 0x19, 0x1a, 0x26, 0x4a, 0xa4, 0x47, 0xe3, 0xaa,
+	0x9c, 0x72, 0x53, 0x8a, 0x54, 0x01, 0xaf, 0x77, 0x38, 0xd4, 0xa0, 0x91, 0x4d, 0x4a, 0x83, 0xc6,
+	0xdb, 0xfe, 0x45, 0x60, 0x2d, 0x81, 0xd5, 0x3b, 0xbd, 0xdb, 0xb9, 0xc9, 0x8d, 0xc3, 0x79, 0xf3,
+	0xd6, 0x52, 0x7b, 0x61, 0x6e, 0x4c, 0xae, 0x80, 0xbb, 0x2a, 0xa9, 0xc6, 0x1c, 0x65, 0x01, 0x16,
+	0x45, 0x31, 0x69, 0x09, 0x83, 0x6f, 0x84, 0x6e, 0x3f, 0x6b, 0xb4, 0xdf, 0x80, 0x16, 0x0a, 0xa7,
+	0x07, 0xa2, 0xd4, 0x52, 0xe7, 0xde, 0x7d, 0xba, 0x59, 0x0b, 0x25, 0x33, 0x81, 0xa6, 0xf4, 0x49,
+	0x9f, 0x0c, 0x37, 0xa3, 0x8b, 0x0f, 0xde, 0x5d, 0xda, 0x3d, 0x96, 0x3a, 0x33, 0xc7, 0xfe, 0x5a,
+	0x9f, 0x0c, 0x37, 0xa2, 0x45, 0xe5, 0x3d, 0xa6, 0xf7, 0x52, 0xa3, 0x2d, 0xa4, 0x15, 0xca, 0x1a,
+	0xe2, 0x44, 0x64, 0x71, 0x8b, 0x58, 0x7f, 0xdd, 0x11, 0xef, 0xac, 0xc0, 0x23, 0x91, 0x1d, 0xb4,
+	0xa0, 0xb7, 0x4f, 0x6f, 0x3a, 0xf1, 0xb8, 0x36, 0x08, 0x71, 0x29, 0x10, 0xfc, 0x8d, 0x66, 0xe6,
+	0x88, 0x9d, 0x9e, 0x87, 0x9d, 0xaf, 0xe7, 0xe1, 0x83, 0x5c, 0xe2, 0x61, 0x95, 0xb0, 0xd4, 0x14,
+	0x3c, 0x35, 0xb6, 0x30, 0x76, 0xf1, 0x78, 0x68, 0xb3, 0x23, 0x8e, 0xd3, 0x09, 0x58, 0xf6, 0x14,
+	0xd2, 0xe8, 0xba, 0x93, 0xd9, 0x37, 0x08, 0x91, 0x40, 0x18, 0x7c, 0x5e, 0xa3, 0xb7, 0x56, 0xdd,
+	0xbd, 0x55, 0xc2, 0x1e, 0xfe, 0x1b, 0xde, 0xbc, 0x3d, 0x7a, 0xc3, 0x36, 0x76, 0xe2, 0x71, 0x29,
+	0x52, 0x94, 0x46, 0xfb, 0x57, 0x2e, 0x27, 0xeb, 0x54, 0x9e, 0x2f, 0x44, 0x06, 0x9f, 0xd6, 0xa9,
+	0xff, 0x47, 0x64, 0x4f, 0x74, 0xf6, 0x4a, 0x48, 0xf5, 0x3f, 0xb9, 0xbf, 0x24, 0xe7, 0xbd, 0xa0,
+	0xd7, 0x3e, 0x08, 0xa9, 0x20, 0x8b, 0x2b, 0x8d, 0x52, 0xf9, 0xdd, 0x3e, 0x19, 0x6e, 0xed, 0xf6,
+	0x58, 0xbb, 0x82, 0x6c, 0xb9, 0x82, 0xec, 0xdd, 0x72, 0x05, 0x47, 0x57, 0x9b, 0x81, 0x27, 0xdf,
+	0x43, 0x12, 0x6d, 0xb5, 0x9d, 0x7b, 0x4d, 0xe3, 0xe0, 0xe5, 0xef, 0x3f, 0x6d, 0x04, 0x16, 0xf0,
+	0x72, 0xd1, 0x8f, 0x5e, 0x9f, 0xce, 0x02, 0x72, 0x36, 0x0b, 0xc8, 0x8f, 0x59, 0x40, 0x4e, 0xe6,
+	0x41, 0xe7, 0x6c, 0x1e, 0x74, 0xbe, 0xcc, 0x83, 0xce, 0xfb, 0xdd, 0x15, 0x93, 0xa0, 0xa6, 0x56,
+	0x56, 0x85, 0x45, 0xd1, 0x18, 0xe1, 0x17, 0xf7, 0xe7, 0xe3, 0xf2, 0x02, 0x39, 0xd3, 0x49, 0xd7,
+	0x79, 0x78, 0xf4, 0x73, 0x00, 0xd8, 0xf3, 0xcb, 0xb5, 0xa2, 0x04, 0x00, 0x00,
+}
+
+func (m *EventPenaltyWarning) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPenaltyWarning) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPenaltyWarning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ValidVoteRate.Size()
+		i -= size
+		if _, err := m.ValidVoteRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ConsecutiveBadWindows != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ConsecutiveBadWindows))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Window != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPenaltySlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPenaltySlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPenaltySlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ValidVoteRate.Size()
+		i -= size
+		if _, err := m.ValidVoteRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ConsecutiveBadWindows != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ConsecutiveBadWindows))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Window != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPenaltySlashAndJail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPenaltySlashAndJail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPenaltySlashAndJail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintEvent(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ValidVoteRate.Size()
+		i -= size
+		if _, err := m.ValidVoteRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ConsecutiveBadWindows != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ConsecutiveBadWindows))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Window != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPenaltyReset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPenaltyReset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPenaltyReset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventPenaltyWarning) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovEvent(uint64(m.Window))
+	}
+	if m.ConsecutiveBadWindows != 0 {
+		n += 1 + sovEvent(uint64(m.ConsecutiveBadWindows))
+	}
+	l = m.ValidVoteRate.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventPenaltySlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovEvent(uint64(m.Window))
+	}
+	if m.ConsecutiveBadWindows != 0 {
+		n += 1 + sovEvent(uint64(m.ConsecutiveBadWindows))
+	}
+	l = m.ValidVoteRate.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventPenaltySlashAndJail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovEvent(uint64(m.Window))
+	}
+	if m.ConsecutiveBadWindows != 0 {
+		n += 1 + sovEvent(uint64(m.ConsecutiveBadWindows))
+	}
+	l = m.ValidVoteRate.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventPenaltyReset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovEvent(uint64(m.Window))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventPenaltyWarning) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPenaltyWarning: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPenaltyWarning: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveBadWindows", wireType)
+			}
+			m.ConsecutiveBadWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveBadWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidVoteRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidVoteRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPenaltySlash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPenaltySlash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPenaltySlash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveBadWindows", wireType)
+			}
+			m.ConsecutiveBadWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveBadWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidVoteRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidVoteRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPenaltySlashAndJail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPenaltySlashAndJail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPenaltySlashAndJail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveBadWindows", wireType)
+			}
+			m.ConsecutiveBadWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveBadWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidVoteRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidVoteRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPenaltyReset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPenaltyReset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPenaltyReset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvent(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvent
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvent
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvent
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvent        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvent          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvent = fmt.Errorf("proto: unexpected end of group")
+)
@@ -2,19 +2,24 @@ package types
 
 // x/oracle module event types
 const (
-	EventTypeExchangeRateUpdate = "exchange_rate_update"
-	EventTypePrevote            = "prevote"
-	EventTypeVote               = "vote"
-	EventTypeFeedDelegate       = "feed_delegate"
-	EventTypeAggregatePrevote   = "aggregate_prevote"
-	EventTypeAggregateVote      = "aggregate_vote"
+	EventTypeExchangeRateUpdate      = "exchange_rate_update"
+	EventTypeQuoteExchangeRateUpdate = "quote_exchange_rate_update"
+	EventTypePrevote                 = "prevote"
+	EventTypeVote                    = "vote"
+	EventTypeFeedDelegate            = "feed_delegate"
+	EventTypeAggregatePrevote        = "aggregate_prevote"
+	EventTypeAggregateVote           = "aggregate_vote"
+	EventTypeInterchainOraclePacket  = "interchain_oracle_packet"
 
 	AttributeKeyDenom         = "denom"
+	AttributeKeyQuote         = "quote"
 	AttributeKeyVoter         = "voter"
 	AttributeKeyExchangeRate  = "exchange_rate"
 	AttributeKeyExchangeRates = "exchange_rates"
 	AttributeKeyOperator      = "operator"
 	AttributeKeyFeeder        = "feeder"
+	AttributeKeyAckSuccess    = "success"
+	AttributeKeyAckError      = "error"
 
 	AttributeValueCategory = ModuleName
 )
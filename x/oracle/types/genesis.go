@@ -1,11 +1,21 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 // NewGenesis creates a new genesis state.
 func NewGenesis(
 	params Params, rates []ExchangeRateTuple,
 	feederDelegations []FeederDelegation, missCounters []MissCounter,
 	aggregateExchangeRatePrevotes []AggregateExchangeRatePrevote,
 	aggregateExchangeRateVotes []AggregateExchangeRateVote,
+	validatorPerformances []ValidatorPerformance,
+	validatorPenalties []ValidatorPenalty,
+	targetQuotes []TargetQuote,
+	interchainTargets []string,
+	interchainExchangeRates []InterchainExchangeRate,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                        params,
@@ -14,6 +24,11 @@ func NewGenesis(
 		MissCounters:                  missCounters,
 		AggregateExchangeRatePrevotes: aggregateExchangeRatePrevotes,
 		AggregateExchangeRateVotes:    aggregateExchangeRateVotes,
+		ValidatorPerformances:         validatorPerformances,
+		ValidatorPenalties:            validatorPenalties,
+		TargetQuotes:                  targetQuotes,
+		InterchainTargets:             interchainTargets,
+		InterchainExchangeRates:       interchainExchangeRates,
 	}
 }
 
@@ -29,6 +44,29 @@ func DefaultGenesis() *GenesisState {
 // failure.
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
+	for _, tq := range gs.TargetQuotes {
+		if err := sdk.ValidateDenom(tq.Denom); err != nil {
+			return err
+		}
+		if err := sdk.ValidateDenom(tq.Quote); err != nil {
+			return err
+		}
+	}
+
+	for _, denom := range gs.InterchainTargets {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+	}
+
+	for _, rate := range gs.InterchainExchangeRates {
+		if err := sdk.ValidateDenom(rate.Denom); err != nil {
+			return err
+		}
+		if rate.ExchangeRate.IsNil() || !rate.ExchangeRate.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidExchangeRate, "interchain exchange rate of '%s' must be positive", rate.Denom)
+		}
+	}
 
 	return gs.Params.Validate()
 }
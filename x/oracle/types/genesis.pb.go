@@ -31,6 +31,11 @@ type GenesisState struct {
 	MissCounters                  []MissCounter                  `protobuf:"bytes,4,rep,name=miss_counters,json=missCounters,proto3" json:"miss_counters"`
 	AggregateExchangeRatePrevotes []AggregateExchangeRatePrevote `protobuf:"bytes,5,rep,name=aggregate_exchange_rate_prevotes,json=aggregateExchangeRatePrevotes,proto3" json:"aggregate_exchange_rate_prevotes"`
 	AggregateExchangeRateVotes    []AggregateExchangeRateVote    `protobuf:"bytes,6,rep,name=aggregate_exchange_rate_votes,json=aggregateExchangeRateVotes,proto3" json:"aggregate_exchange_rate_votes"`
+	ValidatorPerformances         []ValidatorPerformance         `protobuf:"bytes,7,rep,name=validator_performances,json=validatorPerformances,proto3" json:"validator_performances"`
+	ValidatorPenalties            []ValidatorPenalty             `protobuf:"bytes,8,rep,name=validator_penalties,json=validatorPenalties,proto3" json:"validator_penalties"`
+	TargetQuotes                  []TargetQuote                  `protobuf:"bytes,9,rep,name=target_quotes,json=targetQuotes,proto3" json:"target_quotes"`
+	InterchainTargets             []string                       `protobuf:"bytes,10,rep,name=interchain_targets,json=interchainTargets,proto3" json:"interchain_targets,omitempty"`
+	InterchainExchangeRates       []InterchainExchangeRate       `protobuf:"bytes,11,rep,name=interchain_exchange_rates,json=interchainExchangeRates,proto3" json:"interchain_exchange_rates"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -108,6 +113,41 @@ func (m *GenesisState) GetAggregateExchangeRateVotes() []AggregateExchangeRateVo
 	return nil
 }
 
+func (m *GenesisState) GetValidatorPerformances() []ValidatorPerformance {
+	if m != nil {
+		return m.ValidatorPerformances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorPenalties() []ValidatorPenalty {
+	if m != nil {
+		return m.ValidatorPenalties
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTargetQuotes() []TargetQuote {
+	if m != nil {
+		return m.TargetQuotes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInterchainTargets() []string {
+	if m != nil {
+		return m.InterchainTargets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInterchainExchangeRates() []InterchainExchangeRate {
+	if m != nil {
+		return m.InterchainExchangeRates
+	}
+	return nil
+}
+
 // FeederDelegation is the address for where oracle feeder authority are
 // delegated to. By default this struct is only used at genesis to feed in
 // default feeder addresses.
@@ -217,46 +257,111 @@ func (m *MissCounter) GetMissCounter() uint64 {
 	return 0
 }
 
+// TargetQuote defines the quote currency of a target, other than the reference
+// denom, used in oracle module's genesis state.
+type TargetQuote struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Quote string `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+}
+
+func (m *TargetQuote) Reset()         { *m = TargetQuote{} }
+func (m *TargetQuote) String() string { return proto.CompactTextString(m) }
+func (*TargetQuote) ProtoMessage()    {}
+func (*TargetQuote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed25eb01f38101cc, []int{3}
+}
+func (m *TargetQuote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TargetQuote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TargetQuote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TargetQuote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TargetQuote.Merge(m, src)
+}
+func (m *TargetQuote) XXX_Size() int {
+	return m.Size()
+}
+func (m *TargetQuote) XXX_DiscardUnknown() {
+	xxx_messageInfo_TargetQuote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TargetQuote proto.InternalMessageInfo
+
+func (m *TargetQuote) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TargetQuote) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "blackfury.oracle.v1.GenesisState")
 	proto.RegisterType((*FeederDelegation)(nil), "blackfury.oracle.v1.FeederDelegation")
 	proto.RegisterType((*MissCounter)(nil), "blackfury.oracle.v1.MissCounter")
+	proto.RegisterType((*TargetQuote)(nil), "blackfury.oracle.v1.TargetQuote")
 }
 
 func init() { proto.RegisterFile("blackfury/oracle/v1/genesis.proto", fileDescriptor_ed25eb01f38101cc) }
 
 var fileDescriptor_ed25eb01f38101cc = []byte{
-	// 474 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xe3, 0x36, 0x44, 0x62, 0x93, 0x54, 0xed, 0xc2, 0x21, 0x0a, 0xaa, 0xeb, 0x46, 0x2a,
-	0xaa, 0x84, 0x64, 0x2b, 0xe1, 0xc4, 0xb1, 0xe1, 0xdf, 0x01, 0x90, 0x2a, 0x83, 0x38, 0x54, 0x42,
-	0xd6, 0xc6, 0x9e, 0xb8, 0x16, 0x76, 0xd6, 0xda, 0x59, 0x87, 0xe6, 0xc6, 0x23, 0xf0, 0x1c, 0x3c,
-	0x49, 0x8f, 0x3d, 0xc2, 0x05, 0x50, 0xf2, 0x22, 0x28, 0xbb, 0x6e, 0x6c, 0xca, 0x16, 0x71, 0xb3,
-	0x66, 0x7f, 0xdf, 0xf7, 0xcd, 0x68, 0x3c, 0xe4, 0x70, 0x92, 0xb2, 0xf0, 0xe3, 0xb4, 0x10, 0x0b,
-	0x8f, 0x0b, 0x16, 0xa6, 0xe0, 0xcd, 0x87, 0x5e, 0x0c, 0x33, 0xc0, 0x04, 0xdd, 0x5c, 0x70, 0xc9,
-	0xe9, 0xbd, 0x0d, 0xe2, 0x6a, 0xc4, 0x9d, 0x0f, 0xfb, 0xf7, 0x63, 0x1e, 0x73, 0xf5, 0xee, 0xad,
-	0xbf, 0x34, 0xda, 0x77, 0x4c, 0x6e, 0xa5, 0x48, 0x11, 0x83, 0xef, 0x4d, 0xd2, 0x79, 0xa9, 0xed,
-	0xdf, 0x4a, 0x26, 0x81, 0x3e, 0x21, 0xad, 0x9c, 0x09, 0x96, 0x61, 0xcf, 0x72, 0xac, 0xe3, 0xf6,
-	0xe8, 0x81, 0x6b, 0x88, 0x73, 0x4f, 0x15, 0x32, 0x6e, 0x5e, 0xfe, 0x38, 0x68, 0xf8, 0xa5, 0x80,
-	0x9e, 0x11, 0x3a, 0x05, 0x88, 0x40, 0x04, 0x11, 0xa4, 0x10, 0x33, 0x99, 0xf0, 0x19, 0xf6, 0xb6,
-	0x9c, 0xed, 0xe3, 0xf6, 0xe8, 0xc8, 0x68, 0xf3, 0x42, 0xe1, 0xcf, 0x36, 0x74, 0x69, 0xb8, 0x37,
-	0xbd, 0x51, 0x47, 0x9a, 0x90, 0x1d, 0xb8, 0x08, 0xcf, 0xd9, 0x2c, 0x86, 0x40, 0x30, 0x09, 0xd8,
-	0xdb, 0x56, 0xbe, 0x0f, 0x8d, 0xbe, 0xcf, 0x4b, 0xd4, 0x67, 0x12, 0xde, 0x15, 0x79, 0x0a, 0xe3,
-	0xfe, 0xda, 0xf8, 0xeb, 0xcf, 0x03, 0xfa, 0xd7, 0x13, 0xfa, 0x5d, 0xa8, 0xd5, 0x90, 0xbe, 0x22,
-	0xdd, 0x2c, 0x41, 0x0c, 0x42, 0x5e, 0xcc, 0x24, 0x08, 0xec, 0x35, 0x55, 0x92, 0x63, 0x4c, 0x7a,
-	0x93, 0x20, 0x3e, 0xd5, 0x60, 0xd9, 0x7c, 0x27, 0xab, 0x4a, 0x48, 0x3f, 0x5b, 0xc4, 0x61, 0x71,
-	0x2c, 0xd6, 0x83, 0x40, 0xf0, 0xc7, 0x08, 0x41, 0x2e, 0x60, 0xce, 0xd7, 0xa3, 0xdc, 0x51, 0x01,
-	0x43, 0x63, 0xc0, 0xc9, 0xb5, 0xb8, 0xde, 0xf8, 0xa9, 0x56, 0x96, 0x89, 0xfb, 0xec, 0x1f, 0x0c,
-	0xd2, 0x4f, 0x64, 0xff, 0xb6, 0x0e, 0x74, 0x7c, 0x4b, 0xc5, 0xbb, 0xff, 0x1f, 0xff, 0xbe, 0xca,
-	0xee, 0xb3, 0xdb, 0x00, 0x1c, 0x4c, 0xc9, 0xee, 0xcd, 0x05, 0xd3, 0x23, 0xb2, 0x53, 0xfe, 0x23,
-	0x2c, 0x8a, 0x04, 0xa0, 0xfe, 0xcd, 0xee, 0xfa, 0x5d, 0x5d, 0x3d, 0xd1, 0x45, 0xfa, 0x88, 0xec,
-	0xcd, 0x59, 0x9a, 0x44, 0x4c, 0xf2, 0x8a, 0xdc, 0x52, 0xe4, 0xee, 0xe6, 0xa1, 0x84, 0x07, 0x1f,
-	0x48, 0xbb, 0xb6, 0x06, 0xb3, 0xd6, 0x32, 0x6b, 0xe9, 0x21, 0xe9, 0xd4, 0x97, 0xad, 0x32, 0x9a,
-	0x7e, 0xbb, 0xb6, 0xc3, 0xf1, 0xeb, 0xcb, 0xa5, 0x6d, 0x5d, 0x2d, 0x6d, 0xeb, 0xd7, 0xd2, 0xb6,
-	0xbe, 0xac, 0xec, 0xc6, 0xd5, 0xca, 0x6e, 0x7c, 0x5b, 0xd9, 0x8d, 0xb3, 0x51, 0x9c, 0xc8, 0xf3,
-	0x62, 0xe2, 0x86, 0x3c, 0xf3, 0x20, 0x5d, 0x60, 0x52, 0x64, 0x28, 0xd5, 0x9c, 0x5e, 0x75, 0x78,
-	0x17, 0xd7, 0xa7, 0x27, 0x17, 0x39, 0xe0, 0xa4, 0xa5, 0xee, 0xee, 0xf1, 0xef, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x33, 0x55, 0x4b, 0x1d, 0xe9, 0x03, 0x00, 0x00,
+	// 631 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4d, 0x4f, 0x14, 0x4d,
+	0x10, 0xc7, 0x77, 0x78, 0x7b, 0xa0, 0x77, 0x21, 0xd0, 0xf0, 0xe8, 0xb8, 0x86, 0x65, 0xd9, 0x04,
+	0x83, 0x21, 0xce, 0x06, 0x3c, 0x71, 0x04, 0xdf, 0x62, 0xd4, 0x04, 0x57, 0xc2, 0x81, 0x68, 0x26,
+	0xcd, 0x6c, 0xed, 0xd0, 0x71, 0x5e, 0xd6, 0xae, 0x9e, 0x91, 0xbd, 0x79, 0xf6, 0xe4, 0xe7, 0xf0,
+	0x93, 0x70, 0xe4, 0xe8, 0x49, 0x0d, 0x7c, 0x11, 0x33, 0xdd, 0xbd, 0x3b, 0x03, 0x0e, 0x1b, 0x6f,
+	0x33, 0x55, 0xff, 0xfa, 0xff, 0xaa, 0x5f, 0xaa, 0xc9, 0xfa, 0x49, 0xc0, 0xbc, 0x8f, 0xbd, 0x44,
+	0x0c, 0xda, 0xb1, 0x60, 0x5e, 0x00, 0xed, 0x74, 0xbb, 0xed, 0x43, 0x04, 0xc8, 0xd1, 0xe9, 0x8b,
+	0x58, 0xc6, 0x74, 0x79, 0x24, 0x71, 0xb4, 0xc4, 0x49, 0xb7, 0xeb, 0x2b, 0x7e, 0xec, 0xc7, 0x2a,
+	0xdf, 0xce, 0xbe, 0xb4, 0xb4, 0xde, 0x2c, 0x73, 0x33, 0x45, 0x4a, 0xd1, 0xfa, 0x3a, 0x4b, 0x6a,
+	0x2f, 0xb4, 0xfd, 0x3b, 0xc9, 0x24, 0xd0, 0x5d, 0x32, 0xd3, 0x67, 0x82, 0x85, 0x68, 0x5b, 0x4d,
+	0x6b, 0xb3, 0xba, 0x73, 0xdf, 0x29, 0xc1, 0x39, 0x07, 0x4a, 0xb2, 0x3f, 0x75, 0xfe, 0x73, 0xad,
+	0xd2, 0x31, 0x05, 0xf4, 0x98, 0xd0, 0x1e, 0x40, 0x17, 0x84, 0xdb, 0x85, 0x00, 0x7c, 0x26, 0x79,
+	0x1c, 0xa1, 0x3d, 0xd1, 0x9c, 0xdc, 0xac, 0xee, 0x6c, 0x94, 0xda, 0x3c, 0x57, 0xf2, 0xa7, 0x23,
+	0xb5, 0x31, 0x5c, 0xea, 0xdd, 0x88, 0x23, 0xe5, 0x64, 0x01, 0xce, 0xbc, 0x53, 0x16, 0xf9, 0xe0,
+	0x0a, 0x26, 0x01, 0xed, 0x49, 0xe5, 0xfb, 0xa0, 0xd4, 0xf7, 0x99, 0x91, 0x76, 0x98, 0x84, 0xc3,
+	0xa4, 0x1f, 0xc0, 0x7e, 0x3d, 0x33, 0xfe, 0xfe, 0x6b, 0x8d, 0xfe, 0x95, 0xc2, 0xce, 0x3c, 0x14,
+	0x62, 0x48, 0x5f, 0x91, 0xf9, 0x90, 0x23, 0xba, 0x5e, 0x9c, 0x44, 0x12, 0x04, 0xda, 0x53, 0x8a,
+	0xd4, 0x2c, 0x25, 0xbd, 0xe1, 0x88, 0x4f, 0xb4, 0xd0, 0x34, 0x5f, 0x0b, 0xf3, 0x10, 0xd2, 0x2f,
+	0x16, 0x69, 0x32, 0xdf, 0x17, 0xd9, 0x42, 0xc0, 0xbd, 0xb6, 0x04, 0xb7, 0x2f, 0x20, 0x8d, 0xb3,
+	0xa5, 0x4c, 0x2b, 0xc0, 0x76, 0x29, 0x60, 0x6f, 0x58, 0x5c, 0x6c, 0xfc, 0x40, 0x57, 0x1a, 0xe2,
+	0x2a, 0x1b, 0xa3, 0x41, 0xfa, 0x99, 0xac, 0xde, 0xd6, 0x81, 0xc6, 0xcf, 0x28, 0xbc, 0xf3, 0xef,
+	0xf8, 0xa3, 0x9c, 0x5d, 0x67, 0xb7, 0x09, 0x90, 0xf6, 0xc8, 0x9d, 0x94, 0x05, 0xbc, 0xcb, 0x64,
+	0x2c, 0xdc, 0x3e, 0x88, 0x5e, 0x2c, 0x42, 0x16, 0x79, 0x80, 0xf6, 0x7f, 0x8a, 0xf8, 0xb0, 0x94,
+	0x78, 0x34, 0x2c, 0x39, 0xc8, 0x2b, 0x0c, 0xec, 0xff, 0xb4, 0x24, 0x87, 0xf4, 0x3d, 0x59, 0x2e,
+	0x72, 0x22, 0x16, 0x48, 0x0e, 0x68, 0xcf, 0x8e, 0xb9, 0x78, 0x05, 0x48, 0x26, 0x1f, 0x18, 0x00,
+	0x4d, 0xaf, 0xc7, 0xb9, 0xbe, 0x0e, 0x92, 0x09, 0x1f, 0xa4, 0xfb, 0x29, 0x51, 0xdb, 0x35, 0x37,
+	0xe6, 0x3a, 0x1c, 0x2a, 0xe5, 0xdb, 0x24, 0xdf, 0xa0, 0x9a, 0xcc, 0x43, 0x48, 0x1f, 0x11, 0xca,
+	0xb3, 0x8b, 0xe1, 0x9d, 0x32, 0x1e, 0xb9, 0x3a, 0x85, 0x36, 0x69, 0x4e, 0x6e, 0xce, 0x75, 0x96,
+	0xf2, 0x8c, 0xb6, 0x41, 0x1a, 0x92, 0x7b, 0x05, 0xf9, 0x8d, 0x01, 0xa8, 0xaa, 0x3e, 0xb6, 0x4a,
+	0xfb, 0x78, 0x39, 0xaa, 0x2a, 0x1e, 0x8b, 0x69, 0xe9, 0x2e, 0x2f, 0xcd, 0x62, 0xab, 0x47, 0x16,
+	0x6f, 0x4e, 0x24, 0xdd, 0x20, 0x0b, 0x66, 0xa8, 0x59, 0xb7, 0x2b, 0x00, 0xf5, 0xbb, 0x30, 0xd7,
+	0x99, 0xd7, 0xd1, 0x3d, 0x1d, 0xa4, 0x5b, 0x64, 0x29, 0x3f, 0x83, 0xa1, 0x72, 0x42, 0x29, 0x17,
+	0x47, 0x09, 0x23, 0x6e, 0x7d, 0x20, 0xd5, 0xc2, 0xdc, 0x94, 0xd7, 0x5a, 0xe5, 0xb5, 0x74, 0x9d,
+	0xd4, 0x8a, 0xd3, 0xa9, 0x18, 0x53, 0x9d, 0x6a, 0x61, 0xe8, 0x5a, 0xbb, 0xa4, 0x5a, 0x38, 0x07,
+	0xba, 0x42, 0xa6, 0xbb, 0x10, 0xc5, 0xa1, 0xb1, 0xd4, 0x3f, 0x59, 0x54, 0x9d, 0xa7, 0x69, 0x52,
+	0xff, 0xec, 0xbf, 0x3e, 0xbf, 0x6c, 0x58, 0x17, 0x97, 0x0d, 0xeb, 0xf7, 0x65, 0xc3, 0xfa, 0x76,
+	0xd5, 0xa8, 0x5c, 0x5c, 0x35, 0x2a, 0x3f, 0xae, 0x1a, 0x95, 0xe3, 0x1d, 0x9f, 0xcb, 0xd3, 0xe4,
+	0xc4, 0xf1, 0xe2, 0xb0, 0x0d, 0xc1, 0x00, 0x79, 0x12, 0xa2, 0x54, 0x5b, 0xd4, 0xce, 0x1f, 0xd9,
+	0xb3, 0xe1, 0x33, 0x2b, 0x07, 0x7d, 0xc0, 0x93, 0x19, 0xf5, 0xc6, 0x3e, 0xfe, 0x33, 0x00, 0xf0,
+	0xe8, 0xf9, 0x97, 0xd5, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -279,6 +384,71 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.InterchainExchangeRates) > 0 {
+		for iNdEx := len(m.InterchainExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InterchainExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.InterchainTargets) > 0 {
+		for iNdEx := len(m.InterchainTargets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.InterchainTargets[iNdEx])
+			copy(dAtA[i:], m.InterchainTargets[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.InterchainTargets[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.TargetQuotes) > 0 {
+		for iNdEx := len(m.TargetQuotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TargetQuotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ValidatorPenalties) > 0 {
+		for iNdEx := len(m.ValidatorPenalties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorPenalties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ValidatorPerformances) > 0 {
+		for iNdEx := len(m.ValidatorPerformances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorPerformances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.AggregateExchangeRateVotes) > 0 {
 		for iNdEx := len(m.AggregateExchangeRateVotes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -434,6 +604,43 @@ func (m *MissCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TargetQuote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TargetQuote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TargetQuote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -483,6 +690,36 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ValidatorPerformances) > 0 {
+		for _, e := range m.ValidatorPerformances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorPenalties) > 0 {
+		for _, e := range m.ValidatorPenalties {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TargetQuotes) > 0 {
+		for _, e := range m.TargetQuotes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InterchainTargets) > 0 {
+		for _, s := range m.InterchainTargets {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InterchainExchangeRates) > 0 {
+		for _, e := range m.InterchainExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -519,6 +756,23 @@ func (m *MissCounter) Size() (n int) {
 	return n
 }
 
+func (m *TargetQuote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -757,6 +1011,174 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPerformances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorPerformances = append(m.ValidatorPerformances, ValidatorPerformance{})
+			if err := m.ValidatorPerformances[len(m.ValidatorPerformances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPenalties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorPenalties = append(m.ValidatorPenalties, ValidatorPenalty{})
+			if err := m.ValidatorPenalties[len(m.ValidatorPenalties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetQuotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetQuotes = append(m.TargetQuotes, TargetQuote{})
+			if err := m.TargetQuotes[len(m.TargetQuotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterchainTargets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterchainTargets = append(m.InterchainTargets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterchainExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterchainExchangeRates = append(m.InterchainExchangeRates, InterchainExchangeRate{})
+			if err := m.InterchainExchangeRates[len(m.InterchainExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -993,6 +1415,120 @@ func (m *MissCounter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TargetQuote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TargetQuote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TargetQuote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/elysiumstation/blackfury/x/oracle/types"
 	"github.com/stretchr/testify/require"
 )
@@ -13,4 +14,14 @@ func TestGenesisValidation(t *testing.T) {
 
 	genState.Params.VotePeriod = 0
 	require.Error(t, genState.Validate())
+
+	genState = types.DefaultGenesis()
+	genState.TargetQuotes = []types.TargetQuote{{Denom: "foo", Quote: ""}}
+	require.Error(t, genState.Validate())
+
+	genState = types.DefaultGenesis()
+	genState.InterchainExchangeRates = []types.InterchainExchangeRate{{Denom: "foo", ExchangeRate: sdk.ZeroDec()}}
+	require.Error(t, genState.Validate())
+	genState.InterchainExchangeRates[0].ExchangeRate = sdk.OneDec()
+	require.NoError(t, genState.Validate())
 }
@@ -20,6 +20,12 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_oracle"
+
+	// PortID is the default port id that the interchain oracle channel binds to
+	PortID = ModuleName
+
+	// Version defines the current version of the interchain oracle channel
+	Version = "blackfury-oracle-1"
 )
 
 // Prefix keys for oracle module store
@@ -31,6 +37,12 @@ var (
 	AggregateExchangeRateVoteKey    = []byte{0x05} // prefix for each key to a aggregate vote
 	VoteTargetKey                   = []byte{0x06} // prefix for each key to a vote target
 	TargetKey                       = []byte{0x07} // prefix for each key to a target
+	ValidatorPerformanceKey         = []byte{0x08} // prefix for each key to a validator performance
+	ValidatorPenaltyKey             = []byte{0x09} // prefix for each key to a validator penalty
+	TargetQuoteKey                  = []byte{0x0A} // prefix for each key to a target quote
+	QuoteExchangeRateKey            = []byte{0x0B} // prefix for each key to a quote exchange rate
+	InterchainTargetKey             = []byte{0x0C} // prefix for each key to an interchain target
+	InterchainExchangeRateKey       = []byte{0x0D} // prefix for each key to an interchain exchange rate
 )
 
 // GetExchangeRateKey - stored by *denom*
@@ -48,6 +60,21 @@ func GetMissCounterKey(v sdk.ValAddress) []byte {
 	return append(MissCounterKey, address.MustLengthPrefix(v)...)
 }
 
+// GetValidatorPerformancePrefix - stored by *Validator* address
+func GetValidatorPerformancePrefix(v sdk.ValAddress) []byte {
+	return append(ValidatorPerformanceKey, address.MustLengthPrefix(v)...)
+}
+
+// GetValidatorPerformanceKey - stored by *Validator* address and slash window
+func GetValidatorPerformanceKey(v sdk.ValAddress, window uint64) []byte {
+	return append(GetValidatorPerformancePrefix(v), sdk.Uint64ToBigEndian(window)...)
+}
+
+// GetValidatorPenaltyKey - stored by *Validator* address
+func GetValidatorPenaltyKey(v sdk.ValAddress) []byte {
+	return append(ValidatorPenaltyKey, address.MustLengthPrefix(v)...)
+}
+
 // GetAggregateExchangeRatePrevoteKey - stored by *Validator* address
 func GetAggregateExchangeRatePrevoteKey(v sdk.ValAddress) []byte {
 	return append(AggregateExchangeRatePrevoteKey, address.MustLengthPrefix(v)...)
@@ -68,6 +95,26 @@ func GetTargetKey(d string) []byte {
 	return append(TargetKey, []byte(d)...)
 }
 
+// GetTargetQuoteKey - stored by *denom* bytes
+func GetTargetQuoteKey(d string) []byte {
+	return append(TargetQuoteKey, []byte(d)...)
+}
+
+// GetQuoteExchangeRateKey - stored by *denom* bytes
+func GetQuoteExchangeRateKey(d string) []byte {
+	return append(QuoteExchangeRateKey, []byte(d)...)
+}
+
+// GetInterchainTargetKey - stored by *denom* bytes
+func GetInterchainTargetKey(d string) []byte {
+	return append(InterchainTargetKey, []byte(d)...)
+}
+
+// GetInterchainExchangeRateKey - stored by *denom* bytes
+func GetInterchainExchangeRateKey(d string) []byte {
+	return append(InterchainExchangeRateKey, []byte(d)...)
+}
+
 // ExtractDenomFromVoteTargetKey - split denom from the vote target key
 func ExtractDenomFromVoteTargetKey(key []byte) (denom string) {
 	denom = string(key[1:])
@@ -8,15 +8,20 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -24,6 +29,43 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PenaltyTier enumerates the graduated oracle penalty tiers of a validator.
+type PenaltyTier int32
+
+const (
+	// PENALTY_TIER_NONE defines no penalty.
+	PENALTY_TIER_NONE PenaltyTier = 0
+	// PENALTY_TIER_WARNING defines a warning without slashing.
+	PENALTY_TIER_WARNING PenaltyTier = 1
+	// PENALTY_TIER_SLASH defines slashing by the minor slash fraction.
+	PENALTY_TIER_SLASH PenaltyTier = 2
+	// PENALTY_TIER_SLASH_AND_JAIL defines slashing by the slash fraction and
+	// jailing for the jail duration.
+	PENALTY_TIER_SLASH_AND_JAIL PenaltyTier = 3
+)
+
+var PenaltyTier_name = map[int32]string{
+	0: "PENALTY_TIER_NONE",
+	1: "PENALTY_TIER_WARNING",
+	2: "PENALTY_TIER_SLASH",
+	3: "PENALTY_TIER_SLASH_AND_JAIL",
+}
+
+var PenaltyTier_value = map[string]int32{
+	"PENALTY_TIER_NONE":           0,
+	"PENALTY_TIER_WARNING":        1,
+	"PENALTY_TIER_SLASH":          2,
+	"PENALTY_TIER_SLASH_AND_JAIL": 3,
+}
+
+func (x PenaltyTier) String() string {
+	return proto.EnumName(PenaltyTier_name, int32(x))
+}
+
+func (PenaltyTier) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_591637947d94e855, []int{0}
+}
+
 // TargetSource enumerates the quotation source of a target asset.
 type TargetSource int32
 
@@ -63,7 +105,7 @@ func (x TargetSource) String() string {
 }
 
 func (TargetSource) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_591637947d94e855, []int{0}
+	return fileDescriptor_591637947d94e855, []int{1}
 }
 
 // Params defines the parameters for the oracle module.
@@ -75,6 +117,21 @@ type Params struct {
 	SlashFraction            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
 	SlashWindow              uint64                                 `protobuf:"varint,6,opt,name=slash_window,json=slashWindow,proto3" json:"slash_window,omitempty" yaml:"slash_window"`
 	MinValidPerWindow        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=min_valid_per_window,json=minValidPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_valid_per_window" yaml:"min_valid_per_window"`
+	PerformanceWindows       uint64                                 `protobuf:"varint,8,opt,name=performance_windows,json=performanceWindows,proto3" json:"performance_windows,omitempty" yaml:"performance_windows"`
+	// # of consecutive bad slash windows in which a validator is only warned
+	WarningWindows uint64 `protobuf:"varint,9,opt,name=warning_windows,json=warningWindows,proto3" json:"warning_windows,omitempty" yaml:"warning_windows"`
+	// # of consecutive bad slash windows after the warning ones in which a
+	// validator is slashed by minor_slash_fraction without jailing
+	MinorSlashWindows  uint64                                 `protobuf:"varint,10,opt,name=minor_slash_windows,json=minorSlashWindows,proto3" json:"minor_slash_windows,omitempty" yaml:"minor_slash_windows"`
+	MinorSlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=minor_slash_fraction,json=minorSlashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"minor_slash_fraction" yaml:"minor_slash_fraction"`
+	JailDuration       time.Duration                          `protobuf:"bytes,12,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration" yaml:"jail_duration"`
+	// IBC light client of the counterparty oracle chain; empty to disable
+	// interchain oracle
+	InterchainOracleClientId string `protobuf:"bytes,13,opt,name=interchain_oracle_client_id,json=interchainOracleClientId,proto3" json:"interchain_oracle_client_id,omitempty" yaml:"interchain_oracle_client_id"`
+	// IBC channel subscribing to the counterparty oracle chain
+	InterchainOracleChannelId string `protobuf:"bytes,14,opt,name=interchain_oracle_channel_id,json=interchainOracleChannelId,proto3" json:"interchain_oracle_channel_id,omitempty" yaml:"interchain_oracle_channel_id"`
+	// max age of the exchange rates from the interchain oracle
+	InterchainMaxPriceAge time.Duration `protobuf:"bytes,15,opt,name=interchain_max_price_age,json=interchainMaxPriceAge,proto3,stdduration" json:"interchain_max_price_age" yaml:"interchain_max_price_age"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -130,6 +187,55 @@ func (m *Params) GetSlashWindow() uint64 {
 	return 0
 }
 
+func (m *Params) GetPerformanceWindows() uint64 {
+	if m != nil {
+		return m.PerformanceWindows
+	}
+	return 0
+}
+
+func (m *Params) GetWarningWindows() uint64 {
+	if m != nil {
+		return m.WarningWindows
+	}
+	return 0
+}
+
+func (m *Params) GetMinorSlashWindows() uint64 {
+	if m != nil {
+		return m.MinorSlashWindows
+	}
+	return 0
+}
+
+func (m *Params) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetInterchainOracleClientId() string {
+	if m != nil {
+		return m.InterchainOracleClientId
+	}
+	return ""
+}
+
+func (m *Params) GetInterchainOracleChannelId() string {
+	if m != nil {
+		return m.InterchainOracleChannelId
+	}
+	return ""
+}
+
+func (m *Params) GetInterchainMaxPriceAge() time.Duration {
+	if m != nil {
+		return m.InterchainMaxPriceAge
+	}
+	return 0
+}
+
 // AggregateExchangeRatePrevote represents the aggregate prevoting on the
 // ExchangeRateVote. The purpose of aggregate prevoting is to hide vote exchange
 // rates with hash which is formatted as hex string in SHA256("{salt}:{exchange
@@ -249,6 +355,187 @@ func (m *ExchangeRateTuple) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExchangeRateTuple proto.InternalMessageInfo
 
+// QuoteExchangeRate represents the exchange rate of a target denominated in
+// its quote currency, which is derived through the reference denom uusd.
+type QuoteExchangeRate struct {
+	Denom        string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Quote        string                                 `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty" yaml:"quote"`
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate" yaml:"exchange_rate"`
+}
+
+func (m *QuoteExchangeRate) Reset()         { *m = QuoteExchangeRate{} }
+func (m *QuoteExchangeRate) String() string { return proto.CompactTextString(m) }
+func (*QuoteExchangeRate) ProtoMessage()    {}
+func (*QuoteExchangeRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_591637947d94e855, []int{4}
+}
+func (m *QuoteExchangeRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuoteExchangeRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuoteExchangeRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuoteExchangeRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuoteExchangeRate.Merge(m, src)
+}
+func (m *QuoteExchangeRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuoteExchangeRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuoteExchangeRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuoteExchangeRate proto.InternalMessageInfo
+
+// InterchainExchangeRate represents the exchange rate of a target received
+// from the interchain oracle.
+type InterchainExchangeRate struct {
+	Denom        string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate" yaml:"exchange_rate"`
+	// time at which the exchange rate was quoted on the counterparty chain
+	Timestamp time.Time `protobuf:"bytes,3,opt,name=timestamp,proto3,stdtime" json:"timestamp" yaml:"timestamp"`
+}
+
+func (m *InterchainExchangeRate) Reset()         { *m = InterchainExchangeRate{} }
+func (m *InterchainExchangeRate) String() string { return proto.CompactTextString(m) }
+func (*InterchainExchangeRate) ProtoMessage()    {}
+func (*InterchainExchangeRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_591637947d94e855, []int{5}
+}
+func (m *InterchainExchangeRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterchainExchangeRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterchainExchangeRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterchainExchangeRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterchainExchangeRate.Merge(m, src)
+}
+func (m *InterchainExchangeRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterchainExchangeRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterchainExchangeRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterchainExchangeRate proto.InternalMessageInfo
+
+// ValidatorPerformance represents the oracle voting statistics of a validator
+// in a slash window.
+type ValidatorPerformance struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// slash window index, i.e., block height / slash window
+	Window uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty" yaml:"window"`
+	// number of vote periods tallied while the validator is bonded
+	VotePeriods uint64 `protobuf:"varint,3,opt,name=vote_periods,json=votePeriods,proto3" json:"vote_periods,omitempty" yaml:"vote_periods"`
+	// number of vote periods in which the validator submitted a vote
+	Votes uint64 `protobuf:"varint,4,opt,name=votes,proto3" json:"votes,omitempty" yaml:"votes"`
+	// number of votes abstaining from all denoms
+	Abstains uint64 `protobuf:"varint,5,opt,name=abstains,proto3" json:"abstains,omitempty" yaml:"abstains"`
+	// number of ballots won
+	WinCount uint64 `protobuf:"varint,6,opt,name=win_count,json=winCount,proto3" json:"win_count,omitempty" yaml:"win_count"`
+	// number of vote periods counted as missed
+	Misses uint64 `protobuf:"varint,7,opt,name=misses,proto3" json:"misses,omitempty" yaml:"misses"`
+	// number of exchange rates compared with the weighted medians
+	DeviationCount uint64 `protobuf:"varint,8,opt,name=deviation_count,json=deviationCount,proto3" json:"deviation_count,omitempty" yaml:"deviation_count"`
+	// mean relative deviation of the exchange rates from the weighted medians
+	MeanDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=mean_deviation,json=meanDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mean_deviation" yaml:"mean_deviation"`
+}
+
+func (m *ValidatorPerformance) Reset()         { *m = ValidatorPerformance{} }
+func (m *ValidatorPerformance) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformance) ProtoMessage()    {}
+func (*ValidatorPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_591637947d94e855, []int{6}
+}
+func (m *ValidatorPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformance.Merge(m, src)
+}
+func (m *ValidatorPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPerformance proto.InternalMessageInfo
+
+// ValidatorPenalty represents the current oracle penalty tier of a validator.
+type ValidatorPenalty struct {
+	ValidatorAddress string      `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	Tier             PenaltyTier `protobuf:"varint,2,opt,name=tier,proto3,enum=blackfury.oracle.v1.PenaltyTier" json:"tier,omitempty" yaml:"tier"`
+	// # of consecutive slash windows below the min valid vote rate
+	ConsecutiveBadWindows uint64 `protobuf:"varint,3,opt,name=consecutive_bad_windows,json=consecutiveBadWindows,proto3" json:"consecutive_bad_windows,omitempty" yaml:"consecutive_bad_windows"`
+	// the last slash window below the min valid vote rate
+	LastBadWindow uint64 `protobuf:"varint,4,opt,name=last_bad_window,json=lastBadWindow,proto3" json:"last_bad_window,omitempty" yaml:"last_bad_window"`
+}
+
+func (m *ValidatorPenalty) Reset()         { *m = ValidatorPenalty{} }
+func (m *ValidatorPenalty) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPenalty) ProtoMessage()    {}
+func (*ValidatorPenalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_591637947d94e855, []int{7}
+}
+func (m *ValidatorPenalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPenalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPenalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPenalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPenalty.Merge(m, src)
+}
+func (m *ValidatorPenalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPenalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPenalty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPenalty proto.InternalMessageInfo
+
 // RegisterTargetProposal is a gov Content type to register eligible
 // target asset which will be price quoted.
 type RegisterTargetProposal struct {
@@ -264,7 +551,7 @@ func (m *RegisterTargetProposal) Reset()         { *m = RegisterTargetProposal{}
 func (m *RegisterTargetProposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterTargetProposal) ProtoMessage()    {}
 func (*RegisterTargetProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_591637947d94e855, []int{4}
+	return fileDescriptor_591637947d94e855, []int{8}
 }
 func (m *RegisterTargetProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -321,13 +608,19 @@ type TargetParams struct {
 	Source TargetSource `protobuf:"varint,2,opt,name=source,proto3,enum=blackfury.oracle.v1.TargetSource" json:"source,omitempty"`
 	// quotation source DEX contract address
 	SourceDexContract string `protobuf:"bytes,3,opt,name=source_dex_contract,json=sourceDexContract,proto3" json:"source_dex_contract,omitempty"`
+	// denom of the quote currency in which the target is priced, e.g., ueur or
+	// a CPI index; empty for the reference denom uusd
+	Quote string `protobuf:"bytes,4,opt,name=quote,proto3" json:"quote,omitempty"`
+	// whether the target is an off-chain currency or index without on-chain
+	// supply, e.g., ueur, which is usually a quote of other targets
+	OffChain bool `protobuf:"varint,5,opt,name=off_chain,json=offChain,proto3" json:"off_chain,omitempty"`
 }
 
 func (m *TargetParams) Reset()         { *m = TargetParams{} }
 func (m *TargetParams) String() string { return proto.CompactTextString(m) }
 func (*TargetParams) ProtoMessage()    {}
 func (*TargetParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_591637947d94e855, []int{5}
+	return fileDescriptor_591637947d94e855, []int{9}
 }
 func (m *TargetParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -377,12 +670,31 @@ func (m *TargetParams) GetSourceDexContract() string {
 	return ""
 }
 
+func (m *TargetParams) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
+}
+
+func (m *TargetParams) GetOffChain() bool {
+	if m != nil {
+		return m.OffChain
+	}
+	return false
+}
+
 func init() {
+	proto.RegisterEnum("blackfury.oracle.v1.PenaltyTier", PenaltyTier_name, PenaltyTier_value)
 	proto.RegisterEnum("blackfury.oracle.v1.TargetSource", TargetSource_name, TargetSource_value)
 	proto.RegisterType((*Params)(nil), "blackfury.oracle.v1.Params")
 	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "blackfury.oracle.v1.AggregateExchangeRatePrevote")
 	proto.RegisterType((*AggregateExchangeRateVote)(nil), "blackfury.oracle.v1.AggregateExchangeRateVote")
 	proto.RegisterType((*ExchangeRateTuple)(nil), "blackfury.oracle.v1.ExchangeRateTuple")
+	proto.RegisterType((*QuoteExchangeRate)(nil), "blackfury.oracle.v1.QuoteExchangeRate")
+	proto.RegisterType((*InterchainExchangeRate)(nil), "blackfury.oracle.v1.InterchainExchangeRate")
+	proto.RegisterType((*ValidatorPerformance)(nil), "blackfury.oracle.v1.ValidatorPerformance")
+	proto.RegisterType((*ValidatorPenalty)(nil), "blackfury.oracle.v1.ValidatorPenalty")
 	proto.RegisterType((*RegisterTargetProposal)(nil), "blackfury.oracle.v1.RegisterTargetProposal")
 	proto.RegisterType((*TargetParams)(nil), "blackfury.oracle.v1.TargetParams")
 }
@@ -390,64 +702,112 @@ func init() {
 func init() { proto.RegisterFile("blackfury/oracle/v1/oracle.proto", fileDescriptor_591637947d94e855) }
 
 var fileDescriptor_591637947d94e855 = []byte{
-	// 906 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xb6, 0xb7, 0x69, 0x61, 0x27, 0xe9, 0x92, 0x4e, 0xb3, 0x4b, 0xb6, 0x94, 0x38, 0xeb, 0x15,
-	0x55, 0x85, 0x44, 0xa2, 0x2d, 0x07, 0xb4, 0xb9, 0xe5, 0x57, 0x97, 0x48, 0xa5, 0x8d, 0xa6, 0xd9,
-	0x82, 0xb8, 0x58, 0x13, 0x7b, 0xd6, 0xb1, 0x6a, 0x7b, 0xa2, 0x99, 0x49, 0x7f, 0x5c, 0x38, 0xef,
-	0x11, 0x09, 0x21, 0x71, 0xac, 0x04, 0x27, 0x2e, 0x1c, 0x10, 0xfc, 0x0d, 0x7b, 0xdc, 0x23, 0xe2,
-	0x10, 0x50, 0x7b, 0x80, 0x73, 0xfe, 0x02, 0x34, 0x33, 0x4e, 0xeb, 0xb4, 0x01, 0x51, 0xed, 0xc9,
-	0x7e, 0xef, 0x7b, 0xfe, 0xde, 0xf7, 0xbe, 0x79, 0xb6, 0x41, 0xb9, 0x1f, 0x62, 0xf7, 0xf0, 0xc5,
-	0x88, 0x9d, 0x56, 0x29, 0xc3, 0x6e, 0x48, 0xaa, 0x47, 0x4f, 0x92, 0xbb, 0xca, 0x90, 0x51, 0x41,
-	0xe1, 0xea, 0x65, 0x45, 0x25, 0xc9, 0x1f, 0x3d, 0x59, 0x2b, 0xf8, 0xd4, 0xa7, 0x0a, 0xaf, 0xca,
-	0x3b, 0x5d, 0x6a, 0xff, 0xbc, 0x08, 0x96, 0xba, 0x98, 0xe1, 0x88, 0xc3, 0x4f, 0x40, 0xf6, 0x88,
-	0x0a, 0xe2, 0x0c, 0x09, 0x0b, 0xa8, 0x57, 0x34, 0xcb, 0xe6, 0x66, 0xa6, 0xf1, 0x60, 0x32, 0xb6,
-	0xe0, 0x29, 0x8e, 0xc2, 0x9a, 0x9d, 0x02, 0x6d, 0x04, 0x64, 0xd4, 0x55, 0x01, 0x8c, 0xc1, 0x3d,
-	0x85, 0x89, 0x01, 0x23, 0x7c, 0x40, 0x43, 0xaf, 0x78, 0xa7, 0x6c, 0x6e, 0xde, 0x6d, 0x3c, 0x7b,
-	0x35, 0xb6, 0x8c, 0xdf, 0xc7, 0xd6, 0x86, 0x1f, 0x88, 0xc1, 0xa8, 0x5f, 0x71, 0x69, 0x54, 0x75,
-	0x29, 0x8f, 0x28, 0x4f, 0x2e, 0x1f, 0x71, 0xef, 0xb0, 0x2a, 0x4e, 0x87, 0x84, 0x57, 0x5a, 0xc4,
-	0x9d, 0x8c, 0xad, 0xfb, 0xa9, 0x4e, 0x97, 0x6c, 0x36, 0x5a, 0x96, 0x89, 0xde, 0x34, 0x86, 0x04,
-	0x64, 0x19, 0x39, 0xc6, 0xcc, 0x73, 0xfa, 0x38, 0xf6, 0x8a, 0x0b, 0xaa, 0x59, 0xeb, 0xd6, 0xcd,
-	0x92, 0xb1, 0x52, 0x54, 0x36, 0x02, 0x3a, 0x6a, 0xe0, 0xd8, 0x83, 0x2e, 0x58, 0x4b, 0x30, 0x2f,
-	0xe0, 0x82, 0x05, 0xfd, 0x91, 0x08, 0x68, 0xec, 0x1c, 0x07, 0xb1, 0x47, 0x8f, 0x8b, 0x19, 0x65,
-	0xcf, 0x07, 0x93, 0xb1, 0xf5, 0x68, 0x86, 0x67, 0x4e, 0xad, 0x8d, 0x8a, 0x1a, 0x6c, 0xa5, 0xb0,
-	0xcf, 0x15, 0x24, 0xbd, 0xe3, 0x21, 0xe6, 0x03, 0xe7, 0x05, 0xc3, 0xae, 0xcc, 0x17, 0x17, 0xdf,
-	0xcc, 0xbb, 0x59, 0x36, 0x1b, 0x2d, 0xab, 0xc4, 0x76, 0x12, 0xc3, 0x1a, 0xc8, 0xe9, 0x8a, 0x64,
-	0x8c, 0x25, 0x35, 0xc6, 0xbb, 0x93, 0xb1, 0xb5, 0x9a, 0x7e, 0x7e, 0x2a, 0x3c, 0xab, 0xc2, 0x44,
-	0xeb, 0x57, 0xa0, 0x10, 0x05, 0xb1, 0x73, 0x84, 0xc3, 0xc0, 0x93, 0x8b, 0x30, 0xe5, 0x78, 0x4b,
-	0x29, 0xfe, 0xec, 0xd6, 0x8a, 0xdf, 0xd3, 0x1d, 0xe7, 0x71, 0xda, 0x68, 0x25, 0x0a, 0xe2, 0x03,
-	0x99, 0xed, 0x12, 0xa6, 0xfb, 0xd7, 0xde, 0xfe, 0xee, 0xcc, 0x32, 0xfe, 0x3e, 0xb3, 0x4c, 0xfb,
-	0x17, 0x13, 0xac, 0xd7, 0x7d, 0x9f, 0x11, 0x1f, 0x0b, 0xd2, 0x3e, 0x71, 0x07, 0x38, 0xf6, 0x09,
-	0xc2, 0x82, 0x74, 0x19, 0x91, 0xbb, 0x02, 0x1f, 0x83, 0xcc, 0x00, 0xf3, 0x81, 0x5a, 0xe2, 0xbb,
-	0x8d, 0x77, 0x26, 0x63, 0x2b, 0xab, 0x9b, 0xc9, 0xac, 0x8d, 0x14, 0x08, 0x37, 0xc0, 0xa2, 0x2c,
-	0x66, 0xc9, 0xba, 0xe6, 0x27, 0x63, 0x2b, 0x77, 0xb5, 0x80, 0xcc, 0x46, 0x1a, 0x56, 0x9e, 0x8d,
-	0xfa, 0x51, 0x20, 0x9c, 0x7e, 0x48, 0xdd, 0x43, 0xb5, 0x70, 0xb3, 0x9e, 0xa5, 0x50, 0xe9, 0x99,
-	0x0a, 0x1b, 0x32, 0xaa, 0xe5, 0x5e, 0x9e, 0x59, 0x46, 0xa2, 0xdb, 0xb0, 0xff, 0x32, 0xc1, 0xc3,
-	0xb9, 0xba, 0x0f, 0xa4, 0xe8, 0x6f, 0x4c, 0x50, 0x20, 0x49, 0xd2, 0x61, 0x58, 0xbe, 0x03, 0xa3,
-	0x61, 0x48, 0x78, 0xd1, 0x2c, 0x2f, 0x6c, 0x66, 0xb7, 0x36, 0x2a, 0x73, 0x5e, 0xeb, 0x4a, 0x9a,
-	0xa5, 0x27, 0xcb, 0x1b, 0x4f, 0xe5, 0x41, 0x5c, 0xd9, 0x3b, 0x8f, 0xd1, 0xfe, 0xf1, 0x0f, 0x0b,
-	0xde, 0x78, 0x92, 0x23, 0x48, 0x6e, 0xe4, 0xfe, 0xaf, 0x4b, 0xd7, 0x26, 0xfd, 0xd5, 0x04, 0x2b,
-	0x37, 0x1a, 0x48, 0x2e, 0x8f, 0xc4, 0x34, 0x4a, 0xce, 0x25, 0xc5, 0xa5, 0xd2, 0x36, 0xd2, 0x30,
-	0x3c, 0x04, 0xcb, 0x33, 0xb2, 0x93, 0xde, 0xdb, 0xb7, 0x5e, 0xb1, 0xc2, 0x1c, 0x0f, 0x6c, 0x94,
-	0x4b, 0x8f, 0x79, 0x4d, 0xf8, 0x0f, 0x26, 0x78, 0x80, 0x88, 0x1f, 0x70, 0x41, 0x58, 0x0f, 0x33,
-	0x9f, 0x88, 0x2e, 0xa3, 0x43, 0xca, 0x71, 0x08, 0x0b, 0x60, 0x51, 0x04, 0x22, 0x24, 0x5a, 0x3d,
-	0xd2, 0x01, 0x2c, 0x83, 0xac, 0x47, 0xb8, 0xcb, 0x82, 0xa1, 0x7a, 0x7d, 0x95, 0x52, 0x94, 0x4e,
-	0xc1, 0x1d, 0xb0, 0x2c, 0x14, 0x93, 0x33, 0x54, 0x5f, 0x5a, 0xb5, 0x40, 0xd9, 0xad, 0x47, 0x73,
-	0xcf, 0x33, 0xe9, 0xa9, 0x0a, 0x1b, 0x19, 0x39, 0x30, 0xca, 0x89, 0x54, 0xae, 0x96, 0x51, 0x32,
-	0xbf, 0x35, 0x41, 0x2e, 0x5d, 0x2a, 0xc5, 0xa5, 0xac, 0x9d, 0x1a, 0xf9, 0x14, 0x2c, 0x71, 0x3a,
-	0x62, 0xae, 0x76, 0xf0, 0xde, 0x7f, 0xf6, 0xdc, 0x57, 0x85, 0x28, 0x79, 0x00, 0x56, 0xc0, 0xaa,
-	0xbe, 0x73, 0x3c, 0x72, 0xe2, 0xb8, 0x34, 0x16, 0xf2, 0x1b, 0xa2, 0xbf, 0xb6, 0x68, 0x45, 0x43,
-	0x2d, 0x72, 0xd2, 0x4c, 0x00, 0xad, 0xeb, 0xc3, 0x9f, 0x2e, 0x75, 0x69, 0x3a, 0xf8, 0x3e, 0x78,
-	0xd8, 0xab, 0xa3, 0x67, 0xed, 0x9e, 0xb3, 0xbf, 0xf7, 0x1c, 0x35, 0xdb, 0xce, 0xf3, 0xdd, 0xfd,
-	0x6e, 0xbb, 0xd9, 0xd9, 0xee, 0xb4, 0x5b, 0x79, 0x03, 0xae, 0x83, 0xe2, 0x2c, 0x7c, 0x50, 0xdf,
-	0xe9, 0xb4, 0xea, 0xbd, 0x3d, 0xb4, 0x9f, 0x37, 0xe1, 0x7d, 0xb0, 0x32, 0x8b, 0xb6, 0xda, 0x5f,
-	0xe4, 0xef, 0xc0, 0x32, 0x58, 0x9f, 0x4d, 0x77, 0x76, 0x7b, 0x6d, 0xd4, 0xfc, 0xb4, 0xde, 0xd9,
-	0x55, 0x15, 0x0b, 0xf0, 0x31, 0xb0, 0xfe, 0xb5, 0x62, 0x0f, 0xd5, 0x9b, 0x3b, 0xed, 0x7c, 0x66,
-	0x2d, 0xf3, 0xf2, 0xfb, 0x92, 0xd1, 0xd8, 0x79, 0x75, 0x5e, 0x32, 0x5f, 0x9f, 0x97, 0xcc, 0x3f,
-	0xcf, 0x4b, 0xe6, 0xd7, 0x17, 0x25, 0xe3, 0xf5, 0x45, 0xc9, 0xf8, 0xed, 0xa2, 0x64, 0x7c, 0xb9,
-	0x95, 0x5a, 0x33, 0x12, 0x9e, 0xf2, 0x60, 0x14, 0x71, 0x81, 0xe5, 0x91, 0x56, 0xaf, 0x7e, 0xc1,
-	0x27, 0xd3, 0x9f, 0xb0, 0x5a, 0xbb, 0xfe, 0x92, 0xfa, 0xad, 0x7e, 0xfc, 0x4f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x6d, 0x93, 0x89, 0xa3, 0xa5, 0x07, 0x00, 0x00,
+	// 1672 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xbb, 0x73, 0x1b, 0xc7,
+	0x19, 0xc7, 0x91, 0x10, 0x4d, 0x2e, 0x08, 0x12, 0x5c, 0x42, 0xf4, 0x89, 0x62, 0x70, 0xf0, 0x6a,
+	0xc2, 0x28, 0xce, 0x04, 0x18, 0x31, 0x45, 0xc6, 0xec, 0xf0, 0x92, 0x8c, 0x0c, 0x0d, 0x22, 0x4b,
+	0x58, 0x4e, 0xdc, 0x5c, 0x16, 0x77, 0x0b, 0xe0, 0xa2, 0xc3, 0x1d, 0x72, 0x7b, 0xe0, 0xa3, 0x48,
+	0xdc, 0xaa, 0x8b, 0x67, 0xd2, 0xb8, 0xd4, 0x4c, 0x52, 0xa5, 0x49, 0x95, 0x94, 0xa9, 0x55, 0x79,
+	0x5c, 0x66, 0x5c, 0x9c, 0x33, 0x52, 0x91, 0xd4, 0xf8, 0x0b, 0x32, 0xfb, 0xc0, 0xe1, 0xf0, 0x90,
+	0x23, 0x45, 0x49, 0x2a, 0xde, 0x7e, 0xbf, 0xdf, 0x7e, 0xaf, 0xdb, 0xfb, 0xed, 0x47, 0x80, 0x62,
+	0xd7, 0x25, 0xd6, 0x93, 0xde, 0x38, 0xb8, 0x29, 0xfb, 0x01, 0xb1, 0x5c, 0x5a, 0xbe, 0x7c, 0xa0,
+	0x9e, 0x4a, 0xa3, 0xc0, 0x0f, 0x7d, 0xb8, 0x1f, 0x33, 0x4a, 0xca, 0x7e, 0xf9, 0xe0, 0x30, 0xdf,
+	0xf7, 0xfb, 0xbe, 0xc0, 0xcb, 0xfc, 0x49, 0x52, 0x0f, 0x0b, 0x7d, 0xdf, 0xef, 0xbb, 0xb4, 0x2c,
+	0x56, 0xdd, 0x71, 0xaf, 0x6c, 0x8f, 0x03, 0x12, 0x3a, 0xbe, 0xa7, 0x70, 0x63, 0x11, 0x0f, 0x9d,
+	0x21, 0x65, 0x21, 0x19, 0x8e, 0x24, 0x01, 0x7d, 0x99, 0x01, 0x1b, 0x6d, 0x12, 0x90, 0x21, 0x83,
+	0x3f, 0x06, 0x99, 0x4b, 0x3f, 0xa4, 0xe6, 0x88, 0x06, 0x8e, 0x6f, 0xeb, 0x5a, 0x51, 0xbb, 0x9f,
+	0xae, 0x1e, 0x4c, 0x22, 0x03, 0xde, 0x90, 0xa1, 0x7b, 0x8a, 0x12, 0x20, 0xc2, 0x80, 0xaf, 0xda,
+	0x62, 0x01, 0x3d, 0xb0, 0x23, 0xb0, 0x70, 0x10, 0x50, 0x36, 0xf0, 0x5d, 0x5b, 0x5f, 0x2b, 0x6a,
+	0xf7, 0xb7, 0xaa, 0x8f, 0x9e, 0x47, 0x46, 0xea, 0xeb, 0xc8, 0x38, 0xee, 0x3b, 0xe1, 0x60, 0xdc,
+	0x2d, 0x59, 0xfe, 0xb0, 0x6c, 0xf9, 0x6c, 0xe8, 0x33, 0xf5, 0xe7, 0x87, 0xcc, 0x7e, 0x52, 0x0e,
+	0x6f, 0x46, 0x94, 0x95, 0xea, 0xd4, 0x9a, 0x44, 0xc6, 0xed, 0x44, 0xa4, 0xd8, 0x1b, 0xc2, 0x59,
+	0x6e, 0xe8, 0x4c, 0xd7, 0x90, 0x82, 0x4c, 0x40, 0xaf, 0x48, 0x60, 0x9b, 0x5d, 0xe2, 0xd9, 0xfa,
+	0xba, 0x08, 0x56, 0x7f, 0xe3, 0x60, 0xaa, 0xac, 0x84, 0x2b, 0x84, 0x81, 0x5c, 0x55, 0x89, 0x67,
+	0x43, 0x0b, 0x1c, 0x2a, 0xcc, 0x76, 0x58, 0x18, 0x38, 0xdd, 0x31, 0x6f, 0xac, 0x79, 0xe5, 0x78,
+	0xb6, 0x7f, 0xa5, 0xa7, 0x45, 0x7b, 0xbe, 0x3b, 0x89, 0x8c, 0xf7, 0xe6, 0xfc, 0xac, 0xe0, 0x22,
+	0xac, 0x4b, 0xb0, 0x9e, 0xc0, 0x3e, 0x11, 0x10, 0xef, 0x1d, 0x73, 0x09, 0x1b, 0x98, 0xbd, 0x80,
+	0x58, 0xdc, 0xae, 0xdf, 0x7a, 0xbb, 0xde, 0xcd, 0x7b, 0x43, 0x38, 0x2b, 0x0c, 0x0f, 0xd5, 0x1a,
+	0x9e, 0x82, 0x6d, 0xc9, 0x50, 0x65, 0x6c, 0x88, 0x32, 0xde, 0x9d, 0x44, 0xc6, 0x7e, 0x72, 0xff,
+	0x34, 0xf1, 0x8c, 0x58, 0xaa, 0x5c, 0x7f, 0x03, 0xf2, 0x43, 0xc7, 0x33, 0x2f, 0x89, 0xeb, 0xd8,
+	0xfc, 0x20, 0x4c, 0x7d, 0xbc, 0x23, 0x32, 0xfe, 0xe8, 0x8d, 0x33, 0xbe, 0x2b, 0x23, 0xae, 0xf2,
+	0x89, 0xf0, 0xde, 0xd0, 0xf1, 0x1e, 0x73, 0x6b, 0x9b, 0x06, 0x2a, 0xfe, 0x39, 0xd8, 0x1f, 0xd1,
+	0xa0, 0xe7, 0x07, 0x43, 0xe2, 0x59, 0x54, 0x31, 0x99, 0xbe, 0x29, 0x4a, 0x28, 0x4c, 0x22, 0xe3,
+	0x50, 0x3a, 0x5c, 0x41, 0x42, 0x18, 0x26, 0xac, 0xd2, 0x1f, 0x83, 0x35, 0xb0, 0x7b, 0x45, 0x02,
+	0xcf, 0xf1, 0xfa, 0xb1, 0xb3, 0x2d, 0xe1, 0xec, 0x70, 0x12, 0x19, 0x07, 0xd2, 0xd9, 0x02, 0x01,
+	0xe1, 0x1d, 0x65, 0x99, 0x3a, 0x69, 0x81, 0xfd, 0xa1, 0xe3, 0xf9, 0x81, 0x99, 0xec, 0x1c, 0xd3,
+	0xc1, 0x62, 0x56, 0x2b, 0x48, 0xb2, 0x4a, 0x3f, 0xb8, 0x98, 0x35, 0x99, 0xc1, 0xcf, 0x40, 0x3e,
+	0x49, 0x8d, 0xcf, 0x45, 0xe6, 0xad, 0xbb, 0xbc, 0xe4, 0x13, 0x61, 0x38, 0x8b, 0x1f, 0x1f, 0x91,
+	0x5f, 0x80, 0xec, 0x2f, 0x89, 0xe3, 0x9a, 0x53, 0x29, 0xd1, 0xb7, 0x8b, 0xda, 0xfd, 0xcc, 0xc9,
+	0x9d, 0x92, 0xd4, 0x92, 0xd2, 0x54, 0x4b, 0x4a, 0x75, 0x45, 0xa8, 0x16, 0x79, 0x52, 0x93, 0xc8,
+	0xc8, 0xcb, 0x50, 0x73, 0xbb, 0xd1, 0x17, 0xdf, 0x18, 0x1a, 0xde, 0xe6, 0xb6, 0x29, 0x1f, 0x52,
+	0x70, 0xd7, 0xf1, 0x42, 0x1a, 0x58, 0x03, 0xe2, 0x78, 0xa6, 0xd4, 0x38, 0xd3, 0x72, 0x1d, 0xea,
+	0x85, 0xa6, 0x63, 0xeb, 0x59, 0x51, 0xe9, 0xf1, 0x24, 0x32, 0x90, 0x74, 0xf8, 0x2d, 0x64, 0x84,
+	0xf5, 0x19, 0x7a, 0x2e, 0xc0, 0x9a, 0xc0, 0x9a, 0x36, 0x1c, 0x80, 0xa3, 0x15, 0x3b, 0x07, 0xc4,
+	0xf3, 0xa8, 0xcb, 0xe3, 0xec, 0x88, 0x38, 0xdf, 0x9b, 0x44, 0xc6, 0xbd, 0x57, 0xc6, 0x89, 0xd9,
+	0x08, 0xdf, 0x59, 0x0a, 0x24, 0xc1, 0xa6, 0x0d, 0x3f, 0x03, 0x89, 0x2c, 0xcc, 0x21, 0xb9, 0x36,
+	0x47, 0x81, 0x63, 0x51, 0x93, 0xf4, 0xa9, 0xbe, 0xfb, 0xef, 0xba, 0xf7, 0x03, 0xd5, 0x3d, 0x63,
+	0x29, 0x89, 0x39, 0x47, 0xb2, 0x91, 0xb7, 0x67, 0xf0, 0x47, 0xe4, 0xba, 0xcd, 0xc1, 0x4a, 0x9f,
+	0x9e, 0x6e, 0x7e, 0xf1, 0xcc, 0x48, 0xfd, 0xf3, 0x99, 0xa1, 0xa1, 0x3f, 0x6b, 0xe0, 0xa8, 0xd2,
+	0xef, 0x07, 0xb4, 0x4f, 0x42, 0xda, 0xb8, 0xe6, 0x05, 0xf4, 0x29, 0x26, 0x21, 0x6d, 0x07, 0x94,
+	0xcb, 0x28, 0xbc, 0x07, 0xd2, 0x03, 0xc2, 0x06, 0x42, 0xdf, 0xb7, 0xaa, 0xbb, 0x93, 0xc8, 0xc8,
+	0xc8, 0xc0, 0xdc, 0x8a, 0xb0, 0x00, 0xe1, 0x31, 0xb8, 0xc5, 0xc9, 0x81, 0x52, 0xf2, 0xdc, 0x24,
+	0x32, 0xb6, 0x67, 0xda, 0x1c, 0x20, 0x2c, 0x61, 0x21, 0x27, 0xe3, 0xee, 0xd0, 0x09, 0xcd, 0xae,
+	0xeb, 0x5b, 0x4f, 0xf4, 0xf5, 0x25, 0x39, 0x49, 0xa0, 0x5c, 0x4e, 0xc4, 0xb2, 0xca, 0x57, 0xa7,
+	0xdb, 0x4f, 0x9f, 0x19, 0x29, 0x95, 0x77, 0x0a, 0xfd, 0x43, 0x03, 0x77, 0x56, 0xe6, 0xfd, 0x98,
+	0x27, 0xfd, 0x3b, 0x0d, 0xe4, 0xa9, 0x32, 0x9a, 0x01, 0xe1, 0xd7, 0xc3, 0x78, 0xe4, 0x52, 0xa6,
+	0x6b, 0xc5, 0xf5, 0xfb, 0x99, 0x93, 0xe3, 0xd2, 0x8a, 0x2b, 0xb3, 0x94, 0xf4, 0xd2, 0xe1, 0xf4,
+	0xea, 0x07, 0xaa, 0xd5, 0xea, 0x9b, 0x58, 0xe5, 0x11, 0xfd, 0xf1, 0x1b, 0x03, 0x2e, 0xed, 0x64,
+	0x18, 0xd2, 0x25, 0xdb, 0xeb, 0x76, 0x69, 0xa1, 0xd2, 0xbf, 0x68, 0x60, 0x6f, 0x29, 0x00, 0xf7,
+	0x65, 0x53, 0xcf, 0x1f, 0xea, 0xda, 0xa2, 0x2f, 0x61, 0x46, 0x58, 0xc2, 0xf0, 0x09, 0xc8, 0xce,
+	0xa5, 0xad, 0x62, 0x3f, 0x7c, 0x63, 0x5d, 0xc8, 0xaf, 0xe8, 0x01, 0xc2, 0xdb, 0xc9, 0x32, 0x17,
+	0x12, 0xff, 0x5a, 0x03, 0x7b, 0x3f, 0x1d, 0xfb, 0xf3, 0xaf, 0xe7, 0xb5, 0x13, 0x3f, 0x06, 0xb7,
+	0x7e, 0x35, 0xf6, 0xe3, 0x84, 0x13, 0x3c, 0x61, 0x46, 0x58, 0xc2, 0xcb, 0x05, 0xae, 0xff, 0x0f,
+	0x0b, 0xdc, 0x7c, 0x3a, 0x2d, 0xee, 0xb7, 0x6b, 0xe0, 0xa0, 0x19, 0x7f, 0x5b, 0xff, 0x51, 0x85,
+	0xff, 0xcf, 0x57, 0x03, 0x1f, 0x83, 0xad, 0x78, 0x96, 0x13, 0x2d, 0xca, 0x9c, 0x1c, 0x2e, 0x69,
+	0x4c, 0x67, 0xca, 0xa8, 0x1e, 0xa9, 0x93, 0x9f, 0x93, 0xae, 0xe3, 0xad, 0xe8, 0x73, 0xae, 0x2a,
+	0x33, 0x57, 0x89, 0x8e, 0xfc, 0x35, 0x0d, 0xf2, 0xe2, 0x06, 0x26, 0xa1, 0x1f, 0xb4, 0x67, 0xb7,
+	0x27, 0x6c, 0x82, 0xbd, 0xcb, 0xa9, 0xdd, 0x24, 0xb6, 0x1d, 0x50, 0xc6, 0x54, 0x6f, 0x8e, 0x26,
+	0x91, 0xa1, 0xab, 0x4f, 0x60, 0x91, 0x82, 0x70, 0x2e, 0xb6, 0x55, 0xa4, 0x09, 0x7e, 0x1f, 0x6c,
+	0xa8, 0x21, 0x62, 0x4d, 0x28, 0xc7, 0xde, 0x24, 0x32, 0xb2, 0xea, 0xe2, 0x55, 0x83, 0x80, 0x22,
+	0x70, 0xa9, 0x49, 0x4c, 0xa0, 0x6c, 0x59, 0x6a, 0x92, 0x28, 0xc2, 0x99, 0xd9, 0x80, 0x1a, 0x7f,
+	0xa8, 0x4c, 0x4d, 0x6d, 0x0b, 0x1f, 0x2a, 0x53, 0x1f, 0x2a, 0x83, 0x65, 0xb0, 0x49, 0xba, 0x2c,
+	0x24, 0x8e, 0xc7, 0xc4, 0x1c, 0x96, 0xae, 0xee, 0x4f, 0x22, 0x63, 0x57, 0x52, 0xa7, 0x08, 0xc2,
+	0x31, 0x09, 0x3e, 0x00, 0x5b, 0x57, 0x8e, 0x67, 0x5a, 0xfe, 0xd8, 0x0b, 0xd5, 0x2c, 0x95, 0x9f,
+	0x75, 0x39, 0x86, 0x10, 0xde, 0xbc, 0x72, 0xbc, 0x1a, 0x7f, 0xe4, 0x25, 0x0f, 0x1d, 0xc6, 0x28,
+	0xd3, 0xdf, 0x59, 0x2c, 0x59, 0xda, 0x11, 0x56, 0x04, 0x3e, 0x9f, 0xd8, 0xf4, 0xd2, 0x11, 0xd7,
+	0x84, 0x8a, 0xb1, 0xb9, 0x38, 0x9f, 0x2c, 0x10, 0x10, 0xde, 0x89, 0x2d, 0x32, 0x9e, 0x07, 0x76,
+	0x86, 0x94, 0x78, 0x66, 0x6c, 0xd6, 0xb7, 0xde, 0x6e, 0xc2, 0x9c, 0xf7, 0x86, 0x70, 0x96, 0x1b,
+	0xea, 0xd3, 0x75, 0xe2, 0x00, 0x7d, 0xb9, 0x06, 0x72, 0x89, 0x03, 0xe4, 0x11, 0x37, 0xbc, 0xf9,
+	0x6f, 0x1e, 0x9e, 0x06, 0x48, 0x87, 0x8e, 0x52, 0xdf, 0x9d, 0x93, 0xe2, 0xca, 0x3b, 0x40, 0x85,
+	0xed, 0x38, 0x34, 0x48, 0xde, 0x75, 0x7c, 0x1f, 0xc2, 0x62, 0x3b, 0xfc, 0x14, 0xbc, 0x6b, 0xf9,
+	0x1e, 0xa3, 0xd6, 0x38, 0x74, 0x2e, 0xa9, 0xd9, 0x25, 0x76, 0x3c, 0xc4, 0xc9, 0x33, 0x86, 0x26,
+	0x91, 0x51, 0x90, 0xfb, 0x5e, 0x41, 0x44, 0xf8, 0x76, 0x02, 0xa9, 0x12, 0x7b, 0x3a, 0xcc, 0x55,
+	0xc1, 0xae, 0x4b, 0x58, 0x98, 0xe0, 0xea, 0xe9, 0xc5, 0x37, 0xb8, 0x40, 0x40, 0x38, 0xcb, 0x2d,
+	0xb1, 0x93, 0x44, 0x43, 0xff, 0xa0, 0x81, 0x03, 0x4c, 0xfb, 0x0e, 0x0b, 0x69, 0xd0, 0x21, 0x41,
+	0x9f, 0x86, 0xed, 0xc0, 0x1f, 0xf9, 0x8c, 0xb8, 0x30, 0x0f, 0x6e, 0x85, 0x4e, 0xe8, 0x52, 0xd9,
+	0x4a, 0x2c, 0x17, 0xb0, 0x08, 0x32, 0x36, 0x65, 0x56, 0xe0, 0x8c, 0xc4, 0x8b, 0x17, 0x7a, 0x84,
+	0x93, 0x26, 0x78, 0x06, 0xb2, 0xa1, 0xf0, 0x64, 0x8e, 0xc4, 0x7f, 0x81, 0x4a, 0x4a, 0xde, 0x5b,
+	0xd9, 0x4c, 0x15, 0x53, 0x10, 0xab, 0x69, 0x7e, 0x7e, 0xf0, 0x76, 0x98, 0xb0, 0x9d, 0xa6, 0x45,
+	0x9a, 0xcf, 0x35, 0xb0, 0x9d, 0xa4, 0xf2, 0xe4, 0x12, 0x02, 0x3a, 0x95, 0xcb, 0x0f, 0xc0, 0x06,
+	0xf3, 0xc7, 0x81, 0x45, 0xd5, 0x0b, 0xfc, 0xb6, 0x98, 0x17, 0x82, 0x88, 0xd5, 0x06, 0x58, 0x02,
+	0xfb, 0xf2, 0xc9, 0xb4, 0xe9, 0xb5, 0x69, 0xf9, 0x5e, 0xc8, 0x87, 0x57, 0x79, 0x53, 0xe0, 0x3d,
+	0x09, 0xd5, 0xe9, 0x75, 0x4d, 0x01, 0x3c, 0x01, 0x79, 0xf7, 0xa4, 0x65, 0x02, 0x62, 0x01, 0xef,
+	0x82, 0x2d, 0xbf, 0xd7, 0x33, 0x85, 0xe0, 0x8b, 0xcf, 0x7d, 0x13, 0x6f, 0xfa, 0xbd, 0x5e, 0x8d,
+	0xaf, 0x65, 0x29, 0xef, 0xff, 0x1a, 0x64, 0x12, 0x27, 0x08, 0xde, 0x06, 0x7b, 0xed, 0x46, 0xab,
+	0x72, 0xd6, 0xf9, 0xb9, 0xd9, 0x69, 0x36, 0xb0, 0xd9, 0x3a, 0x6f, 0x35, 0x72, 0x29, 0xa8, 0x83,
+	0xfc, 0x9c, 0xf9, 0x93, 0x0a, 0x6e, 0x35, 0x5b, 0x8f, 0x72, 0x1a, 0x3c, 0x00, 0x70, 0x0e, 0xb9,
+	0x38, 0xab, 0x5c, 0x7c, 0x98, 0x5b, 0x83, 0x06, 0xb8, 0xbb, 0x6c, 0x37, 0x2b, 0xad, 0xba, 0xf9,
+	0x93, 0x4a, 0xf3, 0x2c, 0xb7, 0x7e, 0x98, 0x7e, 0xfa, 0xfb, 0x42, 0xea, 0xfd, 0x3f, 0xc5, 0x9d,
+	0x94, 0x0d, 0x80, 0xdf, 0x01, 0x77, 0x3a, 0x15, 0xfc, 0xa8, 0xd1, 0x31, 0x2f, 0xce, 0x3f, 0xc6,
+	0xb5, 0x86, 0xf9, 0x71, 0xeb, 0xa2, 0xdd, 0xa8, 0x35, 0x1f, 0x36, 0x1b, 0xf5, 0x5c, 0x0a, 0x1e,
+	0x01, 0x7d, 0x1e, 0x7e, 0x5c, 0x39, 0x6b, 0xd6, 0x2b, 0x9d, 0x73, 0x7c, 0x91, 0xd3, 0x78, 0xf6,
+	0xf3, 0x68, 0xbd, 0xf1, 0xb3, 0xdc, 0x1a, 0x2c, 0x82, 0xa3, 0x79, 0x73, 0xb3, 0xd5, 0x69, 0xe0,
+	0xda, 0x87, 0x95, 0x66, 0x4b, 0x30, 0xd6, 0xe1, 0x3d, 0x60, 0xbc, 0x92, 0x71, 0x8e, 0x2b, 0xb5,
+	0xb3, 0x46, 0x2e, 0x2d, 0x33, 0xae, 0x9e, 0x3d, 0x7f, 0x51, 0xd0, 0xbe, 0x7a, 0x51, 0xd0, 0xfe,
+	0xfe, 0xa2, 0xa0, 0x7d, 0xfe, 0xb2, 0x90, 0xfa, 0xea, 0x65, 0x21, 0xf5, 0xb7, 0x97, 0x85, 0xd4,
+	0xa7, 0x27, 0x09, 0x9d, 0xa1, 0xee, 0x0d, 0x73, 0xc6, 0x43, 0x16, 0x0a, 0xc9, 0x28, 0xcf, 0x7e,
+	0x11, 0xb9, 0x9e, 0xfe, 0x26, 0x22, 0x74, 0xa7, 0xbb, 0x21, 0x6e, 0xb2, 0x1f, 0xfd, 0x6b, 0x00,
+	0x25, 0x87, 0xd0, 0xdc, 0x34, 0x11, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -490,6 +850,30 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinValidPerWindow.Equal(that1.MinValidPerWindow) {
 		return false
 	}
+	if this.PerformanceWindows != that1.PerformanceWindows {
+		return false
+	}
+	if this.WarningWindows != that1.WarningWindows {
+		return false
+	}
+	if this.MinorSlashWindows != that1.MinorSlashWindows {
+		return false
+	}
+	if !this.MinorSlashFraction.Equal(that1.MinorSlashFraction) {
+		return false
+	}
+	if this.JailDuration != that1.JailDuration {
+		return false
+	}
+	if this.InterchainOracleClientId != that1.InterchainOracleClientId {
+		return false
+	}
+	if this.InterchainOracleChannelId != that1.InterchainOracleChannelId {
+		return false
+	}
+	if this.InterchainMaxPriceAge != that1.InterchainMaxPriceAge {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -512,6 +896,61 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.InterchainMaxPriceAge, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.InterchainMaxPriceAge):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintOracle(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x7a
+	if len(m.InterchainOracleChannelId) > 0 {
+		i -= len(m.InterchainOracleChannelId)
+		copy(dAtA[i:], m.InterchainOracleChannelId)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.InterchainOracleChannelId)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.InterchainOracleClientId) > 0 {
+		i -= len(m.InterchainOracleClientId)
+		copy(dAtA[i:], m.InterchainOracleClientId)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.InterchainOracleClientId)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.JailDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintOracle(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.MinorSlashFraction.Size()
+		i -= size
+		if _, err := m.MinorSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.MinorSlashWindows != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinorSlashWindows))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.WarningWindows != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.WarningWindows))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PerformanceWindows != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PerformanceWindows))
+		i--
+		dAtA[i] = 0x40
+	}
 	{
 		size := m.MinValidPerWindow.Size()
 		i -= size
@@ -696,7 +1135,7 @@ func (m *ExchangeRateTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RegisterTargetProposal) Marshal() (dAtA []byte, err error) {
+func (m *QuoteExchangeRate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -706,44 +1145,44 @@ func (m *RegisterTargetProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RegisterTargetProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuoteExchangeRate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RegisterTargetProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuoteExchangeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.TargetParams.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x1a
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Description)))
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Quote)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Title)))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TargetParams) Marshal() (dAtA []byte, err error) {
+func (m *InterchainExchangeRate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -753,28 +1192,34 @@ func (m *TargetParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TargetParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *InterchainExchangeRate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TargetParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InterchainExchangeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SourceDexContract) > 0 {
-		i -= len(m.SourceDexContract)
-		copy(dAtA[i:], m.SourceDexContract)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.SourceDexContract)))
-		i--
-		dAtA[i] = 0x1a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err3 != nil {
+		return 0, err3
 	}
-	if m.Source != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Source))
-		i--
-		dAtA[i] = 0x10
+	i -= n3
+	i = encodeVarintOracle(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Denom) > 0 {
 		i -= len(m.Denom)
 		copy(dAtA[i:], m.Denom)
@@ -785,27 +1230,253 @@ func (m *TargetParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOracle(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ValidatorPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ValidatorPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.VotePeriod != 0 {
-		n += 1 + sovOracle(uint64(m.VotePeriod))
-	}
-	l = m.VoteThreshold.Size()
+	{
+		size := m.MeanDeviation.Size()
+		i -= size
+		if _, err := m.MeanDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.DeviationCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.DeviationCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Misses != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Misses))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.WinCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.WinCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Abstains != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Abstains))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Votes != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Votes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.VotePeriods != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VotePeriods))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Window != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPenalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPenalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPenalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastBadWindow != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LastBadWindow))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ConsecutiveBadWindows != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ConsecutiveBadWindows))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Tier != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Tier))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterTargetProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterTargetProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterTargetProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TargetParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TargetParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TargetParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TargetParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OffChain {
+		i--
+		if m.OffChain {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SourceDexContract) > 0 {
+		i -= len(m.SourceDexContract)
+		copy(dAtA[i:], m.SourceDexContract)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.SourceDexContract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Source != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotePeriod != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriod))
+	}
+	l = m.VoteThreshold.Size()
 	n += 1 + l + sovOracle(uint64(l))
 	l = m.RewardBand.Size()
 	n += 1 + l + sovOracle(uint64(l))
@@ -819,6 +1490,29 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinValidPerWindow.Size()
 	n += 1 + l + sovOracle(uint64(l))
+	if m.PerformanceWindows != 0 {
+		n += 1 + sovOracle(uint64(m.PerformanceWindows))
+	}
+	if m.WarningWindows != 0 {
+		n += 1 + sovOracle(uint64(m.WarningWindows))
+	}
+	if m.MinorSlashWindows != 0 {
+		n += 1 + sovOracle(uint64(m.MinorSlashWindows))
+	}
+	l = m.MinorSlashFraction.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovOracle(uint64(l))
+	l = len(m.InterchainOracleClientId)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.InterchainOracleChannelId)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.InterchainMaxPriceAge)
+	n += 1 + l + sovOracle(uint64(l))
 	return n
 }
 
@@ -876,52 +1570,866 @@ func (m *ExchangeRateTuple) Size() (n int) {
 	return n
 }
 
-func (m *RegisterTargetProposal) Size() (n int) {
+func (m *QuoteExchangeRate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Title)
+	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
-	l = len(m.Description)
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *InterchainExchangeRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *ValidatorPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
-	l = m.TargetParams.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
-}
+	if m.Window != 0 {
+		n += 1 + sovOracle(uint64(m.Window))
+	}
+	if m.VotePeriods != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriods))
+	}
+	if m.Votes != 0 {
+		n += 1 + sovOracle(uint64(m.Votes))
+	}
+	if m.Abstains != 0 {
+		n += 1 + sovOracle(uint64(m.Abstains))
+	}
+	if m.WinCount != 0 {
+		n += 1 + sovOracle(uint64(m.WinCount))
+	}
+	if m.Misses != 0 {
+		n += 1 + sovOracle(uint64(m.Misses))
+	}
+	if m.DeviationCount != 0 {
+		n += 1 + sovOracle(uint64(m.DeviationCount))
+	}
+	l = m.MeanDeviation.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *ValidatorPenalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Tier != 0 {
+		n += 1 + sovOracle(uint64(m.Tier))
+	}
+	if m.ConsecutiveBadWindows != 0 {
+		n += 1 + sovOracle(uint64(m.ConsecutiveBadWindows))
+	}
+	if m.LastBadWindow != 0 {
+		n += 1 + sovOracle(uint64(m.LastBadWindow))
+	}
+	return n
+}
+
+func (m *RegisterTargetProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.TargetParams.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *TargetParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Source != 0 {
+		n += 1 + sovOracle(uint64(m.Source))
+	}
+	l = len(m.SourceDexContract)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.OffChain {
+		n += 2
+	}
+	return n
+}
+
+func sovOracle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOracle(x uint64) (n int) {
+	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			}
+			m.VotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributionWindow", wireType)
+			}
+			m.RewardDistributionWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardDistributionWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+			}
+			m.SlashWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceWindows", wireType)
+			}
+			m.PerformanceWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerformanceWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WarningWindows", wireType)
+			}
+			m.WarningWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WarningWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinorSlashWindows", wireType)
+			}
+			m.MinorSlashWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinorSlashWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinorSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinorSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterchainOracleClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterchainOracleClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterchainOracleChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterchainOracleChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterchainMaxPriceAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.InterchainMaxPriceAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
+			}
+			m.SubmitBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateTuples = append(m.ExchangeRateTuples, ExchangeRateTuple{})
+			if err := m.ExchangeRateTuples[len(m.ExchangeRateTuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *TargetParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	if m.Source != 0 {
-		n += 1 + sovOracle(uint64(m.Source))
-	}
-	l = len(m.SourceDexContract)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovOracle(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozOracle(x uint64) (n int) {
-	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -944,17 +2452,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.VotePeriod = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -964,14 +2472,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotePeriod |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -999,13 +2520,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuoteExchangeRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuoteExchangeRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuoteExchangeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1033,15 +2604,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributionWindow", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
 			}
-			m.RewardDistributionWindow = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1051,14 +2620,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RewardDistributionWindow |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1086,15 +2668,65 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.SlashWindow = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterchainExchangeRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterchainExchangeRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterchainExchangeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1104,16 +2736,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlashWindow |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1123,23 +2802,22 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1164,7 +2842,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+func (m *ValidatorPerformance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1187,15 +2865,15 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorPerformance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1223,13 +2901,13 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			var stringLen uint64
+			m.Window = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1239,29 +2917,16 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Window |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriods", wireType)
 			}
-			m.SubmitBlock = 0
+			m.VotePeriods = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1271,66 +2936,54 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubmitBlock |= uint64(b&0x7F) << shift
+				m.VotePeriods |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
+			m.Votes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Votes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abstains", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Abstains = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Abstains |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinCount", wireType)
 			}
-			var msglen int
+			m.WinCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1340,29 +2993,52 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.WinCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOracle
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Misses", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
+			m.Misses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Misses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationCount", wireType)
 			}
-			m.ExchangeRateTuples = append(m.ExchangeRateTuples, ExchangeRateTuple{})
-			if err := m.ExchangeRateTuples[len(m.ExchangeRateTuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DeviationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeviationCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MeanDeviation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1390,7 +3066,9 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			if err := m.MeanDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1413,7 +3091,7 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
+func (m *ValidatorPenalty) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1436,15 +3114,15 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorPenalty: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorPenalty: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1472,13 +3150,13 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
 			}
-			var stringLen uint64
+			m.Tier = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1488,26 +3166,49 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Tier |= PenaltyTier(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveBadWindows", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
+			m.ConsecutiveBadWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveBadWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBadWindow", wireType)
 			}
-			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.LastBadWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastBadWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1788,6 +3489,58 @@ func (m *TargetParams) Unmarshal(dAtA []byte) error {
 			}
 			m.SourceDexContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffChain", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OffChain = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic performs a basic check of the interchain oracle packet data
+func (p InterchainOraclePacketData) ValidateBasic() error {
+	if len(p.ExchangeRates) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacket, "no exchange rates")
+	}
+	if p.Timestamp.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidPacket, "no timestamp")
+	}
+
+	denoms := make(map[string]bool)
+	for _, tuple := range p.ExchangeRates {
+		if err := sdk.ValidateDenom(tuple.Denom); err != nil {
+			return sdkerrors.Wrap(ErrInvalidPacket, err.Error())
+		}
+		if denoms[tuple.Denom] {
+			return sdkerrors.Wrapf(ErrInvalidPacket, "duplicate denom %s", tuple.Denom)
+		}
+		denoms[tuple.Denom] = true
+
+		if tuple.ExchangeRate.IsNil() || !tuple.ExchangeRate.IsPositive() {
+			return sdkerrors.Wrap(ErrInvalidExchangeRate, fmt.Sprintf("%s: %s", tuple.Denom, tuple.ExchangeRate))
+		}
+	}
+	return nil
+}
+
+// GetBytes returns the sorted JSON encoding of the interchain oracle packet data
+func (p InterchainOraclePacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&p))
+}
@@ -0,0 +1,394 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: blackfury/oracle/v1/packet.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InterchainOraclePacketData defines the price packet sent by the counterparty
+// oracle chain over the interchain oracle channel.
+type InterchainOraclePacketData struct {
+	// exchange rates of the targets denominated in uUSD
+	ExchangeRates ExchangeRateTuples `protobuf:"bytes,1,rep,name=exchange_rates,json=exchangeRates,proto3,castrepeated=ExchangeRateTuples" json:"exchange_rates"`
+	// time at which the exchange rates were quoted on the counterparty chain
+	Timestamp time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+}
+
+func (m *InterchainOraclePacketData) Reset()         { *m = InterchainOraclePacketData{} }
+func (m *InterchainOraclePacketData) String() string { return proto.CompactTextString(m) }
+func (*InterchainOraclePacketData) ProtoMessage()    {}
+func (*InterchainOraclePacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b4dd53473d014f1, []int{0}
+}
+func (m *InterchainOraclePacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterchainOraclePacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterchainOraclePacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterchainOraclePacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterchainOraclePacketData.Merge(m, src)
+}
+func (m *InterchainOraclePacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterchainOraclePacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterchainOraclePacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterchainOraclePacketData proto.InternalMessageInfo
+
+func (m *InterchainOraclePacketData) GetExchangeRates() ExchangeRateTuples {
+	if m != nil {
+		return m.ExchangeRates
+	}
+	return nil
+}
+
+func (m *InterchainOraclePacketData) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*InterchainOraclePacketData)(nil), "blackfury.oracle.v1.InterchainOraclePacketData")
+}
+
+func init() { proto.RegisterFile("blackfury/oracle/v1/packet.proto", fileDescriptor_0b4dd53473d014f1) }
+
+var fileDescriptor_0b4dd53473d014f1 = []byte{
+	// 302 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xc1, 0x4e, 0xc2, 0x30,
+	0x18, 0xc7, 0x57, 0x4d, 0x8c, 0x8e, 0xe8, 0x61, 0x7a, 0x20, 0x3b, 0x74, 0xc4, 0x83, 0xe1, 0xd4,
+	0x06, 0x7c, 0x83, 0x45, 0x0f, 0x26, 0x26, 0x1a, 0xc2, 0xc9, 0x8b, 0xe9, 0x9a, 0x8f, 0xd2, 0xb0,
+	0xad, 0xcb, 0xfa, 0x8d, 0xc0, 0x5b, 0xf0, 0x1c, 0x3e, 0x09, 0x89, 0x17, 0x8e, 0x9e, 0xc4, 0xc0,
+	0x8b, 0x18, 0x0a, 0x03, 0x13, 0xb9, 0xb5, 0xfd, 0xff, 0xfa, 0x7d, 0xff, 0xff, 0xdf, 0x6f, 0x25,
+	0xa9, 0x90, 0xa3, 0x41, 0x55, 0x4e, 0xb9, 0x29, 0x85, 0x4c, 0x81, 0x8f, 0x3b, 0xbc, 0x10, 0x72,
+	0x04, 0xc8, 0x8a, 0xd2, 0xa0, 0x09, 0xae, 0xf7, 0x04, 0xdb, 0x12, 0x6c, 0xdc, 0x09, 0x6f, 0x94,
+	0x51, 0xc6, 0xe9, 0x7c, 0x73, 0xda, 0xa2, 0x61, 0xa4, 0x8c, 0x51, 0x29, 0x70, 0x77, 0x4b, 0xaa,
+	0x01, 0x47, 0x9d, 0x81, 0x45, 0x91, 0x15, 0x3b, 0xe0, 0xe8, 0xb6, 0xdd, 0x54, 0x47, 0xdc, 0x7e,
+	0x12, 0x3f, 0x7c, 0xca, 0x11, 0x4a, 0x39, 0x14, 0x3a, 0x7f, 0x71, 0xd2, 0xab, 0xb3, 0xf3, 0x20,
+	0x50, 0x04, 0xda, 0xbf, 0x82, 0x89, 0x1c, 0x8a, 0x5c, 0xc1, 0x7b, 0x29, 0x10, 0x6c, 0x93, 0xb4,
+	0x4e, 0xdb, 0x8d, 0xee, 0x1d, 0x3b, 0xe2, 0x92, 0x3d, 0xee, 0xd0, 0x9e, 0x40, 0xe8, 0x57, 0x45,
+	0x0a, 0x71, 0x38, 0xff, 0x8e, 0xbc, 0x8f, 0x65, 0x14, 0xfc, 0x93, 0x6c, 0xef, 0x12, 0xfe, 0xbc,
+	0xd9, 0x20, 0xf6, 0x2f, 0xf6, 0xf6, 0x9b, 0x27, 0x2d, 0xd2, 0x6e, 0x74, 0x43, 0xb6, 0x0d, 0xc8,
+	0xea, 0x80, 0xac, 0x5f, 0x13, 0xf1, 0xf9, 0x66, 0xf2, 0x6c, 0x19, 0x91, 0xde, 0xe1, 0x5b, 0xfc,
+	0x3c, 0x5f, 0x51, 0xb2, 0x58, 0x51, 0xf2, 0xb3, 0xa2, 0x64, 0xb6, 0xa6, 0xde, 0x62, 0x4d, 0xbd,
+	0xaf, 0x35, 0xf5, 0xde, 0xba, 0x4a, 0xe3, 0xb0, 0x4a, 0x98, 0x34, 0x19, 0x87, 0x74, 0x6a, 0x75,
+	0x95, 0x59, 0x14, 0xa8, 0x4d, 0xce, 0x0f, 0x1d, 0x4d, 0xea, 0x96, 0x70, 0x5a, 0x80, 0x4d, 0xce,
+	0xdc, 0xda, 0xfb, 0xdf, 0x01, 0x00, 0xfe, 0x2c, 0xd6, 0x40, 0xb4, 0x01, 0x00, 0x00,
+}
+
+func (m *InterchainOraclePacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterchainOraclePacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterchainOraclePacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPacket(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ExchangeRates) > 0 {
+		for iNdEx := len(m.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InterchainOraclePacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExchangeRates) > 0 {
+		for _, e := range m.ExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovPacket(uint64(l))
+	return n
+}
+
+func sovPacket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacket(x uint64) (n int) {
+	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InterchainOraclePacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterchainOraclePacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterchainOraclePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = append(m.ExchangeRates, ExchangeRateTuple{})
+			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPacket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPacket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPacket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPacket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPacket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPacket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPacket = fmt.Errorf("proto: unexpected end of group")
+)
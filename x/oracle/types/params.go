@@ -2,22 +2,32 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"github.com/elysiumstation/blackfury/types"
 	"gopkg.in/yaml.v2"
 )
 
 // Parameter keys
 var (
-	KeyVotePeriod               = []byte("VotePeriod")
-	KeyVoteThreshold            = []byte("VoteThreshold")
-	KeyRewardBand               = []byte("RewardBand")
-	KeyRewardDistributionWindow = []byte("RewardDistributionWindow")
-	KeySlashFraction            = []byte("SlashFraction")
-	KeySlashWindow              = []byte("SlashWindow")
-	KeyMinValidPerWindow        = []byte("MinValidPerWindow")
+	KeyVotePeriod                = []byte("VotePeriod")
+	KeyVoteThreshold             = []byte("VoteThreshold")
+	KeyRewardBand                = []byte("RewardBand")
+	KeyRewardDistributionWindow  = []byte("RewardDistributionWindow")
+	KeySlashFraction             = []byte("SlashFraction")
+	KeySlashWindow               = []byte("SlashWindow")
+	KeyMinValidPerWindow         = []byte("MinValidPerWindow")
+	KeyPerformanceWindows        = []byte("PerformanceWindows")
+	KeyWarningWindows            = []byte("WarningWindows")
+	KeyMinorSlashWindows         = []byte("MinorSlashWindows")
+	KeyMinorSlashFraction        = []byte("MinorSlashFraction")
+	KeyJailDuration              = []byte("JailDuration")
+	KeyInterchainOracleClientID  = []byte("InterchainOracleClientID")
+	KeyInterchainOracleChannelID = []byte("InterchainOracleChannelID")
+	KeyInterchainMaxPriceAge     = []byte("InterchainMaxPriceAge")
 )
 
 // Default parameter values
@@ -25,14 +35,20 @@ const (
 	DefaultVotePeriod               = types.BlocksPerMinute // 60 seconds
 	DefaultSlashWindow              = types.BlocksPerWeek   // slash window for a week
 	DefaultRewardDistributionWindow = types.BlocksPerYear   // reward distribution window for a year
+	DefaultPerformanceWindows       = 4                     // keep performance of the last 4 slash windows
+	DefaultWarningWindows           = 1                     // warn for the first bad slash window
+	DefaultMinorSlashWindows        = 1                     // minor slash for the next bad slash window
+	DefaultJailDuration             = 24 * time.Hour        // jail for a day
+	DefaultInterchainMaxPriceAge    = 5 * time.Minute       // interchain exchange rates are fresh for 5 minutes
 )
 
 // Default parameter values
 var (
-	DefaultVoteThreshold     = sdk.NewDecWithPrec(50, 2) // 50%
-	DefaultRewardBand        = sdk.NewDecWithPrec(2, 2)  // 2% (-1, 1)
-	DefaultSlashFraction     = sdk.NewDecWithPrec(1, 4)  // 0.01%
-	DefaultMinValidPerWindow = sdk.NewDecWithPrec(5, 2)  // 5%
+	DefaultVoteThreshold      = sdk.NewDecWithPrec(50, 2) // 50%
+	DefaultRewardBand         = sdk.NewDecWithPrec(2, 2)  // 2% (-1, 1)
+	DefaultSlashFraction      = sdk.NewDecWithPrec(1, 4)  // 0.01%
+	DefaultMinValidPerWindow  = sdk.NewDecWithPrec(5, 2)  // 5%
+	DefaultMinorSlashFraction = sdk.NewDecWithPrec(1, 5)  // 0.001%
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -57,6 +73,12 @@ func DefaultParams() Params {
 		SlashFraction:            DefaultSlashFraction,
 		SlashWindow:              DefaultSlashWindow,
 		MinValidPerWindow:        DefaultMinValidPerWindow,
+		PerformanceWindows:       DefaultPerformanceWindows,
+		WarningWindows:           DefaultWarningWindows,
+		MinorSlashWindows:        DefaultMinorSlashWindows,
+		MinorSlashFraction:       DefaultMinorSlashFraction,
+		JailDuration:             DefaultJailDuration,
+		InterchainMaxPriceAge:    DefaultInterchainMaxPriceAge,
 	}
 }
 
@@ -70,6 +92,14 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeySlashFraction, &p.SlashFraction, validateSlashFraction),
 		paramtypes.NewParamSetPair(KeySlashWindow, &p.SlashWindow, validateSlashWindow),
 		paramtypes.NewParamSetPair(KeyMinValidPerWindow, &p.MinValidPerWindow, validateMinValidPerWindow),
+		paramtypes.NewParamSetPair(KeyPerformanceWindows, &p.PerformanceWindows, validatePerformanceWindows),
+		paramtypes.NewParamSetPair(KeyWarningWindows, &p.WarningWindows, validatePenaltyWindows),
+		paramtypes.NewParamSetPair(KeyMinorSlashWindows, &p.MinorSlashWindows, validatePenaltyWindows),
+		paramtypes.NewParamSetPair(KeyMinorSlashFraction, &p.MinorSlashFraction, validateSlashFraction),
+		paramtypes.NewParamSetPair(KeyJailDuration, &p.JailDuration, validateJailDuration),
+		paramtypes.NewParamSetPair(KeyInterchainOracleClientID, &p.InterchainOracleClientId, validateInterchainOracleClientID),
+		paramtypes.NewParamSetPair(KeyInterchainOracleChannelID, &p.InterchainOracleChannelId, validateInterchainOracleChannelID),
+		paramtypes.NewParamSetPair(KeyInterchainMaxPriceAge, &p.InterchainMaxPriceAge, validateInterchainMaxPriceAge),
 	}
 }
 
@@ -102,6 +132,30 @@ func (p Params) Validate() error {
 		return fmt.Errorf("oracle parameter MinValidPerWindow must be between [0, 1]")
 	}
 
+	if p.PerformanceWindows == 0 {
+		return fmt.Errorf("oracle parameter PerformanceWindows must be > 0, is %d", p.PerformanceWindows)
+	}
+
+	if p.MinorSlashFraction.GT(p.SlashFraction) || p.MinorSlashFraction.IsNegative() {
+		return fmt.Errorf("oracle parameter MinorSlashFraction must be between [0, SlashFraction]")
+	}
+
+	if p.JailDuration < 0 {
+		return fmt.Errorf("oracle parameter JailDuration must be >= 0, is %s", p.JailDuration)
+	}
+
+	if err := validateInterchainOracleClientID(p.InterchainOracleClientId); err != nil {
+		return err
+	}
+
+	if err := validateInterchainOracleChannelID(p.InterchainOracleChannelId); err != nil {
+		return err
+	}
+
+	if p.InterchainMaxPriceAge <= 0 {
+		return fmt.Errorf("oracle parameter InterchainMaxPriceAge must be > 0, is %s", p.InterchainMaxPriceAge)
+	}
+
 	return nil
 }
 
@@ -217,3 +271,81 @@ func validateMinValidPerWindow(i interface{}) error {
 
 	return nil
 }
+
+func validatePerformanceWindows(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("performance windows must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validatePenaltyWindows(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateJailDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("jail duration must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateInterchainOracleClientID(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v != "" {
+		if err := host.ClientIdentifierValidator(v); err != nil {
+			return fmt.Errorf("invalid interchain oracle client id: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func validateInterchainOracleChannelID(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v != "" {
+		if err := host.ChannelIdentifierValidator(v); err != nil {
+			return fmt.Errorf("invalid interchain oracle channel id: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func validateInterchainMaxPriceAge(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("interchain max price age must be positive: %s", v)
+	}
+
+	return nil
+}
@@ -55,6 +55,24 @@ func TestParamsEqual(t *testing.T) {
 	err = p7.Validate()
 	require.Error(t, err)
 
+	// zero performance windows
+	p8 := types.DefaultParams()
+	p8.PerformanceWindows = 0
+	err = p8.Validate()
+	require.Error(t, err)
+
+	// minor slash fraction larger than slash fraction
+	p9 := types.DefaultParams()
+	p9.MinorSlashFraction = p9.SlashFraction.Add(sdk.SmallestDec())
+	err = p9.Validate()
+	require.Error(t, err)
+
+	// negative jail duration
+	p10 := types.DefaultParams()
+	p10.JailDuration = -1
+	err = p10.Validate()
+	require.Error(t, err)
+
 	p11 := types.DefaultParams()
 	require.NotNil(t, p11.ParamSetPairs())
 	require.NotNil(t, p11.String())
@@ -67,7 +85,8 @@ func TestParamsValidate(t *testing.T) {
 		switch {
 		case bytes.Compare(types.KeyVotePeriod, pair.Key) == 0 ||
 			bytes.Compare(types.KeyRewardDistributionWindow, pair.Key) == 0 ||
-			bytes.Compare(types.KeySlashWindow, pair.Key) == 0:
+			bytes.Compare(types.KeySlashWindow, pair.Key) == 0 ||
+			bytes.Compare(types.KeyPerformanceWindows, pair.Key) == 0:
 			require.NoError(t, pair.ValidatorFn(uint64(1)))
 			require.Error(t, pair.ValidatorFn("invalid"))
 			require.Error(t, pair.ValidatorFn(uint64(0)))
@@ -78,6 +97,7 @@ func TestParamsValidate(t *testing.T) {
 			require.Error(t, pair.ValidatorFn(sdk.NewDecWithPrec(101, 2)))
 		case bytes.Compare(types.KeyRewardBand, pair.Key) == 0 ||
 			bytes.Compare(types.KeySlashFraction, pair.Key) == 0 ||
+			bytes.Compare(types.KeyMinorSlashFraction, pair.Key) == 0 ||
 			bytes.Compare(types.KeyMinValidPerWindow, pair.Key) == 0:
 			require.NoError(t, pair.ValidatorFn(sdk.NewDecWithPrec(7, 2)))
 			require.Error(t, pair.ValidatorFn("invalid"))
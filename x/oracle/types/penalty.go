@@ -0,0 +1,15 @@
+package types
+
+// PenaltyTierOf returns the penalty tier after the given # of consecutive bad slash windows
+func PenaltyTierOf(consecutiveBadWindows, warningWindows, minorSlashWindows uint64) PenaltyTier {
+	switch {
+	case consecutiveBadWindows == 0:
+		return PENALTY_TIER_NONE
+	case consecutiveBadWindows <= warningWindows:
+		return PENALTY_TIER_WARNING
+	case consecutiveBadWindows <= warningWindows+minorSlashWindows:
+		return PENALTY_TIER_SLASH
+	default:
+		return PENALTY_TIER_SLASH_AND_JAIL
+	}
+}
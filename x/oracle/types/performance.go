@@ -0,0 +1,24 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewValidatorPerformance creates an empty ValidatorPerformance of the validator in the slash window.
+func NewValidatorPerformance(validator sdk.ValAddress, window uint64) ValidatorPerformance {
+	return ValidatorPerformance{
+		ValidatorAddress: validator.String(),
+		Window:           window,
+		MeanDeviation:    sdk.ZeroDec(),
+	}
+}
+
+// AddDeviations accumulates the sum of relative deviations into the mean deviation.
+func (p *ValidatorPerformance) AddDeviations(sum sdk.Dec, count uint64) {
+	if count == 0 {
+		return
+	}
+	total := p.MeanDeviation.MulInt64(int64(p.DeviationCount)).Add(sum)
+	p.DeviationCount += count
+	p.MeanDeviation = total.QuoInt64(int64(p.DeviationCount))
+}
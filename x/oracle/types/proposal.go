@@ -40,6 +40,14 @@ func validateTargetParams(params *TargetParams) error {
 	if params.Source <= TARGET_SOURCE_UNSPECIFIED {
 		return fmt.Errorf("target source must be specified")
 	}
+	if params.Quote != "" {
+		if err := sdk.ValidateDenom(params.Quote); err != nil {
+			return err
+		}
+		if params.Quote == params.Denom {
+			return fmt.Errorf("target cannot be quoted in itself: %s", params.Denom)
+		}
+	}
 	// TODO
 	return nil
 }
@@ -198,6 +198,175 @@ func (m *QueryExchangeRatesResponse) GetExchangeRates() github_com_cosmos_cosmos
 	return nil
 }
 
+// QueryCrossExchangeRateRequest is the request type for the
+// Query/CrossExchangeRate RPC method.
+type QueryCrossExchangeRateRequest struct {
+	// base defines the denomination to query for.
+	Base string `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// quote defines the denomination in which the exchange rate is denominated.
+	Quote string `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+}
+
+func (m *QueryCrossExchangeRateRequest) Reset()         { *m = QueryCrossExchangeRateRequest{} }
+func (m *QueryCrossExchangeRateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCrossExchangeRateRequest) ProtoMessage()    {}
+func (*QueryCrossExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fea2ade2446b6858, []int{4}
+}
+func (m *QueryCrossExchangeRateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCrossExchangeRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCrossExchangeRateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCrossExchangeRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCrossExchangeRateRequest.Merge(m, src)
+}
+func (m *QueryCrossExchangeRateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCrossExchangeRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCrossExchangeRateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCrossExchangeRateRequest proto.InternalMessageInfo
+
+// QueryCrossExchangeRateResponse is response type for the
+// Query/CrossExchangeRate RPC method.
+type QueryCrossExchangeRateResponse struct {
+	// exchange_rate defines the exchange rate of the base asset denominated in
+	// the quote asset.
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate"`
+}
+
+func (m *QueryCrossExchangeRateResponse) Reset()         { *m = QueryCrossExchangeRateResponse{} }
+func (m *QueryCrossExchangeRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCrossExchangeRateResponse) ProtoMessage()    {}
+func (*QueryCrossExchangeRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fea2ade2446b6858, []int{5}
+}
+func (m *QueryCrossExchangeRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCrossExchangeRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCrossExchangeRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCrossExchangeRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCrossExchangeRateResponse.Merge(m, src)
+}
+func (m *QueryCrossExchangeRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCrossExchangeRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCrossExchangeRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCrossExchangeRateResponse proto.InternalMessageInfo
+
+// QueryQuoteExchangeRatesRequest is the request type for the
+// Query/QuoteExchangeRates RPC method.
+type QueryQuoteExchangeRatesRequest struct {
+}
+
+func (m *QueryQuoteExchangeRatesRequest) Reset()         { *m = QueryQuoteExchangeRatesRequest{} }
+func (m *QueryQuoteExchangeRatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteExchangeRatesRequest) ProtoMessage()    {}
+func (*QueryQuoteExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fea2ade2446b6858, []int{6}
+}
+func (m *QueryQuoteExchangeRatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteExchangeRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteExchangeRatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuoteExchangeRatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteExchangeRatesRequest.Merge(m, src)
+}
+func (m *QueryQuoteExchangeRatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteExchangeRatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteExchangeRatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuoteExchangeRatesRequest proto.InternalMessageInfo
+
+// QueryQuoteExchangeRatesResponse is response type for the
+// Query/QuoteExchangeRates RPC method.
+type QueryQuoteExchangeRatesResponse struct {
+	// quote_exchange_rates defines a list of the exchange rate for all targets
+	// with non-reference quote currencies.
+	QuoteExchangeRates []QuoteExchangeRate `protobuf:"bytes,1,rep,name=quote_exchange_rates,json=quoteExchangeRates,proto3" json:"quote_exchange_rates"`
+}
+
+func (m *QueryQuoteExchangeRatesResponse) Reset()         { *m = QueryQuoteExchangeRatesResponse{} }
+func (m *QueryQuoteExchangeRatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteExchangeRatesResponse) ProtoMessage()    {}
+func (*QueryQuoteExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fea2ade2446b6858, []int{7}
+}
+func (m *QueryQuoteExchangeRatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteExchangeRatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteExchangeRatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuoteExchangeRatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteExchangeRatesResponse.Merge(m, src)
+}
+func (m *QueryQuoteExchangeRatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteExchangeRatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteExchangeRatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuoteExchangeRatesResponse proto.InternalMessageInfo
+
+func (m *QueryQuoteExchangeRatesResponse) GetQuoteExchangeRates() []QuoteExchangeRate {
+	if m != nil {
+		return m.QuoteExchangeRates
+	}
+	return nil
+}
+
 // QueryActivesRequest is the request type for the Query/Actives RPC method.
 type QueryActivesRequest struct {
 }
@@ -206,7 +375,7 @@ func (m *QueryActivesRequest) Reset()         { *m = QueryActivesRequest{} }
 func (m *QueryActivesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryActivesRequest) ProtoMessage()    {}
 func (*QueryActivesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fea2ade2446b6858, []int{4}
+	return fileDescriptor_fea2ade2446b6858, []int{8}
 }
 func (m *QueryActivesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -247,7 +416,7 @@ func (m *QueryActivesResponse) Reset()         { *m = QueryActivesResponse{} }
 func (m *QueryActivesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryActivesResponse) ProtoMessage()    {}
 func (*QueryActivesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fea2ade2446b6858, []int{5}
+	return fileDescriptor_fea2ade2446b6858, []int{9}
 }
 func (m *QueryActivesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -292,7 +461,7 @@ func (m *QueryVoteTargetsRequest) Reset()         { *m = QueryVoteTargetsRequest
 func (m *QueryVoteTargetsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteTargetsRequest) ProtoMessage()    {}
 func (*QueryVoteTargetsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fea2ade2446b6858, []int{6}
+	return fileDescriptor_fea2ade2446b6858, []int{10}
 }
 func (m *QueryVoteTargetsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -333,7 +502,7 @@ func (m *QueryVoteTargetsResponse) Reset()         { *m = QueryVoteTargetsRespon
 func (m *QueryVoteTargetsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteTargetsResponse) ProtoMessage()    {}
 func (*QueryVoteTargetsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fea2ade2446b6858, []int{7}
+	return fileDescriptor_fea2ade2446b6858, []int{11}
 }
 func (m *QueryVoteTargetsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -378,7 +547,7 @@ func (m *QueryTargetsRequest) Reset()         { *m = QueryTargetsRequest{} }
 func (m *QueryTargetsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTargetsRequest) ProtoMessage()    {}
 func (*QueryTargetsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fea2ade2446b6858, []int{8}
+	return fileDescriptor_fea2ade2446b6858, []int{12}
 }
 func (m *QueryTargetsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -419,7 +588,7 @@ func (m *QueryTargetsResponse) Reset()         { *m = QueryTargetsResponse{} }
 func (m *QueryTargetsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTargetsResponse) ProtoMessage()    {}
 func (*QueryTargetsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fea2ade2446b6858, []int{9}
+	return fileDescriptor_fea2ade2446b6858, []int{13}
 }
 func (m *QueryTargetsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -466,7 +635,7 @@ func (m *QueryFeederDelegationRequest) Reset()         { *m = QueryFeederDelegat
 func (m *QueryFeederDelegationRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFeederDelegationRequest) ProtoMessage()    {}
 func (*QueryFeederDelegationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fea2ade2446b6858, []int{10}
+	return fileDescriptor_fea2ade2446b6858, []int{14}
 }
 func (m *QueryFeederDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -506,7 +675,7 @@ func (m *QueryFeederDelegationResponse) Reset()         { *m = QueryFeederDelega
 func (m *QueryFeederDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFeederDelegationResponse) ProtoMessage()    {}
 func (*QueryFeederDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fea2ade2446b6858, []int{11}
+	return fileDescriptor_fea2ade2446b6858, []int{15}
 }
 func (m *QueryFeederDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -553,7 +722,7 @@ func (m *QueryMissCounterRequest) Reset()         { *m = QueryMissCounterRequest
 func (m *QueryMissCounterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMissCounterRequest) ProtoMessage()    {}
 func (*QueryMissCounterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fea2ade2446b6858, []int{12}
+	return fileDescriptor_fea2ade2446b6858, []int{16}
 }
 func (m *QueryMissCounterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -593,7 +762,7 @@ func (m *QueryMissCounterResponse) Reset()         { *m = QueryMissCounterRespon
 func (m *QueryMissCounterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMissCounterResponse) ProtoMessage()    {}
 func (*QueryMissCounterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fea2ade2446b6858, []int{13}
+	return fileDescriptor_fea2ade2446b6858, []int{17}
 }
 func (m *QueryMissCounterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -629,25 +798,25 @@ func (m *QueryMissCounterResponse) GetMissCounter() uint64 {
 	return 0
 }
 
-// QueryAggregatePrevoteRequest is the request type for the
-// Query/AggregatePrevote RPC method.
-type QueryAggregatePrevoteRequest struct {
+// QueryValidatorPerformanceRequest is the request type for the
+// Query/ValidatorPerformance RPC method.
+type QueryValidatorPerformanceRequest struct {
 	// validator defines the validator address to query for.
 	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-func (m *QueryAggregatePrevoteRequest) Reset()         { *m = QueryAggregatePrevoteRequest{} }
-func (m *QueryAggregatePrevoteRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAggregatePrevoteRequest) ProtoMessage()    {}
-func (*QueryAggregatePrevoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fea2ade2446b6858, []int{14}
+func (m *QueryValidatorPerformanceRequest) Reset()         { *m = QueryValidatorPerformanceRequest{} }
+func (m *QueryValidatorPerformanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorPerformanceRequest) ProtoMessage()    {}
+func (*QueryValidatorPerformanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fea2ade2446b6858, []int{18}
 }
-func (m *QueryAggregatePrevoteRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryValidatorPerformanceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAggregatePrevoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValidatorPerformanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAggregatePrevoteRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValidatorPerformanceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -657,38 +826,38 @@ func (m *QueryAggregatePrevoteRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAggregatePrevoteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAggregatePrevoteRequest.Merge(m, src)
+func (m *QueryValidatorPerformanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorPerformanceRequest.Merge(m, src)
 }
-func (m *QueryAggregatePrevoteRequest) XXX_Size() int {
+func (m *QueryValidatorPerformanceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAggregatePrevoteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAggregatePrevoteRequest.DiscardUnknown(m)
+func (m *QueryValidatorPerformanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorPerformanceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAggregatePrevoteRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryValidatorPerformanceRequest proto.InternalMessageInfo
 
-// QueryAggregatePrevoteResponse is response type for the
-// Query/AggregatePrevote RPC method.
-type QueryAggregatePrevoteResponse struct {
-	// aggregate_prevote defines oracle aggregate prevote submitted by a validator
-	// in the current vote period.
-	AggregatePrevote AggregateExchangeRatePrevote `protobuf:"bytes,1,opt,name=aggregate_prevote,json=aggregatePrevote,proto3" json:"aggregate_prevote"`
+// QueryValidatorPerformanceResponse is response type for the
+// Query/ValidatorPerformance RPC method.
+type QueryValidatorPerformanceResponse struct {
+	// performances defines the oracle voting statistics of a validator, ordered
+	// by slash window.
+	Performances []ValidatorPerformance `protobuf:"bytes,1,rep,name=performances,proto3" json:"performances"`
 }
 
-func (m *QueryAggregatePrevoteResponse) Reset()         { *m = QueryAggregatePrevoteResponse{} }
-func (m *QueryAggregatePrevoteResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAggregatePrevoteResponse) ProtoMessage()    {}
-func (*QueryAggregatePrevoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fea2ade2446b6858, []int{15}
+func (m *QueryValidatorPerformanceResponse) Reset()         { *m = QueryValidatorPerformanceResponse{} }
+func (m *QueryValidatorPerformanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorPerformanceResponse) ProtoMessage()    {}
+func (*QueryValidatorPerformanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fea2ade2446b6858, []int{19}
 }
-func (m *QueryAggregatePrevoteResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryValidatorPerformanceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAggregatePrevoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValidatorPerformanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAggregatePrevoteResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValidatorPerformanceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -698,42 +867,44 @@ func (m *QueryAggregatePrevoteResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryAggregatePrevoteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAggregatePrevoteResponse.Merge(m, src)
+func (m *QueryValidatorPerformanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorPerformanceResponse.Merge(m, src)
 }
-func (m *QueryAggregatePrevoteResponse) XXX_Size() int {
+func (m *QueryValidatorPerformanceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAggregatePrevoteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAggregatePrevoteResponse.DiscardUnknown(m)
+func (m *QueryValidatorPerformanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorPerformanceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAggregatePrevoteResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryValidatorPerformanceResponse proto.InternalMessageInfo
 
-func (m *QueryAggregatePrevoteResponse) GetAggregatePrevote() AggregateExchangeRatePrevote {
+func (m *QueryValidatorPerformanceResponse) GetPerformances() []ValidatorPerformance {
 	if m != nil {
-		return m.AggregatePrevote
+		return m.Performances
 	}
-	return AggregateExchangeRatePrevote{}
+	return nil
 }
 
-// QueryAggregatePrevotesRequest is the request type for the
-// Query/AggregatePrevotes RPC method.
-type QueryAggregatePrevotesRequest struct {
+// QueryValidatorPenaltyRequest is the request type for the
+// Query/ValidatorPenalty RPC method.
+type QueryValidatorPenaltyRequest struct {
+	// validator defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-func (m *QueryAggregatePrevotesRequest) Reset()         { *m = QueryAggregatePrevotesRequest{} }
-func (m *QueryAggregatePrevotesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAggregatePrevotesRequest) ProtoMessage()    {}
-func (*QueryAggregatePrevotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fea2ade2446b6858, []int{16}
+func (m *QueryValidatorPenaltyRequest) Reset()         { *m = QueryValidatorPenaltyRequest{} }
+func (m *QueryValidatorPenaltyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorPenaltyRequest) ProtoMessage()    {}
+func (*QueryValidatorPenaltyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fea2ade2446b6858, []int{20}
 }
-func (m *QueryAggregatePrevotesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryValidatorPenaltyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAggregatePrevotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValidatorPenaltyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAggregatePrevotesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValidatorPenaltyRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -743,38 +914,37 @@ func (m *QueryAggregatePrevotesRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryAggregatePrevotesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAggregatePrevotesRequest.Merge(m, src)
+func (m *QueryValidatorPenaltyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorPenaltyRequest.Merge(m, src)
 }
-func (m *QueryAggregatePrevotesRequest) XXX_Size() int {
+func (m *QueryValidatorPenaltyRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAggregatePrevotesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAggregatePrevotesRequest.DiscardUnknown(m)
+func (m *QueryValidatorPenaltyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorPenaltyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAggregatePrevotesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryValidatorPenaltyRequest proto.InternalMessageInfo
 
-// QueryAggregatePrevotesResponse is response type for the
-// Query/AggregatePrevotes RPC method.
-type QueryAggregatePrevotesResponse struct {
-	// aggregate_prevotes defines all oracle aggregate prevotes submitted in the
-	// current vote period.
-	AggregatePrevotes []AggregateExchangeRatePrevote `protobuf:"bytes,1,rep,name=aggregate_prevotes,json=aggregatePrevotes,proto3" json:"aggregate_prevotes"`
+// QueryValidatorPenaltyResponse is response type for the
+// Query/ValidatorPenalty RPC method.
+type QueryValidatorPenaltyResponse struct {
+	// penalty defines the oracle penalty tier of a validator.
+	Penalty ValidatorPenalty `protobuf:"bytes,1,opt,name=penalty,proto3" json:"penalty"`
 }
 
-func (m *QueryAggregatePrevotesResponse) Reset()         { *m = QueryAggregatePrevotesResponse{} }
-func (m *QueryAggregatePrevotesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAggregatePrevotesResponse) ProtoMessage()    {}
-func (*QueryAggregatePrevotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fea2ade2446b6858, []int{17}
+func (m *QueryValidatorPenaltyResponse) Reset()         { *m = QueryValidatorPenaltyResponse{} }
+func (m *QueryValidatorPenaltyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorPenaltyResponse) ProtoMessage()    {}
+func (*QueryValidatorPenaltyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fea2ade2446b6858, []int{21}
 }
-func (m *QueryAggregatePrevotesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryValidatorPenaltyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAggregatePrevotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValidatorPenaltyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAggregatePrevotesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValidatorPenaltyResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -784,44 +954,42 @@ func (m *QueryAggregatePrevotesResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryAggregatePrevotesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAggregatePrevotesResponse.Merge(m, src)
+func (m *QueryValidatorPenaltyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorPenaltyResponse.Merge(m, src)
 }
-func (m *QueryAggregatePrevotesResponse) XXX_Size() int {
+func (m *QueryValidatorPenaltyResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAggregatePrevotesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAggregatePrevotesResponse.DiscardUnknown(m)
+func (m *QueryValidatorPenaltyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorPenaltyResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAggregatePrevotesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryValidatorPenaltyResponse proto.InternalMessageInfo
 
-func (m *QueryAggregatePrevotesResponse) GetAggregatePrevotes() []AggregateExchangeRatePrevote {
+func (m *QueryValidatorPenaltyResponse) GetPenalty() ValidatorPenalty {
 	if m != nil {
-		return m.AggregatePrevotes
+		return m.Penalty
 	}
-	return nil
+	return ValidatorPenalty{}
 }
 
-// QueryAggregateVoteRequest is the request type for the Query/AggregateVote RPC
-// method.
-type QueryAggregateVoteRequest struct {
-	// validator defines the validator address to query for.
-	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+// QueryValidatorPenaltiesRequest is the request type for the
+// Query/ValidatorPenalties RPC method.
+type QueryValidatorPenaltiesRequest struct {
 }
 
-func (m *QueryAggregateVoteRequest) Reset()         { *m = QueryAggregateVoteRequest{} }
-func (m *QueryAggregateVoteRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAggregateVoteRequest) ProtoMessage()    {}
-func (*QueryAggregateVoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fea2ade2446b6858, []int{18}
+func (m *QueryValidatorPenaltiesRequest) Reset()         { *m = QueryValidatorPenaltiesRequest{} }
+func (m *QueryValidatorPenaltiesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorPenaltiesRequest) ProtoMessage()    {}
+func (*QueryValidatorPenaltiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fea2ade2446b6858, []int{22}
 }
-func (m *QueryAggregateVoteRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryValidatorPenaltiesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAggregateVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValidatorPenaltiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAggregateVoteRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValidatorPenaltiesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -831,38 +999,37 @@ func (m *QueryAggregateVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryAggregateVoteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAggregateVoteRequest.Merge(m, src)
+func (m *QueryValidatorPenaltiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorPenaltiesRequest.Merge(m, src)
 }
-func (m *QueryAggregateVoteRequest) XXX_Size() int {
+func (m *QueryValidatorPenaltiesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAggregateVoteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAggregateVoteRequest.DiscardUnknown(m)
+func (m *QueryValidatorPenaltiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorPenaltiesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAggregateVoteRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryValidatorPenaltiesRequest proto.InternalMessageInfo
 
-// QueryAggregateVoteResponse is response type for the
-// Query/AggregateVote RPC method.
-type QueryAggregateVoteResponse struct {
-	// aggregate_vote defines oracle aggregate vote submitted by a validator in
-	// the current vote period.
-	AggregateVote AggregateExchangeRateVote `protobuf:"bytes,1,opt,name=aggregate_vote,json=aggregateVote,proto3" json:"aggregate_vote"`
+// QueryValidatorPenaltiesResponse is response type for the
+// Query/ValidatorPenalties RPC method.
+type QueryValidatorPenaltiesResponse struct {
+	// penalties defines the oracle penalty tiers of all penalized validators.
+	Penalties []ValidatorPenalty `protobuf:"bytes,1,rep,name=penalties,proto3" json:"penalties"`
 }
 
-func (m *QueryAggregateVoteResponse) Reset()         { *m = QueryAggregateVoteResponse{} }
-func (m *QueryAggregateVoteResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAggregateVoteResponse) ProtoMessage()    {}
-func (*QueryAggregateVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fea2ade2446b6858, []int{19}
+func (m *QueryValidatorPenaltiesResponse) Reset()         { *m = QueryValidatorPenaltiesResponse{} }
+func (m *QueryValidatorPenaltiesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorPenaltiesResponse) ProtoMessage()    {}
+func (*QueryValidatorPenaltiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fea2ade2446b6858, []int{23}
 }
-func (m *QueryAggregateVoteResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryValidatorPenaltiesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAggregateVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValidatorPenaltiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAggregateVoteResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValidatorPenaltiesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -872,42 +1039,44 @@ func (m *QueryAggregateVoteResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryAggregateVoteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAggregateVoteResponse.Merge(m, src)
+func (m *QueryValidatorPenaltiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorPenaltiesResponse.Merge(m, src)
 }
-func (m *QueryAggregateVoteResponse) XXX_Size() int {
+func (m *QueryValidatorPenaltiesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAggregateVoteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAggregateVoteResponse.DiscardUnknown(m)
+func (m *QueryValidatorPenaltiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorPenaltiesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAggregateVoteResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryValidatorPenaltiesResponse proto.InternalMessageInfo
 
-func (m *QueryAggregateVoteResponse) GetAggregateVote() AggregateExchangeRateVote {
+func (m *QueryValidatorPenaltiesResponse) GetPenalties() []ValidatorPenalty {
 	if m != nil {
-		return m.AggregateVote
+		return m.Penalties
 	}
-	return AggregateExchangeRateVote{}
+	return nil
 }
 
-// QueryAggregateVotesRequest is the request type for the Query/AggregateVotes
-// RPC method.
-type QueryAggregateVotesRequest struct {
+// QueryAggregatePrevoteRequest is the request type for the
+// Query/AggregatePrevote RPC method.
+type QueryAggregatePrevoteRequest struct {
+	// validator defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-func (m *QueryAggregateVotesRequest) Reset()         { *m = QueryAggregateVotesRequest{} }
-func (m *QueryAggregateVotesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAggregateVotesRequest) ProtoMessage()    {}
-func (*QueryAggregateVotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fea2ade2446b6858, []int{20}
+func (m *QueryAggregatePrevoteRequest) Reset()         { *m = QueryAggregatePrevoteRequest{} }
+func (m *QueryAggregatePrevoteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregatePrevoteRequest) ProtoMessage()    {}
+func (*QueryAggregatePrevoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fea2ade2446b6858, []int{24}
 }
-func (m *QueryAggregateVotesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAggregatePrevoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAggregateVotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAggregatePrevoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAggregateVotesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAggregatePrevoteRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -917,38 +1086,38 @@ func (m *QueryAggregateVotesRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryAggregateVotesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAggregateVotesRequest.Merge(m, src)
+func (m *QueryAggregatePrevoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregatePrevoteRequest.Merge(m, src)
 }
-func (m *QueryAggregateVotesRequest) XXX_Size() int {
+func (m *QueryAggregatePrevoteRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAggregateVotesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAggregateVotesRequest.DiscardUnknown(m)
+func (m *QueryAggregatePrevoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregatePrevoteRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAggregateVotesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAggregatePrevoteRequest proto.InternalMessageInfo
 
-// QueryAggregateVotesResponse is response type for the
-// Query/AggregateVotes RPC method.
-type QueryAggregateVotesResponse struct {
-	// aggregate_votes defines all oracle aggregate votes submitted in the current
-	// vote period.
-	AggregateVotes []AggregateExchangeRateVote `protobuf:"bytes,1,rep,name=aggregate_votes,json=aggregateVotes,proto3" json:"aggregate_votes"`
+// QueryAggregatePrevoteResponse is response type for the
+// Query/AggregatePrevote RPC method.
+type QueryAggregatePrevoteResponse struct {
+	// aggregate_prevote defines oracle aggregate prevote submitted by a validator
+	// in the current vote period.
+	AggregatePrevote AggregateExchangeRatePrevote `protobuf:"bytes,1,opt,name=aggregate_prevote,json=aggregatePrevote,proto3" json:"aggregate_prevote"`
 }
 
-func (m *QueryAggregateVotesResponse) Reset()         { *m = QueryAggregateVotesResponse{} }
-func (m *QueryAggregateVotesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAggregateVotesResponse) ProtoMessage()    {}
-func (*QueryAggregateVotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fea2ade2446b6858, []int{21}
+func (m *QueryAggregatePrevoteResponse) Reset()         { *m = QueryAggregatePrevoteResponse{} }
+func (m *QueryAggregatePrevoteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregatePrevoteResponse) ProtoMessage()    {}
+func (*QueryAggregatePrevoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fea2ade2446b6858, []int{25}
 }
-func (m *QueryAggregateVotesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAggregatePrevoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAggregateVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAggregatePrevoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAggregateVotesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAggregatePrevoteResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -958,41 +1127,42 @@ func (m *QueryAggregateVotesResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAggregateVotesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAggregateVotesResponse.Merge(m, src)
+func (m *QueryAggregatePrevoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregatePrevoteResponse.Merge(m, src)
 }
-func (m *QueryAggregateVotesResponse) XXX_Size() int {
+func (m *QueryAggregatePrevoteResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAggregateVotesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAggregateVotesResponse.DiscardUnknown(m)
+func (m *QueryAggregatePrevoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregatePrevoteResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAggregateVotesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAggregatePrevoteResponse proto.InternalMessageInfo
 
-func (m *QueryAggregateVotesResponse) GetAggregateVotes() []AggregateExchangeRateVote {
+func (m *QueryAggregatePrevoteResponse) GetAggregatePrevote() AggregateExchangeRatePrevote {
 	if m != nil {
-		return m.AggregateVotes
+		return m.AggregatePrevote
 	}
-	return nil
+	return AggregateExchangeRatePrevote{}
 }
 
-// QueryParamsRequest is request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+// QueryAggregatePrevotesRequest is the request type for the
+// Query/AggregatePrevotes RPC method.
+type QueryAggregatePrevotesRequest struct {
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fea2ade2446b6858, []int{22}
+func (m *QueryAggregatePrevotesRequest) Reset()         { *m = QueryAggregatePrevotesRequest{} }
+func (m *QueryAggregatePrevotesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregatePrevotesRequest) ProtoMessage()    {}
+func (*QueryAggregatePrevotesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fea2ade2446b6858, []int{26}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAggregatePrevotesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAggregatePrevotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAggregatePrevotesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1002,36 +1172,38 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryAggregatePrevotesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregatePrevotesRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryAggregatePrevotesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryAggregatePrevotesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregatePrevotesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAggregatePrevotesRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	// params holds all the parameters of this module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+// QueryAggregatePrevotesResponse is response type for the
+// Query/AggregatePrevotes RPC method.
+type QueryAggregatePrevotesResponse struct {
+	// aggregate_prevotes defines all oracle aggregate prevotes submitted in the
+	// current vote period.
+	AggregatePrevotes []AggregateExchangeRatePrevote `protobuf:"bytes,1,rep,name=aggregate_prevotes,json=aggregatePrevotes,proto3" json:"aggregate_prevotes"`
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fea2ade2446b6858, []int{23}
+func (m *QueryAggregatePrevotesResponse) Reset()         { *m = QueryAggregatePrevotesResponse{} }
+func (m *QueryAggregatePrevotesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregatePrevotesResponse) ProtoMessage()    {}
+func (*QueryAggregatePrevotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fea2ade2446b6858, []int{27}
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAggregatePrevotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAggregatePrevotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAggregatePrevotesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1041,605 +1213,1091 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryAggregatePrevotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregatePrevotesResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryAggregatePrevotesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryAggregatePrevotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregatePrevotesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAggregatePrevotesResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *QueryAggregatePrevotesResponse) GetAggregatePrevotes() []AggregateExchangeRatePrevote {
 	if m != nil {
-		return m.Params
+		return m.AggregatePrevotes
 	}
-	return Params{}
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryExchangeRateRequest)(nil), "blackfury.oracle.v1.QueryExchangeRateRequest")
-	proto.RegisterType((*QueryExchangeRateResponse)(nil), "blackfury.oracle.v1.QueryExchangeRateResponse")
-	proto.RegisterType((*QueryExchangeRatesRequest)(nil), "blackfury.oracle.v1.QueryExchangeRatesRequest")
-	proto.RegisterType((*QueryExchangeRatesResponse)(nil), "blackfury.oracle.v1.QueryExchangeRatesResponse")
-	proto.RegisterType((*QueryActivesRequest)(nil), "blackfury.oracle.v1.QueryActivesRequest")
-	proto.RegisterType((*QueryActivesResponse)(nil), "blackfury.oracle.v1.QueryActivesResponse")
-	proto.RegisterType((*QueryVoteTargetsRequest)(nil), "blackfury.oracle.v1.QueryVoteTargetsRequest")
-	proto.RegisterType((*QueryVoteTargetsResponse)(nil), "blackfury.oracle.v1.QueryVoteTargetsResponse")
-	proto.RegisterType((*QueryTargetsRequest)(nil), "blackfury.oracle.v1.QueryTargetsRequest")
-	proto.RegisterType((*QueryTargetsResponse)(nil), "blackfury.oracle.v1.QueryTargetsResponse")
-	proto.RegisterType((*QueryFeederDelegationRequest)(nil), "blackfury.oracle.v1.QueryFeederDelegationRequest")
-	proto.RegisterType((*QueryFeederDelegationResponse)(nil), "blackfury.oracle.v1.QueryFeederDelegationResponse")
-	proto.RegisterType((*QueryMissCounterRequest)(nil), "blackfury.oracle.v1.QueryMissCounterRequest")
-	proto.RegisterType((*QueryMissCounterResponse)(nil), "blackfury.oracle.v1.QueryMissCounterResponse")
-	proto.RegisterType((*QueryAggregatePrevoteRequest)(nil), "blackfury.oracle.v1.QueryAggregatePrevoteRequest")
-	proto.RegisterType((*QueryAggregatePrevoteResponse)(nil), "blackfury.oracle.v1.QueryAggregatePrevoteResponse")
-	proto.RegisterType((*QueryAggregatePrevotesRequest)(nil), "blackfury.oracle.v1.QueryAggregatePrevotesRequest")
-	proto.RegisterType((*QueryAggregatePrevotesResponse)(nil), "blackfury.oracle.v1.QueryAggregatePrevotesResponse")
-	proto.RegisterType((*QueryAggregateVoteRequest)(nil), "blackfury.oracle.v1.QueryAggregateVoteRequest")
-	proto.RegisterType((*QueryAggregateVoteResponse)(nil), "blackfury.oracle.v1.QueryAggregateVoteResponse")
-	proto.RegisterType((*QueryAggregateVotesRequest)(nil), "blackfury.oracle.v1.QueryAggregateVotesRequest")
-	proto.RegisterType((*QueryAggregateVotesResponse)(nil), "blackfury.oracle.v1.QueryAggregateVotesResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "blackfury.oracle.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "blackfury.oracle.v1.QueryParamsResponse")
+// QueryAggregateVoteRequest is the request type for the Query/AggregateVote RPC
+// method.
+type QueryAggregateVoteRequest struct {
+	// validator defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-func init() { proto.RegisterFile("blackfury/oracle/v1/query.proto", fileDescriptor_fea2ade2446b6858) }
-
-var fileDescriptor_fea2ade2446b6858 = []byte{
-	// 1134 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xc6, 0x3d, 0xdf, 0x6f, 0x9b, 0xd0, 0xd7, 0x71, 0x9a, 0x4c, 0x83, 0x48, 0x9d, 0x60, 0x87,
-	0x45, 0x2d, 0xee, 0x8f, 0xec, 0xc6, 0x0e, 0x28, 0x4a, 0xa4, 0xaa, 0x24, 0x0d, 0x20, 0x55, 0x45,
-	0x04, 0x83, 0x72, 0x00, 0xa1, 0x68, 0xbc, 0x3b, 0xde, 0xae, 0x6a, 0x7b, 0xdc, 0x9d, 0xb5, 0x15,
-	0xab, 0xf4, 0x82, 0x84, 0x54, 0xa9, 0x17, 0x24, 0x24, 0x4e, 0x1c, 0x7a, 0xe0, 0x00, 0x88, 0x3f,
-	0x00, 0xb8, 0x23, 0xf5, 0x58, 0x89, 0x0b, 0xe2, 0x50, 0x50, 0xc2, 0x81, 0x7f, 0x81, 0x1b, 0xda,
-	0xd9, 0xd9, 0xf5, 0xae, 0xbd, 0xbb, 0x59, 0x87, 0x93, 0xe3, 0x99, 0x77, 0x9e, 0xf7, 0x33, 0xcf,
-	0x8e, 0xe7, 0xc9, 0x42, 0xb9, 0xd1, 0x22, 0xfa, 0xbd, 0x66, 0xcf, 0x1e, 0x68, 0xcc, 0x26, 0x7a,
-	0x8b, 0x6a, 0xfd, 0xaa, 0x76, 0xbf, 0x47, 0xed, 0x81, 0xda, 0xb5, 0x99, 0xc3, 0xf0, 0x85, 0xa0,
-	0x40, 0xf5, 0x0a, 0xd4, 0x7e, 0xb5, 0xb8, 0x60, 0x32, 0x93, 0x89, 0x79, 0xcd, 0xfd, 0xcb, 0x2b,
-	0x2d, 0x2e, 0x9b, 0x8c, 0x99, 0x2d, 0xaa, 0x91, 0xae, 0xa5, 0x91, 0x4e, 0x87, 0x39, 0xc4, 0xb1,
-	0x58, 0x87, 0xcb, 0xd9, 0x92, 0xce, 0x78, 0x9b, 0x71, 0xad, 0x41, 0xb8, 0xdb, 0xa4, 0x41, 0x1d,
-	0x52, 0xd5, 0x74, 0x66, 0x75, 0xe4, 0xfc, 0x4a, 0x1c, 0x89, 0x6c, 0x29, 0x2a, 0x94, 0x2d, 0x58,
-	0x7c, 0xdf, 0x25, 0x7b, 0xeb, 0x50, 0xbf, 0x4b, 0x3a, 0x26, 0xad, 0x13, 0x87, 0xd6, 0xe9, 0xfd,
-	0x1e, 0xe5, 0x0e, 0x5e, 0x80, 0xb3, 0x06, 0xed, 0xb0, 0xf6, 0x22, 0x5a, 0x41, 0x95, 0x73, 0x75,
-	0xef, 0xcb, 0xd6, 0x0b, 0x8f, 0x9e, 0x94, 0x73, 0x7f, 0x3f, 0x29, 0xe7, 0x94, 0x2e, 0x5c, 0x8c,
-	0x59, 0xcb, 0xbb, 0xac, 0xc3, 0x29, 0xfe, 0x00, 0x0a, 0x54, 0x8e, 0x1f, 0xd8, 0xc4, 0xa1, 0x9e,
-	0xc8, 0x8e, 0xfa, 0xf4, 0x79, 0x39, 0xf7, 0xfb, 0xf3, 0xf2, 0x65, 0xd3, 0x72, 0xee, 0xf6, 0x1a,
-	0xaa, 0xce, 0xda, 0x9a, 0xdc, 0x84, 0xf7, 0xb1, 0xca, 0x8d, 0x7b, 0x9a, 0x33, 0xe8, 0x52, 0xae,
-	0xee, 0x52, 0xbd, 0x3e, 0x43, 0x43, 0xe2, 0xca, 0x52, 0x4c, 0x47, 0x2e, 0x71, 0x95, 0xaf, 0x10,
-	0x14, 0xe3, 0x66, 0x25, 0xd0, 0x21, 0xcc, 0x46, 0x80, 0xf8, 0x22, 0x5a, 0xf9, 0x7f, 0x25, 0x5f,
-	0x5b, 0x56, 0xbd, 0xc6, 0xaa, 0x6b, 0xa2, 0x2a, 0x4d, 0x74, 0x7b, 0xdf, 0x62, 0x56, 0x67, 0x67,
-	0xdd, 0xe5, 0xfd, 0xfe, 0x8f, 0xf2, 0xb5, 0x6c, 0xbc, 0xee, 0x1a, 0x5e, 0x2f, 0x84, 0xa1, 0xb9,
-	0xf2, 0x22, 0x5c, 0x10, 0x5c, 0xdb, 0xba, 0x63, 0xf5, 0x87, 0xbc, 0x6b, 0xb0, 0x10, 0x1d, 0x96,
-	0xa0, 0x8b, 0x30, 0x4d, 0xbc, 0x21, 0x41, 0x78, 0xae, 0xee, 0x7f, 0x55, 0x2e, 0xc2, 0x4b, 0x62,
-	0xc5, 0x3e, 0x73, 0xe8, 0x87, 0xc4, 0x36, 0xa9, 0x13, 0x88, 0xdd, 0x90, 0xcf, 0x31, 0x32, 0x25,
-	0x05, 0x5f, 0x81, 0x99, 0x3e, 0x73, 0xe8, 0x81, 0xe3, 0x8d, 0x4b, 0xd5, 0x7c, 0x7f, 0x58, 0x1a,
-	0x20, 0x8e, 0xa8, 0xfa, 0x88, 0xa3, 0x8a, 0x8b, 0x30, 0x1d, 0x15, 0xf3, 0xbf, 0x2a, 0xef, 0xc1,
-	0xb2, 0x58, 0xf1, 0x36, 0xa5, 0x06, 0xb5, 0x77, 0x69, 0x8b, 0x9a, 0xe2, 0xc4, 0xfa, 0x67, 0xea,
-	0x12, 0xcc, 0xf6, 0x49, 0xcb, 0x32, 0x88, 0xc3, 0xec, 0x03, 0x62, 0x18, 0xb6, 0x3c, 0x5c, 0x85,
-	0x60, 0x74, 0xdb, 0x30, 0xec, 0xd0, 0x21, 0x7b, 0x13, 0x5e, 0x4e, 0x10, 0x94, 0x2c, 0x65, 0xc8,
-	0x37, 0xc5, 0x5c, 0x58, 0x0e, 0xbc, 0x21, 0x57, 0x4b, 0xb9, 0x2d, 0x5d, 0x7b, 0xd7, 0xe2, 0xfc,
-	0x16, 0xeb, 0x75, 0x1c, 0x6a, 0x9f, 0x9a, 0xc6, 0xb7, 0x39, 0xa2, 0x35, 0xb4, 0xb9, 0x6d, 0x71,
-	0x7e, 0xa0, 0x7b, 0xe3, 0x42, 0xea, 0x4c, 0x3d, 0xdf, 0x1e, 0x96, 0x06, 0xee, 0x6c, 0x9b, 0xa6,
-	0xed, 0xee, 0x83, 0xee, 0xd9, 0xd4, 0x7d, 0x0c, 0xa7, 0xe6, 0xf9, 0x1c, 0x49, 0x7b, 0xc6, 0x15,
-	0x25, 0x95, 0x01, 0xf3, 0xc4, 0x9f, 0x3b, 0xe8, 0x7a, 0x93, 0x42, 0x35, 0x5f, 0xab, 0xaa, 0x31,
-	0xf7, 0x90, 0x1a, 0x28, 0x85, 0x7f, 0x46, 0x52, 0x75, 0xe7, 0x8c, 0xfb, 0x73, 0xa8, 0xcf, 0x91,
-	0x91, 0x6e, 0x4a, 0x39, 0x01, 0x23, 0x38, 0x49, 0x8f, 0x10, 0x94, 0x92, 0x2a, 0x24, 0x69, 0x13,
-	0xf0, 0x18, 0xa9, 0xff, 0x23, 0x3d, 0x35, 0xea, 0xfc, 0x28, 0x2a, 0x57, 0xee, 0xc8, 0x4b, 0x24,
-	0x58, 0xbd, 0xff, 0x5f, 0x9e, 0xc0, 0x40, 0x5e, 0x3a, 0x23, 0x6a, 0x72, 0x4f, 0x1f, 0xc3, 0xec,
-	0x70, 0x4f, 0x21, 0xeb, 0xd5, 0xec, 0xfb, 0xd9, 0x1f, 0x6e, 0xa6, 0x40, 0xc2, 0x4d, 0x94, 0xe5,
-	0xb8, 0xd6, 0x81, 0xe3, 0x9f, 0xc2, 0x52, 0xec, 0xac, 0x24, 0xfb, 0x04, 0xce, 0x47, 0xc9, 0x7c,
-	0xab, 0x4f, 0x87, 0x36, 0x1b, 0x41, 0xe3, 0xca, 0x02, 0x60, 0xd1, 0x7d, 0x8f, 0xd8, 0xa4, 0x1d,
-	0x30, 0xed, 0xc9, 0x6b, 0xc6, 0x1f, 0x95, 0x2c, 0x9b, 0x30, 0xd5, 0x15, 0x23, 0xd2, 0x9d, 0xa5,
-	0x58, 0x04, 0x6f, 0x91, 0xec, 0x27, 0x17, 0xd4, 0xfe, 0x39, 0x0f, 0x67, 0x85, 0x24, 0xfe, 0x16,
-	0xc1, 0x4c, 0x18, 0x0e, 0xaf, 0xc6, 0xaa, 0x24, 0xa5, 0x5d, 0x51, 0xcd, 0x5a, 0xee, 0x41, 0x2b,
-	0x9b, 0x9f, 0xfd, 0xfa, 0xd7, 0x97, 0xff, 0x5b, 0xc7, 0x55, 0x2d, 0x2e, 0x64, 0x45, 0x56, 0x72,
-	0xed, 0x81, 0xf8, 0x7c, 0xa8, 0x45, 0x92, 0x07, 0x7f, 0x83, 0xa0, 0x10, 0x09, 0x29, 0x9c, 0xb1,
-	0xb9, 0x6f, 0x64, 0x51, 0xcb, 0x5c, 0x2f, 0x69, 0x6b, 0x82, 0xf6, 0x3a, 0xbe, 0x9a, 0x46, 0x1b,
-	0xcd, 0x47, 0xfc, 0x18, 0xc1, 0xb4, 0x0c, 0x27, 0x5c, 0x49, 0x6e, 0x18, 0x8d, 0xb5, 0xe2, 0x95,
-	0x0c, 0x95, 0x12, 0xea, 0x9a, 0x80, 0xba, 0x84, 0x5f, 0x4d, 0x83, 0x92, 0xe1, 0x87, 0xbf, 0x46,
-	0x90, 0x0f, 0xa5, 0x1b, 0xbe, 0x9e, 0xdc, 0x67, 0x3c, 0x1f, 0x8b, 0xab, 0x19, 0xab, 0x25, 0xd9,
-	0x9a, 0x20, 0xbb, 0x8a, 0x2b, 0x69, 0x64, 0xe1, 0x50, 0x15, 0x66, 0xf9, 0x68, 0x29, 0x66, 0x8d,
-	0x60, 0x5d, 0xc9, 0x50, 0x39, 0x89, 0x59, 0x3e, 0xcd, 0xcf, 0x08, 0xe6, 0x46, 0x13, 0x13, 0x57,
-	0x93, 0x9b, 0x25, 0xc4, 0x75, 0xb1, 0x36, 0xc9, 0x12, 0x09, 0x7a, 0x53, 0x80, 0x6e, 0xe2, 0x8d,
-	0x58, 0xd0, 0xe0, 0x1e, 0xe5, 0xda, 0x83, 0xe8, 0x4d, 0xfb, 0x50, 0xf3, 0x42, 0x1b, 0x7f, 0x87,
-	0x20, 0x1f, 0x0a, 0xd8, 0xb4, 0x27, 0x3d, 0x9e, 0xe9, 0x69, 0x4f, 0x3a, 0x26, 0xb5, 0x95, 0x1b,
-	0x82, 0x76, 0x03, 0xbf, 0x31, 0x31, 0xad, 0x1b, 0xec, 0xf8, 0x17, 0x04, 0x73, 0xa3, 0x91, 0x96,
-	0x66, 0x74, 0x42, 0xf2, 0xa7, 0x19, 0x9d, 0x14, 0xed, 0xca, 0x6d, 0x81, 0xbe, 0x8b, 0x77, 0x26,
-	0x46, 0x1f, 0xcb, 0x59, 0xfc, 0x23, 0x82, 0xf9, 0xb1, 0x68, 0xc6, 0x13, 0x50, 0x05, 0x47, 0x7a,
-	0x7d, 0xa2, 0x35, 0x72, 0x2b, 0x5b, 0x62, 0x2b, 0xaf, 0xe3, 0xda, 0x49, 0x5b, 0x19, 0xff, 0x0f,
-	0x01, 0xff, 0x84, 0xa0, 0x10, 0x09, 0xb9, 0xb4, 0xdb, 0x34, 0x2e, 0xf4, 0xd3, 0x6e, 0xd3, 0xd8,
-	0x58, 0x57, 0xde, 0x11, 0xb8, 0xdb, 0xf8, 0x66, 0x12, 0xae, 0x61, 0x9d, 0xe8, 0xbc, 0xb0, 0xfd,
-	0x07, 0x04, 0xb3, 0xd1, 0x80, 0xc6, 0x59, 0x61, 0x02, 0xc3, 0xd7, 0xb2, 0x2f, 0x90, 0xf8, 0x1b,
-	0x02, 0xbf, 0x8a, 0xb5, 0xec, 0x6e, 0x7b, 0x56, 0x3f, 0x46, 0x30, 0xe5, 0xc5, 0x30, 0x7e, 0x2d,
-	0xb9, 0x6b, 0x24, 0xf3, 0x8b, 0x95, 0x93, 0x0b, 0x25, 0x96, 0x2a, 0xb0, 0x2a, 0xf8, 0xb2, 0xe6,
-	0x16, 0x13, 0xd6, 0x6c, 0x5a, 0xba, 0x45, 0x5a, 0xe3, 0x90, 0x5e, 0xf6, 0xef, 0xdc, 0x79, 0x7a,
-	0x54, 0x42, 0xcf, 0x8e, 0x4a, 0xe8, 0xcf, 0xa3, 0x12, 0xfa, 0xe2, 0xb8, 0x94, 0x7b, 0x76, 0x5c,
-	0xca, 0xfd, 0x76, 0x5c, 0xca, 0x7d, 0x54, 0x0b, 0xbd, 0xad, 0xd1, 0xd6, 0x80, 0x5b, 0xbd, 0x36,
-	0xf7, 0xde, 0x9c, 0x43, 0x62, 0x87, 0xbe, 0x9c, 0x78, 0x7b, 0x6b, 0x4c, 0x89, 0x17, 0xe2, 0xf5,
-	0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x6e, 0x84, 0x52, 0xbe, 0x0f, 0x00, 0x00,
+func (m *QueryAggregateVoteRequest) Reset()         { *m = QueryAggregateVoteRequest{} }
+func (m *QueryAggregateVoteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregateVoteRequest) ProtoMessage()    {}
+func (*QueryAggregateVoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fea2ade2446b6858, []int{28}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// ExchangeRate returns exchange rate of a denom.
-	ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
-	// ExchangeRates returns exchange rates of all denoms.
-	ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error)
-	// Actives returns all active denoms.
-	Actives(ctx context.Context, in *QueryActivesRequest, opts ...grpc.CallOption) (*QueryActivesResponse, error)
-	// VoteTargets returns all vote target denoms.
-	VoteTargets(ctx context.Context, in *QueryVoteTargetsRequest, opts ...grpc.CallOption) (*QueryVoteTargetsResponse, error)
-	// Targets returns all target denoms (including vote targets).
-	Targets(ctx context.Context, in *QueryTargetsRequest, opts ...grpc.CallOption) (*QueryTargetsResponse, error)
-	// FeederDelegation returns feeder delegation of a validator.
-	FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error)
-	// MissCounter returns oracle miss counter of a validator.
-	MissCounter(ctx context.Context, in *QueryMissCounterRequest, opts ...grpc.CallOption) (*QueryMissCounterResponse, error)
-	// AggregatePrevote returns an aggregate prevote of a validator.
-	AggregatePrevote(ctx context.Context, in *QueryAggregatePrevoteRequest, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error)
-	// AggregatePrevotes returns aggregate prevotes of all validators.
-	AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotesRequest, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error)
-	// AggregateVote returns an aggregate vote of a validator.
-	AggregateVote(ctx context.Context, in *QueryAggregateVoteRequest, opts ...grpc.CallOption) (*QueryAggregateVoteResponse, error)
-	// AggregateVotes returns aggregate votes of all validators.
-	AggregateVotes(ctx context.Context, in *QueryAggregateVotesRequest, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error)
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryAggregateVoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryAggregateVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregateVoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryAggregateVoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregateVoteRequest.Merge(m, src)
 }
-
-func (c *queryClient) ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error) {
-	out := new(QueryExchangeRateResponse)
-	err := c.cc.Invoke(ctx, "/blackfury.oracle.v1.Query/ExchangeRate", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryAggregateVoteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregateVoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregateVoteRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error) {
-	out := new(QueryExchangeRatesResponse)
-	err := c.cc.Invoke(ctx, "/blackfury.oracle.v1.Query/ExchangeRates", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *queryClient) Actives(ctx context.Context, in *QueryActivesRequest, opts ...grpc.CallOption) (*QueryActivesResponse, error) {
-	out := new(QueryActivesResponse)
-	err := c.cc.Invoke(ctx, "/blackfury.oracle.v1.Query/Actives", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryAggregateVoteRequest proto.InternalMessageInfo
 
-func (c *queryClient) VoteTargets(ctx context.Context, in *QueryVoteTargetsRequest, opts ...grpc.CallOption) (*QueryVoteTargetsResponse, error) {
-	out := new(QueryVoteTargetsResponse)
-	err := c.cc.Invoke(ctx, "/blackfury.oracle.v1.Query/VoteTargets", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryAggregateVoteResponse is response type for the
+// Query/AggregateVote RPC method.
+type QueryAggregateVoteResponse struct {
+	// aggregate_vote defines oracle aggregate vote submitted by a validator in
+	// the current vote period.
+	AggregateVote AggregateExchangeRateVote `protobuf:"bytes,1,opt,name=aggregate_vote,json=aggregateVote,proto3" json:"aggregate_vote"`
 }
 
-func (c *queryClient) Targets(ctx context.Context, in *QueryTargetsRequest, opts ...grpc.CallOption) (*QueryTargetsResponse, error) {
-	out := new(QueryTargetsResponse)
-	err := c.cc.Invoke(ctx, "/blackfury.oracle.v1.Query/Targets", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryAggregateVoteResponse) Reset()         { *m = QueryAggregateVoteResponse{} }
+func (m *QueryAggregateVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregateVoteResponse) ProtoMessage()    {}
+func (*QueryAggregateVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fea2ade2446b6858, []int{29}
 }
-
-func (c *queryClient) FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error) {
-	out := new(QueryFeederDelegationResponse)
-	err := c.cc.Invoke(ctx, "/blackfury.oracle.v1.Query/FeederDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryAggregateVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) MissCounter(ctx context.Context, in *QueryMissCounterRequest, opts ...grpc.CallOption) (*QueryMissCounterResponse, error) {
-	out := new(QueryMissCounterResponse)
-	err := c.cc.Invoke(ctx, "/blackfury.oracle.v1.Query/MissCounter", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAggregateVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregateVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) AggregatePrevote(ctx context.Context, in *QueryAggregatePrevoteRequest, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error) {
-	out := new(QueryAggregatePrevoteResponse)
-	err := c.cc.Invoke(ctx, "/blackfury.oracle.v1.Query/AggregatePrevote", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryAggregateVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregateVoteResponse.Merge(m, src)
 }
-
-func (c *queryClient) AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotesRequest, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error) {
-	out := new(QueryAggregatePrevotesResponse)
-	err := c.cc.Invoke(ctx, "/blackfury.oracle.v1.Query/AggregatePrevotes", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryAggregateVoteResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) AggregateVote(ctx context.Context, in *QueryAggregateVoteRequest, opts ...grpc.CallOption) (*QueryAggregateVoteResponse, error) {
-	out := new(QueryAggregateVoteResponse)
-	err := c.cc.Invoke(ctx, "/blackfury.oracle.v1.Query/AggregateVote", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryAggregateVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregateVoteResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) AggregateVotes(ctx context.Context, in *QueryAggregateVotesRequest, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error) {
-	out := new(QueryAggregateVotesResponse)
-	err := c.cc.Invoke(ctx, "/blackfury.oracle.v1.Query/AggregateVotes", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryAggregateVoteResponse proto.InternalMessageInfo
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/blackfury.oracle.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAggregateVoteResponse) GetAggregateVote() AggregateExchangeRateVote {
+	if m != nil {
+		return m.AggregateVote
 	}
-	return out, nil
+	return AggregateExchangeRateVote{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// ExchangeRate returns exchange rate of a denom.
-	ExchangeRate(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
-	// ExchangeRates returns exchange rates of all denoms.
-	ExchangeRates(context.Context, *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error)
-	// Actives returns all active denoms.
-	Actives(context.Context, *QueryActivesRequest) (*QueryActivesResponse, error)
-	// VoteTargets returns all vote target denoms.
-	VoteTargets(context.Context, *QueryVoteTargetsRequest) (*QueryVoteTargetsResponse, error)
-	// Targets returns all target denoms (including vote targets).
-	Targets(context.Context, *QueryTargetsRequest) (*QueryTargetsResponse, error)
-	// FeederDelegation returns feeder delegation of a validator.
-	FeederDelegation(context.Context, *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error)
-	// MissCounter returns oracle miss counter of a validator.
-	MissCounter(context.Context, *QueryMissCounterRequest) (*QueryMissCounterResponse, error)
-	// AggregatePrevote returns an aggregate prevote of a validator.
-	AggregatePrevote(context.Context, *QueryAggregatePrevoteRequest) (*QueryAggregatePrevoteResponse, error)
-	// AggregatePrevotes returns aggregate prevotes of all validators.
-	AggregatePrevotes(context.Context, *QueryAggregatePrevotesRequest) (*QueryAggregatePrevotesResponse, error)
-	// AggregateVote returns an aggregate vote of a validator.
-	AggregateVote(context.Context, *QueryAggregateVoteRequest) (*QueryAggregateVoteResponse, error)
-	// AggregateVotes returns aggregate votes of all validators.
-	AggregateVotes(context.Context, *QueryAggregateVotesRequest) (*QueryAggregateVotesResponse, error)
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+// QueryAggregateVotesRequest is the request type for the Query/AggregateVotes
+// RPC method.
+type QueryAggregateVotesRequest struct {
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryAggregateVotesRequest) Reset()         { *m = QueryAggregateVotesRequest{} }
+func (m *QueryAggregateVotesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregateVotesRequest) ProtoMessage()    {}
+func (*QueryAggregateVotesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fea2ade2446b6858, []int{30}
 }
-
-func (*UnimplementedQueryServer) ExchangeRate(ctx context.Context, req *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRate not implemented")
+func (m *QueryAggregateVotesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ExchangeRates(ctx context.Context, req *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRates not implemented")
+func (m *QueryAggregateVotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregateVotesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Actives(ctx context.Context, req *QueryActivesRequest) (*QueryActivesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Actives not implemented")
+func (m *QueryAggregateVotesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregateVotesRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) VoteTargets(ctx context.Context, req *QueryVoteTargetsRequest) (*QueryVoteTargetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VoteTargets not implemented")
+func (m *QueryAggregateVotesRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Targets(ctx context.Context, req *QueryTargetsRequest) (*QueryTargetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Targets not implemented")
+func (m *QueryAggregateVotesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregateVotesRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) FeederDelegation(ctx context.Context, req *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FeederDelegation not implemented")
+
+var xxx_messageInfo_QueryAggregateVotesRequest proto.InternalMessageInfo
+
+// QueryAggregateVotesResponse is response type for the
+// Query/AggregateVotes RPC method.
+type QueryAggregateVotesResponse struct {
+	// aggregate_votes defines all oracle aggregate votes submitted in the current
+	// vote period.
+	AggregateVotes []AggregateExchangeRateVote `protobuf:"bytes,1,rep,name=aggregate_votes,json=aggregateVotes,proto3" json:"aggregate_votes"`
 }
-func (*UnimplementedQueryServer) MissCounter(ctx context.Context, req *QueryMissCounterRequest) (*QueryMissCounterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MissCounter not implemented")
+
+func (m *QueryAggregateVotesResponse) Reset()         { *m = QueryAggregateVotesResponse{} }
+func (m *QueryAggregateVotesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregateVotesResponse) ProtoMessage()    {}
+func (*QueryAggregateVotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fea2ade2446b6858, []int{31}
 }
-func (*UnimplementedQueryServer) AggregatePrevote(ctx context.Context, req *QueryAggregatePrevoteRequest) (*QueryAggregatePrevoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevote not implemented")
+func (m *QueryAggregateVotesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) AggregatePrevotes(ctx context.Context, req *QueryAggregatePrevotesRequest) (*QueryAggregatePrevotesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevotes not implemented")
+func (m *QueryAggregateVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregateVotesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) AggregateVote(ctx context.Context, req *QueryAggregateVoteRequest) (*QueryAggregateVoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregateVote not implemented")
+func (m *QueryAggregateVotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregateVotesResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) AggregateVotes(ctx context.Context, req *QueryAggregateVotesRequest) (*QueryAggregateVotesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregateVotes not implemented")
+func (m *QueryAggregateVotesResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryAggregateVotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregateVotesResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryAggregateVotesResponse proto.InternalMessageInfo
 
-func _Query_ExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryExchangeRateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ExchangeRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/blackfury.oracle.v1.Query/ExchangeRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ExchangeRate(ctx, req.(*QueryExchangeRateRequest))
+func (m *QueryAggregateVotesResponse) GetAggregateVotes() []AggregateExchangeRateVote {
+	if m != nil {
+		return m.AggregateVotes
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryExchangeRatesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ExchangeRates(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/blackfury.oracle.v1.Query/ExchangeRates",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ExchangeRates(ctx, req.(*QueryExchangeRatesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
 }
 
-func _Query_Actives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryActivesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Actives(ctx, in)
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fea2ade2446b6858, []int{32}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/blackfury.oracle.v1.Query/Actives",
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fea2ade2446b6858, []int{33}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Actives(ctx, req.(*QueryActivesRequest))
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return interceptor(ctx, in, info, handler)
+	return Params{}
 }
 
-func _Query_VoteTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVoteTargetsRequest)
-	if err := dec(in); err != nil {
+func init() {
+	proto.RegisterType((*QueryExchangeRateRequest)(nil), "blackfury.oracle.v1.QueryExchangeRateRequest")
+	proto.RegisterType((*QueryExchangeRateResponse)(nil), "blackfury.oracle.v1.QueryExchangeRateResponse")
+	proto.RegisterType((*QueryExchangeRatesRequest)(nil), "blackfury.oracle.v1.QueryExchangeRatesRequest")
+	proto.RegisterType((*QueryExchangeRatesResponse)(nil), "blackfury.oracle.v1.QueryExchangeRatesResponse")
+	proto.RegisterType((*QueryCrossExchangeRateRequest)(nil), "blackfury.oracle.v1.QueryCrossExchangeRateRequest")
+	proto.RegisterType((*QueryCrossExchangeRateResponse)(nil), "blackfury.oracle.v1.QueryCrossExchangeRateResponse")
+	proto.RegisterType((*QueryQuoteExchangeRatesRequest)(nil), "blackfury.oracle.v1.QueryQuoteExchangeRatesRequest")
+	proto.RegisterType((*QueryQuoteExchangeRatesResponse)(nil), "blackfury.oracle.v1.QueryQuoteExchangeRatesResponse")
+	proto.RegisterType((*QueryActivesRequest)(nil), "blackfury.oracle.v1.QueryActivesRequest")
+	proto.RegisterType((*QueryActivesResponse)(nil), "blackfury.oracle.v1.QueryActivesResponse")
+	proto.RegisterType((*QueryVoteTargetsRequest)(nil), "blackfury.oracle.v1.QueryVoteTargetsRequest")
+	proto.RegisterType((*QueryVoteTargetsResponse)(nil), "blackfury.oracle.v1.QueryVoteTargetsResponse")
+	proto.RegisterType((*QueryTargetsRequest)(nil), "blackfury.oracle.v1.QueryTargetsRequest")
+	proto.RegisterType((*QueryTargetsResponse)(nil), "blackfury.oracle.v1.QueryTargetsResponse")
+	proto.RegisterType((*QueryFeederDelegationRequest)(nil), "blackfury.oracle.v1.QueryFeederDelegationRequest")
+	proto.RegisterType((*QueryFeederDelegationResponse)(nil), "blackfury.oracle.v1.QueryFeederDelegationResponse")
+	proto.RegisterType((*QueryMissCounterRequest)(nil), "blackfury.oracle.v1.QueryMissCounterRequest")
+	proto.RegisterType((*QueryMissCounterResponse)(nil), "blackfury.oracle.v1.QueryMissCounterResponse")
+	proto.RegisterType((*QueryValidatorPerformanceRequest)(nil), "blackfury.oracle.v1.QueryValidatorPerformanceRequest")
+	proto.RegisterType((*QueryValidatorPerformanceResponse)(nil), "blackfury.oracle.v1.QueryValidatorPerformanceResponse")
+	proto.RegisterType((*QueryValidatorPenaltyRequest)(nil), "blackfury.oracle.v1.QueryValidatorPenaltyRequest")
+	proto.RegisterType((*QueryValidatorPenaltyResponse)(nil), "blackfury.oracle.v1.QueryValidatorPenaltyResponse")
+	proto.RegisterType((*QueryValidatorPenaltiesRequest)(nil), "blackfury.oracle.v1.QueryValidatorPenaltiesRequest")
+	proto.RegisterType((*QueryValidatorPenaltiesResponse)(nil), "blackfury.oracle.v1.QueryValidatorPenaltiesResponse")
+	proto.RegisterType((*QueryAggregatePrevoteRequest)(nil), "blackfury.oracle.v1.QueryAggregatePrevoteRequest")
+	proto.RegisterType((*QueryAggregatePrevoteResponse)(nil), "blackfury.oracle.v1.QueryAggregatePrevoteResponse")
+	proto.RegisterType((*QueryAggregatePrevotesRequest)(nil), "blackfury.oracle.v1.QueryAggregatePrevotesRequest")
+	proto.RegisterType((*QueryAggregatePrevotesResponse)(nil), "blackfury.oracle.v1.QueryAggregatePrevotesResponse")
+	proto.RegisterType((*QueryAggregateVoteRequest)(nil), "blackfury.oracle.v1.QueryAggregateVoteRequest")
+	proto.RegisterType((*QueryAggregateVoteResponse)(nil), "blackfury.oracle.v1.QueryAggregateVoteResponse")
+	proto.RegisterType((*QueryAggregateVotesRequest)(nil), "blackfury.oracle.v1.QueryAggregateVotesRequest")
+	proto.RegisterType((*QueryAggregateVotesResponse)(nil), "blackfury.oracle.v1.QueryAggregateVotesResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "blackfury.oracle.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "blackfury.oracle.v1.QueryParamsResponse")
+}
+
+func init() { proto.RegisterFile("blackfury/oracle/v1/query.proto", fileDescriptor_fea2ade2446b6858) }
+
+var fileDescriptor_fea2ade2446b6858 = []byte{
+	// 1457 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xcd, 0x6f, 0xdc, 0xc4,
+	0x1b, 0xc7, 0x33, 0xfd, 0xb5, 0xcd, 0xaf, 0xcf, 0x26, 0x21, 0x99, 0x06, 0x75, 0xeb, 0x26, 0xbb,
+	0xa9, 0x51, 0x4b, 0xd2, 0x36, 0x76, 0x76, 0xd3, 0xd2, 0x36, 0xa2, 0xb4, 0x49, 0x53, 0x10, 0x55,
+	0x11, 0xe9, 0x16, 0xf5, 0x00, 0x82, 0xc8, 0xd9, 0x9d, 0xdd, 0x5a, 0xdd, 0x5d, 0x6f, 0x3c, 0xde,
+	0x28, 0x51, 0xe9, 0x01, 0x24, 0xa4, 0x4a, 0xbd, 0x20, 0x21, 0x71, 0xe2, 0xd0, 0x03, 0x07, 0x40,
+	0x9c, 0xb8, 0xf0, 0x26, 0x71, 0x43, 0xaa, 0xc4, 0xa5, 0x12, 0x17, 0xc4, 0xa1, 0xa0, 0x86, 0x03,
+	0x7f, 0x06, 0xf2, 0xf8, 0xb1, 0xd7, 0xf6, 0xda, 0x8e, 0xbd, 0x88, 0x53, 0xb2, 0x33, 0xcf, 0xcb,
+	0xe7, 0xf9, 0x7a, 0x6c, 0x7f, 0x65, 0x28, 0x6e, 0x34, 0xb5, 0xea, 0xdd, 0x7a, 0xd7, 0xdc, 0x51,
+	0x0d, 0x53, 0xab, 0x36, 0x99, 0xba, 0x55, 0x52, 0x37, 0xbb, 0xcc, 0xdc, 0x51, 0x3a, 0xa6, 0x61,
+	0x19, 0xf4, 0xb0, 0x17, 0xa0, 0x38, 0x01, 0xca, 0x56, 0x49, 0x9a, 0x6c, 0x18, 0x0d, 0x43, 0xec,
+	0xab, 0xf6, 0x7f, 0x4e, 0xa8, 0x34, 0xd5, 0x30, 0x8c, 0x46, 0x93, 0xa9, 0x5a, 0x47, 0x57, 0xb5,
+	0x76, 0xdb, 0xb0, 0x34, 0x4b, 0x37, 0xda, 0x1c, 0x77, 0x0b, 0x55, 0x83, 0xb7, 0x0c, 0xae, 0x6e,
+	0x68, 0xdc, 0x6e, 0xb2, 0xc1, 0x2c, 0xad, 0xa4, 0x56, 0x0d, 0xbd, 0x8d, 0xfb, 0x33, 0x51, 0x24,
+	0xd8, 0x52, 0x44, 0xc8, 0x4b, 0x90, 0xbf, 0x69, 0x93, 0x5d, 0xdb, 0xae, 0xde, 0xd1, 0xda, 0x0d,
+	0x56, 0xd1, 0x2c, 0x56, 0x61, 0x9b, 0x5d, 0xc6, 0x2d, 0x3a, 0x09, 0x07, 0x6a, 0xac, 0x6d, 0xb4,
+	0xf2, 0x64, 0x86, 0xcc, 0x1e, 0xaa, 0x38, 0x3f, 0x96, 0xfe, 0xff, 0xe0, 0x51, 0x71, 0xe8, 0xef,
+	0x47, 0xc5, 0x21, 0xb9, 0x03, 0x47, 0x23, 0x72, 0x79, 0xc7, 0x68, 0x73, 0x46, 0x6f, 0xc1, 0x28,
+	0xc3, 0xf5, 0x75, 0x53, 0xb3, 0x98, 0x53, 0x64, 0x45, 0x79, 0xfc, 0xb4, 0x38, 0xf4, 0xfb, 0xd3,
+	0xe2, 0xc9, 0x86, 0x6e, 0xdd, 0xe9, 0x6e, 0x28, 0x55, 0xa3, 0xa5, 0xe2, 0x10, 0xce, 0x9f, 0x79,
+	0x5e, 0xbb, 0xab, 0x5a, 0x3b, 0x1d, 0xc6, 0x95, 0x55, 0x56, 0xad, 0x8c, 0x30, 0x5f, 0x71, 0xf9,
+	0x58, 0x44, 0x47, 0x8e, 0xb8, 0xf2, 0xa7, 0x04, 0xa4, 0xa8, 0x5d, 0x04, 0xda, 0x86, 0xb1, 0x00,
+	0x10, 0xcf, 0x93, 0x99, 0xff, 0xcd, 0xe6, 0xca, 0x53, 0x8a, 0xd3, 0x58, 0xb1, 0x45, 0x54, 0x50,
+	0x44, 0xbb, 0xf7, 0x55, 0x43, 0x6f, 0xaf, 0x2c, 0xda, 0xbc, 0x5f, 0xfd, 0x51, 0x3c, 0x9d, 0x8e,
+	0xd7, 0xce, 0xe1, 0x95, 0x51, 0x3f, 0x34, 0x97, 0x6f, 0xc1, 0xb4, 0xe0, 0xba, 0x6a, 0x1a, 0x9c,
+	0x47, 0x09, 0x4d, 0x61, 0xbf, 0xdd, 0x1c, 0x75, 0x16, 0xff, 0xdb, 0xe2, 0x6f, 0x76, 0x0d, 0x8b,
+	0xe5, 0xf7, 0x39, 0xe2, 0x8b, 0x1f, 0x3e, 0xf1, 0xbb, 0x50, 0x88, 0x2b, 0xfa, 0x5f, 0x5e, 0x81,
+	0x19, 0x6c, 0x7b, 0xd3, 0xc6, 0x89, 0xbc, 0x0c, 0x1f, 0x10, 0x28, 0xc6, 0x86, 0x20, 0xda, 0x7b,
+	0x30, 0x29, 0xe6, 0x59, 0x8f, 0xbc, 0x22, 0x27, 0x95, 0x88, 0xfb, 0x43, 0xe9, 0x2b, 0xb7, 0xb2,
+	0xdf, 0x9e, 0xa4, 0x42, 0x37, 0xfb, 0xfa, 0xc8, 0xcf, 0xc3, 0x61, 0x81, 0xb0, 0x5c, 0xb5, 0xf4,
+	0xad, 0x1e, 0xda, 0x02, 0x4c, 0x06, 0x97, 0x11, 0x27, 0x0f, 0xc3, 0x9a, 0xb3, 0x24, 0x08, 0x0e,
+	0x55, 0xdc, 0x9f, 0xf2, 0x51, 0x38, 0x22, 0x32, 0x6e, 0x1b, 0x16, 0x7b, 0x4b, 0x33, 0x1b, 0xcc,
+	0xf2, 0x8a, 0x5d, 0x82, 0x7c, 0xff, 0x16, 0x16, 0x3c, 0x0e, 0x23, 0x5b, 0xf6, 0x78, 0x96, 0xb3,
+	0x8e, 0x55, 0x73, 0x5b, 0xbd, 0x50, 0x0f, 0x31, 0x54, 0xd5, 0x45, 0x0c, 0x57, 0xcc, 0xc3, 0x70,
+	0xb0, 0x98, 0xfb, 0x53, 0x7e, 0x13, 0xa6, 0x44, 0xc6, 0xab, 0x8c, 0xd5, 0x98, 0xb9, 0xca, 0x9a,
+	0xac, 0x21, 0x9e, 0x11, 0xee, 0xe1, 0x3a, 0x01, 0x63, 0x5b, 0x5a, 0x53, 0xaf, 0x69, 0x96, 0x61,
+	0xae, 0x6b, 0xb5, 0x9a, 0x89, 0xc7, 0x6c, 0xd4, 0x5b, 0x5d, 0xae, 0xd5, 0x4c, 0xdf, 0xc9, 0xba,
+	0x02, 0xd3, 0x31, 0x05, 0x91, 0xa5, 0x08, 0xb9, 0xba, 0xd8, 0xf3, 0x97, 0x03, 0x67, 0xc9, 0xae,
+	0x25, 0x5f, 0x47, 0xd5, 0xde, 0xd0, 0x39, 0xbf, 0x6a, 0x74, 0xdb, 0x16, 0x33, 0x07, 0xa6, 0x71,
+	0x65, 0x0e, 0xd4, 0xea, 0xc9, 0xdc, 0xd2, 0x39, 0x5f, 0xaf, 0x3a, 0xeb, 0xa2, 0xd4, 0xfe, 0x4a,
+	0xae, 0xd5, 0x0b, 0x95, 0x6f, 0xc1, 0x8c, 0x73, 0x95, 0xdc, 0xf2, 0x6b, 0xcc, 0xac, 0x1b, 0x66,
+	0x4b, 0x6b, 0x57, 0xd9, 0xc0, 0x4c, 0xdb, 0x70, 0x3c, 0xa1, 0xa8, 0x77, 0xfb, 0x8d, 0x74, 0x7a,
+	0xcb, 0xee, 0xd9, 0x9e, 0x8b, 0x3c, 0xdb, 0x51, 0x85, 0xf0, 0x78, 0x07, 0x8a, 0x78, 0x17, 0xdb,
+	0x97, 0xd0, 0xd6, 0x9a, 0xd6, 0xce, 0xc0, 0xa3, 0xd4, 0x61, 0x3a, 0xa6, 0x20, 0x8e, 0x71, 0x0d,
+	0x86, 0x3b, 0xce, 0x92, 0x28, 0x95, 0x2b, 0x9f, 0xd8, 0x6b, 0x02, 0x11, 0x8c, 0xf4, 0x6e, 0xae,
+	0xf7, 0xdc, 0x08, 0xc5, 0xe9, 0xbd, 0x9b, 0xb3, 0x09, 0xc5, 0xd8, 0x08, 0x64, 0x79, 0x1d, 0x0e,
+	0x75, 0xdc, 0x45, 0xd4, 0x33, 0x13, 0x4d, 0x2f, 0xdb, 0x13, 0x72, 0xb9, 0xd1, 0x30, 0xed, 0xf3,
+	0xcd, 0xd6, 0x4c, 0x66, 0xdf, 0x9e, 0x03, 0x0b, 0xf9, 0x11, 0x81, 0xe9, 0x98, 0x8a, 0x48, 0x5f,
+	0x83, 0x09, 0xcd, 0xdd, 0x5b, 0xef, 0x38, 0x9b, 0xa8, 0x69, 0x29, 0x72, 0x0a, 0xaf, 0x92, 0xff,
+	0xe1, 0x86, 0x55, 0x71, 0xa2, 0x71, 0x2d, 0xd4, 0x4d, 0x2e, 0xc6, 0x60, 0x78, 0x3a, 0x3f, 0x20,
+	0x50, 0x88, 0x8b, 0x40, 0xd2, 0x3a, 0xd0, 0x3e, 0x52, 0x57, 0xf0, 0x81, 0x51, 0x27, 0xc2, 0xa8,
+	0x5c, 0xbe, 0x81, 0xaf, 0x73, 0x2f, 0xfb, 0xf6, 0xbf, 0xb9, 0x02, 0x3b, 0x20, 0x45, 0x55, 0xc3,
+	0x99, 0xde, 0x81, 0xb1, 0xde, 0x4c, 0x3e, 0xe9, 0x95, 0xf4, 0xf3, 0xdc, 0xee, 0x0d, 0x33, 0xaa,
+	0xf9, 0x9b, 0xc8, 0x53, 0x51, 0xad, 0x3d, 0xc5, 0xdf, 0x87, 0x63, 0x91, 0xbb, 0x48, 0xf6, 0x2e,
+	0x3c, 0x17, 0x24, 0x73, 0xa5, 0x1e, 0x0c, 0x6d, 0x2c, 0x80, 0xc6, 0xe5, 0x49, 0xa0, 0xa2, 0xfb,
+	0x9a, 0x66, 0x6a, 0x2d, 0x8f, 0x69, 0x0d, 0x0e, 0x07, 0x56, 0x91, 0xe5, 0x22, 0x1c, 0xec, 0x88,
+	0x15, 0x54, 0xe7, 0x58, 0x24, 0x82, 0x93, 0x84, 0xfd, 0x30, 0xa1, 0xfc, 0xed, 0x11, 0x38, 0x20,
+	0x4a, 0xd2, 0x2f, 0x08, 0x8c, 0xf8, 0xe1, 0xe8, 0x7c, 0xcc, 0x0b, 0x3d, 0xda, 0x77, 0x4a, 0x4a,
+	0xda, 0x70, 0x07, 0x5a, 0xbe, 0xf8, 0xe1, 0xaf, 0x7f, 0x7d, 0xb2, 0x6f, 0x91, 0x96, 0xd4, 0x28,
+	0xbb, 0x2b, 0x5c, 0x2b, 0x57, 0xef, 0x89, 0xbf, 0xf7, 0xd5, 0x80, 0xe3, 0xa0, 0x9f, 0x13, 0x18,
+	0xf5, 0xd7, 0xe4, 0x34, 0x65, 0x73, 0x57, 0x48, 0x49, 0x4d, 0x1d, 0x8f, 0xb4, 0x65, 0x41, 0x7b,
+	0x86, 0x9e, 0x4a, 0xa2, 0x0d, 0xfa, 0x22, 0xfa, 0x13, 0x81, 0x89, 0x3e, 0xa3, 0x47, 0xcb, 0xf1,
+	0xad, 0xe3, 0xac, 0xa6, 0xb4, 0x98, 0x29, 0x07, 0x91, 0xaf, 0x08, 0xe4, 0x25, 0x7a, 0x21, 0x51,
+	0x60, 0xdb, 0xb6, 0x86, 0xf4, 0x55, 0xef, 0x09, 0x73, 0x76, 0x9f, 0x7e, 0x4f, 0xec, 0x53, 0x18,
+	0xf6, 0x69, 0x34, 0x81, 0x26, 0xd6, 0x60, 0x4a, 0x67, 0xb3, 0x25, 0xe1, 0x0c, 0x17, 0xc4, 0x0c,
+	0x65, 0xba, 0x90, 0x34, 0x43, 0x94, 0x29, 0xa5, 0x0f, 0x09, 0x0c, 0xa3, 0x63, 0xa4, 0xb3, 0xf1,
+	0xbd, 0x83, 0x5e, 0x53, 0x9a, 0x4b, 0x11, 0x89, 0x68, 0xa7, 0x05, 0xda, 0x09, 0xfa, 0x42, 0x12,
+	0x1a, 0x3a, 0x52, 0xfa, 0x19, 0x81, 0x9c, 0xcf, 0x72, 0xd2, 0x33, 0xf1, 0x7d, 0xfa, 0x4d, 0xab,
+	0x34, 0x9f, 0x32, 0x1a, 0xc9, 0x16, 0x04, 0xd9, 0x29, 0x3a, 0x9b, 0x44, 0xe6, 0x77, 0xba, 0x42,
+	0x2c, 0x17, 0x2d, 0x41, 0xac, 0x10, 0xd6, 0x5c, 0x8a, 0xc8, 0x2c, 0x62, 0xb9, 0x34, 0x3f, 0x10,
+	0x18, 0x0f, 0xdb, 0x58, 0x5a, 0x8a, 0x6f, 0x16, 0xe3, 0xa1, 0xa5, 0x72, 0x96, 0x14, 0x04, 0xbd,
+	0x2c, 0x40, 0x2f, 0xd2, 0xf3, 0x91, 0xa0, 0xde, 0x4b, 0x8c, 0xab, 0xf7, 0x82, 0xaf, 0xb9, 0xfb,
+	0xaa, 0xe3, 0xa4, 0xe9, 0x97, 0x04, 0x72, 0x3e, 0xd7, 0x9b, 0x74, 0xa5, 0xfb, 0x8d, 0xb6, 0x34,
+	0x9f, 0x32, 0x1a, 0x69, 0x2f, 0x09, 0xda, 0xf3, 0xf4, 0x5c, 0x66, 0x5a, 0xdb, 0x6d, 0xd3, 0x5f,
+	0x08, 0x4c, 0x46, 0x99, 0x58, 0x7a, 0x2e, 0xe1, 0xc0, 0xc5, 0x5b, 0x72, 0xe9, 0xa5, 0xac, 0x69,
+	0x38, 0xc6, 0xaa, 0x18, 0xe3, 0x15, 0xfa, 0x72, 0xe6, 0x31, 0x7c, 0x36, 0x9b, 0xfe, 0x48, 0x60,
+	0x3c, 0x6c, 0x21, 0x93, 0x8e, 0x4d, 0x8c, 0x1b, 0x97, 0xca, 0x59, 0x52, 0x52, 0x3d, 0x6b, 0x93,
+	0x27, 0x70, 0x40, 0xbf, 0x21, 0x40, 0xfb, 0x4d, 0x74, 0xd2, 0xb3, 0x36, 0xd6, 0x94, 0x4b, 0x67,
+	0xb3, 0x25, 0xe1, 0x0c, 0x25, 0x31, 0xc3, 0x69, 0x3a, 0xb7, 0xd7, 0x0c, 0x9e, 0x1f, 0xa7, 0x3f,
+	0x13, 0x18, 0x0f, 0x1b, 0xd2, 0x24, 0xc9, 0x63, 0x7c, 0xbb, 0x54, 0xce, 0x92, 0x82, 0xb8, 0xd7,
+	0x05, 0xee, 0x2a, 0x5d, 0xc9, 0x2c, 0x79, 0x9f, 0x4b, 0xa6, 0xdf, 0x11, 0x98, 0x08, 0x37, 0xe2,
+	0x34, 0x03, 0x15, 0x4f, 0xf1, 0xa6, 0x8e, 0x75, 0xee, 0xf2, 0x92, 0x18, 0xe5, 0x2c, 0x2d, 0xef,
+	0x35, 0x4a, 0xbf, 0xbf, 0xb7, 0xdf, 0xd1, 0xa3, 0x01, 0x8b, 0x9a, 0xe4, 0x85, 0xa2, 0x2c, 0xbb,
+	0xa4, 0xa6, 0x8e, 0x47, 0xdc, 0xd7, 0x04, 0xee, 0x32, 0xbd, 0x1c, 0x87, 0x5b, 0xd3, 0xf7, 0x54,
+	0x5e, 0xc8, 0xfe, 0x35, 0x81, 0xb1, 0x40, 0x0b, 0x4e, 0xd3, 0xc2, 0x78, 0x82, 0x2f, 0xa4, 0x4f,
+	0x40, 0xfc, 0xf3, 0x02, 0xbf, 0x44, 0xd5, 0xf4, 0x6a, 0x3b, 0x52, 0x3f, 0x24, 0x70, 0xd0, 0x31,
+	0xd1, 0xf4, 0xc5, 0xf8, 0xae, 0x01, 0xc7, 0x2e, 0xcd, 0xee, 0x1d, 0x88, 0x58, 0x8a, 0xc0, 0x9a,
+	0xa5, 0x27, 0x55, 0x3b, 0x58, 0x33, 0xea, 0x75, 0xbd, 0xaa, 0x6b, 0xcd, 0x7e, 0x48, 0xc7, 0xb9,
+	0xaf, 0xdc, 0x78, 0xfc, 0xac, 0x40, 0x9e, 0x3c, 0x2b, 0x90, 0x3f, 0x9f, 0x15, 0xc8, 0xc7, 0xbb,
+	0x85, 0xa1, 0x27, 0xbb, 0x85, 0xa1, 0xdf, 0x76, 0x0b, 0x43, 0x6f, 0x97, 0x7d, 0xdf, 0x08, 0x59,
+	0x73, 0x87, 0xeb, 0xdd, 0x16, 0x77, 0xbe, 0x40, 0xfb, 0x8a, 0x6d, 0xbb, 0xe5, 0xc4, 0x37, 0xc3,
+	0x8d, 0x83, 0xe2, 0xc3, 0xf2, 0xe2, 0x3f, 0x03, 0x00, 0x91, 0xb1, 0x8f, 0x16, 0x06, 0x17, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ExchangeRate returns exchange rate of a denom.
+	ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
+	// ExchangeRates returns exchange rates of all denoms.
+	ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error)
+	// CrossExchangeRate returns exchange rate of a denom denominated in another
+	// denom.
+	CrossExchangeRate(ctx context.Context, in *QueryCrossExchangeRateRequest, opts ...grpc.CallOption) (*QueryCrossExchangeRateResponse, error)
+	// QuoteExchangeRates returns exchange rates of all targets denominated in
+	// their non-reference quote currencies.
+	QuoteExchangeRates(ctx context.Context, in *QueryQuoteExchangeRatesRequest, opts ...grpc.CallOption) (*QueryQuoteExchangeRatesResponse, error)
+	// Actives returns all active denoms.
+	Actives(ctx context.Context, in *QueryActivesRequest, opts ...grpc.CallOption) (*QueryActivesResponse, error)
+	// VoteTargets returns all vote target denoms.
+	VoteTargets(ctx context.Context, in *QueryVoteTargetsRequest, opts ...grpc.CallOption) (*QueryVoteTargetsResponse, error)
+	// Targets returns all target denoms (including vote targets).
+	Targets(ctx context.Context, in *QueryTargetsRequest, opts ...grpc.CallOption) (*QueryTargetsResponse, error)
+	// FeederDelegation returns feeder delegation of a validator.
+	FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error)
+	// MissCounter returns oracle miss counter of a validator.
+	MissCounter(ctx context.Context, in *QueryMissCounterRequest, opts ...grpc.CallOption) (*QueryMissCounterResponse, error)
+	// ValidatorPerformance returns oracle voting statistics of a validator in
+	// the recent slash windows.
+	ValidatorPerformance(ctx context.Context, in *QueryValidatorPerformanceRequest, opts ...grpc.CallOption) (*QueryValidatorPerformanceResponse, error)
+	// ValidatorPenalty returns the oracle penalty tier of a validator.
+	ValidatorPenalty(ctx context.Context, in *QueryValidatorPenaltyRequest, opts ...grpc.CallOption) (*QueryValidatorPenaltyResponse, error)
+	// ValidatorPenalties returns the oracle penalty tiers of all penalized
+	// validators.
+	ValidatorPenalties(ctx context.Context, in *QueryValidatorPenaltiesRequest, opts ...grpc.CallOption) (*QueryValidatorPenaltiesResponse, error)
+	// AggregatePrevote returns an aggregate prevote of a validator.
+	AggregatePrevote(ctx context.Context, in *QueryAggregatePrevoteRequest, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error)
+	// AggregatePrevotes returns aggregate prevotes of all validators.
+	AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotesRequest, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error)
+	// AggregateVote returns an aggregate vote of a validator.
+	AggregateVote(ctx context.Context, in *QueryAggregateVoteRequest, opts ...grpc.CallOption) (*QueryAggregateVoteResponse, error)
+	// AggregateVotes returns aggregate votes of all validators.
+	AggregateVotes(ctx context.Context, in *QueryAggregateVotesRequest, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error)
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error) {
+	out := new(QueryExchangeRateResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.oracle.v1.Query/ExchangeRate", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).VoteTargets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/blackfury.oracle.v1.Query/VoteTargets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VoteTargets(ctx, req.(*QueryVoteTargetsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_Targets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTargetsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error) {
+	out := new(QueryExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.oracle.v1.Query/ExchangeRates", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Targets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/blackfury.oracle.v1.Query/Targets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Targets(ctx, req.(*QueryTargetsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_FeederDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFeederDelegationRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) CrossExchangeRate(ctx context.Context, in *QueryCrossExchangeRateRequest, opts ...grpc.CallOption) (*QueryCrossExchangeRateResponse, error) {
+	out := new(QueryCrossExchangeRateResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.oracle.v1.Query/CrossExchangeRate", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).FeederDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/blackfury.oracle.v1.Query/FeederDelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FeederDelegation(ctx, req.(*QueryFeederDelegationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_MissCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMissCounterRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QuoteExchangeRates(ctx context.Context, in *QueryQuoteExchangeRatesRequest, opts ...grpc.CallOption) (*QueryQuoteExchangeRatesResponse, error) {
+	out := new(QueryQuoteExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.oracle.v1.Query/QuoteExchangeRates", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).MissCounter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/blackfury.oracle.v1.Query/MissCounter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MissCounter(ctx, req.(*QueryMissCounterRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_AggregatePrevote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAggregatePrevoteRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) Actives(ctx context.Context, in *QueryActivesRequest, opts ...grpc.CallOption) (*QueryActivesResponse, error) {
+	out := new(QueryActivesResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.oracle.v1.Query/Actives", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AggregatePrevote(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/blackfury.oracle.v1.Query/AggregatePrevote",
+	return out, nil
+}
+
+func (c *queryClient) VoteTargets(ctx context.Context, in *QueryVoteTargetsRequest, opts ...grpc.CallOption) (*QueryVoteTargetsResponse, error) {
+	out := new(QueryVoteTargetsResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.oracle.v1.Query/VoteTargets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Targets(ctx context.Context, in *QueryTargetsRequest, opts ...grpc.CallOption) (*QueryTargetsResponse, error) {
+	out := new(QueryTargetsResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.oracle.v1.Query/Targets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error) {
+	out := new(QueryFeederDelegationResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.oracle.v1.Query/FeederDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MissCounter(ctx context.Context, in *QueryMissCounterRequest, opts ...grpc.CallOption) (*QueryMissCounterResponse, error) {
+	out := new(QueryMissCounterResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.oracle.v1.Query/MissCounter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorPerformance(ctx context.Context, in *QueryValidatorPerformanceRequest, opts ...grpc.CallOption) (*QueryValidatorPerformanceResponse, error) {
+	out := new(QueryValidatorPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.oracle.v1.Query/ValidatorPerformance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorPenalty(ctx context.Context, in *QueryValidatorPenaltyRequest, opts ...grpc.CallOption) (*QueryValidatorPenaltyResponse, error) {
+	out := new(QueryValidatorPenaltyResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.oracle.v1.Query/ValidatorPenalty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorPenalties(ctx context.Context, in *QueryValidatorPenaltiesRequest, opts ...grpc.CallOption) (*QueryValidatorPenaltiesResponse, error) {
+	out := new(QueryValidatorPenaltiesResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.oracle.v1.Query/ValidatorPenalties", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AggregatePrevote(ctx context.Context, in *QueryAggregatePrevoteRequest, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error) {
+	out := new(QueryAggregatePrevoteResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.oracle.v1.Query/AggregatePrevote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotesRequest, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error) {
+	out := new(QueryAggregatePrevotesResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.oracle.v1.Query/AggregatePrevotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AggregateVote(ctx context.Context, in *QueryAggregateVoteRequest, opts ...grpc.CallOption) (*QueryAggregateVoteResponse, error) {
+	out := new(QueryAggregateVoteResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.oracle.v1.Query/AggregateVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AggregateVotes(ctx context.Context, in *QueryAggregateVotesRequest, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error) {
+	out := new(QueryAggregateVotesResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.oracle.v1.Query/AggregateVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.oracle.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ExchangeRate returns exchange rate of a denom.
+	ExchangeRate(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
+	// ExchangeRates returns exchange rates of all denoms.
+	ExchangeRates(context.Context, *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error)
+	// CrossExchangeRate returns exchange rate of a denom denominated in another
+	// denom.
+	CrossExchangeRate(context.Context, *QueryCrossExchangeRateRequest) (*QueryCrossExchangeRateResponse, error)
+	// QuoteExchangeRates returns exchange rates of all targets denominated in
+	// their non-reference quote currencies.
+	QuoteExchangeRates(context.Context, *QueryQuoteExchangeRatesRequest) (*QueryQuoteExchangeRatesResponse, error)
+	// Actives returns all active denoms.
+	Actives(context.Context, *QueryActivesRequest) (*QueryActivesResponse, error)
+	// VoteTargets returns all vote target denoms.
+	VoteTargets(context.Context, *QueryVoteTargetsRequest) (*QueryVoteTargetsResponse, error)
+	// Targets returns all target denoms (including vote targets).
+	Targets(context.Context, *QueryTargetsRequest) (*QueryTargetsResponse, error)
+	// FeederDelegation returns feeder delegation of a validator.
+	FeederDelegation(context.Context, *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error)
+	// MissCounter returns oracle miss counter of a validator.
+	MissCounter(context.Context, *QueryMissCounterRequest) (*QueryMissCounterResponse, error)
+	// ValidatorPerformance returns oracle voting statistics of a validator in
+	// the recent slash windows.
+	ValidatorPerformance(context.Context, *QueryValidatorPerformanceRequest) (*QueryValidatorPerformanceResponse, error)
+	// ValidatorPenalty returns the oracle penalty tier of a validator.
+	ValidatorPenalty(context.Context, *QueryValidatorPenaltyRequest) (*QueryValidatorPenaltyResponse, error)
+	// ValidatorPenalties returns the oracle penalty tiers of all penalized
+	// validators.
+	ValidatorPenalties(context.Context, *QueryValidatorPenaltiesRequest) (*QueryValidatorPenaltiesResponse, error)
+	// AggregatePrevote returns an aggregate prevote of a validator.
+	AggregatePrevote(context.Context, *QueryAggregatePrevoteRequest) (*QueryAggregatePrevoteResponse, error)
+	// AggregatePrevotes returns aggregate prevotes of all validators.
+	AggregatePrevotes(context.Context, *QueryAggregatePrevotesRequest) (*QueryAggregatePrevotesResponse, error)
+	// AggregateVote returns an aggregate vote of a validator.
+	AggregateVote(context.Context, *QueryAggregateVoteRequest) (*QueryAggregateVoteResponse, error)
+	// AggregateVotes returns aggregate votes of all validators.
+	AggregateVotes(context.Context, *QueryAggregateVotesRequest) (*QueryAggregateVotesResponse, error)
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) ExchangeRate(ctx context.Context, req *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRate not implemented")
+}
+func (*UnimplementedQueryServer) ExchangeRates(ctx context.Context, req *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRates not implemented")
+}
+func (*UnimplementedQueryServer) CrossExchangeRate(ctx context.Context, req *QueryCrossExchangeRateRequest) (*QueryCrossExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CrossExchangeRate not implemented")
+}
+func (*UnimplementedQueryServer) QuoteExchangeRates(ctx context.Context, req *QueryQuoteExchangeRatesRequest) (*QueryQuoteExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteExchangeRates not implemented")
+}
+func (*UnimplementedQueryServer) Actives(ctx context.Context, req *QueryActivesRequest) (*QueryActivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Actives not implemented")
+}
+func (*UnimplementedQueryServer) VoteTargets(ctx context.Context, req *QueryVoteTargetsRequest) (*QueryVoteTargetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteTargets not implemented")
+}
+func (*UnimplementedQueryServer) Targets(ctx context.Context, req *QueryTargetsRequest) (*QueryTargetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Targets not implemented")
+}
+func (*UnimplementedQueryServer) FeederDelegation(ctx context.Context, req *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeederDelegation not implemented")
+}
+func (*UnimplementedQueryServer) MissCounter(ctx context.Context, req *QueryMissCounterRequest) (*QueryMissCounterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MissCounter not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorPerformance(ctx context.Context, req *QueryValidatorPerformanceRequest) (*QueryValidatorPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPerformance not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorPenalty(ctx context.Context, req *QueryValidatorPenaltyRequest) (*QueryValidatorPenaltyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPenalty not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorPenalties(ctx context.Context, req *QueryValidatorPenaltiesRequest) (*QueryValidatorPenaltiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPenalties not implemented")
+}
+func (*UnimplementedQueryServer) AggregatePrevote(ctx context.Context, req *QueryAggregatePrevoteRequest) (*QueryAggregatePrevoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevote not implemented")
+}
+func (*UnimplementedQueryServer) AggregatePrevotes(ctx context.Context, req *QueryAggregatePrevotesRequest) (*QueryAggregatePrevotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevotes not implemented")
+}
+func (*UnimplementedQueryServer) AggregateVote(ctx context.Context, req *QueryAggregateVoteRequest) (*QueryAggregateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateVote not implemented")
+}
+func (*UnimplementedQueryServer) AggregateVotes(ctx context.Context, req *QueryAggregateVotesRequest) (*QueryAggregateVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateVotes not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.oracle.v1.Query/ExchangeRate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AggregatePrevote(ctx, req.(*QueryAggregatePrevoteRequest))
+		return srv.(QueryServer).ExchangeRate(ctx, req.(*QueryExchangeRateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_AggregatePrevotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAggregatePrevotesRequest)
+func _Query_ExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).AggregatePrevotes(ctx, in)
+		return srv.(QueryServer).ExchangeRates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/blackfury.oracle.v1.Query/AggregatePrevotes",
+		FullMethod: "/blackfury.oracle.v1.Query/ExchangeRates",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AggregatePrevotes(ctx, req.(*QueryAggregatePrevotesRequest))
+		return srv.(QueryServer).ExchangeRates(ctx, req.(*QueryExchangeRatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_AggregateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAggregateVoteRequest)
+func _Query_CrossExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCrossExchangeRateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).AggregateVote(ctx, in)
+		return srv.(QueryServer).CrossExchangeRate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/blackfury.oracle.v1.Query/AggregateVote",
+		FullMethod: "/blackfury.oracle.v1.Query/CrossExchangeRate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AggregateVote(ctx, req.(*QueryAggregateVoteRequest))
+		return srv.(QueryServer).CrossExchangeRate(ctx, req.(*QueryCrossExchangeRateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_AggregateVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAggregateVotesRequest)
+func _Query_QuoteExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteExchangeRatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).AggregateVotes(ctx, in)
+		return srv.(QueryServer).QuoteExchangeRates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/blackfury.oracle.v1.Query/AggregateVotes",
+		FullMethod: "/blackfury.oracle.v1.Query/QuoteExchangeRates",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AggregateVotes(ctx, req.(*QueryAggregateVotesRequest))
+		return srv.(QueryServer).QuoteExchangeRates(ctx, req.(*QueryQuoteExchangeRatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
+func _Query_Actives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActivesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+		return srv.(QueryServer).Actives(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/blackfury.oracle.v1.Query/Params",
+		FullMethod: "/blackfury.oracle.v1.Query/Actives",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+		return srv.(QueryServer).Actives(ctx, req.(*QueryActivesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "blackfury.oracle.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ExchangeRate",
-			Handler:    _Query_ExchangeRate_Handler,
-		},
-		{
-			MethodName: "ExchangeRates",
-			Handler:    _Query_ExchangeRates_Handler,
-		},
-		{
-			MethodName: "Actives",
-			Handler:    _Query_Actives_Handler,
-		},
-		{
-			MethodName: "VoteTargets",
-			Handler:    _Query_VoteTargets_Handler,
-		},
-		{
-			MethodName: "Targets",
-			Handler:    _Query_Targets_Handler,
-		},
-		{
-			MethodName: "FeederDelegation",
-			Handler:    _Query_FeederDelegation_Handler,
-		},
-		{
-			MethodName: "MissCounter",
-			Handler:    _Query_MissCounter_Handler,
-		},
-		{
+func _Query_VoteTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteTargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteTargets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.oracle.v1.Query/VoteTargets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteTargets(ctx, req.(*QueryVoteTargetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Targets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Targets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.oracle.v1.Query/Targets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Targets(ctx, req.(*QueryTargetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeederDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeederDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeederDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.oracle.v1.Query/FeederDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeederDelegation(ctx, req.(*QueryFeederDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MissCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMissCounterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MissCounter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.oracle.v1.Query/MissCounter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MissCounter(ctx, req.(*QueryMissCounterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.oracle.v1.Query/ValidatorPerformance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorPerformance(ctx, req.(*QueryValidatorPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorPenalty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorPenaltyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorPenalty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.oracle.v1.Query/ValidatorPenalty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorPenalty(ctx, req.(*QueryValidatorPenaltyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorPenalties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorPenaltiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorPenalties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.oracle.v1.Query/ValidatorPenalties",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorPenalties(ctx, req.(*QueryValidatorPenaltiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AggregatePrevote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregatePrevoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregatePrevote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.oracle.v1.Query/AggregatePrevote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregatePrevote(ctx, req.(*QueryAggregatePrevoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AggregatePrevotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregatePrevotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregatePrevotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.oracle.v1.Query/AggregatePrevotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregatePrevotes(ctx, req.(*QueryAggregatePrevotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AggregateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregateVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.oracle.v1.Query/AggregateVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregateVote(ctx, req.(*QueryAggregateVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AggregateVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregateVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregateVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.oracle.v1.Query/AggregateVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregateVotes(ctx, req.(*QueryAggregateVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.oracle.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "blackfury.oracle.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExchangeRate",
+			Handler:    _Query_ExchangeRate_Handler,
+		},
+		{
+			MethodName: "ExchangeRates",
+			Handler:    _Query_ExchangeRates_Handler,
+		},
+		{
+			MethodName: "CrossExchangeRate",
+			Handler:    _Query_CrossExchangeRate_Handler,
+		},
+		{
+			MethodName: "QuoteExchangeRates",
+			Handler:    _Query_QuoteExchangeRates_Handler,
+		},
+		{
+			MethodName: "Actives",
+			Handler:    _Query_Actives_Handler,
+		},
+		{
+			MethodName: "VoteTargets",
+			Handler:    _Query_VoteTargets_Handler,
+		},
+		{
+			MethodName: "Targets",
+			Handler:    _Query_Targets_Handler,
+		},
+		{
+			MethodName: "FeederDelegation",
+			Handler:    _Query_FeederDelegation_Handler,
+		},
+		{
+			MethodName: "MissCounter",
+			Handler:    _Query_MissCounter_Handler,
+		},
+		{
+			MethodName: "ValidatorPerformance",
+			Handler:    _Query_ValidatorPerformance_Handler,
+		},
+		{
+			MethodName: "ValidatorPenalty",
+			Handler:    _Query_ValidatorPenalty_Handler,
+		},
+		{
+			MethodName: "ValidatorPenalties",
+			Handler:    _Query_ValidatorPenalties_Handler,
+		},
+		{
 			MethodName: "AggregatePrevote",
 			Handler:    _Query_AggregatePrevote_Handler,
 		},
@@ -1787,7 +2445,7 @@ func (m *QueryExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryActivesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryCrossExchangeRateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1797,20 +2455,34 @@ func (m *QueryActivesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryActivesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCrossExchangeRateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryActivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCrossExchangeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryActivesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryCrossExchangeRateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1820,17 +2492,133 @@ func (m *QueryActivesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryActivesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCrossExchangeRateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryActivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCrossExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Actives) > 0 {
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteExchangeRatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteExchangeRatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteExchangeRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QuoteExchangeRates) > 0 {
+		for iNdEx := len(m.QuoteExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QuoteExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActivesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActivesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActivesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActivesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actives) > 0 {
 		for iNdEx := len(m.Actives) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Actives[iNdEx])
 			copy(dAtA[i:], m.Actives[iNdEx])
@@ -2070,7 +2858,7 @@ func (m *QueryMissCounterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregatePrevoteRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryValidatorPerformanceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2080,12 +2868,12 @@ func (m *QueryAggregatePrevoteRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAggregatePrevoteRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValidatorPerformanceRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregatePrevoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValidatorPerformanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2100,7 +2888,7 @@ func (m *QueryAggregatePrevoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregatePrevoteResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryValidatorPerformanceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2110,18 +2898,85 @@ func (m *QueryAggregatePrevoteResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAggregatePrevoteResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValidatorPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregatePrevoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValidatorPerformanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Performances) > 0 {
+		for iNdEx := len(m.Performances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Performances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorPenaltyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorPenaltyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorPenaltyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorPenaltyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorPenaltyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorPenaltyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.AggregatePrevote.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Penalty.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2133,7 +2988,7 @@ func (m *QueryAggregatePrevoteResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregatePrevotesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryValidatorPenaltiesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2143,12 +2998,12 @@ func (m *QueryAggregatePrevotesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAggregatePrevotesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValidatorPenaltiesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregatePrevotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValidatorPenaltiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2156,7 +3011,7 @@ func (m *QueryAggregatePrevotesRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregatePrevotesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryValidatorPenaltiesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2166,20 +3021,20 @@ func (m *QueryAggregatePrevotesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAggregatePrevotesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValidatorPenaltiesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregatePrevotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValidatorPenaltiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AggregatePrevotes) > 0 {
-		for iNdEx := len(m.AggregatePrevotes) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Penalties) > 0 {
+		for iNdEx := len(m.Penalties) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AggregatePrevotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Penalties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2193,7 +3048,7 @@ func (m *QueryAggregatePrevotesResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregateVoteRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregatePrevoteRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2203,12 +3058,12 @@ func (m *QueryAggregateVoteRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAggregateVoteRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevoteRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregateVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2223,7 +3078,7 @@ func (m *QueryAggregateVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregateVoteResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregatePrevoteResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2233,18 +3088,18 @@ func (m *QueryAggregateVoteResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAggregateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevoteResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.AggregateVote.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.AggregatePrevote.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2256,7 +3111,7 @@ func (m *QueryAggregateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregateVotesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregatePrevotesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2266,12 +3121,12 @@ func (m *QueryAggregateVotesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAggregateVotesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevotesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregateVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2279,7 +3134,7 @@ func (m *QueryAggregateVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregateVotesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregatePrevotesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2289,20 +3144,20 @@ func (m *QueryAggregateVotesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAggregateVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevotesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregateVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AggregateVotes) > 0 {
-		for iNdEx := len(m.AggregateVotes) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.AggregatePrevotes) > 0 {
+		for iNdEx := len(m.AggregatePrevotes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AggregateVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.AggregatePrevotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2316,7 +3171,7 @@ func (m *QueryAggregateVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregateVoteRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2326,20 +3181,27 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregateVoteRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAggregateVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregateVoteResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2349,18 +3211,18 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAggregateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.AggregateVote.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2372,22 +3234,138 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryAggregateVotesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryExchangeRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+
+func (m *QueryAggregateVotesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregateVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregateVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregateVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregateVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AggregateVotes) > 0 {
+		for iNdEx := len(m.AggregateVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AggregateVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryExchangeRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = len(m.Denom)
 	if l > 0 {
@@ -2431,6 +3409,58 @@ func (m *QueryExchangeRatesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCrossExchangeRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCrossExchangeRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryQuoteExchangeRatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryQuoteExchangeRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.QuoteExchangeRates) > 0 {
+		for _, e := range m.QuoteExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryActivesRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2554,6 +3584,82 @@ func (m *QueryMissCounterResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryValidatorPerformanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorPerformanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Performances) > 0 {
+		for _, e := range m.Performances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorPenaltyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorPenaltyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Penalty.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorPenaltiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryValidatorPenaltiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Penalties) > 0 {
+		for _, e := range m.Penalties {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryAggregatePrevoteRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2599,84 +3705,632 @@ func (m *QueryAggregatePrevotesResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *QueryAggregateVoteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryAggregateVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.AggregateVote.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
+	return n
+}
+
+func (m *QueryAggregateVoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AggregateVote.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAggregateVotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAggregateVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AggregateVotes) > 0 {
+		for _, e := range m.AggregateVotes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = append(m.ExchangeRates, types.DecCoin{})
+			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCrossExchangeRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCrossExchangeRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCrossExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCrossExchangeRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCrossExchangeRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCrossExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryAggregateVotesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *QueryAggregateVotesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.AggregateVotes) > 0 {
-		for _, e := range m.AggregateVotes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryQuoteExchangeRatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteExchangeRatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryQuoteExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2699,17 +4353,17 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQuoteExchangeRatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQuoteExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteExchangeRates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2719,23 +4373,25 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.QuoteExchangeRates = append(m.QuoteExchangeRates, QuoteExchangeRate{})
+			if err := m.QuoteExchangeRates[len(m.QuoteExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2758,7 +4414,7 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2781,15 +4437,65 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActivesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActivesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actives", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2817,9 +4523,7 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Actives = append(m.Actives, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2842,7 +4546,7 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2865,10 +4569,10 @@ func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRatesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteTargetsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteTargetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2892,7 +4596,7 @@ func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2915,17 +4619,17 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteTargetsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteTargetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteTargets", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2935,26 +4639,74 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRates = append(m.ExchangeRates, types.DecCoin{})
-			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.VoteTargets = append(m.VoteTargets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTargetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTargetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTargetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2976,7 +4728,7 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTargetsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2999,12 +4751,44 @@ func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActivesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTargetsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTargetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Targets = append(m.Targets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3026,7 +4810,7 @@ func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3049,15 +4833,15 @@ func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActivesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeederDelegationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeederDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actives", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3085,7 +4869,7 @@ func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Actives = append(m.Actives, string(dAtA[iNdEx:postIndex]))
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3108,7 +4892,7 @@ func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3131,12 +4915,44 @@ func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteTargetsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteTargetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeederAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3158,7 +4974,7 @@ func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3181,15 +4997,15 @@ func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteTargetsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMissCounterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteTargetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMissCounterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteTargets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3217,7 +5033,7 @@ func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VoteTargets = append(m.VoteTargets, string(dAtA[iNdEx:postIndex]))
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3240,7 +5056,7 @@ func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTargetsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3263,12 +5079,31 @@ func (m *QueryTargetsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTargetsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMissCounterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTargetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMissCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
+			}
+			m.MissCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3290,7 +5125,7 @@ func (m *QueryTargetsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTargetsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3313,15 +5148,15 @@ func (m *QueryTargetsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTargetsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorPerformanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTargetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorPerformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3349,7 +5184,7 @@ func (m *QueryTargetsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Targets = append(m.Targets, string(dAtA[iNdEx:postIndex]))
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3372,7 +5207,7 @@ func (m *QueryTargetsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3395,17 +5230,17 @@ func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorPerformanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Performances", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3415,23 +5250,25 @@ func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			m.Performances = append(m.Performances, ValidatorPerformance{})
+			if err := m.Performances[len(m.Performances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3454,7 +5291,7 @@ func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorPenaltyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3477,15 +5314,15 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorPenaltyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorPenaltyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3513,7 +5350,7 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeederAddr = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3536,7 +5373,7 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorPenaltyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3559,17 +5396,17 @@ func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMissCounterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorPenaltyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMissCounterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorPenaltyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3579,23 +5416,24 @@ func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			if err := m.Penalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3618,7 +5456,7 @@ func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorPenaltiesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3641,17 +5479,67 @@ func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMissCounterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorPenaltiesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMissCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorPenaltiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorPenaltiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorPenaltiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorPenaltiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalties", wireType)
 			}
-			m.MissCounter = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3661,11 +5549,26 @@ func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissCounter |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Penalties = append(m.Penalties, ValidatorPenalty{})
+			if err := m.Penalties[len(m.Penalties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -14,4 +14,6 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
 	k.RegulateCheckpoint(ctx)
+	k.ClaimAutoCompoundRebases(ctx)
+	k.PruneHistory(ctx)
 }
@@ -53,7 +53,7 @@ func (k Keeper) RegulateUserCheckpoint(ctx sdk.Context, veID uint64, lockedOld t
 	// regulate system checkpoint history
 	userSlopeChange := userPointNew.Slope.Sub(userPointOld.Slope)
 	userBiasChange := userPointNew.Bias.Sub(userPointOld.Bias)
-	k.regulateCheckpoint(ctx, userSlopeChange, userBiasChange)
+	k.regulateCheckpoint(ctx, userSlopeChange, userBiasChange, 0)
 
 	slopeChangeOld := k.GetSlopeChange(ctx, lockedOld.End)
 	slopeChangeNew := k.GetSlopeChange(ctx, lockedNew.End)
@@ -89,18 +89,27 @@ func (k Keeper) RegulateUserCheckpoint(ctx sdk.Context, veID uint64, lockedOld t
 	userPointNew.Timestamp = now
 	userPointNew.Block = ctx.BlockHeight()
 	k.SetUserCheckpoint(ctx, veID, userEpoch, userPointNew)
+
+	// keep the data of ve NFT in sync
+	k.setVeNftData(ctx, veID, lockedNew, userPointNew)
 }
 
+// RegulateCheckpoint regulates system checkpoint history when past the regulated period.
+// After a long chain halt, at most CheckpointCatchUpWeeks weekly checkpoints are written
+// per call, and the remaining weeks are caught up in the following blocks.
 func (k Keeper) RegulateCheckpoint(ctx sdk.Context) {
 	now := uint64(ctx.BlockTime().Unix())
 	epoch := k.GetEpoch(ctx)
 	pointLast := k.GetCheckpoint(ctx, epoch)
 	if now-pointLast.Timestamp >= types.RegulatedPeriod {
-		k.regulateCheckpoint(ctx, sdk.ZeroInt(), sdk.ZeroInt())
+		k.regulateCheckpoint(ctx, sdk.ZeroInt(), sdk.ZeroInt(), k.CheckpointCatchUpWeeks(ctx))
 	}
 }
 
-func (k Keeper) regulateCheckpoint(ctx sdk.Context, userSlopeChange, userBiasChange sdk.Int) {
+// regulateCheckpoint writes one checkpoint per regulated week since the last checkpoint.
+// If maxWeeks is zero, it always catches up to the current time, which is required when
+// applying user changes; otherwise it stops at a regulated time after maxWeeks weeks.
+func (k Keeper) regulateCheckpoint(ctx sdk.Context, userSlopeChange, userBiasChange sdk.Int, maxWeeks uint64) {
 	now := uint64(ctx.BlockTime().Unix())
 
 	epoch := k.GetEpoch(ctx)
@@ -126,15 +135,8 @@ func (k Keeper) regulateCheckpoint(ctx sdk.Context, userSlopeChange, userBiasCha
 	}
 
 	ti := types.RegulatedUnixTime(timeLast)
-	i := 0
+	weeks := uint64(0)
 	for {
-		i++
-		if i > 2 {
-			// since checkpoint will always be regulated when past the regulated period at end block,
-			// this loop will not exceed two rounds
-			panic("broken checkpoint regulation")
-		}
-
 		ti = types.NextRegulatedUnixTime(ti)
 
 		var slopeChange sdk.Int
@@ -172,13 +174,19 @@ func (k Keeper) regulateCheckpoint(ctx sdk.Context, userSlopeChange, userBiasCha
 		if ti == now {
 			pointLast.Block = ctx.BlockHeight()
 			break // break loop
-		} else {
-			// set new checkpoint
-			k.SetCheckpoint(ctx, epoch, pointLast)
 		}
+
+		weeks++
+		if maxWeeks > 0 && weeks >= maxWeeks {
+			// the remaining weeks will be caught up in the following blocks
+			break
+		}
+
+		// set new checkpoint
+		k.SetCheckpoint(ctx, epoch, pointLast)
 	}
 
-	// TODO: delete slope changes in the past, since they will be no longer used
+	// slope changes in the past will be no longer used, and are pruned at end block
 
 	// set new last epoch
 	k.SetEpoch(ctx, epoch)
@@ -251,6 +259,20 @@ func (k Keeper) GetUserEpoch(ctx sdk.Context, veID uint64) uint64 {
 	return sdk.BigEndianToUint64(bz)
 }
 
+// IterateUserEpochs iterates over the latest user epochs of all ve
+func (k Keeper) IterateUserEpochs(ctx sdk.Context, cb func(veID uint64, userEpoch uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixUserEpoch)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		veID := sdk.BigEndianToUint64(iterator.Key()[len(types.KeyPrefixUserEpoch):])
+		if cb(veID, sdk.BigEndianToUint64(iterator.Value())) {
+			break
+		}
+	}
+}
+
 func (k Keeper) SetUserCheckpoint(ctx sdk.Context, veID uint64, epoch uint64, point types.Checkpoint) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&point)
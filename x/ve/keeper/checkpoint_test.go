@@ -1,7 +1,11 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elysiumstation/blackfury/app"
+	"github.com/elysiumstation/blackfury/x/ve"
 	"github.com/elysiumstation/blackfury/x/ve/types"
 )
 
@@ -103,3 +107,84 @@ func (suite *KeeperTestSuite) TestKeeper_RegulateUserCheckpoint() {
 	suite.Require().Equal(sdk.ZeroInt(), userPoint.Bias)
 	suite.Require().Equal(sdk.ZeroInt(), userPoint.Slope)
 }
+
+func (suite *KeeperTestSuite) TestKeeper_RegulateCheckpoint_CatchUp() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VeKeeper
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	amount := sdk.NewCoin(k.LockDenom(suite.ctx), sdk.NewIntWithDecimal(1000, 18))
+	err := app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(amount))
+	require.NoError(err)
+	_, _, err = k.CreateLock(suite.ctx, sender, sender, amount, types.MaxLockTime)
+	require.NoError(err)
+
+	start := suite.ctx.BlockTime()
+	halfYear := start.Add(26 * 7 * 24 * time.Hour)
+	threeYears := start.Add(3 * 52 * 7 * 24 * time.Hour)
+	expectedAtHalfYear := k.GetTotalVotingPower(suite.ctx, uint64(halfYear.Unix()), 0)
+	expectedAtThreeYears := k.GetTotalVotingPower(suite.ctx, uint64(threeYears.Unix()), 0)
+	require.True(expectedAtThreeYears.IsPositive())
+
+	checkWeekly := func(ctx sdk.Context, fromEpoch uint64) {
+		epoch := k.GetEpoch(ctx)
+		for i := fromEpoch; i < epoch; i++ {
+			point := k.GetCheckpoint(ctx, i)
+			require.Equal(types.RegulatedUnixTime(point.Timestamp), point.Timestamp)
+			require.LessOrEqual(k.GetCheckpoint(ctx, i+1).Timestamp, point.Timestamp+types.RegulatedPeriod)
+		}
+	}
+
+	// halt for half a year, caught up within one block
+	epochBefore := k.GetEpoch(suite.ctx)
+	ctx := suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1).WithBlockTime(halfYear)
+	require.NotPanics(func() { ve.EndBlocker(ctx, k) })
+	epoch := k.GetEpoch(ctx)
+	require.GreaterOrEqual(epoch-epochBefore, uint64(26))
+	require.Equal(uint64(halfYear.Unix()), k.GetCheckpoint(ctx, epoch).Timestamp)
+	require.Equal(ctx.BlockHeight(), k.GetCheckpoint(ctx, epoch).Block)
+	checkWeekly(ctx, epochBefore+1)
+	require.Equal(expectedAtHalfYear, k.GetCheckpoint(ctx, epoch).Bias)
+
+	// halt for years, caught up over several blocks
+	epochBefore = epoch
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(threeYears)
+	require.NotPanics(func() { ve.EndBlocker(ctx, k) })
+	epoch = k.GetEpoch(ctx)
+	require.Equal(epochBefore+k.CheckpointCatchUpWeeks(ctx), epoch)
+	require.Less(k.GetCheckpoint(ctx, epoch).Timestamp, uint64(threeYears.Unix()))
+	// total voting power is still right before the catch-up completes
+	require.Equal(expectedAtThreeYears, k.GetTotalVotingPower(ctx, uint64(threeYears.Unix()), 0))
+
+	for i := 0; i < 10 && k.GetCheckpoint(ctx, k.GetEpoch(ctx)).Timestamp < uint64(threeYears.Unix()); i++ {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(threeYears.Add(time.Duration(i+1) * 5 * time.Second))
+		ve.EndBlocker(ctx, k)
+	}
+	epoch = k.GetEpoch(ctx)
+	require.Equal(uint64(ctx.BlockTime().Unix()), k.GetCheckpoint(ctx, epoch).Timestamp)
+	require.Equal(ctx.BlockHeight(), k.GetCheckpoint(ctx, epoch).Block)
+	checkWeekly(ctx, epochBefore+1)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_RegulateUserCheckpoint_CatchUp() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VeKeeper
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	amount := sdk.NewCoin(k.LockDenom(suite.ctx), sdk.NewIntWithDecimal(1000, 18))
+	err := app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(amount.Add(amount)))
+	require.NoError(err)
+	_, _, err = k.CreateLock(suite.ctx, sender, sender, amount, types.MaxLockTime)
+	require.NoError(err)
+
+	// user action after a halt of two years catches up at once
+	ctx := suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1).WithBlockTime(suite.ctx.BlockTime().Add(2 * 52 * 7 * 24 * time.Hour))
+	epochBefore := k.GetEpoch(ctx)
+	_, _, err = k.CreateLock(ctx, sender, sender, amount, types.MaxLockTime)
+	require.NoError(err)
+	epoch := k.GetEpoch(ctx)
+	require.Greater(epoch-epochBefore, k.CheckpointCatchUpWeeks(ctx))
+	require.Equal(uint64(ctx.BlockTime().Unix()), k.GetCheckpoint(ctx, epoch).Timestamp)
+}
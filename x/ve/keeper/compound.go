@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elysiumstation/blackfury/x/ve/types"
+)
+
+// SetVeAutoCompound enables auto-compounding for ve
+func (k Keeper) SetVeAutoCompound(ctx sdk.Context, veID uint64, compoundRewards bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := []byte{0}
+	if compoundRewards {
+		bz[0] = 1
+	}
+	store.Set(types.AutoCompoundKey(veID), bz)
+}
+
+// GetVeAutoCompound gets whether auto-compounding is enabled for ve,
+// and whether the gauge and bribe rewards are also compounded
+func (k Keeper) GetVeAutoCompound(ctx sdk.Context, veID uint64) (enabled bool, compoundRewards bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.AutoCompoundKey(veID))
+	if bz == nil {
+		return false, false
+	}
+	return true, len(bz) > 0 && bz[0] == 1
+}
+
+// DeleteVeAutoCompound disables auto-compounding for ve
+func (k Keeper) DeleteVeAutoCompound(ctx sdk.Context, veID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AutoCompoundKey(veID))
+}
+
+// CompoundReward deposits the reward coin taken from sender into the lock of ve,
+// if ve compounds rewards and the coin is in the lock denom.
+// It returns false if the reward is not compounded, so the caller should pay it to the owner.
+func (k Keeper) CompoundReward(ctx sdk.Context, veID uint64, sender sdk.AccAddress, coin sdk.Coin) (bool, error) {
+	if coin.Denom != k.LockDenom(ctx) || !coin.IsPositive() {
+		return false, nil
+	}
+	enabled, compoundRewards := k.GetVeAutoCompound(ctx, veID)
+	if !enabled || !compoundRewards || !k.isLockActive(ctx, veID) {
+		return false, nil
+	}
+
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(coin))
+	if err != nil {
+		return false, err
+	}
+	return true, k.compound(ctx, veID, coin)
+}
+
+// ClaimAutoCompoundRebases claims the distribution rebases of all auto-compounding ve into their locks,
+// in a round starting at the beginning of every regulated period. Each call visits at most
+// MaxAutoCompoundClaimsPerBlock ve from the cursor saved by the last call, until the round completes.
+// A ve failing to claim is skipped and logged.
+func (k Keeper) ClaimAutoCompoundRebases(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	start := store.Get(types.AutoCompoundClaimCursorKey())
+	if start == nil {
+		// no round in progress, so start a new one if a new regulated period has begun
+		now := uint64(ctx.BlockTime().Unix())
+		if types.RegulatedUnixTime(now) <= types.RegulatedUnixTime(k.GetAutoCompoundClaimLastTimestamp(ctx)) {
+			return
+		}
+		k.SetAutoCompoundClaimLastTimestamp(ctx, now)
+		start = types.KeyPrefixAutoCompound
+	}
+	iterator := store.Iterator(start, sdk.PrefixEndBytes(types.KeyPrefixAutoCompound))
+
+	var veIDs []uint64
+	var cursor []byte
+	for ; iterator.Valid(); iterator.Next() {
+		if len(veIDs) >= types.MaxAutoCompoundClaimsPerBlock {
+			cursor = iterator.Key()
+			break
+		}
+		veIDs = append(veIDs, sdk.BigEndianToUint64(iterator.Key()[len(types.KeyPrefixAutoCompound):]))
+	}
+	iterator.Close()
+
+	if cursor != nil {
+		store.Set(types.AutoCompoundClaimCursorKey(), cursor)
+	} else {
+		// the round completes
+		store.Delete(types.AutoCompoundClaimCursorKey())
+	}
+
+	distributor := NewDistributor(k)
+	for _, veID := range veIDs {
+		cacheCtx, write := ctx.CacheContext()
+		if k.GetDistributionClaimLastTimestampByUser(cacheCtx, veID) == 0 {
+			// never claimed, so start from the first checkpoint of ve, before which it has no share
+			timeFirst := k.GetUserCheckpoint(cacheCtx, veID, types.FirstEpoch).Timestamp
+			if timeFirst == 0 {
+				continue
+			}
+			k.SetDistributionClaimLastTimestampByUser(cacheCtx, veID, timeFirst)
+		}
+		err := distributor.Claim(cacheCtx, veID)
+		if err != nil {
+			k.Logger(ctx).Error("failed to claim auto-compounding rebase", "ve", veID, "error", err.Error())
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
+
+// IterateVeAutoCompound iterates over all auto-compounding ve, until the callback returns true
+func (k Keeper) IterateVeAutoCompound(ctx sdk.Context, cb func(veID uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixAutoCompound)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		veID := sdk.BigEndianToUint64(iterator.Key()[len(types.KeyPrefixAutoCompound):])
+		if cb(veID) {
+			break
+		}
+	}
+}
+
+// SetAutoCompoundClaimLastTimestamp sets the last time when the rebases of auto-compounding ve were claimed
+func (k Keeper) SetAutoCompoundClaimLastTimestamp(ctx sdk.Context, timestamp uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AutoCompoundClaimLastTimestampKey(), sdk.Uint64ToBigEndian(timestamp))
+}
+
+// GetAutoCompoundClaimLastTimestamp gets the last time when the rebases of auto-compounding ve were claimed
+func (k Keeper) GetAutoCompoundClaimLastTimestamp(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.AutoCompoundClaimLastTimestampKey())
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// compound deposits the coin which has been sent to the ve module account into the lock of ve
+func (k Keeper) compound(ctx sdk.Context, veID uint64, coin sdk.Coin) error {
+	locked := k.GetLockedAmountByUser(ctx, veID)
+	err := k.DepositFor(ctx, nil, veID, coin.Amount, 0, locked, false)
+	if err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventAutoCompound{
+		VeId:   types.VeIDFromUint64(veID),
+		Amount: coin,
+	})
+}
+
+// isLockActive checks whether ve has locked amount which has not expired
+func (k Keeper) isLockActive(ctx sdk.Context, veID uint64) bool {
+	locked := k.GetLockedAmountByUser(ctx, veID)
+	return locked.Amount.IsPositive() && locked.End > uint64(ctx.BlockTime().Unix())
+}
@@ -0,0 +1,195 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	nfttypes "github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/elysiumstation/blackfury/app"
+	"github.com/elysiumstation/blackfury/x/ve"
+	"github.com/elysiumstation/blackfury/x/ve/keeper"
+	"github.com/elysiumstation/blackfury/x/ve/types"
+	"github.com/tharsis/ethermint/tests"
+)
+
+func (suite *KeeperTestSuite) TestAutoCompound() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VeKeeper
+	impl := keeper.NewMsgServerImpl(k)
+
+	owner := sdk.AccAddress(suite.address.Bytes())
+	addr, _ := tests.NewAddrKey()
+	stranger := sdk.AccAddress(addr.Bytes())
+
+	denom := k.LockDenom(suite.ctx)
+	amount := sdk.NewCoin(denom, sdk.NewIntWithDecimal(1000, 18))
+	require.NoError(app.FundAccount(suite.app.BankKeeper, suite.ctx, owner, sdk.NewCoins(amount.Add(amount))))
+	veID, _, err := k.CreateLock(suite.ctx, owner, owner, amount, types.MaxLockTime)
+	require.NoError(err)
+	otherVeID, _, err := k.CreateLock(suite.ctx, owner, owner, amount, types.MaxLockTime)
+	require.NoError(err)
+	id := types.VeIDFromUint64(veID)
+
+	// only the owner can set auto-compounding
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	_, err = impl.SetAutoCompound(ctx, &types.MsgSetAutoCompound{Sender: stranger.String(), VeId: id, Enabled: true})
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = impl.SetAutoCompound(ctx, &types.MsgSetAutoCompound{Sender: owner.String(), VeId: id, Enabled: true})
+	require.NoError(err)
+	_, err = impl.SetAutoCompound(ctx, &types.MsgSetAutoCompound{Sender: owner.String(), VeId: types.VeIDFromUint64(otherVeID), Enabled: true, CompoundRewards: true})
+	require.NoError(err)
+
+	res, err := k.AutoCompoundingVes(ctx, &types.QueryAutoCompoundingVesRequest{})
+	require.NoError(err)
+	require.Equal([]types.AutoCompound{
+		{VeId: id, CompoundRewards: false},
+		{VeId: types.VeIDFromUint64(otherVeID), CompoundRewards: true},
+	}, res.AutoCompounds)
+
+	// claimed rebase is deposited into the lock
+	createdTime := uint64(suite.ctx.BlockTime().Unix())
+	k.SetDistributionClaimLastTimestampByUser(suite.ctx, veID, createdTime)
+	rebase := sdk.NewCoin(denom, sdk.NewIntWithDecimal(100, 18))
+	require.NoError(app.FundModuleAccount(suite.app.BankKeeper, suite.ctx, types.DistributionPoolName, sdk.NewCoins(rebase)))
+	k.SetDistributionPerPeriod(suite.ctx, types.RegulatedUnixTime(createdTime), rebase.Amount)
+
+	claimCtx := suite.ctx.WithBlockTime(time.Unix(int64(types.NextRegulatedUnixTime(types.RegulatedUnixTime(createdTime))+types.RegulatedPeriod), 0))
+	balanceBefore := suite.app.BankKeeper.GetBalance(claimCtx, owner, denom)
+	require.NoError(keeper.NewDistributor(k).Claim(claimCtx, veID))
+	locked := k.GetLockedAmountByUser(claimCtx, veID)
+	require.True(locked.Amount.GT(amount.Amount))
+	require.Equal(locked.Amount, k.GetVeNftMetadata(claimCtx, veID).Locked.Amount)
+	require.Equal(balanceBefore, suite.app.BankKeeper.GetBalance(claimCtx, owner, denom))
+
+	// gauge and bribe rewards are compounded only if opted in and in the lock denom
+	reward := sdk.NewCoin(denom, sdk.NewInt(10))
+	require.NoError(app.FundAccount(suite.app.BankKeeper, suite.ctx, stranger, sdk.NewCoins(reward)))
+	compounded, err := k.CompoundReward(suite.ctx, veID, stranger, reward)
+	require.NoError(err)
+	require.False(compounded)
+	compounded, err = k.CompoundReward(suite.ctx, otherVeID, stranger, sdk.NewCoin("uatom", sdk.NewInt(10)))
+	require.NoError(err)
+	require.False(compounded)
+	compounded, err = k.CompoundReward(suite.ctx, otherVeID, stranger, reward)
+	require.NoError(err)
+	require.True(compounded)
+	require.Equal(amount.Amount.Add(reward.Amount), k.GetLockedAmountByUser(suite.ctx, otherVeID).Amount)
+
+	// auto-compounding is reset on transfer
+	_, err = suite.app.NftKeeper.Send(ctx, &nfttypes.MsgSend{
+		ClassId:  types.VeNftClass.Id,
+		Id:       id,
+		Sender:   owner.String(),
+		Receiver: stranger.String(),
+	})
+	require.NoError(err)
+	enabled, _ := k.GetVeAutoCompound(suite.ctx, veID)
+	require.False(enabled)
+
+	_, err = impl.SetAutoCompound(ctx, &types.MsgSetAutoCompound{Sender: owner.String(), VeId: types.VeIDFromUint64(otherVeID), Enabled: false})
+	require.NoError(err)
+	res, err = k.AutoCompoundingVes(ctx, &types.QueryAutoCompoundingVesRequest{})
+	require.NoError(err)
+	require.Empty(res.AutoCompounds)
+}
+
+func (suite *KeeperTestSuite) TestAutoCompoundRebasesInEndBlocker() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VeKeeper
+	impl := keeper.NewMsgServerImpl(k)
+
+	owner := sdk.AccAddress(suite.address.Bytes())
+	denom := k.LockDenom(suite.ctx)
+	amount := sdk.NewCoin(denom, sdk.NewIntWithDecimal(1000, 18))
+	require.NoError(app.FundAccount(suite.app.BankKeeper, suite.ctx, owner, sdk.NewCoins(amount.Add(amount))))
+	veID, _, err := k.CreateLock(suite.ctx, owner, owner, amount, types.MaxLockTime)
+	require.NoError(err)
+	otherVeID, _, err := k.CreateLock(suite.ctx, owner, owner, amount, types.MaxLockTime)
+	require.NoError(err)
+	_, err = impl.SetAutoCompound(sdk.WrapSDKContext(suite.ctx), &types.MsgSetAutoCompound{Sender: owner.String(), VeId: types.VeIDFromUint64(veID), Enabled: true})
+	require.NoError(err)
+
+	createdTime := uint64(suite.ctx.BlockTime().Unix())
+	rebase := sdk.NewCoin(denom, sdk.NewIntWithDecimal(100, 18))
+	require.NoError(app.FundModuleAccount(suite.app.BankKeeper, suite.ctx, types.DistributionPoolName, sdk.NewCoins(rebase)))
+	k.SetDistributionPerPeriod(suite.ctx, types.RegulatedUnixTime(createdTime), rebase.Amount)
+
+	// nothing to claim within the period of locking
+	ve.EndBlocker(suite.ctx, k)
+	require.Equal(amount.Amount, k.GetLockedAmountByUser(suite.ctx, veID).Amount)
+
+	// the rebase of the auto-compounding ve is deposited into its lock once a full period has passed since locking
+	nextPeriod := time.Unix(int64(types.NextRegulatedUnixTime(types.RegulatedUnixTime(createdTime))+types.RegulatedPeriod), 0).Add(time.Hour)
+	ctx := suite.ctx.WithBlockTime(nextPeriod)
+	balanceBefore := suite.app.BankKeeper.GetBalance(ctx, owner, denom)
+	ve.EndBlocker(ctx, k)
+	locked := k.GetLockedAmountByUser(ctx, veID).Amount
+	require.True(locked.GT(amount.Amount))
+	require.True(locked.Sub(amount.Amount).LTE(rebase.Amount.QuoRaw(2)))
+	require.Equal(amount.Amount, k.GetLockedAmountByUser(ctx, otherVeID).Amount)
+	require.Equal(balanceBefore, suite.app.BankKeeper.GetBalance(ctx, owner, denom))
+	require.Equal(uint64(nextPeriod.Unix()), k.GetAutoCompoundClaimLastTimestamp(ctx))
+
+	// claimed only once in a period
+	ve.EndBlocker(ctx.WithBlockTime(nextPeriod.Add(time.Hour)), k)
+	require.Equal(locked, k.GetLockedAmountByUser(ctx, veID).Amount)
+
+	// the other ve can still claim its share
+	k.SetDistributionClaimLastTimestampByUser(ctx, otherVeID, createdTime)
+	require.NoError(keeper.NewDistributor(k).Claim(ctx, otherVeID))
+	require.Equal(balanceBefore.Amount.Add(locked.Sub(amount.Amount)), suite.app.BankKeeper.GetBalance(ctx, owner, denom).Amount)
+}
+
+func (suite *KeeperTestSuite) TestAutoCompoundRebasesBoundedPerBlock() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VeKeeper
+
+	owner := sdk.AccAddress(suite.address.Bytes())
+	denom := k.LockDenom(suite.ctx)
+	amount := sdk.NewCoin(denom, sdk.NewIntWithDecimal(1, 18))
+	count := types.MaxAutoCompoundClaimsPerBlock + 10
+	require.NoError(app.FundAccount(suite.app.BankKeeper, suite.ctx, owner, sdk.NewCoins(sdk.NewCoin(denom, amount.Amount.MulRaw(int64(count))))))
+	var veIDs []uint64
+	for i := 0; i < count; i++ {
+		veID, _, err := k.CreateLock(suite.ctx, owner, owner, amount, types.MaxLockTime)
+		require.NoError(err)
+		k.SetVeAutoCompound(suite.ctx, veID, false)
+		veIDs = append(veIDs, veID)
+	}
+
+	createdTime := uint64(suite.ctx.BlockTime().Unix())
+	rebase := sdk.NewCoin(denom, sdk.NewIntWithDecimal(100, 18))
+	require.NoError(app.FundModuleAccount(suite.app.BankKeeper, suite.ctx, types.DistributionPoolName, sdk.NewCoins(rebase)))
+	k.SetDistributionPerPeriod(suite.ctx, types.RegulatedUnixTime(createdTime), rebase.Amount)
+
+	countCompounded := func(ctx sdk.Context) (n int) {
+		for _, veID := range veIDs {
+			if k.GetLockedAmountByUser(ctx, veID).Amount.GT(amount.Amount) {
+				n++
+			}
+		}
+		return
+	}
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+
+	// the round is split across blocks
+	ctx := suite.ctx.WithBlockTime(time.Unix(int64(types.NextRegulatedUnixTime(types.RegulatedUnixTime(createdTime))+types.RegulatedPeriod), 0))
+	k.ClaimAutoCompoundRebases(ctx)
+	require.Equal(types.MaxAutoCompoundClaimsPerBlock, countCompounded(ctx))
+	require.True(store.Has(types.AutoCompoundClaimCursorKey()))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(5 * time.Second))
+	k.ClaimAutoCompoundRebases(ctx)
+	require.Equal(count, countCompounded(ctx))
+	require.False(store.Has(types.AutoCompoundClaimCursorKey()))
+
+	// no new round within the same period
+	locked := k.GetLockedAmountByUser(ctx, veIDs[0]).Amount
+	k.ClaimAutoCompoundRebases(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)))
+	require.Equal(locked, k.GetLockedAmountByUser(ctx, veIDs[0]).Amount)
+	require.False(store.Has(types.AutoCompoundClaimCursorKey()))
+}
@@ -78,6 +78,16 @@ func (d Distributor) Claim(ctx sdk.Context, veID uint64) error {
 		return nil
 	}
 	coin := sdk.NewCoin(d.keeper.LockDenom(ctx), amount)
+
+	// deposit into the lock if auto-compounding
+	if enabled, _ := d.keeper.GetVeAutoCompound(ctx, veID); enabled && d.keeper.isLockActive(ctx, veID) {
+		err := d.keeper.bankKeeper.SendCoinsFromModuleToModule(ctx, types.DistributionPoolName, types.ModuleName, sdk.NewCoins(coin))
+		if err != nil {
+			return err
+		}
+		return d.keeper.compound(ctx, veID, coin)
+	}
+
 	err := d.keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.DistributionPoolName, owner, sdk.NewCoins(coin))
 	if err != nil {
 		return err
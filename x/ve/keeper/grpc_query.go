@@ -3,8 +3,10 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 	"github.com/elysiumstation/blackfury/x/ve/types"
 	"google.golang.org/grpc/codes"
@@ -242,6 +244,77 @@ func (k Keeper) VeNft(c context.Context, msg *types.QueryVeNftRequest) (*types.Q
 	return &types.QueryVeNftResponse{Nft: nft}, nil
 }
 
+func (k Keeper) VeNftMetadata(c context.Context, msg *types.QueryVeNftMetadataRequest) (*types.QueryVeNftMetadataResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.nftKeeper.HasNFT(ctx, types.VeNftClass.Id, msg.Id) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidVeID, "invalid ve id: %s", msg.Id)
+	}
+
+	metadata := k.GetVeNftMetadata(ctx, types.Uint64FromVeID(msg.Id))
+
+	return &types.QueryVeNftMetadataResponse{
+		Metadata: metadata,
+		Json:     metadata.JSON(),
+		Svg:      metadata.SVG(),
+	}, nil
+}
+
+func (k Keeper) VoterApproval(c context.Context, msg *types.QueryVoterApprovalRequest) (*types.QueryVoterApprovalResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.nftKeeper.HasNFT(ctx, types.VeNftClass.Id, msg.Id) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidVeID, "invalid ve id: %s", msg.Id)
+	}
+	veID := types.Uint64FromVeID(msg.Id)
+
+	res := &types.QueryVoterApprovalResponse{}
+	if approved := k.GetApprovedVoter(ctx, veID); approved != nil {
+		res.ApprovedVoter = approved.String()
+	}
+	if len(msg.Voter) > 0 {
+		voter, err := sdk.AccAddressFromBech32(msg.Voter)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		res.Authorized = k.IsVoterAuthorized(ctx, veID, voter)
+	}
+
+	return res, nil
+}
+
+func (k Keeper) AutoCompoundingVes(c context.Context, msg *types.QueryAutoCompoundingVesRequest) (*types.QueryAutoCompoundingVesResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAutoCompound)
+
+	var autoCompounds []types.AutoCompound
+	pageRes, err := query.Paginate(store, msg.Pagination, func(key, value []byte) error {
+		autoCompounds = append(autoCompounds, types.AutoCompound{
+			VeId:            types.VeIDFromUint64(sdk.BigEndianToUint64(key)),
+			CompoundRewards: len(value) > 0 && value[0] == 1,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAutoCompoundingVesResponse{
+		AutoCompounds: autoCompounds,
+		Pagination:    pageRes,
+	}, nil
+}
+
 func (k Keeper) Params(c context.Context, msg *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	if msg == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -5,6 +5,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/elysiumstation/blackfury/app"
 	"github.com/elysiumstation/blackfury/x/ve/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -181,3 +182,50 @@ func (suite *KeeperTestSuite) TestKeeper_Params() {
 	params := k.GetParams(suite.ctx)
 	suite.Require().Equal(res.Params, params)
 }
+
+func (suite *KeeperTestSuite) TestKeeper_VeNftMetadata() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VeKeeper
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := k.VeNftMetadata(ctx, nil)
+	require.Nil(res)
+	require.Error(err)
+	_, err = k.VeNftMetadata(ctx, &types.QueryVeNftMetadataRequest{Id: "ve-100"})
+	require.Error(err)
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	amount := sdk.NewCoin(k.LockDenom(suite.ctx), sdk.NewIntWithDecimal(1000, 18))
+	err = app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(amount.Add(amount)))
+	require.NoError(err)
+	veID, unlockTime, err := k.CreateLock(suite.ctx, sender, sender, amount, types.MaxLockTime)
+	require.NoError(err)
+
+	checkData := func(locked types.LockedBalance) {
+		token, found := suite.app.NftKeeper.GetNFT(suite.ctx, types.VeNftClass.Id, types.VeIDFromUint64(veID))
+		require.True(found)
+		require.NotNil(token.Data)
+		var data types.VeNftData
+		require.NoError(suite.app.AppCodec().Unmarshal(token.Data.Value, &data))
+		require.Equal(locked, data.Locked)
+		require.Equal(k.GetUserCheckpoint(suite.ctx, veID, k.GetUserEpoch(suite.ctx, veID)), data.Point)
+	}
+	checkData(types.LockedBalance{Amount: amount.Amount, End: unlockTime})
+
+	// data is in sync after deposit
+	err = k.DepositFor(suite.ctx, sender, veID, amount.Amount, 0, k.GetLockedAmountByUser(suite.ctx, veID), true)
+	require.NoError(err)
+	checkData(types.LockedBalance{Amount: amount.Amount.Add(amount.Amount), End: unlockTime})
+
+	k.SetVeVoted(suite.ctx, veID, true)
+	res, err = k.VeNftMetadata(ctx, &types.QueryVeNftMetadataRequest{Id: types.VeIDFromUint64(veID)})
+	require.NoError(err)
+	require.Equal(sender.String(), res.Metadata.Owner)
+	require.Equal(amount.Amount.Add(amount.Amount), res.Metadata.Locked.Amount)
+	require.Equal(k.GetVotingPower(suite.ctx, veID, uint64(suite.ctx.BlockTime().Unix()), 0), res.Metadata.VotingPower)
+	require.True(res.Metadata.VotingPower.IsPositive())
+	require.True(res.Metadata.Voted)
+	require.Equal(res.Metadata.JSON(), res.Json)
+	require.Equal(res.Metadata.SVG(), res.Svg)
+}
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elysiumstation/blackfury/x/ve/types"
+)
+
+// RegisterInvariants registers the ve module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "total-locked", TotalLockedInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-balance", ModuleBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "nft-owner", NftOwnerInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "checkpoint-bias", CheckpointBiasInvariant(k))
+}
+
+// AllInvariants runs all invariants of the ve module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			TotalLockedInvariant(k),
+			ModuleBalanceInvariant(k),
+			NftOwnerInvariant(k),
+			CheckpointBiasInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// TotalLockedInvariant checks that the total locked amount equals the sum of locked amounts of all ve
+func TotalLockedInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		sum := sdk.ZeroInt()
+		k.IterateLockedAmounts(ctx, func(_ uint64, locked types.LockedBalance) bool {
+			sum = sum.Add(locked.Amount)
+			return false
+		})
+
+		totalLocked := k.GetTotalLockedAmount(ctx)
+		broken := !totalLocked.Equal(sum)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "total-locked",
+			fmt.Sprintf("\ttotal locked amount: %s\n\tsum of locked amounts: %s\n", totalLocked, sum),
+		), broken
+	}
+}
+
+// ModuleBalanceInvariant checks that the ve module account balance covers the total locked amount
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		balance := k.bankKeeper.GetBalance(ctx, moduleAddr, k.LockDenom(ctx))
+
+		totalLocked := k.GetTotalLockedAmount(ctx)
+		broken := balance.Amount.LT(totalLocked)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "module-balance",
+			fmt.Sprintf("\tmodule account balance: %s\n\ttotal locked amount: %s\n", balance, totalLocked),
+		), broken
+	}
+}
+
+// NftOwnerInvariant checks that every locked ve has an owner of its NFT
+func NftOwnerInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		k.IterateLockedAmounts(ctx, func(veID uint64, _ types.LockedBalance) bool {
+			owner := k.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, types.VeIDFromUint64(veID))
+			if owner.Empty() {
+				count++
+				msg += fmt.Sprintf("\t%s has no NFT owner\n", types.VeIDFromUint64(veID))
+			}
+			return false
+		})
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "nft-owner",
+			fmt.Sprintf("locked ve without NFT owner found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// CheckpointBiasInvariant checks that the bias of the latest system checkpoint is not less than
+// the sum of biases of all user checkpoints, as of the time of the system checkpoint
+func CheckpointBiasInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		point := k.GetCheckpoint(ctx, k.GetEpoch(ctx))
+
+		sum := sdk.ZeroInt()
+		k.IterateUserEpochs(ctx, func(veID uint64, userEpoch uint64) bool {
+			userPoint := k.GetUserCheckpoint(ctx, veID, userEpoch)
+			if userPoint.Timestamp > point.Timestamp {
+				// cannot happen, since the system checkpoint is regulated along with user checkpoints
+				sum = sum.Add(userPoint.Bias)
+				return false
+			}
+			bias := userPoint.Bias.Sub(userPoint.Slope.MulRaw(int64(point.Timestamp - userPoint.Timestamp)))
+			if bias.IsPositive() {
+				sum = sum.Add(bias)
+			}
+			return false
+		})
+
+		broken := point.Bias.LT(sum)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "checkpoint-bias",
+			fmt.Sprintf("\tsystem checkpoint bias: %s\n\tsum of user checkpoint biases: %s\n", point.Bias, sum),
+		), broken
+	}
+}
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elysiumstation/blackfury/app"
+	"github.com/elysiumstation/blackfury/x/ve/keeper"
+	"github.com/elysiumstation/blackfury/x/ve/types"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	require := suite.Require()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	impl := keeper.NewMsgServerImpl(suite.app.VeKeeper)
+	k := suite.app.VeKeeper
+	sender := sdk.AccAddress(suite.address.Bytes())
+
+	amount := sdk.NewCoin("afury", sdk.NewInt(1e18))
+	err := app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(sdk.NewCoin("afury", sdk.NewInt(2e18))))
+	require.NoError(err)
+
+	for i := 0; i < 2; i++ {
+		_, err = impl.Create(ctx, &types.MsgCreate{
+			Sender:       sender.String(),
+			Amount:       amount,
+			LockDuration: types.MaxLockTime,
+		})
+		require.NoError(err)
+	}
+	requireNotBroken := func() {
+		for _, inv := range []sdk.Invariant{
+			keeper.TotalLockedInvariant(k),
+			keeper.ModuleBalanceInvariant(k),
+			keeper.NftOwnerInvariant(k),
+			keeper.CheckpointBiasInvariant(k),
+		} {
+			msg, broken := inv(suite.ctx)
+			require.False(broken, msg)
+		}
+	}
+	requireNotBroken()
+
+	_, err = impl.Merge(ctx, &types.MsgMerge{
+		Sender:   sender.String(),
+		FromVeId: types.VeIDFromUint64(1),
+		ToVeId:   types.VeIDFromUint64(2),
+	})
+	require.NoError(err)
+	requireNotBroken()
+
+	k.SlashLockedAmountByUser(suite.ctx, 2, sdk.NewInt(1e17))
+	requireNotBroken()
+
+	testCases := []struct {
+		name      string
+		invariant sdk.Invariant
+		malleate  func(ctx sdk.Context)
+	}{
+		{
+			"total locked drifts",
+			keeper.TotalLockedInvariant(k),
+			func(ctx sdk.Context) {
+				k.SetTotalLockedAmount(ctx, k.GetTotalLockedAmount(ctx).AddRaw(1))
+			},
+		},
+		{
+			"module balance insufficient",
+			keeper.ModuleBalanceInvariant(k),
+			func(ctx sdk.Context) {
+				err := suite.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(sdk.NewCoin("afury", sdk.NewInt(1))))
+				require.NoError(err)
+			},
+		},
+		{
+			"locked ve without nft",
+			keeper.NftOwnerInvariant(k),
+			func(ctx sdk.Context) {
+				k.SetLockedAmountByUser(ctx, 100, k.GetLockedAmountByUser(ctx, 2))
+			},
+		},
+		{
+			"system bias less than user biases",
+			keeper.CheckpointBiasInvariant(k),
+			func(ctx sdk.Context) {
+				epoch := k.GetEpoch(ctx)
+				point := k.GetCheckpoint(ctx, epoch)
+				point.Bias = sdk.ZeroInt()
+				k.SetCheckpoint(ctx, epoch, point)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		cacheCtx, _ := suite.ctx.CacheContext()
+		tc.malleate(cacheCtx)
+		_, broken := tc.invariant(cacheCtx)
+		require.True(broken, tc.name)
+		_, broken = keeper.AllInvariants(k)(cacheCtx)
+		require.True(broken, tc.name)
+	}
+}
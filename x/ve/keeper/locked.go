@@ -48,3 +48,19 @@ func (k Keeper) DeleteLockedAmountByUser(ctx sdk.Context, veID uint64) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.LockedAmountByUserKey(veID))
 }
+
+// IterateLockedAmounts iterates over locked amounts of all ve
+func (k Keeper) IterateLockedAmounts(ctx sdk.Context, cb func(veID uint64, locked types.LockedBalance) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixLockedAmountByUser)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		veID := sdk.BigEndianToUint64(iterator.Key()[len(types.KeyPrefixLockedAmountByUser):])
+		var locked types.LockedBalance
+		k.cdc.MustUnmarshal(iterator.Value(), &locked)
+		if cb(veID, locked) {
+			break
+		}
+	}
+}
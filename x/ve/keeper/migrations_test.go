@@ -0,0 +1,24 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/elysiumstation/blackfury/x/ve"
+	"github.com/elysiumstation/blackfury/x/ve/keeper"
+	"github.com/elysiumstation/blackfury/x/ve/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	suite.SetupTest()
+	k := suite.app.VeKeeper
+
+	// params added since version 2 do not exist before the migration
+	store := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	store.Delete(types.KeyHistoryRetention)
+	store.Delete(types.KeyCheckpointCatchUpWeeks)
+	suite.Require().Panics(func() { ve.EndBlocker(suite.ctx, k) })
+
+	suite.Require().NoError(keeper.NewMigrator(k).Migrate2to3(suite.ctx))
+	suite.Require().Equal(types.DefaultParams(), k.GetParams(suite.ctx))
+	suite.Require().NotPanics(func() { ve.EndBlocker(suite.ctx, k) })
+}
@@ -25,42 +25,12 @@ var _ types.MsgServer = msgServer{}
 func (m msgServer) Create(c context.Context, msg *types.MsgCreate) (*types.MsgCreateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	err := m.Keeper.checkLockDenom(ctx, msg.Amount)
-	if err != nil {
-		return nil, err
-	}
-
 	sender, receiver, err := getSenderReceiver(msg.Sender, msg.To)
 	if err != nil {
 		return nil, err
 	}
 
-	unlockTime := types.RegulatedUnixTimeFromNow(ctx, msg.LockDuration)
-	if unlockTime <= uint64(ctx.BlockTime().Unix()) {
-		return nil, sdkerrors.Wrapf(types.ErrPastLockTime, "past time: %s", time.Unix(int64(unlockTime), 0))
-	}
-	if unlockTime > uint64(ctx.BlockTime().Unix())+types.MaxLockTime {
-		return nil, sdkerrors.Wrapf(types.ErrTooLongLockTime, "future time: %s", time.Unix(int64(unlockTime), 0))
-	}
-
-	// get new ve id
-	veID := m.Keeper.GetNextVeID(ctx)
-	if veID > types.MaxVeID || veID == types.EmptyVeID {
-		return nil, sdkerrors.Wrap(types.ErrInvalidVeID, "no available ve id")
-	}
-	m.Keeper.SetNextVeID(ctx, veID+1)
-
-	// mint nft for ve id
-	err = m.Keeper.nftKeeper.Mint(ctx, nfttypes.NFT{
-		ClassId: types.VeNftClass.Id,
-		Id:      types.VeIDFromUint64(veID),
-	}, receiver)
-	if err != nil {
-		return nil, err
-	}
-
-	// deposit for ve id
-	err = m.Keeper.DepositFor(ctx, sender, veID, msg.Amount.Amount, unlockTime, types.NewLockedBalance(), true)
+	veID, unlockTime, err := m.Keeper.CreateLock(ctx, sender, receiver, msg.Amount, msg.LockDuration)
 	if err != nil {
 		return nil, err
 	}
@@ -243,6 +213,8 @@ func (m msgServer) Merge(c context.Context, msg *types.MsgMerge) (*types.MsgMerg
 
 	// delete user locked of fromVeID
 	m.Keeper.DeleteLockedAmountByUser(ctx, fromVeID)
+	// it will be added back to total locked when deposited for toVeID
+	m.Keeper.SetTotalLockedAmount(ctx, m.Keeper.GetTotalLockedAmount(ctx).Sub(lockedFrom.Amount))
 
 	// regulate checkpoint of fromVeID
 	m.Keeper.RegulateUserCheckpoint(ctx, fromVeID, lockedFrom, types.NewLockedBalance())
@@ -354,6 +326,126 @@ func (m msgServer) Withdraw(c context.Context, msg *types.MsgWithdraw) (*types.M
 	return &types.MsgWithdrawResponse{}, nil
 }
 
+func (m msgServer) ApproveVoter(c context.Context, msg *types.MsgApproveVoter) (*types.MsgApproveVoterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	voter, err := sdk.AccAddressFromBech32(msg.Voter)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msg.VeId) > 0 {
+		owner := m.Keeper.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, msg.VeId)
+		if !sender.Equals(owner) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s", sender, msg.VeId)
+		}
+		m.Keeper.SetApprovedVoter(ctx, types.Uint64FromVeID(msg.VeId), voter)
+	} else {
+		m.Keeper.SetVoterOperator(ctx, sender, voter, true)
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventApproveVoter{
+		Sender: sender.String(),
+		VeId:   msg.VeId,
+		Voter:  voter.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgApproveVoterResponse{}, nil
+}
+
+func (m msgServer) RevokeVoter(c context.Context, msg *types.MsgRevokeVoter) (*types.MsgRevokeVoterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msg.VeId) > 0 {
+		owner := m.Keeper.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, msg.VeId)
+		if !sender.Equals(owner) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s", sender, msg.VeId)
+		}
+		m.Keeper.DeleteApprovedVoter(ctx, types.Uint64FromVeID(msg.VeId))
+	} else {
+		voter, err := sdk.AccAddressFromBech32(msg.Voter)
+		if err != nil {
+			return nil, err
+		}
+		m.Keeper.SetVoterOperator(ctx, sender, voter, false)
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRevokeVoter{
+		Sender: sender.String(),
+		VeId:   msg.VeId,
+		Voter:  msg.Voter,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgRevokeVoterResponse{}, nil
+}
+
+func (m msgServer) SetAutoCompound(c context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := m.Keeper.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, msg.VeId)
+	if !sender.Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s", sender, msg.VeId)
+	}
+
+	veID := types.Uint64FromVeID(msg.VeId)
+	compoundRewards := msg.Enabled && msg.CompoundRewards
+	if msg.Enabled {
+		m.Keeper.SetVeAutoCompound(ctx, veID, compoundRewards)
+	} else {
+		m.Keeper.DeleteVeAutoCompound(ctx, veID)
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventSetAutoCompound{
+		Sender:          sender.String(),
+		VeId:            msg.VeId,
+		Enabled:         msg.Enabled,
+		CompoundRewards: compoundRewards,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
+
 // DepositFor deposits some more amount and/or update locking end time for a veNFT.
 //
 //		 veID: must be valid ve id
@@ -396,6 +488,45 @@ func (k Keeper) DepositFor(ctx sdk.Context, sender sdk.AccAddress, veID uint64,
 	return nil
 }
 
+// CreateLock locks the amount taken from sender for the lock duration, into a new veNFT owned by receiver.
+func (k Keeper) CreateLock(ctx sdk.Context, sender, receiver sdk.AccAddress, amount sdk.Coin, lockDuration uint64) (veID uint64, unlockTime uint64, err error) {
+	err = k.checkLockDenom(ctx, amount)
+	if err != nil {
+		return
+	}
+
+	unlockTime = types.RegulatedUnixTimeFromNow(ctx, lockDuration)
+	if unlockTime <= uint64(ctx.BlockTime().Unix()) {
+		err = sdkerrors.Wrapf(types.ErrPastLockTime, "past time: %s", time.Unix(int64(unlockTime), 0))
+		return
+	}
+	if unlockTime > uint64(ctx.BlockTime().Unix())+types.MaxLockTime {
+		err = sdkerrors.Wrapf(types.ErrTooLongLockTime, "future time: %s", time.Unix(int64(unlockTime), 0))
+		return
+	}
+
+	// get new ve id
+	veID = k.GetNextVeID(ctx)
+	if veID > types.MaxVeID || veID == types.EmptyVeID {
+		err = sdkerrors.Wrap(types.ErrInvalidVeID, "no available ve id")
+		return
+	}
+	k.SetNextVeID(ctx, veID+1)
+
+	// mint nft for ve id
+	err = k.nftKeeper.Mint(ctx, nfttypes.NFT{
+		ClassId: types.VeNftClass.Id,
+		Id:      types.VeIDFromUint64(veID),
+	}, receiver)
+	if err != nil {
+		return
+	}
+
+	// deposit for ve id
+	err = k.DepositFor(ctx, sender, veID, amount.Amount, unlockTime, types.NewLockedBalance(), true)
+	return
+}
+
 func (k Keeper) checkLockDenom(ctx sdk.Context, amount sdk.Coin) error {
 	lockDenom := k.LockDenom(ctx)
 	if amount.Denom != lockDenom {
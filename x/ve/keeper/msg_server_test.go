@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/elysiumstation/blackfury/x/ve/keeper"
@@ -344,3 +345,34 @@ func (suite *KeeperTestSuite) TestKeeper_DepositFor() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgServiceRouter() {
+	require := suite.Require()
+	router := suite.app.MsgServiceRouter()
+	sender := sdk.AccAddress(suite.address.Bytes())
+	amount := sdk.NewCoin("afury", sdk.NewInt(1))
+
+	deliver := func(ctx sdk.Context, msg sdk.Msg) {
+		require.NoError(msg.ValidateBasic())
+		handler := router.Handler(msg)
+		require.NotNil(handler, "%T is not routable", msg)
+		_, err := handler(ctx, msg)
+		require.NoError(err, "%T", msg)
+	}
+
+	for i := 0; i < 2; i++ {
+		deliver(suite.ctx, &types.MsgCreate{
+			Sender:       sender.String(),
+			Amount:       amount,
+			LockDuration: types.RegulatedPeriod,
+		})
+	}
+	deliver(suite.ctx, &types.MsgDeposit{Sender: sender.String(), VeId: "ve-1", Amount: amount})
+	deliver(suite.ctx, &types.MsgExtendTime{Sender: sender.String(), VeId: "ve-1", LockDuration: 2 * types.RegulatedPeriod})
+	deliver(suite.ctx, &types.MsgMerge{Sender: sender.String(), FromVeId: "ve-2", ToVeId: "ve-1"})
+	require.Equal(sdk.NewInt(3), suite.app.VeKeeper.GetLockedAmountByUser(suite.ctx, 1).Amount)
+
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(3 * types.RegulatedPeriod * time.Second))
+	deliver(ctx, &types.MsgWithdraw{Sender: sender.String(), VeId: "ve-1"})
+	require.True(suite.app.VeKeeper.GetLockedAmountByUser(ctx, 1).Amount.IsZero())
+}
@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	nfttypes "github.com/cosmos/cosmos-sdk/x/nft"
 	nftkeeper "github.com/cosmos/cosmos-sdk/x/nft/keeper"
@@ -27,11 +29,12 @@ func (am NftAppModule) RegisterServices(cfg module.Configurator) {
 
 type NftKeeper struct {
 	nftkeeper.Keeper
-	veKeeper func() Keeper
+	veKeeper     func() Keeper
+	erc721Keeper func() types.Erc721Keeper
 }
 
-func NewNftKeeper(keeper nftkeeper.Keeper, veKeeper func() Keeper) NftKeeper {
-	return NftKeeper{Keeper: keeper, veKeeper: veKeeper}
+func NewNftKeeper(keeper nftkeeper.Keeper, veKeeper func() Keeper, erc721Keeper func() types.Erc721Keeper) NftKeeper {
+	return NftKeeper{Keeper: keeper, veKeeper: veKeeper, erc721Keeper: erc721Keeper}
 }
 
 // Send implement Send method of the types.MsgServer of the nft module.
@@ -47,7 +50,102 @@ func (k NftKeeper) Send(c context.Context, msg *nfttypes.MsgSend) (*nfttypes.Msg
 		}
 	}
 
-	return k.Keeper.Send(c, msg)
+	res, err := k.Keeper.Send(c, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+	k.resetOwnerSettings(sdk.UnwrapSDKContext(c), msg.ClassId, msg.Id)
+	err = k.syncErc721(sdk.UnwrapSDKContext(c), msg.ClassId, msg.Id, receiver)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Mint overrides Mint of the nft keeper, with mirroring ve NFT into the EVM.
+func (k NftKeeper) Mint(ctx sdk.Context, token nfttypes.NFT, receiver sdk.AccAddress) error {
+	err := k.Keeper.Mint(ctx, token, receiver)
+	if err != nil {
+		return err
+	}
+	return k.syncErc721(ctx, token.ClassId, token.Id, receiver)
+}
+
+// Burn overrides Burn of the nft keeper, with mirroring ve NFT into the EVM.
+func (k NftKeeper) Burn(ctx sdk.Context, classID string, nftID string) error {
+	err := k.Keeper.Burn(ctx, classID, nftID)
+	if err != nil {
+		return err
+	}
+	k.resetOwnerSettings(ctx, classID, nftID)
+	return k.syncErc721(ctx, classID, nftID, nil)
+}
+
+// Transfer overrides Transfer of the nft keeper, with mirroring ve NFT into the EVM.
+func (k NftKeeper) Transfer(ctx sdk.Context, classID string, nftID string, receiver sdk.AccAddress) error {
+	err := k.Keeper.Transfer(ctx, classID, nftID, receiver)
+	if err != nil {
+		return err
+	}
+	k.resetOwnerSettings(ctx, classID, nftID)
+	return k.syncErc721(ctx, classID, nftID, receiver)
+}
+
+// TransferVeNftFromEVM transfers ve NFT in the nft module for the ERC721 transfer in the EVM.
+// It does not mirror the transfer back into the EVM.
+func (k NftKeeper) TransferVeNftFromEVM(ctx sdk.Context, veID uint64, from, to sdk.AccAddress) error {
+	nftID := types.VeIDFromUint64(veID)
+	if !k.Keeper.HasNFT(ctx, types.VeNftClass.Id, nftID) {
+		return sdkerrors.Wrapf(types.ErrInvalidVeID, "ve %d not found", veID)
+	}
+	owner := k.Keeper.GetOwner(ctx, types.VeNftClass.Id, nftID)
+	if !owner.Equals(from) {
+		return sdkerrors.Wrapf(types.ErrInvalidVeOwner, "ve %d is owned by %s, not %s", veID, owner, from)
+	}
+	err := k.veKeeper().CheckVeAttached(ctx, veID)
+	if err != nil {
+		return err
+	}
+	err = k.Keeper.Transfer(ctx, types.VeNftClass.Id, nftID, to)
+	if err != nil {
+		return err
+	}
+	k.resetOwnerSettings(ctx, types.VeNftClass.Id, nftID)
+	return nil
+}
+
+// IterateVeNfts iterates over all ve NFTs with their owners, until the callback returns true
+func (k NftKeeper) IterateVeNfts(ctx sdk.Context, cb func(veID uint64, owner sdk.AccAddress) (stop bool)) {
+	for _, token := range k.Keeper.GetNFTsOfClass(ctx, types.VeNftClass.Id) {
+		owner := k.Keeper.GetOwner(ctx, types.VeNftClass.Id, token.Id)
+		if cb(types.Uint64FromVeID(token.Id), owner) {
+			break
+		}
+	}
+}
+
+// resetOwnerSettings clears the voter approved and the auto-compounding enabled by the
+// previous owner of ve NFT, like the token approval of ERC721 cleared on transfer
+func (k NftKeeper) resetOwnerSettings(ctx sdk.Context, classID string, nftID string) {
+	if classID != types.VeNftClass.Id {
+		return
+	}
+	veID := types.Uint64FromVeID(nftID)
+	k.veKeeper().DeleteApprovedVoter(ctx, veID)
+	k.veKeeper().DeleteVeAutoCompound(ctx, veID)
+}
+
+// syncErc721 mirrors the owner of ve NFT into the ERC721 contract in the EVM
+func (k NftKeeper) syncErc721(ctx sdk.Context, classID string, nftID string, owner sdk.AccAddress) error {
+	if classID != types.VeNftClass.Id || k.erc721Keeper == nil {
+		return nil
+	}
+	return k.erc721Keeper().SyncVeNftOwner(ctx, types.Uint64FromVeID(nftID), owner)
 }
 
 // CheckVeAttached checks whether the ve has attached/voted
@@ -68,6 +166,42 @@ func (k Keeper) HasNftClass(ctx sdk.Context) bool {
 	return k.nftKeeper.HasClass(ctx, types.VeNftClass.Id)
 }
 
+// GetVeNftMetadata gets the dynamic metadata of ve
+func (k Keeper) GetVeNftMetadata(ctx sdk.Context, veID uint64) types.VeNftMetadata {
+	id := types.VeIDFromUint64(veID)
+	owner := k.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, id)
+	delegated := sdk.ZeroInt()
+	if k.getDelegatedAmount != nil {
+		delegated = k.getDelegatedAmount(ctx, veID)
+	}
+	return types.VeNftMetadata{
+		Id:              id,
+		Owner:           owner.String(),
+		Locked:          k.GetLockedAmountByUser(ctx, veID),
+		VotingPower:     k.GetVotingPower(ctx, veID, uint64(ctx.BlockTime().Unix()), 0),
+		DelegatedAmount: delegated,
+		Voted:           k.GetVeVoted(ctx, veID),
+		Attached:        k.GetVeAttached(ctx, veID),
+	}
+}
+
+// setVeNftData sets the locked balance and the voting power checkpoint as the data of ve NFT
+func (k Keeper) setVeNftData(ctx sdk.Context, veID uint64, locked types.LockedBalance, point types.Checkpoint) {
+	token, found := k.nftKeeper.GetNFT(ctx, types.VeNftClass.Id, types.VeIDFromUint64(veID))
+	if !found {
+		return
+	}
+	data, err := codectypes.NewAnyWithValue(&types.VeNftData{Locked: locked, Point: point})
+	if err != nil {
+		panic(err)
+	}
+	token.Data = data
+	err = k.nftKeeper.Update(ctx, token)
+	if err != nil {
+		panic(err)
+	}
+}
+
 // SetNextVeID sets the next ID for creating new ve
 func (k Keeper) SetNextVeID(ctx sdk.Context, nextVeID uint64) {
 	store := ctx.KVStore(k.storeKey)
@@ -136,3 +270,21 @@ func (k Keeper) GetVeVoted(ctx sdk.Context, veID uint64) bool {
 		return true
 	}
 }
+
+// IterateVeVoted iterates over all ve which have voted, until the callback returns true
+func (k Keeper) IterateVeVoted(ctx sdk.Context, cb func(veID uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixVoted)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		bz := iterator.Value()
+		if len(bz) == 0 || bz[0] == 0 {
+			continue
+		}
+		veID := sdk.BigEndianToUint64(iterator.Key()[len(types.KeyPrefixVoted):])
+		if cb(veID) {
+			break
+		}
+	}
+}
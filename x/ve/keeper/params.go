@@ -20,3 +20,13 @@ func (k Keeper) LockDenom(ctx sdk.Context) (res string) {
 	k.paramstore.Get(ctx, types.KeyLockDenom, &res)
 	return
 }
+
+func (k Keeper) HistoryRetention(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyHistoryRetention, &res)
+	return
+}
+
+func (k Keeper) CheckpointCatchUpWeeks(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyCheckpointCatchUpWeeks, &res)
+	return
+}
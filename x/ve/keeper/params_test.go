@@ -12,8 +12,10 @@ func (suite *KeeperTestSuite) TestKeeper_GetParams() {
 func (suite *KeeperTestSuite) TestKeeper_SetParams() {
 	suite.SetupTest()
 	k := suite.app.VeKeeper
-	k.SetParams(suite.ctx, types.Params{LockDenom: "aaa"})
-	params := k.GetParams(suite.ctx)
+	params := types.DefaultParams()
+	params.LockDenom = "aaa"
+	k.SetParams(suite.ctx, params)
+	params = k.GetParams(suite.ctx)
 	suite.Require().Equal("aaa", params.LockDenom)
 }
 
@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elysiumstation/blackfury/x/ve/types"
+)
+
+// PruneHistory deletes obsolete slope changes and user checkpoints,
+// visiting at most MaxPruneDeletionsPerBlock keys per call.
+func (k Keeper) PruneHistory(ctx sdk.Context) {
+	limit := types.MaxPruneDeletionsPerBlock
+
+	var slopeChangeKeys [][]byte
+	k.iteratePrunableSlopeChanges(ctx, func(key []byte) (stop bool) {
+		slopeChangeKeys = append(slopeChangeKeys, key)
+		return len(slopeChangeKeys) >= limit
+	})
+	k.deleteKeys(ctx, slopeChangeKeys)
+
+	k.pruneUserCheckpoints(ctx, limit-len(slopeChangeKeys))
+}
+
+// CountPrunableHistory counts the slope changes and user checkpoints that can be pruned.
+func (k Keeper) CountPrunableHistory(ctx sdk.Context) (slopeChanges uint64, userCheckpoints uint64) {
+	k.iteratePrunableSlopeChanges(ctx, func(key []byte) (stop bool) {
+		slopeChanges++
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixUserPointHistoryByUserEpoch)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if k.isUserCheckpointPrunable(ctx, iterator.Key()) {
+			userCheckpoints++
+		}
+	}
+	return
+}
+
+// iteratePrunableSlopeChanges iterates over the slope changes not later than the latest system checkpoint,
+// which have been applied and will be no longer used
+func (k Keeper) iteratePrunableSlopeChanges(ctx sdk.Context, cb func(key []byte) (stop bool)) {
+	epoch := k.GetEpoch(ctx)
+	if epoch == types.EmptyEpoch {
+		return
+	}
+	timeLast := k.GetCheckpoint(ctx, epoch).Timestamp
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixSlopeChange)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		timestamp := sdk.BigEndianToUint64(iterator.Key()[len(types.KeyPrefixSlopeChange):])
+		if timestamp > timeLast {
+			break
+		}
+		if cb(iterator.Key()) {
+			break
+		}
+	}
+}
+
+// pruneUserCheckpoints visits at most limit user checkpoints from the cursor saved by the last call,
+// and deletes the prunable ones
+func (k Keeper) pruneUserCheckpoints(ctx sdk.Context, limit int) {
+	if limit <= 0 || k.HistoryRetention(ctx) == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	start := store.Get(types.UserPointPruneCursorKey())
+	if start == nil {
+		start = types.KeyPrefixUserPointHistoryByUserEpoch
+	}
+	iterator := store.Iterator(start, sdk.PrefixEndBytes(types.KeyPrefixUserPointHistoryByUserEpoch))
+
+	var keys [][]byte
+	var cursor []byte
+	visited := 0
+	for ; iterator.Valid(); iterator.Next() {
+		if visited >= limit {
+			cursor = iterator.Key()
+			break
+		}
+		visited++
+		if k.isUserCheckpointPrunable(ctx, iterator.Key()) {
+			keys = append(keys, iterator.Key())
+		}
+	}
+	iterator.Close()
+
+	k.deleteKeys(ctx, keys)
+
+	if cursor != nil {
+		store.Set(types.UserPointPruneCursorKey(), cursor)
+	} else {
+		// start over from the beginning at the next call
+		store.Delete(types.UserPointPruneCursorKey())
+	}
+}
+
+// isUserCheckpointPrunable checks whether the user checkpoint has been superseded by the next one
+// before the history horizon. The horizon is the retention period before now, but not later than
+// the last distribution claim of the ve, so that the unclaimed periods can still be claimed.
+func (k Keeper) isUserCheckpointPrunable(ctx sdk.Context, key []byte) bool {
+	retention := k.HistoryRetention(ctx)
+	now := uint64(ctx.BlockTime().Unix())
+	if retention == 0 || now <= retention {
+		return false
+	}
+
+	prefixLen := len(types.KeyPrefixUserPointHistoryByUserEpoch)
+	veID := sdk.BigEndianToUint64(key[prefixLen : prefixLen+8])
+	userEpoch := sdk.BigEndianToUint64(key[prefixLen+8:])
+
+	// always keep the latest user checkpoint
+	if userEpoch >= k.GetUserEpoch(ctx, veID) {
+		return false
+	}
+
+	horizon := now - retention
+	claimLast := k.GetDistributionClaimLastTimestampByUser(ctx, veID)
+	if claimLast < horizon {
+		horizon = claimLast
+	}
+
+	return k.GetUserCheckpoint(ctx, veID, userEpoch+1).Timestamp <= horizon
+}
+
+func (k Keeper) deleteKeys(ctx sdk.Context, keys [][]byte) {
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elysiumstation/blackfury/app"
+	"github.com/elysiumstation/blackfury/x/ve"
+	"github.com/elysiumstation/blackfury/x/ve/types"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_PruneHistory_SlopeChanges() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VeKeeper
+
+	now := uint64(suite.ctx.BlockTime().Unix())
+	week := types.RegulatedUnixTime(now)
+	k.RegulateCheckpoint(suite.ctx)
+
+	// past slope changes more than the per-block limit, and a future one
+	past := types.MaxPruneDeletionsPerBlock + 10
+	for i := 1; i <= past; i++ {
+		k.SetSlopeChange(suite.ctx, week-uint64(i)*types.RegulatedPeriod, sdk.NewInt(1))
+	}
+	future := types.NextRegulatedUnixTime(week)
+	k.SetSlopeChange(suite.ctx, future, sdk.NewInt(1))
+
+	slopeChanges, _ := k.CountPrunableHistory(suite.ctx)
+	require.Equal(uint64(past), slopeChanges)
+
+	k.PruneHistory(suite.ctx)
+	slopeChanges, _ = k.CountPrunableHistory(suite.ctx)
+	require.Equal(uint64(past-types.MaxPruneDeletionsPerBlock), slopeChanges)
+
+	k.PruneHistory(suite.ctx)
+	slopeChanges, _ = k.CountPrunableHistory(suite.ctx)
+	require.Equal(uint64(0), slopeChanges)
+	require.Equal(sdk.NewInt(1), k.GetSlopeChange(suite.ctx, future))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_PruneHistory_UserCheckpoints() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VeKeeper
+
+	params := k.GetParams(suite.ctx)
+	params.HistoryRetention = 4 * types.RegulatedPeriod
+	k.SetParams(suite.ctx, params)
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	amount := sdk.NewCoin(k.LockDenom(suite.ctx), sdk.NewIntWithDecimal(1000, 18))
+	err := app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(amount.Add(amount)))
+	require.NoError(err)
+	claimedVeID, _, err := k.CreateLock(suite.ctx, sender, sender, amount, types.MaxLockTime)
+	require.NoError(err)
+	unclaimedVeID, _, err := k.CreateLock(suite.ctx, sender, sender, amount, types.MaxLockTime)
+	require.NoError(err)
+
+	// deposit every week for ten weeks
+	ctx := suite.ctx
+	start := ctx.BlockTime()
+	one := sdk.NewInt(1)
+	for i := 1; i <= 10; i++ {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(start.Add(time.Duration(i) * 7 * 24 * time.Hour))
+		for _, veID := range []uint64{claimedVeID, unclaimedVeID} {
+			err = app.FundAccount(suite.app.BankKeeper, ctx, sender, sdk.NewCoins(sdk.NewCoin(amount.Denom, one)))
+			require.NoError(err)
+			err = k.DepositFor(ctx, sender, veID, one, 0, k.GetLockedAmountByUser(ctx, veID), true)
+			require.NoError(err)
+		}
+	}
+	require.Equal(uint64(11), k.GetUserEpoch(ctx, claimedVeID))
+
+	now := uint64(ctx.BlockTime().Unix())
+	recent := now - 2*types.RegulatedPeriod
+	old := uint64(start.Unix()) + types.RegulatedPeriod + 1
+	powerRecent := k.GetVotingPower(ctx, claimedVeID, recent, 0)
+	powerOld := k.GetVotingPower(ctx, unclaimedVeID, old, 0)
+
+	// only the ve which has claimed recently can be pruned up to the retention horizon
+	k.SetDistributionClaimLastTimestampByUser(ctx, claimedVeID, now)
+	_, userCheckpoints := k.CountPrunableHistory(ctx)
+	require.Equal(uint64(6), userCheckpoints)
+
+	ve.EndBlocker(ctx, k)
+	_, userCheckpoints = k.CountPrunableHistory(ctx)
+	require.Equal(uint64(0), userCheckpoints)
+
+	// historical voting power within the retention and the unclaimed periods is kept
+	require.Equal(powerRecent, k.GetVotingPower(ctx, claimedVeID, recent, 0))
+	require.Equal(powerOld, k.GetVotingPower(ctx, unclaimedVeID, old, 0))
+	require.Equal(k.GetVotingPower(ctx, claimedVeID, now, 0), k.GetVotingPower(ctx, unclaimedVeID, now, 0))
+	require.True(k.GetUserCheckpoint(ctx, claimedVeID, 6).Bias.IsZero())
+	require.True(k.GetUserCheckpoint(ctx, claimedVeID, 7).Bias.IsPositive())
+	require.True(k.GetUserCheckpoint(ctx, unclaimedVeID, 1).Bias.IsPositive())
+
+	// no pruning with zero retention
+	params.HistoryRetention = 0
+	k.SetParams(ctx, params)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(52 * 7 * 24 * time.Hour))
+	_, userCheckpoints = k.CountPrunableHistory(ctx)
+	require.Equal(uint64(0), userCheckpoints)
+}
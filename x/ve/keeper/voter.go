@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/elysiumstation/blackfury/x/ve/types"
+)
+
+// SetApprovedVoter sets the voter approved by the owner of ve
+func (k Keeper) SetApprovedVoter(ctx sdk.Context, veID uint64, voter sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ApprovedVoterKey(veID), voter)
+}
+
+// GetApprovedVoter gets the voter approved by the owner of ve, or nil if none
+func (k Keeper) GetApprovedVoter(ctx sdk.Context, veID uint64) sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ApprovedVoterKey(veID))
+	if bz == nil {
+		return nil
+	}
+	return bz
+}
+
+// DeleteApprovedVoter deletes the voter approved by the owner of ve
+func (k Keeper) DeleteApprovedVoter(ctx sdk.Context, veID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ApprovedVoterKey(veID))
+}
+
+// SetVoterOperator sets whether the operator is approved to vote for all ve of the owner
+func (k Keeper) SetVoterOperator(ctx sdk.Context, owner sdk.AccAddress, operator sdk.AccAddress, approved bool) {
+	store := ctx.KVStore(k.storeKey)
+	if approved {
+		store.Set(types.VoterOperatorKey(owner, operator), []byte{1})
+	} else {
+		store.Delete(types.VoterOperatorKey(owner, operator))
+	}
+}
+
+// IsVoterOperator checks whether the operator is approved to vote for all ve of the owner
+func (k Keeper) IsVoterOperator(ctx sdk.Context, owner sdk.AccAddress, operator sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.VoterOperatorKey(owner, operator))
+}
+
+// IsVoterAuthorized checks whether the address is the owner of ve,
+// the voter approved for ve, or an operator approved by the owner
+func (k Keeper) IsVoterAuthorized(ctx sdk.Context, veID uint64, addr sdk.AccAddress) bool {
+	owner := k.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, types.VeIDFromUint64(veID))
+	if owner.Empty() || addr.Empty() {
+		return false
+	}
+	if addr.Equals(owner) || addr.Equals(k.GetApprovedVoter(ctx, veID)) {
+		return true
+	}
+	return k.IsVoterOperator(ctx, owner, addr)
+}
+
+// CheckVoterAuthorized checks whether the address can vote, abstain, poke and claim rewards for ve
+func (k Keeper) CheckVoterAuthorized(ctx sdk.Context, veID uint64, addr sdk.AccAddress) error {
+	if !k.IsVoterAuthorized(ctx, veID, addr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s is neither the owner nor an approved voter of ve %d", addr, veID)
+	}
+	return nil
+}
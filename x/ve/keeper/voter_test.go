@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	nfttypes "github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/elysiumstation/blackfury/app"
+	"github.com/elysiumstation/blackfury/x/ve/keeper"
+	"github.com/elysiumstation/blackfury/x/ve/types"
+	"github.com/tharsis/ethermint/tests"
+)
+
+func (suite *KeeperTestSuite) TestVoterApproval() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VeKeeper
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	impl := keeper.NewMsgServerImpl(k)
+
+	owner := sdk.AccAddress(suite.address.Bytes())
+	newAddr := func() sdk.AccAddress {
+		addr, _ := tests.NewAddrKey()
+		return sdk.AccAddress(addr.Bytes())
+	}
+	voter, operator, stranger := newAddr(), newAddr(), newAddr()
+
+	amount := sdk.NewCoin(k.LockDenom(suite.ctx), sdk.NewInt(1000))
+	require.NoError(app.FundAccount(suite.app.BankKeeper, suite.ctx, owner, sdk.NewCoins(amount.Add(amount))))
+	veID, _, err := k.CreateLock(suite.ctx, owner, owner, amount, types.MaxLockTime)
+	require.NoError(err)
+	otherVeID, _, err := k.CreateLock(suite.ctx, owner, owner, amount, types.MaxLockTime)
+	require.NoError(err)
+	id := types.VeIDFromUint64(veID)
+
+	queryApproval := func(addr sdk.AccAddress) *types.QueryVoterApprovalResponse {
+		res, err := k.VoterApproval(ctx, &types.QueryVoterApprovalRequest{Id: id, Voter: addr.String()})
+		require.NoError(err)
+		return res
+	}
+	require.True(queryApproval(owner).Authorized)
+	require.False(queryApproval(voter).Authorized)
+
+	// only the owner can approve voter for ve
+	_, err = impl.ApproveVoter(ctx, &types.MsgApproveVoter{Sender: stranger.String(), VeId: id, Voter: stranger.String()})
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = impl.ApproveVoter(ctx, &types.MsgApproveVoter{Sender: owner.String(), VeId: id, Voter: voter.String()})
+	require.NoError(err)
+	require.Equal(&types.QueryVoterApprovalResponse{ApprovedVoter: voter.String(), Authorized: true}, queryApproval(voter))
+	require.NoError(k.CheckVoterAuthorized(suite.ctx, veID, voter))
+	require.ErrorIs(k.CheckVoterAuthorized(suite.ctx, otherVeID, voter), sdkerrors.ErrUnauthorized)
+
+	// operator is approved for all ve of the owner
+	_, err = impl.ApproveVoter(ctx, &types.MsgApproveVoter{Sender: owner.String(), Voter: operator.String()})
+	require.NoError(err)
+	require.NoError(k.CheckVoterAuthorized(suite.ctx, veID, operator))
+	require.NoError(k.CheckVoterAuthorized(suite.ctx, otherVeID, operator))
+	require.ErrorIs(k.CheckVoterAuthorized(suite.ctx, veID, stranger), sdkerrors.ErrUnauthorized)
+
+	// approval for ve is cleared on transfer, but operator approval stays with the owner
+	_, err = suite.app.NftKeeper.Send(ctx, &nfttypes.MsgSend{
+		ClassId:  types.VeNftClass.Id,
+		Id:       id,
+		Sender:   owner.String(),
+		Receiver: stranger.String(),
+	})
+	require.NoError(err)
+	require.Nil(k.GetApprovedVoter(suite.ctx, veID))
+	require.False(queryApproval(voter).Authorized)
+	require.False(queryApproval(operator).Authorized)
+	require.True(queryApproval(stranger).Authorized)
+	require.NoError(k.CheckVoterAuthorized(suite.ctx, otherVeID, operator))
+
+	// revoke
+	_, err = impl.ApproveVoter(ctx, &types.MsgApproveVoter{Sender: owner.String(), VeId: types.VeIDFromUint64(otherVeID), Voter: voter.String()})
+	require.NoError(err)
+	_, err = impl.RevokeVoter(ctx, &types.MsgRevokeVoter{Sender: stranger.String(), VeId: types.VeIDFromUint64(otherVeID)})
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = impl.RevokeVoter(ctx, &types.MsgRevokeVoter{Sender: owner.String(), VeId: types.VeIDFromUint64(otherVeID)})
+	require.NoError(err)
+	require.ErrorIs(k.CheckVoterAuthorized(suite.ctx, otherVeID, voter), sdkerrors.ErrUnauthorized)
+	_, err = impl.RevokeVoter(ctx, &types.MsgRevokeVoter{Sender: owner.String(), Voter: operator.String()})
+	require.NoError(err)
+	require.ErrorIs(k.CheckVoterAuthorized(suite.ctx, otherVeID, operator), sdkerrors.ErrUnauthorized)
+	require.NoError(k.CheckVoterAuthorized(suite.ctx, otherVeID, owner))
+
+	// nonexistent ve
+	require.ErrorIs(k.CheckVoterAuthorized(suite.ctx, 10000, owner), sdkerrors.ErrUnauthorized)
+	_, err = k.VoterApproval(ctx, &types.QueryVoterApprovalRequest{Id: "ve-10000"})
+	require.ErrorIs(err, types.ErrInvalidVeID)
+}
@@ -21,6 +21,15 @@ const (
 	// Regulated period for ve locking time
 	RegulatedPeriod = blackfury.SecondsPerWeek
 
+	// Default maximum number of weekly checkpoints written per block when catching up after a chain halt
+	DefaultCheckpointCatchUpWeeks = 52
+
+	// Maximum number of obsolete history keys deleted per block
+	MaxPruneDeletionsPerBlock = 100
+
+	// Maximum number of auto-compounding ve claimed per block
+	MaxAutoCompoundClaimsPerBlock = 100
+
 	EmptyEpoch = 0
 	FirstEpoch = 1
 )
@@ -16,4 +16,5 @@ var (
 	ErrAmountNotPositive    = sdkerrors.Register(ModuleName, 9, "amount must be positive")
 	ErrSameVeID             = sdkerrors.Register(ModuleName, 10, "from ve id and to ve id must be different")
 	ErrVeAttached           = sdkerrors.Register(ModuleName, 11, "ve owner deposited into gauge or ve voted")
+	ErrInvalidVeOwner       = sdkerrors.Register(ModuleName, 12, "invalid ve owner")
 )
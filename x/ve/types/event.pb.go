@@ -332,44 +332,294 @@ func (m *EventWithdraw) GetVeId() string {
 	return ""
 }
 
+type EventApproveVoter struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	VeId   string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	Voter  string `protobuf:"bytes,3,opt,name=voter,proto3" json:"voter,omitempty"`
+}
+
+func (m *EventApproveVoter) Reset()         { *m = EventApproveVoter{} }
+func (m *EventApproveVoter) String() string { return proto.CompactTextString(m) }
+func (*EventApproveVoter) ProtoMessage()    {}
+func (*EventApproveVoter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0760ebfbe620b84a, []int{5}
+}
+func (m *EventApproveVoter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventApproveVoter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventApproveVoter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventApproveVoter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventApproveVoter.Merge(m, src)
+}
+func (m *EventApproveVoter) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventApproveVoter) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventApproveVoter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventApproveVoter proto.InternalMessageInfo
+
+func (m *EventApproveVoter) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventApproveVoter) GetVeId() string {
+	if m != nil {
+		return m.VeId
+	}
+	return ""
+}
+
+func (m *EventApproveVoter) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+type EventRevokeVoter struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	VeId   string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	Voter  string `protobuf:"bytes,3,opt,name=voter,proto3" json:"voter,omitempty"`
+}
+
+func (m *EventRevokeVoter) Reset()         { *m = EventRevokeVoter{} }
+func (m *EventRevokeVoter) String() string { return proto.CompactTextString(m) }
+func (*EventRevokeVoter) ProtoMessage()    {}
+func (*EventRevokeVoter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0760ebfbe620b84a, []int{6}
+}
+func (m *EventRevokeVoter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRevokeVoter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRevokeVoter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRevokeVoter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRevokeVoter.Merge(m, src)
+}
+func (m *EventRevokeVoter) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRevokeVoter) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRevokeVoter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRevokeVoter proto.InternalMessageInfo
+
+func (m *EventRevokeVoter) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventRevokeVoter) GetVeId() string {
+	if m != nil {
+		return m.VeId
+	}
+	return ""
+}
+
+func (m *EventRevokeVoter) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+type EventSetAutoCompound struct {
+	Sender          string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	VeId            string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	Enabled         bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CompoundRewards bool   `protobuf:"varint,4,opt,name=compound_rewards,json=compoundRewards,proto3" json:"compound_rewards,omitempty"`
+}
+
+func (m *EventSetAutoCompound) Reset()         { *m = EventSetAutoCompound{} }
+func (m *EventSetAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*EventSetAutoCompound) ProtoMessage()    {}
+func (*EventSetAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0760ebfbe620b84a, []int{7}
+}
+func (m *EventSetAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSetAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetAutoCompound.Merge(m, src)
+}
+func (m *EventSetAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetAutoCompound proto.InternalMessageInfo
+
+func (m *EventSetAutoCompound) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventSetAutoCompound) GetVeId() string {
+	if m != nil {
+		return m.VeId
+	}
+	return ""
+}
+
+func (m *EventSetAutoCompound) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *EventSetAutoCompound) GetCompoundRewards() bool {
+	if m != nil {
+		return m.CompoundRewards
+	}
+	return false
+}
+
+type EventAutoCompound struct {
+	VeId   string     `protobuf:"bytes,1,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *EventAutoCompound) Reset()         { *m = EventAutoCompound{} }
+func (m *EventAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*EventAutoCompound) ProtoMessage()    {}
+func (*EventAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0760ebfbe620b84a, []int{8}
+}
+func (m *EventAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAutoCompound.Merge(m, src)
+}
+func (m *EventAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAutoCompound proto.InternalMessageInfo
+
+func (m *EventAutoCompound) GetVeId() string {
+	if m != nil {
+		return m.VeId
+	}
+	return ""
+}
+
+func (m *EventAutoCompound) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*EventCreate)(nil), "blackfury.ve.v1.EventCreate")
 	proto.RegisterType((*EventDeposit)(nil), "blackfury.ve.v1.EventDeposit")
 	proto.RegisterType((*EventExtendTime)(nil), "blackfury.ve.v1.EventExtendTime")
 	proto.RegisterType((*EventMerge)(nil), "blackfury.ve.v1.EventMerge")
 	proto.RegisterType((*EventWithdraw)(nil), "blackfury.ve.v1.EventWithdraw")
+	proto.RegisterType((*EventApproveVoter)(nil), "blackfury.ve.v1.EventApproveVoter")
+	proto.RegisterType((*EventRevokeVoter)(nil), "blackfury.ve.v1.EventRevokeVoter")
+	proto.RegisterType((*EventSetAutoCompound)(nil), "blackfury.ve.v1.EventSetAutoCompound")
+	proto.RegisterType((*EventAutoCompound)(nil), "blackfury.ve.v1.EventAutoCompound")
 }
 
 func init() { proto.RegisterFile("blackfury/ve/v1/event.proto", fileDescriptor_0760ebfbe620b84a) }
 
 var fileDescriptor_0760ebfbe620b84a = []byte{
-	// 401 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x8a, 0x13, 0x41,
-	0x10, 0xc6, 0x33, 0x9b, 0x6c, 0x88, 0x15, 0x65, 0x61, 0x14, 0x19, 0xa3, 0xcc, 0x86, 0x9c, 0x72,
-	0xea, 0x26, 0x7a, 0xf0, 0xe2, 0x69, 0xd7, 0x05, 0x3d, 0x78, 0x09, 0xa2, 0x20, 0xc2, 0x30, 0x7f,
-	0x6a, 0xb3, 0xcd, 0x66, 0xba, 0x42, 0x77, 0x4d, 0xbb, 0x79, 0x0b, 0x1f, 0xc5, 0xc7, 0xd8, 0xe3,
-	0x1e, 0x3d, 0x89, 0x24, 0x2f, 0x22, 0xdd, 0x09, 0x71, 0xd1, 0xdd, 0x43, 0x6e, 0x5d, 0xf5, 0x15,
-	0xdf, 0xf7, 0x2b, 0xba, 0xe0, 0x79, 0x31, 0xcf, 0xcb, 0xcb, 0xf3, 0xc6, 0x2c, 0xa5, 0x43, 0xe9,
-	0x26, 0x12, 0x1d, 0x6a, 0x16, 0x0b, 0x43, 0x4c, 0xf1, 0xd1, 0x4e, 0x14, 0x0e, 0x85, 0x9b, 0x0c,
-	0x9e, 0xcc, 0x68, 0x46, 0x41, 0x93, 0xfe, 0xb5, 0x19, 0x1b, 0xa4, 0x25, 0xd9, 0x9a, 0xac, 0x2c,
-	0x72, 0xeb, 0x2d, 0x0a, 0xe4, 0x7c, 0x22, 0x4b, 0x52, 0x7a, 0xa3, 0x8f, 0x7e, 0x44, 0xd0, 0x3f,
-	0xf3, 0xb6, 0xa7, 0x06, 0x73, 0xc6, 0xf8, 0x29, 0x74, 0x2d, 0xea, 0x0a, 0x4d, 0x12, 0x0d, 0xa3,
-	0xf1, 0x83, 0xe9, 0xb6, 0x8a, 0x07, 0xd0, 0x33, 0x58, 0xa2, 0x72, 0x68, 0x92, 0x83, 0xa0, 0xec,
-	0xea, 0xf8, 0x31, 0x1c, 0x3a, 0xcc, 0x54, 0x95, 0xb4, 0x83, 0xd0, 0x71, 0xf8, 0xbe, 0x8a, 0x5f,
-	0x43, 0x37, 0xaf, 0xa9, 0xd1, 0x9c, 0x74, 0x86, 0xd1, 0xb8, 0xff, 0xf2, 0x99, 0xd8, 0x90, 0x08,
-	0x4f, 0x22, 0xb6, 0x24, 0xe2, 0x94, 0x94, 0x3e, 0xe9, 0x5c, 0xff, 0x3a, 0x6e, 0x4d, 0xb7, 0xe3,
-	0xf1, 0x31, 0xf4, 0x1b, 0x3d, 0xa7, 0xf2, 0x32, 0x63, 0x55, 0x63, 0x72, 0x38, 0x8c, 0xc6, 0x9d,
-	0x29, 0x6c, 0x5a, 0x1f, 0x55, 0x8d, 0x23, 0x86, 0x87, 0x81, 0xf8, 0x2d, 0x2e, 0xc8, 0x2a, 0xbe,
-	0x17, 0x79, 0x87, 0x75, 0x70, 0x27, 0x56, 0x7b, 0x2f, 0xac, 0x51, 0x06, 0x47, 0x21, 0xf5, 0xec,
-	0x8a, 0x51, 0x57, 0x1e, 0x64, 0xbf, 0xe0, 0x7f, 0xd6, 0x6a, 0xff, 0xb7, 0xd6, 0x57, 0x80, 0x10,
-	0xf0, 0x01, 0xcd, 0xec, 0x7e, 0xef, 0x17, 0x00, 0xe7, 0x86, 0xea, 0xec, 0x76, 0x40, 0xcf, 0x77,
-	0x3e, 0xf9, 0x90, 0x04, 0x7a, 0x4c, 0xd9, 0xed, 0xcf, 0xe8, 0x32, 0x79, 0x65, 0xf4, 0x06, 0x1e,
-	0x05, 0xf7, 0xcf, 0x8a, 0x2f, 0x2a, 0x93, 0x7f, 0xdb, 0x0b, 0xfe, 0xe4, 0xdd, 0xf5, 0x2a, 0x8d,
-	0x6e, 0x56, 0x69, 0xf4, 0x7b, 0x95, 0x46, 0xdf, 0xd7, 0x69, 0xeb, 0x66, 0x9d, 0xb6, 0x7e, 0xae,
-	0xd3, 0xd6, 0x17, 0x31, 0x53, 0x7c, 0xd1, 0x14, 0xa2, 0xa4, 0x5a, 0xe2, 0x7c, 0x69, 0x55, 0x53,
-	0x5b, 0xce, 0x59, 0x91, 0x96, 0x7f, 0xaf, 0xf7, 0xca, 0xdf, 0x2f, 0x2f, 0x17, 0x68, 0x8b, 0x6e,
-	0x38, 0xbb, 0x57, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x6a, 0x3e, 0x65, 0x42, 0xdc, 0x02, 0x00,
-	0x00,
+	// 505 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xe3, 0xe6, 0x0f, 0x61, 0x02, 0x4a, 0x31, 0x11, 0x32, 0x01, 0xb9, 0x91, 0x4f, 0xe1,
+	0x62, 0x2b, 0x70, 0xe0, 0xc2, 0xa5, 0x0d, 0x95, 0xe0, 0xc0, 0xc5, 0x40, 0x90, 0x10, 0x92, 0xe5,
+	0x3f, 0xd3, 0x74, 0x95, 0xd8, 0x63, 0xad, 0xd7, 0xdb, 0xe6, 0x0d, 0x38, 0xf2, 0x28, 0x3c, 0x46,
+	0x8f, 0x3d, 0x72, 0x42, 0x28, 0x79, 0x11, 0xe4, 0xb5, 0x13, 0x22, 0x68, 0x0f, 0x96, 0xb8, 0x79,
+	0xe6, 0xdb, 0xfd, 0xbe, 0xdf, 0xae, 0x57, 0x03, 0x4f, 0x82, 0xa5, 0x1f, 0x2e, 0xce, 0x72, 0xbe,
+	0x72, 0x24, 0x3a, 0x72, 0xe2, 0xa0, 0xc4, 0x44, 0xd8, 0x29, 0x27, 0x41, 0x7a, 0x7f, 0x27, 0xda,
+	0x12, 0x6d, 0x39, 0x19, 0x0e, 0xe6, 0x34, 0x27, 0xa5, 0x39, 0xc5, 0x57, 0xb9, 0x6c, 0x68, 0x86,
+	0x94, 0xc5, 0x94, 0x39, 0x81, 0x9f, 0x15, 0x16, 0x01, 0x0a, 0x7f, 0xe2, 0x84, 0xc4, 0x92, 0x52,
+	0xb7, 0xbe, 0x6b, 0xd0, 0x3b, 0x2d, 0x6c, 0xa7, 0x1c, 0x7d, 0x81, 0xfa, 0x23, 0xe8, 0x64, 0x98,
+	0x44, 0xc8, 0x0d, 0x6d, 0xa4, 0x8d, 0xef, 0xba, 0x55, 0xa5, 0x0f, 0xa1, 0xcb, 0x31, 0x44, 0x26,
+	0x91, 0x1b, 0x07, 0x4a, 0xd9, 0xd5, 0xfa, 0x43, 0x68, 0x4b, 0xf4, 0x58, 0x64, 0x34, 0x95, 0xd0,
+	0x92, 0xf8, 0x36, 0xd2, 0x5f, 0x42, 0xc7, 0x8f, 0x29, 0x4f, 0x84, 0xd1, 0x1a, 0x69, 0xe3, 0xde,
+	0xf3, 0xc7, 0x76, 0x49, 0x62, 0x17, 0x24, 0x76, 0x45, 0x62, 0x4f, 0x89, 0x25, 0x27, 0xad, 0xab,
+	0x9f, 0x47, 0x0d, 0xb7, 0x5a, 0xae, 0x1f, 0x41, 0x2f, 0x4f, 0x96, 0x14, 0x2e, 0x3c, 0xc1, 0x62,
+	0x34, 0xda, 0x23, 0x6d, 0xdc, 0x72, 0xa1, 0x6c, 0x7d, 0x60, 0x31, 0x5a, 0x02, 0xee, 0x29, 0xe2,
+	0xd7, 0x98, 0x52, 0xc6, 0xc4, 0xad, 0xc8, 0x3b, 0xac, 0x83, 0x1b, 0xb1, 0x9a, 0xb5, 0xb0, 0x2c,
+	0x0f, 0xfa, 0x2a, 0xf5, 0xf4, 0x52, 0x60, 0x12, 0x15, 0x20, 0xf5, 0x82, 0xff, 0x3a, 0x56, 0xf3,
+	0x9f, 0x63, 0x7d, 0x01, 0x50, 0x01, 0xef, 0x90, 0xcf, 0x6f, 0xf7, 0x7e, 0x0a, 0x70, 0xc6, 0x29,
+	0xf6, 0xf6, 0x03, 0xba, 0x45, 0x67, 0x56, 0x84, 0x18, 0xd0, 0x15, 0xe4, 0xed, 0xff, 0x8c, 0x8e,
+	0xa0, 0x42, 0xb1, 0x5e, 0xc1, 0x7d, 0xe5, 0xfe, 0x89, 0x89, 0xf3, 0x88, 0xfb, 0x17, 0xb5, 0xe0,
+	0xad, 0x19, 0x3c, 0x50, 0xbb, 0x8f, 0xd3, 0x94, 0x93, 0xc4, 0x19, 0x09, 0xe4, 0xf5, 0x8e, 0x3f,
+	0x80, 0xb6, 0x2c, 0x76, 0x55, 0x58, 0x65, 0x61, 0x7d, 0x84, 0x43, 0xe5, 0xeb, 0xa2, 0xa4, 0xc5,
+	0xff, 0xb3, 0xfd, 0xaa, 0xc1, 0x40, 0xf9, 0xbe, 0x47, 0x71, 0x9c, 0x0b, 0x9a, 0x52, 0x9c, 0x52,
+	0x9e, 0x44, 0xf5, 0xbc, 0x0d, 0xb8, 0x83, 0x89, 0x1f, 0x2c, 0xb1, 0xbc, 0xcb, 0xae, 0xbb, 0x2d,
+	0xf5, 0x67, 0x70, 0x18, 0x56, 0x96, 0x1e, 0xc7, 0x0b, 0x9f, 0x47, 0x99, 0x7a, 0xe5, 0x5d, 0xb7,
+	0xbf, 0xed, 0xbb, 0x65, 0xdb, 0xf2, 0xb7, 0x37, 0xb7, 0x8f, 0xb1, 0x8b, 0xd3, 0x6e, 0x7c, 0x99,
+	0x07, 0xb5, 0x5e, 0xe6, 0xc9, 0x9b, 0xab, 0xb5, 0xa9, 0x5d, 0xaf, 0x4d, 0xed, 0xd7, 0xda, 0xd4,
+	0xbe, 0x6d, 0xcc, 0xc6, 0xf5, 0xc6, 0x6c, 0xfc, 0xd8, 0x98, 0x8d, 0xcf, 0xf6, 0x9c, 0x89, 0xf3,
+	0x3c, 0xb0, 0x43, 0x8a, 0x1d, 0x5c, 0xae, 0x32, 0x96, 0xc7, 0x99, 0xf0, 0x05, 0xa3, 0xc4, 0xf9,
+	0x33, 0x5a, 0x2e, 0x8b, 0xe1, 0x22, 0x56, 0x29, 0x66, 0x41, 0x47, 0xcd, 0x84, 0x17, 0xbf, 0x07,
+	0x00, 0x99, 0x83, 0xdc, 0x68, 0x79, 0x04, 0x00, 0x00,
 }
 
 func (m *EventCreate) Marshal() (dAtA []byte, err error) {
@@ -601,75 +851,260 @@ func (m *EventWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventApproveVoter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventCreate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventApproveVoter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventApproveVoter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.VeId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	if m.UnlockTime != 0 {
-		n += 1 + sovEvent(uint64(m.UnlockTime))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDeposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.VeId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+func (m *EventRevokeVoter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventExtendTime) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventRevokeVoter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRevokeVoter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.VeId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompoundRewards {
+		i--
+		if m.CompoundRewards {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventCreate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.UnlockTime != 0 {
+		n += 1 + sovEvent(uint64(m.UnlockTime))
+	}
+	return n
+}
+
+func (m *EventDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventExtendTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
 	}
 	if m.UnlockTime != 0 {
 		n += 1 + sovEvent(uint64(m.UnlockTime))
@@ -695,33 +1130,591 @@ func (m *EventMerge) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *EventWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventApproveVoter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRevokeVoter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSetAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.CompoundRewards {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventCreate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			m.UnlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventExtendTime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventExtendTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventExtendTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			m.UnlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *EventWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.VeId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventCreate) Unmarshal(dAtA []byte) error {
+func (m *EventMerge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -744,10 +1737,10 @@ func (m *EventCreate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMerge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMerge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -784,7 +1777,7 @@ func (m *EventCreate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -812,11 +1805,11 @@ func (m *EventCreate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.FromVeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -844,13 +1837,63 @@ func (m *EventCreate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VeId = string(dAtA[iNdEx:postIndex])
+			m.ToVeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWithdraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -860,30 +1903,29 @@ func (m *EventCreate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
 			}
-			m.UnlockTime = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -893,11 +1935,24 @@ func (m *EventCreate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnlockTime |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -919,7 +1974,7 @@ func (m *EventCreate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDeposit) Unmarshal(dAtA []byte) error {
+func (m *EventApproveVoter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -942,10 +1997,10 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventApproveVoter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventApproveVoter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1014,9 +2069,9 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1026,24 +2081,23 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1066,7 +2120,7 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventExtendTime) Unmarshal(dAtA []byte) error {
+func (m *EventRevokeVoter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1089,10 +2143,10 @@ func (m *EventExtendTime) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventExtendTime: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRevokeVoter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventExtendTime: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRevokeVoter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1160,10 +2214,10 @@ func (m *EventExtendTime) Unmarshal(dAtA []byte) error {
 			m.VeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
-			m.UnlockTime = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1173,11 +2227,24 @@ func (m *EventExtendTime) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnlockTime |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -1199,7 +2266,7 @@ func (m *EventExtendTime) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMerge) Unmarshal(dAtA []byte) error {
+func (m *EventSetAutoCompound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1222,10 +2289,10 @@ func (m *EventMerge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMerge: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetAutoCompound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMerge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1262,7 +2329,7 @@ func (m *EventMerge) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromVeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1290,13 +2357,13 @@ func (m *EventMerge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromVeId = string(dAtA[iNdEx:postIndex])
+			m.VeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToVeId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1306,24 +2373,32 @@ func (m *EventMerge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
+			m.Enabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompoundRewards", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ToVeId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.CompoundRewards = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -1345,7 +2420,7 @@ func (m *EventMerge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
+func (m *EventAutoCompound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1368,15 +2443,15 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAutoCompound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1404,13 +2479,13 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.VeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1420,23 +2495,24 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VeId = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
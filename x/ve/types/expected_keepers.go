@@ -38,7 +38,14 @@ type NftKeeper interface {
 	GetNFTsOfClassByOwner(ctx sdk.Context, classID string, owner sdk.AccAddress) (nfts []nft.NFT)
 	GetOwner(ctx sdk.Context, classID string, nftID string) sdk.AccAddress
 	HasNFT(ctx sdk.Context, classID, id string) bool
+	GetNFT(ctx sdk.Context, classID, nftID string) (nft.NFT, bool)
+	Update(ctx sdk.Context, token nft.NFT) error
 	NFTs(goCtx context.Context, r *nft.QueryNFTsRequest) (*nft.QueryNFTsResponse, error)
 	NFT(goCtx context.Context, r *nft.QueryNFTRequest) (*nft.QueryNFTResponse, error)
 	// Methods imported from nft should be defined here
 }
+
+// Erc721Keeper defines the expected interface needed to mirror ve NFTs into the EVM.
+type Erc721Keeper interface {
+	SyncVeNftOwner(ctx sdk.Context, veID uint64, owner sdk.AccAddress) error
+}
@@ -71,6 +71,12 @@ func (m *GenesisState) GetParams() Params {
 // Params defines the parameters for the module.
 type Params struct {
 	LockDenom string `protobuf:"bytes,1,opt,name=lock_denom,json=lockDenom,proto3" json:"lock_denom,omitempty"`
+	// retention period in seconds of user checkpoints available to historical
+	// queries, zero to keep all history
+	HistoryRetention uint64 `protobuf:"varint,2,opt,name=history_retention,json=historyRetention,proto3" json:"history_retention,omitempty"`
+	// max number of weekly checkpoints written per block when catching up after
+	// a chain halt
+	CheckpointCatchUpWeeks uint64 `protobuf:"varint,3,opt,name=checkpoint_catch_up_weeks,json=checkpointCatchUpWeeks,proto3" json:"checkpoint_catch_up_weeks,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -112,6 +118,20 @@ func (m *Params) GetLockDenom() string {
 	return ""
 }
 
+func (m *Params) GetHistoryRetention() uint64 {
+	if m != nil {
+		return m.HistoryRetention
+	}
+	return 0
+}
+
+func (m *Params) GetCheckpointCatchUpWeeks() uint64 {
+	if m != nil {
+		return m.CheckpointCatchUpWeeks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "blackfury.ve.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "blackfury.ve.v1.Params")
@@ -120,22 +140,26 @@ func init() {
 func init() { proto.RegisterFile("blackfury/ve/v1/genesis.proto", fileDescriptor_83239277854d7a4e) }
 
 var fileDescriptor_83239277854d7a4e = []byte{
-	// 231 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0xca, 0x49, 0x4c,
-	0xce, 0x4e, 0x2b, 0x2d, 0xaa, 0xd4, 0x2f, 0x4b, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b,
-	0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x87, 0x4b, 0xeb, 0x95, 0xa5,
-	0xea, 0x95, 0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0xe5, 0xf4, 0x41, 0x2c, 0x88, 0x32,
-	0x25, 0x57, 0x2e, 0x1e, 0x77, 0x88, 0xbe, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x53, 0x2e, 0xb6,
-	0x82, 0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x71, 0x3d, 0x34,
-	0x73, 0xf4, 0x02, 0xc0, 0xd2, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x15, 0x2b, 0xe9,
-	0x72, 0xb1, 0x41, 0xc4, 0x85, 0x64, 0xb9, 0xb8, 0x72, 0xf2, 0x93, 0xb3, 0xe3, 0x53, 0x52, 0xf3,
-	0xf2, 0x73, 0xc1, 0x86, 0x70, 0x06, 0x71, 0x82, 0x44, 0x5c, 0x40, 0x02, 0x56, 0x2c, 0x33, 0x16,
-	0xc8, 0x33, 0x38, 0x79, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72,
-	0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x5e,
-	0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x6a, 0x4e, 0x65, 0x71, 0x66,
-	0x69, 0x6e, 0x71, 0x49, 0x62, 0x49, 0x66, 0x7e, 0x9e, 0x3e, 0xc2, 0xbf, 0x15, 0x20, 0x1f, 0x97,
-	0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xbd, 0x61, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x54,
-	0x75, 0xb0, 0x76, 0x0e, 0x01, 0x00, 0x00,
+	// 304 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xc1, 0x4a, 0xf3, 0x40,
+	0x14, 0x85, 0x33, 0xff, 0x5f, 0x0a, 0x1d, 0x05, 0x35, 0x88, 0x56, 0xa1, 0x69, 0xe9, 0xaa, 0x20,
+	0xcc, 0x50, 0xc5, 0x85, 0x2e, 0xab, 0xa2, 0x4b, 0x89, 0x88, 0xe0, 0x26, 0x4c, 0xc7, 0x6b, 0x32,
+	0xa4, 0xc9, 0x0c, 0x99, 0x49, 0x34, 0x6f, 0xe1, 0x46, 0x70, 0xe9, 0xe3, 0x74, 0xd9, 0xa5, 0x2b,
+	0x91, 0xf6, 0x45, 0x64, 0x92, 0x6a, 0xc1, 0xdd, 0xe5, 0x7c, 0xe7, 0x9c, 0x0b, 0x07, 0x77, 0xc6,
+	0x13, 0xc6, 0xe3, 0xc7, 0x3c, 0x2b, 0x69, 0x01, 0xb4, 0x18, 0xd2, 0x10, 0x52, 0xd0, 0x42, 0x13,
+	0x95, 0x49, 0x23, 0xdd, 0x8d, 0x5f, 0x4c, 0x0a, 0x20, 0xc5, 0x70, 0x7f, 0x3b, 0x94, 0xa1, 0xac,
+	0x18, 0xb5, 0x57, 0x6d, 0xeb, 0x5f, 0xe0, 0xf5, 0xcb, 0x3a, 0x77, 0x63, 0x98, 0x01, 0xf7, 0x18,
+	0x37, 0x15, 0xcb, 0x58, 0xa2, 0xdb, 0xa8, 0x87, 0x06, 0x6b, 0x87, 0xbb, 0xe4, 0x4f, 0x0f, 0xb9,
+	0xae, 0xf0, 0xa8, 0x31, 0xfd, 0xec, 0x3a, 0xfe, 0xd2, 0xdc, 0x7f, 0x45, 0xb8, 0x59, 0x03, 0xb7,
+	0x83, 0xf1, 0x44, 0xf2, 0x38, 0x78, 0x80, 0x54, 0x26, 0x55, 0x4b, 0xcb, 0x6f, 0x59, 0xe5, 0xdc,
+	0x0a, 0xee, 0x01, 0xde, 0x8a, 0x84, 0x36, 0x32, 0x2b, 0x83, 0x0c, 0x0c, 0xa4, 0x46, 0xc8, 0xb4,
+	0xfd, 0xaf, 0x87, 0x06, 0x0d, 0x7f, 0x73, 0x09, 0xfc, 0x1f, 0xdd, 0x3d, 0xc1, 0x7b, 0x3c, 0x02,
+	0x1e, 0x2b, 0x29, 0x52, 0x13, 0x70, 0x66, 0x78, 0x14, 0xe4, 0x2a, 0x78, 0x02, 0x88, 0x75, 0xfb,
+	0x7f, 0x15, 0xda, 0x59, 0x19, 0xce, 0x2c, 0xbf, 0x55, 0x77, 0x96, 0x9e, 0x36, 0xde, 0xde, 0xbb,
+	0xce, 0xe8, 0x6a, 0x3a, 0xf7, 0xd0, 0x6c, 0xee, 0xa1, 0xaf, 0xb9, 0x87, 0x5e, 0x16, 0x9e, 0x33,
+	0x5b, 0x78, 0xce, 0xc7, 0xc2, 0x73, 0xee, 0x49, 0x28, 0x4c, 0x94, 0x8f, 0x09, 0x97, 0x09, 0x85,
+	0x49, 0xa9, 0x45, 0x9e, 0x68, 0xc3, 0xec, 0x57, 0xba, 0x1a, 0xf6, 0xd9, 0x4e, 0x6b, 0x4a, 0x05,
+	0x7a, 0xdc, 0xac, 0xf6, 0x3a, 0xfa, 0x1e, 0x00, 0x31, 0xee, 0xc6, 0xda, 0x77, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -191,6 +215,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CheckpointCatchUpWeeks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CheckpointCatchUpWeeks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HistoryRetention != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.HistoryRetention))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.LockDenom) > 0 {
 		i -= len(m.LockDenom)
 		copy(dAtA[i:], m.LockDenom)
@@ -233,6 +267,12 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.HistoryRetention != 0 {
+		n += 1 + sovGenesis(uint64(m.HistoryRetention))
+	}
+	if m.CheckpointCatchUpWeeks != 0 {
+		n += 1 + sovGenesis(uint64(m.CheckpointCatchUpWeeks))
+	}
 	return n
 }
 
@@ -386,6 +426,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.LockDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryRetention", wireType)
+			}
+			m.HistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointCatchUpWeeks", wireType)
+			}
+			m.CheckpointCatchUpWeeks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointCatchUpWeeks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,9 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const (
 	// ModuleName defines the module name
@@ -43,6 +46,15 @@ const (
 	prefixDistributionTotalAmount
 	prefixDistributionPerPeriod
 	prefixDistributionClaimLastTimestampByUser
+
+	prefixUserPointPruneCursor
+
+	prefixApprovedVoter
+	prefixVoterOperator
+
+	prefixAutoCompound
+	prefixAutoCompoundClaimLastTimestamp
+	prefixAutoCompoundClaimCursor
 )
 
 var (
@@ -66,6 +78,15 @@ var (
 	KeyPrefixDistributionTotalAmount              = []byte{prefixDistributionTotalAmount}
 	KeyPrefixDistributionPerPeriod                = []byte{prefixDistributionPerPeriod}
 	KeyPrefixDistributionClaimLastTimestampByUser = []byte{prefixDistributionClaimLastTimestampByUser}
+
+	KeyPrefixUserPointPruneCursor = []byte{prefixUserPointPruneCursor}
+
+	KeyPrefixApprovedVoter = []byte{prefixApprovedVoter}
+	KeyPrefixVoterOperator = []byte{prefixVoterOperator}
+
+	KeyPrefixAutoCompound                   = []byte{prefixAutoCompound}
+	KeyPrefixAutoCompoundClaimLastTimestamp = []byte{prefixAutoCompoundClaimLastTimestamp}
+	KeyPrefixAutoCompoundClaimCursor        = []byte{prefixAutoCompoundClaimCursor}
 )
 
 func TotalLockedAmountKey() []byte {
@@ -135,3 +156,27 @@ func DistributionPerPeriodKey(timestamp uint64) []byte {
 func DistributionClaimLastTimestampByUserKey(veID uint64) []byte {
 	return append(KeyPrefixDistributionClaimLastTimestampByUser, sdk.Uint64ToBigEndian(veID)...)
 }
+
+func UserPointPruneCursorKey() []byte {
+	return KeyPrefixUserPointPruneCursor
+}
+
+func ApprovedVoterKey(veID uint64) []byte {
+	return append(KeyPrefixApprovedVoter, sdk.Uint64ToBigEndian(veID)...)
+}
+
+func VoterOperatorKey(owner sdk.AccAddress, operator sdk.AccAddress) []byte {
+	return append(append(KeyPrefixVoterOperator, address.MustLengthPrefix(owner)...), operator...)
+}
+
+func AutoCompoundKey(veID uint64) []byte {
+	return append(KeyPrefixAutoCompound, sdk.Uint64ToBigEndian(veID)...)
+}
+
+func AutoCompoundClaimLastTimestampKey() []byte {
+	return KeyPrefixAutoCompoundClaimLastTimestamp
+}
+
+func AutoCompoundClaimCursorKey() []byte {
+	return KeyPrefixAutoCompoundClaimCursor
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -91,3 +92,33 @@ func TestDistributionClaimLastTimestampByUserKey(t *testing.T) {
 	key := DistributionClaimLastTimestampByUserKey(uint64(10000))
 	require.Equal(t, "110000000000002710", hex.EncodeToString(key))
 }
+
+func TestUserPointPruneCursorKey(t *testing.T) {
+	key := UserPointPruneCursorKey()
+	require.Equal(t, "12", hex.EncodeToString(key))
+}
+
+func TestApprovedVoterKey(t *testing.T) {
+	key := ApprovedVoterKey(uint64(10000))
+	require.Equal(t, "130000000000002710", hex.EncodeToString(key))
+}
+
+func TestVoterOperatorKey(t *testing.T) {
+	key := VoterOperatorKey(sdk.AccAddress{0x01, 0x02}, sdk.AccAddress{0x03})
+	require.Equal(t, "1402010203", hex.EncodeToString(key))
+}
+
+func TestAutoCompoundKey(t *testing.T) {
+	key := AutoCompoundKey(uint64(10000))
+	require.Equal(t, "150000000000002710", hex.EncodeToString(key))
+}
+
+func TestAutoCompoundClaimLastTimestampKey(t *testing.T) {
+	key := AutoCompoundClaimLastTimestampKey()
+	require.Equal(t, "16", hex.EncodeToString(key))
+}
+
+func TestAutoCompoundClaimCursorKey(t *testing.T) {
+	key := AutoCompoundClaimCursorKey()
+	require.Equal(t, "17", hex.EncodeToString(key))
+}
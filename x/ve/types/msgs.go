@@ -11,6 +11,11 @@ const (
 	TypeMsgExtendTime = "extend_time"
 	TypeMsgMerge      = "merge"
 	TypeMsgWithdraw   = "withdraw"
+
+	TypeMsgApproveVoter = "approve_voter"
+	TypeMsgRevokeVoter  = "revoke_voter"
+
+	TypeMsgSetAutoCompound = "set_auto_compound"
 )
 
 var (
@@ -19,6 +24,11 @@ var (
 	_ sdk.Msg = &MsgExtendTime{}
 	_ sdk.Msg = &MsgMerge{}
 	_ sdk.Msg = &MsgWithdraw{}
+
+	_ sdk.Msg = &MsgApproveVoter{}
+	_ sdk.Msg = &MsgRevokeVoter{}
+
+	_ sdk.Msg = &MsgSetAutoCompound{}
 )
 
 // Route implements sdk.Msg
@@ -207,3 +217,115 @@ func (m *MsgWithdraw) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+// Route implements sdk.Msg
+func (m *MsgApproveVoter) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgApproveVoter) Type() string { return TypeMsgApproveVoter }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgApproveVoter) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgApproveVoter) ValidateBasic() error {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if len(m.VeId) > 0 && Uint64FromVeID(m.VeId) == EmptyVeID {
+		return ErrInvalidVeID
+	}
+	voter, err := sdk.AccAddressFromBech32(m.Voter)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid voter address (%s)", err)
+	}
+	if voter.Equals(sender) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot approve sender itself as voter")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgApproveVoter) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgRevokeVoter) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgRevokeVoter) Type() string { return TypeMsgRevokeVoter }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgRevokeVoter) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgRevokeVoter) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if (len(m.VeId) > 0) == (len(m.Voter) > 0) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "exactly one of ve id and voter must be specified")
+	}
+	if len(m.VeId) > 0 && Uint64FromVeID(m.VeId) == EmptyVeID {
+		return ErrInvalidVeID
+	}
+	if len(m.Voter) > 0 {
+		_, err = sdk.AccAddressFromBech32(m.Voter)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid voter address (%s)", err)
+		}
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgRevokeVoter) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgSetAutoCompound) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgSetAutoCompound) Type() string { return TypeMsgSetAutoCompound }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgSetAutoCompound) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgSetAutoCompound) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if Uint64FromVeID(m.VeId) == EmptyVeID {
+		return ErrInvalidVeID
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
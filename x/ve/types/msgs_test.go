@@ -334,3 +334,166 @@ func TestMsgWithdraw_GetSigners(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, sender, signers[0])
 }
+
+func TestMsgApproveVoter_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	for _, tc := range []struct {
+		desc   string
+		sender string
+		veId   string
+		voter  string
+		valid  bool
+	}{
+		{
+			desc:   "invalid sender address",
+			sender: "",
+		},
+		{
+			desc:   "invalid veId",
+			sender: "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+			veId:   "xxx",
+			voter:  "did:fury:black1353a4uac03etdylz86tyq9ssm3x2704jlshe67",
+		},
+		{
+			desc:   "invalid voter address",
+			sender: "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+			veId:   "ve-100",
+			voter:  "xxx",
+		},
+		{
+			desc:   "voter is sender",
+			sender: "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+			veId:   "ve-100",
+			voter:  "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+		},
+		{
+			desc:   "valid",
+			sender: "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+			veId:   "ve-100",
+			voter:  "did:fury:black1353a4uac03etdylz86tyq9ssm3x2704jlshe67",
+			valid:  true,
+		},
+		{
+			desc:   "valid operator",
+			sender: "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+			voter:  "did:fury:black1353a4uac03etdylz86tyq9ssm3x2704jlshe67",
+			valid:  true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := &types.MsgApproveVoter{
+				Sender: tc.sender,
+				VeId:   tc.veId,
+				Voter:  tc.voter,
+			}
+			err := msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgRevokeVoter_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	for _, tc := range []struct {
+		desc   string
+		sender string
+		veId   string
+		voter  string
+		valid  bool
+	}{
+		{
+			desc:   "invalid sender address",
+			sender: "",
+		},
+		{
+			desc:   "neither veId nor voter",
+			sender: "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+		},
+		{
+			desc:   "both veId and voter",
+			sender: "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+			veId:   "ve-100",
+			voter:  "did:fury:black1353a4uac03etdylz86tyq9ssm3x2704jlshe67",
+		},
+		{
+			desc:   "invalid veId",
+			sender: "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+			veId:   "xxx",
+		},
+		{
+			desc:   "invalid voter address",
+			sender: "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+			voter:  "xxx",
+		},
+		{
+			desc:   "valid",
+			sender: "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+			veId:   "ve-100",
+			valid:  true,
+		},
+		{
+			desc:   "valid operator",
+			sender: "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+			voter:  "did:fury:black1353a4uac03etdylz86tyq9ssm3x2704jlshe67",
+			valid:  true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := &types.MsgRevokeVoter{
+				Sender: tc.sender,
+				VeId:   tc.veId,
+				Voter:  tc.voter,
+			}
+			err := msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgSetAutoCompound_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	for _, tc := range []struct {
+		desc   string
+		sender string
+		veId   string
+		valid  bool
+	}{
+		{
+			desc:   "invalid sender address",
+			sender: "",
+		},
+		{
+			desc:   "invalid veId",
+			sender: "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+			veId:   "xxx",
+		},
+		{
+			desc:   "valid",
+			sender: "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+			veId:   "ve-100",
+			valid:  true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := &types.MsgSetAutoCompound{
+				Sender:  tc.sender,
+				VeId:    tc.veId,
+				Enabled: true,
+			}
+			err := msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
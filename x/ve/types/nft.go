@@ -56,3 +56,41 @@ func VeNftUri(nftID string, balance sdk.Int, lockedEnd uint64, value sdk.Int) st
 
 	return fmt.Sprintf("data:application/json;base64,%s", base64.URLEncoding.EncodeToString(uriStr))
 }
+
+// SVG renders the veNFT metadata as a deterministic SVG image
+func (m VeNftMetadata) SVG() string {
+	return fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" preserveAspectRatio="xMinYMin meet" viewBox="0 0 350 350"><style>.base { fill: white; font-family: serif; font-size: 14px; }</style><rect width="100%%" height="100%%" fill="black" /><text x="10" y="20" class="base">token %s</text><text x="10" y="40" class="base">locked %s</text><text x="10" y="60" class="base">locked_end %d</text><text x="10" y="80" class="base">voting_power %s</text><text x="10" y="100" class="base">delegated %s</text><text x="10" y="120" class="base">voted %t</text><text x="10" y="140" class="base">attached %d</text></svg>`,
+		m.Id, m.Locked.Amount, m.Locked.End, m.VotingPower, m.DelegatedAmount, m.Voted, m.Attached)
+}
+
+// JSON returns the veNFT metadata document following the ERC-721 metadata standard
+func (m VeNftMetadata) JSON() string {
+	type attribute struct {
+		TraitType   string      `json:"trait_type"`
+		DisplayType string      `json:"display_type,omitempty"`
+		Value       interface{} `json:"value"`
+	}
+	var doc struct {
+		Name        string      `json:"name"`
+		Description string      `json:"description"`
+		Image       string      `json:"image"`
+		Attributes  []attribute `json:"attributes"`
+	}
+	doc.Name = fmt.Sprintf("lock #%s", m.Id)
+	doc.Description = VeNftClass.Description
+	doc.Image = fmt.Sprintf("data:image/svg+xml;base64,%s", base64.StdEncoding.EncodeToString([]byte(m.SVG())))
+	doc.Attributes = []attribute{
+		{TraitType: "locked_amount", Value: m.Locked.Amount.String()},
+		{TraitType: "locked_end", DisplayType: "date", Value: m.Locked.End},
+		{TraitType: "voting_power", Value: m.VotingPower.String()},
+		{TraitType: "delegated_amount", Value: m.DelegatedAmount.String()},
+		{TraitType: "voted", Value: m.Voted},
+		{TraitType: "attached", Value: m.Attached},
+	}
+
+	bz, err := json.Marshal(&doc)
+	if err != nil {
+		panic(err)
+	}
+	return string(bz)
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -37,3 +39,37 @@ func TestVeNftUri(t *testing.T) {
 	)
 	require.Equal(t, "data:application/json;base64,eyJuYW1lIjoibG9jayAjMTAwMDAiLCJkZXNjcmlwdGlvbiI6IkJsYWNrZnVyeSBsb2NrcywgY2FuIGJlIHVzZWQgdG8gYm9vc3QgZ2F1Z2UgeWllbGRzLCB2b3RlIG9uIHRva2VuIGVtaXNzaW9uLCBhbmQgcmVjZWl2ZSBicmliZXMiLCJpbWFnZSI6ImRhdGE6aW1hZ2Uvc3ZnK3htbDtiYXNlNjQsUEhOMlp5QjRiV3h1Y3owaWFIUjBjRG92TDNkM2R5NTNNeTV2Y21jdk1qQXdNQzl6ZG1jaUlIQnlaWE5sY25abFFYTndaV04wVW1GMGFXODlJbmhOYVc1WlRXbHVJRzFsWlhRaUlIWnBaWGRDYjNnOUlqQWdNQ0F6TlRBZ016VXdJajQ4YzNSNWJHVS1MbUpoYzJVZ2V5Qm1hV3hzT2lCM2FHbDBaVHNnWm05dWRDMW1ZVzFwYkhrNklITmxjbWxtT3lCbWIyNTBMWE5wZW1VNklERTBjSGc3SUgwOEwzTjBlV3hsUGp4eVpXTjBJSGRwWkhSb1BTSXhNREFsSWlCb1pXbG5hSFE5SWpFd01DVWlJR1pwYkd3OUltSnNZV05ySWlBdlBqeDBaWGgwSUhnOUlqRXdJaUI1UFNJeU1DSWdZMnhoYzNNOUltSmhjMlVpUG5SdmEyVnVJREV3TURBd1BDOTBaWGgwUGp4MFpYaDBJSGc5SWpFd0lpQjVQU0kwTUNJZ1kyeGhjM005SW1KaGMyVWlQbUpoYkdGdVkyVlBaaUF4TURBd01Ed3ZkR1Y0ZEQ0OGRHVjRkQ0I0UFNJeE1DSWdlVDBpTmpBaUlHTnNZWE56UFNKaVlYTmxJajVzYjJOclpXUmZaVzVrSURFd01EQXdQQzkwWlhoMFBqeDBaWGgwSUhnOUlqRXdJaUI1UFNJNE1DSWdZMnhoYzNNOUltSmhjMlVpUG5aaGJIVmxJREV3TURBd1BDOTBaWGgwUGp3dmMzWm5QZz09In0=", uri)
 }
+
+func TestVeNftMetadata(t *testing.T) {
+	metadata := VeNftMetadata{
+		Id:              "ve-1",
+		Owner:           "owner",
+		Locked:          LockedBalance{Amount: sdk.NewInt(10000), End: 1700000000},
+		VotingPower:     sdk.NewInt(5000),
+		DelegatedAmount: sdk.NewInt(100),
+		Voted:           true,
+		Attached:        2,
+	}
+
+	svg := metadata.SVG()
+	require.Equal(t, svg, metadata.SVG())
+	require.Contains(t, svg, "token ve-1")
+	require.Contains(t, svg, "voting_power 5000")
+	require.Contains(t, svg, "voted true")
+
+	var doc struct {
+		Name       string `json:"name"`
+		Image      string `json:"image"`
+		Attributes []struct {
+			TraitType string      `json:"trait_type"`
+			Value     interface{} `json:"value"`
+		} `json:"attributes"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(metadata.JSON()), &doc))
+	require.Equal(t, "lock #ve-1", doc.Name)
+	require.Equal(t, "data:image/svg+xml;base64,"+base64.StdEncoding.EncodeToString([]byte(svg)), doc.Image)
+	require.Len(t, doc.Attributes, 6)
+	require.Equal(t, "locked_amount", doc.Attributes[0].TraitType)
+	require.Equal(t, "10000", doc.Attributes[0].Value)
+	require.Equal(t, true, doc.Attributes[4].Value)
+}
@@ -11,7 +11,9 @@ import (
 
 // Parameter keys
 var (
-	KeyLockDenom = []byte("LockDenom")
+	KeyLockDenom              = []byte("LockDenom")
+	KeyHistoryRetention       = []byte("HistoryRetention")
+	KeyCheckpointCatchUpWeeks = []byte("CheckpointCatchUpWeeks")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -24,7 +26,9 @@ func ParamKeyTable() paramtypes.KeyTable {
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
 	return Params{
-		LockDenom: blackfury.BaseDenom,
+		LockDenom:              blackfury.BaseDenom,
+		HistoryRetention:       MaxLockTime,
+		CheckpointCatchUpWeeks: DefaultCheckpointCatchUpWeeks,
 	}
 }
 
@@ -32,12 +36,20 @@ func DefaultParams() Params {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyLockDenom, &p.LockDenom, validateLockDenom),
+		paramtypes.NewParamSetPair(KeyHistoryRetention, &p.HistoryRetention, validateHistoryRetention),
+		paramtypes.NewParamSetPair(KeyCheckpointCatchUpWeeks, &p.CheckpointCatchUpWeeks, validateCheckpointCatchUpWeeks),
 	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	return sdk.ValidateDenom(p.LockDenom)
+	if err := sdk.ValidateDenom(p.LockDenom); err != nil {
+		return err
+	}
+	if err := validateHistoryRetention(p.HistoryRetention); err != nil {
+		return err
+	}
+	return validateCheckpointCatchUpWeeks(p.CheckpointCatchUpWeeks)
 }
 
 func validateLockDenom(i interface{}) error {
@@ -49,6 +61,32 @@ func validateLockDenom(i interface{}) error {
 	return sdk.ValidateDenom(v)
 }
 
+func validateHistoryRetention(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v != 0 && v < RegulatedPeriod {
+		return fmt.Errorf("history retention must be zero or at least %d seconds: %d", RegulatedPeriod, v)
+	}
+
+	return nil
+}
+
+func validateCheckpointCatchUpWeeks(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("checkpoint catch-up weeks must be positive: %d", v)
+	}
+
+	return nil
+}
+
 // String implements the Stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
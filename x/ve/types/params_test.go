@@ -10,4 +10,19 @@ import (
 func TestDefaultParams(t *testing.T) {
 	params := DefaultParams()
 	require.Equal(t, blackfury.BaseDenom, params.LockDenom)
+	require.Equal(t, uint64(MaxLockTime), params.HistoryRetention)
+	require.Equal(t, uint64(DefaultCheckpointCatchUpWeeks), params.CheckpointCatchUpWeeks)
+	require.NoError(t, params.Validate())
+}
+
+func TestParams_Validate(t *testing.T) {
+	params := DefaultParams()
+	params.HistoryRetention = 0
+	require.NoError(t, params.Validate())
+	params.HistoryRetention = RegulatedPeriod - 1
+	require.Error(t, params.Validate())
+	params.HistoryRetention = RegulatedPeriod
+	require.NoError(t, params.Validate())
+	params.CheckpointCatchUpWeeks = 0
+	require.Error(t, params.Validate())
 }
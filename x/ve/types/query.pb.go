@@ -414,6 +414,328 @@ func (m *QueryVeNftResponse) GetNft() *nft.NFT {
 	return nil
 }
 
+// QueryVeNftMetadataRequest is the request type for the Query/VeNftMetadata
+// RPC method
+type QueryVeNftMetadataRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryVeNftMetadataRequest) Reset()         { *m = QueryVeNftMetadataRequest{} }
+func (m *QueryVeNftMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVeNftMetadataRequest) ProtoMessage()    {}
+func (*QueryVeNftMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da2757da80f42589, []int{8}
+}
+func (m *QueryVeNftMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeNftMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeNftMetadataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeNftMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeNftMetadataRequest.Merge(m, src)
+}
+func (m *QueryVeNftMetadataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeNftMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeNftMetadataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeNftMetadataRequest proto.InternalMessageInfo
+
+func (m *QueryVeNftMetadataRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// QueryVeNftMetadataResponse is the response type for the Query/VeNftMetadata
+// RPC method
+type QueryVeNftMetadataResponse struct {
+	Metadata VeNftMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata"`
+	// metadata JSON document following the ERC-721 metadata standard
+	Json string `protobuf:"bytes,2,opt,name=json,proto3" json:"json,omitempty"`
+	// deterministic SVG rendering
+	Svg string `protobuf:"bytes,3,opt,name=svg,proto3" json:"svg,omitempty"`
+}
+
+func (m *QueryVeNftMetadataResponse) Reset()         { *m = QueryVeNftMetadataResponse{} }
+func (m *QueryVeNftMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVeNftMetadataResponse) ProtoMessage()    {}
+func (*QueryVeNftMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da2757da80f42589, []int{9}
+}
+func (m *QueryVeNftMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeNftMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeNftMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeNftMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeNftMetadataResponse.Merge(m, src)
+}
+func (m *QueryVeNftMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeNftMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeNftMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeNftMetadataResponse proto.InternalMessageInfo
+
+func (m *QueryVeNftMetadataResponse) GetMetadata() VeNftMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return VeNftMetadata{}
+}
+
+func (m *QueryVeNftMetadataResponse) GetJson() string {
+	if m != nil {
+		return m.Json
+	}
+	return ""
+}
+
+func (m *QueryVeNftMetadataResponse) GetSvg() string {
+	if m != nil {
+		return m.Svg
+	}
+	return ""
+}
+
+// QueryVoterApprovalRequest is the request type for the Query/VoterApproval
+// RPC method
+type QueryVoterApprovalRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// optional address to check the authorization for
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+}
+
+func (m *QueryVoterApprovalRequest) Reset()         { *m = QueryVoterApprovalRequest{} }
+func (m *QueryVoterApprovalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoterApprovalRequest) ProtoMessage()    {}
+func (*QueryVoterApprovalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da2757da80f42589, []int{10}
+}
+func (m *QueryVoterApprovalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoterApprovalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoterApprovalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoterApprovalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoterApprovalRequest.Merge(m, src)
+}
+func (m *QueryVoterApprovalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoterApprovalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoterApprovalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoterApprovalRequest proto.InternalMessageInfo
+
+func (m *QueryVoterApprovalRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *QueryVoterApprovalRequest) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+// QueryVoterApprovalResponse is the response type for the Query/VoterApproval
+// RPC method
+type QueryVoterApprovalResponse struct {
+	// approved voter of the veNFT, empty if none
+	ApprovedVoter string `protobuf:"bytes,1,opt,name=approved_voter,json=approvedVoter,proto3" json:"approved_voter,omitempty"`
+	// whether the queried address is the owner, the approved voter or an
+	// operator of the owner
+	Authorized bool `protobuf:"varint,2,opt,name=authorized,proto3" json:"authorized,omitempty"`
+}
+
+func (m *QueryVoterApprovalResponse) Reset()         { *m = QueryVoterApprovalResponse{} }
+func (m *QueryVoterApprovalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoterApprovalResponse) ProtoMessage()    {}
+func (*QueryVoterApprovalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da2757da80f42589, []int{11}
+}
+func (m *QueryVoterApprovalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoterApprovalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoterApprovalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoterApprovalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoterApprovalResponse.Merge(m, src)
+}
+func (m *QueryVoterApprovalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoterApprovalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoterApprovalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoterApprovalResponse proto.InternalMessageInfo
+
+func (m *QueryVoterApprovalResponse) GetApprovedVoter() string {
+	if m != nil {
+		return m.ApprovedVoter
+	}
+	return ""
+}
+
+func (m *QueryVoterApprovalResponse) GetAuthorized() bool {
+	if m != nil {
+		return m.Authorized
+	}
+	return false
+}
+
+// QueryAutoCompoundingVesRequest is the request type for the
+// Query/AutoCompoundingVes RPC method
+type QueryAutoCompoundingVesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAutoCompoundingVesRequest) Reset()         { *m = QueryAutoCompoundingVesRequest{} }
+func (m *QueryAutoCompoundingVesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundingVesRequest) ProtoMessage()    {}
+func (*QueryAutoCompoundingVesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da2757da80f42589, []int{12}
+}
+func (m *QueryAutoCompoundingVesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundingVesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundingVesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundingVesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundingVesRequest.Merge(m, src)
+}
+func (m *QueryAutoCompoundingVesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundingVesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundingVesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundingVesRequest proto.InternalMessageInfo
+
+func (m *QueryAutoCompoundingVesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAutoCompoundingVesResponse is the response type for the
+// Query/AutoCompoundingVes RPC method
+type QueryAutoCompoundingVesResponse struct {
+	AutoCompounds []AutoCompound      `protobuf:"bytes,1,rep,name=auto_compounds,json=autoCompounds,proto3" json:"auto_compounds"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAutoCompoundingVesResponse) Reset()         { *m = QueryAutoCompoundingVesResponse{} }
+func (m *QueryAutoCompoundingVesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundingVesResponse) ProtoMessage()    {}
+func (*QueryAutoCompoundingVesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da2757da80f42589, []int{13}
+}
+func (m *QueryAutoCompoundingVesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundingVesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundingVesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundingVesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundingVesResponse.Merge(m, src)
+}
+func (m *QueryAutoCompoundingVesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundingVesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundingVesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundingVesResponse proto.InternalMessageInfo
+
+func (m *QueryAutoCompoundingVesResponse) GetAutoCompounds() []AutoCompound {
+	if m != nil {
+		return m.AutoCompounds
+	}
+	return nil
+}
+
+func (m *QueryAutoCompoundingVesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -422,7 +744,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_da2757da80f42589, []int{8}
+	return fileDescriptor_da2757da80f42589, []int{14}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -461,7 +783,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_da2757da80f42589, []int{9}
+	return fileDescriptor_da2757da80f42589, []int{15}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -506,6 +828,12 @@ func init() {
 	proto.RegisterType((*QueryVeNftsResponse)(nil), "blackfury.ve.v1.QueryVeNftsResponse")
 	proto.RegisterType((*QueryVeNftRequest)(nil), "blackfury.ve.v1.QueryVeNftRequest")
 	proto.RegisterType((*QueryVeNftResponse)(nil), "blackfury.ve.v1.QueryVeNftResponse")
+	proto.RegisterType((*QueryVeNftMetadataRequest)(nil), "blackfury.ve.v1.QueryVeNftMetadataRequest")
+	proto.RegisterType((*QueryVeNftMetadataResponse)(nil), "blackfury.ve.v1.QueryVeNftMetadataResponse")
+	proto.RegisterType((*QueryVoterApprovalRequest)(nil), "blackfury.ve.v1.QueryVoterApprovalRequest")
+	proto.RegisterType((*QueryVoterApprovalResponse)(nil), "blackfury.ve.v1.QueryVoterApprovalResponse")
+	proto.RegisterType((*QueryAutoCompoundingVesRequest)(nil), "blackfury.ve.v1.QueryAutoCompoundingVesRequest")
+	proto.RegisterType((*QueryAutoCompoundingVesResponse)(nil), "blackfury.ve.v1.QueryAutoCompoundingVesResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "blackfury.ve.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "blackfury.ve.v1.QueryParamsResponse")
 }
@@ -513,52 +841,69 @@ func init() {
 func init() { proto.RegisterFile("blackfury/ve/v1/query.proto", fileDescriptor_da2757da80f42589) }
 
 var fileDescriptor_da2757da80f42589 = []byte{
-	// 706 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6f, 0xd3, 0x3c,
-	0x1c, 0x6e, 0xfa, 0xb5, 0xf7, 0xf5, 0xa4, 0xf7, 0xc3, 0x9b, 0xd4, 0xae, 0x6c, 0xd9, 0x94, 0xee,
-	0xa3, 0x63, 0x5a, 0xac, 0x0e, 0x71, 0x46, 0xaa, 0xd0, 0x60, 0x12, 0x9a, 0x46, 0x34, 0x71, 0xe0,
-	0x52, 0x9c, 0xd6, 0x0d, 0xd1, 0x5a, 0x3b, 0xab, 0xdd, 0x8c, 0x6a, 0xe2, 0xc2, 0x95, 0x0b, 0x88,
-	0x3b, 0xe2, 0xcf, 0xd9, 0x71, 0x12, 0x17, 0xc4, 0x61, 0x42, 0x1b, 0x7f, 0x08, 0x8a, 0xed, 0x74,
-	0xe9, 0x27, 0x3b, 0x70, 0x6a, 0x13, 0x3f, 0xbf, 0xe7, 0xc3, 0x79, 0x9c, 0x80, 0x7b, 0x6e, 0x1b,
-	0x37, 0x4e, 0x5a, 0xbd, 0x6e, 0x1f, 0x85, 0x04, 0x85, 0x55, 0x74, 0xda, 0x23, 0xdd, 0xbe, 0x1d,
-	0x74, 0x99, 0x60, 0xf0, 0xdf, 0xc1, 0xa2, 0x1d, 0x12, 0x3b, 0xac, 0x96, 0x16, 0x3d, 0xe6, 0x31,
-	0xb9, 0x86, 0xa2, 0x7f, 0x0a, 0x56, 0x5a, 0xf6, 0x18, 0xf3, 0xda, 0x04, 0xe1, 0xc0, 0x47, 0x98,
-	0x52, 0x26, 0xb0, 0xf0, 0x19, 0xe5, 0x7a, 0xf5, 0x7e, 0x83, 0xf1, 0x0e, 0xe3, 0xc8, 0xc5, 0x9c,
-	0x28, 0x76, 0x14, 0x56, 0x5d, 0x22, 0x70, 0x15, 0x05, 0xd8, 0xf3, 0xa9, 0x04, 0xc7, 0x4c, 0x1a,
-	0x4b, 0x5b, 0x62, 0x00, 0xa2, 0x2d, 0xa1, 0x57, 0x57, 0x46, 0xbd, 0x7a, 0x84, 0x12, 0xee, 0x6b,
-	0x21, 0xcb, 0x01, 0xcb, 0xcf, 0x23, 0xfa, 0x63, 0x26, 0x70, 0xfb, 0x05, 0x13, 0x3e, 0xf5, 0x8e,
-	0xd8, 0x19, 0xe9, 0x3a, 0xe4, 0xb4, 0x47, 0xb8, 0x80, 0x05, 0x30, 0x87, 0x45, 0x5d, 0xf8, 0x1d,
-	0x52, 0x34, 0xd6, 0x8c, 0x4a, 0xd6, 0xc9, 0x63, 0x71, 0xec, 0x77, 0x08, 0x5c, 0x02, 0x7f, 0x61,
-	0x51, 0x77, 0xdb, 0xac, 0x71, 0x52, 0x4c, 0xaf, 0x19, 0x95, 0x8c, 0x33, 0x87, 0x45, 0x2d, 0xba,
-	0xb4, 0x08, 0x58, 0x99, 0xc2, 0xc9, 0x03, 0x46, 0x39, 0x81, 0x8f, 0x41, 0x2e, 0x88, 0x6e, 0x48,
-	0xca, 0xbf, 0x6b, 0xf6, 0xc5, 0xd5, 0x6a, 0xea, 0xfb, 0xd5, 0xea, 0xa6, 0xe7, 0x8b, 0xd7, 0x3d,
-	0xd7, 0x6e, 0xb0, 0x0e, 0xd2, 0x99, 0xd4, 0xcf, 0x2e, 0x6f, 0x9e, 0x20, 0xd1, 0x0f, 0x08, 0xb7,
-	0x0f, 0xa8, 0x70, 0xd4, 0xb0, 0xe5, 0x82, 0x82, 0x94, 0x99, 0xe0, 0x7a, 0x01, 0xe4, 0x42, 0x52,
-	0xf7, 0x9b, 0x4a, 0xc0, 0xc9, 0x86, 0xe4, 0xa0, 0x99, 0x8c, 0x92, 0x9e, 0x1a, 0x25, 0x33, 0x1c,
-	0xe5, 0x15, 0x28, 0x8e, 0x6b, 0xfc, 0xd1, 0x14, 0x5d, 0x00, 0x95, 0x02, 0x39, 0x6c, 0x09, 0x1e,
-	0x07, 0x58, 0x04, 0x39, 0x76, 0x46, 0x63, 0x6e, 0x47, 0x5d, 0xc0, 0x7d, 0x00, 0x6e, 0x9f, 0xbe,
-	0x0c, 0x31, 0xbf, 0xb7, 0x69, 0x2b, 0x76, 0x3b, 0xaa, 0x8a, 0xad, 0x8a, 0xa8, 0x5b, 0x60, 0x1f,
-	0x61, 0x8f, 0x68, 0x46, 0x27, 0x31, 0x69, 0xbd, 0x37, 0xc0, 0xc2, 0x90, 0xa8, 0x4e, 0xb4, 0x03,
-	0xb2, 0xb4, 0x25, 0x78, 0xd1, 0x58, 0xcb, 0x54, 0xe6, 0xf7, 0x0a, 0x31, 0x73, 0x54, 0xa6, 0x98,
-	0xf2, 0x70, 0xff, 0xd8, 0x91, 0x20, 0xf8, 0x64, 0x82, 0x99, 0xad, 0xdf, 0x9a, 0x51, 0x4a, 0x43,
-	0x6e, 0xca, 0xe0, 0xff, 0x5b, 0x33, 0xf1, 0x06, 0xfc, 0x03, 0xd2, 0x83, 0xc7, 0x97, 0xf6, 0x9b,
-	0xd6, 0xa3, 0xe4, 0x36, 0x0d, 0x0c, 0x6f, 0x83, 0x0c, 0x6d, 0x09, 0x09, 0x9b, 0xe1, 0x37, 0xc2,
-	0x58, 0x8b, 0x9a, 0xe0, 0x08, 0x77, 0x71, 0x27, 0xde, 0x67, 0xeb, 0x99, 0xde, 0x88, 0xf8, 0xae,
-	0xe6, 0x7d, 0x08, 0xf2, 0x81, 0xbc, 0x33, 0xa0, 0x1e, 0x39, 0xd4, 0xb6, 0x1a, 0xa8, 0x65, 0xa3,
-	0x87, 0xee, 0x68, 0xf0, 0xde, 0x97, 0x1c, 0xc8, 0x49, 0x3a, 0xf8, 0xd9, 0x00, 0xff, 0x8d, 0xd6,
-	0x1f, 0xee, 0x8e, 0xb1, 0xcc, 0x3a, 0x7a, 0x25, 0xfb, 0xae, 0x70, 0x65, 0xda, 0xda, 0x79, 0xf7,
-	0xf5, 0xe7, 0xa7, 0xf4, 0x06, 0x2c, 0xa3, 0xd1, 0x23, 0x2f, 0xa2, 0x91, 0x7a, 0x28, 0x67, 0xea,
-	0xb2, 0x76, 0xf0, 0xa3, 0x01, 0xe6, 0x93, 0xde, 0x2a, 0x93, 0xc5, 0x26, 0xd8, 0xda, 0xbe, 0x03,
-	0x52, 0x3b, 0xda, 0x95, 0x8e, 0xb6, 0xe0, 0xc6, 0x98, 0xa3, 0xa4, 0x17, 0x74, 0x2e, 0xcf, 0xea,
-	0x5b, 0x28, 0x40, 0x5e, 0x15, 0x12, 0x96, 0xa7, 0x68, 0x24, 0xcf, 0x48, 0x69, 0x7d, 0x36, 0x48,
-	0x7b, 0x58, 0x95, 0x1e, 0x96, 0x60, 0x61, 0xdc, 0x03, 0x91, 0x3d, 0x0e, 0x41, 0x4e, 0x8e, 0x40,
-	0x6b, 0x06, 0x5f, 0xac, 0x59, 0x9e, 0x89, 0xd1, 0x92, 0xeb, 0x52, 0xd2, 0x84, 0xcb, 0x53, 0x24,
-	0xd1, 0xb9, 0x4e, 0xab, 0x4a, 0x34, 0x2d, 0xed, 0x50, 0x53, 0xa7, 0xa5, 0x1d, 0x2e, 0xee, 0x8c,
-	0xb4, 0xaa, 0xa2, 0xb5, 0xa7, 0x17, 0xd7, 0xa6, 0x71, 0x79, 0x6d, 0x1a, 0x3f, 0xae, 0x4d, 0xe3,
-	0xc3, 0x8d, 0x99, 0xba, 0xbc, 0x31, 0x53, 0xdf, 0x6e, 0xcc, 0xd4, 0x4b, 0x3b, 0xf1, 0xde, 0x22,
-	0xed, 0x3e, 0xf7, 0x7b, 0x1d, 0xae, 0x3e, 0x4a, 0x09, 0xae, 0x37, 0x11, 0x9b, 0x7c, 0x87, 0xb9,
-	0x79, 0xf9, 0x01, 0x79, 0xf0, 0x2b, 0x00, 0x00, 0xff, 0xff, 0xca, 0x32, 0x80, 0x72, 0x0d, 0x07,
-	0x00, 0x00,
+	// 985 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x18, 0xad, 0xf3, 0xa3, 0xdb, 0x7e, 0x55, 0xcb, 0x32, 0xad, 0xd4, 0x34, 0xb4, 0x6e, 0xe5, 0xb6,
+	0xbb, 0xdd, 0x8d, 0x6a, 0xd3, 0xae, 0x38, 0x43, 0x0b, 0x5a, 0x58, 0x04, 0xab, 0x62, 0x55, 0x7b,
+	0xe0, 0x12, 0x26, 0xc9, 0xc4, 0x35, 0x4d, 0x3c, 0x5e, 0xcf, 0xd8, 0x4b, 0x59, 0x71, 0x01, 0x6e,
+	0x5c, 0x40, 0x1c, 0x38, 0xc1, 0x91, 0x3f, 0x81, 0xbf, 0x61, 0x8f, 0x2b, 0x71, 0x41, 0x1c, 0x56,
+	0xa8, 0xe5, 0x0f, 0x41, 0xf3, 0xc3, 0xa9, 0x9d, 0xc4, 0x69, 0x84, 0xf6, 0x14, 0x7b, 0xe6, 0xfb,
+	0xde, 0x7b, 0xdf, 0xf8, 0xe9, 0x65, 0xe0, 0xad, 0x56, 0x0f, 0xb7, 0xcf, 0xbb, 0x71, 0x74, 0xe1,
+	0x24, 0xc4, 0x49, 0x0e, 0x9c, 0xa7, 0x31, 0x89, 0x2e, 0xec, 0x30, 0xa2, 0x9c, 0xa2, 0x37, 0x06,
+	0x9b, 0x76, 0x42, 0xec, 0xe4, 0xa0, 0xbe, 0xe2, 0x51, 0x8f, 0xca, 0x3d, 0x47, 0x3c, 0xa9, 0xb2,
+	0xfa, 0xba, 0x47, 0xa9, 0xd7, 0x23, 0x0e, 0x0e, 0x7d, 0x07, 0x07, 0x01, 0xe5, 0x98, 0xfb, 0x34,
+	0x60, 0x7a, 0xf7, 0x7e, 0x9b, 0xb2, 0x3e, 0x65, 0x4e, 0x0b, 0x33, 0xa2, 0xd0, 0x9d, 0xe4, 0xa0,
+	0x45, 0x38, 0x3e, 0x70, 0x42, 0xec, 0xf9, 0x81, 0x2c, 0x4e, 0x91, 0x74, 0x6d, 0xd0, 0xe5, 0x83,
+	0xa2, 0xa0, 0xcb, 0xf5, 0xee, 0xc6, 0xb0, 0x56, 0x8f, 0x04, 0x84, 0xf9, 0x29, 0x51, 0x6d, 0x78,
+	0x3b, 0x21, 0x6a, 0xc7, 0x72, 0x61, 0xfd, 0x33, 0x41, 0x7c, 0x4a, 0x39, 0xee, 0x3d, 0xa1, 0xdc,
+	0x0f, 0xbc, 0x13, 0xfa, 0x8c, 0x44, 0x2e, 0x79, 0x1a, 0x13, 0xc6, 0xd1, 0x2a, 0xdc, 0xc2, 0xbc,
+	0xc9, 0xfd, 0x3e, 0xa9, 0x19, 0x5b, 0xc6, 0x5e, 0xc5, 0x9d, 0xc5, 0xfc, 0xd4, 0xef, 0x13, 0xb4,
+	0x06, 0x73, 0x98, 0x37, 0x5b, 0x3d, 0xda, 0x3e, 0xaf, 0x95, 0xb6, 0x8c, 0xbd, 0xb2, 0x7b, 0x0b,
+	0xf3, 0x63, 0xf1, 0x6a, 0x11, 0xd8, 0x28, 0xc0, 0x64, 0x21, 0x0d, 0x18, 0x41, 0x1f, 0x40, 0x35,
+	0x14, 0x0b, 0x12, 0x72, 0xfe, 0xd8, 0x7e, 0xf1, 0x6a, 0x73, 0xe6, 0xef, 0x57, 0x9b, 0x77, 0x3c,
+	0x9f, 0x9f, 0xc5, 0x2d, 0xbb, 0x4d, 0xfb, 0x8e, 0x9e, 0x56, 0xfd, 0xec, 0xb3, 0xce, 0xb9, 0xc3,
+	0x2f, 0x42, 0xc2, 0xec, 0x47, 0x01, 0x77, 0x55, 0xb3, 0xd5, 0x82, 0x55, 0x49, 0x33, 0x46, 0xf5,
+	0x32, 0x54, 0x13, 0xd2, 0xf4, 0x3b, 0x8a, 0xc0, 0xad, 0x24, 0xe4, 0x51, 0x27, 0x3b, 0x4a, 0xa9,
+	0x70, 0x94, 0x72, 0x7e, 0x94, 0x2f, 0xa0, 0x36, 0xca, 0xf1, 0x5a, 0xa7, 0x88, 0x00, 0x29, 0x06,
+	0xf2, 0xb8, 0xcb, 0x59, 0x3a, 0xc0, 0x0a, 0x54, 0xe9, 0xb3, 0x20, 0xc5, 0x76, 0xd5, 0x0b, 0x7a,
+	0x08, 0x70, 0xed, 0x0b, 0x39, 0xc4, 0xc2, 0xe1, 0x1d, 0x5b, 0xa1, 0xdb, 0xc2, 0x44, 0xb6, 0xb2,
+	0xa8, 0xf6, 0x87, 0x7d, 0x82, 0x3d, 0xa2, 0x11, 0xdd, 0x4c, 0xa7, 0xf5, 0x83, 0x01, 0xcb, 0x39,
+	0x52, 0x3d, 0x51, 0x03, 0x2a, 0x41, 0x97, 0xb3, 0x9a, 0xb1, 0x55, 0xde, 0x5b, 0x38, 0x5c, 0x4d,
+	0x91, 0x85, 0xcd, 0x52, 0xc8, 0xc7, 0x0f, 0x4f, 0x5d, 0x59, 0x84, 0x3e, 0x1c, 0x23, 0xe6, 0xee,
+	0x8d, 0x62, 0x14, 0x53, 0x4e, 0xcd, 0x36, 0xbc, 0x79, 0x2d, 0x26, 0x3d, 0x80, 0x25, 0x28, 0x0d,
+	0x3e, 0x5f, 0xc9, 0xef, 0x58, 0xef, 0x66, 0x8f, 0x69, 0x20, 0xf8, 0x1e, 0x94, 0x83, 0x2e, 0x97,
+	0x65, 0x13, 0xf4, 0x8a, 0x1a, 0xab, 0x01, 0x6b, 0xd7, 0x00, 0x9f, 0x12, 0x8e, 0x3b, 0x98, 0xe3,
+	0x22, 0xb6, 0xef, 0x0d, 0xa8, 0x8f, 0xab, 0xd6, 0xb4, 0xef, 0xc1, 0x5c, 0x5f, 0xaf, 0x69, 0x6e,
+	0xd3, 0x1e, 0xca, 0x03, 0x3b, 0xd7, 0x79, 0x5c, 0x11, 0xe6, 0x70, 0x07, 0x5d, 0x08, 0x41, 0xe5,
+	0x4b, 0xa6, 0x8f, 0x6d, 0xde, 0x95, 0xcf, 0xe8, 0x36, 0x94, 0x59, 0xe2, 0x49, 0x07, 0xce, 0xbb,
+	0xe2, 0xd1, 0x3a, 0x4a, 0x35, 0x53, 0x4e, 0xa2, 0xa3, 0x30, 0x8c, 0x68, 0x82, 0x7b, 0x05, 0x9a,
+	0x85, 0x65, 0x12, 0x51, 0xa7, 0x31, 0xd5, 0x8b, 0xd5, 0x4e, 0x07, 0xc9, 0x43, 0xe8, 0x41, 0x76,
+	0x61, 0x09, 0xcb, 0x35, 0xd2, 0x69, 0xaa, 0x66, 0x85, 0xb7, 0x98, 0xae, 0xca, 0x36, 0x64, 0x02,
+	0xe0, 0x98, 0x9f, 0xd1, 0xc8, 0xff, 0x9a, 0x74, 0x24, 0xfe, 0x9c, 0x9b, 0x59, 0xb1, 0xce, 0xc0,
+	0x94, 0x24, 0x47, 0x31, 0xa7, 0xef, 0xd3, 0x7e, 0x48, 0xe3, 0xa0, 0xe3, 0x07, 0xde, 0x13, 0x32,
+	0xf0, 0x73, 0xde, 0xb9, 0xc6, 0xff, 0x76, 0xee, 0x1f, 0x06, 0x6c, 0x16, 0x52, 0xe9, 0xa1, 0x3e,
+	0x86, 0x25, 0x1c, 0x73, 0xda, 0x6c, 0xeb, 0xed, 0xd4, 0xcf, 0x1b, 0x23, 0xdf, 0x28, 0x0b, 0xa2,
+	0x3f, 0xd1, 0x22, 0xce, 0xac, 0xbd, 0x46, 0x93, 0xaf, 0x68, 0xff, 0x9e, 0xe0, 0x08, 0xf7, 0xd3,
+	0x63, 0xb1, 0x3e, 0x81, 0xe5, 0xdc, 0xaa, 0x9e, 0xe0, 0x1d, 0x98, 0x0d, 0xe5, 0xca, 0xc0, 0xd9,
+	0xc3, 0xca, 0x55, 0x83, 0xd6, 0xac, 0x8b, 0x0f, 0xbf, 0x9b, 0x87, 0xaa, 0x84, 0x43, 0xbf, 0x19,
+	0x70, 0x7b, 0x38, 0x7d, 0xd1, 0xfe, 0x08, 0xca, 0xa4, 0xe4, 0xaf, 0xdb, 0xd3, 0x96, 0x2b, 0xd1,
+	0x56, 0xe3, 0xdb, 0x3f, 0xff, 0xfd, 0xb9, 0xb4, 0x8b, 0xb6, 0x9d, 0xe1, 0x3f, 0x1b, 0x2e, 0x5a,
+	0x84, 0xbf, 0xfc, 0xc0, 0x6b, 0xca, 0xd4, 0x43, 0x3f, 0x19, 0xb0, 0x90, 0xd5, 0xb6, 0x37, 0x9e,
+	0x6c, 0x8c, 0xac, 0x7b, 0x53, 0x54, 0x6a, 0x45, 0xfb, 0x52, 0xd1, 0x5d, 0xb4, 0x3b, 0xa2, 0x28,
+	0xab, 0xc5, 0x79, 0x2e, 0xff, 0x2a, 0xbe, 0x41, 0x1c, 0x66, 0x55, 0x1e, 0xa2, 0xed, 0x02, 0x8e,
+	0x6c, 0x44, 0xd7, 0x77, 0x26, 0x17, 0x69, 0x0d, 0x9b, 0x52, 0xc3, 0x1a, 0x5a, 0x1d, 0xd5, 0x40,
+	0x64, 0x8c, 0x26, 0x50, 0x95, 0x2d, 0xc8, 0x9a, 0x80, 0x97, 0x72, 0x6e, 0x4f, 0xac, 0xd1, 0x94,
+	0x3b, 0x92, 0xd2, 0x44, 0xeb, 0x05, 0x94, 0xce, 0x73, 0x31, 0xed, 0x2f, 0x06, 0x2c, 0xe6, 0x32,
+	0x0a, 0xdd, 0x9f, 0x00, 0x3e, 0x14, 0x98, 0xf5, 0xc6, 0x54, 0xb5, 0x37, 0x7f, 0x87, 0x6b, 0x41,
+	0xce, 0x20, 0x1b, 0x7f, 0x15, 0xca, 0xb2, 0x71, 0x55, 0xa8, 0x6c, 0x4c, 0x2c, 0xd6, 0x1b, 0x53,
+	0xd5, 0x6a, 0x65, 0x0f, 0xa4, 0xb2, 0x7d, 0xd4, 0x98, 0xa8, 0x4c, 0x26, 0x63, 0x13, 0xa7, 0x6a,
+	0x7e, 0x37, 0x00, 0x8d, 0xc6, 0x0f, 0x72, 0xc6, 0x13, 0x17, 0x66, 0x62, 0xfd, 0xed, 0xe9, 0x1b,
+	0x6e, 0x3c, 0xc8, 0x5c, 0xe0, 0x09, 0x6b, 0x27, 0x84, 0x09, 0x43, 0xab, 0x9c, 0x28, 0x32, 0x74,
+	0x2e, 0x8c, 0xea, 0x3b, 0x93, 0x8b, 0x6e, 0x34, 0xb4, 0x4a, 0xa1, 0xe3, 0x8f, 0x5e, 0x5c, 0x9a,
+	0xc6, 0xcb, 0x4b, 0xd3, 0xf8, 0xe7, 0xd2, 0x34, 0x7e, 0xbc, 0x32, 0x67, 0x5e, 0x5e, 0x99, 0x33,
+	0x7f, 0x5d, 0x99, 0x33, 0x9f, 0xdb, 0x99, 0x9b, 0x11, 0xe9, 0x5d, 0x30, 0x3f, 0xee, 0x33, 0x75,
+	0x21, 0xce, 0x60, 0x7d, 0x25, 0xd0, 0xe4, 0x2d, 0xa9, 0x35, 0x2b, 0xaf, 0xa8, 0x0f, 0xfe, 0x1b,
+	0x00, 0xcd, 0xe1, 0x45, 0x11, 0x89, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -581,6 +926,13 @@ type QueryClient interface {
 	VeNfts(ctx context.Context, in *QueryVeNftsRequest, opts ...grpc.CallOption) (*QueryVeNftsResponse, error)
 	// VeNft queries an veNFT based on its id.
 	VeNft(ctx context.Context, in *QueryVeNftRequest, opts ...grpc.CallOption) (*QueryVeNftResponse, error)
+	// VeNftMetadata queries the dynamic metadata of an veNFT.
+	VeNftMetadata(ctx context.Context, in *QueryVeNftMetadataRequest, opts ...grpc.CallOption) (*QueryVeNftMetadataResponse, error)
+	// VoterApproval queries the approved voter of an veNFT, and whether an
+	// address is authorized to vote for it.
+	VoterApproval(ctx context.Context, in *QueryVoterApprovalRequest, opts ...grpc.CallOption) (*QueryVoterApprovalResponse, error)
+	// AutoCompoundingVes queries all auto-compounding veNFTs.
+	AutoCompoundingVes(ctx context.Context, in *QueryAutoCompoundingVesRequest, opts ...grpc.CallOption) (*QueryAutoCompoundingVesResponse, error)
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -629,6 +981,33 @@ func (c *queryClient) VeNft(ctx context.Context, in *QueryVeNftRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) VeNftMetadata(ctx context.Context, in *QueryVeNftMetadataRequest, opts ...grpc.CallOption) (*QueryVeNftMetadataResponse, error) {
+	out := new(QueryVeNftMetadataResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.ve.v1.Query/VeNftMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VoterApproval(ctx context.Context, in *QueryVoterApprovalRequest, opts ...grpc.CallOption) (*QueryVoterApprovalResponse, error) {
+	out := new(QueryVoterApprovalResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.ve.v1.Query/VoterApproval", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AutoCompoundingVes(ctx context.Context, in *QueryAutoCompoundingVesRequest, opts ...grpc.CallOption) (*QueryAutoCompoundingVesResponse, error) {
+	out := new(QueryAutoCompoundingVesResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.ve.v1.Query/AutoCompoundingVes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/blackfury.ve.v1.Query/Params", in, out, opts...)
@@ -648,6 +1027,13 @@ type QueryServer interface {
 	VeNfts(context.Context, *QueryVeNftsRequest) (*QueryVeNftsResponse, error)
 	// VeNft queries an veNFT based on its id.
 	VeNft(context.Context, *QueryVeNftRequest) (*QueryVeNftResponse, error)
+	// VeNftMetadata queries the dynamic metadata of an veNFT.
+	VeNftMetadata(context.Context, *QueryVeNftMetadataRequest) (*QueryVeNftMetadataResponse, error)
+	// VoterApproval queries the approved voter of an veNFT, and whether an
+	// address is authorized to vote for it.
+	VoterApproval(context.Context, *QueryVoterApprovalRequest) (*QueryVoterApprovalResponse, error)
+	// AutoCompoundingVes queries all auto-compounding veNFTs.
+	AutoCompoundingVes(context.Context, *QueryAutoCompoundingVesRequest) (*QueryAutoCompoundingVesResponse, error)
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -668,6 +1054,15 @@ func (*UnimplementedQueryServer) VeNfts(ctx context.Context, req *QueryVeNftsReq
 func (*UnimplementedQueryServer) VeNft(ctx context.Context, req *QueryVeNftRequest) (*QueryVeNftResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VeNft not implemented")
 }
+func (*UnimplementedQueryServer) VeNftMetadata(ctx context.Context, req *QueryVeNftMetadataRequest) (*QueryVeNftMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VeNftMetadata not implemented")
+}
+func (*UnimplementedQueryServer) VoterApproval(ctx context.Context, req *QueryVoterApprovalRequest) (*QueryVoterApprovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoterApproval not implemented")
+}
+func (*UnimplementedQueryServer) AutoCompoundingVes(ctx context.Context, req *QueryAutoCompoundingVesRequest) (*QueryAutoCompoundingVesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoCompoundingVes not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -748,6 +1143,60 @@ func _Query_VeNft_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VeNftMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVeNftMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VeNftMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.ve.v1.Query/VeNftMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VeNftMetadata(ctx, req.(*QueryVeNftMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VoterApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoterApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoterApproval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.ve.v1.Query/VoterApproval",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoterApproval(ctx, req.(*QueryVoterApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AutoCompoundingVes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoCompoundingVesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoCompoundingVes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.ve.v1.Query/AutoCompoundingVes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoCompoundingVes(ctx, req.(*QueryAutoCompoundingVesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -786,6 +1235,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VeNft",
 			Handler:    _Query_VeNft_Handler,
 		},
+		{
+			MethodName: "VeNftMetadata",
+			Handler:    _Query_VeNftMetadata_Handler,
+		},
+		{
+			MethodName: "VoterApproval",
+			Handler:    _Query_VoterApproval_Handler,
+		},
+		{
+			MethodName: "AutoCompoundingVes",
+			Handler:    _Query_AutoCompoundingVes_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -1090,7 +1551,245 @@ func (m *QueryVeNftResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryVeNftMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeNftMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeNftMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeNftMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeNftMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeNftMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Svg) > 0 {
+		i -= len(m.Svg)
+		copy(dAtA[i:], m.Svg)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Svg)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Json) > 0 {
+		i -= len(m.Json)
+		copy(dAtA[i:], m.Json)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Json)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoterApprovalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoterApprovalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoterApprovalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoterApprovalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoterApprovalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoterApprovalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Authorized {
+		i--
+		if m.Authorized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ApprovedVoter) > 0 {
+		i -= len(m.ApprovedVoter)
+		copy(dAtA[i:], m.ApprovedVoter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ApprovedVoter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoCompoundingVesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundingVesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundingVesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoCompoundingVesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundingVesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundingVesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AutoCompounds) > 0 {
+		for iNdEx := len(m.AutoCompounds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompounds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1275,52 +1974,149 @@ func (m *QueryVeNftResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
+func (m *QueryVeNftMetadataRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
+func (m *QueryVeNftMetadataResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Params.Size()
+	l = m.Metadata.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Json)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Svg)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryVoterApprovalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryVoterApprovalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ApprovedVoter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Authorized {
+		n += 2
+	}
+	return n
 }
-func (m *QueryTotalVotingPowerRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
+
+func (m *QueryAutoCompoundingVesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoCompoundingVesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AutoCompounds) > 0 {
+		for _, e := range m.AutoCompounds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTotalVotingPowerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
@@ -1353,7 +2149,684 @@ func (m *QueryTotalVotingPowerRequest) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AtBlock", wireType)
 			}
-			m.AtBlock = 0
+			m.AtBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AtBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalVotingPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalVotingPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalVotingPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotingPowerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotingPowerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotingPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtTime", wireType)
+			}
+			m.AtTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AtTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtBlock", wireType)
+			}
+			m.AtBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AtBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotingPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotingPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotingPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeNftsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeNftsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeNftsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeNftsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeNftsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeNftsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nfts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nfts = append(m.Nfts, &nft.NFT{})
+			if err := m.Nfts[len(m.Nfts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeNftRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeNftRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeNftRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeNftResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeNftResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeNftResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nft", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1363,11 +2836,28 @@ func (m *QueryTotalVotingPowerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AtBlock |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Nft == nil {
+				m.Nft = &nft.NFT{}
+			}
+			if err := m.Nft.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1389,7 +2879,7 @@ func (m *QueryTotalVotingPowerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalVotingPowerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVeNftMetadataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1412,15 +2902,15 @@ func (m *QueryTotalVotingPowerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalVotingPowerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVeNftMetadataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalVotingPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVeNftMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1448,9 +2938,7 @@ func (m *QueryTotalVotingPowerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1473,7 +2961,7 @@ func (m *QueryTotalVotingPowerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotingPowerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVeNftMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1496,17 +2984,17 @@ func (m *QueryVotingPowerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotingPowerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVeNftMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotingPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVeNftMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1516,48 +3004,30 @@ func (m *QueryVotingPowerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VeId = string(dAtA[iNdEx:postIndex])
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AtTime", wireType)
-			}
-			m.AtTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AtTime |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AtBlock", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Json", wireType)
 			}
-			m.AtBlock = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1567,64 +3037,27 @@ func (m *QueryVotingPowerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AtBlock |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryVotingPowerResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotingPowerResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotingPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Json = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Svg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1652,9 +3085,7 @@ func (m *QueryVotingPowerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Svg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1677,7 +3108,7 @@ func (m *QueryVotingPowerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVeNftsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVoterApprovalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1700,15 +3131,15 @@ func (m *QueryVeNftsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVeNftsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoterApprovalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVeNftsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoterApprovalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1736,13 +3167,13 @@ func (m *QueryVeNftsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1752,27 +3183,23 @@ func (m *QueryVeNftsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1795,7 +3222,7 @@ func (m *QueryVeNftsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVeNftsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVoterApprovalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1818,17 +3245,17 @@ func (m *QueryVeNftsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVeNftsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoterApprovalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVeNftsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoterApprovalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nfts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedVoter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1838,31 +3265,29 @@ func (m *QueryVeNftsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nfts = append(m.Nfts, &nft.NFT{})
-			if err := m.Nfts[len(m.Nfts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ApprovedVoter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorized", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1872,28 +3297,12 @@ func (m *QueryVeNftsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Authorized = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1915,7 +3324,7 @@ func (m *QueryVeNftsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVeNftRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAutoCompoundingVesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1938,17 +3347,17 @@ func (m *QueryVeNftRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVeNftRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAutoCompoundingVesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVeNftRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAutoCompoundingVesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1958,23 +3367,27 @@ func (m *QueryVeNftRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1997,7 +3410,7 @@ func (m *QueryVeNftRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVeNftResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAutoCompoundingVesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2020,15 +3433,15 @@ func (m *QueryVeNftResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVeNftResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAutoCompoundingVesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVeNftResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAutoCompoundingVesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nft", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompounds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2055,10 +3468,44 @@ func (m *QueryVeNftResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Nft == nil {
-				m.Nft = &nft.NFT{}
+			m.AutoCompounds = append(m.AutoCompounds, AutoCompound{})
+			if err := m.AutoCompounds[len(m.AutoCompounds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Nft.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -423,6 +423,240 @@ func (m *MsgWithdrawResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWithdrawResponse proto.InternalMessageInfo
 
+type MsgApproveVoter struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// veNFT to approve the voter for; empty to approve the voter as operator
+	// for all veNFTs of the sender
+	VeId  string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty" yaml:"ve_id"`
+	Voter string `protobuf:"bytes,3,opt,name=voter,proto3" json:"voter,omitempty" yaml:"voter"`
+}
+
+func (m *MsgApproveVoter) Reset()         { *m = MsgApproveVoter{} }
+func (m *MsgApproveVoter) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveVoter) ProtoMessage()    {}
+func (*MsgApproveVoter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0bf36219432e43a, []int{10}
+}
+func (m *MsgApproveVoter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveVoter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveVoter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveVoter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveVoter.Merge(m, src)
+}
+func (m *MsgApproveVoter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveVoter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveVoter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveVoter proto.InternalMessageInfo
+
+type MsgApproveVoterResponse struct {
+}
+
+func (m *MsgApproveVoterResponse) Reset()         { *m = MsgApproveVoterResponse{} }
+func (m *MsgApproveVoterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveVoterResponse) ProtoMessage()    {}
+func (*MsgApproveVoterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0bf36219432e43a, []int{11}
+}
+func (m *MsgApproveVoterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveVoterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveVoterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveVoterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveVoterResponse.Merge(m, src)
+}
+func (m *MsgApproveVoterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveVoterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveVoterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveVoterResponse proto.InternalMessageInfo
+
+type MsgRevokeVoter struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// veNFT to revoke the voter approval for; must be empty if voter is
+	// specified
+	VeId string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty" yaml:"ve_id"`
+	// operator to revoke the approval for all veNFTs of the sender; must be
+	// empty if ve_id is specified
+	Voter string `protobuf:"bytes,3,opt,name=voter,proto3" json:"voter,omitempty" yaml:"voter"`
+}
+
+func (m *MsgRevokeVoter) Reset()         { *m = MsgRevokeVoter{} }
+func (m *MsgRevokeVoter) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeVoter) ProtoMessage()    {}
+func (*MsgRevokeVoter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0bf36219432e43a, []int{12}
+}
+func (m *MsgRevokeVoter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeVoter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeVoter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeVoter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeVoter.Merge(m, src)
+}
+func (m *MsgRevokeVoter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeVoter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeVoter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeVoter proto.InternalMessageInfo
+
+type MsgRevokeVoterResponse struct {
+}
+
+func (m *MsgRevokeVoterResponse) Reset()         { *m = MsgRevokeVoterResponse{} }
+func (m *MsgRevokeVoterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeVoterResponse) ProtoMessage()    {}
+func (*MsgRevokeVoterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0bf36219432e43a, []int{13}
+}
+func (m *MsgRevokeVoterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeVoterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeVoterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeVoterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeVoterResponse.Merge(m, src)
+}
+func (m *MsgRevokeVoterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeVoterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeVoterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeVoterResponse proto.InternalMessageInfo
+
+type MsgSetAutoCompound struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	VeId    string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty" yaml:"ve_id"`
+	Enabled bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+	// whether to also compound the gauge and bribe rewards in the lock denom,
+	// besides the distribution rebases; ignored if not enabled
+	CompoundRewards bool `protobuf:"varint,4,opt,name=compound_rewards,json=compoundRewards,proto3" json:"compound_rewards,omitempty" yaml:"compound_rewards"`
+}
+
+func (m *MsgSetAutoCompound) Reset()         { *m = MsgSetAutoCompound{} }
+func (m *MsgSetAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompound) ProtoMessage()    {}
+func (*MsgSetAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0bf36219432e43a, []int{14}
+}
+func (m *MsgSetAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompound.Merge(m, src)
+}
+func (m *MsgSetAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompound proto.InternalMessageInfo
+
+type MsgSetAutoCompoundResponse struct {
+}
+
+func (m *MsgSetAutoCompoundResponse) Reset()         { *m = MsgSetAutoCompoundResponse{} }
+func (m *MsgSetAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgSetAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0bf36219432e43a, []int{15}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompoundResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreate)(nil), "blackfury.ve.v1.MsgCreate")
 	proto.RegisterType((*MsgCreateResponse)(nil), "blackfury.ve.v1.MsgCreateResponse")
@@ -434,57 +668,79 @@ func init() {
 	proto.RegisterType((*MsgMergeResponse)(nil), "blackfury.ve.v1.MsgMergeResponse")
 	proto.RegisterType((*MsgWithdraw)(nil), "blackfury.ve.v1.MsgWithdraw")
 	proto.RegisterType((*MsgWithdrawResponse)(nil), "blackfury.ve.v1.MsgWithdrawResponse")
+	proto.RegisterType((*MsgApproveVoter)(nil), "blackfury.ve.v1.MsgApproveVoter")
+	proto.RegisterType((*MsgApproveVoterResponse)(nil), "blackfury.ve.v1.MsgApproveVoterResponse")
+	proto.RegisterType((*MsgRevokeVoter)(nil), "blackfury.ve.v1.MsgRevokeVoter")
+	proto.RegisterType((*MsgRevokeVoterResponse)(nil), "blackfury.ve.v1.MsgRevokeVoterResponse")
+	proto.RegisterType((*MsgSetAutoCompound)(nil), "blackfury.ve.v1.MsgSetAutoCompound")
+	proto.RegisterType((*MsgSetAutoCompoundResponse)(nil), "blackfury.ve.v1.MsgSetAutoCompoundResponse")
 }
 
 func init() { proto.RegisterFile("blackfury/ve/v1/tx.proto", fileDescriptor_e0bf36219432e43a) }
 
 var fileDescriptor_e0bf36219432e43a = []byte{
-	// 719 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x31, 0x4f, 0xdb, 0x40,
-	0x14, 0xc7, 0xe3, 0x24, 0xa4, 0xe1, 0x41, 0x04, 0x1c, 0x44, 0x0d, 0x26, 0xd8, 0x60, 0x68, 0x4b,
-	0x87, 0xda, 0x02, 0x36, 0xa4, 0x2e, 0x81, 0x4a, 0x30, 0x64, 0xb1, 0xaa, 0x56, 0xea, 0x82, 0x9c,
-	0xe4, 0x30, 0x16, 0xb1, 0x2f, 0xf2, 0x5d, 0x02, 0x19, 0xba, 0x74, 0xea, 0x58, 0xa9, 0x5f, 0x00,
-	0xa9, 0x5b, 0xc7, 0x7e, 0x86, 0x0e, 0x8c, 0x48, 0x5d, 0x3a, 0x45, 0x15, 0x74, 0x60, 0xce, 0x27,
-	0xa8, 0x7c, 0x67, 0x3b, 0xa6, 0x18, 0x24, 0x2a, 0xba, 0xe5, 0xee, 0xff, 0xde, 0xfb, 0xbd, 0xff,
-	0xcb, 0xdd, 0x19, 0x2a, 0x8d, 0xb6, 0xd5, 0x3c, 0x3a, 0xe8, 0xfa, 0x7d, 0xa3, 0x87, 0x8d, 0xde,
-	0xba, 0xc1, 0x4e, 0xf4, 0x8e, 0x4f, 0x18, 0x41, 0x53, 0xb1, 0xa2, 0xf7, 0xb0, 0xde, 0x5b, 0x97,
-	0xe7, 0x6c, 0x62, 0x13, 0xae, 0x19, 0xc1, 0x2f, 0x11, 0x26, 0x57, 0x6d, 0x42, 0xec, 0x36, 0x36,
-	0xac, 0x8e, 0x63, 0x58, 0x9e, 0x47, 0x98, 0xc5, 0x1c, 0xe2, 0xd1, 0x50, 0x55, 0x9a, 0x84, 0xba,
-	0x84, 0x1a, 0x0d, 0x8b, 0x06, 0xd5, 0x1b, 0x98, 0x59, 0xeb, 0x46, 0x93, 0x38, 0x9e, 0xd0, 0xb5,
-	0x2b, 0x09, 0xc6, 0xeb, 0xd4, 0xde, 0xf6, 0xb1, 0xc5, 0x30, 0x7a, 0x0e, 0x05, 0x8a, 0xbd, 0x16,
-	0xf6, 0x2b, 0xd2, 0x92, 0xb4, 0x36, 0x5e, 0x9b, 0x19, 0x0e, 0xd4, 0x52, 0xdf, 0x72, 0xdb, 0x5b,
-	0x9a, 0xd8, 0xd7, 0xcc, 0x30, 0x00, 0x2d, 0x42, 0x96, 0x91, 0x4a, 0x96, 0x87, 0x95, 0x86, 0x03,
-	0x75, 0x5c, 0x84, 0x31, 0xa2, 0x99, 0x59, 0x46, 0xd0, 0x2e, 0x14, 0x2c, 0x97, 0x74, 0x3d, 0x56,
-	0xc9, 0x2d, 0x49, 0x6b, 0x13, 0x1b, 0xf3, 0xba, 0x68, 0x44, 0x0f, 0x1a, 0xd1, 0xc3, 0x46, 0xf4,
-	0x6d, 0xe2, 0x78, 0xb5, 0xf2, 0xd9, 0x40, 0xcd, 0x8c, 0x40, 0x22, 0x4d, 0x33, 0xc3, 0x7c, 0xf4,
-	0x12, 0x4a, 0x6d, 0xd2, 0x3c, 0xda, 0x6f, 0x75, 0x7d, 0xee, 0xac, 0x92, 0x5f, 0x92, 0xd6, 0xf2,
-	0xb5, 0xca, 0x70, 0xa0, 0xce, 0x89, 0x8c, 0x6b, 0xb2, 0x66, 0x4e, 0x06, 0xeb, 0x9d, 0x70, 0xb9,
-	0x55, 0xfc, 0x78, 0xaa, 0x66, 0xae, 0x4e, 0xd5, 0x8c, 0xb6, 0x07, 0x33, 0xb1, 0x53, 0x13, 0xd3,
-	0x0e, 0xf1, 0x28, 0x46, 0xb3, 0x30, 0xd6, 0xc3, 0xfb, 0x4e, 0x4b, 0x18, 0x36, 0xf3, 0x3d, 0xbc,
-	0xd7, 0x42, 0x2a, 0x4c, 0x74, 0x3d, 0x5e, 0x95, 0x39, 0x2e, 0xe6, 0x26, 0xf3, 0x26, 0x88, 0xad,
-	0xd7, 0x8e, 0x8b, 0xb5, 0x6f, 0x12, 0x40, 0x9d, 0xda, 0x3b, 0xb8, 0x43, 0xa8, 0xc3, 0xee, 0x33,
-	0xb6, 0x27, 0x11, 0x4f, 0x4c, 0x6e, 0x7a, 0x38, 0x50, 0x27, 0x45, 0x24, 0xdf, 0xd6, 0xc2, 0x0e,
-	0x1e, 0x6c, 0x7c, 0x09, 0xff, 0x73, 0x80, 0x46, 0x3d, 0x47, 0x03, 0xd0, 0xbe, 0x4a, 0x50, 0xaa,
-	0x53, 0xfb, 0xd5, 0x09, 0xc3, 0x5e, 0x2b, 0x30, 0xf7, 0x1f, 0xdc, 0xdc, 0xf8, 0x0b, 0x73, 0xff,
-	0xf8, 0x17, 0x3e, 0x86, 0xf2, 0xb5, 0x5e, 0x63, 0x17, 0x5f, 0x24, 0x28, 0xd6, 0xa9, 0x5d, 0xc7,
-	0xbe, 0x7d, 0x2f, 0x03, 0x9b, 0x00, 0x07, 0x3e, 0x71, 0xf7, 0x93, 0x2e, 0xca, 0xc3, 0x81, 0x3a,
-	0x23, 0xc2, 0x47, 0x9a, 0x66, 0x16, 0x83, 0xc5, 0x9b, 0xc0, 0xce, 0x0b, 0x28, 0x32, 0x12, 0xa6,
-	0xe4, 0x78, 0xca, 0xec, 0x70, 0xa0, 0x4e, 0x45, 0x17, 0x20, 0x4a, 0x28, 0x30, 0x12, 0x84, 0x27,
-	0xda, 0x47, 0x30, 0x1d, 0x35, 0x19, 0x77, 0xee, 0xc0, 0x44, 0x9d, 0xda, 0x6f, 0x1d, 0x76, 0xd8,
-	0xf2, 0xad, 0xe3, 0x87, 0x1f, 0x7e, 0x02, 0x5f, 0x86, 0xd9, 0x04, 0x2a, 0xea, 0x60, 0xe3, 0x7b,
-	0x1e, 0x72, 0x75, 0x6a, 0xa3, 0x36, 0x14, 0xc2, 0x67, 0x40, 0xd6, 0xff, 0x7a, 0x7a, 0xf4, 0xf8,
-	0xe2, 0xc8, 0xda, 0xed, 0x5a, 0xec, 0x49, 0xfb, 0xf0, 0xe3, 0xf7, 0xe7, 0x6c, 0x15, 0xc9, 0xc6,
-	0xcd, 0xc7, 0xcd, 0x68, 0x0a, 0x46, 0x07, 0x1e, 0x45, 0xd7, 0x67, 0x21, 0xad, 0x64, 0x28, 0xca,
-	0x2b, 0x77, 0x88, 0x31, 0x70, 0x85, 0x03, 0x17, 0xd1, 0x42, 0x1a, 0xb0, 0x15, 0x62, 0xde, 0x03,
-	0x24, 0x4e, 0xb9, 0x92, 0x56, 0x77, 0xa4, 0xcb, 0x4f, 0xef, 0xd6, 0x63, 0xf4, 0x33, 0x8e, 0x5e,
-	0x46, 0x6a, 0x1a, 0x1a, 0xf3, 0x78, 0xfe, 0x8a, 0xa0, 0x43, 0x18, 0x13, 0xc7, 0x73, 0x3e, 0xad,
-	0x32, 0x97, 0xe4, 0xe5, 0x5b, 0xa5, 0x98, 0xb7, 0xcc, 0x79, 0x0b, 0x68, 0x3e, 0x8d, 0xe7, 0x72,
-	0x00, 0x83, 0x62, 0x7c, 0x9e, 0xaa, 0x69, 0x15, 0x23, 0x55, 0x5e, 0xbd, 0x4b, 0x8d, 0x91, 0xab,
-	0x1c, 0xa9, 0xa0, 0x6a, 0x1a, 0xf2, 0x38, 0x8c, 0xae, 0xed, 0x9e, 0x5d, 0x28, 0xd2, 0xf9, 0x85,
-	0x22, 0xfd, 0xba, 0x50, 0xa4, 0x4f, 0x97, 0x4a, 0xe6, 0xfc, 0x52, 0xc9, 0xfc, 0xbc, 0x54, 0x32,
-	0xef, 0x74, 0xdb, 0x61, 0x87, 0xdd, 0x86, 0xde, 0x24, 0xae, 0x81, 0xdb, 0x7d, 0xea, 0x74, 0x5d,
-	0x2a, 0xbe, 0x52, 0x89, 0x82, 0x27, 0x41, 0x49, 0xd6, 0xef, 0x60, 0xda, 0x28, 0xf0, 0x4f, 0xd3,
-	0xe6, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x20, 0x28, 0xc2, 0xae, 0x1b, 0x07, 0x00, 0x00,
+	// 961 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xbf, 0x6f, 0xdb, 0x46,
+	0x14, 0x16, 0x2d, 0x5b, 0x96, 0x9f, 0xed, 0xc8, 0x3e, 0xdb, 0xb5, 0x4c, 0x2b, 0xa2, 0x4c, 0x27,
+	0xb1, 0x82, 0x36, 0x24, 0x9c, 0x6c, 0x01, 0x3a, 0x44, 0x4e, 0x8b, 0x64, 0xd0, 0xc2, 0x16, 0x29,
+	0xd0, 0x45, 0xa0, 0xa4, 0x0b, 0x4d, 0x58, 0xe4, 0x11, 0xbc, 0x23, 0x6d, 0x0f, 0x1d, 0x5a, 0xa0,
+	0x40, 0xc7, 0xa2, 0x3f, 0xf6, 0x00, 0xdd, 0x3a, 0xf6, 0xaf, 0xc8, 0x18, 0xa0, 0x4b, 0x27, 0xa2,
+	0xb0, 0xdb, 0x22, 0xb3, 0xfe, 0x82, 0x82, 0x77, 0x24, 0x4d, 0xcb, 0x8c, 0xd3, 0x14, 0x36, 0x90,
+	0x4d, 0x7c, 0xdf, 0xf7, 0xde, 0xf7, 0xbd, 0x27, 0xde, 0x3b, 0x42, 0xbd, 0x3f, 0x32, 0x07, 0x07,
+	0xcf, 0x03, 0xff, 0x58, 0x0f, 0xb1, 0x1e, 0xee, 0xea, 0xec, 0x48, 0xf3, 0x7c, 0xc2, 0x08, 0xaa,
+	0x65, 0x88, 0x16, 0x62, 0x2d, 0xdc, 0x95, 0x57, 0x2d, 0x62, 0x11, 0x8e, 0xe9, 0xf1, 0x2f, 0x41,
+	0x93, 0x1b, 0x16, 0x21, 0xd6, 0x08, 0xeb, 0xa6, 0x67, 0xeb, 0xa6, 0xeb, 0x12, 0x66, 0x32, 0x9b,
+	0xb8, 0x34, 0x41, 0x9b, 0x03, 0x42, 0x1d, 0x42, 0xf5, 0xbe, 0x49, 0xe3, 0xea, 0x7d, 0xcc, 0xcc,
+	0x5d, 0x7d, 0x40, 0x6c, 0x57, 0xe0, 0xea, 0x6b, 0x09, 0xe6, 0xba, 0xd4, 0xda, 0xf3, 0xb1, 0xc9,
+	0x30, 0xba, 0x0b, 0x15, 0x8a, 0xdd, 0x21, 0xf6, 0xeb, 0x52, 0x4b, 0x6a, 0xcf, 0x75, 0x96, 0xc7,
+	0x91, 0xb2, 0x78, 0x6c, 0x3a, 0xa3, 0x87, 0xaa, 0x88, 0xab, 0x46, 0x42, 0x40, 0x37, 0x61, 0x8a,
+	0x91, 0xfa, 0x14, 0xa7, 0x2d, 0x8e, 0x23, 0x65, 0x4e, 0xd0, 0x18, 0x51, 0x8d, 0x29, 0x46, 0xd0,
+	0x13, 0xa8, 0x98, 0x0e, 0x09, 0x5c, 0x56, 0x2f, 0xb7, 0xa4, 0xf6, 0xfc, 0xfd, 0x0d, 0x4d, 0x18,
+	0xd1, 0x62, 0x23, 0x5a, 0x62, 0x44, 0xdb, 0x23, 0xb6, 0xdb, 0x59, 0x7b, 0x19, 0x29, 0xa5, 0x33,
+	0x21, 0x91, 0xa6, 0x1a, 0x49, 0x3e, 0xfa, 0x18, 0x16, 0x47, 0x64, 0x70, 0xd0, 0x1b, 0x06, 0x3e,
+	0xef, 0xac, 0x3e, 0xdd, 0x92, 0xda, 0xd3, 0x9d, 0xfa, 0x38, 0x52, 0x56, 0x45, 0xc6, 0x39, 0x58,
+	0x35, 0x16, 0xe2, 0xe7, 0xc7, 0xc9, 0xe3, 0xc3, 0xea, 0x77, 0x2f, 0x94, 0xd2, 0xeb, 0x17, 0x4a,
+	0x49, 0x7d, 0x0a, 0xcb, 0x59, 0xa7, 0x06, 0xa6, 0x1e, 0x71, 0x29, 0x46, 0x2b, 0x30, 0x13, 0xe2,
+	0x9e, 0x3d, 0x14, 0x0d, 0x1b, 0xd3, 0x21, 0x7e, 0x3a, 0x44, 0x0a, 0xcc, 0x07, 0x2e, 0xaf, 0xca,
+	0x6c, 0x07, 0xf3, 0x26, 0xa7, 0x0d, 0x10, 0xa1, 0xcf, 0x6d, 0x07, 0xab, 0xbf, 0x49, 0x00, 0x5d,
+	0x6a, 0x3d, 0xc6, 0x1e, 0xa1, 0x36, 0x7b, 0x97, 0xb1, 0xdd, 0x4e, 0xf5, 0xc4, 0xe4, 0x96, 0xc6,
+	0x91, 0xb2, 0x20, 0x98, 0x3c, 0xac, 0x26, 0x0e, 0xae, 0x6c, 0x7c, 0xb9, 0xfe, 0x57, 0x01, 0x9d,
+	0x79, 0x4e, 0x07, 0xa0, 0xfe, 0x2a, 0xc1, 0x62, 0x97, 0x5a, 0x9f, 0x1c, 0x31, 0xec, 0x0e, 0xe3,
+	0xe6, 0xae, 0xa1, 0x9b, 0x0b, 0x7f, 0x61, 0xf9, 0x7f, 0xfe, 0x85, 0xeb, 0xb0, 0x76, 0xce, 0x6b,
+	0xd6, 0xc5, 0x2f, 0x12, 0x54, 0xbb, 0xd4, 0xea, 0x62, 0xdf, 0x7a, 0xa7, 0x06, 0x1e, 0x00, 0x3c,
+	0xf7, 0x89, 0xd3, 0xcb, 0x77, 0xb1, 0x36, 0x8e, 0x94, 0x65, 0x41, 0x3f, 0xc3, 0x54, 0xa3, 0x1a,
+	0x3f, 0x3c, 0x8b, 0xdb, 0xb9, 0x07, 0x55, 0x46, 0x92, 0x94, 0x32, 0x4f, 0x59, 0x19, 0x47, 0x4a,
+	0x2d, 0x3d, 0x00, 0x69, 0x42, 0x85, 0x91, 0x98, 0x9e, 0xb3, 0x8f, 0x60, 0x29, 0x35, 0x99, 0x39,
+	0xb7, 0x61, 0xbe, 0x4b, 0xad, 0x2f, 0x6c, 0xb6, 0x3f, 0xf4, 0xcd, 0xc3, 0xab, 0x1f, 0x7e, 0x4e,
+	0x7e, 0x0d, 0x56, 0x72, 0x52, 0x99, 0x83, 0x9f, 0x25, 0xa8, 0x75, 0xa9, 0xf5, 0xc8, 0xf3, 0x7c,
+	0x12, 0xe2, 0x67, 0x84, 0x61, 0xff, 0x1a, 0xde, 0x81, 0x3b, 0x30, 0x13, 0xc6, 0xa5, 0xeb, 0xe5,
+	0x0b, 0xb4, 0x38, 0xac, 0x1a, 0x02, 0xce, 0xd9, 0xdd, 0x80, 0xf5, 0x09, 0x5b, 0x99, 0xe5, 0x9f,
+	0x24, 0xb8, 0xd1, 0xa5, 0x96, 0x81, 0x43, 0x72, 0xf0, 0x1e, 0x39, 0xae, 0xc3, 0x07, 0xe7, 0x5d,
+	0x65, 0x86, 0xff, 0x91, 0xf8, 0xe1, 0xfb, 0x0c, 0xb3, 0x47, 0x01, 0x23, 0x7b, 0xc4, 0xf1, 0x48,
+	0xe0, 0x0e, 0xaf, 0xc1, 0xf4, 0x47, 0x30, 0x8b, 0x5d, 0xb3, 0x3f, 0xc2, 0xe2, 0xd5, 0xac, 0x76,
+	0xd0, 0x38, 0x52, 0x6e, 0x08, 0x62, 0x02, 0xa8, 0x46, 0x4a, 0x41, 0x9f, 0xc2, 0xd2, 0x20, 0xf1,
+	0xd2, 0xf3, 0xf1, 0xa1, 0xe9, 0x0f, 0x29, 0x5f, 0xaf, 0xd5, 0xce, 0xe6, 0x38, 0x52, 0xd6, 0x45,
+	0xda, 0x24, 0x43, 0x35, 0x6a, 0x69, 0xc8, 0x10, 0x91, 0xdc, 0x08, 0x1a, 0x20, 0x5f, 0xec, 0x33,
+	0x1d, 0xc3, 0xfd, 0xbf, 0x67, 0xa1, 0xdc, 0xa5, 0x16, 0x1a, 0x41, 0x25, 0xb9, 0x71, 0x64, 0x6d,
+	0xe2, 0x96, 0xd3, 0xb2, 0x1d, 0x2d, 0xab, 0x6f, 0xc6, 0xb2, 0xc1, 0xaa, 0xdf, 0xfc, 0xfe, 0xd7,
+	0x8f, 0x53, 0x0d, 0x24, 0xeb, 0x17, 0xef, 0x51, 0x7d, 0x20, 0x34, 0x3c, 0x98, 0x4d, 0x37, 0xf5,
+	0x66, 0x51, 0xc9, 0x04, 0x94, 0xb7, 0x2f, 0x01, 0x33, 0xc1, 0x6d, 0x2e, 0x78, 0x13, 0x6d, 0x16,
+	0x09, 0x0e, 0x13, 0x99, 0xaf, 0x00, 0x72, 0x0b, 0xb5, 0x59, 0x54, 0xf7, 0x0c, 0x97, 0xef, 0x5c,
+	0x8e, 0x67, 0xd2, 0x3b, 0x5c, 0x7a, 0x0b, 0x29, 0x45, 0xd2, 0x98, 0xf3, 0xf9, 0x85, 0x85, 0xf6,
+	0x61, 0x46, 0x6c, 0xc2, 0x8d, 0xa2, 0xca, 0x1c, 0x92, 0xb7, 0xde, 0x08, 0x65, 0x7a, 0x5b, 0x5c,
+	0x6f, 0x13, 0x6d, 0x14, 0xe9, 0x39, 0x5c, 0x80, 0x41, 0x35, 0x5b, 0x5d, 0x8d, 0xa2, 0x8a, 0x29,
+	0x2a, 0xdf, 0xba, 0x0c, 0xcd, 0x24, 0x6f, 0x71, 0xc9, 0x26, 0x6a, 0x14, 0x49, 0x1e, 0xa6, 0x4a,
+	0xdf, 0x4a, 0xb0, 0x70, 0x6e, 0x5d, 0xb5, 0x8a, 0x8a, 0xe7, 0x19, 0x72, 0xfb, 0x6d, 0x8c, 0xcc,
+	0xc2, 0x5d, 0x6e, 0x61, 0x1b, 0x6d, 0x15, 0x59, 0x30, 0x45, 0x46, 0x8f, 0x9f, 0x7c, 0xf4, 0xb5,
+	0x04, 0xf3, 0xf9, 0x1d, 0xa4, 0x14, 0x89, 0xe4, 0x08, 0xf2, 0xce, 0x5b, 0x08, 0x99, 0x89, 0x36,
+	0x37, 0xa1, 0xa2, 0x56, 0x91, 0x09, 0x9f, 0x27, 0x24, 0x1e, 0x7e, 0x90, 0xa0, 0x36, 0xb9, 0x56,
+	0x0a, 0x5f, 0xe4, 0x09, 0x92, 0xfc, 0xe1, 0x7f, 0x20, 0x65, 0x7e, 0xee, 0x71, 0x3f, 0x3b, 0xe8,
+	0x76, 0x91, 0x1f, 0x8a, 0x59, 0xcf, 0x0c, 0x18, 0xe9, 0xa5, 0x6b, 0xa1, 0xf3, 0xe4, 0xe5, 0x49,
+	0x53, 0x7a, 0x75, 0xd2, 0x94, 0xfe, 0x3c, 0x69, 0x4a, 0xdf, 0x9f, 0x36, 0x4b, 0xaf, 0x4e, 0x9b,
+	0xa5, 0x3f, 0x4e, 0x9b, 0xa5, 0x2f, 0x35, 0xcb, 0x66, 0xfb, 0x41, 0x5f, 0x1b, 0x10, 0x47, 0xc7,
+	0xa3, 0x63, 0x6a, 0x07, 0x0e, 0x15, 0x5f, 0xac, 0xb9, 0xca, 0x47, 0x71, 0x6d, 0x76, 0xec, 0x61,
+	0xda, 0xaf, 0xf0, 0xcf, 0xd4, 0x07, 0xff, 0x0e, 0x00, 0x1d, 0xda, 0xe8, 0x99, 0x27, 0x0b, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -509,6 +765,15 @@ type MsgClient interface {
 	Merge(ctx context.Context, in *MsgMerge, opts ...grpc.CallOption) (*MsgMergeResponse, error)
 	// Withdraw withdraws all coin amount of a veNFT.
 	Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
+	// ApproveVoter approves an address to vote, abstain, poke and claim rewards
+	// for a veNFT, or for all veNFTs of the sender.
+	ApproveVoter(ctx context.Context, in *MsgApproveVoter, opts ...grpc.CallOption) (*MsgApproveVoterResponse, error)
+	// RevokeVoter revokes the voter approval for a veNFT, or the operator
+	// approval for all veNFTs of the sender.
+	RevokeVoter(ctx context.Context, in *MsgRevokeVoter, opts ...grpc.CallOption) (*MsgRevokeVoterResponse, error)
+	// SetAutoCompound enables or disables auto-compounding of the claimed
+	// rewards into the lock of a veNFT.
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
 }
 
 type msgClient struct {
@@ -564,6 +829,33 @@ func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) ApproveVoter(ctx context.Context, in *MsgApproveVoter, opts ...grpc.CallOption) (*MsgApproveVoterResponse, error) {
+	out := new(MsgApproveVoterResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.ve.v1.Msg/ApproveVoter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeVoter(ctx context.Context, in *MsgRevokeVoter, opts ...grpc.CallOption) (*MsgRevokeVoterResponse, error) {
+	out := new(MsgRevokeVoterResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.ve.v1.Msg/RevokeVoter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.ve.v1.Msg/SetAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Create creates a veNFT.
@@ -576,6 +868,15 @@ type MsgServer interface {
 	Merge(context.Context, *MsgMerge) (*MsgMergeResponse, error)
 	// Withdraw withdraws all coin amount of a veNFT.
 	Withdraw(context.Context, *MsgWithdraw) (*MsgWithdrawResponse, error)
+	// ApproveVoter approves an address to vote, abstain, poke and claim rewards
+	// for a veNFT, or for all veNFTs of the sender.
+	ApproveVoter(context.Context, *MsgApproveVoter) (*MsgApproveVoterResponse, error)
+	// RevokeVoter revokes the voter approval for a veNFT, or the operator
+	// approval for all veNFTs of the sender.
+	RevokeVoter(context.Context, *MsgRevokeVoter) (*MsgRevokeVoterResponse, error)
+	// SetAutoCompound enables or disables auto-compounding of the claimed
+	// rewards into the lock of a veNFT.
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -597,6 +898,15 @@ func (*UnimplementedMsgServer) Merge(ctx context.Context, req *MsgMerge) (*MsgMe
 func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdraw) (*MsgWithdrawResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
 }
+func (*UnimplementedMsgServer) ApproveVoter(ctx context.Context, req *MsgApproveVoter) (*MsgApproveVoterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveVoter not implemented")
+}
+func (*UnimplementedMsgServer) RevokeVoter(ctx context.Context, req *MsgRevokeVoter) (*MsgRevokeVoterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeVoter not implemented")
+}
+func (*UnimplementedMsgServer) SetAutoCompound(ctx context.Context, req *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -692,6 +1002,60 @@ func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ApproveVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveVoter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveVoter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.ve.v1.Msg/ApproveVoter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveVoter(ctx, req.(*MsgApproveVoter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeVoter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeVoter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.ve.v1.Msg/RevokeVoter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeVoter(ctx, req.(*MsgRevokeVoter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.ve.v1.Msg/SetAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "blackfury.ve.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -716,6 +1080,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Withdraw",
 			Handler:    _Msg_Withdraw_Handler,
 		},
+		{
+			MethodName: "ApproveVoter",
+			Handler:    _Msg_ApproveVoter_Handler,
+		},
+		{
+			MethodName: "RevokeVoter",
+			Handler:    _Msg_RevokeVoter_Handler,
+		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "blackfury/ve/v1/tx.proto",
@@ -1070,45 +1446,259 @@ func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgApproveVoter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgApproveVoter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveVoter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.LockDuration != 0 {
-		n += 1 + sovTx(uint64(m.LockDuration))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgApproveVoterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveVoterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveVoterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeVoter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeVoter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeVoter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeVoterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeVoterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeVoterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompoundRewards {
+		i--
+		if m.CompoundRewards {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.LockDuration != 0 {
+		n += 1 + sovTx(uint64(m.LockDuration))
+	}
+	return n
+}
+
+func (m *MsgCreateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = len(m.VeId)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
@@ -1220,25 +1810,764 @@ func (m *MsgWithdraw) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgApproveVoter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgApproveVoterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeVoter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeVoterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.CompoundRewards {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
+			}
+			m.LockDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			m.UnlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtendTime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtendTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtendTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
+			}
+			m.LockDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtendTimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtendTimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtendTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgWithdrawResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreate) Unmarshal(dAtA []byte) error {
+func (m *MsgMerge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1261,10 +2590,10 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMerge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMerge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1301,7 +2630,7 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1329,13 +2658,13 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.FromVeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVeId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1345,44 +2674,24 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ToVeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
-			}
-			m.LockDuration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LockDuration |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1404,7 +2713,7 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMergeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1427,63 +2736,12 @@ func (m *MsgCreateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMergeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMergeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VeId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
-			}
-			m.UnlockTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnlockTime |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1505,7 +2763,7 @@ func (m *MsgCreateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1528,10 +2786,10 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1598,39 +2856,6 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			}
 			m.VeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1652,7 +2877,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1675,10 +2900,10 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1702,7 +2927,7 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExtendTime) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveVoter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1725,10 +2950,10 @@ func (m *MsgExtendTime) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExtendTime: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveVoter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExtendTime: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveVoter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1796,10 +3021,10 @@ func (m *MsgExtendTime) Unmarshal(dAtA []byte) error {
 			m.VeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
-			m.LockDuration = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1809,11 +3034,24 @@ func (m *MsgExtendTime) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LockDuration |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1835,7 +3073,7 @@ func (m *MsgExtendTime) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExtendTimeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveVoterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1858,10 +3096,10 @@ func (m *MsgExtendTimeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExtendTimeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveVoterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExtendTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveVoterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1885,7 +3123,7 @@ func (m *MsgExtendTimeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMerge) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeVoter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1908,10 +3146,10 @@ func (m *MsgMerge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMerge: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeVoter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMerge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeVoter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1948,7 +3186,7 @@ func (m *MsgMerge) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromVeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1976,11 +3214,11 @@ func (m *MsgMerge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromVeId = string(dAtA[iNdEx:postIndex])
+			m.VeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToVeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2008,7 +3246,7 @@ func (m *MsgMerge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToVeId = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2031,7 +3269,7 @@ func (m *MsgMerge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMergeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeVoterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2054,10 +3292,10 @@ func (m *MsgMergeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMergeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeVoterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMergeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeVoterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2081,7 +3319,7 @@ func (m *MsgMergeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoCompound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2104,10 +3342,10 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoCompound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2174,6 +3412,46 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 			}
 			m.VeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompoundRewards", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CompoundRewards = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2195,7 +3473,7 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoCompoundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2218,10 +3496,10 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -133,35 +133,249 @@ func (m *Checkpoint) GetBlock() int64 {
 	return 0
 }
 
+// VeNftData is the data of a veNFT stored in the nft module, which is kept in
+// sync on every lock change.
+type VeNftData struct {
+	// locked amount and unlock time
+	Locked LockedBalance `protobuf:"bytes,1,opt,name=locked,proto3" json:"locked"`
+	// voting power checkpoint as of the last lock change
+	Point Checkpoint `protobuf:"bytes,2,opt,name=point,proto3" json:"point"`
+}
+
+func (m *VeNftData) Reset()         { *m = VeNftData{} }
+func (m *VeNftData) String() string { return proto.CompactTextString(m) }
+func (*VeNftData) ProtoMessage()    {}
+func (*VeNftData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ac702c4be0a44ba, []int{2}
+}
+func (m *VeNftData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VeNftData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VeNftData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VeNftData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VeNftData.Merge(m, src)
+}
+func (m *VeNftData) XXX_Size() int {
+	return m.Size()
+}
+func (m *VeNftData) XXX_DiscardUnknown() {
+	xxx_messageInfo_VeNftData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VeNftData proto.InternalMessageInfo
+
+func (m *VeNftData) GetLocked() LockedBalance {
+	if m != nil {
+		return m.Locked
+	}
+	return LockedBalance{}
+}
+
+func (m *VeNftData) GetPoint() Checkpoint {
+	if m != nil {
+		return m.Point
+	}
+	return Checkpoint{}
+}
+
+// VeNftMetadata represents the dynamic metadata of a veNFT.
+type VeNftMetadata struct {
+	// ve id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner address
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// locked amount and unlock time
+	Locked LockedBalance `protobuf:"bytes,3,opt,name=locked,proto3" json:"locked"`
+	// current voting power
+	VotingPower github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=voting_power,json=votingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"voting_power"`
+	// locked amount delegated for staking
+	DelegatedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=delegated_amount,json=delegatedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delegated_amount"`
+	// whether the ve has voted
+	Voted bool `protobuf:"varint,6,opt,name=voted,proto3" json:"voted,omitempty"`
+	// attached times of the ve
+	Attached uint64 `protobuf:"varint,7,opt,name=attached,proto3" json:"attached,omitempty"`
+}
+
+func (m *VeNftMetadata) Reset()         { *m = VeNftMetadata{} }
+func (m *VeNftMetadata) String() string { return proto.CompactTextString(m) }
+func (*VeNftMetadata) ProtoMessage()    {}
+func (*VeNftMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ac702c4be0a44ba, []int{3}
+}
+func (m *VeNftMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VeNftMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VeNftMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VeNftMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VeNftMetadata.Merge(m, src)
+}
+func (m *VeNftMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *VeNftMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_VeNftMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VeNftMetadata proto.InternalMessageInfo
+
+func (m *VeNftMetadata) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *VeNftMetadata) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *VeNftMetadata) GetLocked() LockedBalance {
+	if m != nil {
+		return m.Locked
+	}
+	return LockedBalance{}
+}
+
+func (m *VeNftMetadata) GetVoted() bool {
+	if m != nil {
+		return m.Voted
+	}
+	return false
+}
+
+func (m *VeNftMetadata) GetAttached() uint64 {
+	if m != nil {
+		return m.Attached
+	}
+	return 0
+}
+
+// AutoCompound represents the auto-compounding setting of a ve.
+type AutoCompound struct {
+	VeId string `protobuf:"bytes,1,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	// whether to also compound the gauge and bribe rewards in the lock denom,
+	// besides the distribution rebases
+	CompoundRewards bool `protobuf:"varint,2,opt,name=compound_rewards,json=compoundRewards,proto3" json:"compound_rewards,omitempty"`
+}
+
+func (m *AutoCompound) Reset()         { *m = AutoCompound{} }
+func (m *AutoCompound) String() string { return proto.CompactTextString(m) }
+func (*AutoCompound) ProtoMessage()    {}
+func (*AutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ac702c4be0a44ba, []int{4}
+}
+func (m *AutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompound.Merge(m, src)
+}
+func (m *AutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompound proto.InternalMessageInfo
+
+func (m *AutoCompound) GetVeId() string {
+	if m != nil {
+		return m.VeId
+	}
+	return ""
+}
+
+func (m *AutoCompound) GetCompoundRewards() bool {
+	if m != nil {
+		return m.CompoundRewards
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*LockedBalance)(nil), "blackfury.ve.v1.LockedBalance")
 	proto.RegisterType((*Checkpoint)(nil), "blackfury.ve.v1.Checkpoint")
+	proto.RegisterType((*VeNftData)(nil), "blackfury.ve.v1.VeNftData")
+	proto.RegisterType((*VeNftMetadata)(nil), "blackfury.ve.v1.VeNftMetadata")
+	proto.RegisterType((*AutoCompound)(nil), "blackfury.ve.v1.AutoCompound")
 }
 
 func init() { proto.RegisterFile("blackfury/ve/v1/ve.proto", fileDescriptor_5ac702c4be0a44ba) }
 
 var fileDescriptor_5ac702c4be0a44ba = []byte{
-	// 317 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xcf, 0x4a, 0x33, 0x31,
-	0x14, 0xc5, 0x27, 0x5f, 0xff, 0x40, 0x03, 0x1f, 0x4a, 0xe8, 0x62, 0x28, 0x92, 0x96, 0x2e, 0xa4,
-	0x1b, 0x13, 0x8a, 0x6f, 0x30, 0x8a, 0x28, 0xb8, 0xea, 0xd2, 0x5d, 0x92, 0xc6, 0x36, 0xcc, 0x4c,
-	0xee, 0xd0, 0x64, 0x06, 0xfb, 0x16, 0x3e, 0x56, 0x71, 0xd5, 0xa5, 0xb8, 0x28, 0xd2, 0xbe, 0x88,
-	0x4c, 0xa6, 0x58, 0xb7, 0xba, 0xca, 0x4d, 0x4e, 0x7e, 0xf7, 0x1c, 0x38, 0x38, 0x96, 0x99, 0x50,
-	0xe9, 0x73, 0xb9, 0x5a, 0xf3, 0x4a, 0xf3, 0x6a, 0xca, 0x2b, 0xcd, 0x8a, 0x15, 0x78, 0x20, 0x67,
-	0xdf, 0x0a, 0xab, 0x34, 0xab, 0xa6, 0x83, 0xfe, 0x02, 0x16, 0x10, 0x34, 0x5e, 0x4f, 0xcd, 0xb7,
-	0x01, 0x55, 0xe0, 0x72, 0x70, 0x5c, 0x0a, 0x57, 0xf3, 0x52, 0x7b, 0x31, 0xe5, 0x0a, 0x8c, 0x6d,
-	0xf4, 0xb1, 0xc1, 0xff, 0x1f, 0x41, 0xa5, 0x7a, 0x9e, 0x88, 0x4c, 0x58, 0xa5, 0xc9, 0x1d, 0xee,
-	0x8a, 0x1c, 0x4a, 0xeb, 0x63, 0x34, 0x42, 0x93, 0x5e, 0xc2, 0x36, 0xbb, 0x61, 0xf4, 0xb1, 0x1b,
-	0x5e, 0x2e, 0x8c, 0x5f, 0x96, 0x92, 0x29, 0xc8, 0xf9, 0x71, 0x67, 0x73, 0x5c, 0xb9, 0x79, 0xca,
-	0xfd, 0xba, 0xd0, 0x8e, 0x3d, 0x58, 0x3f, 0x3b, 0xd2, 0xe4, 0x1c, 0xb7, 0xb4, 0x9d, 0xc7, 0xff,
-	0x46, 0x68, 0xd2, 0x9e, 0xd5, 0xe3, 0xf8, 0x0d, 0x61, 0x7c, 0xb3, 0xd4, 0x2a, 0x2d, 0xc0, 0x58,
-	0x4f, 0x12, 0xdc, 0x96, 0x46, 0xb8, 0x3f, 0xda, 0x04, 0x96, 0xdc, 0xe2, 0x8e, 0xcb, 0xa0, 0xd0,
-	0xc1, 0xe6, 0xf7, 0x4b, 0x1a, 0x98, 0x5c, 0xe0, 0x9e, 0x37, 0xb9, 0x76, 0x5e, 0xe4, 0x45, 0xdc,
-	0x0a, 0x81, 0x4f, 0x0f, 0xa4, 0x8f, 0x3b, 0x32, 0x03, 0x95, 0xc6, 0xed, 0x11, 0x9a, 0xb4, 0x66,
-	0xcd, 0x25, 0xb9, 0xdf, 0xec, 0x29, 0xda, 0xee, 0x29, 0xfa, 0xdc, 0x53, 0xf4, 0x7a, 0xa0, 0xd1,
-	0xf6, 0x40, 0xa3, 0xf7, 0x03, 0x8d, 0x9e, 0xd8, 0x0f, 0x73, 0x9d, 0xad, 0x9d, 0x29, 0x73, 0xe7,
-	0x85, 0x37, 0x60, 0xf9, 0xa9, 0xcc, 0x97, 0xba, 0xce, 0x10, 0x44, 0x76, 0x43, 0x11, 0xd7, 0x5f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x4d, 0x68, 0x7d, 0xa2, 0xeb, 0x01, 0x00, 0x00,
+	// 534 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xbd, 0x8e, 0xd3, 0x4c,
+	0x14, 0x8d, 0x13, 0x3b, 0x5f, 0x72, 0xb3, 0xfb, 0x25, 0x1a, 0x52, 0x58, 0x01, 0x79, 0xa3, 0x14,
+	0x28, 0x14, 0xd8, 0xca, 0x52, 0xd0, 0xd0, 0x6c, 0x76, 0x85, 0x58, 0x09, 0x56, 0xe0, 0x02, 0x09,
+	0x9a, 0x68, 0xec, 0xb9, 0x9b, 0x58, 0xb1, 0x3d, 0x96, 0x3d, 0x76, 0x48, 0xcb, 0x13, 0xf0, 0x58,
+	0x2b, 0xaa, 0x95, 0x68, 0x10, 0xc5, 0x0a, 0x25, 0x2f, 0x82, 0x3c, 0x63, 0x92, 0x05, 0xba, 0x54,
+	0x9e, 0xfb, 0x73, 0x8e, 0xcf, 0x3d, 0x33, 0x17, 0x4c, 0x2f, 0xa4, 0xfe, 0xf2, 0x3a, 0x4f, 0xd7,
+	0x4e, 0x81, 0x4e, 0x31, 0x71, 0x0a, 0xb4, 0x93, 0x94, 0x0b, 0x4e, 0xba, 0xbb, 0x8a, 0x5d, 0xa0,
+	0x5d, 0x4c, 0x06, 0xfd, 0x39, 0x9f, 0x73, 0x59, 0x73, 0xca, 0x93, 0x6a, 0x1b, 0x58, 0x3e, 0xcf,
+	0x22, 0x9e, 0x39, 0x1e, 0xcd, 0x4a, 0xbc, 0x87, 0x82, 0x4e, 0x1c, 0x9f, 0x07, 0xb1, 0xaa, 0x8f,
+	0x02, 0x38, 0x7e, 0xcd, 0xfd, 0x25, 0xb2, 0x29, 0x0d, 0x69, 0xec, 0x23, 0x79, 0x09, 0x4d, 0x1a,
+	0xf1, 0x3c, 0x16, 0xa6, 0x36, 0xd4, 0xc6, 0xed, 0xa9, 0x7d, 0x73, 0x77, 0x52, 0xfb, 0x71, 0x77,
+	0xf2, 0x78, 0x1e, 0x88, 0x45, 0xee, 0xd9, 0x3e, 0x8f, 0x9c, 0x8a, 0x53, 0x7d, 0x9e, 0x66, 0x6c,
+	0xe9, 0x88, 0x75, 0x82, 0x99, 0x7d, 0x19, 0x0b, 0xb7, 0x42, 0x93, 0x1e, 0x34, 0x30, 0x66, 0x66,
+	0x7d, 0xa8, 0x8d, 0x75, 0xb7, 0x3c, 0x8e, 0xbe, 0x6a, 0x00, 0xe7, 0x0b, 0xf4, 0x97, 0x09, 0x0f,
+	0x62, 0x41, 0xa6, 0xa0, 0x7b, 0x01, 0xcd, 0x0e, 0xfc, 0x8d, 0xc4, 0x92, 0x0b, 0x30, 0xb2, 0x90,
+	0x27, 0x68, 0xd6, 0x0f, 0x22, 0x51, 0x60, 0xf2, 0x08, 0xda, 0x22, 0x88, 0x30, 0x13, 0x34, 0x4a,
+	0xcc, 0x86, 0x14, 0xbc, 0x4f, 0x90, 0x3e, 0x18, 0x5e, 0xc8, 0xfd, 0xa5, 0xa9, 0x0f, 0xb5, 0x71,
+	0xc3, 0x55, 0xc1, 0xe8, 0xb3, 0x06, 0xed, 0xf7, 0x78, 0x75, 0x2d, 0x2e, 0xa8, 0xa0, 0xe4, 0x05,
+	0x34, 0x43, 0xe9, 0xa2, 0x9c, 0xa6, 0x73, 0x6a, 0xd9, 0x7f, 0xdd, 0x8e, 0xfd, 0x87, 0xc9, 0x53,
+	0xbd, 0x14, 0xea, 0x56, 0x18, 0xf2, 0x1c, 0x0c, 0x69, 0x89, 0x9c, 0xa2, 0x73, 0xfa, 0xf0, 0x1f,
+	0xf0, 0xde, 0xb5, 0x0a, 0xa9, 0xfa, 0x47, 0xdf, 0xea, 0x70, 0x2c, 0x45, 0xbc, 0x41, 0x41, 0x59,
+	0x29, 0xe4, 0x7f, 0xa8, 0x07, 0x4a, 0x44, 0xdb, 0xad, 0x07, 0xac, 0x14, 0xcf, 0x57, 0x31, 0xa6,
+	0xca, 0x20, 0x57, 0x05, 0xf7, 0xe4, 0x36, 0x0e, 0x90, 0xfb, 0x0e, 0x8e, 0x0a, 0x2e, 0x82, 0x78,
+	0x3e, 0x4b, 0xf8, 0x0a, 0x53, 0x53, 0x3f, 0xc8, 0xfb, 0x8e, 0xe2, 0x78, 0x5b, 0x52, 0x90, 0x0f,
+	0xd0, 0x63, 0x18, 0xe2, 0x9c, 0x0a, 0x64, 0xb3, 0xea, 0xf9, 0x19, 0x07, 0xd1, 0x76, 0x77, 0x3c,
+	0x67, 0xea, 0x1d, 0xf6, 0xc1, 0x28, 0xb8, 0x40, 0x66, 0x36, 0x87, 0xda, 0xb8, 0xe5, 0xaa, 0x80,
+	0x0c, 0xa0, 0x45, 0x85, 0xa0, 0xfe, 0x02, 0x99, 0xf9, 0x9f, 0xbc, 0xf1, 0x5d, 0x3c, 0xba, 0x82,
+	0xa3, 0xb3, 0x5c, 0xf0, 0x73, 0x1e, 0x25, 0x3c, 0x8f, 0x19, 0x79, 0x00, 0x46, 0x81, 0xb3, 0x9d,
+	0xad, 0x7a, 0x81, 0x97, 0x8c, 0x3c, 0x81, 0x9e, 0x5f, 0x35, 0xcc, 0x52, 0x5c, 0xd1, 0x94, 0x65,
+	0xd2, 0xe3, 0x96, 0xdb, 0xfd, 0x9d, 0x77, 0x55, 0x7a, 0xfa, 0xea, 0x66, 0x63, 0x69, 0xb7, 0x1b,
+	0x4b, 0xfb, 0xb9, 0xb1, 0xb4, 0x2f, 0x5b, 0xab, 0x76, 0xbb, 0xb5, 0x6a, 0xdf, 0xb7, 0x56, 0xed,
+	0xa3, 0x7d, 0x6f, 0x28, 0x0c, 0xd7, 0x59, 0x90, 0x47, 0x99, 0xa0, 0x22, 0xe0, 0xb1, 0xb3, 0xdf,
+	0xfb, 0x4f, 0xe5, 0xe6, 0xcb, 0x01, 0xbd, 0xa6, 0xdc, 0xd9, 0x67, 0xbf, 0x06, 0x00, 0xf1, 0x25,
+	0x3c, 0xf8, 0x16, 0x04, 0x00, 0x00,
 }
 
 func (m *LockedBalance) Marshal() (dAtA []byte, err error) {
@@ -255,6 +469,171 @@ func (m *Checkpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VeNftData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VeNftData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VeNftData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Point.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVe(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Locked.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVe(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *VeNftMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VeNftMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VeNftMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attached != 0 {
+		i = encodeVarintVe(dAtA, i, uint64(m.Attached))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Voted {
+		i--
+		if m.Voted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.DelegatedAmount.Size()
+		i -= size
+		if _, err := m.DelegatedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVe(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.VotingPower.Size()
+		i -= size
+		if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVe(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Locked.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVe(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintVe(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintVe(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompoundRewards {
+		i--
+		if m.CompoundRewards {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintVe(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVe(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVe(v)
 	base := offset
@@ -299,6 +678,64 @@ func (m *Checkpoint) Size() (n int) {
 	return n
 }
 
+func (m *VeNftData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locked.Size()
+	n += 1 + l + sovVe(uint64(l))
+	l = m.Point.Size()
+	n += 1 + l + sovVe(uint64(l))
+	return n
+}
+
+func (m *VeNftMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovVe(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovVe(uint64(l))
+	}
+	l = m.Locked.Size()
+	n += 1 + l + sovVe(uint64(l))
+	l = m.VotingPower.Size()
+	n += 1 + l + sovVe(uint64(l))
+	l = m.DelegatedAmount.Size()
+	n += 1 + l + sovVe(uint64(l))
+	if m.Voted {
+		n += 2
+	}
+	if m.Attached != 0 {
+		n += 1 + sovVe(uint64(m.Attached))
+	}
+	return n
+}
+
+func (m *AutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovVe(uint64(l))
+	}
+	if m.CompoundRewards {
+		n += 2
+	}
+	return n
+}
+
 func sovVe(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -564,6 +1001,478 @@ func (m *Checkpoint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VeNftData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVe
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VeNftData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VeNftData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVe
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Locked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Point", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVe
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Point.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVe(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVe
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VeNftMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVe
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VeNftMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VeNftMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVe
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Locked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Voted = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attached", wireType)
+			}
+			m.Attached = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attached |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVe(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVe
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoCompound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVe
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompoundRewards", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CompoundRewards = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVe(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVe
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipVe(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,7 +5,6 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	blackfury "github.com/elysiumstation/blackfury/types"
 	"github.com/elysiumstation/blackfury/x/vesting/keeper"
 	"github.com/elysiumstation/blackfury/x/vesting/types"
 )
@@ -14,7 +13,9 @@ import (
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
-	if blackfury.IsPeriodLastBlock(ctx, types.ClaimVestedPeriod) {
-		k.ClaimVested(ctx)
-	}
+	k.ClaimVested(ctx)
+	k.PayStrategicReservePayouts(ctx)
+
+	k.DropExpiredAirdrops(ctx)
+	k.SweepMerkleAirdrops(ctx)
 }
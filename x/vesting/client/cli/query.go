@@ -22,6 +22,11 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryAirdropTotals())
+	cmd.AddCommand(CmdQueryVestingBuckets())
+	cmd.AddCommand(CmdQueryVestingBucket())
+	cmd.AddCommand(CmdQueryStrategicReserve())
+	cmd.AddCommand(CmdQueryStrategicReservePayouts())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -50,3 +55,144 @@ func CmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryVestingBuckets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vesting-buckets",
+		Short: "Query all vesting buckets with their vested, unvested and claimed amounts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VestingBuckets(context.Background(), &types.QueryVestingBucketsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "vesting-buckets")
+
+	return cmd
+}
+
+func CmdQueryVestingBucket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vesting-bucket [name]",
+		Short: "Query a vesting bucket with its vested, unvested and claimed amounts",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VestingBucket(context.Background(), &types.QueryVestingBucketRequest{Name: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryStrategicReserve() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "strategic-reserve",
+		Short: "Query the balance of the strategic reserve pool",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StrategicReserve(context.Background(), &types.QueryStrategicReserveRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryStrategicReservePayouts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "strategic-reserve-payouts",
+		Short: "Query the payout history of the strategic reserve pool",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			ongoing, err := cmd.Flags().GetBool(FlagOngoing)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.StrategicReservePayouts(context.Background(), &types.QueryStrategicReservePayoutsRequest{
+				Ongoing:    ongoing,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(FlagOngoing, false, "only query the ongoing streaming payouts")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "strategic-reserve-payouts")
+
+	return cmd
+}
+
+func CmdQueryAirdropTotals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "airdrop-totals",
+		Short: "Query the airdrop totals against the cap",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AirdropTotals(context.Background(), &types.QueryAirdropTotalsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
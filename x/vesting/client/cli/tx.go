@@ -2,12 +2,25 @@ package cli
 
 import (
 	"fmt"
+	"io/ioutil"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/elysiumstation/blackfury/x/vesting/types"
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagDestinationModule = "destination-module"
+	FlagDestinationAddr   = "destination-addr"
+	FlagOngoing           = "ongoing"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -18,5 +31,257 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(CmdFundStrategicReserve())
+
+	return cmd
+}
+
+func CmdFundStrategicReserve() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-strategic-reserve [amount]",
+		Short: "Fund the strategic reserve pool with the specified amount",
+		Long: strings.TrimSpace(
+			`Fund the strategic reserve pool with the specified amount.
+The strategic reserve custodian migrates the funds it holds into the pool by this command.`,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgFundStrategicReserve{
+				Sender: clientCtx.GetFromAddress().String(),
+				Amount: amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewAddVestingBucketProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-vesting-bucket [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an add vesting bucket proposal",
+		Long: strings.TrimSpace(
+			`Submit an add vesting bucket proposal along with an initial deposit.
+The bucket is funded from the community pool when the proposal passes.
+The bucket details must be supplied via a JSON file.
+
+Example:
+$ blackfuryd tx gov submit-proposal add-vesting-bucket <path/to/bucket.json> --from=<key_or_address>
+
+Where bucket.json contains:
+
+{
+  "name": "ecosystem_grants",
+  "amount": "1000000000000000000000000",
+  "cliff": "31536000",
+  "duration": "126144000",
+  "destination_addr": "did:fury:black1...",
+  "claim_period": "100"
+}`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			title, description, deposit, err := getProposalArgs(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var content types.AddVestingBucketProposal
+			if err = clientCtx.Codec.UnmarshalJSON(bz, &content); err != nil {
+				return err
+			}
+			content.Title = title
+			content.Description = description
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalTxFlagsToCmd(cmd)
+
 	return cmd
 }
+
+func NewSetVestingBucketDestinationProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-vesting-bucket-destination [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to redirect the vested coins of a vesting bucket",
+		Long: strings.TrimSpace(
+			`Submit a proposal to redirect the vested coins of a vesting bucket along with an initial deposit.
+Exactly one of the destination module and the destination address must be given.
+The distribution module as the destination means the community pool.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			title, description, deposit, err := getProposalArgs(cmd)
+			if err != nil {
+				return err
+			}
+
+			destModule, err := cmd.Flags().GetString(FlagDestinationModule)
+			if err != nil {
+				return err
+			}
+			destAddr, err := cmd.Flags().GetString(FlagDestinationAddr)
+			if err != nil {
+				return err
+			}
+
+			content := &types.SetVestingBucketDestinationProposal{
+				Title:             title,
+				Description:       description,
+				Name:              args[0],
+				DestinationModule: destModule,
+				DestinationAddr:   destAddr,
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDestinationModule, "", "name of the destination module")
+	cmd.Flags().String(FlagDestinationAddr, "", "destination address")
+	addProposalTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewStrategicReserveSpendProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "strategic-reserve-spend [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a strategic reserve spend proposal",
+		Long: strings.TrimSpace(
+			`Submit a strategic reserve spend proposal along with an initial deposit.
+The amount is paid at once, or streamed linearly over the duration in seconds if nonzero.
+The spend details must be supplied via a JSON file.
+
+Example:
+$ blackfuryd tx gov submit-proposal strategic-reserve-spend <path/to/spend.json> --from=<key_or_address>
+
+Where spend.json contains:
+
+{
+  "recipient": "did:fury:black1...",
+  "amount": [{"denom": "afury", "amount": "1000000000000000000000"}],
+  "duration": "31536000"
+}`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			title, description, deposit, err := getProposalArgs(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var content types.StrategicReserveSpendProposal
+			if err = clientCtx.Codec.UnmarshalJSON(bz, &content); err != nil {
+				return err
+			}
+			content.Title = title
+			content.Description = description
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getProposalArgs(cmd *cobra.Command) (title, description string, deposit sdk.Coins, err error) {
+	title, err = cmd.Flags().GetString(cli.FlagTitle)
+	if err != nil {
+		return
+	}
+
+	description, err = cmd.Flags().GetString(cli.FlagDescription)
+	if err != nil {
+		return
+	}
+
+	depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+	if err != nil {
+		return
+	}
+
+	deposit, err = sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func addProposalTxFlagsToCmd(cmd *cobra.Command) {
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1ufury", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+}
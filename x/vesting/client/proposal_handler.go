@@ -0,0 +1,13 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/elysiumstation/blackfury/x/vesting/client/cli"
+	"github.com/elysiumstation/blackfury/x/vesting/client/rest"
+)
+
+var (
+	AddVestingBucketProposalHandler            = govclient.NewProposalHandler(cli.NewAddVestingBucketProposalCmd, rest.AddVestingBucketProposalRESTHandler)
+	SetVestingBucketDestinationProposalHandler = govclient.NewProposalHandler(cli.NewSetVestingBucketDestinationProposalCmd, rest.SetVestingBucketDestinationProposalRESTHandler)
+	StrategicReserveSpendProposalHandler       = govclient.NewProposalHandler(cli.NewStrategicReserveSpendProposalCmd, rest.StrategicReserveSpendProposalRESTHandler)
+)
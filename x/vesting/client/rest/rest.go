@@ -0,0 +1,177 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/elysiumstation/blackfury/x/vesting/types"
+)
+
+type AddVestingBucketProposalRequest struct {
+	BaseReq           rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title             string       `json:"title" yaml:"title"`
+	Description       string       `json:"description" yaml:"description"`
+	Deposit           sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Name              string       `json:"name" yaml:"name"`
+	Amount            sdk.Int      `json:"amount" yaml:"amount"`
+	Cliff             uint64       `json:"cliff" yaml:"cliff"`
+	Duration          uint64       `json:"duration" yaml:"duration"`
+	DestinationModule string       `json:"destination_module" yaml:"destination_module"`
+	DestinationAddr   string       `json:"destination_addr" yaml:"destination_addr"`
+	ClaimPeriod       uint64       `json:"claim_period" yaml:"claim_period"`
+}
+
+type SetVestingBucketDestinationProposalRequest struct {
+	BaseReq           rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title             string       `json:"title" yaml:"title"`
+	Description       string       `json:"description" yaml:"description"`
+	Deposit           sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Name              string       `json:"name" yaml:"name"`
+	DestinationModule string       `json:"destination_module" yaml:"destination_module"`
+	DestinationAddr   string       `json:"destination_addr" yaml:"destination_addr"`
+}
+
+type StrategicReserveSpendProposalRequest struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Recipient   string       `json:"recipient" yaml:"recipient"`
+	Amount      sdk.Coins    `json:"amount" yaml:"amount"`
+	Duration    uint64       `json:"duration" yaml:"duration"`
+}
+
+func AddVestingBucketProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "add_vesting_bucket",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req AddVestingBucketProposalRequest
+
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			req.BaseReq = req.BaseReq.Sanitize()
+			if !req.BaseReq.ValidateBasic(w) {
+				return
+			}
+
+			from, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+
+			content := &types.AddVestingBucketProposal{
+				Title:             req.Title,
+				Description:       req.Description,
+				Name:              req.Name,
+				Amount:            req.Amount,
+				Cliff:             req.Cliff,
+				Duration:          req.Duration,
+				DestinationModule: req.DestinationModule,
+				DestinationAddr:   req.DestinationAddr,
+				ClaimPeriod:       req.ClaimPeriod,
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, from)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+
+			if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+				return
+			}
+
+			tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+		},
+	}
+}
+
+func SetVestingBucketDestinationProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "set_vesting_bucket_destination",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req SetVestingBucketDestinationProposalRequest
+
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			req.BaseReq = req.BaseReq.Sanitize()
+			if !req.BaseReq.ValidateBasic(w) {
+				return
+			}
+
+			from, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+
+			content := &types.SetVestingBucketDestinationProposal{
+				Title:             req.Title,
+				Description:       req.Description,
+				Name:              req.Name,
+				DestinationModule: req.DestinationModule,
+				DestinationAddr:   req.DestinationAddr,
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, from)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+
+			if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+				return
+			}
+
+			tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+		},
+	}
+}
+
+func StrategicReserveSpendProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "strategic_reserve_spend",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req StrategicReserveSpendProposalRequest
+
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			req.BaseReq = req.BaseReq.Sanitize()
+			if !req.BaseReq.ValidateBasic(w) {
+				return
+			}
+
+			from, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+
+			content := &types.StrategicReserveSpendProposal{
+				Title:       req.Title,
+				Description: req.Description,
+				Recipient:   req.Recipient,
+				Amount:      req.Amount,
+				Duration:    req.Duration,
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, from)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+
+			if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+				return
+			}
+
+			tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+		},
+	}
+}
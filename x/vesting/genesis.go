@@ -28,7 +28,22 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	}
 	k.SetAllocationAddresses(ctx, allocAddresses)
 
-	if ctx.BlockHeight() <= 1 {
+	for _, bucket := range genState.VestingBuckets {
+		k.SetVestingBucket(ctx, bucket)
+	}
+
+	for _, payout := range genState.StrategicReservePayouts {
+		k.SetStrategicReservePayout(ctx, payout)
+		if !payout.Completed() {
+			k.SetOngoingStrategicReservePayout(ctx, payout.Id)
+		}
+	}
+	if genState.NextStrategicReservePayoutId != 0 {
+		k.SetNextStrategicReservePayoutID(ctx, genState.NextStrategicReservePayoutId)
+	}
+
+	// the vesting buckets have been allocated if they are imported
+	if ctx.BlockHeight() <= 1 && len(genState.VestingBuckets) == 0 {
 		k.AllocateAtGenesis(ctx, genState)
 	}
 }
@@ -39,6 +54,15 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	genesis.Params = k.GetParams(ctx)
 	genesis.AllocationAddresses = k.GetAllocationAddresses(ctx)
+	k.IterateVestingBuckets(ctx, func(bucket types.VestingBucket) (stop bool) {
+		genesis.VestingBuckets = append(genesis.VestingBuckets, bucket)
+		return false
+	})
+	k.IterateStrategicReservePayouts(ctx, func(payout types.StrategicReservePayout) (stop bool) {
+		genesis.StrategicReservePayouts = append(genesis.StrategicReservePayouts, payout)
+		return false
+	})
+	genesis.NextStrategicReservePayoutId = k.GetNextStrategicReservePayoutID(ctx)
 
 	return genesis
 }
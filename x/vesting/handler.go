@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/elysiumstation/blackfury/x/vesting/keeper"
 	"github.com/elysiumstation/blackfury/x/vesting/types"
 )
@@ -21,3 +22,18 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		}
 	}
 }
+
+func NewVestingProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.AddVestingBucketProposal:
+			return keeper.HandleAddVestingBucketProposal(ctx, k, c)
+		case *types.SetVestingBucketDestinationProposal:
+			return keeper.HandleSetVestingBucketDestinationProposal(ctx, k, c)
+		case *types.StrategicReserveSpendProposal:
+			return keeper.HandleStrategicReserveSpendProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/elysiumstation/blackfury/x/vesting/types"
+)
+
+// DropExpiredAirdrops drops the pending airdrops past their expiry height,
+// and releases their amounts from the airdrop total
+func (k Keeper) DropExpiredAirdrops(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.KeyPrefixAirdropExpiryQueue, types.AirdropExpiryQueuePrefix(uint64(ctx.BlockHeight())))
+	var expired []sdk.AccAddress
+	for ; iter.Valid(); iter.Next() {
+		// key: prefix | height | length-prefixed address
+		expired = append(expired, iter.Key()[len(types.AirdropExpiryQueuePrefix(0))+1:])
+	}
+	iter.Close()
+
+	if len(expired) == 0 {
+		return
+	}
+
+	total := k.GetAirdropTotalAmount(ctx)
+	for _, acc := range expired {
+		airdrop := k.GetAirdrop(ctx, acc)
+		k.DeleteAirdrop(ctx, acc)
+		total = total.Sub(airdrop.Amount.Amount)
+	}
+	k.SetAirdropTotalAmount(ctx, total)
+}
+
+// GetAirdropPendingAmount returns the amount and count of the pending airdrops
+func (k Keeper) GetAirdropPendingAmount(ctx sdk.Context) (sdk.Int, uint64) {
+	amount, count := sdk.ZeroInt(), uint64(0)
+	k.IterateAirdrops(ctx, func(airdrop types.Airdrop) (stop bool) {
+		amount = amount.Add(airdrop.Amount.Amount)
+		count++
+		return false
+	})
+	return amount, count
+}
+
+// validateAirdrop checks that the airdrop can be delivered to its target when executed
+func (k Keeper) validateAirdrop(ctx sdk.Context, airdrop types.Airdrop) error {
+	if err := airdrop.ValidateDelivery(); err != nil {
+		return err
+	}
+	if airdrop.ExpiryHeight != 0 && airdrop.ExpiryHeight < uint64(ctx.BlockHeight()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "airdrop expiry height %d has passed", airdrop.ExpiryHeight)
+	}
+
+	targetAddr := airdrop.GetTargetAddr()
+	if _, ok := k.accountKeeper.GetAccount(ctx, targetAddr).(authtypes.ModuleAccountI); ok || k.bankKeeper.BlockedAddr(targetAddr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot airdrop to module account %s", targetAddr)
+	}
+
+	switch airdrop.Delivery {
+	case types.AIRDROP_DELIVERY_CONTINUOUS_VESTING:
+		_, err := k.getVestingTargetAccount(ctx, targetAddr)
+		return err
+	case types.AIRDROP_DELIVERY_VE_LOCK:
+		if lockDenom := k.veKeeper.LockDenom(ctx); airdrop.Amount.Denom != lockDenom {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "ve lock airdrop denom should be %s", lockDenom)
+		}
+	}
+	return nil
+}
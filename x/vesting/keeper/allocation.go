@@ -2,20 +2,26 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	blackfury "github.com/elysiumstation/blackfury/types"
 	"github.com/elysiumstation/blackfury/x/vesting/types"
 	ethermint "github.com/tharsis/ethermint/types"
 )
 
 func (k Keeper) AllocateAtGenesis(ctx sdk.Context, genState types.GenesisState) {
-	startTime := ctx.BlockTime().Unix()
 	alloc := genState.Params.Allocation
 
-	k.createContinuousVestingAccount(ctx, types.StakingRewardVestingName, alloc.StakingRewardAmount, startTime, types.StakingRewardVestingTime)
-	k.createContinuousVestingAccount(ctx, types.CommunityPoolVestingName, alloc.CommunityPoolAmount, startTime, types.CommunityPoolVestingTime)
-	k.createContinuousVestingAccount(ctx, types.TeamVestingName, alloc.TeamVestingAmount, startTime, types.TeamVestingTime)
+	// The team vesting is funded to the community pool until the team vesting address is set
+	teamModule, teamAddr := "", genState.AllocationAddresses.TeamVestingAddr
+	if len(teamAddr) == 0 {
+		teamModule = distrtypes.ModuleName
+	}
+	k.createGenesisVestingBucket(ctx, types.StakingRewardVestingName, alloc.StakingRewardAmount, types.StakingRewardVestingTime, k.feeCollectorName, "")
+	k.createGenesisVestingBucket(ctx, types.CommunityPoolVestingName, alloc.CommunityPoolAmount, types.CommunityPoolVestingTime, distrtypes.ModuleName, "")
+	k.createGenesisVestingBucket(ctx, types.TeamVestingName, alloc.TeamVestingAmount, types.TeamVestingTime, teamModule, teamAddr)
 
 	k.veKeeper.AddTotalEmission(ctx, alloc.VeVestingAmount)
 
@@ -24,62 +30,98 @@ func (k Keeper) AllocateAtGenesis(ctx sdk.Context, genState types.GenesisState)
 	if err != nil {
 		panic(err)
 	}
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, genState.AllocationAddresses.GetStrategicReserveCustodianAddr(), sdk.NewCoins(srAmount))
+	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.StrategicReservePoolName, sdk.NewCoins(srAmount))
 	if err != nil {
 		panic(err)
 	}
 }
 
-func (k Keeper) ClaimVested(ctx sdk.Context) {
-	vestedClaim := []struct {
-		vestingName     string
-		destinationName string
-		destinationAddr sdk.AccAddress
-	}{
-		{types.StakingRewardVestingName, k.feeCollectorName, sdk.AccAddress{}},
-		{types.CommunityPoolVestingName, "", sdk.AccAddress{}},
-		{types.TeamVestingName, "", k.GetAllocationAddresses(ctx).GetTeamVestingAddr()},
+func (k Keeper) createGenesisVestingBucket(ctx sdk.Context, name string, amount sdk.Int, duration int64, destModule string, destAddr string) {
+	bucket := types.VestingBucket{
+		Name:              name,
+		Amount:            amount,
+		Duration:          uint64(duration),
+		DestinationModule: destModule,
+		DestinationAddr:   destAddr,
+		ClaimPeriod:       types.ClaimVestedPeriod,
+	}
+	err := k.AddVestingBucket(ctx, bucket)
+	if err != nil {
+		panic(err)
 	}
 
-	for _, claim := range vestedClaim {
-		vestingAddr := k.getVestingAddress(claim.vestingName)
-		spendable := k.bankKeeper.SpendableCoins(ctx, vestingAddr)
-		if !spendable.IsAllPositive() {
-			continue
-		}
+	amt := sdk.NewCoins(sdk.NewCoin(blackfury.BaseDenom, amount))
+	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, amt)
+	if err != nil {
+		panic(err)
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bucket.GetAddress(), amt)
+	if err != nil {
+		panic(err)
+	}
+}
 
-		var err error
-		if len(claim.destinationName) != 0 {
-			err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, vestingAddr, claim.destinationName, spendable)
-		} else if !claim.destinationAddr.Empty() {
-			err = k.bankKeeper.SendCoins(ctx, vestingAddr, claim.destinationAddr, spendable)
-		} else {
-			err = k.distrKeeper.FundCommunityPool(ctx, spendable, vestingAddr)
-		}
+// DeliverAirdrop mints the airdrop amount and delivers it to the target by the airdrop delivery
+func (k Keeper) DeliverAirdrop(ctx sdk.Context, airdrop types.Airdrop) error {
+	targetAddr := airdrop.GetTargetAddr()
+	amount := sdk.NewCoins(airdrop.Amount)
+
+	err := k.bankKeeper.MintCoins(ctx, types.ModuleName, amount)
+	if err != nil {
+		return err
+	}
+
+	switch airdrop.Delivery {
+	case types.AIRDROP_DELIVERY_LIQUID:
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, targetAddr, amount)
+	case types.AIRDROP_DELIVERY_CONTINUOUS_VESTING:
+		err = k.setContinuousVestingAccount(ctx, targetAddr, amount, airdrop.Duration)
 		if err != nil {
-			panic(err)
+			return err
 		}
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, targetAddr, amount)
+	case types.AIRDROP_DELIVERY_VE_LOCK:
+		_, _, err = k.veKeeper.CreateLock(ctx, authtypes.NewModuleAddress(types.ModuleName), targetAddr, airdrop.Amount, airdrop.Duration)
+		return err
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid airdrop delivery %s", airdrop.Delivery)
 	}
 }
 
-func (k Keeper) createContinuousVestingAccount(ctx sdk.Context, vestingName string, amount sdk.Int, startTime int64, duration int64) {
-	baseAccount := k.accountKeeper.NewAccountWithAddress(ctx, k.getVestingAddress(vestingName))
-	amt := sdk.NewCoin(blackfury.BaseDenom, amount)
-	vestingAccount := vestingtypes.NewContinuousVestingAccount(baseAccount.(*ethermint.EthAccount).BaseAccount, sdk.NewCoins(amt), startTime, startTime+duration)
-	k.accountKeeper.SetAccount(ctx, vestingAccount)
-
-	err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(amt))
+// setContinuousVestingAccount turns the account into a continuous vesting account,
+// which vests the amount linearly over the duration from now.
+// Only new accounts and externally owned base accounts can be turned.
+func (k Keeper) setContinuousVestingAccount(ctx sdk.Context, addr sdk.AccAddress, amount sdk.Coins, duration uint64) error {
+	baseAccount, err := k.getVestingTargetAccount(ctx, addr)
 	if err != nil {
-		panic(err)
+		return err
 	}
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, vestingAccount.GetAddress(), sdk.NewCoins(amt))
-	if err != nil {
-		panic(err)
+	if baseAccount == nil {
+		baseAccount = k.accountKeeper.NewAccountWithAddress(ctx, addr).(*ethermint.EthAccount).BaseAccount
 	}
+
+	startTime := ctx.BlockTime().Unix()
+	vestingAccount := vestingtypes.NewContinuousVestingAccount(baseAccount, amount, startTime, startTime+int64(duration))
+	k.accountKeeper.SetAccount(ctx, vestingAccount)
+	return nil
 }
 
-func (k Keeper) getVestingAddress(vestingName string) sdk.AccAddress {
-	return authtypes.NewModuleAddress(vestingName)
+// getVestingTargetAccount returns the base account which can be turned into a vesting account,
+// or nil if the account does not exist yet.
+func (k Keeper) getVestingTargetAccount(ctx sdk.Context, addr sdk.AccAddress) (*authtypes.BaseAccount, error) {
+	switch acc := k.accountKeeper.GetAccount(ctx, addr).(type) {
+	case nil:
+		return nil, nil
+	case *ethermint.EthAccount:
+		if acc.Type() != ethermint.AccountTypeEOA {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot airdrop vesting coins to contract account %s", addr)
+		}
+		return acc.BaseAccount, nil
+	case *authtypes.BaseAccount:
+		return acc, nil
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot airdrop vesting coins to account %s of type %T", addr, acc)
+	}
 }
 
 // SetAllocationAddresses sets allocation target addresses
@@ -120,11 +162,15 @@ func (k Keeper) GetAirdropTotalAmount(ctx sdk.Context) sdk.Int {
 	return total.Int
 }
 
-// SetAirdrop sets airdrop target
+// SetAirdrop sets airdrop target, and queues it by its expiry height if any
 func (k Keeper) SetAirdrop(ctx sdk.Context, acc sdk.AccAddress, airdrop types.Airdrop) {
+	k.dequeueAirdropExpiry(ctx, acc)
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&airdrop)
 	store.Set(types.AirdropsKey(acc), bz)
+	if airdrop.ExpiryHeight != 0 {
+		store.Set(types.AirdropExpiryQueueKey(airdrop.ExpiryHeight, acc), []byte{1})
+	}
 }
 
 // GetAirdrop gets airdrop target
@@ -141,10 +187,18 @@ func (k Keeper) GetAirdrop(ctx sdk.Context, acc sdk.AccAddress) types.Airdrop {
 
 // DeleteAirdrop deletes airdrop target
 func (k Keeper) DeleteAirdrop(ctx sdk.Context, acc sdk.AccAddress) {
+	k.dequeueAirdropExpiry(ctx, acc)
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.AirdropsKey(acc))
 }
 
+func (k Keeper) dequeueAirdropExpiry(ctx sdk.Context, acc sdk.AccAddress) {
+	if airdrop := k.GetAirdrop(ctx, acc); airdrop.ExpiryHeight != 0 {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.AirdropExpiryQueueKey(airdrop.ExpiryHeight, acc))
+	}
+}
+
 // IterateAirdrops iterates airdrop targets
 func (k Keeper) IterateAirdrops(ctx sdk.Context, handler func(airdrop types.Airdrop) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
@@ -191,3 +245,22 @@ func (k Keeper) IterateAirdropsCompleted(ctx sdk.Context, handler func(airdrop t
 		}
 	}
 }
+
+// SetAirdropFailed sets failed airdrop target
+func (k Keeper) SetAirdropFailed(ctx sdk.Context, acc sdk.AccAddress, airdrop types.Airdrop) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&airdrop)
+	store.Set(types.AirdropsFailedKey(acc), bz)
+}
+
+// GetAirdropFailed gets failed airdrop target
+func (k Keeper) GetAirdropFailed(ctx sdk.Context, acc sdk.AccAddress) types.Airdrop {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.AirdropsFailedKey(acc))
+	if bz == nil {
+		return types.Airdrop{}
+	}
+	var airdrop types.Airdrop
+	k.cdc.MustUnmarshal(bz, &airdrop)
+	return airdrop
+}
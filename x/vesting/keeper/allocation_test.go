@@ -1,10 +1,9 @@
 package keeper_test
 
 import (
-	"math/big"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	blacktypes "github.com/elysiumstation/blackfury/types"
 	"github.com/elysiumstation/blackfury/x/vesting/types"
 )
@@ -12,56 +11,41 @@ import (
 func (suite *KeeperTestSuite) TestKeeper_AllocateAtGenesis() {
 	suite.SetupTest()
 	k := suite.app.VestingKeeper
-	receiver := sdk.AccAddress(suite.address.Bytes())
-	denom := blacktypes.BaseDenom
-	amt, _ := new(big.Int).SetString("50000000000000000000000000", 10)
-	amt2, _ := new(big.Int).SetString("200000000000000000000000000", 10)
-	amt3, _ := new(big.Int).SetString("450000000000000000000000000", 10)
-	baseAmt := sdk.NewIntFromBigInt(amt)
-	baseAmt2 := sdk.NewIntFromBigInt(amt2)
-	baseAmt3 := sdk.NewIntFromBigInt(amt3)
-	genState := types.GenesisState{
-		Params: types.Params{
-			Allocation: types.AllocationAmounts{
-				TotalAmount:            sdk.NewInt(10000),
-				AirdropAmount:          sdk.NewInt(10000),
-				VeVestingAmount:        sdk.NewInt(10000),
-				StakingRewardAmount:    sdk.NewInt(10000),
-				CommunityPoolAmount:    sdk.NewInt(10000),
-				StrategicReserveAmount: sdk.NewInt(10000),
-				TeamVestingAmount:      sdk.NewInt(10000),
-			},
-		},
-		AllocationAddresses: types.AllocationAddresses{
-			StrategicReserveCustodianAddr: receiver.String(),
-		},
+	alloc := k.GetParams(suite.ctx).Allocation
+
+	for _, tc := range []struct {
+		name       string
+		amount     sdk.Int
+		destModule string
+	}{
+		{types.StakingRewardVestingName, alloc.StakingRewardAmount, authtypes.FeeCollectorName},
+		{types.CommunityPoolVestingName, alloc.CommunityPoolAmount, distrtypes.ModuleName},
+		// no team vesting address at genesis
+		{types.TeamVestingName, alloc.TeamVestingAmount, distrtypes.ModuleName},
+	} {
+		bucket, found := k.GetVestingBucket(suite.ctx, tc.name)
+		suite.Require().True(found)
+		suite.Require().Equal(tc.amount, bucket.Amount)
+		suite.Require().Equal(uint64(blacktypes.SecondsPer4Years), bucket.Duration)
+		suite.Require().Equal(uint64(types.ClaimVestedPeriod), bucket.ClaimPeriod)
+		suite.Require().Equal(tc.destModule, bucket.DestinationModule)
+		suite.Require().True(bucket.ClaimedAmount.IsZero())
+		suite.Require().Equal(
+			tc.amount,
+			suite.app.BankKeeper.GetBalance(suite.ctx, authtypes.NewModuleAddress(tc.name), blacktypes.BaseDenom).Amount,
+		)
 	}
-	k.AllocateAtGenesis(suite.ctx, genState)
 
-	alloc := genState.Params.Allocation
-	// Check Results
-	suite.Require().Equal(
-		alloc.StakingRewardAmount.Add(baseAmt),
-		suite.app.BankKeeper.GetBalance(suite.ctx,
-			authtypes.NewModuleAddress(types.StakingRewardVestingName), denom).Amount,
-	)
-	suite.Require().Equal(
-		alloc.CommunityPoolAmount.Add(baseAmt),
-		suite.app.BankKeeper.GetBalance(suite.ctx,
-			authtypes.NewModuleAddress(types.CommunityPoolVestingName), denom).Amount,
-	)
-	suite.Require().Equal(
-		alloc.TeamVestingAmount.Add(baseAmt2),
-		suite.app.BankKeeper.GetBalance(suite.ctx,
-			authtypes.NewModuleAddress(types.TeamVestingName), denom).Amount,
-	)
+	// the strategic reserve is held by the pool instead of the custodian
 	suite.Require().Equal(
-		alloc.StrategicReserveAmount.Mul(sdk.NewInt(2)),
-		suite.app.BankKeeper.GetBalance(suite.ctx, receiver, denom).Amount,
+		alloc.StrategicReserveAmount,
+		suite.app.BankKeeper.GetBalance(suite.ctx, authtypes.NewModuleAddress(types.StrategicReservePoolName), blacktypes.BaseDenom).Amount,
 	)
+	custodian := k.GetAllocationAddresses(suite.ctx).GetStrategicReserveCustodianAddr()
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, custodian, blacktypes.BaseDenom).IsZero())
 
 	emission := suite.app.VeKeeper.GetTotalEmission(suite.ctx)
-	suite.Require().Equal(alloc.VeVestingAmount.Add(baseAmt3), emission)
+	suite.Require().Equal(alloc.VeVestingAmount, emission)
 }
 
 func (suite *KeeperTestSuite) TestKeeper_GetAllocationAddresses_SetAllocationAddresses() {
@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	blackfury "github.com/elysiumstation/blackfury/types"
+	"github.com/elysiumstation/blackfury/x/vesting/types"
+)
+
+// AddVestingBucket adds a new vesting bucket starting from now.
+// The caller is responsible for funding the bucket address with the amount.
+func (k Keeper) AddVestingBucket(ctx sdk.Context, bucket types.VestingBucket) error {
+	bucket.StartTime = ctx.BlockTime().Unix()
+	bucket.ClaimedAmount = sdk.ZeroInt()
+	if err := bucket.Validate(); err != nil {
+		return err
+	}
+
+	if _, found := k.GetVestingBucket(ctx, bucket.Name); found {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "vesting bucket %s already exists", bucket.Name)
+	}
+	// The derived address must not collide with any existing account, e.g., a module account of the same name
+	if k.accountKeeper.GetAccount(ctx, bucket.GetAddress()) != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account of vesting bucket %s already exists", bucket.Name)
+	}
+	if err := k.validateDestination(ctx, bucket.DestinationModule, bucket.DestinationAddr); err != nil {
+		return err
+	}
+
+	k.SetVestingBucket(ctx, bucket)
+	return nil
+}
+
+// SetVestingBucketDestination redirects the vested coins of the bucket to the new destination
+func (k Keeper) SetVestingBucketDestination(ctx sdk.Context, name string, destModule string, destAddr string) error {
+	bucket, found := k.GetVestingBucket(ctx, name)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "vesting bucket %s not found", name)
+	}
+	if err := types.ValidateVestingBucketDestination(destModule, destAddr); err != nil {
+		return err
+	}
+	if err := k.validateDestination(ctx, destModule, destAddr); err != nil {
+		return err
+	}
+
+	bucket.DestinationModule = destModule
+	bucket.DestinationAddr = destAddr
+	k.SetVestingBucket(ctx, bucket)
+	return nil
+}
+
+func (k Keeper) validateDestination(ctx sdk.Context, destModule string, destAddr string) error {
+	if len(destModule) != 0 {
+		if k.accountKeeper.GetModuleAddress(destModule) == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", destModule)
+		}
+		return nil
+	}
+	addr, err := sdk.AccAddressFromBech32(destAddr)
+	if err != nil {
+		return err
+	}
+	if k.bankKeeper.BlockedAddr(addr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive vested coins", destAddr)
+	}
+	return nil
+}
+
+// ClaimVested claims the vested coins of every vesting bucket at the last block of its claim period
+func (k Keeper) ClaimVested(ctx sdk.Context) {
+	var buckets []types.VestingBucket
+	k.IterateVestingBuckets(ctx, func(bucket types.VestingBucket) (stop bool) {
+		if blackfury.IsPeriodLastBlock(ctx, bucket.ClaimPeriod) {
+			buckets = append(buckets, bucket)
+		}
+		return false
+	})
+
+	for _, bucket := range buckets {
+		k.claimVestingBucket(ctx, bucket)
+	}
+}
+
+func (k Keeper) claimVestingBucket(ctx sdk.Context, bucket types.VestingBucket) {
+	claimable := bucket.VestedAmount(ctx.BlockTime().Unix()).Sub(bucket.ClaimedAmount)
+	if !claimable.IsPositive() {
+		return
+	}
+
+	bucketAddr := bucket.GetAddress()
+	amount := sdk.NewCoins(sdk.NewCoin(blackfury.BaseDenom, claimable))
+
+	var err error
+	switch {
+	case bucket.DestinationModule == distrtypes.ModuleName:
+		err = k.distrKeeper.FundCommunityPool(ctx, amount, bucketAddr)
+	case len(bucket.DestinationModule) != 0:
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, bucketAddr, bucket.DestinationModule, amount)
+	default:
+		err = k.bankKeeper.SendCoins(ctx, bucketAddr, bucket.GetDestinationAddr(), amount)
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	bucket.ClaimedAmount = bucket.ClaimedAmount.Add(claimable)
+	k.SetVestingBucket(ctx, bucket)
+}
+
+// GetVestingBucketStatus returns the vesting bucket with its amounts at the current block time
+func (k Keeper) GetVestingBucketStatus(ctx sdk.Context, bucket types.VestingBucket) types.VestingBucketStatus {
+	vested := bucket.VestedAmount(ctx.BlockTime().Unix())
+	return types.VestingBucketStatus{
+		Bucket:   bucket,
+		Vested:   vested,
+		Unvested: bucket.Amount.Sub(vested),
+		Claimed:  bucket.ClaimedAmount,
+	}
+}
+
+// SetVestingBucket sets vesting bucket
+func (k Keeper) SetVestingBucket(ctx sdk.Context, bucket types.VestingBucket) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&bucket)
+	store.Set(types.VestingBucketKey(bucket.Name), bz)
+}
+
+// GetVestingBucket gets vesting bucket
+func (k Keeper) GetVestingBucket(ctx sdk.Context, name string) (bucket types.VestingBucket, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.VestingBucketKey(name))
+	if bz == nil {
+		return bucket, false
+	}
+	k.cdc.MustUnmarshal(bz, &bucket)
+	return bucket, true
+}
+
+// IterateVestingBuckets iterates vesting buckets
+func (k Keeper) IterateVestingBuckets(ctx sdk.Context, handler func(bucket types.VestingBucket) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixVestingBuckets)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var bucket types.VestingBucket
+		k.cdc.MustUnmarshal(iter.Value(), &bucket)
+		if handler(bucket) {
+			break
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	blacktypes "github.com/elysiumstation/blackfury/types"
+	"github.com/elysiumstation/blackfury/x/vesting"
+	"github.com/elysiumstation/blackfury/x/vesting/keeper"
+	"github.com/elysiumstation/blackfury/x/vesting/types"
+	"github.com/tharsis/ethermint/tests"
+)
+
+func (suite *KeeperTestSuite) TestVestingBucketProposals() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VestingKeeper
+	handler := vesting.NewVestingProposalHandler(k)
+	ctx := suite.ctx
+
+	funder := sdk.AccAddress(suite.address.Bytes())
+	err := suite.app.DistrKeeper.FundCommunityPool(ctx, sdk.NewCoins(sdk.NewInt64Coin(blacktypes.BaseDenom, 10000)), funder)
+	require.NoError(err)
+
+	addr, _ := tests.NewAddrKey()
+	grantee := sdk.AccAddress(addr.Bytes())
+	proposal := &types.AddVestingBucketProposal{
+		Title:           "grants",
+		Description:     "ecosystem grants",
+		Name:            "ecosystem_grants",
+		Amount:          sdk.NewInt(1000),
+		Cliff:           100,
+		Duration:        1000,
+		DestinationAddr: grantee.String(),
+		ClaimPeriod:     5,
+	}
+	require.NoError(proposal.ValidateBasic())
+	require.NoError(handler(ctx, proposal))
+	bucketAddr := types.VestingBucketAddress(proposal.Name)
+	require.Equal(sdk.NewInt(1000), suite.app.BankKeeper.GetBalance(ctx, bucketAddr, blacktypes.BaseDenom).Amount)
+
+	// duplicate bucket, or bucket colliding with a module account
+	require.Error(handler(ctx, proposal))
+	collision := *proposal
+	collision.Name = authtypes.FeeCollectorName
+	require.Error(handler(ctx, &collision))
+	unknown := *proposal
+	unknown.Name = "unknown_module"
+	unknown.DestinationModule, unknown.DestinationAddr = "xxx", ""
+	require.Error(handler(ctx, &unknown))
+
+	queryBucket := func(ctx sdk.Context) types.VestingBucketStatus {
+		res, err := k.VestingBucket(sdk.WrapSDKContext(ctx), &types.QueryVestingBucketRequest{Name: proposal.Name})
+		require.NoError(err)
+		return res.Bucket
+	}
+	claimAt := func(elapsed int64) sdk.Context {
+		// the last block of the claim period
+		ctx := ctx.WithBlockHeight(int64(proposal.ClaimPeriod)*(ctx.BlockHeight()/int64(proposal.ClaimPeriod)+1) - 1).
+			WithBlockTime(ctx.BlockTime().Add(time.Duration(elapsed) * time.Second))
+		vesting.EndBlocker(ctx, k)
+		return ctx
+	}
+
+	// nothing is vested before the cliff
+	status := queryBucket(claimAt(99))
+	require.True(status.Vested.IsZero())
+	require.Equal(sdk.NewInt(1000), status.Unvested)
+	require.True(status.Claimed.IsZero())
+
+	// vested linearly after the cliff, and claimed to the destination
+	claimCtx := claimAt(250)
+	status = queryBucket(claimCtx)
+	require.Equal(sdk.NewInt(250), status.Vested)
+	require.Equal(sdk.NewInt(750), status.Unvested)
+	require.Equal(sdk.NewInt(250), status.Claimed)
+	require.Equal(sdk.NewInt(250), suite.app.BankKeeper.GetBalance(claimCtx, grantee, blacktypes.BaseDenom).Amount)
+
+	// not claimed out of the claim period
+	ctx = claimCtx.WithBlockHeight(claimCtx.BlockHeight() + 1).WithBlockTime(claimCtx.BlockTime().Add(250 * time.Second))
+	vesting.EndBlocker(ctx, k)
+	require.Equal(sdk.NewInt(250), queryBucket(ctx).Claimed)
+
+	// redirected to another address
+	addr, _ = tests.NewAddrKey()
+	newGrantee := sdk.AccAddress(addr.Bytes())
+	redirect := &types.SetVestingBucketDestinationProposal{
+		Title:           "redirect",
+		Description:     "redirect grants",
+		Name:            proposal.Name,
+		DestinationAddr: newGrantee.String(),
+	}
+	require.NoError(redirect.ValidateBasic())
+	require.NoError(handler(ctx, redirect))
+	ctx = claimAt(2000)
+	status = queryBucket(ctx)
+	require.Equal(sdk.NewInt(1000), status.Vested)
+	require.True(status.Unvested.IsZero())
+	require.Equal(sdk.NewInt(1000), status.Claimed)
+	require.Equal(sdk.NewInt(250), suite.app.BankKeeper.GetBalance(ctx, grantee, blacktypes.BaseDenom).Amount)
+	require.Equal(sdk.NewInt(750), suite.app.BankKeeper.GetBalance(ctx, newGrantee, blacktypes.BaseDenom).Amount)
+	require.True(suite.app.BankKeeper.GetBalance(ctx, bucketAddr, blacktypes.BaseDenom).IsZero())
+
+	redirect.Name = "xxx"
+	require.Error(handler(ctx, redirect))
+	redirect.Name = proposal.Name
+	redirect.DestinationAddr = authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+	require.Error(handler(ctx, redirect))
+
+	res, err := k.VestingBuckets(sdk.WrapSDKContext(ctx), &types.QueryVestingBucketsRequest{})
+	require.NoError(err)
+	require.Len(res.Buckets, 4)
+}
+
+func (suite *KeeperTestSuite) TestSetAllocationAddress_RedirectsTeamVestingBucket() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VestingKeeper
+
+	addr, _ := tests.NewAddrKey()
+	team := sdk.AccAddress(addr.Bytes())
+	addr, _ = tests.NewAddrKey()
+	newTeam := sdk.AccAddress(addr.Bytes())
+	k.SetAllocationAddresses(suite.ctx, types.AllocationAddresses{TeamVestingAddr: team.String()})
+	require.NoError(k.SetVestingBucketDestination(suite.ctx, types.TeamVestingName, "", team.String()))
+
+	_, err := keeper.NewMsgServerImpl(k).SetAllocationAddress(sdk.WrapSDKContext(suite.ctx), &types.MsgSetAllocationAddress{
+		Sender:          team.String(),
+		TeamVestingAddr: newTeam.String(),
+	})
+	require.NoError(err)
+	bucket, _ := k.GetVestingBucket(suite.ctx, types.TeamVestingName)
+	require.Equal(newTeam.String(), bucket.DestinationAddr)
+	require.Empty(bucket.DestinationModule)
+}
+
+func (suite *KeeperTestSuite) TestVestingBucketGenesis() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VestingKeeper
+
+	bucket, found := k.GetVestingBucket(suite.ctx, types.CommunityPoolVestingName)
+	require.True(found)
+	bucket.ClaimedAmount = sdk.NewInt(100)
+	k.SetVestingBucket(suite.ctx, bucket)
+
+	genState := vesting.ExportGenesis(suite.ctx, k)
+	require.Len(genState.VestingBuckets, 3)
+	require.NoError(genState.Validate())
+
+	// the imported vesting buckets are not allocated again
+	suite.SetupTest()
+	k = suite.app.VestingKeeper
+	ctx := suite.ctx.WithBlockHeight(0)
+	supply := suite.app.BankKeeper.GetSupply(ctx, blacktypes.BaseDenom)
+	vesting.InitGenesis(ctx, k, *genState)
+	imported, found := k.GetVestingBucket(ctx, types.CommunityPoolVestingName)
+	require.True(found)
+	require.Equal(bucket, imported)
+	require.Equal(supply, suite.app.BankKeeper.GetSupply(ctx, blacktypes.BaseDenom))
+}
@@ -21,7 +21,9 @@ func (k Keeper) Airdrops(c context.Context, msg *types.QueryAirdropsRequest) (*t
 	ctx := sdk.UnwrapSDKContext(c)
 
 	keyPrefix := types.KeyPrefixAirdrops
-	if msg.Completed {
+	if msg.Failed {
+		keyPrefix = types.KeyPrefixAirdropsFailed
+	} else if msg.Completed {
 		keyPrefix = types.KeyPrefixAirdropsCompleted
 	}
 
@@ -61,10 +63,13 @@ func (k Keeper) Airdrop(c context.Context, msg *types.QueryAirdropRequest) (*typ
 	}
 
 	var airdrop types.Airdrop
-	if !msg.Completed {
-		airdrop = k.GetAirdrop(ctx, targetAddr)
-	} else {
+	switch {
+	case msg.Failed:
+		airdrop = k.GetAirdropFailed(ctx, targetAddr)
+	case msg.Completed:
 		airdrop = k.GetAirdropCompleted(ctx, targetAddr)
+	default:
+		airdrop = k.GetAirdrop(ctx, targetAddr)
 	}
 	if airdrop.Empty() {
 		return nil, status.Error(codes.NotFound, "airdrop target not found")
@@ -75,6 +80,174 @@ func (k Keeper) Airdrop(c context.Context, msg *types.QueryAirdropRequest) (*typ
 	}, nil
 }
 
+func (k Keeper) AirdropTotals(c context.Context, msg *types.QueryAirdropTotalsRequest) (*types.QueryAirdropTotalsResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	airdropCap := k.GetParams(ctx).Allocation.AirdropAmount
+	total := k.GetAirdropTotalAmount(ctx)
+	pending, pendingCount := k.GetAirdropPendingAmount(ctx)
+	available := airdropCap.Sub(total)
+	if available.IsNegative() {
+		available = sdk.ZeroInt()
+	}
+
+	return &types.QueryAirdropTotalsResponse{
+		Cap:          airdropCap,
+		Total:        total,
+		Pending:      pending,
+		PendingCount: pendingCount,
+		Available:    available,
+	}, nil
+}
+
+func (k Keeper) MerkleAirdrops(c context.Context, msg *types.QueryMerkleAirdropsRequest) (*types.QueryMerkleAirdropsResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var airdrops []types.MerkleAirdrop
+	store := ctx.KVStore(k.storeKey)
+	airdropStore := prefix.NewStore(store, types.KeyPrefixMerkleAirdrops)
+	pageRes, err := query.Paginate(airdropStore, msg.Pagination, func(key []byte, value []byte) error {
+		var airdrop types.MerkleAirdrop
+		k.cdc.MustUnmarshal(value, &airdrop)
+		airdrops = append(airdrops, airdrop)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMerkleAirdropsResponse{
+		Airdrops:   airdrops,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) MerkleAirdropClaimed(c context.Context, msg *types.QueryMerkleAirdropClaimedRequest) (*types.QueryMerkleAirdropClaimedResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if _, found := k.GetMerkleAirdrop(ctx, msg.AirdropId); !found {
+		return nil, status.Error(codes.NotFound, "merkle airdrop not found")
+	}
+
+	return &types.QueryMerkleAirdropClaimedResponse{
+		Claimed: k.IsMerkleAirdropClaimed(ctx, msg.AirdropId, addr),
+	}, nil
+}
+
+func (k Keeper) VestingBuckets(c context.Context, msg *types.QueryVestingBucketsRequest) (*types.QueryVestingBucketsResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var buckets []types.VestingBucketStatus
+	store := ctx.KVStore(k.storeKey)
+	bucketStore := prefix.NewStore(store, types.KeyPrefixVestingBuckets)
+	pageRes, err := query.Paginate(bucketStore, msg.Pagination, func(key []byte, value []byte) error {
+		var bucket types.VestingBucket
+		k.cdc.MustUnmarshal(value, &bucket)
+		buckets = append(buckets, k.GetVestingBucketStatus(ctx, bucket))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVestingBucketsResponse{
+		Buckets:    buckets,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) VestingBucket(c context.Context, msg *types.QueryVestingBucketRequest) (*types.QueryVestingBucketResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	bucket, found := k.GetVestingBucket(ctx, msg.Name)
+	if !found {
+		return nil, status.Error(codes.NotFound, "vesting bucket not found")
+	}
+
+	return &types.QueryVestingBucketResponse{
+		Bucket: k.GetVestingBucketStatus(ctx, bucket),
+	}, nil
+}
+
+func (k Keeper) StrategicReserve(c context.Context, msg *types.QueryStrategicReserveRequest) (*types.QueryStrategicReserveResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	balance := k.GetStrategicReserveBalance(ctx)
+	committed := k.GetStrategicReserveCommitted(ctx)
+	available, hasNeg := balance.SafeSub(committed)
+	if hasNeg {
+		available = sdk.Coins{}
+	}
+
+	return &types.QueryStrategicReserveResponse{
+		Balance:   balance,
+		Committed: committed,
+		Available: available,
+	}, nil
+}
+
+func (k Keeper) StrategicReservePayouts(c context.Context, msg *types.QueryStrategicReservePayoutsRequest) (*types.QueryStrategicReservePayoutsResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	keyPrefix := types.KeyPrefixStrategicReservePayouts
+	if msg.Ongoing {
+		keyPrefix = types.KeyPrefixOngoingStrategicReservePayouts
+	}
+
+	var payouts []types.StrategicReservePayout
+	store := ctx.KVStore(k.storeKey)
+	payoutStore := prefix.NewStore(store, keyPrefix)
+	pageRes, err := query.Paginate(payoutStore, msg.Pagination, func(key []byte, value []byte) error {
+		payout, found := k.GetStrategicReservePayout(ctx, sdk.BigEndianToUint64(key))
+		if !found {
+			return status.Errorf(codes.Internal, "strategic reserve payout %d not found", sdk.BigEndianToUint64(key))
+		}
+		payouts = append(payouts, payout)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStrategicReservePayoutsResponse{
+		Payouts:    payouts,
+		Pagination: pageRes,
+	}, nil
+}
+
 func (k Keeper) Params(c context.Context, msg *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	if msg == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
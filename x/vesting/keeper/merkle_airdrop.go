@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/elysiumstation/blackfury/x/vesting/types"
+)
+
+// SweepMerkleAirdrops funds the community pool with the unclaimed amounts of
+// the Merkle airdrops past their claim deadline, and removes them.
+func (k Keeper) SweepMerkleAirdrops(ctx sdk.Context) {
+	var expired []types.MerkleAirdrop
+	k.IterateMerkleAirdrops(ctx, func(airdrop types.MerkleAirdrop) (stop bool) {
+		if !ctx.BlockTime().Before(airdrop.ClaimDeadline) {
+			expired = append(expired, airdrop)
+		}
+		return false
+	})
+
+	for _, airdrop := range expired {
+		unclaimed := airdrop.TotalAmount.Sub(airdrop.ClaimedAmount)
+		if unclaimed.IsPositive() {
+			amount := sdk.NewCoins(unclaimed)
+			err := k.bankKeeper.MintCoins(ctx, types.ModuleName, amount)
+			if err != nil {
+				panic(err)
+			}
+			err = k.distrKeeper.FundCommunityPool(ctx, amount, authtypes.NewModuleAddress(types.ModuleName))
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		k.DeleteMerkleAirdrop(ctx, airdrop.Id)
+		k.deleteMerkleAirdropClaims(ctx, airdrop.Id)
+	}
+}
+
+// GetNextMerkleAirdropID gets the next Merkle airdrop id
+func (k Keeper) GetNextMerkleAirdropID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextMerkleAirdropIDKey())
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextMerkleAirdropID sets the next Merkle airdrop id
+func (k Keeper) SetNextMerkleAirdropID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextMerkleAirdropIDKey(), sdk.Uint64ToBigEndian(id))
+}
+
+// SetMerkleAirdrop sets Merkle airdrop
+func (k Keeper) SetMerkleAirdrop(ctx sdk.Context, airdrop types.MerkleAirdrop) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&airdrop)
+	store.Set(types.MerkleAirdropsKey(airdrop.Id), bz)
+}
+
+// GetMerkleAirdrop gets Merkle airdrop
+func (k Keeper) GetMerkleAirdrop(ctx sdk.Context, id uint64) (airdrop types.MerkleAirdrop, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.MerkleAirdropsKey(id))
+	if bz == nil {
+		return airdrop, false
+	}
+	k.cdc.MustUnmarshal(bz, &airdrop)
+	return airdrop, true
+}
+
+// DeleteMerkleAirdrop deletes Merkle airdrop
+func (k Keeper) DeleteMerkleAirdrop(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.MerkleAirdropsKey(id))
+}
+
+// IterateMerkleAirdrops iterates Merkle airdrops
+func (k Keeper) IterateMerkleAirdrops(ctx sdk.Context, handler func(airdrop types.MerkleAirdrop) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixMerkleAirdrops)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var airdrop types.MerkleAirdrop
+		k.cdc.MustUnmarshal(iter.Value(), &airdrop)
+		if handler(airdrop) {
+			break
+		}
+	}
+}
+
+// SetMerkleAirdropClaimed marks the Merkle airdrop as claimed by the account
+func (k Keeper) SetMerkleAirdropClaimed(ctx sdk.Context, id uint64, acc sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MerkleAirdropClaimsKey(id, acc), []byte{1})
+}
+
+// IsMerkleAirdropClaimed checks whether the Merkle airdrop has been claimed by the account
+func (k Keeper) IsMerkleAirdropClaimed(ctx sdk.Context, id uint64, acc sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.MerkleAirdropClaimsKey(id, acc))
+}
+
+func (k Keeper) deleteMerkleAirdropClaims(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.MerkleAirdropClaimsPrefix(id))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
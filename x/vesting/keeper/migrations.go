@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	blackfury "github.com/elysiumstation/blackfury/types"
+	"github.com/elysiumstation/blackfury/x/vesting/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+// It turns the continuous vesting accounts allocated at genesis into vesting buckets,
+// which keep vesting from the same start time to the same destinations.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	k := m.keeper
+
+	// The team vesting was claimed to the community pool until the team vesting address was set
+	teamModule, teamAddr := "", k.GetAllocationAddresses(ctx).TeamVestingAddr
+	if len(teamAddr) == 0 {
+		teamModule = distrtypes.ModuleName
+	}
+
+	for _, v := range []struct {
+		name       string
+		destModule string
+		destAddr   string
+	}{
+		{types.StakingRewardVestingName, k.feeCollectorName, ""},
+		{types.CommunityPoolVestingName, distrtypes.ModuleName, ""},
+		{types.TeamVestingName, teamModule, teamAddr},
+	} {
+		if _, found := k.GetVestingBucket(ctx, v.name); found {
+			continue
+		}
+		addr := types.VestingBucketAddress(v.name)
+		acc, ok := k.accountKeeper.GetAccount(ctx, addr).(*vestingtypes.ContinuousVestingAccount)
+		if !ok {
+			continue
+		}
+
+		amount := acc.OriginalVesting.AmountOf(blackfury.BaseDenom)
+		// The vested coins have been claimed out of the account
+		claimed := amount.Sub(k.bankKeeper.GetAllBalances(ctx, addr).AmountOf(blackfury.BaseDenom))
+		if claimed.IsNegative() {
+			claimed = sdk.ZeroInt()
+		}
+
+		bucket := types.VestingBucket{
+			Name:              v.name,
+			Amount:            amount,
+			StartTime:         acc.StartTime,
+			Duration:          uint64(acc.EndTime - acc.StartTime),
+			DestinationModule: v.destModule,
+			DestinationAddr:   v.destAddr,
+			ClaimPeriod:       types.ClaimVestedPeriod,
+			ClaimedAmount:     claimed,
+		}
+		if err := bucket.Validate(); err != nil {
+			return err
+		}
+
+		// The bucket locks the unvested coins instead of the vesting account
+		k.accountKeeper.SetAccount(ctx, acc.BaseAccount)
+		k.SetVestingBucket(ctx, bucket)
+		k.Logger(ctx).Info("migrated vesting account to vesting bucket", "name", v.name, "amount", amount, "claimed", claimed)
+	}
+
+	return nil
+}
+
+// Migrate3to4 migrates from version 3 to 4.
+// It moves the strategic reserve allocated to the custodian at genesis into the strategic reserve pool,
+// up to the allocated amount, so that it can only be spent by governance.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	k := m.keeper
+
+	custodian := k.GetAllocationAddresses(ctx).GetStrategicReserveCustodianAddr()
+	if custodian.Empty() {
+		return nil
+	}
+
+	amount := k.bankKeeper.SpendableCoins(ctx, custodian).AmountOf(blackfury.BaseDenom)
+	if allocated := k.GetParams(ctx).Allocation.StrategicReserveAmount; amount.GT(allocated) {
+		amount = allocated
+	}
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(blackfury.BaseDenom, amount))
+	if err := k.FundStrategicReserve(ctx, custodian, coins); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMigrateStrategicReserve,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyCustodian, custodian.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+		),
+	)
+	k.Logger(ctx).Info("migrated strategic reserve to pool", "custodian", custodian, "amount", coins)
+	return nil
+}
@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/elysiumstation/blackfury/app"
+	blacktypes "github.com/elysiumstation/blackfury/types"
+	"github.com/elysiumstation/blackfury/x/vesting/keeper"
+	"github.com/elysiumstation/blackfury/x/vesting/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VestingKeeper
+	ctx := suite.ctx
+	alloc := k.GetParams(ctx).Allocation
+	startTime := ctx.BlockTime().Unix() - 1000
+
+	// restore the continuous vesting accounts allocated at genesis before the vesting buckets
+	store := ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	for _, name := range []string{types.StakingRewardVestingName, types.CommunityPoolVestingName, types.TeamVestingName} {
+		store.Delete(types.VestingBucketKey(name))
+		addr := types.VestingBucketAddress(name)
+		acc := suite.app.AccountKeeper.GetAccount(ctx, addr)
+		original := suite.app.BankKeeper.GetAllBalances(ctx, addr)
+		vestingAcc := vestingtypes.NewContinuousVestingAccount(
+			authtypes.NewBaseAccount(addr, nil, acc.GetAccountNumber(), acc.GetSequence()),
+			original, startTime, startTime+blacktypes.SecondsPer4Years,
+		)
+		suite.app.AccountKeeper.SetAccount(ctx, vestingAcc)
+	}
+	// the vested coins of the staking reward vesting have been claimed
+	stakingAddr := types.VestingBucketAddress(types.StakingRewardVestingName)
+	claimed := sdk.NewCoins(sdk.NewInt64Coin(blacktypes.BaseDenom, 100))
+	err := suite.app.BankKeeper.SendCoinsFromAccountToModule(ctx, stakingAddr, authtypes.FeeCollectorName, claimed)
+	require.NoError(err)
+
+	require.NoError(keeper.NewMigrator(k).Migrate2to3(ctx))
+
+	for _, tc := range []struct {
+		name       string
+		amount     sdk.Int
+		claimed    sdk.Int
+		destModule string
+	}{
+		{types.StakingRewardVestingName, alloc.StakingRewardAmount, sdk.NewInt(100), authtypes.FeeCollectorName},
+		{types.CommunityPoolVestingName, alloc.CommunityPoolAmount, sdk.ZeroInt(), distrtypes.ModuleName},
+		{types.TeamVestingName, alloc.TeamVestingAmount, sdk.ZeroInt(), distrtypes.ModuleName},
+	} {
+		bucket, found := k.GetVestingBucket(ctx, tc.name)
+		require.True(found)
+		require.Equal(tc.amount, bucket.Amount)
+		require.Equal(startTime, bucket.StartTime)
+		require.Equal(uint64(blacktypes.SecondsPer4Years), bucket.Duration)
+		require.Equal(tc.claimed, bucket.ClaimedAmount)
+		require.Equal(tc.destModule, bucket.DestinationModule)
+		require.Equal(uint64(types.ClaimVestedPeriod), bucket.ClaimPeriod)
+
+		// the coins are no longer locked by a vesting account
+		acc := suite.app.AccountKeeper.GetAccount(ctx, bucket.GetAddress())
+		require.IsType(&authtypes.BaseAccount{}, acc)
+		require.Equal(
+			tc.amount.Sub(tc.claimed),
+			suite.app.BankKeeper.SpendableCoins(ctx, bucket.GetAddress()).AmountOf(blacktypes.BaseDenom),
+		)
+	}
+
+	// existing vesting buckets are left untouched
+	bucket, _ := k.GetVestingBucket(ctx, types.StakingRewardVestingName)
+	bucket.ClaimedAmount = sdk.NewInt(200)
+	k.SetVestingBucket(ctx, bucket)
+	require.NoError(keeper.NewMigrator(k).Migrate2to3(ctx))
+	migrated, _ := k.GetVestingBucket(ctx, types.StakingRewardVestingName)
+	require.Equal(bucket, migrated)
+}
+
+func (suite *KeeperTestSuite) TestMigrate3to4() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VestingKeeper
+	ctx := suite.ctx
+	allocated := k.GetParams(ctx).Allocation.StrategicReserveAmount
+	poolAddr := authtypes.NewModuleAddress(types.StrategicReservePoolName)
+	reserve := suite.app.BankKeeper.GetBalance(ctx, poolAddr, blacktypes.BaseDenom).Amount
+
+	// no custodian
+	require.NoError(keeper.NewMigrator(k).Migrate3to4(ctx))
+	require.Equal(reserve, suite.app.BankKeeper.GetBalance(ctx, poolAddr, blacktypes.BaseDenom).Amount)
+
+	// the custodian holds the strategic reserve allocated at genesis, besides its own 10000
+	custodian := sdk.AccAddress(suite.address.Bytes())
+	k.SetAllocationAddresses(ctx, types.AllocationAddresses{StrategicReserveCustodianAddr: custodian.String()})
+	err := app.FundAccount(suite.app.BankKeeper, ctx, custodian, sdk.NewCoins(sdk.NewCoin(blacktypes.BaseDenom, allocated)))
+	require.NoError(err)
+
+	require.NoError(keeper.NewMigrator(k).Migrate3to4(ctx))
+	require.Equal(reserve.Add(allocated), suite.app.BankKeeper.GetBalance(ctx, poolAddr, blacktypes.BaseDenom).Amount)
+	require.Equal(sdk.NewInt(10000), suite.app.BankKeeper.GetBalance(ctx, custodian, blacktypes.BaseDenom).Amount)
+
+	var recorded bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeMigrateStrategicReserve {
+			recorded = true
+			require.Equal(custodian.String(), string(event.Attributes[1].Value))
+			require.Equal(sdk.NewCoin(blacktypes.BaseDenom, allocated).String(), string(event.Attributes[2].Value))
+		}
+	}
+	require.True(recorded)
+}
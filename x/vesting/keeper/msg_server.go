@@ -34,6 +34,9 @@ func (m msgServer) AddAirdrops(c context.Context, msg *types.MsgAddAirdrops) (*t
 
 	total := m.Keeper.GetAirdropTotalAmount(ctx)
 
+	// amounts of the targets added by this message, which replace the existing ones
+	added := make(map[string]sdk.Int)
+	airdrops := make([]types.Airdrop, 0, len(msg.Airdrops))
 	for _, airdrop := range msg.Airdrops {
 		targetAddr, err := sdk.AccAddressFromBech32(airdrop.TargetAddr)
 		if err != nil {
@@ -45,19 +48,120 @@ func (m msgServer) AddAirdrops(c context.Context, msg *types.MsgAddAirdrops) (*t
 		}
 		airdrop.Amount = amount
 
-		m.Keeper.SetAirdrop(ctx, targetAddr, airdrop)
+		err = m.Keeper.validateAirdrop(ctx, airdrop)
+		if err != nil {
+			return nil, err
+		}
+
+		// The overwritten airdrop no longer counts towards the total
+		if prev, ok := added[targetAddr.String()]; ok {
+			total = total.Sub(prev)
+		} else if existing := m.Keeper.GetAirdrop(ctx, targetAddr); !existing.Empty() {
+			total = total.Sub(existing.Amount.Amount)
+		}
+		added[targetAddr.String()] = amount.Amount
 		total = total.Add(amount.Amount)
+
+		airdrops = append(airdrops, airdrop)
 	}
 
 	if total.GT(m.Keeper.GetParams(ctx).Allocation.AirdropAmount) {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "total amount should not be greater than its cap")
 	}
 
+	for _, airdrop := range airdrops {
+		m.Keeper.SetAirdrop(ctx, airdrop.GetTargetAddr(), airdrop)
+	}
 	m.Keeper.SetAirdropTotalAmount(ctx, total)
 
 	return &types.MsgAddAirdropsResponse{}, nil
 }
 
+func (m msgServer) UpdateAirdrop(c context.Context, msg *types.MsgUpdateAirdrop) (*types.MsgUpdateAirdropResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	// Airdrops can only be updated by team vesting address
+	teamAddr := m.Keeper.GetAllocationAddresses(ctx).GetTeamVestingAddr()
+	if !sender.Equals(teamAddr) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "unauthorized sender")
+	}
+
+	airdrop := msg.Airdrop
+	targetAddr, err := sdk.AccAddressFromBech32(airdrop.TargetAddr)
+	if err != nil {
+		return nil, err
+	}
+	existing := m.Keeper.GetAirdrop(ctx, targetAddr)
+	if existing.Empty() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "airdrop target %s not found", airdrop.TargetAddr)
+	}
+
+	amount, err := sdk.ParseCoinNormalized(airdrop.Amount.String())
+	if err != nil {
+		return nil, err
+	}
+	airdrop.Amount = amount
+
+	err = m.Keeper.validateAirdrop(ctx, airdrop)
+	if err != nil {
+		return nil, err
+	}
+
+	total := m.Keeper.GetAirdropTotalAmount(ctx).Sub(existing.Amount.Amount).Add(amount.Amount)
+	if total.GT(m.Keeper.GetParams(ctx).Allocation.AirdropAmount) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "total amount should not be greater than its cap")
+	}
+
+	m.Keeper.SetAirdrop(ctx, targetAddr, airdrop)
+	m.Keeper.SetAirdropTotalAmount(ctx, total)
+
+	return &types.MsgUpdateAirdropResponse{}, nil
+}
+
+func (m msgServer) CancelAirdrops(c context.Context, msg *types.MsgCancelAirdrops) (*types.MsgCancelAirdropsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	// Airdrops can only be cancelled by team vesting address
+	teamAddr := m.Keeper.GetAllocationAddresses(ctx).GetTeamVestingAddr()
+	if !sender.Equals(teamAddr) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "unauthorized sender")
+	}
+
+	total := m.Keeper.GetAirdropTotalAmount(ctx)
+
+	targetAddrs := make([]sdk.AccAddress, 0, len(msg.TargetAddrs))
+	for _, target := range msg.TargetAddrs {
+		targetAddr, err := sdk.AccAddressFromBech32(target)
+		if err != nil {
+			return nil, err
+		}
+		airdrop := m.Keeper.GetAirdrop(ctx, targetAddr)
+		if airdrop.Empty() {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "airdrop target %s not found", target)
+		}
+
+		targetAddrs = append(targetAddrs, targetAddr)
+		total = total.Sub(airdrop.Amount.Amount)
+	}
+
+	for _, targetAddr := range targetAddrs {
+		m.Keeper.DeleteAirdrop(ctx, targetAddr)
+	}
+	m.Keeper.SetAirdropTotalAmount(ctx, total)
+
+	return &types.MsgCancelAirdropsResponse{}, nil
+}
+
 func (m msgServer) ExecuteAirdrops(c context.Context, msg *types.MsgExecuteAirdrops) (*types.MsgExecuteAirdropsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -72,30 +176,148 @@ func (m msgServer) ExecuteAirdrops(c context.Context, msg *types.MsgExecuteAirdr
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "unauthorized sender")
 	}
 
-	count := uint64(0)
+	// Expired airdrops are not performed
+	m.Keeper.DropExpiredAirdrops(ctx)
+
+	var airdrops []types.Airdrop
 	m.Keeper.IterateAirdrops(ctx, func(airdrop types.Airdrop) (stop bool) {
-		// mint and send
-		err = m.Keeper.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(airdrop.Amount))
+		airdrops = append(airdrops, airdrop)
+		return uint64(len(airdrops)) >= msg.MaxCount
+	})
+
+	var failed []string
+	total := m.Keeper.GetAirdropTotalAmount(ctx)
+	for _, airdrop := range airdrops {
+		targetAddr := airdrop.GetTargetAddr()
+		m.Keeper.DeleteAirdrop(ctx, targetAddr)
+
+		// mint and deliver, or revert the delivery on failure
+		cacheCtx, write := ctx.CacheContext()
+		err = m.Keeper.DeliverAirdrop(cacheCtx, airdrop)
 		if err != nil {
-			return true
+			// a failed airdrop should not block the others, so record it and release its amount from the total
+			m.Keeper.Logger(ctx).Error("failed to deliver airdrop", "target", airdrop.TargetAddr, "error", err)
+			m.Keeper.SetAirdropFailed(ctx, targetAddr, airdrop)
+			total = total.Sub(airdrop.Amount.Amount)
+			failed = append(failed, airdrop.TargetAddr)
+			continue
 		}
-		err = m.Keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, airdrop.GetTargetAddr(), sdk.NewCoins(airdrop.Amount))
+		write()
+
+		// complete airdrop into store
+		m.Keeper.SetAirdropCompleted(ctx, targetAddr, airdrop)
+	}
+	m.Keeper.SetAirdropTotalAmount(ctx, total)
+
+	return &types.MsgExecuteAirdropsResponse{FailedTargetAddrs: failed}, nil
+}
+
+func (m msgServer) AddMerkleAirdrop(c context.Context, msg *types.MsgAddMerkleAirdrop) (*types.MsgAddMerkleAirdropResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	// Airdrops can only be added by team vesting address
+	teamAddr := m.Keeper.GetAllocationAddresses(ctx).GetTeamVestingAddr()
+	if !sender.Equals(teamAddr) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "unauthorized sender")
+	}
+
+	_, err = types.ParseMerkleHash(msg.MerkleRoot)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := sdk.ParseCoinNormalized(msg.TotalAmount.String())
+	if err != nil {
+		return nil, err
+	}
+	if !msg.ClaimDeadline.After(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "claim deadline %s is not in the future", msg.ClaimDeadline)
+	}
+	err = types.Airdrop{Delivery: msg.Delivery, Duration: msg.Duration}.ValidateDelivery()
+	if err != nil {
+		return nil, err
+	}
+
+	total := m.Keeper.GetAirdropTotalAmount(ctx).Add(amount.Amount)
+	if total.GT(m.Keeper.GetParams(ctx).Allocation.AirdropAmount) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "total amount should not be greater than its cap")
+	}
+	m.Keeper.SetAirdropTotalAmount(ctx, total)
+
+	id := m.Keeper.GetNextMerkleAirdropID(ctx)
+	m.Keeper.SetNextMerkleAirdropID(ctx, id+1)
+
+	m.Keeper.SetMerkleAirdrop(ctx, types.MerkleAirdrop{
+		Id:            id,
+		MerkleRoot:    msg.MerkleRoot,
+		TotalAmount:   amount,
+		ClaimedAmount: sdk.NewCoin(amount.Denom, sdk.ZeroInt()),
+		ClaimDeadline: msg.ClaimDeadline,
+		Delivery:      msg.Delivery,
+		Duration:      msg.Duration,
+	})
+
+	return &types.MsgAddMerkleAirdropResponse{AirdropId: id}, nil
+}
+
+func (m msgServer) ClaimAirdrop(c context.Context, msg *types.MsgClaimAirdrop) (*types.MsgClaimAirdropResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	airdrop, found := m.Keeper.GetMerkleAirdrop(ctx, msg.AirdropId)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "merkle airdrop %d not found", msg.AirdropId)
+	}
+	if !ctx.BlockTime().Before(airdrop.ClaimDeadline) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "merkle airdrop %d is past its claim deadline", msg.AirdropId)
+	}
+	if m.Keeper.IsMerkleAirdropClaimed(ctx, airdrop.Id, sender) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "merkle airdrop %d already claimed", msg.AirdropId)
+	}
+
+	root, err := types.ParseMerkleHash(airdrop.MerkleRoot)
+	if err != nil {
+		return nil, err
+	}
+	proof := make([][]byte, len(msg.Proof))
+	for i, node := range msg.Proof {
+		proof[i], err = types.ParseMerkleHash(node)
 		if err != nil {
-			return true
+			return nil, err
 		}
+	}
+	if !types.VerifyMerkleProof(root, types.MerkleAirdropLeaf(sender, msg.Amount), proof) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invalid merkle proof")
+	}
 
-		// complete airdrop into store
-		m.Keeper.DeleteAirdrop(ctx, airdrop.GetTargetAddr())
-		m.Keeper.SetAirdropCompleted(ctx, airdrop.GetTargetAddr(), airdrop)
+	amount := sdk.NewCoin(airdrop.TotalAmount.Denom, msg.Amount)
+	airdrop.ClaimedAmount = airdrop.ClaimedAmount.Add(amount)
+	if airdrop.TotalAmount.IsLT(airdrop.ClaimedAmount) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "claimed amount should not be greater than total amount")
+	}
 
-		count++
-		return count >= msg.MaxCount
+	err = m.Keeper.DeliverAirdrop(ctx, types.Airdrop{
+		TargetAddr: sender.String(),
+		Amount:     amount,
+		Delivery:   airdrop.Delivery,
+		Duration:   airdrop.Duration,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.MsgExecuteAirdropsResponse{}, nil
+	m.Keeper.SetMerkleAirdropClaimed(ctx, airdrop.Id, sender)
+	m.Keeper.SetMerkleAirdrop(ctx, airdrop)
+
+	return &types.MsgClaimAirdropResponse{}, nil
 }
 
 func (m msgServer) SetAllocationAddress(c context.Context, msg *types.MsgSetAllocationAddress) (*types.MsgSetAllocationAddressResponse, error) {
@@ -138,6 +360,15 @@ func (m msgServer) SetAllocationAddress(c context.Context, msg *types.MsgSetAllo
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "unauthorized sender")
 		}
 
+		// The team vesting bucket follows the team vesting address, unless redirected by governance
+		bucket, found := m.Keeper.GetVestingBucket(ctx, types.TeamVestingName)
+		if found && bucket.DestinationAddr == addr.TeamVestingAddr {
+			err = m.Keeper.SetVestingBucketDestination(ctx, bucket.Name, "", newTva.String())
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		addr.TeamVestingAddr = newTva.String()
 	}
 
@@ -145,3 +376,19 @@ func (m msgServer) SetAllocationAddress(c context.Context, msg *types.MsgSetAllo
 
 	return &types.MsgSetAllocationAddressResponse{}, nil
 }
+
+func (m msgServer) FundStrategicReserve(c context.Context, msg *types.MsgFundStrategicReserve) (*types.MsgFundStrategicReserveResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.Keeper.FundStrategicReserve(ctx, sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFundStrategicReserveResponse{}, nil
+}
@@ -1,10 +1,17 @@
 package keeper_test
 
 import (
+	"encoding/hex"
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authvestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	blacktypes "github.com/elysiumstation/blackfury/types"
+	vetypes "github.com/elysiumstation/blackfury/x/ve/types"
 	"github.com/elysiumstation/blackfury/x/vesting/keeper"
 	"github.com/elysiumstation/blackfury/x/vesting/types"
 	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
@@ -26,6 +33,11 @@ func (suite *KeeperTestSuite) TestAddAirdrops() {
 	teamAddr := receiver
 	impl := keeper.NewMsgServerImpl(k)
 	cap := k.GetParams(suite.ctx).Allocation.AirdropAmount.Add(sdk.NewInt(1))
+
+	// an existing vesting account
+	vestingAddr := sdk.AccAddress("vesting_account")
+	vestingAcc := authvestingtypes.NewContinuousVestingAccount(authtypes.NewBaseAccountWithAddress(vestingAddr), sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(1))), 0, 1)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, vestingAcc)
 	testCases := []struct {
 		name     string
 		pass     bool
@@ -40,6 +52,18 @@ func (suite *KeeperTestSuite) TestAddAirdrops() {
 		{"total amount should not be greater than its cap", false, teamAddr.String(), []types.Airdrop{
 			{TargetAddr: receiver.String(), Amount: sdk.NewCoin(denom, cap)},
 		}},
+		{"ve lock airdrop shorter than a regulated period", false, teamAddr.String(), []types.Airdrop{
+			{TargetAddr: receiver.String(), Amount: sdk.NewCoin(denom, sdk.NewInt(1)), Delivery: types.AIRDROP_DELIVERY_VE_LOCK, Duration: vetypes.RegulatedPeriod - 1},
+		}},
+		{"ve lock airdrop of other denom than lock denom", false, teamAddr.String(), []types.Airdrop{
+			{TargetAddr: receiver.String(), Amount: sdk.NewCoin(blacktypes.MicroFUSDDenom, sdk.NewInt(1)), Delivery: types.AIRDROP_DELIVERY_VE_LOCK, Duration: vetypes.MaxLockTime},
+		}},
+		{"module account target", false, teamAddr.String(), []types.Airdrop{
+			{TargetAddr: authtypes.NewModuleAddress(distrtypes.ModuleName).String(), Amount: sdk.NewCoin(denom, sdk.NewInt(1))},
+		}},
+		{"vesting airdrop to vesting account", false, teamAddr.String(), []types.Airdrop{
+			{TargetAddr: vestingAddr.String(), Amount: sdk.NewCoin(denom, sdk.NewInt(1)), Delivery: types.AIRDROP_DELIVERY_CONTINUOUS_VESTING, Duration: 1000},
+		}},
 		{"valid", true, teamAddr.String(), []types.Airdrop{
 			{TargetAddr: receiver.String(), Amount: sdk.NewCoin(denom, sdk.NewInt(1))},
 		}},
@@ -117,6 +141,114 @@ func (suite *KeeperTestSuite) TestExecuteAirdrops() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestExecuteAirdropsDelivery() {
+	require := suite.Require()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	k := suite.app.VestingKeeper
+	teamAddr := sdk.AccAddress(suite.address.Bytes())
+	denom := blacktypes.AttoFuryDenom
+	k.SetAllocationAddresses(suite.ctx, types.AllocationAddresses{
+		TeamVestingAddr:               teamAddr.String(),
+		StrategicReserveCustodianAddr: teamAddr.String(),
+	})
+	impl := keeper.NewMsgServerImpl(k)
+
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(err)
+	vestingAddr := sdk.AccAddress(priv.PubKey().Address())
+	priv, err = ethsecp256k1.GenerateKey()
+	require.NoError(err)
+	veAddr := sdk.AccAddress(priv.PubKey().Address())
+
+	amount := sdk.NewCoin(denom, sdk.NewInt(1000))
+	_, err = impl.AddAirdrops(ctx, &types.MsgAddAirdrops{
+		Sender: teamAddr.String(),
+		Airdrops: []types.Airdrop{
+			{TargetAddr: vestingAddr.String(), Amount: amount, Delivery: types.AIRDROP_DELIVERY_CONTINUOUS_VESTING, Duration: 1000},
+			{TargetAddr: veAddr.String(), Amount: amount, Delivery: types.AIRDROP_DELIVERY_VE_LOCK, Duration: vetypes.MaxLockTime},
+		},
+	})
+	require.NoError(err)
+
+	nextVeID := suite.app.VeKeeper.GetNextVeID(suite.ctx)
+	_, err = impl.ExecuteAirdrops(ctx, &types.MsgExecuteAirdrops{
+		Sender:   teamAddr.String(),
+		MaxCount: 100,
+	})
+	require.NoError(err)
+
+	// continuous vesting
+	acc, ok := suite.app.AccountKeeper.GetAccount(suite.ctx, vestingAddr).(*authvestingtypes.ContinuousVestingAccount)
+	require.True(ok)
+	require.Equal(sdk.NewCoins(amount), acc.GetOriginalVesting())
+	require.Equal(suite.ctx.BlockTime().Unix()+1000, acc.GetEndTime())
+	require.Equal(amount, suite.app.BankKeeper.GetBalance(suite.ctx, vestingAddr, denom))
+	require.True(suite.app.BankKeeper.SpendableCoins(suite.ctx, vestingAddr).IsZero())
+
+	// ve lock
+	require.Equal(veAddr, suite.app.NftKeeper.GetOwner(suite.ctx, vetypes.VeNftClass.Id, vetypes.VeIDFromUint64(nextVeID)))
+	locked := suite.app.VeKeeper.GetLockedAmountByUser(suite.ctx, nextVeID)
+	require.Equal(amount.Amount, locked.Amount)
+	require.True(suite.app.BankKeeper.GetBalance(suite.ctx, veAddr, denom).IsZero())
+
+	require.False(k.GetAirdropCompleted(suite.ctx, vestingAddr).Empty())
+	require.False(k.GetAirdropCompleted(suite.ctx, veAddr).Empty())
+}
+
+func (suite *KeeperTestSuite) TestExecuteAirdropsSkipFailed() {
+	require := suite.Require()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	k := suite.app.VestingKeeper
+	teamAddr := sdk.AccAddress(suite.address.Bytes())
+	denom := blacktypes.AttoFuryDenom
+	k.SetAllocationAddresses(suite.ctx, types.AllocationAddresses{
+		TeamVestingAddr:               teamAddr.String(),
+		StrategicReserveCustodianAddr: teamAddr.String(),
+	})
+	impl := keeper.NewMsgServerImpl(k)
+
+	failingAddr := sdk.AccAddress("failing_target")
+	liquidAddr := sdk.AccAddress("liquid_target")
+	amount := sdk.NewCoin(denom, sdk.NewInt(1000))
+	_, err := impl.AddAirdrops(ctx, &types.MsgAddAirdrops{
+		Sender: teamAddr.String(),
+		Airdrops: []types.Airdrop{
+			{TargetAddr: failingAddr.String(), Amount: amount, Delivery: types.AIRDROP_DELIVERY_CONTINUOUS_VESTING, Duration: 1000},
+			{TargetAddr: liquidAddr.String(), Amount: amount},
+		},
+	})
+	require.NoError(err)
+	require.Equal(sdk.NewInt(2000), k.GetAirdropTotalAmount(suite.ctx))
+
+	// the target turns into a vesting account after the airdrop is added
+	vestingAcc := authvestingtypes.NewContinuousVestingAccount(authtypes.NewBaseAccountWithAddress(failingAddr), sdk.NewCoins(amount), 0, 1)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, vestingAcc)
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, denom)
+
+	res, err := impl.ExecuteAirdrops(ctx, &types.MsgExecuteAirdrops{
+		Sender:   teamAddr.String(),
+		MaxCount: 100,
+	})
+	require.NoError(err)
+	require.Equal([]string{failingAddr.String()}, res.FailedTargetAddrs)
+
+	// the failed airdrop is recorded and released from the total, without minting
+	require.False(k.GetAirdropFailed(suite.ctx, failingAddr).Empty())
+	require.True(k.GetAirdrop(suite.ctx, failingAddr).Empty())
+	require.True(k.GetAirdropCompleted(suite.ctx, failingAddr).Empty())
+	require.Equal(sdk.NewInt(1000), k.GetAirdropTotalAmount(suite.ctx))
+	require.Equal(supply.Add(amount), suite.app.BankKeeper.GetSupply(suite.ctx, denom))
+
+	// the others are delivered
+	require.False(k.GetAirdropCompleted(suite.ctx, liquidAddr).Empty())
+	require.Equal(amount, suite.app.BankKeeper.GetBalance(suite.ctx, liquidAddr, denom))
+
+	queryRes, err := suite.app.VestingKeeper.Airdrops(ctx, &types.QueryAirdropsRequest{Failed: true})
+	require.NoError(err)
+	require.Len(queryRes.Airdrops, 1)
+	require.Equal(failingAddr.String(), queryRes.Airdrops[0].TargetAddr)
+}
+
 func (suite *KeeperTestSuite) TestSetAllocationAddress() {
 	require := suite.Require()
 	ctx := sdk.WrapSDKContext(suite.ctx)
@@ -164,3 +296,237 @@ func (suite *KeeperTestSuite) TestSetAllocationAddress() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMerkleAirdrop() {
+	require := suite.Require()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	k := suite.app.VestingKeeper
+	teamAddr := sdk.AccAddress(suite.address.Bytes())
+	denom := blacktypes.AttoFuryDenom
+	k.SetAllocationAddresses(suite.ctx, types.AllocationAddresses{
+		TeamVestingAddr:               teamAddr.String(),
+		StrategicReserveCustodianAddr: teamAddr.String(),
+	})
+	impl := keeper.NewMsgServerImpl(k)
+
+	var recipients []sdk.AccAddress
+	var leaves [][]byte
+	for i := 1; i <= 3; i++ {
+		priv, err := ethsecp256k1.GenerateKey()
+		require.NoError(err)
+		addr := sdk.AccAddress(priv.PubKey().Address())
+		recipients = append(recipients, addr)
+		leaves = append(leaves, types.MerkleAirdropLeaf(addr, sdk.NewInt(int64(i*100))))
+	}
+	proof := func(index int) []string {
+		var nodes []string
+		for _, node := range types.MerkleProof(leaves, index) {
+			nodes = append(nodes, hex.EncodeToString(node))
+		}
+		return nodes
+	}
+
+	deadline := suite.ctx.BlockTime().Add(time.Hour)
+	total := sdk.NewCoin(denom, sdk.NewInt(1000))
+	addMsg := &types.MsgAddMerkleAirdrop{
+		Sender:        teamAddr.String(),
+		MerkleRoot:    hex.EncodeToString(types.MerkleRoot(leaves)),
+		TotalAmount:   total,
+		ClaimDeadline: deadline,
+	}
+
+	// unauthorized sender
+	_, err := impl.AddMerkleAirdrop(ctx, &types.MsgAddMerkleAirdrop{
+		Sender:        recipients[0].String(),
+		MerkleRoot:    addMsg.MerkleRoot,
+		TotalAmount:   total,
+		ClaimDeadline: deadline,
+	})
+	require.Error(err)
+	// deadline in the past
+	_, err = impl.AddMerkleAirdrop(ctx, &types.MsgAddMerkleAirdrop{
+		Sender:        teamAddr.String(),
+		MerkleRoot:    addMsg.MerkleRoot,
+		TotalAmount:   total,
+		ClaimDeadline: suite.ctx.BlockTime(),
+	})
+	require.Error(err)
+
+	res, err := impl.AddMerkleAirdrop(ctx, addMsg)
+	require.NoError(err)
+	require.Equal(uint64(1), res.AirdropId)
+	require.Equal(total.Amount, k.GetAirdropTotalAmount(suite.ctx))
+
+	testCases := []struct {
+		name   string
+		pass   bool
+		sender sdk.AccAddress
+		id     uint64
+		amount sdk.Int
+		proof  []string
+	}{
+		{"airdrop not found", false, recipients[0], 2, sdk.NewInt(100), proof(0)},
+		{"wrong amount", false, recipients[0], 1, sdk.NewInt(200), proof(0)},
+		{"wrong proof", false, recipients[0], 1, sdk.NewInt(100), proof(1)},
+		{"valid", true, recipients[0], 1, sdk.NewInt(100), proof(0)},
+		{"already claimed", false, recipients[0], 1, sdk.NewInt(100), proof(0)},
+		{"valid", true, recipients[1], 1, sdk.NewInt(200), proof(1)},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			res, err := impl.ClaimAirdrop(ctx, &types.MsgClaimAirdrop{
+				Sender:    tc.sender.String(),
+				AirdropId: tc.id,
+				Amount:    tc.amount,
+				Proof:     tc.proof,
+			})
+			if tc.pass {
+				require.NoError(err, tc.name)
+				require.Equal(tc.amount, suite.app.BankKeeper.GetBalance(suite.ctx, tc.sender, denom).Amount)
+				require.True(k.IsMerkleAirdropClaimed(suite.ctx, tc.id, tc.sender))
+			} else {
+				require.Error(err, tc.name)
+				require.Nil(res)
+			}
+		})
+	}
+
+	airdrop, found := k.GetMerkleAirdrop(suite.ctx, 1)
+	require.True(found)
+	require.Equal(sdk.NewCoin(denom, sdk.NewInt(300)), airdrop.ClaimedAmount)
+
+	// not swept before the deadline
+	k.SweepMerkleAirdrops(suite.ctx)
+	_, found = k.GetMerkleAirdrop(suite.ctx, 1)
+	require.True(found)
+
+	// expired claim is rejected, and the unclaimed amount is swept to the community pool
+	expiredCtx := suite.ctx.WithBlockTime(deadline)
+	_, err = impl.ClaimAirdrop(sdk.WrapSDKContext(expiredCtx), &types.MsgClaimAirdrop{
+		Sender:    recipients[2].String(),
+		AirdropId: 1,
+		Amount:    sdk.NewInt(300),
+		Proof:     proof(2),
+	})
+	require.Error(err)
+
+	poolBefore := suite.app.DistrKeeper.GetFeePoolCommunityCoins(expiredCtx).AmountOf(denom)
+	k.SweepMerkleAirdrops(expiredCtx)
+	poolAfter := suite.app.DistrKeeper.GetFeePoolCommunityCoins(expiredCtx).AmountOf(denom)
+	require.Equal(sdk.NewDec(700), poolAfter.Sub(poolBefore))
+	_, found = k.GetMerkleAirdrop(expiredCtx, 1)
+	require.False(found)
+	require.False(k.IsMerkleAirdropClaimed(expiredCtx, 1, recipients[0]))
+}
+
+func (suite *KeeperTestSuite) TestAirdropLifecycle() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VestingKeeper
+	impl := keeper.NewMsgServerImpl(k)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	teamAddr := sdk.AccAddress(suite.address.Bytes())
+	k.SetAllocationAddresses(suite.ctx, types.AllocationAddresses{TeamVestingAddr: teamAddr.String()})
+
+	var targets []sdk.AccAddress
+	for i := 0; i < 3; i++ {
+		priv, err := ethsecp256k1.GenerateKey()
+		require.NoError(err)
+		targets = append(targets, sdk.AccAddress(priv.PubKey().Address()))
+	}
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewInt64Coin(blacktypes.BaseDenom, amount)
+	}
+	queryTotals := func(ctx sdk.Context) *types.QueryAirdropTotalsResponse {
+		res, err := k.AirdropTotals(sdk.WrapSDKContext(ctx), &types.QueryAirdropTotalsRequest{})
+		require.NoError(err)
+		return res
+	}
+
+	// re-adding the same target replaces its amount in the total
+	for _, amount := range []int64{100, 300} {
+		_, err := impl.AddAirdrops(ctx, &types.MsgAddAirdrops{
+			Sender: teamAddr.String(),
+			Airdrops: []types.Airdrop{
+				{TargetAddr: targets[0].String(), Amount: coin(amount)},
+				{TargetAddr: targets[1].String(), Amount: coin(amount), ExpiryHeight: 10},
+			},
+		})
+		require.NoError(err)
+	}
+	totals := queryTotals(suite.ctx)
+	require.Equal(sdk.NewInt(600), totals.Total)
+	require.Equal(sdk.NewInt(600), totals.Pending)
+	require.Equal(uint64(2), totals.PendingCount)
+	require.Equal(totals.Cap.Sub(totals.Total), totals.Available)
+
+	// expiry in the past is rejected
+	_, err := impl.AddAirdrops(sdk.WrapSDKContext(suite.ctx.WithBlockHeight(11)), &types.MsgAddAirdrops{
+		Sender:   teamAddr.String(),
+		Airdrops: []types.Airdrop{{TargetAddr: targets[2].String(), Amount: coin(1), ExpiryHeight: 10}},
+	})
+	require.Error(err)
+
+	// update
+	_, err = impl.UpdateAirdrop(ctx, &types.MsgUpdateAirdrop{
+		Sender:  teamAddr.String(),
+		Airdrop: types.Airdrop{TargetAddr: targets[0].String(), Amount: coin(200), ExpiryHeight: 20},
+	})
+	require.NoError(err)
+	require.Equal(sdk.NewInt(500), k.GetAirdropTotalAmount(suite.ctx))
+	require.Equal(uint64(20), k.GetAirdrop(suite.ctx, targets[0]).ExpiryHeight)
+	_, err = impl.UpdateAirdrop(ctx, &types.MsgUpdateAirdrop{
+		Sender:  teamAddr.String(),
+		Airdrop: types.Airdrop{TargetAddr: targets[2].String(), Amount: coin(200)},
+	})
+	require.ErrorIs(err, sdkerrors.ErrNotFound)
+	_, err = impl.UpdateAirdrop(ctx, &types.MsgUpdateAirdrop{
+		Sender:  teamAddr.String(),
+		Airdrop: types.Airdrop{TargetAddr: targets[0].String(), Amount: sdk.NewCoin(blacktypes.BaseDenom, totals.Cap)},
+	})
+	require.Error(err)
+	_, err = impl.UpdateAirdrop(ctx, &types.MsgUpdateAirdrop{
+		Sender:  targets[0].String(),
+		Airdrop: types.Airdrop{TargetAddr: targets[0].String(), Amount: coin(1)},
+	})
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// expiry is dropped after the expiry height, and not performed
+	k.DropExpiredAirdrops(suite.ctx.WithBlockHeight(10))
+	require.False(k.GetAirdrop(suite.ctx, targets[1]).Empty())
+	expiredCtx := suite.ctx.WithBlockHeight(11)
+	_, err = impl.ExecuteAirdrops(sdk.WrapSDKContext(expiredCtx), &types.MsgExecuteAirdrops{
+		Sender:   teamAddr.String(),
+		MaxCount: 10,
+	})
+	require.NoError(err)
+	require.True(k.GetAirdrop(expiredCtx, targets[1]).Empty())
+	require.True(k.GetAirdropCompleted(expiredCtx, targets[1]).Empty())
+	require.False(k.GetAirdropCompleted(expiredCtx, targets[0]).Empty())
+	require.Equal(sdk.NewInt(200), k.GetAirdropTotalAmount(expiredCtx))
+	// the expiry queue of the updated and performed airdrop is cleared
+	k.DropExpiredAirdrops(expiredCtx.WithBlockHeight(21))
+	require.Equal(sdk.NewInt(200), k.GetAirdropTotalAmount(expiredCtx))
+
+	// cancel
+	_, err = impl.AddAirdrops(ctx, &types.MsgAddAirdrops{
+		Sender:   teamAddr.String(),
+		Airdrops: []types.Airdrop{{TargetAddr: targets[2].String(), Amount: coin(50), ExpiryHeight: 30}},
+	})
+	require.NoError(err)
+	_, err = impl.CancelAirdrops(ctx, &types.MsgCancelAirdrops{
+		Sender:      teamAddr.String(),
+		TargetAddrs: []string{targets[2].String(), targets[1].String()},
+	})
+	require.ErrorIs(err, sdkerrors.ErrNotFound)
+	_, err = impl.CancelAirdrops(ctx, &types.MsgCancelAirdrops{
+		Sender:      teamAddr.String(),
+		TargetAddrs: []string{targets[2].String()},
+	})
+	require.NoError(err)
+	require.True(k.GetAirdrop(suite.ctx, targets[2]).Empty())
+	totals = queryTotals(suite.ctx)
+	require.Equal(sdk.NewInt(200), totals.Total)
+	require.True(totals.Pending.IsZero())
+	require.Equal(uint64(0), totals.PendingCount)
+}
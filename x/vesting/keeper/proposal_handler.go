@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	blackfury "github.com/elysiumstation/blackfury/types"
+	"github.com/elysiumstation/blackfury/x/vesting/types"
+)
+
+func HandleAddVestingBucketProposal(ctx sdk.Context, k Keeper, p *types.AddVestingBucketProposal) error {
+	bucket := types.VestingBucket{
+		Name:              p.Name,
+		Amount:            p.Amount,
+		Cliff:             p.Cliff,
+		Duration:          p.Duration,
+		DestinationModule: p.DestinationModule,
+		DestinationAddr:   p.DestinationAddr,
+		ClaimPeriod:       p.ClaimPeriod,
+	}
+	err := k.AddVestingBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+
+	// The new bucket is funded from the community pool
+	amount := sdk.NewCoins(sdk.NewCoin(blackfury.BaseDenom, p.Amount))
+	return k.distrKeeper.DistributeFromFeePool(ctx, amount, bucket.GetAddress())
+}
+
+func HandleSetVestingBucketDestinationProposal(ctx sdk.Context, k Keeper, p *types.SetVestingBucketDestinationProposal) error {
+	return k.SetVestingBucketDestination(ctx, p.Name, p.DestinationModule, p.DestinationAddr)
+}
+
+func HandleStrategicReserveSpendProposal(ctx sdk.Context, k Keeper, p *types.StrategicReserveSpendProposal) error {
+	recipient, err := sdk.AccAddressFromBech32(p.Recipient)
+	if err != nil {
+		return err
+	}
+
+	id, err := k.SpendStrategicReserve(ctx, recipient, p.Amount, p.Duration)
+	if err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info("spent from the strategic reserve pool", "payout", id, "amount", p.Amount.String(), "recipient", p.Recipient, "duration", p.Duration)
+	return nil
+}
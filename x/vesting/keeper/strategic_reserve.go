@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	blackfury "github.com/elysiumstation/blackfury/types"
+	"github.com/elysiumstation/blackfury/x/vesting/types"
+)
+
+// FundStrategicReserve transfers coins from the sender to the strategic reserve pool
+func (k Keeper) FundStrategicReserve(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coins) error {
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.StrategicReservePoolName, amount)
+}
+
+// GetStrategicReserveBalance returns the balance of the strategic reserve pool
+func (k Keeper) GetStrategicReserveBalance(ctx sdk.Context) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.StrategicReservePoolName))
+}
+
+// GetStrategicReserveCommitted returns the amount committed to the ongoing streaming payouts,
+// which is still held by the strategic reserve pool
+func (k Keeper) GetStrategicReserveCommitted(ctx sdk.Context) sdk.Coins {
+	committed := sdk.Coins{}
+	k.IterateOngoingStrategicReservePayouts(ctx, func(payout types.StrategicReservePayout) (stop bool) {
+		committed = committed.Add(payout.Amount.Sub(payout.PaidAmount)...)
+		return false
+	})
+	return committed
+}
+
+// SpendStrategicReserve spends the amount from the strategic reserve pool to the recipient.
+// The amount is paid at once if the duration is zero, otherwise it is streamed linearly over the duration.
+func (k Keeper) SpendStrategicReserve(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins, duration uint64) (uint64, error) {
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", recipient)
+	}
+
+	available, hasNeg := k.GetStrategicReserveBalance(ctx).SafeSub(k.GetStrategicReserveCommitted(ctx))
+	if hasNeg || !available.IsAllGTE(amount) {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "strategic reserve pool does not have sufficient coins to spend %s", amount)
+	}
+
+	id := k.GetNextStrategicReservePayoutID(ctx)
+	k.SetNextStrategicReservePayoutID(ctx, id+1)
+
+	payout := types.StrategicReservePayout{
+		Id:         id,
+		Recipient:  recipient.String(),
+		Amount:     amount,
+		StartTime:  ctx.BlockTime().Unix(),
+		Duration:   duration,
+		PaidAmount: sdk.Coins{},
+	}
+	if duration == 0 {
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.StrategicReservePoolName, recipient, amount)
+		if err != nil {
+			return 0, err
+		}
+		payout.PaidAmount = amount
+	} else {
+		k.SetOngoingStrategicReservePayout(ctx, id)
+	}
+
+	k.SetStrategicReservePayout(ctx, payout)
+	return id, nil
+}
+
+// PayStrategicReservePayouts pays the streamed amounts of the ongoing payouts at the last block of the payout period
+func (k Keeper) PayStrategicReservePayouts(ctx sdk.Context) {
+	if !blackfury.IsPeriodLastBlock(ctx, types.StrategicReservePayoutPeriod) {
+		return
+	}
+
+	var payouts []types.StrategicReservePayout
+	k.IterateOngoingStrategicReservePayouts(ctx, func(payout types.StrategicReservePayout) (stop bool) {
+		payouts = append(payouts, payout)
+		return false
+	})
+
+	for _, payout := range payouts {
+		due := payout.StreamedAmount(ctx.BlockTime().Unix()).Sub(payout.PaidAmount)
+		if !due.IsZero() {
+			err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.StrategicReservePoolName, payout.GetRecipient(), due)
+			if err != nil {
+				panic(err)
+			}
+			payout.PaidAmount = payout.PaidAmount.Add(due...)
+			k.SetStrategicReservePayout(ctx, payout)
+		}
+		if payout.Completed() {
+			k.DeleteOngoingStrategicReservePayout(ctx, payout.Id)
+		}
+	}
+}
+
+// GetNextStrategicReservePayoutID gets the next strategic reserve payout id
+func (k Keeper) GetNextStrategicReservePayoutID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextStrategicReservePayoutIDKey())
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextStrategicReservePayoutID sets the next strategic reserve payout id
+func (k Keeper) SetNextStrategicReservePayoutID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextStrategicReservePayoutIDKey(), sdk.Uint64ToBigEndian(id))
+}
+
+// SetStrategicReservePayout sets strategic reserve payout
+func (k Keeper) SetStrategicReservePayout(ctx sdk.Context, payout types.StrategicReservePayout) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&payout)
+	store.Set(types.StrategicReservePayoutsKey(payout.Id), bz)
+}
+
+// GetStrategicReservePayout gets strategic reserve payout
+func (k Keeper) GetStrategicReservePayout(ctx sdk.Context, id uint64) (payout types.StrategicReservePayout, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.StrategicReservePayoutsKey(id))
+	if bz == nil {
+		return payout, false
+	}
+	k.cdc.MustUnmarshal(bz, &payout)
+	return payout, true
+}
+
+// IterateStrategicReservePayouts iterates strategic reserve payouts
+func (k Keeper) IterateStrategicReservePayouts(ctx sdk.Context, handler func(payout types.StrategicReservePayout) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixStrategicReservePayouts)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var payout types.StrategicReservePayout
+		k.cdc.MustUnmarshal(iter.Value(), &payout)
+		if handler(payout) {
+			break
+		}
+	}
+}
+
+// SetOngoingStrategicReservePayout marks the strategic reserve payout as ongoing
+func (k Keeper) SetOngoingStrategicReservePayout(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.OngoingStrategicReservePayoutsKey(id), []byte{1})
+}
+
+// DeleteOngoingStrategicReservePayout unmarks the strategic reserve payout as ongoing
+func (k Keeper) DeleteOngoingStrategicReservePayout(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.OngoingStrategicReservePayoutsKey(id))
+}
+
+// IterateOngoingStrategicReservePayouts iterates ongoing strategic reserve payouts
+func (k Keeper) IterateOngoingStrategicReservePayouts(ctx sdk.Context, handler func(payout types.StrategicReservePayout) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixOngoingStrategicReservePayouts)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		id := sdk.BigEndianToUint64(iter.Key()[len(types.KeyPrefixOngoingStrategicReservePayouts):])
+		payout, found := k.GetStrategicReservePayout(ctx, id)
+		if !found {
+			panic("ongoing strategic reserve payout not found")
+		}
+		if handler(payout) {
+			break
+		}
+	}
+}
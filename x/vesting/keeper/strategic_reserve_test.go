@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	blacktypes "github.com/elysiumstation/blackfury/types"
+	"github.com/elysiumstation/blackfury/x/vesting"
+	"github.com/elysiumstation/blackfury/x/vesting/keeper"
+	"github.com/elysiumstation/blackfury/x/vesting/types"
+	"github.com/tharsis/ethermint/tests"
+)
+
+func (suite *KeeperTestSuite) TestStrategicReserve() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VestingKeeper
+	handler := vesting.NewVestingProposalHandler(k)
+	ctx := suite.ctx
+	c := sdk.WrapSDKContext(ctx)
+
+	queryReserve := func(ctx sdk.Context) *types.QueryStrategicReserveResponse {
+		res, err := k.StrategicReserve(sdk.WrapSDKContext(ctx), &types.QueryStrategicReserveRequest{})
+		require.NoError(err)
+		return res
+	}
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(blacktypes.BaseDenom, amount))
+	}
+	genesisReserve := queryReserve(ctx).Balance
+
+	// the custodian migrates its funds into the pool
+	custodian := sdk.AccAddress(suite.address.Bytes())
+	_, err := keeper.NewMsgServerImpl(k).FundStrategicReserve(c, &types.MsgFundStrategicReserve{
+		Sender: custodian.String(),
+		Amount: coins(10000),
+	})
+	require.NoError(err)
+	require.Equal(genesisReserve.Add(coins(10000)...), queryReserve(ctx).Balance)
+	require.True(suite.app.BankKeeper.GetBalance(ctx, custodian, blacktypes.BaseDenom).IsZero())
+
+	addr, _ := tests.NewAddrKey()
+	recipient := sdk.AccAddress(addr.Bytes())
+	spend := &types.StrategicReserveSpendProposal{
+		Title:       "spend",
+		Description: "spend at once",
+		Recipient:   recipient.String(),
+		Amount:      coins(1000),
+	}
+	require.NoError(spend.ValidateBasic())
+	require.NoError(handler(ctx, spend))
+	require.Equal(coins(1000), suite.app.BankKeeper.GetAllBalances(ctx, recipient))
+
+	// streaming payout
+	stream := *spend
+	stream.Amount = coins(4000)
+	stream.Duration = 400
+	require.NoError(handler(ctx, &stream))
+	reserve := queryReserve(ctx)
+	require.Equal(coins(4000), reserve.Committed)
+	require.Equal(reserve.Balance.Sub(coins(4000)), reserve.Available)
+
+	// the committed amount cannot be spent
+	overspend := *spend
+	overspend.Amount = reserve.Available.Add(coins(1)...)
+	require.ErrorIs(handler(ctx, &overspend), sdkerrors.ErrInsufficientFunds)
+	blocked := *spend
+	blocked.Recipient = authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+	require.ErrorIs(handler(ctx, &blocked), sdkerrors.ErrUnauthorized)
+
+	payAt := func(elapsed int64) sdk.Context {
+		// the last block of the payout period
+		ctx := ctx.WithBlockHeight(types.StrategicReservePayoutPeriod*(ctx.BlockHeight()/types.StrategicReservePayoutPeriod+1) - 1).
+			WithBlockTime(ctx.BlockTime().Add(time.Duration(elapsed) * time.Second))
+		k.PayStrategicReservePayouts(ctx)
+		return ctx
+	}
+
+	ctx = payAt(100)
+	require.Equal(coins(2000), suite.app.BankKeeper.GetAllBalances(ctx, recipient))
+	require.Equal(coins(3000), queryReserve(ctx).Committed)
+
+	// not paid out of the payout period
+	k.PayStrategicReservePayouts(ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(100 * time.Second)))
+	require.Equal(coins(2000), suite.app.BankKeeper.GetAllBalances(ctx, recipient))
+
+	ctx = payAt(400)
+	require.Equal(coins(5000), suite.app.BankKeeper.GetAllBalances(ctx, recipient))
+	require.True(queryReserve(ctx).Committed.IsZero())
+
+	res, err := k.StrategicReservePayouts(sdk.WrapSDKContext(ctx), &types.QueryStrategicReservePayoutsRequest{})
+	require.NoError(err)
+	require.Len(res.Payouts, 2)
+	require.Equal(coins(1000), res.Payouts[0].PaidAmount)
+	require.Equal(coins(4000), res.Payouts[1].PaidAmount)
+	require.Equal(uint64(400), res.Payouts[1].Duration)
+
+	res, err = k.StrategicReservePayouts(sdk.WrapSDKContext(ctx), &types.QueryStrategicReservePayoutsRequest{Ongoing: true})
+	require.NoError(err)
+	require.Empty(res.Payouts)
+}
+
+func (suite *KeeperTestSuite) TestStrategicReservePayoutGenesis() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VestingKeeper
+	handler := vesting.NewVestingProposalHandler(k)
+
+	addr, _ := tests.NewAddrKey()
+	recipient := sdk.AccAddress(addr.Bytes())
+	amount := sdk.NewCoins(sdk.NewInt64Coin(blacktypes.BaseDenom, 1000))
+	spend := &types.StrategicReserveSpendProposal{
+		Title:       "spend",
+		Description: "spend at once",
+		Recipient:   recipient.String(),
+		Amount:      amount,
+	}
+	require.NoError(handler(suite.ctx, spend))
+	stream := *spend
+	stream.Duration = 400
+	require.NoError(handler(suite.ctx, &stream))
+
+	genState := vesting.ExportGenesis(suite.ctx, k)
+	require.Len(genState.StrategicReservePayouts, 2)
+	require.Equal(uint64(3), genState.NextStrategicReservePayoutId)
+	require.NoError(genState.Validate())
+
+	// only the streaming payout is ongoing after import
+	suite.SetupTest()
+	k = suite.app.VestingKeeper
+	vesting.InitGenesis(suite.ctx, k, *genState)
+	require.Equal(uint64(3), k.GetNextStrategicReservePayoutID(suite.ctx))
+	var ongoing []uint64
+	k.IterateOngoingStrategicReservePayouts(suite.ctx, func(payout types.StrategicReservePayout) (stop bool) {
+		ongoing = append(ongoing, payout.Id)
+		return false
+	})
+	require.Equal([]uint64{2}, ongoing)
+	require.Equal(amount, k.GetStrategicReserveCommitted(suite.ctx))
+}
@@ -166,11 +166,19 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	vestingtypes.RegisterMsgServer(cfg.MsgServer(), vesting.NewMsgServerImpl(am.accountKeeper.(authkeeper.AccountKeeper), am.bankKeeper))
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(err)
+	}
 }
 
 // ConsensusVersion implements ConsensusVersion.
 func (am AppModule) ConsensusVersion() uint64 {
-	return 2
+	return 4
 }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the vesting module.
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"regexp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var reVestingBucketName = regexp.MustCompile(`^[a-z][a-z0-9_]{2,63}$`)
+
+// ValidateVestingBucketName checks that the name consists of 3 to 64 lowercase
+// letters, digits or underscores, starting with a letter
+func ValidateVestingBucketName(name string) error {
+	if !reVestingBucketName.MatchString(name) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid vesting bucket name %s", name)
+	}
+	return nil
+}
+
+// ValidateVestingBucketDestination checks that exactly one of the destination
+// module and address is given
+func ValidateVestingBucketDestination(module, addr string) error {
+	if (len(module) != 0) == (len(addr) != 0) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "only one of destination module and address must be given")
+	}
+	if len(addr) != 0 {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid destination address (%s)", err)
+		}
+	}
+	return nil
+}
+
+// ValidateVestingSchedule checks the amount, cliff, duration and claim period
+// of a vesting bucket
+func ValidateVestingSchedule(amount sdk.Int, cliff, duration, claimPeriod uint64) error {
+	if amount.IsNil() || !amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "vesting amount must be positive")
+	}
+	if duration == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "vesting duration must be positive")
+	}
+	if cliff > duration {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "vesting cliff %d exceeds duration %d", cliff, duration)
+	}
+	if claimPeriod == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "claim period must be positive")
+	}
+	return nil
+}
+
+// Validate performs basic validation of the vesting bucket
+func (b VestingBucket) Validate() error {
+	if err := ValidateVestingBucketName(b.Name); err != nil {
+		return err
+	}
+	if err := ValidateVestingSchedule(b.Amount, b.Cliff, b.Duration, b.ClaimPeriod); err != nil {
+		return err
+	}
+	if b.ClaimedAmount.IsNil() || b.ClaimedAmount.IsNegative() || b.ClaimedAmount.GT(b.Amount) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "claimed amount must be in [0, amount]")
+	}
+	return ValidateVestingBucketDestination(b.DestinationModule, b.DestinationAddr)
+}
+
+// GetAddress returns the account address which holds the unclaimed coins
+func (b VestingBucket) GetAddress() sdk.AccAddress {
+	return VestingBucketAddress(b.Name)
+}
+
+// VestingBucketAddress returns the account address derived from the bucket name
+func VestingBucketAddress(name string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(name)
+}
+
+func (b VestingBucket) GetDestinationAddr() sdk.AccAddress {
+	if len(b.DestinationAddr) == 0 {
+		return sdk.AccAddress{}
+	}
+	addr, err := sdk.AccAddressFromBech32(b.DestinationAddr)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// VestedAmount returns the amount vested at the unix time in seconds.
+// Nothing is vested before the cliff, and then the amount is vested linearly
+// from the start time over the duration.
+func (b VestingBucket) VestedAmount(blockTime int64) sdk.Int {
+	if blockTime < b.StartTime || uint64(blockTime-b.StartTime) < b.Cliff {
+		return sdk.ZeroInt()
+	}
+	elapsed := uint64(blockTime - b.StartTime)
+	if elapsed >= b.Duration {
+		return b.Amount
+	}
+	return b.Amount.Mul(sdk.NewIntFromUint64(elapsed)).Quo(sdk.NewIntFromUint64(b.Duration))
+}
@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elysiumstation/blackfury/x/vesting/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVestingBucket_VestedAmount(t *testing.T) {
+	bucket := types.VestingBucket{
+		Amount:    sdk.NewInt(1000),
+		StartTime: 1000,
+		Cliff:     100,
+		Duration:  400,
+	}
+	for _, tc := range []struct {
+		blockTime int64
+		vested    int64
+	}{
+		{999, 0},
+		{1000, 0},
+		{1099, 0},
+		{1100, 250},
+		{1201, 502},
+		{1400, 1000},
+		{2000, 1000},
+	} {
+		require.Equal(t, sdk.NewInt(tc.vested).String(), bucket.VestedAmount(tc.blockTime).String(), "block time %d", tc.blockTime)
+	}
+}
+
+func TestAddVestingBucketProposal_ValidateBasic(t *testing.T) {
+	valid := types.AddVestingBucketProposal{
+		Title:             "title",
+		Description:       "description",
+		Name:              "ecosystem_grants",
+		Amount:            sdk.NewInt(1000),
+		Cliff:             100,
+		Duration:          1000,
+		DestinationModule: "distribution",
+		ClaimPeriod:       10,
+	}
+	require.NoError(t, valid.ValidateBasic())
+
+	for _, malleate := range []func(p *types.AddVestingBucketProposal){
+		func(p *types.AddVestingBucketProposal) { p.Name = "Grants" },
+		func(p *types.AddVestingBucketProposal) { p.Name = "g" },
+		func(p *types.AddVestingBucketProposal) { p.Amount = sdk.ZeroInt() },
+		func(p *types.AddVestingBucketProposal) { p.Duration = 0 },
+		func(p *types.AddVestingBucketProposal) { p.Cliff = 1001 },
+		func(p *types.AddVestingBucketProposal) { p.ClaimPeriod = 0 },
+		func(p *types.AddVestingBucketProposal) { p.DestinationModule = "" },
+		func(p *types.AddVestingBucketProposal) { p.DestinationAddr = "xxx" },
+		func(p *types.AddVestingBucketProposal) { p.DestinationModule, p.DestinationAddr = "", "xxx" },
+	} {
+		p := valid
+		malleate(&p)
+		require.Error(t, p.ValidateBasic())
+	}
+}
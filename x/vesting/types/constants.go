@@ -7,13 +7,16 @@ const (
 	CommunityPoolVestingName = "community_pool_vesting"
 	TeamVestingName          = "team_vesting"
 
-	// Strate reserve pool controlled by governance.
-	// Not used now, maybe future.
+	// Strategic reserve pool controlled by governance.
 	StrategicReservePoolName = "strategic_reserve_pool"
 
 	StakingRewardVestingTime = blackfury.SecondsPer4Years
 	CommunityPoolVestingTime = blackfury.SecondsPer4Years
 	TeamVestingTime          = blackfury.SecondsPer4Years
 
+	// claim period in blocks of the genesis vesting buckets
 	ClaimVestedPeriod = 10
+
+	// period in blocks at which the streaming payouts of the strategic reserve are paid
+	StrategicReservePayoutPeriod = 10
 )
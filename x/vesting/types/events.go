@@ -0,0 +1,11 @@
+package types
+
+// vesting module event types
+const (
+	EventTypeMigrateStrategicReserve = "migrate_strategic_reserve"
+
+	AttributeKeyCustodian = "custodian"
+	AttributeKeyAmount    = "amount"
+
+	AttributeValueCategory = ModuleName
+)
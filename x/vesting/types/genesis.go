@@ -1,6 +1,10 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vetypes "github.com/elysiumstation/blackfury/x/ve/types"
+)
 
 // DefaultGenesis returns the default vesting genesis state
 func DefaultGenesis() *GenesisState {
@@ -12,6 +16,31 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	names := make(map[string]bool)
+	for _, bucket := range gs.VestingBuckets {
+		if err := bucket.Validate(); err != nil {
+			return err
+		}
+		if names[bucket.Name] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate vesting bucket %s", bucket.Name)
+		}
+		names[bucket.Name] = true
+	}
+
+	ids := make(map[uint64]bool)
+	for _, payout := range gs.StrategicReservePayouts {
+		if err := payout.Validate(); err != nil {
+			return err
+		}
+		if ids[payout.Id] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate strategic reserve payout %d", payout.Id)
+		}
+		if payout.Id == 0 || payout.Id >= gs.NextStrategicReservePayoutId {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "strategic reserve payout id %d must be in [1, next id %d)", payout.Id, gs.NextStrategicReservePayoutId)
+		}
+		ids[payout.Id] = true
+	}
+
 	return gs.Params.Validate()
 }
 
@@ -51,3 +80,25 @@ func (a Airdrop) GetTargetAddr() sdk.AccAddress {
 	}
 	return ta
 }
+
+// ValidateDelivery checks that the duration of the airdrop suits its delivery
+func (a Airdrop) ValidateDelivery() error {
+	switch a.Delivery {
+	case AIRDROP_DELIVERY_LIQUID:
+		if a.Duration != 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "liquid airdrop should not have duration")
+		}
+	case AIRDROP_DELIVERY_CONTINUOUS_VESTING:
+		if a.Duration == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "vesting airdrop should have duration")
+		}
+	case AIRDROP_DELIVERY_VE_LOCK:
+		// the unlock time is rounded down to the regulated period, so a shorter duration unlocks immediately
+		if a.Duration < vetypes.RegulatedPeriod || a.Duration > vetypes.MaxLockTime {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "ve lock airdrop duration should be in [%d, %d]", vetypes.RegulatedPeriod, vetypes.MaxLockTime)
+		}
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid airdrop delivery %s", a.Delivery)
+	}
+	return nil
+}
@@ -28,6 +28,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	Params              Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	AllocationAddresses AllocationAddresses `protobuf:"bytes,2,opt,name=allocation_addresses,json=allocationAddresses,proto3" json:"allocation_addresses"`
+	// vesting buckets, which are allocated at genesis if empty
+	VestingBuckets []VestingBucket `protobuf:"bytes,3,rep,name=vesting_buckets,json=vestingBuckets,proto3" json:"vesting_buckets"`
+	// strategic reserve payouts, which are ongoing until fully paid
+	StrategicReservePayouts []StrategicReservePayout `protobuf:"bytes,4,rep,name=strategic_reserve_payouts,json=strategicReservePayouts,proto3" json:"strategic_reserve_payouts"`
+	// next strategic reserve payout id
+	NextStrategicReservePayoutId uint64 `protobuf:"varint,5,opt,name=next_strategic_reserve_payout_id,json=nextStrategicReservePayoutId,proto3" json:"next_strategic_reserve_payout_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +83,27 @@ func (m *GenesisState) GetAllocationAddresses() AllocationAddresses {
 	return AllocationAddresses{}
 }
 
+func (m *GenesisState) GetVestingBuckets() []VestingBucket {
+	if m != nil {
+		return m.VestingBuckets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStrategicReservePayouts() []StrategicReservePayout {
+	if m != nil {
+		return m.StrategicReservePayouts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextStrategicReservePayoutId() uint64 {
+	if m != nil {
+		return m.NextStrategicReservePayoutId
+	}
+	return 0
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	Allocation AllocationAmounts `protobuf:"bytes,1,opt,name=allocation,proto3" json:"allocation"`
@@ -214,40 +241,46 @@ func init() {
 }
 
 var fileDescriptor_e8d1f381397fc63a = []byte{
-	// 523 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0xd4, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0x06, 0x70, 0xbb, 0xa4, 0x41, 0x5c, 0x0b, 0x55, 0x9c, 0x80, 0x22, 0x04, 0x4e, 0x95, 0x81,
-	0x7f, 0x12, 0xb6, 0x4a, 0xb7, 0x6c, 0x0d, 0x43, 0xc5, 0x50, 0xa9, 0x18, 0xc1, 0x50, 0x09, 0xac,
-	0xb3, 0xef, 0x70, 0xad, 0xd8, 0x3e, 0xeb, 0xee, 0xb5, 0x21, 0xdf, 0x80, 0xb1, 0x23, 0x63, 0x77,
-	0x24, 0x3e, 0x47, 0xc7, 0x8e, 0x88, 0xa1, 0x42, 0xc9, 0x17, 0x41, 0x3e, 0x9f, 0x9d, 0xb4, 0x89,
-	0x54, 0xc9, 0x53, 0xa2, 0xe4, 0xb9, 0xdf, 0xe3, 0xf7, 0x6c, 0x1f, 0x1a, 0x7a, 0x11, 0xf6, 0x27,
-	0x5f, 0x33, 0x3e, 0xb5, 0x73, 0x2a, 0x20, 0x4c, 0x02, 0x3b, 0xdf, 0xb3, 0x03, 0x9a, 0x50, 0x11,
-	0x0a, 0x2b, 0xe5, 0x0c, 0x98, 0xd1, 0xab, 0x33, 0x96, 0xca, 0x58, 0xf9, 0xde, 0xe3, 0x5e, 0xc0,
-	0x02, 0x26, 0x03, 0x76, 0xf1, 0xad, 0xcc, 0x0e, 0x7f, 0xeb, 0x68, 0xfb, 0xb0, 0x5c, 0xfd, 0x01,
-	0x30, 0x50, 0x63, 0x84, 0xda, 0x29, 0xe6, 0x38, 0x16, 0x7d, 0x7d, 0x57, 0x7f, 0xb1, 0xf5, 0xe6,
-	0x89, 0xb5, 0x4e, 0xb3, 0x8e, 0x65, 0x66, 0xdc, 0xba, 0xb8, 0x1a, 0x68, 0x8e, 0x5a, 0x61, 0x78,
-	0xa8, 0x87, 0xa3, 0x88, 0xf9, 0x18, 0x42, 0x96, 0xb8, 0x98, 0x10, 0x4e, 0x85, 0xa0, 0xa2, 0xbf,
-	0x21, 0xa5, 0x97, 0xeb, 0xa5, 0x83, 0x7a, 0xc5, 0x41, 0xb5, 0x40, 0xb1, 0x5d, 0xbc, 0xfa, 0xd7,
-	0xf0, 0x33, 0x6a, 0x97, 0xdd, 0xc6, 0x11, 0x42, 0x8b, 0x80, 0xba, 0xda, 0xe7, 0xb7, 0x76, 0xc4,
-	0x2c, 0x4b, 0xa0, 0x6a, 0x58, 0x02, 0x46, 0xad, 0x9f, 0xe7, 0x03, 0x6d, 0xf8, 0x6b, 0x13, 0x75,
-	0x56, 0xd2, 0xc6, 0x7b, 0xb4, 0x0d, 0x0c, 0x70, 0xe4, 0x62, 0xf9, 0x83, 0x2c, 0xbb, 0x37, 0xb6,
-	0x0a, 0xe3, 0xef, 0xd5, 0xe0, 0x59, 0x10, 0xc2, 0x69, 0xe6, 0x59, 0x3e, 0x8b, 0x6d, 0x9f, 0x89,
-	0x98, 0x09, 0xf5, 0xf1, 0x5a, 0x90, 0x89, 0x0d, 0xd3, 0x94, 0x0a, 0xeb, 0x5d, 0x02, 0xce, 0x96,
-	0x34, 0x4a, 0xd3, 0xf8, 0x88, 0x1e, 0xe0, 0x90, 0x13, 0xce, 0xd2, 0x0a, 0xdd, 0x68, 0x84, 0xde,
-	0x57, 0x8a, 0x62, 0x4f, 0x50, 0x27, 0xa7, 0xae, 0x1a, 0xbd, 0x92, 0xef, 0x34, 0x92, 0x77, 0x72,
-	0xfa, 0xa9, 0x74, 0x94, 0xed, 0xa1, 0x87, 0x02, 0xf0, 0xa4, 0x80, 0x39, 0xfd, 0x86, 0x39, 0xa9,
-	0xfc, 0x56, 0x23, 0xbf, 0xab, 0x30, 0x47, 0x5a, 0x8b, 0x0e, 0x9f, 0xc5, 0x71, 0x96, 0x84, 0x30,
-	0x75, 0x53, 0xc6, 0xea, 0x2d, 0xdf, 0x6c, 0xd6, 0x51, 0x63, 0xc7, 0x8c, 0x55, 0x5b, 0x7f, 0x8a,
-	0xfa, 0x02, 0x38, 0x06, 0x1a, 0x84, 0xbe, 0xcb, 0xa9, 0xa0, 0x3c, 0xa7, 0x55, 0x4d, 0xbb, 0x51,
-	0xcd, 0xa3, 0xda, 0x73, 0x4a, 0x4e, 0x35, 0x7d, 0x41, 0x5d, 0xa0, 0x38, 0xbe, 0x79, 0x3f, 0xee,
-	0x36, 0x2a, 0xe9, 0x14, 0xd4, 0xb5, 0x3b, 0x32, 0x3c, 0xd3, 0x51, 0x77, 0xcd, 0xfb, 0x63, 0xbc,
-	0x42, 0x9d, 0xeb, 0xbd, 0x84, 0xf0, 0xf2, 0xa1, 0x75, 0x76, 0x96, 0x15, 0x42, 0xb8, 0x71, 0x88,
-	0x76, 0x57, 0x77, 0xc3, 0xcf, 0x04, 0x30, 0x12, 0xe2, 0xf2, 0x2d, 0x2e, 0x1f, 0x4d, 0xe7, 0xe9,
-	0xcd, 0x29, 0xdf, 0x56, 0xa9, 0x02, 0x1a, 0xb5, 0x7e, 0x9c, 0x0f, 0xb4, 0xf1, 0xd1, 0xc5, 0xcc,
-	0xd4, 0x2f, 0x67, 0xa6, 0xfe, 0x6f, 0x66, 0xea, 0x67, 0x73, 0x53, 0xbb, 0x9c, 0x9b, 0xda, 0x9f,
-	0xb9, 0xa9, 0x9d, 0xec, 0x2f, 0xcd, 0x49, 0xa3, 0xa9, 0x08, 0xb3, 0x58, 0x80, 0xbc, 0x70, 0x7b,
-	0x71, 0xa8, 0x7d, 0xaf, 0x8f, 0x35, 0x39, 0xb8, 0xd7, 0x96, 0xc7, 0xd4, 0xfe, 0xff, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x3a, 0x18, 0xfe, 0x5d, 0xf8, 0x04, 0x00, 0x00,
+	// 615 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x4e, 0xd4, 0x40,
+	0x18, 0xc7, 0x5b, 0xb6, 0xac, 0x71, 0x40, 0xc8, 0x76, 0x51, 0x2b, 0xc1, 0xee, 0x66, 0x4d, 0x14,
+	0x8d, 0xb6, 0x01, 0x6e, 0xdc, 0x58, 0x13, 0x09, 0x07, 0x12, 0x2c, 0xd1, 0x03, 0x89, 0x36, 0xd3,
+	0xce, 0x58, 0x9a, 0xdd, 0x76, 0x9a, 0x99, 0x69, 0x65, 0xdf, 0xc0, 0x23, 0x47, 0x8f, 0xdc, 0x7d,
+	0x03, 0x9f, 0x80, 0x23, 0x47, 0xe3, 0x81, 0x18, 0x78, 0x11, 0xd3, 0xe9, 0x74, 0x77, 0xd9, 0xad,
+	0x31, 0xe9, 0x69, 0x9b, 0x99, 0xff, 0xff, 0xf7, 0xdf, 0xf9, 0xbe, 0x2f, 0x1f, 0xe8, 0x79, 0x43,
+	0xe8, 0x0f, 0xbe, 0xa4, 0x74, 0x64, 0x67, 0x98, 0xf1, 0x30, 0x0e, 0xec, 0x6c, 0xcb, 0x0e, 0x70,
+	0x8c, 0x59, 0xc8, 0xac, 0x84, 0x12, 0x4e, 0xf4, 0xb5, 0xb1, 0xc6, 0x92, 0x1a, 0x2b, 0xdb, 0x5a,
+	0x5f, 0x0b, 0x48, 0x40, 0x84, 0xc0, 0xce, 0xbf, 0x0a, 0xed, 0x7a, 0x35, 0xaf, 0xb4, 0x09, 0x4d,
+	0xef, 0x67, 0x03, 0x2c, 0xef, 0x17, 0x09, 0xc7, 0x1c, 0x72, 0xac, 0xef, 0x82, 0x66, 0x02, 0x29,
+	0x8c, 0x98, 0xa1, 0x76, 0xd5, 0xcd, 0xa5, 0xed, 0x0d, 0xab, 0x2a, 0xd1, 0x3a, 0x12, 0x9a, 0xbe,
+	0x76, 0x79, 0xdd, 0x51, 0x1c, 0xe9, 0xd0, 0x3d, 0xb0, 0x06, 0x87, 0x43, 0xe2, 0x43, 0x1e, 0x92,
+	0xd8, 0x85, 0x08, 0x51, 0xcc, 0x18, 0x66, 0xc6, 0x82, 0x20, 0xbd, 0xac, 0x26, 0xed, 0x8d, 0x1d,
+	0x7b, 0xa5, 0x41, 0x62, 0xdb, 0x70, 0xfe, 0x4a, 0x77, 0xc0, 0xaa, 0x34, 0xbb, 0x5e, 0xea, 0x0f,
+	0x30, 0x67, 0x46, 0xa3, 0xdb, 0xd8, 0x5c, 0xda, 0x7e, 0x56, 0x8d, 0xff, 0x58, 0x7c, 0xf6, 0x85,
+	0x56, 0x82, 0x57, 0xb2, 0xe9, 0x43, 0xa6, 0xc7, 0xe0, 0x09, 0xe3, 0x14, 0x72, 0x1c, 0x84, 0xbe,
+	0x4b, 0x31, 0xc3, 0x34, 0xc3, 0x6e, 0x02, 0x47, 0x24, 0xe5, 0xcc, 0xd0, 0x04, 0xfd, 0x75, 0x35,
+	0xfd, 0xb8, 0xb4, 0x39, 0x85, 0xeb, 0x48, 0x98, 0x64, 0xcc, 0x63, 0x56, 0x79, 0xcb, 0xf4, 0x77,
+	0xa0, 0x1b, 0xe3, 0x33, 0xee, 0xfe, 0x2b, 0xd4, 0x0d, 0x91, 0xb1, 0xd8, 0x55, 0x37, 0x35, 0x67,
+	0x23, 0xd7, 0x55, 0x87, 0x1c, 0xa0, 0xde, 0x27, 0xd0, 0x2c, 0xfa, 0xa0, 0x1f, 0x02, 0x30, 0x29,
+	0x96, 0xec, 0xdc, 0x8b, 0xff, 0xd6, 0x3b, 0x22, 0x69, 0xcc, 0xcb, 0x6a, 0x4f, 0x01, 0x76, 0xb5,
+	0xef, 0x17, 0x1d, 0xa5, 0xf7, 0x63, 0x11, 0xb4, 0xe6, 0xd4, 0xfa, 0x7b, 0xb0, 0xcc, 0x09, 0x87,
+	0x43, 0x17, 0x8a, 0x03, 0x11, 0x76, 0xbf, 0x6f, 0xe5, 0x8c, 0xdf, 0xd7, 0x9d, 0xe7, 0x41, 0xc8,
+	0x4f, 0x53, 0xcf, 0xf2, 0x49, 0x64, 0xfb, 0x84, 0x45, 0x84, 0xc9, 0x9f, 0x37, 0x0c, 0x0d, 0x6c,
+	0x3e, 0x4a, 0x30, 0xb3, 0x0e, 0x62, 0xee, 0x2c, 0x09, 0x46, 0xc1, 0xd4, 0x3f, 0x80, 0x15, 0x18,
+	0x52, 0x44, 0x49, 0x52, 0x42, 0x17, 0x6a, 0x41, 0x1f, 0x48, 0x8a, 0xc4, 0x9e, 0x80, 0x56, 0x86,
+	0xdd, 0x72, 0x5a, 0x24, 0xb9, 0x51, 0x8b, 0xbc, 0x9a, 0x61, 0x39, 0x48, 0x92, 0xed, 0x81, 0x87,
+	0x8c, 0xc3, 0x41, 0x0e, 0xa6, 0xf8, 0x2b, 0xa4, 0xa8, 0xe4, 0x6b, 0xb5, 0xf8, 0x6d, 0x09, 0x73,
+	0x04, 0x6b, 0x92, 0xe1, 0x93, 0x28, 0x4a, 0xe3, 0x90, 0x8f, 0xdc, 0x84, 0x90, 0x71, 0xc9, 0x17,
+	0xeb, 0x65, 0x8c, 0x61, 0x47, 0x84, 0x94, 0xa5, 0x3f, 0x05, 0xc6, 0xfc, 0x14, 0xca, 0x98, 0x66,
+	0xad, 0x98, 0x47, 0xb3, 0x53, 0x2f, 0x93, 0x3e, 0x83, 0x36, 0xc7, 0x30, 0x9a, 0xed, 0xc7, 0xbd,
+	0x5a, 0x21, 0xad, 0x1c, 0x75, 0xa7, 0x23, 0xbd, 0x73, 0x15, 0xb4, 0x2b, 0x76, 0x89, 0xfe, 0x0a,
+	0xb4, 0xee, 0xe6, 0x22, 0x44, 0x8b, 0xa1, 0x75, 0x56, 0xa7, 0x29, 0x08, 0x51, 0x7d, 0x1f, 0x74,
+	0xe7, 0xab, 0xe1, 0xa7, 0x8c, 0x13, 0x14, 0xc2, 0x62, 0xa3, 0x15, 0xa3, 0xe9, 0x3c, 0x9d, 0x7d,
+	0xe5, 0xdb, 0x52, 0x95, 0x83, 0x76, 0xb5, 0x6f, 0x17, 0x1d, 0xa5, 0x7f, 0x78, 0x79, 0x63, 0xaa,
+	0x57, 0x37, 0xa6, 0xfa, 0xe7, 0xc6, 0x54, 0xcf, 0x6f, 0x4d, 0xe5, 0xea, 0xd6, 0x54, 0x7e, 0xdd,
+	0x9a, 0xca, 0xc9, 0xce, 0xd4, 0x3b, 0xf1, 0x70, 0xc4, 0xc2, 0x34, 0x62, 0x5c, 0xfc, 0x71, 0x7b,
+	0xb2, 0xb4, 0xcf, 0xc6, 0x6b, 0x5b, 0x3c, 0xdc, 0x6b, 0x8a, 0x95, 0xbd, 0xf3, 0x77, 0x00, 0xba,
+	0x67, 0xd5, 0x97, 0x28, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -270,6 +303,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextStrategicReservePayoutId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextStrategicReservePayoutId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.StrategicReservePayouts) > 0 {
+		for iNdEx := len(m.StrategicReservePayouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StrategicReservePayouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.VestingBuckets) > 0 {
+		for iNdEx := len(m.VestingBuckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingBuckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.AllocationAddresses.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -477,6 +543,21 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.AllocationAddresses.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.VestingBuckets) > 0 {
+		for _, e := range m.VestingBuckets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StrategicReservePayouts) > 0 {
+		for _, e := range m.StrategicReservePayouts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextStrategicReservePayoutId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextStrategicReservePayoutId))
+	}
 	return n
 }
 
@@ -632,6 +713,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingBuckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingBuckets = append(m.VestingBuckets, VestingBucket{})
+			if err := m.VestingBuckets[len(m.VestingBuckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategicReservePayouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StrategicReservePayouts = append(m.StrategicReservePayouts, StrategicReservePayout{})
+			if err := m.StrategicReservePayouts[len(m.StrategicReservePayouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextStrategicReservePayoutId", wireType)
+			}
+			m.NextStrategicReservePayoutId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextStrategicReservePayoutId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -56,3 +56,54 @@ func TestAirdrop_GetTargetAddr(t *testing.T) {
 	}
 	require.Equal(t, addrStr, airdrop.GetTargetAddr().String())
 }
+
+func TestGenesisState_Validate_VestingBuckets(t *testing.T) {
+	app.Setup(false)
+
+	bucket := types.VestingBucket{
+		Name:              types.CommunityPoolVestingName,
+		Amount:            sdk.NewInt(1000),
+		Duration:          1000,
+		DestinationModule: "distribution",
+		ClaimPeriod:       1,
+		ClaimedAmount:     sdk.ZeroInt(),
+	}
+	gs := types.DefaultGenesis()
+	gs.VestingBuckets = []types.VestingBucket{bucket}
+	require.NoError(t, gs.Validate())
+
+	gs.VestingBuckets = append(gs.VestingBuckets, bucket)
+	require.Error(t, gs.Validate())
+
+	bucket.Duration = 0
+	gs.VestingBuckets = []types.VestingBucket{bucket}
+	require.Error(t, gs.Validate())
+}
+
+func TestGenesisState_Validate_StrategicReservePayouts(t *testing.T) {
+	app.Setup(false)
+
+	payout := types.StrategicReservePayout{
+		Id:         1,
+		Recipient:  "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+		Amount:     sdk.NewCoins(sdk.NewInt64Coin("afury", 1000)),
+		Duration:   100,
+		PaidAmount: sdk.NewCoins(sdk.NewInt64Coin("afury", 100)),
+	}
+	gs := types.DefaultGenesis()
+	gs.StrategicReservePayouts = []types.StrategicReservePayout{payout}
+	gs.NextStrategicReservePayoutId = 2
+	require.NoError(t, gs.Validate())
+
+	// the payout id must be less than the next id
+	gs.NextStrategicReservePayoutId = 1
+	require.Error(t, gs.Validate())
+
+	gs.NextStrategicReservePayoutId = 3
+	gs.StrategicReservePayouts = append(gs.StrategicReservePayouts, payout)
+	require.Error(t, gs.Validate())
+
+	payout.PaidAmount = sdk.NewCoins(sdk.NewInt64Coin("afury", 1001))
+	gs.StrategicReservePayouts = []types.StrategicReservePayout{payout}
+	require.Error(t, gs.Validate())
+}
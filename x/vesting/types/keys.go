@@ -25,6 +25,15 @@ const (
 	prefixAirdropsTotalAmount
 	prefixAirdrops
 	prefixAirdropsCompleted
+	prefixNextMerkleAirdropID
+	prefixMerkleAirdrops
+	prefixMerkleAirdropClaims
+	prefixVestingBuckets
+	prefixNextStrategicReservePayoutID
+	prefixStrategicReservePayouts
+	prefixOngoingStrategicReservePayouts
+	prefixAirdropExpiryQueue
+	prefixAirdropsFailed
 )
 
 var (
@@ -32,6 +41,16 @@ var (
 	KeyPrefixAirdropsTotalAmount = []byte{prefixAirdropsTotalAmount}
 	KeyPrefixAirdrops            = []byte{prefixAirdrops}
 	KeyPrefixAirdropsCompleted   = []byte{prefixAirdropsCompleted}
+	KeyPrefixNextMerkleAirdropID = []byte{prefixNextMerkleAirdropID}
+	KeyPrefixMerkleAirdrops      = []byte{prefixMerkleAirdrops}
+	KeyPrefixMerkleAirdropClaims = []byte{prefixMerkleAirdropClaims}
+	KeyPrefixVestingBuckets      = []byte{prefixVestingBuckets}
+
+	KeyPrefixNextStrategicReservePayoutID   = []byte{prefixNextStrategicReservePayoutID}
+	KeyPrefixStrategicReservePayouts        = []byte{prefixStrategicReservePayouts}
+	KeyPrefixOngoingStrategicReservePayouts = []byte{prefixOngoingStrategicReservePayouts}
+	KeyPrefixAirdropExpiryQueue             = []byte{prefixAirdropExpiryQueue}
+	KeyPrefixAirdropsFailed                 = []byte{prefixAirdropsFailed}
 )
 
 func AllocationAddrKey() []byte {
@@ -49,3 +68,47 @@ func AirdropsKey(acc sdk.AccAddress) []byte {
 func AirdropsCompletedKey(acc sdk.AccAddress) []byte {
 	return append(KeyPrefixAirdropsCompleted, address.MustLengthPrefix(acc)...)
 }
+
+func AirdropsFailedKey(acc sdk.AccAddress) []byte {
+	return append(KeyPrefixAirdropsFailed, address.MustLengthPrefix(acc)...)
+}
+
+func AirdropExpiryQueuePrefix(height uint64) []byte {
+	return append(KeyPrefixAirdropExpiryQueue, sdk.Uint64ToBigEndian(height)...)
+}
+
+func AirdropExpiryQueueKey(height uint64, acc sdk.AccAddress) []byte {
+	return append(AirdropExpiryQueuePrefix(height), address.MustLengthPrefix(acc)...)
+}
+
+func NextMerkleAirdropIDKey() []byte {
+	return KeyPrefixNextMerkleAirdropID
+}
+
+func MerkleAirdropsKey(id uint64) []byte {
+	return append(KeyPrefixMerkleAirdrops, sdk.Uint64ToBigEndian(id)...)
+}
+
+func MerkleAirdropClaimsPrefix(id uint64) []byte {
+	return append(KeyPrefixMerkleAirdropClaims, sdk.Uint64ToBigEndian(id)...)
+}
+
+func MerkleAirdropClaimsKey(id uint64, acc sdk.AccAddress) []byte {
+	return append(MerkleAirdropClaimsPrefix(id), address.MustLengthPrefix(acc)...)
+}
+
+func VestingBucketKey(name string) []byte {
+	return append(KeyPrefixVestingBuckets, []byte(name)...)
+}
+
+func NextStrategicReservePayoutIDKey() []byte {
+	return KeyPrefixNextStrategicReservePayoutID
+}
+
+func StrategicReservePayoutsKey(id uint64) []byte {
+	return append(KeyPrefixStrategicReservePayouts, sdk.Uint64ToBigEndian(id)...)
+}
+
+func OngoingStrategicReservePayoutsKey(id uint64) []byte {
+	return append(KeyPrefixOngoingStrategicReservePayouts, sdk.Uint64ToBigEndian(id)...)
+}
@@ -39,3 +39,24 @@ func TestAirdropsCompletedKey(t *testing.T) {
 	key := types.AirdropsCompletedKey(addr)
 	require.Equal(t, "0414dcd3b2e3d86a013b5b5a823b30f8fb791bbc0ea1", hex.EncodeToString(key))
 }
+
+func TestAirdropExpiryQueueKey(t *testing.T) {
+	app.Setup(false)
+	addrStr := "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm"
+	addr, err := sdk.AccAddressFromBech32(addrStr)
+	require.NoError(t, err)
+
+	key := types.AirdropExpiryQueueKey(100, addr)
+	require.Equal(t, "0c000000000000006414dcd3b2e3d86a013b5b5a823b30f8fb791bbc0ea1", hex.EncodeToString(key))
+}
+
+func TestVestingBucketKey(t *testing.T) {
+	key := types.VestingBucketKey("team_vesting")
+	require.Equal(t, "087465616d5f76657374696e67", hex.EncodeToString(key))
+}
+
+func TestStrategicReservePayoutsKey(t *testing.T) {
+	require.Equal(t, "09", hex.EncodeToString(types.NextStrategicReservePayoutIDKey()))
+	require.Equal(t, "0a0000000000000001", hex.EncodeToString(types.StrategicReservePayoutsKey(1)))
+	require.Equal(t, "0b0000000000000001", hex.EncodeToString(types.OngoingStrategicReservePayoutsKey(1)))
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MerkleAirdropLeaf returns the Merkle leaf of the claim of amount by the address.
+func MerkleAirdropLeaf(addr sdk.AccAddress, amount sdk.Int) []byte {
+	hash := sha256.New()
+	hash.Write(address.MustLengthPrefix(addr))
+	hash.Write([]byte(amount.String()))
+	return hash.Sum(nil)
+}
+
+// VerifyMerkleProof checks that the proof leads from the leaf to the root.
+// The pairs of nodes are hashed in sorted order, so the proof does not contain the positions.
+func VerifyMerkleProof(root []byte, leaf []byte, proof [][]byte) bool {
+	computed := leaf
+	for _, node := range proof {
+		computed = hashMerklePair(computed, node)
+	}
+	return bytes.Equal(computed, root)
+}
+
+// MerkleRoot returns the Merkle root of the leaves.
+func MerkleRoot(leaves [][]byte) []byte {
+	if len(leaves) == 0 {
+		return nil
+	}
+	level := leaves
+	for len(level) > 1 {
+		level = nextMerkleLevel(level)
+	}
+	return level[0]
+}
+
+// MerkleProof returns the Merkle proof of the leaf at the index.
+func MerkleProof(leaves [][]byte, index int) [][]byte {
+	var proof [][]byte
+	level := leaves
+	for len(level) > 1 {
+		sibling := index ^ 1
+		if sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+		level = nextMerkleLevel(level)
+		index /= 2
+	}
+	return proof
+}
+
+// ParseMerkleHash parses a hex encoded Merkle root or proof node.
+func ParseMerkleHash(s string) ([]byte, error) {
+	bz, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid merkle hash %s: %s", s, err)
+	}
+	if len(bz) != sha256.Size {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid merkle hash length %d", len(bz))
+	}
+	return bz, nil
+}
+
+// nextMerkleLevel hashes the pairs of nodes; an odd last node is promoted as is.
+func nextMerkleLevel(level [][]byte) [][]byte {
+	next := make([][]byte, 0, (len(level)+1)/2)
+	for i := 0; i < len(level); i += 2 {
+		if i+1 < len(level) {
+			next = append(next, hashMerklePair(level[i], level[i+1]))
+		} else {
+			next = append(next, level[i])
+		}
+	}
+	return next
+}
+
+func hashMerklePair(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	hash := sha256.Sum256(append(append([]byte{}, a...), b...))
+	return hash[:]
+}
@@ -0,0 +1,41 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elysiumstation/blackfury/x/vesting/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMerkleProof(t *testing.T) {
+	var leaves [][]byte
+	for i := 1; i <= 5; i++ {
+		leaves = append(leaves, types.MerkleAirdropLeaf(sdk.AccAddress([]byte{byte(i)}), sdk.NewInt(int64(i))))
+	}
+	root := types.MerkleRoot(leaves)
+
+	for i, leaf := range leaves {
+		require.True(t, types.VerifyMerkleProof(root, leaf, types.MerkleProof(leaves, i)))
+	}
+
+	// proof of another leaf
+	require.False(t, types.VerifyMerkleProof(root, leaves[0], types.MerkleProof(leaves, 1)))
+	// leaf with another amount
+	wrongLeaf := types.MerkleAirdropLeaf(sdk.AccAddress([]byte{1}), sdk.NewInt(2))
+	require.False(t, types.VerifyMerkleProof(root, wrongLeaf, types.MerkleProof(leaves, 0)))
+
+	// single leaf is its own root
+	require.True(t, types.VerifyMerkleProof(leaves[0], leaves[0], types.MerkleProof(leaves[:1], 0)))
+}
+
+func TestParseMerkleHash(t *testing.T) {
+	_, err := types.ParseMerkleHash("xx")
+	require.Error(t, err)
+	_, err = types.ParseMerkleHash("abcd")
+	require.Error(t, err)
+	hash, err := types.ParseMerkleHash(hex.EncodeToString(make([]byte, 32)))
+	require.NoError(t, err)
+	require.Len(t, hash, 32)
+}
@@ -7,14 +7,24 @@ import (
 
 const (
 	TypeMsgAddAirdrops          = "add_airdrops"
+	TypeMsgUpdateAirdrop        = "update_airdrop"
+	TypeMsgCancelAirdrops       = "cancel_airdrops"
 	TypeMsgExecuteAirdrops      = "execute_airdrops"
 	TypeMsgSetAllocationAddress = "set_allocation_address"
+	TypeMsgAddMerkleAirdrop     = "add_merkle_airdrop"
+	TypeMsgClaimAirdrop         = "claim_airdrop"
+	TypeMsgFundStrategicReserve = "fund_strategic_reserve"
 )
 
 var (
 	_ sdk.Msg = &MsgAddAirdrops{}
+	_ sdk.Msg = &MsgUpdateAirdrop{}
+	_ sdk.Msg = &MsgCancelAirdrops{}
 	_ sdk.Msg = &MsgExecuteAirdrops{}
 	_ sdk.Msg = &MsgSetAllocationAddress{}
+	_ sdk.Msg = &MsgAddMerkleAirdrop{}
+	_ sdk.Msg = &MsgClaimAirdrop{}
+	_ sdk.Msg = &MsgFundStrategicReserve{}
 )
 
 // Route implements sdk.Msg
@@ -35,25 +45,105 @@ func (m *MsgAddAirdrops) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
 	}
 	for _, airdrop := range m.Airdrops {
-		_, err := sdk.AccAddressFromBech32(airdrop.TargetAddr)
-		if err != nil {
-			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid airdrop target address (%s)", err)
-		}
-		err = airdrop.Amount.Validate()
+		err = validateAirdrop(airdrop)
 		if err != nil {
 			return err
 		}
-		// Only native fury coin is allowed
-		_, err = sdk.ParseCoinNormalized(airdrop.Amount.String())
+	}
+	return nil
+}
+
+func validateAirdrop(airdrop Airdrop) error {
+	_, err := sdk.AccAddressFromBech32(airdrop.TargetAddr)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid airdrop target address (%s)", err)
+	}
+	err = airdrop.Amount.Validate()
+	if err != nil {
+		return err
+	}
+	// Only native fury coin is allowed
+	_, err = sdk.ParseCoinNormalized(airdrop.Amount.String())
+	if err != nil {
+		return err
+	}
+	return airdrop.ValidateDelivery()
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgAddAirdrops) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgUpdateAirdrop) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgUpdateAirdrop) Type() string { return TypeMsgUpdateAirdrop }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgUpdateAirdrop) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgUpdateAirdrop) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return validateAirdrop(m.Airdrop)
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgUpdateAirdrop) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgCancelAirdrops) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgCancelAirdrops) Type() string { return TypeMsgCancelAirdrops }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgCancelAirdrops) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgCancelAirdrops) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if len(m.TargetAddrs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no airdrop target address")
+	}
+	seen := make(map[string]bool)
+	for _, targetAddr := range m.TargetAddrs {
+		addr, err := sdk.AccAddressFromBech32(targetAddr)
 		if err != nil {
-			return err
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid airdrop target address (%s)", err)
+		}
+		if seen[addr.String()] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate airdrop target address %s", targetAddr)
 		}
+		seen[addr.String()] = true
 	}
 	return nil
 }
 
 // GetSigners implements sdk.Msg
-func (m *MsgAddAirdrops) GetSigners() []sdk.AccAddress {
+func (m *MsgCancelAirdrops) GetSigners() []sdk.AccAddress {
 	sender, err := sdk.AccAddressFromBech32(m.Sender)
 	if err != nil {
 		panic(err)
@@ -133,3 +223,121 @@ func (m *MsgSetAllocationAddress) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+// Route implements sdk.Msg
+func (m *MsgAddMerkleAirdrop) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgAddMerkleAirdrop) Type() string { return TypeMsgAddMerkleAirdrop }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgAddMerkleAirdrop) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgAddMerkleAirdrop) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	_, err = ParseMerkleHash(m.MerkleRoot)
+	if err != nil {
+		return err
+	}
+	err = m.TotalAmount.Validate()
+	if err != nil {
+		return err
+	}
+	if !m.TotalAmount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "total amount should be positive")
+	}
+	// Only native fury coin is allowed
+	_, err = sdk.ParseCoinNormalized(m.TotalAmount.String())
+	if err != nil {
+		return err
+	}
+	if m.ClaimDeadline.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty claim deadline")
+	}
+	return Airdrop{Delivery: m.Delivery, Duration: m.Duration}.ValidateDelivery()
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgAddMerkleAirdrop) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgClaimAirdrop) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgClaimAirdrop) Type() string { return TypeMsgClaimAirdrop }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgClaimAirdrop) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgClaimAirdrop) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount should be positive")
+	}
+	for _, node := range m.Proof {
+		_, err = ParseMerkleHash(node)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgClaimAirdrop) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgFundStrategicReserve) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgFundStrategicReserve) Type() string { return TypeMsgFundStrategicReserve }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgFundStrategicReserve) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgFundStrategicReserve) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid fund amount %s", m.Amount)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgFundStrategicReserve) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
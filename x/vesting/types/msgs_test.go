@@ -1,11 +1,14 @@
 package types_test
 
 import (
+	"encoding/hex"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/elysiumstation/blackfury/app"
 	blacktypes "github.com/elysiumstation/blackfury/types"
+	vetypes "github.com/elysiumstation/blackfury/x/ve/types"
 	"github.com/elysiumstation/blackfury/x/vesting/types"
 	"github.com/stretchr/testify/require"
 )
@@ -40,6 +43,71 @@ func TestMsgAddAirdrops_ValidateBasic(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc:   "liquid airdrop with duration",
+			sender: "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+			airdrops: []types.Airdrop{
+				{
+					TargetAddr: "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+					Amount:     sdk.NewCoin(blacktypes.AttoFuryDenom, sdk.NewInt(1)),
+					Duration:   1,
+				},
+			},
+		},
+		{
+			desc:   "vesting airdrop without duration",
+			sender: "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+			airdrops: []types.Airdrop{
+				{
+					TargetAddr: "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+					Amount:     sdk.NewCoin(blacktypes.AttoFuryDenom, sdk.NewInt(1)),
+					Delivery:   types.AIRDROP_DELIVERY_CONTINUOUS_VESTING,
+				},
+			},
+		},
+		{
+			desc:   "ve lock airdrop with too long duration",
+			sender: "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+			airdrops: []types.Airdrop{
+				{
+					TargetAddr: "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+					Amount:     sdk.NewCoin(blacktypes.AttoFuryDenom, sdk.NewInt(1)),
+					Delivery:   types.AIRDROP_DELIVERY_VE_LOCK,
+					Duration:   vetypes.MaxLockTime + 1,
+				},
+			},
+		},
+		{
+			desc:   "ve lock airdrop shorter than a regulated period",
+			sender: "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+			airdrops: []types.Airdrop{
+				{
+					TargetAddr: "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+					Amount:     sdk.NewCoin(blacktypes.AttoFuryDenom, sdk.NewInt(1)),
+					Delivery:   types.AIRDROP_DELIVERY_VE_LOCK,
+					Duration:   vetypes.RegulatedPeriod - 1,
+				},
+			},
+		},
+		{
+			desc:   "valid vesting and ve lock airdrops",
+			sender: "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+			airdrops: []types.Airdrop{
+				{
+					TargetAddr: "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+					Amount:     sdk.NewCoin(blacktypes.AttoFuryDenom, sdk.NewInt(1)),
+					Delivery:   types.AIRDROP_DELIVERY_CONTINUOUS_VESTING,
+					Duration:   86400,
+				},
+				{
+					TargetAddr: "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+					Amount:     sdk.NewCoin(blacktypes.AttoFuryDenom, sdk.NewInt(1)),
+					Delivery:   types.AIRDROP_DELIVERY_VE_LOCK,
+					Duration:   vetypes.MaxLockTime,
+				},
+			},
+			valid: true,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			msg := &types.MsgAddAirdrops{
@@ -173,3 +241,195 @@ func TestMsgSetAllocationAddress_GetSigners(t *testing.T) {
 	signers := msg.GetSigners()
 	require.Equal(t, addr, signers[0].String())
 }
+
+func TestMsgAddMerkleAirdrop_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	root := hex.EncodeToString(make([]byte, 32))
+	for _, tc := range []struct {
+		desc          string
+		sender        string
+		merkleRoot    string
+		totalAmount   sdk.Coin
+		claimDeadline time.Time
+		delivery      types.AirdropDelivery
+		duration      uint64
+		valid         bool
+	}{
+		{
+			desc:   "invalid sender address",
+			sender: "",
+		},
+		{
+			desc:          "invalid merkle root",
+			sender:        "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+			merkleRoot:    "abcd",
+			totalAmount:   sdk.NewCoin(blacktypes.AttoFuryDenom, sdk.NewInt(1)),
+			claimDeadline: time.Unix(1, 0),
+		},
+		{
+			desc:          "zero total amount",
+			sender:        "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+			merkleRoot:    root,
+			totalAmount:   sdk.NewCoin(blacktypes.AttoFuryDenom, sdk.ZeroInt()),
+			claimDeadline: time.Unix(1, 0),
+		},
+		{
+			desc:        "missing claim deadline",
+			sender:      "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+			merkleRoot:  root,
+			totalAmount: sdk.NewCoin(blacktypes.AttoFuryDenom, sdk.NewInt(1)),
+		},
+		{
+			desc:          "vesting airdrop without duration",
+			sender:        "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+			merkleRoot:    root,
+			totalAmount:   sdk.NewCoin(blacktypes.AttoFuryDenom, sdk.NewInt(1)),
+			claimDeadline: time.Unix(1, 0),
+			delivery:      types.AIRDROP_DELIVERY_CONTINUOUS_VESTING,
+		},
+		{
+			desc:          "valid",
+			sender:        "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+			merkleRoot:    root,
+			totalAmount:   sdk.NewCoin(blacktypes.AttoFuryDenom, sdk.NewInt(1)),
+			claimDeadline: time.Unix(1, 0),
+			valid:         true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := &types.MsgAddMerkleAirdrop{
+				Sender:        tc.sender,
+				MerkleRoot:    tc.merkleRoot,
+				TotalAmount:   tc.totalAmount,
+				ClaimDeadline: tc.claimDeadline,
+				Delivery:      tc.delivery,
+				Duration:      tc.duration,
+			}
+			err := msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgClaimAirdrop_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	node := hex.EncodeToString(make([]byte, 32))
+	for _, tc := range []struct {
+		desc   string
+		sender string
+		amount sdk.Int
+		proof  []string
+		valid  bool
+	}{
+		{
+			desc:   "invalid sender address",
+			sender: "",
+			amount: sdk.NewInt(1),
+		},
+		{
+			desc:   "zero amount",
+			sender: "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+			amount: sdk.ZeroInt(),
+		},
+		{
+			desc:   "invalid proof node",
+			sender: "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+			amount: sdk.NewInt(1),
+			proof:  []string{"xx"},
+		},
+		{
+			desc:   "valid",
+			sender: "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+			amount: sdk.NewInt(1),
+			proof:  []string{node},
+			valid:  true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := &types.MsgClaimAirdrop{
+				Sender:    tc.sender,
+				AirdropId: 1,
+				Amount:    tc.amount,
+				Proof:     tc.proof,
+			}
+			err := msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgFundStrategicReserve_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	for _, tc := range []struct {
+		desc   string
+		sender string
+		amount sdk.Coins
+		valid  bool
+	}{
+		{
+			desc:   "invalid sender address",
+			sender: "",
+			amount: sdk.NewCoins(sdk.NewInt64Coin(blacktypes.BaseDenom, 1)),
+		},
+		{
+			desc:   "zero amount",
+			sender: "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+			amount: sdk.Coins{},
+		},
+		{
+			desc:   "valid",
+			sender: "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm",
+			amount: sdk.NewCoins(sdk.NewInt64Coin(blacktypes.BaseDenom, 1)),
+			valid:  true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := &types.MsgFundStrategicReserve{
+				Sender: tc.sender,
+				Amount: tc.amount,
+			}
+			err := msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgCancelAirdrops_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	target := "did:fury:black1mnfm9c7cdgqnkk66sganp78m0ydmcr4panm2dm"
+	for _, tc := range []struct {
+		desc    string
+		targets []string
+		valid   bool
+	}{
+		{desc: "no target"},
+		{desc: "invalid target address", targets: []string{"xxx"}},
+		{desc: "duplicate target address", targets: []string{target, target}},
+		{desc: "valid", targets: []string{target}, valid: true},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := &types.MsgCancelAirdrops{
+				Sender:      target,
+				TargetAddrs: tc.targets,
+			}
+			err := msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate performs basic validation of the strategic reserve payout
+func (p StrategicReservePayout) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+	if !p.Amount.IsValid() || p.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, p.Amount.String())
+	}
+	if !p.PaidAmount.IsValid() || !p.Amount.IsAllGTE(p.PaidAmount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "paid amount %s exceeds amount %s", p.PaidAmount, p.Amount)
+	}
+	return nil
+}
+
+func (p StrategicReservePayout) GetRecipient() sdk.AccAddress {
+	recipient, err := sdk.AccAddressFromBech32(p.Recipient)
+	if err != nil {
+		panic(err)
+	}
+	return recipient
+}
+
+// StreamedAmount returns the amount streamed at the unix time in seconds,
+// which is the whole amount for a one-off payout
+func (p StrategicReservePayout) StreamedAmount(blockTime int64) sdk.Coins {
+	if blockTime < p.StartTime {
+		return sdk.Coins{}
+	}
+	elapsed := uint64(blockTime - p.StartTime)
+	if elapsed >= p.Duration {
+		return p.Amount
+	}
+	streamed := sdk.Coins{}
+	for _, coin := range p.Amount {
+		amount := coin.Amount.Mul(sdk.NewIntFromUint64(elapsed)).Quo(sdk.NewIntFromUint64(p.Duration))
+		streamed = streamed.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	return streamed
+}
+
+// Completed returns true if the whole amount has been paid
+func (p StrategicReservePayout) Completed() bool {
+	return p.PaidAmount.IsAllGTE(p.Amount)
+}
@@ -0,0 +1,20 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elysiumstation/blackfury/x/vesting/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrategicReservePayout_StreamedAmount(t *testing.T) {
+	amount := sdk.NewCoins(sdk.NewInt64Coin("afury", 1000), sdk.NewInt64Coin("uatom", 10))
+	payout := types.StrategicReservePayout{Amount: amount, StartTime: 1000, Duration: 400}
+	require.True(t, payout.StreamedAmount(999).IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("afury", 250), sdk.NewInt64Coin("uatom", 2)), payout.StreamedAmount(1100))
+	require.Equal(t, amount, payout.StreamedAmount(1400))
+
+	payout.Duration = 0
+	require.Equal(t, amount, payout.StreamedAmount(1000))
+}
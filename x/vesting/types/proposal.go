@@ -0,0 +1,88 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeAddVestingBucket            = "AddVestingBucket"
+	ProposalTypeSetVestingBucketDestination = "SetVestingBucketDestination"
+	ProposalTypeStrategicReserveSpend       = "StrategicReserveSpend"
+)
+
+var (
+	_ govtypes.Content = &AddVestingBucketProposal{}
+	_ govtypes.Content = &SetVestingBucketDestinationProposal{}
+	_ govtypes.Content = &StrategicReserveSpendProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeAddVestingBucket)
+	govtypes.RegisterProposalType(ProposalTypeSetVestingBucketDestination)
+	govtypes.RegisterProposalType(ProposalTypeStrategicReserveSpend)
+	govtypes.RegisterProposalTypeCodec(&AddVestingBucketProposal{}, "vesting/AddVestingBucketProposal")
+	govtypes.RegisterProposalTypeCodec(&SetVestingBucketDestinationProposal{}, "vesting/SetVestingBucketDestinationProposal")
+	govtypes.RegisterProposalTypeCodec(&StrategicReserveSpendProposal{}, "vesting/StrategicReserveSpendProposal")
+}
+
+func (m *AddVestingBucketProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *AddVestingBucketProposal) ProposalType() string {
+	return ProposalTypeAddVestingBucket
+}
+
+func (m *AddVestingBucketProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(m); err != nil {
+		return err
+	}
+	if err := ValidateVestingBucketName(m.Name); err != nil {
+		return err
+	}
+	if err := ValidateVestingSchedule(m.Amount, m.Cliff, m.Duration, m.ClaimPeriod); err != nil {
+		return err
+	}
+	return ValidateVestingBucketDestination(m.DestinationModule, m.DestinationAddr)
+}
+
+func (m *SetVestingBucketDestinationProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *SetVestingBucketDestinationProposal) ProposalType() string {
+	return ProposalTypeSetVestingBucketDestination
+}
+
+func (m *SetVestingBucketDestinationProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(m); err != nil {
+		return err
+	}
+	if err := ValidateVestingBucketName(m.Name); err != nil {
+		return err
+	}
+	return ValidateVestingBucketDestination(m.DestinationModule, m.DestinationAddr)
+}
+
+func (m *StrategicReserveSpendProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *StrategicReserveSpendProposal) ProposalType() string {
+	return ProposalTypeStrategicReserveSpend
+}
+
+func (m *StrategicReserveSpendProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(m); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid spend amount %s", m.Amount)
+	}
+	return nil
+}
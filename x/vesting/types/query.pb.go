@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -34,6 +36,9 @@ type QueryAirdropsRequest struct {
 	// pagination defines an optional pagination for the request.
 	Completed  bool               `protobuf:"varint,1,opt,name=completed,proto3" json:"completed,omitempty"`
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// queries the airdrops which failed to be delivered, instead of the pending
+	// or completed ones
+	Failed bool `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
 }
 
 func (m *QueryAirdropsRequest) Reset()         { *m = QueryAirdropsRequest{} }
@@ -83,6 +88,13 @@ func (m *QueryAirdropsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAirdropsRequest) GetFailed() bool {
+	if m != nil {
+		return m.Failed
+	}
+	return false
+}
+
 type QueryAirdropsResponse struct {
 	// airdrops contains all the queried airdrops.
 	Airdrops []Airdrop `protobuf:"bytes,1,rep,name=airdrops,proto3" json:"airdrops"`
@@ -140,6 +152,7 @@ func (m *QueryAirdropsResponse) GetPagination() *query.PageResponse {
 type QueryAirdropRequest struct {
 	TargetAddr string `protobuf:"bytes,1,opt,name=target_addr,json=targetAddr,proto3" json:"target_addr,omitempty"`
 	Completed  bool   `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	Failed     bool   `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
 }
 
 func (m *QueryAirdropRequest) Reset()         { *m = QueryAirdropRequest{} }
@@ -189,6 +202,13 @@ func (m *QueryAirdropRequest) GetCompleted() bool {
 	return false
 }
 
+func (m *QueryAirdropRequest) GetFailed() bool {
+	if m != nil {
+		return m.Failed
+	}
+	return false
+}
+
 type QueryAirdropResponse struct {
 	Airdrop Airdrop `protobuf:"bytes,1,opt,name=airdrop,proto3" json:"airdrop"`
 }
@@ -233,22 +253,21 @@ func (m *QueryAirdropResponse) GetAirdrop() Airdrop {
 	return Airdrop{}
 }
 
-// QueryParamsRequest is request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+type QueryAirdropTotalsRequest struct {
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryAirdropTotalsRequest) Reset()         { *m = QueryAirdropTotalsRequest{} }
+func (m *QueryAirdropTotalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAirdropTotalsRequest) ProtoMessage()    {}
+func (*QueryAirdropTotalsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bf850f462140e0f4, []int{4}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAirdropTotalsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAirdropTotalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAirdropTotalsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -258,36 +277,44 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryAirdropTotalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAirdropTotalsRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryAirdropTotalsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryAirdropTotalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAirdropTotalsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAirdropTotalsRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	// params holds all the parameters of this module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+type QueryAirdropTotalsResponse struct {
+	// cap of the airdrop allocation
+	Cap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=cap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cap"`
+	// total amount counted against the cap, including pending, completed and
+	// Merkle airdrops
+	Total github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total"`
+	// amount of the pending airdrops
+	Pending github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=pending,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"pending"`
+	// count of the pending airdrops
+	PendingCount uint64 `protobuf:"varint,4,opt,name=pending_count,json=pendingCount,proto3" json:"pending_count,omitempty"`
+	// amount still available under the cap
+	Available github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=available,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"available"`
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryAirdropTotalsResponse) Reset()         { *m = QueryAirdropTotalsResponse{} }
+func (m *QueryAirdropTotalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAirdropTotalsResponse) ProtoMessage()    {}
+func (*QueryAirdropTotalsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bf850f462140e0f4, []int{5}
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAirdropTotalsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAirdropTotalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAirdropTotalsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -297,555 +324,3981 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryAirdropTotalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAirdropTotalsResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryAirdropTotalsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryAirdropTotalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAirdropTotalsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAirdropTotalsResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *QueryAirdropTotalsResponse) GetPendingCount() uint64 {
 	if m != nil {
-		return m.Params
+		return m.PendingCount
 	}
-	return Params{}
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*QueryAirdropsRequest)(nil), "blackfury.vesting.v1.QueryAirdropsRequest")
-	proto.RegisterType((*QueryAirdropsResponse)(nil), "blackfury.vesting.v1.QueryAirdropsResponse")
-	proto.RegisterType((*QueryAirdropRequest)(nil), "blackfury.vesting.v1.QueryAirdropRequest")
-	proto.RegisterType((*QueryAirdropResponse)(nil), "blackfury.vesting.v1.QueryAirdropResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "blackfury.vesting.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "blackfury.vesting.v1.QueryParamsResponse")
+type QueryMerkleAirdropsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("blackfury/vesting/v1/query.proto", fileDescriptor_bf850f462140e0f4) }
-
-var fileDescriptor_bf850f462140e0f4 = []byte{
-	// 542 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0xa9, 0xa6, 0xe9, 0xe4, 0x36, 0x46, 0x08, 0x21, 0x6e, 0xc3, 0x22, 0x35, 0x8d,
-	0x32, 0x43, 0x52, 0x4f, 0x82, 0x48, 0x7b, 0xd0, 0x93, 0xd0, 0x2e, 0x7a, 0xf1, 0x22, 0xb3, 0xd9,
-	0x71, 0x5c, 0xcc, 0xee, 0x6c, 0x77, 0x66, 0x83, 0x41, 0xbd, 0xe8, 0xd1, 0x8b, 0x20, 0x78, 0xf7,
-	0x3b, 0xf8, 0x21, 0x7a, 0x2c, 0x78, 0xf1, 0x24, 0x92, 0xf8, 0x41, 0x4a, 0x66, 0x66, 0xb7, 0x49,
-	0x59, 0xd2, 0xdc, 0xc2, 0xcb, 0xff, 0xbd, 0xff, 0xef, 0xfd, 0xdf, 0xb0, 0xb0, 0xeb, 0x8f, 0xe9,
-	0xe8, 0xdd, 0x9b, 0x2c, 0x9d, 0x92, 0x09, 0x93, 0x2a, 0x8c, 0x39, 0x99, 0x0c, 0xc8, 0x69, 0xc6,
-	0xd2, 0x29, 0x4e, 0x52, 0xa1, 0x04, 0x6a, 0x16, 0x0a, 0x6c, 0x15, 0x78, 0x32, 0x68, 0x37, 0xb9,
-	0xe0, 0x42, 0x0b, 0xc8, 0xe2, 0x97, 0xd1, 0xb6, 0x3b, 0x5c, 0x08, 0x3e, 0x66, 0x84, 0x26, 0x21,
-	0xa1, 0x71, 0x2c, 0x14, 0x55, 0xa1, 0x88, 0xa5, 0xfd, 0xb7, 0x3f, 0x12, 0x32, 0x12, 0x92, 0xf8,
-	0x54, 0x32, 0x63, 0x41, 0x26, 0x03, 0x9f, 0x29, 0x3a, 0x20, 0x09, 0xe5, 0x61, 0xac, 0xc5, 0x56,
-	0xeb, 0x96, 0x72, 0x71, 0x16, 0x33, 0x19, 0xca, 0xb5, 0x9a, 0x1c, 0x52, 0x6b, 0xdc, 0x8f, 0xb0,
-	0x79, 0xb2, 0x70, 0x3a, 0x0c, 0xd3, 0x20, 0x15, 0x89, 0xf4, 0xd8, 0x69, 0xc6, 0xa4, 0x42, 0x1d,
-	0xb8, 0x33, 0x12, 0x51, 0x32, 0x66, 0x8a, 0x05, 0x2d, 0xd0, 0x05, 0xbd, 0xba, 0x77, 0x59, 0x40,
-	0x4f, 0x21, 0xbc, 0x24, 0x6a, 0x55, 0xbb, 0xa0, 0xd7, 0x18, 0xee, 0x61, 0x83, 0x8f, 0x17, 0xf8,
-	0xd8, 0x24, 0x64, 0xf1, 0xf1, 0x31, 0xe5, 0xcc, 0x4e, 0xf6, 0x96, 0x3a, 0xdd, 0x9f, 0x00, 0xde,
-	0xbe, 0x62, 0x2f, 0x13, 0x11, 0x4b, 0x86, 0x9e, 0xc0, 0x3a, 0xb5, 0xb5, 0x16, 0xe8, 0x6e, 0xf5,
-	0x1a, 0xc3, 0x3b, 0xb8, 0x2c, 0x68, 0x6c, 0x3b, 0x8f, 0x6e, 0x9c, 0xfd, 0xdd, 0xad, 0x78, 0x45,
-	0x13, 0x7a, 0x56, 0x82, 0x78, 0xef, 0x5a, 0x44, 0xe3, 0xbe, 0xc2, 0xf8, 0x02, 0xde, 0x5a, 0x46,
-	0xcc, 0x03, 0xda, 0x85, 0x0d, 0x45, 0x53, 0xce, 0xd4, 0x6b, 0x1a, 0x04, 0xa9, 0x8e, 0x68, 0xc7,
-	0x83, 0xa6, 0x74, 0x18, 0x04, 0xe9, 0x6a, 0x82, 0xd5, 0x2b, 0x09, 0xba, 0x2f, 0x57, 0x73, 0x2f,
-	0xf6, 0x7e, 0x0c, 0xb7, 0xed, 0x0a, 0x7a, 0xe4, 0x86, 0x6b, 0xe7, 0x3d, 0x6e, 0x13, 0x22, 0x3d,
-	0xf6, 0x98, 0xa6, 0x34, 0xca, 0x8f, 0xe9, 0x9e, 0xd8, 0x15, 0xf2, 0xaa, 0xf5, 0x7a, 0x04, 0x6b,
-	0x89, 0xae, 0x58, 0xab, 0x4e, 0xb9, 0x95, 0xe9, 0xb2, 0x4e, 0xb6, 0x63, 0xf8, 0x6b, 0x0b, 0xde,
-	0xd4, 0x33, 0xd1, 0x57, 0x00, 0xeb, 0xf9, 0xf9, 0x50, 0xbf, 0x7c, 0x44, 0xd9, 0x13, 0x6b, 0xdf,
-	0xdf, 0x48, 0x6b, 0x58, 0xdd, 0xbd, 0xcf, 0xbf, 0xff, 0x7f, 0xaf, 0x76, 0x91, 0x43, 0x4a, 0x1f,
-	0x75, 0x71, 0xf6, 0x1f, 0x00, 0x6e, 0xdb, 0x66, 0xb4, 0x7f, 0xbd, 0x41, 0xce, 0xd2, 0xdf, 0x44,
-	0x6a, 0x51, 0x1e, 0x6a, 0x14, 0x8c, 0x1e, 0xac, 0x47, 0x21, 0x1f, 0x96, 0xde, 0xc7, 0x27, 0xf4,
-	0x05, 0xc0, 0x9a, 0x49, 0x12, 0xf5, 0xd6, 0x98, 0xad, 0x1c, 0xae, 0xbd, 0xbf, 0x81, 0xd2, 0x52,
-	0xdd, 0xd5, 0x54, 0x0e, 0xea, 0x94, 0x53, 0x99, 0xb3, 0x1d, 0x3d, 0x3f, 0x9b, 0x39, 0xe0, 0x7c,
-	0xe6, 0x80, 0x7f, 0x33, 0x07, 0x7c, 0x9b, 0x3b, 0x95, 0xf3, 0xb9, 0x53, 0xf9, 0x33, 0x77, 0x2a,
-	0xaf, 0x0e, 0x78, 0xa8, 0xde, 0x66, 0x3e, 0x1e, 0x89, 0x88, 0xb0, 0xf1, 0x54, 0x86, 0x59, 0x24,
-	0xcd, 0xe7, 0x69, 0x69, 0xe0, 0xfb, 0x62, 0xa4, 0x9a, 0x26, 0x4c, 0xfa, 0x35, 0xfd, 0x11, 0x39,
-	0xb8, 0x08, 0x00, 0x00, 0xff, 0xff, 0x99, 0xe0, 0x19, 0x2a, 0x26, 0x05, 0x00, 0x00,
+func (m *QueryMerkleAirdropsRequest) Reset()         { *m = QueryMerkleAirdropsRequest{} }
+func (m *QueryMerkleAirdropsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMerkleAirdropsRequest) ProtoMessage()    {}
+func (*QueryMerkleAirdropsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf850f462140e0f4, []int{6}
+}
+func (m *QueryMerkleAirdropsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMerkleAirdropsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerkleAirdropsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMerkleAirdropsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerkleAirdropsRequest.Merge(m, src)
+}
+func (m *QueryMerkleAirdropsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMerkleAirdropsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerkleAirdropsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryMerkleAirdropsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Airdrops queries airdrop targets.
-	Airdrops(ctx context.Context, in *QueryAirdropsRequest, opts ...grpc.CallOption) (*QueryAirdropsResponse, error)
-	// Airdrops queries airdrop target for given address.
-	Airdrop(ctx context.Context, in *QueryAirdropRequest, opts ...grpc.CallOption) (*QueryAirdropResponse, error)
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryMerkleAirdropsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryMerkleAirdropsResponse struct {
+	// airdrops contains all the queried Merkle airdrops.
+	Airdrops []MerkleAirdrop `protobuf:"bytes,1,rep,name=airdrops,proto3" json:"airdrops"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryMerkleAirdropsResponse) Reset()         { *m = QueryMerkleAirdropsResponse{} }
+func (m *QueryMerkleAirdropsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMerkleAirdropsResponse) ProtoMessage()    {}
+func (*QueryMerkleAirdropsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf850f462140e0f4, []int{7}
 }
-
-func (c *queryClient) Airdrops(ctx context.Context, in *QueryAirdropsRequest, opts ...grpc.CallOption) (*QueryAirdropsResponse, error) {
-	out := new(QueryAirdropsResponse)
-	err := c.cc.Invoke(ctx, "/blackfury.vesting.v1.Query/Airdrops", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryMerkleAirdropsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMerkleAirdropsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerkleAirdropsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryMerkleAirdropsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerkleAirdropsResponse.Merge(m, src)
+}
+func (m *QueryMerkleAirdropsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMerkleAirdropsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerkleAirdropsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Airdrop(ctx context.Context, in *QueryAirdropRequest, opts ...grpc.CallOption) (*QueryAirdropResponse, error) {
-	out := new(QueryAirdropResponse)
-	err := c.cc.Invoke(ctx, "/blackfury.vesting.v1.Query/Airdrop", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMerkleAirdropsResponse proto.InternalMessageInfo
+
+func (m *QueryMerkleAirdropsResponse) GetAirdrops() []MerkleAirdrop {
+	if m != nil {
+		return m.Airdrops
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/blackfury.vesting.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryMerkleAirdropsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Airdrops queries airdrop targets.
-	Airdrops(context.Context, *QueryAirdropsRequest) (*QueryAirdropsResponse, error)
-	// Airdrops queries airdrop target for given address.
-	Airdrop(context.Context, *QueryAirdropRequest) (*QueryAirdropResponse, error)
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+type QueryMerkleAirdropClaimedRequest struct {
+	AirdropId uint64 `protobuf:"varint,1,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryMerkleAirdropClaimedRequest) Reset()         { *m = QueryMerkleAirdropClaimedRequest{} }
+func (m *QueryMerkleAirdropClaimedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMerkleAirdropClaimedRequest) ProtoMessage()    {}
+func (*QueryMerkleAirdropClaimedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf850f462140e0f4, []int{8}
 }
-
-func (*UnimplementedQueryServer) Airdrops(ctx context.Context, req *QueryAirdropsRequest) (*QueryAirdropsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Airdrops not implemented")
+func (m *QueryMerkleAirdropClaimedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Airdrop(ctx context.Context, req *QueryAirdropRequest) (*QueryAirdropResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Airdrop not implemented")
+func (m *QueryMerkleAirdropClaimedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerkleAirdropClaimedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryMerkleAirdropClaimedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerkleAirdropClaimedRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryMerkleAirdropClaimedRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_Airdrops_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAirdropsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Airdrops(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/blackfury.vesting.v1.Query/Airdrops",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Airdrops(ctx, req.(*QueryAirdropsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryMerkleAirdropClaimedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerkleAirdropClaimedRequest.DiscardUnknown(m)
 }
 
-func _Query_Airdrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAirdropRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Airdrop(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/blackfury.vesting.v1.Query/Airdrop",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Airdrop(ctx, req.(*QueryAirdropRequest))
+var xxx_messageInfo_QueryMerkleAirdropClaimedRequest proto.InternalMessageInfo
+
+func (m *QueryMerkleAirdropClaimedRequest) GetAirdropId() uint64 {
+	if m != nil {
+		return m.AirdropId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/blackfury.vesting.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryMerkleAirdropClaimedRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "blackfury.vesting.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Airdrops",
-			Handler:    _Query_Airdrops_Handler,
-		},
-		{
-			MethodName: "Airdrop",
-			Handler:    _Query_Airdrop_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "blackfury/vesting/v1/query.proto",
+type QueryMerkleAirdropClaimedResponse struct {
+	Claimed bool `protobuf:"varint,1,opt,name=claimed,proto3" json:"claimed,omitempty"`
 }
 
-func (m *QueryAirdropsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryMerkleAirdropClaimedResponse) Reset()         { *m = QueryMerkleAirdropClaimedResponse{} }
+func (m *QueryMerkleAirdropClaimedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMerkleAirdropClaimedResponse) ProtoMessage()    {}
+func (*QueryMerkleAirdropClaimedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf850f462140e0f4, []int{9}
 }
-
-func (m *QueryAirdropsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMerkleAirdropClaimedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryAirdropsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Completed {
-		i--
-		if m.Completed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryMerkleAirdropClaimedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerkleAirdropClaimedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryMerkleAirdropClaimedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerkleAirdropClaimedResponse.Merge(m, src)
+}
+func (m *QueryMerkleAirdropClaimedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMerkleAirdropClaimedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerkleAirdropClaimedResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAirdropsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMerkleAirdropClaimedResponse proto.InternalMessageInfo
+
+func (m *QueryMerkleAirdropClaimedResponse) GetClaimed() bool {
+	if m != nil {
+		return m.Claimed
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryAirdropsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// VestingBucketStatus is a vesting bucket with its amounts at the current
+// block time.
+type VestingBucketStatus struct {
+	Bucket VestingBucket `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket"`
+	// amount vested so far, including the claimed amount
+	Vested github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=vested,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"vested"`
+	// amount not vested yet
+	Unvested github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=unvested,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"unvested"`
+	// amount claimed to the destination so far
+	Claimed github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=claimed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"claimed"`
 }
 
-func (m *QueryAirdropsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Airdrops) > 0 {
-		for iNdEx := len(m.Airdrops) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Airdrops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *VestingBucketStatus) Reset()         { *m = VestingBucketStatus{} }
+func (m *VestingBucketStatus) String() string { return proto.CompactTextString(m) }
+func (*VestingBucketStatus) ProtoMessage()    {}
+func (*VestingBucketStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf850f462140e0f4, []int{10}
+}
+func (m *VestingBucketStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingBucketStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingBucketStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *VestingBucketStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingBucketStatus.Merge(m, src)
+}
+func (m *VestingBucketStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingBucketStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingBucketStatus.DiscardUnknown(m)
 }
 
-func (m *QueryAirdropRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_VestingBucketStatus proto.InternalMessageInfo
+
+func (m *VestingBucketStatus) GetBucket() VestingBucket {
+	if m != nil {
+		return m.Bucket
 	}
-	return dAtA[:n], nil
+	return VestingBucket{}
 }
 
-func (m *QueryAirdropRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryVestingBucketsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAirdropRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Completed {
-		i--
-		if m.Completed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryVestingBucketsRequest) Reset()         { *m = QueryVestingBucketsRequest{} }
+func (m *QueryVestingBucketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingBucketsRequest) ProtoMessage()    {}
+func (*QueryVestingBucketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf850f462140e0f4, []int{11}
+}
+func (m *QueryVestingBucketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingBucketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingBucketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.TargetAddr) > 0 {
-		i -= len(m.TargetAddr)
-		copy(dAtA[i:], m.TargetAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TargetAddr)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryVestingBucketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingBucketsRequest.Merge(m, src)
+}
+func (m *QueryVestingBucketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingBucketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingBucketsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAirdropResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVestingBucketsRequest proto.InternalMessageInfo
+
+func (m *QueryVestingBucketsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAirdropResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryVestingBucketsResponse struct {
+	Buckets []VestingBucketStatus `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAirdropResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Airdrop.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryVestingBucketsResponse) Reset()         { *m = QueryVestingBucketsResponse{} }
+func (m *QueryVestingBucketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingBucketsResponse) ProtoMessage()    {}
+func (*QueryVestingBucketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf850f462140e0f4, []int{12}
+}
+func (m *QueryVestingBucketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingBucketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingBucketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryVestingBucketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingBucketsResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVestingBucketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingBucketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingBucketsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryVestingBucketsResponse proto.InternalMessageInfo
+
+func (m *QueryVestingBucketsResponse) GetBuckets() []VestingBucketStatus {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVestingBucketsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryVestingBucketRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryVestingBucketRequest) Reset()         { *m = QueryVestingBucketRequest{} }
+func (m *QueryVestingBucketRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingBucketRequest) ProtoMessage()    {}
+func (*QueryVestingBucketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf850f462140e0f4, []int{13}
+}
+func (m *QueryVestingBucketRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingBucketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingBucketRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryVestingBucketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingBucketRequest.Merge(m, src)
+}
+func (m *QueryVestingBucketRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingBucketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingBucketRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryVestingBucketRequest proto.InternalMessageInfo
+
+func (m *QueryVestingBucketRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryAirdropsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Completed {
-		n += 2
+
+type QueryVestingBucketResponse struct {
+	Bucket VestingBucketStatus `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket"`
+}
+
+func (m *QueryVestingBucketResponse) Reset()         { *m = QueryVestingBucketResponse{} }
+func (m *QueryVestingBucketResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingBucketResponse) ProtoMessage()    {}
+func (*QueryVestingBucketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf850f462140e0f4, []int{14}
+}
+func (m *QueryVestingBucketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingBucketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingBucketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryVestingBucketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingBucketResponse.Merge(m, src)
+}
+func (m *QueryVestingBucketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingBucketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingBucketResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingBucketResponse proto.InternalMessageInfo
+
+func (m *QueryVestingBucketResponse) GetBucket() VestingBucketStatus {
+	if m != nil {
+		return m.Bucket
 	}
-	return n
+	return VestingBucketStatus{}
 }
 
-func (m *QueryAirdropsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryStrategicReserveRequest struct {
+}
+
+func (m *QueryStrategicReserveRequest) Reset()         { *m = QueryStrategicReserveRequest{} }
+func (m *QueryStrategicReserveRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStrategicReserveRequest) ProtoMessage()    {}
+func (*QueryStrategicReserveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf850f462140e0f4, []int{15}
+}
+func (m *QueryStrategicReserveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStrategicReserveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStrategicReserveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.Airdrops) > 0 {
-		for _, e := range m.Airdrops {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryStrategicReserveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStrategicReserveRequest.Merge(m, src)
+}
+func (m *QueryStrategicReserveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStrategicReserveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStrategicReserveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStrategicReserveRequest proto.InternalMessageInfo
+
+type QueryStrategicReserveResponse struct {
+	// balance of the strategic reserve pool
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+	// amount committed to the ongoing streaming payouts
+	Committed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=committed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"committed"`
+	// amount available for new spending
+	Available github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=available,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"available"`
+}
+
+func (m *QueryStrategicReserveResponse) Reset()         { *m = QueryStrategicReserveResponse{} }
+func (m *QueryStrategicReserveResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStrategicReserveResponse) ProtoMessage()    {}
+func (*QueryStrategicReserveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf850f462140e0f4, []int{16}
+}
+func (m *QueryStrategicReserveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStrategicReserveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStrategicReserveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryStrategicReserveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStrategicReserveResponse.Merge(m, src)
+}
+func (m *QueryStrategicReserveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStrategicReserveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStrategicReserveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStrategicReserveResponse proto.InternalMessageInfo
+
+func (m *QueryStrategicReserveResponse) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAirdropRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStrategicReserveResponse) GetCommitted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Committed
 	}
-	var l int
-	_ = l
-	l = len(m.TargetAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *QueryStrategicReserveResponse) GetAvailable() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Available
 	}
-	if m.Completed {
-		n += 2
+	return nil
+}
+
+type QueryStrategicReservePayoutsRequest struct {
+	// only query the ongoing streaming payouts
+	Ongoing bool `protobuf:"varint,1,opt,name=ongoing,proto3" json:"ongoing,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStrategicReservePayoutsRequest) Reset()         { *m = QueryStrategicReservePayoutsRequest{} }
+func (m *QueryStrategicReservePayoutsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStrategicReservePayoutsRequest) ProtoMessage()    {}
+func (*QueryStrategicReservePayoutsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf850f462140e0f4, []int{17}
+}
+func (m *QueryStrategicReservePayoutsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStrategicReservePayoutsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStrategicReservePayoutsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryStrategicReservePayoutsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStrategicReservePayoutsRequest.Merge(m, src)
+}
+func (m *QueryStrategicReservePayoutsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStrategicReservePayoutsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStrategicReservePayoutsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAirdropResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryStrategicReservePayoutsRequest proto.InternalMessageInfo
+
+func (m *QueryStrategicReservePayoutsRequest) GetOngoing() bool {
+	if m != nil {
+		return m.Ongoing
 	}
-	var l int
-	_ = l
-	l = m.Airdrop.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return false
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStrategicReservePayoutsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryStrategicReservePayoutsResponse struct {
+	Payouts []StrategicReservePayout `protobuf:"bytes,1,rep,name=payouts,proto3" json:"payouts"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStrategicReservePayoutsResponse) Reset()         { *m = QueryStrategicReservePayoutsResponse{} }
+func (m *QueryStrategicReservePayoutsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStrategicReservePayoutsResponse) ProtoMessage()    {}
+func (*QueryStrategicReservePayoutsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf850f462140e0f4, []int{18}
+}
+func (m *QueryStrategicReservePayoutsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStrategicReservePayoutsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStrategicReservePayoutsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryStrategicReservePayoutsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStrategicReservePayoutsResponse.Merge(m, src)
+}
+func (m *QueryStrategicReservePayoutsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStrategicReservePayoutsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStrategicReservePayoutsResponse.DiscardUnknown(m)
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+var xxx_messageInfo_QueryStrategicReservePayoutsResponse proto.InternalMessageInfo
+
+func (m *QueryStrategicReservePayoutsResponse) GetPayouts() []StrategicReservePayout {
+	if m != nil {
+		return m.Payouts
+	}
+	return nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryStrategicReservePayoutsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf850f462140e0f4, []int{19}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf850f462140e0f4, []int{20}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func init() {
+	proto.RegisterType((*QueryAirdropsRequest)(nil), "blackfury.vesting.v1.QueryAirdropsRequest")
+	proto.RegisterType((*QueryAirdropsResponse)(nil), "blackfury.vesting.v1.QueryAirdropsResponse")
+	proto.RegisterType((*QueryAirdropRequest)(nil), "blackfury.vesting.v1.QueryAirdropRequest")
+	proto.RegisterType((*QueryAirdropResponse)(nil), "blackfury.vesting.v1.QueryAirdropResponse")
+	proto.RegisterType((*QueryAirdropTotalsRequest)(nil), "blackfury.vesting.v1.QueryAirdropTotalsRequest")
+	proto.RegisterType((*QueryAirdropTotalsResponse)(nil), "blackfury.vesting.v1.QueryAirdropTotalsResponse")
+	proto.RegisterType((*QueryMerkleAirdropsRequest)(nil), "blackfury.vesting.v1.QueryMerkleAirdropsRequest")
+	proto.RegisterType((*QueryMerkleAirdropsResponse)(nil), "blackfury.vesting.v1.QueryMerkleAirdropsResponse")
+	proto.RegisterType((*QueryMerkleAirdropClaimedRequest)(nil), "blackfury.vesting.v1.QueryMerkleAirdropClaimedRequest")
+	proto.RegisterType((*QueryMerkleAirdropClaimedResponse)(nil), "blackfury.vesting.v1.QueryMerkleAirdropClaimedResponse")
+	proto.RegisterType((*VestingBucketStatus)(nil), "blackfury.vesting.v1.VestingBucketStatus")
+	proto.RegisterType((*QueryVestingBucketsRequest)(nil), "blackfury.vesting.v1.QueryVestingBucketsRequest")
+	proto.RegisterType((*QueryVestingBucketsResponse)(nil), "blackfury.vesting.v1.QueryVestingBucketsResponse")
+	proto.RegisterType((*QueryVestingBucketRequest)(nil), "blackfury.vesting.v1.QueryVestingBucketRequest")
+	proto.RegisterType((*QueryVestingBucketResponse)(nil), "blackfury.vesting.v1.QueryVestingBucketResponse")
+	proto.RegisterType((*QueryStrategicReserveRequest)(nil), "blackfury.vesting.v1.QueryStrategicReserveRequest")
+	proto.RegisterType((*QueryStrategicReserveResponse)(nil), "blackfury.vesting.v1.QueryStrategicReserveResponse")
+	proto.RegisterType((*QueryStrategicReservePayoutsRequest)(nil), "blackfury.vesting.v1.QueryStrategicReservePayoutsRequest")
+	proto.RegisterType((*QueryStrategicReservePayoutsResponse)(nil), "blackfury.vesting.v1.QueryStrategicReservePayoutsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "blackfury.vesting.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "blackfury.vesting.v1.QueryParamsResponse")
+}
+
+func init() { proto.RegisterFile("blackfury/vesting/v1/query.proto", fileDescriptor_bf850f462140e0f4) }
+
+var fileDescriptor_bf850f462140e0f4 = []byte{
+	// 1287 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x3a, 0xae, 0x9d, 0xbc, 0x12, 0x84, 0xa6, 0x01, 0xdc, 0x6d, 0xea, 0x98, 0x6d, 0x49,
+	0x9d, 0xd2, 0xee, 0xc6, 0x09, 0xa2, 0xa2, 0x52, 0x05, 0x49, 0x4a, 0x4a, 0x50, 0x2a, 0xa5, 0x2e,
+	0x70, 0x80, 0x83, 0x35, 0xf6, 0x4e, 0x97, 0x55, 0xd6, 0xbb, 0xee, 0xee, 0xda, 0x22, 0x8a, 0x72,
+	0x81, 0x03, 0x07, 0x2e, 0x48, 0x20, 0xce, 0x20, 0x38, 0x20, 0x04, 0x07, 0x2e, 0xdc, 0xb8, 0x97,
+	0x5b, 0x25, 0x84, 0x84, 0x10, 0x2a, 0x90, 0xf0, 0x43, 0xd0, 0xce, 0xbc, 0xb1, 0xbd, 0xee, 0xc6,
+	0x59, 0x5b, 0xe9, 0xc9, 0xbb, 0xb3, 0xef, 0x7b, 0xef, 0x9b, 0x6f, 0xde, 0x9b, 0xf7, 0x64, 0x28,
+	0xd5, 0x1d, 0xda, 0xd8, 0xb9, 0xd7, 0xf6, 0x77, 0x8d, 0x0e, 0x0b, 0x42, 0xdb, 0xb5, 0x8c, 0x4e,
+	0xc5, 0xb8, 0xdf, 0x66, 0xfe, 0xae, 0xde, 0xf2, 0xbd, 0xd0, 0x23, 0xb3, 0x5d, 0x0b, 0x1d, 0x2d,
+	0xf4, 0x4e, 0x45, 0x9d, 0xb5, 0x3c, 0xcb, 0xe3, 0x06, 0x46, 0xf4, 0x24, 0x6c, 0xd5, 0x39, 0xcb,
+	0xf3, 0x2c, 0x87, 0x19, 0xb4, 0x65, 0x1b, 0xd4, 0x75, 0xbd, 0x90, 0x86, 0xb6, 0xe7, 0x06, 0xf8,
+	0xf5, 0x72, 0xc3, 0x0b, 0x9a, 0x5e, 0x60, 0xd4, 0x69, 0xc0, 0x44, 0x08, 0xa3, 0x53, 0xa9, 0xb3,
+	0x90, 0x56, 0x8c, 0x16, 0xb5, 0x6c, 0x97, 0x1b, 0xa3, 0x6d, 0xb1, 0xdf, 0x56, 0x5a, 0x35, 0x3c,
+	0x5b, 0x7e, 0xd7, 0x12, 0x79, 0x5b, 0xcc, 0x65, 0x81, 0x1d, 0x0c, 0xb5, 0x91, 0x9b, 0xe0, 0x36,
+	0xda, 0x17, 0x0a, 0xcc, 0xde, 0x89, 0xa8, 0xac, 0xda, 0xbe, 0xe9, 0x7b, 0xad, 0xa0, 0xca, 0xee,
+	0xb7, 0x59, 0x10, 0x92, 0x39, 0x98, 0x6e, 0x78, 0xcd, 0x96, 0xc3, 0x42, 0x66, 0x16, 0x94, 0x92,
+	0x52, 0x9e, 0xaa, 0xf6, 0x16, 0xc8, 0x06, 0x40, 0x8f, 0x72, 0x21, 0x53, 0x52, 0xca, 0xa7, 0x97,
+	0x17, 0x74, 0xc1, 0x59, 0x8f, 0x38, 0xeb, 0x42, 0x42, 0x64, 0xae, 0x6f, 0x53, 0x8b, 0xa1, 0xe7,
+	0x6a, 0x1f, 0x92, 0x3c, 0x07, 0xb9, 0x7b, 0xd4, 0x76, 0x98, 0x59, 0x98, 0xe4, 0x21, 0xf0, 0x4d,
+	0xfb, 0x5a, 0x81, 0x67, 0x07, 0x68, 0x05, 0x2d, 0xcf, 0x0d, 0x18, 0x79, 0x0d, 0xa6, 0x28, 0xae,
+	0x15, 0x94, 0xd2, 0x64, 0xf9, 0xf4, 0xf2, 0x79, 0x3d, 0xe9, 0x84, 0x74, 0x44, 0xae, 0x65, 0x1f,
+	0x3c, 0x9a, 0x9f, 0xa8, 0x76, 0x41, 0xe4, 0x56, 0x02, 0xf5, 0x4b, 0xc7, 0x52, 0x17, 0xd1, 0xfb,
+	0xb9, 0x6b, 0x0e, 0x9c, 0xe9, 0xa7, 0x28, 0x85, 0x9b, 0x87, 0xd3, 0x21, 0xf5, 0x2d, 0x16, 0xd6,
+	0xa8, 0x69, 0xfa, 0x5c, 0xba, 0xe9, 0x2a, 0x88, 0xa5, 0x55, 0xd3, 0xf4, 0xe3, 0xca, 0x66, 0x06,
+	0x95, 0x3d, 0x4a, 0x91, 0x77, 0xe2, 0xe7, 0xd4, 0xd5, 0xe3, 0x06, 0xe4, 0x71, 0x6b, 0x3c, 0x54,
+	0x4a, 0x39, 0x24, 0x46, 0x3b, 0x07, 0x67, 0xfb, 0xdd, 0xbe, 0xed, 0x85, 0xd4, 0x91, 0x39, 0xa0,
+	0xfd, 0x9b, 0x01, 0x35, 0xe9, 0x2b, 0x86, 0x7e, 0x1d, 0x26, 0x1b, 0x54, 0x84, 0x9d, 0x5e, 0xd3,
+	0x23, 0xbf, 0x7f, 0x3e, 0x9a, 0x5f, 0xb0, 0xec, 0xf0, 0x83, 0x76, 0x5d, 0x6f, 0x78, 0x4d, 0x03,
+	0x73, 0x58, 0xfc, 0x5c, 0x0d, 0xcc, 0x1d, 0x23, 0xdc, 0x6d, 0xb1, 0x40, 0xdf, 0x74, 0xc3, 0x6a,
+	0x04, 0x25, 0x37, 0xe1, 0x54, 0x18, 0xf9, 0x2c, 0x64, 0xc6, 0xf2, 0x21, 0xc0, 0xe4, 0x4d, 0xc8,
+	0xb7, 0x98, 0x6b, 0xda, 0xae, 0x55, 0x98, 0x1c, 0xcb, 0x8f, 0x84, 0x93, 0x0b, 0x30, 0x83, 0x8f,
+	0xb5, 0x86, 0xd7, 0x76, 0xc3, 0x42, 0xb6, 0xa4, 0x94, 0xb3, 0xd5, 0xa7, 0x70, 0x71, 0x3d, 0x5a,
+	0x23, 0x5b, 0x30, 0x4d, 0x3b, 0xd4, 0x76, 0x68, 0xdd, 0x61, 0x85, 0x53, 0x63, 0x05, 0xec, 0x39,
+	0xd0, 0x4c, 0x94, 0xf8, 0x36, 0xf3, 0x77, 0x1c, 0x36, 0x58, 0x85, 0xf1, 0x3a, 0x53, 0xc6, 0xad,
+	0x33, 0xed, 0x47, 0x05, 0xce, 0x25, 0x86, 0xc1, 0xa3, 0x7c, 0xe3, 0xb1, 0xaa, 0xba, 0x90, 0x9c,
+	0x46, 0x31, 0xfc, 0x93, 0xab, 0xad, 0xf7, 0xa1, 0xf4, 0x38, 0xdd, 0x75, 0x87, 0xda, 0x4d, 0x66,
+	0x4a, 0x6d, 0xce, 0x03, 0x60, 0xe0, 0x9a, 0x2d, 0xae, 0xa8, 0x6c, 0x75, 0x1a, 0x57, 0x36, 0x4d,
+	0x52, 0x80, 0x7c, 0x54, 0x80, 0x2c, 0x08, 0x44, 0x76, 0x55, 0xe5, 0xab, 0x76, 0x03, 0x5e, 0x18,
+	0xe2, 0x1c, 0x15, 0x29, 0x40, 0xbe, 0x21, 0x96, 0xf0, 0xf6, 0x93, 0xaf, 0xda, 0xcf, 0x19, 0x38,
+	0xf3, 0xae, 0x50, 0x64, 0xad, 0xdd, 0xd8, 0x61, 0xe1, 0xdd, 0x90, 0x86, 0xed, 0x80, 0xac, 0x42,
+	0xae, 0xce, 0xdf, 0xf1, 0x9c, 0x8e, 0x50, 0x30, 0x06, 0x45, 0x05, 0x11, 0x48, 0x36, 0x20, 0x17,
+	0x59, 0xe2, 0xbd, 0x30, 0x7a, 0x5e, 0x21, 0x9a, 0xbc, 0x05, 0x53, 0x6d, 0x17, 0x3d, 0x8d, 0x57,
+	0x12, 0x5d, 0x7c, 0x54, 0x5d, 0x52, 0x88, 0xec, 0x78, 0xd5, 0x25, 0x85, 0x93, 0xa9, 0x1e, 0x53,
+	0xe0, 0xc4, 0x53, 0xfd, 0x27, 0x99, 0xea, 0x83, 0x61, 0xf0, 0x60, 0x37, 0x21, 0x2f, 0xd4, 0x96,
+	0x99, 0xbe, 0x98, 0xe2, 0x9c, 0xc4, 0x11, 0xcb, 0xcb, 0x13, 0xf1, 0x27, 0x97, 0xee, 0x06, 0xde,
+	0xc2, 0xb1, 0x98, 0x52, 0x18, 0x02, 0x59, 0x97, 0x36, 0x19, 0x76, 0x12, 0xfe, 0xac, 0xb1, 0x24,
+	0x29, 0xbb, 0x5b, 0xbc, 0x35, 0x90, 0x89, 0x23, 0xef, 0x10, 0xe1, 0x5a, 0x11, 0xe6, 0x78, 0x98,
+	0xbb, 0xa1, 0x4f, 0x43, 0x66, 0xd9, 0x8d, 0x2a, 0x0b, 0x98, 0xdf, 0x91, 0xba, 0x6b, 0x7f, 0x65,
+	0xe0, 0xfc, 0x11, 0x06, 0x48, 0x85, 0x41, 0xbe, 0x4e, 0x1d, 0xea, 0x36, 0x18, 0xaa, 0x7d, 0x36,
+	0xa6, 0x8f, 0x54, 0x66, 0xdd, 0xb3, 0xdd, 0xb5, 0xa5, 0x28, 0xf6, 0xf7, 0x7f, 0xcf, 0x97, 0x53,
+	0x24, 0x56, 0x04, 0x08, 0xaa, 0xd2, 0x37, 0xb1, 0x79, 0x4f, 0x6d, 0xda, 0xa1, 0xa8, 0x9d, 0x13,
+	0x0f, 0xd4, 0xf3, 0x1e, 0x85, 0xea, 0x5d, 0xff, 0x93, 0x4f, 0x20, 0x54, 0xaf, 0x37, 0x7c, 0xa2,
+	0xc0, 0x85, 0x44, 0x79, 0xb7, 0xe9, 0xae, 0xd7, 0xee, 0x95, 0x4e, 0x01, 0xf2, 0x9e, 0x6b, 0x79,
+	0x51, 0x03, 0xc4, 0xbb, 0x0a, 0x5f, 0x4f, 0x6a, 0x4e, 0xd3, 0x7e, 0x51, 0xe0, 0xe2, 0x70, 0x26,
+	0x78, 0xde, 0x5b, 0x90, 0x6f, 0x89, 0x25, 0x3c, 0xef, 0x2b, 0xc9, 0xb9, 0x97, 0xec, 0x47, 0x16,
+	0x18, 0xba, 0x38, 0xb9, 0x02, 0x9b, 0x05, 0xc2, 0xe9, 0x6f, 0x53, 0x9f, 0x36, 0xbb, 0xf3, 0xcd,
+	0x1d, 0x38, 0x13, 0x5b, 0xc5, 0x3d, 0x5c, 0x87, 0x5c, 0x8b, 0xaf, 0x60, 0xf9, 0xcc, 0x25, 0x6f,
+	0x41, 0xa0, 0x64, 0xc5, 0x08, 0xc4, 0xf2, 0x37, 0x33, 0x70, 0x8a, 0xfb, 0x24, 0x9f, 0x2a, 0x30,
+	0x25, 0xfb, 0x2c, 0xb9, 0x9c, 0xec, 0x22, 0x69, 0xf2, 0x56, 0x5f, 0x4a, 0x65, 0x2b, 0xb8, 0x6a,
+	0x0b, 0x1f, 0xfd, 0xf6, 0xdf, 0xe7, 0x99, 0x12, 0x29, 0x1a, 0x89, 0xc3, 0x7e, 0xb7, 0x33, 0x7f,
+	0xa9, 0x40, 0x1e, 0xc1, 0x64, 0xf1, 0xf8, 0x00, 0x92, 0xcb, 0xe5, 0x34, 0xa6, 0x48, 0xe5, 0x65,
+	0x4e, 0x45, 0x27, 0x57, 0x86, 0x53, 0x31, 0xf6, 0xfa, 0xc6, 0xe3, 0x7d, 0xf2, 0x95, 0x02, 0x33,
+	0xb1, 0xf1, 0x92, 0x18, 0xc7, 0xc7, 0x8c, 0x8d, 0xa9, 0xea, 0x52, 0x7a, 0x00, 0x52, 0xbd, 0xc2,
+	0xa9, 0x2e, 0x90, 0x8b, 0x43, 0xa9, 0xd6, 0x42, 0x41, 0xe8, 0x5b, 0x05, 0x9e, 0x8e, 0xcf, 0x4d,
+	0x64, 0x58, 0xc8, 0xc4, 0x49, 0x4e, 0xad, 0x8c, 0x80, 0x40, 0x96, 0x57, 0x39, 0xcb, 0x4b, 0xe4,
+	0xc5, 0x64, 0x96, 0x4d, 0x8e, 0xaa, 0x75, 0x8f, 0xf8, 0x77, 0x05, 0x66, 0x93, 0x46, 0x1a, 0xf2,
+	0x4a, 0xda, 0xd0, 0xf1, 0x01, 0x4b, 0xbd, 0x36, 0x32, 0x0e, 0x89, 0x6f, 0x71, 0xe2, 0x1b, 0xe4,
+	0x66, 0x2a, 0xe2, 0xc6, 0x5e, 0x6f, 0x8c, 0xdb, 0x37, 0x70, 0x58, 0x30, 0xf6, 0x70, 0x5a, 0xdb,
+	0xe7, 0xf2, 0xc7, 0x7b, 0xf9, 0x50, 0xf9, 0x13, 0xa7, 0x0b, 0xb5, 0x32, 0x02, 0x22, 0x9d, 0xfc,
+	0xf8, 0x58, 0x93, 0xc3, 0xc0, 0x77, 0x0a, 0xcc, 0xc4, 0x3c, 0x0d, 0x4d, 0xe4, 0xa4, 0x4e, 0xaf,
+	0x2e, 0xa5, 0x07, 0xa4, 0xab, 0xb9, 0x01, 0x8e, 0xc6, 0x5e, 0x34, 0x3c, 0xec, 0x93, 0x1f, 0x14,
+	0x78, 0x66, 0xf0, 0x02, 0x26, 0xcb, 0x43, 0x82, 0x1f, 0xd1, 0xff, 0xd5, 0x95, 0x91, 0x30, 0xc8,
+	0xd9, 0xe0, 0x9c, 0x17, 0xc9, 0xa5, 0x64, 0xce, 0x81, 0xc4, 0xd5, 0x7c, 0x64, 0xf6, 0xab, 0x02,
+	0xcf, 0x1f, 0xd1, 0x77, 0xc8, 0xab, 0x23, 0x30, 0x88, 0x77, 0x4d, 0xf5, 0xfa, 0x38, 0x50, 0xdc,
+	0xc3, 0x35, 0xbe, 0x87, 0x0a, 0x31, 0x52, 0xee, 0xc1, 0x90, 0x1d, 0xed, 0x63, 0x05, 0x72, 0xa2,
+	0x71, 0x90, 0xf2, 0x90, 0xf8, 0xb1, 0x3e, 0xa5, 0x2e, 0xa6, 0xb0, 0x44, 0x62, 0x17, 0x39, 0xb1,
+	0x22, 0x99, 0x4b, 0x26, 0x26, 0xba, 0xd4, 0xda, 0xed, 0x07, 0x07, 0x45, 0xe5, 0xe1, 0x41, 0x51,
+	0xf9, 0xe7, 0xa0, 0xa8, 0x7c, 0x76, 0x58, 0x9c, 0x78, 0x78, 0x58, 0x9c, 0xf8, 0xe3, 0xb0, 0x38,
+	0xf1, 0xde, 0x4a, 0xdf, 0x9c, 0xc2, 0x9c, 0xdd, 0xc0, 0x6e, 0x37, 0x03, 0xf1, 0x2f, 0x56, 0x9f,
+	0xc3, 0x0f, 0xbb, 0x2e, 0xf9, 0xe0, 0x52, 0xcf, 0xf1, 0xff, 0x92, 0x56, 0xfe, 0x1f, 0x00, 0x53,
+	0x6f, 0xae, 0x0a, 0x4d, 0x13, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Airdrops queries airdrop targets.
+	Airdrops(ctx context.Context, in *QueryAirdropsRequest, opts ...grpc.CallOption) (*QueryAirdropsResponse, error)
+	// Airdrops queries airdrop target for given address.
+	Airdrop(ctx context.Context, in *QueryAirdropRequest, opts ...grpc.CallOption) (*QueryAirdropResponse, error)
+	// AirdropTotals queries the airdrop totals against the cap.
+	AirdropTotals(ctx context.Context, in *QueryAirdropTotalsRequest, opts ...grpc.CallOption) (*QueryAirdropTotalsResponse, error)
+	// MerkleAirdrops queries Merkle airdrops.
+	MerkleAirdrops(ctx context.Context, in *QueryMerkleAirdropsRequest, opts ...grpc.CallOption) (*QueryMerkleAirdropsResponse, error)
+	// MerkleAirdropClaimed queries whether the address has claimed from the
+	// Merkle airdrop.
+	MerkleAirdropClaimed(ctx context.Context, in *QueryMerkleAirdropClaimedRequest, opts ...grpc.CallOption) (*QueryMerkleAirdropClaimedResponse, error)
+	// VestingBuckets queries vesting buckets with their vested, unvested and
+	// claimed amounts.
+	VestingBuckets(ctx context.Context, in *QueryVestingBucketsRequest, opts ...grpc.CallOption) (*QueryVestingBucketsResponse, error)
+	// VestingBucket queries a vesting bucket with its vested, unvested and
+	// claimed amounts.
+	VestingBucket(ctx context.Context, in *QueryVestingBucketRequest, opts ...grpc.CallOption) (*QueryVestingBucketResponse, error)
+	// StrategicReserve queries the balance of the strategic reserve pool.
+	StrategicReserve(ctx context.Context, in *QueryStrategicReserveRequest, opts ...grpc.CallOption) (*QueryStrategicReserveResponse, error)
+	// StrategicReservePayouts queries the payout history of the strategic
+	// reserve pool.
+	StrategicReservePayouts(ctx context.Context, in *QueryStrategicReservePayoutsRequest, opts ...grpc.CallOption) (*QueryStrategicReservePayoutsResponse, error)
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Airdrops(ctx context.Context, in *QueryAirdropsRequest, opts ...grpc.CallOption) (*QueryAirdropsResponse, error) {
+	out := new(QueryAirdropsResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.vesting.v1.Query/Airdrops", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Airdrop(ctx context.Context, in *QueryAirdropRequest, opts ...grpc.CallOption) (*QueryAirdropResponse, error) {
+	out := new(QueryAirdropResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.vesting.v1.Query/Airdrop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AirdropTotals(ctx context.Context, in *QueryAirdropTotalsRequest, opts ...grpc.CallOption) (*QueryAirdropTotalsResponse, error) {
+	out := new(QueryAirdropTotalsResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.vesting.v1.Query/AirdropTotals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MerkleAirdrops(ctx context.Context, in *QueryMerkleAirdropsRequest, opts ...grpc.CallOption) (*QueryMerkleAirdropsResponse, error) {
+	out := new(QueryMerkleAirdropsResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.vesting.v1.Query/MerkleAirdrops", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MerkleAirdropClaimed(ctx context.Context, in *QueryMerkleAirdropClaimedRequest, opts ...grpc.CallOption) (*QueryMerkleAirdropClaimedResponse, error) {
+	out := new(QueryMerkleAirdropClaimedResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.vesting.v1.Query/MerkleAirdropClaimed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingBuckets(ctx context.Context, in *QueryVestingBucketsRequest, opts ...grpc.CallOption) (*QueryVestingBucketsResponse, error) {
+	out := new(QueryVestingBucketsResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.vesting.v1.Query/VestingBuckets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingBucket(ctx context.Context, in *QueryVestingBucketRequest, opts ...grpc.CallOption) (*QueryVestingBucketResponse, error) {
+	out := new(QueryVestingBucketResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.vesting.v1.Query/VestingBucket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StrategicReserve(ctx context.Context, in *QueryStrategicReserveRequest, opts ...grpc.CallOption) (*QueryStrategicReserveResponse, error) {
+	out := new(QueryStrategicReserveResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.vesting.v1.Query/StrategicReserve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StrategicReservePayouts(ctx context.Context, in *QueryStrategicReservePayoutsRequest, opts ...grpc.CallOption) (*QueryStrategicReservePayoutsResponse, error) {
+	out := new(QueryStrategicReservePayoutsResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.vesting.v1.Query/StrategicReservePayouts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.vesting.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Airdrops queries airdrop targets.
+	Airdrops(context.Context, *QueryAirdropsRequest) (*QueryAirdropsResponse, error)
+	// Airdrops queries airdrop target for given address.
+	Airdrop(context.Context, *QueryAirdropRequest) (*QueryAirdropResponse, error)
+	// AirdropTotals queries the airdrop totals against the cap.
+	AirdropTotals(context.Context, *QueryAirdropTotalsRequest) (*QueryAirdropTotalsResponse, error)
+	// MerkleAirdrops queries Merkle airdrops.
+	MerkleAirdrops(context.Context, *QueryMerkleAirdropsRequest) (*QueryMerkleAirdropsResponse, error)
+	// MerkleAirdropClaimed queries whether the address has claimed from the
+	// Merkle airdrop.
+	MerkleAirdropClaimed(context.Context, *QueryMerkleAirdropClaimedRequest) (*QueryMerkleAirdropClaimedResponse, error)
+	// VestingBuckets queries vesting buckets with their vested, unvested and
+	// claimed amounts.
+	VestingBuckets(context.Context, *QueryVestingBucketsRequest) (*QueryVestingBucketsResponse, error)
+	// VestingBucket queries a vesting bucket with its vested, unvested and
+	// claimed amounts.
+	VestingBucket(context.Context, *QueryVestingBucketRequest) (*QueryVestingBucketResponse, error)
+	// StrategicReserve queries the balance of the strategic reserve pool.
+	StrategicReserve(context.Context, *QueryStrategicReserveRequest) (*QueryStrategicReserveResponse, error)
+	// StrategicReservePayouts queries the payout history of the strategic
+	// reserve pool.
+	StrategicReservePayouts(context.Context, *QueryStrategicReservePayoutsRequest) (*QueryStrategicReservePayoutsResponse, error)
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Airdrops(ctx context.Context, req *QueryAirdropsRequest) (*QueryAirdropsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Airdrops not implemented")
+}
+func (*UnimplementedQueryServer) Airdrop(ctx context.Context, req *QueryAirdropRequest) (*QueryAirdropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Airdrop not implemented")
+}
+func (*UnimplementedQueryServer) AirdropTotals(ctx context.Context, req *QueryAirdropTotalsRequest) (*QueryAirdropTotalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AirdropTotals not implemented")
+}
+func (*UnimplementedQueryServer) MerkleAirdrops(ctx context.Context, req *QueryMerkleAirdropsRequest) (*QueryMerkleAirdropsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MerkleAirdrops not implemented")
+}
+func (*UnimplementedQueryServer) MerkleAirdropClaimed(ctx context.Context, req *QueryMerkleAirdropClaimedRequest) (*QueryMerkleAirdropClaimedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MerkleAirdropClaimed not implemented")
+}
+func (*UnimplementedQueryServer) VestingBuckets(ctx context.Context, req *QueryVestingBucketsRequest) (*QueryVestingBucketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingBuckets not implemented")
+}
+func (*UnimplementedQueryServer) VestingBucket(ctx context.Context, req *QueryVestingBucketRequest) (*QueryVestingBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingBucket not implemented")
+}
+func (*UnimplementedQueryServer) StrategicReserve(ctx context.Context, req *QueryStrategicReserveRequest) (*QueryStrategicReserveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StrategicReserve not implemented")
+}
+func (*UnimplementedQueryServer) StrategicReservePayouts(ctx context.Context, req *QueryStrategicReservePayoutsRequest) (*QueryStrategicReservePayoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StrategicReservePayouts not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Airdrops_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAirdropsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Airdrops(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.vesting.v1.Query/Airdrops",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Airdrops(ctx, req.(*QueryAirdropsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Airdrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAirdropRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Airdrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.vesting.v1.Query/Airdrop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Airdrop(ctx, req.(*QueryAirdropRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AirdropTotals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAirdropTotalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AirdropTotals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.vesting.v1.Query/AirdropTotals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AirdropTotals(ctx, req.(*QueryAirdropTotalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MerkleAirdrops_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMerkleAirdropsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MerkleAirdrops(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.vesting.v1.Query/MerkleAirdrops",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MerkleAirdrops(ctx, req.(*QueryMerkleAirdropsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MerkleAirdropClaimed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMerkleAirdropClaimedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MerkleAirdropClaimed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.vesting.v1.Query/MerkleAirdropClaimed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MerkleAirdropClaimed(ctx, req.(*QueryMerkleAirdropClaimedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingBuckets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingBucketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingBuckets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.vesting.v1.Query/VestingBuckets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingBuckets(ctx, req.(*QueryVestingBucketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingBucketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.vesting.v1.Query/VestingBucket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingBucket(ctx, req.(*QueryVestingBucketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StrategicReserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStrategicReserveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StrategicReserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.vesting.v1.Query/StrategicReserve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StrategicReserve(ctx, req.(*QueryStrategicReserveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StrategicReservePayouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStrategicReservePayoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StrategicReservePayouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.vesting.v1.Query/StrategicReservePayouts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StrategicReservePayouts(ctx, req.(*QueryStrategicReservePayoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.vesting.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "blackfury.vesting.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Airdrops",
+			Handler:    _Query_Airdrops_Handler,
+		},
+		{
+			MethodName: "Airdrop",
+			Handler:    _Query_Airdrop_Handler,
+		},
+		{
+			MethodName: "AirdropTotals",
+			Handler:    _Query_AirdropTotals_Handler,
+		},
+		{
+			MethodName: "MerkleAirdrops",
+			Handler:    _Query_MerkleAirdrops_Handler,
+		},
+		{
+			MethodName: "MerkleAirdropClaimed",
+			Handler:    _Query_MerkleAirdropClaimed_Handler,
+		},
+		{
+			MethodName: "VestingBuckets",
+			Handler:    _Query_VestingBuckets_Handler,
+		},
+		{
+			MethodName: "VestingBucket",
+			Handler:    _Query_VestingBucket_Handler,
+		},
+		{
+			MethodName: "StrategicReserve",
+			Handler:    _Query_StrategicReserve_Handler,
+		},
+		{
+			MethodName: "StrategicReservePayouts",
+			Handler:    _Query_StrategicReservePayouts_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "blackfury/vesting/v1/query.proto",
+}
+
+func (m *QueryAirdropsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAirdropsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAirdropsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Failed {
+		i--
+		if m.Failed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Completed {
+		i--
+		if m.Completed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAirdropsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAirdropsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAirdropsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Airdrops) > 0 {
+		for iNdEx := len(m.Airdrops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Airdrops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAirdropRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAirdropRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAirdropRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Failed {
+		i--
+		if m.Failed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Completed {
+		i--
+		if m.Completed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TargetAddr) > 0 {
+		i -= len(m.TargetAddr)
+		copy(dAtA[i:], m.TargetAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TargetAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAirdropResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAirdropResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAirdropResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Airdrop.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAirdropTotalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAirdropTotalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAirdropTotalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAirdropTotalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAirdropTotalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAirdropTotalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Available.Size()
+		i -= size
+		if _, err := m.Available.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.PendingCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PendingCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Pending.Size()
+		i -= size
+		if _, err := m.Pending.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Cap.Size()
+		i -= size
+		if _, err := m.Cap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMerkleAirdropsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMerkleAirdropsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMerkleAirdropsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMerkleAirdropsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMerkleAirdropsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMerkleAirdropsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Airdrops) > 0 {
+		for iNdEx := len(m.Airdrops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Airdrops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMerkleAirdropClaimedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMerkleAirdropClaimedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMerkleAirdropClaimedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AirdropId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AirdropId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMerkleAirdropClaimedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMerkleAirdropClaimedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMerkleAirdropClaimedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Claimed {
+		i--
+		if m.Claimed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingBucketStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingBucketStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingBucketStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Claimed.Size()
+		i -= size
+		if _, err := m.Claimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Unvested.Size()
+		i -= size
+		if _, err := m.Unvested.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Vested.Size()
+		i -= size
+		if _, err := m.Vested.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Bucket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingBucketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingBucketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingBucketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingBucketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingBucketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingBucketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingBucketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingBucketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingBucketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingBucketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingBucketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Bucket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStrategicReserveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStrategicReserveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStrategicReserveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStrategicReserveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStrategicReserveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStrategicReserveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Available) > 0 {
+		for iNdEx := len(m.Available) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Available[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Committed) > 0 {
+		for iNdEx := len(m.Committed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Committed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStrategicReservePayoutsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStrategicReservePayoutsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStrategicReservePayoutsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Ongoing {
+		i--
+		if m.Ongoing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStrategicReservePayoutsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStrategicReservePayoutsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStrategicReservePayoutsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Payouts) > 0 {
+		for iNdEx := len(m.Payouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAirdropsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Completed {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Failed {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAirdropsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Airdrops) > 0 {
+		for _, e := range m.Airdrops {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAirdropRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TargetAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Completed {
+		n += 2
+	}
+	if m.Failed {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAirdropResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Airdrop.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAirdropTotalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAirdropTotalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Cap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Pending.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.PendingCount != 0 {
+		n += 1 + sovQuery(uint64(m.PendingCount))
+	}
+	l = m.Available.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMerkleAirdropsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMerkleAirdropsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Airdrops) > 0 {
+		for _, e := range m.Airdrops {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMerkleAirdropClaimedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AirdropId != 0 {
+		n += 1 + sovQuery(uint64(m.AirdropId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMerkleAirdropClaimedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Claimed {
+		n += 2
+	}
+	return n
+}
+
+func (m *VestingBucketStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Bucket.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Vested.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Unvested.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Claimed.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVestingBucketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingBucketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingBucketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingBucketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Bucket.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStrategicReserveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStrategicReserveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Committed) > 0 {
+		for _, e := range m.Committed {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Available) > 0 {
+		for _, e := range m.Available {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStrategicReservePayoutsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ongoing {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStrategicReservePayoutsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Payouts) > 0 {
+		for _, e := range m.Payouts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAirdropsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAirdropsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAirdropsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Completed = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Failed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAirdropsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAirdropsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAirdropsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Airdrops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Airdrops = append(m.Airdrops, Airdrop{})
+			if err := m.Airdrops[len(m.Airdrops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAirdropRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAirdropRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAirdropRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Completed = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Failed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAirdropResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAirdropResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAirdropResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Airdrop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Airdrop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAirdropTotalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAirdropTotalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAirdropTotalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAirdropTotalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAirdropTotalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAirdropTotalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pending.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCount", wireType)
+			}
+			m.PendingCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Available.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMerkleAirdropsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMerkleAirdropsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMerkleAirdropsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMerkleAirdropsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMerkleAirdropsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMerkleAirdropsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Airdrops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Airdrops = append(m.Airdrops, MerkleAirdrop{})
+			if err := m.Airdrops[len(m.Airdrops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMerkleAirdropClaimedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMerkleAirdropClaimedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMerkleAirdropClaimedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
+			}
+			m.AirdropId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AirdropId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMerkleAirdropClaimedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMerkleAirdropClaimedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMerkleAirdropClaimedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Claimed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingBucketStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingBucketStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingBucketStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Vested.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Unvested.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingBucketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingBucketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingBucketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingBucketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingBucketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingBucketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, VestingBucketStatus{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryAirdropsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVestingBucketRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -868,17 +4321,17 @@ func (m *QueryAirdropsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAirdropsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVestingBucketRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAirdropsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVestingBucketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -888,15 +4341,77 @@ func (m *QueryAirdropsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Completed = bool(v != 0)
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingBucketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingBucketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -923,10 +4438,7 @@ func (m *QueryAirdropsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Bucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -951,7 +4463,7 @@ func (m *QueryAirdropsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAirdropsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStrategicReserveRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -974,15 +4486,65 @@ func (m *QueryAirdropsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAirdropsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStrategicReserveRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAirdropsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStrategicReserveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStrategicReserveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStrategicReserveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStrategicReserveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Airdrops", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1009,14 +4571,14 @@ func (m *QueryAirdropsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Airdrops = append(m.Airdrops, Airdrop{})
-			if err := m.Airdrops[len(m.Airdrops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Committed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1043,10 +4605,42 @@ func (m *QueryAirdropsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.Committed = append(m.Committed, types.Coin{})
+			if err := m.Committed[len(m.Committed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Available = append(m.Available, types.Coin{})
+			if err := m.Available[len(m.Available)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1071,7 +4665,7 @@ func (m *QueryAirdropsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAirdropRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStrategicReservePayoutsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1094,17 +4688,17 @@ func (m *QueryAirdropRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAirdropRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStrategicReservePayoutsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAirdropRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStrategicReservePayoutsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ongoing", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1114,29 +4708,17 @@ func (m *QueryAirdropRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TargetAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Ongoing = bool(v != 0)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1146,12 +4728,28 @@ func (m *QueryAirdropRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Completed = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1173,7 +4771,7 @@ func (m *QueryAirdropRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAirdropResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStrategicReservePayoutsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1196,15 +4794,15 @@ func (m *QueryAirdropResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAirdropResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStrategicReservePayoutsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAirdropResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStrategicReservePayoutsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Airdrop", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payouts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1231,7 +4829,44 @@ func (m *QueryAirdropResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Airdrop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Payouts = append(m.Payouts, StrategicReservePayout{})
+			if err := m.Payouts[len(m.Payouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
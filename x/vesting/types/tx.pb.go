@@ -6,22 +6,28 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -105,6 +111,158 @@ func (m *MsgAddAirdropsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddAirdropsResponse proto.InternalMessageInfo
 
+// MsgUpdateAirdrop represents a message to update a pending airdrop target.
+type MsgUpdateAirdrop struct {
+	Sender  string  `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Airdrop Airdrop `protobuf:"bytes,2,opt,name=airdrop,proto3" json:"airdrop"`
+}
+
+func (m *MsgUpdateAirdrop) Reset()         { *m = MsgUpdateAirdrop{} }
+func (m *MsgUpdateAirdrop) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAirdrop) ProtoMessage()    {}
+func (*MsgUpdateAirdrop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2fab51e328bf2d6, []int{2}
+}
+func (m *MsgUpdateAirdrop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAirdrop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAirdrop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAirdrop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAirdrop.Merge(m, src)
+}
+func (m *MsgUpdateAirdrop) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAirdrop) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAirdrop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAirdrop proto.InternalMessageInfo
+
+// MsgUpdateAirdropResponse defines the Msg/UpdateAirdrop response type.
+type MsgUpdateAirdropResponse struct {
+}
+
+func (m *MsgUpdateAirdropResponse) Reset()         { *m = MsgUpdateAirdropResponse{} }
+func (m *MsgUpdateAirdropResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAirdropResponse) ProtoMessage()    {}
+func (*MsgUpdateAirdropResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2fab51e328bf2d6, []int{3}
+}
+func (m *MsgUpdateAirdropResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAirdropResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAirdropResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAirdropResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAirdropResponse.Merge(m, src)
+}
+func (m *MsgUpdateAirdropResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAirdropResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAirdropResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAirdropResponse proto.InternalMessageInfo
+
+// MsgCancelAirdrops represents a message to remove pending airdrop targets.
+type MsgCancelAirdrops struct {
+	Sender      string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TargetAddrs []string `protobuf:"bytes,2,rep,name=target_addrs,json=targetAddrs,proto3" json:"target_addrs,omitempty"`
+}
+
+func (m *MsgCancelAirdrops) Reset()         { *m = MsgCancelAirdrops{} }
+func (m *MsgCancelAirdrops) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAirdrops) ProtoMessage()    {}
+func (*MsgCancelAirdrops) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2fab51e328bf2d6, []int{4}
+}
+func (m *MsgCancelAirdrops) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelAirdrops) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelAirdrops.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelAirdrops) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAirdrops.Merge(m, src)
+}
+func (m *MsgCancelAirdrops) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelAirdrops) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAirdrops.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelAirdrops proto.InternalMessageInfo
+
+// MsgCancelAirdropsResponse defines the Msg/CancelAirdrops response type.
+type MsgCancelAirdropsResponse struct {
+}
+
+func (m *MsgCancelAirdropsResponse) Reset()         { *m = MsgCancelAirdropsResponse{} }
+func (m *MsgCancelAirdropsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAirdropsResponse) ProtoMessage()    {}
+func (*MsgCancelAirdropsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2fab51e328bf2d6, []int{5}
+}
+func (m *MsgCancelAirdropsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelAirdropsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelAirdropsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelAirdropsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAirdropsResponse.Merge(m, src)
+}
+func (m *MsgCancelAirdropsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelAirdropsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAirdropsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelAirdropsResponse proto.InternalMessageInfo
+
 type MsgExecuteAirdrops struct {
 	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	// max count of airdrops performed this time
@@ -115,7 +273,7 @@ func (m *MsgExecuteAirdrops) Reset()         { *m = MsgExecuteAirdrops{} }
 func (m *MsgExecuteAirdrops) String() string { return proto.CompactTextString(m) }
 func (*MsgExecuteAirdrops) ProtoMessage()    {}
 func (*MsgExecuteAirdrops) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a2fab51e328bf2d6, []int{2}
+	return fileDescriptor_a2fab51e328bf2d6, []int{6}
 }
 func (m *MsgExecuteAirdrops) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -145,13 +303,16 @@ func (m *MsgExecuteAirdrops) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgExecuteAirdrops proto.InternalMessageInfo
 
 type MsgExecuteAirdropsResponse struct {
+	// targets of the airdrops which failed to be delivered, and are recorded as
+	// failed instead
+	FailedTargetAddrs []string `protobuf:"bytes,1,rep,name=failed_target_addrs,json=failedTargetAddrs,proto3" json:"failed_target_addrs,omitempty"`
 }
 
 func (m *MsgExecuteAirdropsResponse) Reset()         { *m = MsgExecuteAirdropsResponse{} }
 func (m *MsgExecuteAirdropsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExecuteAirdropsResponse) ProtoMessage()    {}
 func (*MsgExecuteAirdropsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a2fab51e328bf2d6, []int{3}
+	return fileDescriptor_a2fab51e328bf2d6, []int{7}
 }
 func (m *MsgExecuteAirdropsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -180,6 +341,182 @@ func (m *MsgExecuteAirdropsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgExecuteAirdropsResponse proto.InternalMessageInfo
 
+func (m *MsgExecuteAirdropsResponse) GetFailedTargetAddrs() []string {
+	if m != nil {
+		return m.FailedTargetAddrs
+	}
+	return nil
+}
+
+// MsgAddMerkleAirdrop represents a message to add a Merkle airdrop.
+type MsgAddMerkleAirdrop struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// hex encoded Merkle root of the claims
+	MerkleRoot    string          `protobuf:"bytes,2,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	TotalAmount   types.Coin      `protobuf:"bytes,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount"`
+	ClaimDeadline time.Time       `protobuf:"bytes,4,opt,name=claim_deadline,json=claimDeadline,proto3,stdtime" json:"claim_deadline"`
+	Delivery      AirdropDelivery `protobuf:"varint,5,opt,name=delivery,proto3,enum=blackfury.vesting.v1.AirdropDelivery" json:"delivery,omitempty"`
+	// duration in seconds of vesting or locking; zero for liquid delivery
+	Duration uint64 `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (m *MsgAddMerkleAirdrop) Reset()         { *m = MsgAddMerkleAirdrop{} }
+func (m *MsgAddMerkleAirdrop) String() string { return proto.CompactTextString(m) }
+func (*MsgAddMerkleAirdrop) ProtoMessage()    {}
+func (*MsgAddMerkleAirdrop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2fab51e328bf2d6, []int{8}
+}
+func (m *MsgAddMerkleAirdrop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddMerkleAirdrop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddMerkleAirdrop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddMerkleAirdrop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddMerkleAirdrop.Merge(m, src)
+}
+func (m *MsgAddMerkleAirdrop) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddMerkleAirdrop) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddMerkleAirdrop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddMerkleAirdrop proto.InternalMessageInfo
+
+// MsgAddMerkleAirdropResponse defines the Msg/AddMerkleAirdrop response type.
+type MsgAddMerkleAirdropResponse struct {
+	AirdropId uint64 `protobuf:"varint,1,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+}
+
+func (m *MsgAddMerkleAirdropResponse) Reset()         { *m = MsgAddMerkleAirdropResponse{} }
+func (m *MsgAddMerkleAirdropResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddMerkleAirdropResponse) ProtoMessage()    {}
+func (*MsgAddMerkleAirdropResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2fab51e328bf2d6, []int{9}
+}
+func (m *MsgAddMerkleAirdropResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddMerkleAirdropResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddMerkleAirdropResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddMerkleAirdropResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddMerkleAirdropResponse.Merge(m, src)
+}
+func (m *MsgAddMerkleAirdropResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddMerkleAirdropResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddMerkleAirdropResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddMerkleAirdropResponse proto.InternalMessageInfo
+
+func (m *MsgAddMerkleAirdropResponse) GetAirdropId() uint64 {
+	if m != nil {
+		return m.AirdropId
+	}
+	return 0
+}
+
+// MsgClaimAirdrop represents a message to claim from a Merkle airdrop.
+type MsgClaimAirdrop struct {
+	Sender    string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	AirdropId uint64                                 `protobuf:"varint,2,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// hex encoded Merkle proof of the claim
+	Proof []string `protobuf:"bytes,4,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *MsgClaimAirdrop) Reset()         { *m = MsgClaimAirdrop{} }
+func (m *MsgClaimAirdrop) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAirdrop) ProtoMessage()    {}
+func (*MsgClaimAirdrop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2fab51e328bf2d6, []int{10}
+}
+func (m *MsgClaimAirdrop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAirdrop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAirdrop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimAirdrop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAirdrop.Merge(m, src)
+}
+func (m *MsgClaimAirdrop) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAirdrop) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAirdrop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimAirdrop proto.InternalMessageInfo
+
+// MsgClaimAirdropResponse defines the Msg/ClaimAirdrop response type.
+type MsgClaimAirdropResponse struct {
+}
+
+func (m *MsgClaimAirdropResponse) Reset()         { *m = MsgClaimAirdropResponse{} }
+func (m *MsgClaimAirdropResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAirdropResponse) ProtoMessage()    {}
+func (*MsgClaimAirdropResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2fab51e328bf2d6, []int{11}
+}
+func (m *MsgClaimAirdropResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAirdropResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAirdropResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimAirdropResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAirdropResponse.Merge(m, src)
+}
+func (m *MsgClaimAirdropResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAirdropResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAirdropResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimAirdropResponse proto.InternalMessageInfo
+
 // MsgSetAllocationAddress represents a message to set allocation address.
 type MsgSetAllocationAddress struct {
 	Sender                        string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
@@ -191,7 +528,7 @@ func (m *MsgSetAllocationAddress) Reset()         { *m = MsgSetAllocationAddress
 func (m *MsgSetAllocationAddress) String() string { return proto.CompactTextString(m) }
 func (*MsgSetAllocationAddress) ProtoMessage()    {}
 func (*MsgSetAllocationAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a2fab51e328bf2d6, []int{4}
+	return fileDescriptor_a2fab51e328bf2d6, []int{12}
 }
 func (m *MsgSetAllocationAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -229,7 +566,7 @@ func (m *MsgSetAllocationAddressResponse) Reset()         { *m = MsgSetAllocatio
 func (m *MsgSetAllocationAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetAllocationAddressResponse) ProtoMessage()    {}
 func (*MsgSetAllocationAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a2fab51e328bf2d6, []int{5}
+	return fileDescriptor_a2fab51e328bf2d6, []int{13}
 }
 func (m *MsgSetAllocationAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -258,53 +595,176 @@ func (m *MsgSetAllocationAddressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetAllocationAddressResponse proto.InternalMessageInfo
 
+// MsgFundStrategicReserve represents a message to fund the strategic reserve
+// pool.
+type MsgFundStrategicReserve struct {
+	Sender string                                   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgFundStrategicReserve) Reset()         { *m = MsgFundStrategicReserve{} }
+func (m *MsgFundStrategicReserve) String() string { return proto.CompactTextString(m) }
+func (*MsgFundStrategicReserve) ProtoMessage()    {}
+func (*MsgFundStrategicReserve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2fab51e328bf2d6, []int{14}
+}
+func (m *MsgFundStrategicReserve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundStrategicReserve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundStrategicReserve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundStrategicReserve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundStrategicReserve.Merge(m, src)
+}
+func (m *MsgFundStrategicReserve) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundStrategicReserve) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundStrategicReserve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundStrategicReserve proto.InternalMessageInfo
+
+// MsgFundStrategicReserveResponse defines the Msg/FundStrategicReserve
+// response type.
+type MsgFundStrategicReserveResponse struct {
+}
+
+func (m *MsgFundStrategicReserveResponse) Reset()         { *m = MsgFundStrategicReserveResponse{} }
+func (m *MsgFundStrategicReserveResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundStrategicReserveResponse) ProtoMessage()    {}
+func (*MsgFundStrategicReserveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2fab51e328bf2d6, []int{15}
+}
+func (m *MsgFundStrategicReserveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundStrategicReserveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundStrategicReserveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundStrategicReserveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundStrategicReserveResponse.Merge(m, src)
+}
+func (m *MsgFundStrategicReserveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundStrategicReserveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundStrategicReserveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundStrategicReserveResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAddAirdrops)(nil), "blackfury.vesting.v1.MsgAddAirdrops")
 	proto.RegisterType((*MsgAddAirdropsResponse)(nil), "blackfury.vesting.v1.MsgAddAirdropsResponse")
+	proto.RegisterType((*MsgUpdateAirdrop)(nil), "blackfury.vesting.v1.MsgUpdateAirdrop")
+	proto.RegisterType((*MsgUpdateAirdropResponse)(nil), "blackfury.vesting.v1.MsgUpdateAirdropResponse")
+	proto.RegisterType((*MsgCancelAirdrops)(nil), "blackfury.vesting.v1.MsgCancelAirdrops")
+	proto.RegisterType((*MsgCancelAirdropsResponse)(nil), "blackfury.vesting.v1.MsgCancelAirdropsResponse")
 	proto.RegisterType((*MsgExecuteAirdrops)(nil), "blackfury.vesting.v1.MsgExecuteAirdrops")
 	proto.RegisterType((*MsgExecuteAirdropsResponse)(nil), "blackfury.vesting.v1.MsgExecuteAirdropsResponse")
+	proto.RegisterType((*MsgAddMerkleAirdrop)(nil), "blackfury.vesting.v1.MsgAddMerkleAirdrop")
+	proto.RegisterType((*MsgAddMerkleAirdropResponse)(nil), "blackfury.vesting.v1.MsgAddMerkleAirdropResponse")
+	proto.RegisterType((*MsgClaimAirdrop)(nil), "blackfury.vesting.v1.MsgClaimAirdrop")
+	proto.RegisterType((*MsgClaimAirdropResponse)(nil), "blackfury.vesting.v1.MsgClaimAirdropResponse")
 	proto.RegisterType((*MsgSetAllocationAddress)(nil), "blackfury.vesting.v1.MsgSetAllocationAddress")
 	proto.RegisterType((*MsgSetAllocationAddressResponse)(nil), "blackfury.vesting.v1.MsgSetAllocationAddressResponse")
+	proto.RegisterType((*MsgFundStrategicReserve)(nil), "blackfury.vesting.v1.MsgFundStrategicReserve")
+	proto.RegisterType((*MsgFundStrategicReserveResponse)(nil), "blackfury.vesting.v1.MsgFundStrategicReserveResponse")
 }
 
 func init() { proto.RegisterFile("blackfury/vesting/v1/tx.proto", fileDescriptor_a2fab51e328bf2d6) }
 
 var fileDescriptor_a2fab51e328bf2d6 = []byte{
-	// 539 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x77, 0x93, 0x52, 0x92, 0x29, 0x58, 0x5c, 0x42, 0x0d, 0x6b, 0xb3, 0x89, 0xc1, 0x1f,
-	0x41, 0xec, 0xae, 0x69, 0x11, 0xc1, 0x8b, 0xa4, 0x45, 0x3c, 0xe5, 0xb2, 0x05, 0x0f, 0x5e, 0x96,
-	0xc9, 0xce, 0x73, 0x5c, 0x4c, 0x76, 0xc2, 0xbc, 0xd9, 0xb0, 0xb9, 0x7a, 0xf2, 0x58, 0xf0, 0x2c,
-	0xf4, 0x4f, 0xf0, 0xe2, 0xff, 0xd0, 0x63, 0xc1, 0x8b, 0x27, 0x91, 0xc4, 0x83, 0x47, 0xff, 0x04,
-	0xc9, 0x66, 0xb3, 0xa6, 0x75, 0x17, 0xdb, 0xdb, 0xfc, 0xf8, 0xbe, 0xf7, 0xfd, 0xbc, 0x79, 0x8f,
-	0x21, 0x8d, 0xc1, 0x90, 0xfa, 0xef, 0xde, 0x44, 0x72, 0xea, 0x4c, 0x00, 0x55, 0x10, 0x72, 0x67,
-	0xd2, 0x75, 0x54, 0x6c, 0x8f, 0xa5, 0x50, 0xc2, 0xa8, 0x65, 0xd7, 0x76, 0x7a, 0x6d, 0x4f, 0xba,
-	0xe6, 0x2e, 0x17, 0x82, 0x0f, 0xc1, 0xa1, 0xe3, 0xc0, 0xa1, 0x61, 0x28, 0x14, 0x55, 0x81, 0x08,
-	0x71, 0x19, 0x63, 0xd6, 0xb8, 0xe0, 0x22, 0x59, 0x3a, 0x8b, 0x55, 0x7a, 0xda, 0xce, 0x35, 0x5a,
-	0x25, 0x4d, 0x34, 0x6d, 0x24, 0x37, 0xfa, 0xc8, 0x7b, 0x8c, 0xf5, 0x02, 0xc9, 0xa4, 0x18, 0xa3,
-	0xb1, 0x43, 0x36, 0x11, 0x42, 0x06, 0xb2, 0xae, 0xb7, 0xf4, 0x4e, 0xd5, 0x4d, 0x77, 0xc6, 0x73,
-	0x52, 0xa1, 0xa9, 0xa6, 0x5e, 0x6a, 0x95, 0x3b, 0x5b, 0xfb, 0x0d, 0x3b, 0x0f, 0xd5, 0x4e, 0x33,
-	0x1d, 0x6e, 0x9c, 0x7d, 0x6f, 0x6a, 0x6e, 0x16, 0xf4, 0xac, 0xf2, 0xe1, 0xb4, 0xa9, 0xfd, 0x3a,
-	0x6d, 0x6a, 0xed, 0x3a, 0xd9, 0xb9, 0x68, 0xea, 0x02, 0x8e, 0x45, 0x88, 0xd0, 0x3e, 0x26, 0x46,
-	0x1f, 0xf9, 0x8b, 0x18, 0xfc, 0x48, 0xc1, 0x7f, 0x91, 0x6e, 0x93, 0xea, 0x88, 0xc6, 0x9e, 0x2f,
-	0xa2, 0x50, 0xd5, 0x4b, 0x2d, 0xbd, 0xb3, 0xe1, 0x56, 0x46, 0x34, 0x3e, 0x5a, 0xec, 0xd7, 0xec,
-	0x76, 0x89, 0xf9, 0x6f, 0xd2, 0xcc, 0xf2, 0xb3, 0x4e, 0x6e, 0xf5, 0x91, 0x1f, 0x83, 0xea, 0x0d,
-	0x87, 0xc2, 0x4f, 0xde, 0xb5, 0xc7, 0x98, 0x04, 0x2c, 0x36, 0x7e, 0x48, 0x6e, 0x2a, 0xa0, 0x23,
-	0x2f, 0xad, 0xda, 0xa3, 0x8c, 0xc9, 0x04, 0xa0, 0xea, 0x6e, 0x2f, 0x2e, 0x5e, 0x2d, 0xcf, 0x17,
-	0x69, 0x8c, 0x97, 0xa4, 0x85, 0x4a, 0x52, 0x05, 0x3c, 0xf0, 0x3d, 0x09, 0x08, 0x72, 0x02, 0x9e,
-	0x1f, 0xa1, 0x12, 0x2c, 0xa0, 0xe1, 0x32, 0xb4, 0x9c, 0x84, 0x36, 0x32, 0x9d, 0xbb, 0x94, 0x1d,
-	0xad, 0x54, 0x8b, 0x44, 0x6b, 0x05, 0xdd, 0x21, 0xcd, 0x02, 0xe2, 0x55, 0x55, 0xfb, 0xbf, 0xcb,
-	0xa4, 0xdc, 0x47, 0x6e, 0x9c, 0xe8, 0x64, 0x6b, 0xbd, 0xbb, 0x77, 0xf3, 0x7b, 0x76, 0xb1, 0x1d,
-	0xe6, 0xa3, 0xab, 0xa8, 0xb2, 0x17, 0xdc, 0x7b, 0xff, 0xf5, 0xe7, 0xc7, 0xd2, 0x03, 0xe3, 0x9e,
-	0x53, 0x30, 0xd9, 0x0e, 0x65, 0xcc, 0x5b, 0xcd, 0x81, 0xf1, 0x49, 0x27, 0xdb, 0x97, 0x3b, 0xdc,
-	0x29, 0x34, 0xbc, 0xa4, 0x34, 0x1f, 0x5f, 0x55, 0x99, 0xe1, 0xd9, 0x09, 0x5e, 0xc7, 0xb8, 0x5f,
-	0x88, 0x07, 0x31, 0xf8, 0x7f, 0xf9, 0xbe, 0xe8, 0xa4, 0x96, 0x3b, 0x0d, 0x7b, 0x85, 0xd6, 0x79,
-	0x72, 0xf3, 0xc9, 0xb5, 0xe4, 0x19, 0xee, 0xd3, 0x04, 0xb7, 0x6b, 0x38, 0x85, 0xb8, 0x08, 0xca,
-	0xa3, 0x59, 0x7c, 0x32, 0x41, 0x80, 0x78, 0xd8, 0x3f, 0x9b, 0x59, 0xfa, 0xf9, 0xcc, 0xd2, 0x7f,
-	0xcc, 0x2c, 0xfd, 0x64, 0x6e, 0x69, 0xe7, 0x73, 0x4b, 0xfb, 0x36, 0xb7, 0xb4, 0xd7, 0x07, 0x3c,
-	0x50, 0x6f, 0xa3, 0x81, 0xed, 0x8b, 0x91, 0x03, 0xc3, 0x29, 0x06, 0xd1, 0x08, 0x97, 0x1f, 0xc8,
-	0x9a, 0x47, 0x9c, 0xb9, 0xa8, 0xe9, 0x18, 0x70, 0xb0, 0x99, 0x7c, 0x10, 0x07, 0x7f, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0xce, 0x52, 0xfe, 0x38, 0xaf, 0x04, 0x00, 0x00,
+	// 1089 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0xbb, 0xdd, 0x92, 0xbc, 0xee, 0xb6, 0xdb, 0xd9, 0x6a, 0x49, 0x5d, 0x9a, 0x74, 0x23,
+	0xda, 0x66, 0x17, 0x6a, 0x37, 0xad, 0x56, 0x48, 0x08, 0x84, 0xd2, 0x2e, 0x8b, 0x56, 0x90, 0x8b,
+	0xbb, 0x20, 0xc4, 0xc5, 0x9a, 0xd8, 0x53, 0x63, 0xd5, 0xf6, 0x44, 0x9e, 0x71, 0x94, 0x5e, 0x39,
+	0x71, 0xac, 0x04, 0x07, 0x0e, 0x20, 0x95, 0x03, 0x17, 0x4e, 0x7b, 0xe1, 0x3f, 0xf4, 0xb8, 0x12,
+	0x17, 0xc4, 0x61, 0x17, 0xb5, 0x1c, 0xf8, 0x19, 0xc8, 0xe3, 0xb1, 0xeb, 0xa4, 0x71, 0x9b, 0x9e,
+	0x9a, 0x99, 0xf9, 0xde, 0x7b, 0xdf, 0x7b, 0xdf, 0x9b, 0x79, 0x2e, 0xac, 0x74, 0x3d, 0x6c, 0x1d,
+	0x1e, 0x44, 0xe1, 0x91, 0xde, 0x27, 0x8c, 0xbb, 0x81, 0xa3, 0xf7, 0x5b, 0x3a, 0x1f, 0x68, 0xbd,
+	0x90, 0x72, 0x8a, 0x16, 0xb3, 0x63, 0x4d, 0x1e, 0x6b, 0xfd, 0x96, 0xfa, 0x8e, 0x43, 0xa9, 0xe3,
+	0x11, 0x1d, 0xf7, 0x5c, 0x1d, 0x07, 0x01, 0xe5, 0x98, 0xbb, 0x34, 0x60, 0x89, 0x8d, 0xba, 0xe8,
+	0x50, 0x87, 0x8a, 0x9f, 0x7a, 0xfc, 0x4b, 0xee, 0xd6, 0xa5, 0x8d, 0x58, 0x75, 0xa3, 0x03, 0x9d,
+	0xbb, 0x3e, 0x61, 0x1c, 0xfb, 0x3d, 0x09, 0xa8, 0x59, 0x94, 0xf9, 0x94, 0xe9, 0x5d, 0xcc, 0x88,
+	0xde, 0x6f, 0x75, 0x09, 0xc7, 0x2d, 0xdd, 0xa2, 0x6e, 0x20, 0xcf, 0x1b, 0x63, 0x99, 0xa6, 0xac,
+	0x04, 0xa6, 0xc1, 0x60, 0xae, 0xc3, 0x9c, 0xb6, 0x6d, 0xb7, 0xdd, 0xd0, 0x0e, 0x69, 0x8f, 0xa1,
+	0x07, 0x30, 0xc3, 0x48, 0x60, 0x93, 0xb0, 0xaa, 0xac, 0x2a, 0xcd, 0x8a, 0x21, 0x57, 0xe8, 0x13,
+	0x28, 0x63, 0x89, 0xa9, 0x4e, 0xad, 0xde, 0x6a, 0xce, 0x6e, 0xaf, 0x68, 0xe3, 0x72, 0xd5, 0xa4,
+	0xa7, 0xdd, 0xe9, 0xd3, 0xd7, 0xf5, 0x92, 0x91, 0x19, 0x7d, 0x58, 0xfe, 0xfe, 0xa4, 0x5e, 0xfa,
+	0xef, 0xa4, 0x5e, 0x6a, 0x54, 0xe1, 0xc1, 0x70, 0x50, 0x83, 0xb0, 0x1e, 0x0d, 0x18, 0x69, 0x30,
+	0xb8, 0xd7, 0x61, 0xce, 0x97, 0x3d, 0x1b, 0x73, 0x22, 0x0f, 0x0b, 0x09, 0x7d, 0x0c, 0x6f, 0x49,
+	0xdf, 0xd5, 0xa9, 0x55, 0x65, 0x52, 0x3e, 0xa9, 0x4d, 0x8e, 0x8e, 0x0a, 0xd5, 0xd1, 0xa0, 0x19,
+	0xa1, 0xaf, 0x61, 0xa1, 0xc3, 0x9c, 0x3d, 0x1c, 0x58, 0xc4, 0xbb, 0xb6, 0x44, 0x0f, 0xe1, 0x0e,
+	0xc7, 0xa1, 0x43, 0xb8, 0x89, 0x6d, 0x3b, 0x4c, 0xca, 0x54, 0x31, 0x66, 0x93, 0xbd, 0x76, 0xbc,
+	0x95, 0x8b, 0xba, 0x0c, 0x4b, 0x97, 0x3c, 0x67, 0x61, 0xf7, 0x01, 0x75, 0x98, 0xf3, 0xe9, 0x80,
+	0x58, 0x11, 0x27, 0xd7, 0xc6, 0x5d, 0x86, 0x8a, 0x8f, 0x07, 0xa6, 0x45, 0xa3, 0x80, 0x8b, 0x5a,
+	0x4c, 0x1b, 0x65, 0x1f, 0x0f, 0xf6, 0xe2, 0x75, 0x2e, 0xe2, 0x17, 0xa0, 0x5e, 0x76, 0x9a, 0x86,
+	0x44, 0x1a, 0xdc, 0x3f, 0xc0, 0xae, 0x47, 0x6c, 0x73, 0x28, 0x07, 0x45, 0xe4, 0xb0, 0x90, 0x1c,
+	0xbd, 0xb8, 0xc8, 0xa4, 0x71, 0x3a, 0x05, 0xf7, 0x13, 0x15, 0x3b, 0x24, 0x3c, 0xf4, 0xae, 0x95,
+	0xab, 0x0e, 0xb3, 0xbe, 0x00, 0x9a, 0x21, 0xa5, 0x09, 0xcd, 0x8a, 0x01, 0xc9, 0x96, 0x41, 0x29,
+	0x47, 0xbb, 0x70, 0x87, 0x53, 0x8e, 0x3d, 0x13, 0xfb, 0x22, 0x91, 0x5b, 0x42, 0xd4, 0x25, 0x2d,
+	0xe9, 0x72, 0x2d, 0xee, 0x72, 0x4d, 0x76, 0xb9, 0xb6, 0x47, 0xdd, 0x40, 0x0a, 0x3a, 0x2b, 0x8c,
+	0xda, 0xc2, 0x06, 0x7d, 0x0e, 0x73, 0x96, 0x87, 0x5d, 0xdf, 0xb4, 0x09, 0xb6, 0x3d, 0x37, 0x20,
+	0xd5, 0x69, 0xe1, 0x45, 0xd5, 0x92, 0xcb, 0xa4, 0xa5, 0x97, 0x49, 0x7b, 0x91, 0x5e, 0xa6, 0xdd,
+	0x72, 0xec, 0xe6, 0xf8, 0x4d, 0x5d, 0x31, 0xee, 0x0a, 0xdb, 0xa7, 0xd2, 0x14, 0xb5, 0xa1, 0x6c,
+	0x13, 0xcf, 0xed, 0x93, 0xf0, 0xa8, 0x7a, 0x7b, 0x55, 0x69, 0xce, 0x6d, 0xaf, 0x5d, 0xd9, 0x61,
+	0x4f, 0x25, 0xd8, 0xc8, 0xcc, 0x90, 0x0a, 0x65, 0x3b, 0x0a, 0xc5, 0x65, 0xaf, 0xce, 0x24, 0xc2,
+	0xa4, 0xeb, 0x9c, 0x30, 0x1f, 0xc1, 0xf2, 0x98, 0x4a, 0x66, 0xca, 0xac, 0x00, 0xc8, 0xa6, 0x35,
+	0x5d, 0x5b, 0x54, 0x75, 0xda, 0xa8, 0xc8, 0x9d, 0xe7, 0x76, 0xe3, 0xa5, 0x02, 0xf3, 0x71, 0x27,
+	0xc5, 0xdc, 0xaf, 0x13, 0x61, 0xd8, 0xd5, 0xd4, 0x88, 0x2b, 0xf4, 0x0c, 0x66, 0x72, 0xc5, 0xaf,
+	0xec, 0x6a, 0x71, 0x69, 0xfe, 0x7e, 0x5d, 0x5f, 0x77, 0x5c, 0xfe, 0x6d, 0xd4, 0xd5, 0x2c, 0xea,
+	0xeb, 0xf2, 0xd1, 0x49, 0xfe, 0x6c, 0x32, 0xfb, 0x50, 0xe7, 0x47, 0x3d, 0xc2, 0xb4, 0xe7, 0x01,
+	0x37, 0xa4, 0x35, 0x5a, 0x84, 0xdb, 0xbd, 0x90, 0xd2, 0x83, 0xea, 0xb4, 0xe8, 0x9e, 0x64, 0x91,
+	0x4b, 0x78, 0x09, 0xde, 0x1e, 0x61, 0x9c, 0x75, 0xfe, 0x4b, 0x45, 0x9c, 0xed, 0x13, 0xde, 0xf6,
+	0x3c, 0x6a, 0x89, 0x52, 0xc5, 0x0d, 0x47, 0x58, 0x71, 0xff, 0x3f, 0x86, 0x05, 0x4e, 0xb0, 0x6f,
+	0x4a, 0x49, 0x44, 0xe7, 0xca, 0x06, 0x9b, 0x8f, 0x0f, 0xbe, 0x4a, 0xf6, 0x63, 0x37, 0xe8, 0x33,
+	0x58, 0x65, 0x3c, 0xc4, 0x9c, 0x38, 0xae, 0x65, 0x86, 0x84, 0x91, 0xb0, 0x4f, 0x4c, 0x2b, 0x62,
+	0x9c, 0xda, 0x2e, 0x0e, 0x12, 0x53, 0x91, 0xbc, 0xb1, 0x92, 0xe1, 0x8c, 0x04, 0xb6, 0x97, 0xa2,
+	0x62, 0x47, 0xb9, 0x6c, 0x1e, 0x42, 0xbd, 0x80, 0x71, 0x96, 0xd5, 0x49, 0x92, 0xd5, 0xb3, 0x28,
+	0xb0, 0xf7, 0x47, 0xbc, 0x16, 0x66, 0x65, 0x65, 0x62, 0x24, 0xcf, 0xed, 0x15, 0x37, 0x61, 0x2b,
+	0xd6, 0xe9, 0xf7, 0x37, 0xf5, 0xe6, 0x04, 0x3a, 0xc5, 0x06, 0x2c, 0x55, 0xea, 0x52, 0x16, 0xe3,
+	0x18, 0xa6, 0x59, 0x6c, 0xff, 0x0a, 0x70, 0xab, 0xc3, 0x1c, 0x74, 0xac, 0xc0, 0x6c, 0x7e, 0x64,
+	0xbc, 0x3b, 0xfe, 0x5a, 0x0c, 0xbf, 0xf1, 0xea, 0xfb, 0x93, 0xa0, 0xb2, 0x8a, 0x6d, 0x7e, 0xf7,
+	0xe7, 0xbf, 0x3f, 0x4c, 0x6d, 0xa0, 0x35, 0xbd, 0x60, 0xde, 0xea, 0xd8, 0xb6, 0xcd, 0x74, 0xb8,
+	0xa0, 0x9f, 0x14, 0xb8, 0x3b, 0x3c, 0x36, 0xd6, 0x0b, 0xc3, 0x0d, 0xe1, 0x54, 0x6d, 0x32, 0x5c,
+	0x46, 0x4c, 0x17, 0xc4, 0x1e, 0xa1, 0x8d, 0x42, 0x62, 0x91, 0xb0, 0x4b, 0xb9, 0xa1, 0x9f, 0x15,
+	0x98, 0x1b, 0x19, 0x20, 0x1b, 0x85, 0x31, 0x87, 0x81, 0xaa, 0x3e, 0x21, 0x30, 0x63, 0xb7, 0x25,
+	0xd8, 0x3d, 0x46, 0xcd, 0x42, 0x76, 0x96, 0x30, 0xbc, 0xa8, 0xdc, 0x2f, 0x0a, 0xcc, 0x8f, 0x0e,
+	0x9a, 0x66, 0x61, 0xd8, 0x11, 0xa4, 0xba, 0x35, 0x29, 0x32, 0x63, 0xa8, 0x09, 0x86, 0x4d, 0xb4,
+	0x5e, 0xc8, 0x90, 0x0c, 0x88, 0x75, 0xc1, 0xef, 0x37, 0x05, 0xee, 0x5d, 0x1a, 0x32, 0x8f, 0xae,
+	0xea, 0xa5, 0x21, 0xa8, 0xda, 0x9a, 0x18, 0x9a, 0x51, 0xdc, 0x11, 0x14, 0x37, 0xd1, 0x7b, 0x57,
+	0xf6, 0x9e, 0x9c, 0x66, 0xa9, 0xcc, 0x3f, 0x2a, 0x70, 0x67, 0xe8, 0x0d, 0x5e, 0x2b, 0xd6, 0x2e,
+	0x07, 0x53, 0x37, 0x27, 0x82, 0xdd, 0xa0, 0x7c, 0xc9, 0x00, 0x4c, 0x69, 0xfd, 0xa1, 0xc0, 0xe2,
+	0xd8, 0xc7, 0xb4, 0x38, 0xee, 0x38, 0xb8, 0xfa, 0xe4, 0x46, 0xf0, 0x8c, 0xee, 0x07, 0x82, 0x6e,
+	0x0b, 0xe9, 0x85, 0x74, 0x59, 0xfc, 0xa9, 0x91, 0xd9, 0x9b, 0x58, 0xd2, 0x8b, 0x79, 0x8f, 0x7d,
+	0x2e, 0x8b, 0x79, 0x8f, 0x83, 0xab, 0x4f, 0x6e, 0x04, 0xbf, 0x01, 0xef, 0x83, 0x28, 0xb0, 0xcd,
+	0x4b, 0xa3, 0x64, 0xb7, 0x73, 0x7a, 0x56, 0x53, 0x5e, 0x9d, 0xd5, 0x94, 0x7f, 0xce, 0x6a, 0xca,
+	0xf1, 0x79, 0xad, 0xf4, 0xea, 0xbc, 0x56, 0xfa, 0xeb, 0xbc, 0x56, 0xfa, 0x66, 0x27, 0xf7, 0x38,
+	0x13, 0xef, 0x88, 0xb9, 0x91, 0xcf, 0x92, 0xff, 0x03, 0x72, 0x31, 0x06, 0x59, 0x14, 0xf1, 0x5a,
+	0x77, 0x67, 0xc4, 0x07, 0xcb, 0xce, 0xff, 0x03, 0x00, 0x5e, 0x26, 0xe1, 0x61, 0x76, 0x0c, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -322,12 +782,27 @@ type MsgClient interface {
 	// AddAirdrops adds airdrop targets.
 	// Should only be called by core team multisig.
 	AddAirdrops(ctx context.Context, in *MsgAddAirdrops, opts ...grpc.CallOption) (*MsgAddAirdropsResponse, error)
+	// UpdateAirdrop updates a pending airdrop target.
+	// Should only be called by core team multisig.
+	UpdateAirdrop(ctx context.Context, in *MsgUpdateAirdrop, opts ...grpc.CallOption) (*MsgUpdateAirdropResponse, error)
+	// CancelAirdrops removes pending airdrop targets.
+	// Should only be called by core team multisig.
+	CancelAirdrops(ctx context.Context, in *MsgCancelAirdrops, opts ...grpc.CallOption) (*MsgCancelAirdropsResponse, error)
 	// ExecuteAirdrops performs airdrops.
 	// Should only be called by core team multisig.
 	ExecuteAirdrops(ctx context.Context, in *MsgExecuteAirdrops, opts ...grpc.CallOption) (*MsgExecuteAirdropsResponse, error)
+	// AddMerkleAirdrop adds an airdrop committed as a Merkle root, which is
+	// claimed by the recipients.
+	// Should only be called by core team multisig.
+	AddMerkleAirdrop(ctx context.Context, in *MsgAddMerkleAirdrop, opts ...grpc.CallOption) (*MsgAddMerkleAirdropResponse, error)
+	// ClaimAirdrop claims from a Merkle airdrop with a Merkle proof.
+	ClaimAirdrop(ctx context.Context, in *MsgClaimAirdrop, opts ...grpc.CallOption) (*MsgClaimAirdropResponse, error)
 	// SetAllocationAddress sets allocation address of team vesting or
 	// strategic_reserve_custodian.
 	SetAllocationAddress(ctx context.Context, in *MsgSetAllocationAddress, opts ...grpc.CallOption) (*MsgSetAllocationAddressResponse, error)
+	// FundStrategicReserve funds the strategic reserve pool. The strategic
+	// reserve custodian migrates the funds it holds into the pool by it.
+	FundStrategicReserve(ctx context.Context, in *MsgFundStrategicReserve, opts ...grpc.CallOption) (*MsgFundStrategicReserveResponse, error)
 }
 
 type msgClient struct {
@@ -347,6 +822,24 @@ func (c *msgClient) AddAirdrops(ctx context.Context, in *MsgAddAirdrops, opts ..
 	return out, nil
 }
 
+func (c *msgClient) UpdateAirdrop(ctx context.Context, in *MsgUpdateAirdrop, opts ...grpc.CallOption) (*MsgUpdateAirdropResponse, error) {
+	out := new(MsgUpdateAirdropResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.vesting.v1.Msg/UpdateAirdrop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelAirdrops(ctx context.Context, in *MsgCancelAirdrops, opts ...grpc.CallOption) (*MsgCancelAirdropsResponse, error) {
+	out := new(MsgCancelAirdropsResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.vesting.v1.Msg/CancelAirdrops", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) ExecuteAirdrops(ctx context.Context, in *MsgExecuteAirdrops, opts ...grpc.CallOption) (*MsgExecuteAirdropsResponse, error) {
 	out := new(MsgExecuteAirdropsResponse)
 	err := c.cc.Invoke(ctx, "/blackfury.vesting.v1.Msg/ExecuteAirdrops", in, out, opts...)
@@ -356,6 +849,24 @@ func (c *msgClient) ExecuteAirdrops(ctx context.Context, in *MsgExecuteAirdrops,
 	return out, nil
 }
 
+func (c *msgClient) AddMerkleAirdrop(ctx context.Context, in *MsgAddMerkleAirdrop, opts ...grpc.CallOption) (*MsgAddMerkleAirdropResponse, error) {
+	out := new(MsgAddMerkleAirdropResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.vesting.v1.Msg/AddMerkleAirdrop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimAirdrop(ctx context.Context, in *MsgClaimAirdrop, opts ...grpc.CallOption) (*MsgClaimAirdropResponse, error) {
+	out := new(MsgClaimAirdropResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.vesting.v1.Msg/ClaimAirdrop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SetAllocationAddress(ctx context.Context, in *MsgSetAllocationAddress, opts ...grpc.CallOption) (*MsgSetAllocationAddressResponse, error) {
 	out := new(MsgSetAllocationAddressResponse)
 	err := c.cc.Invoke(ctx, "/blackfury.vesting.v1.Msg/SetAllocationAddress", in, out, opts...)
@@ -365,17 +876,41 @@ func (c *msgClient) SetAllocationAddress(ctx context.Context, in *MsgSetAllocati
 	return out, nil
 }
 
+func (c *msgClient) FundStrategicReserve(ctx context.Context, in *MsgFundStrategicReserve, opts ...grpc.CallOption) (*MsgFundStrategicReserveResponse, error) {
+	out := new(MsgFundStrategicReserveResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.vesting.v1.Msg/FundStrategicReserve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AddAirdrops adds airdrop targets.
 	// Should only be called by core team multisig.
 	AddAirdrops(context.Context, *MsgAddAirdrops) (*MsgAddAirdropsResponse, error)
+	// UpdateAirdrop updates a pending airdrop target.
+	// Should only be called by core team multisig.
+	UpdateAirdrop(context.Context, *MsgUpdateAirdrop) (*MsgUpdateAirdropResponse, error)
+	// CancelAirdrops removes pending airdrop targets.
+	// Should only be called by core team multisig.
+	CancelAirdrops(context.Context, *MsgCancelAirdrops) (*MsgCancelAirdropsResponse, error)
 	// ExecuteAirdrops performs airdrops.
 	// Should only be called by core team multisig.
 	ExecuteAirdrops(context.Context, *MsgExecuteAirdrops) (*MsgExecuteAirdropsResponse, error)
+	// AddMerkleAirdrop adds an airdrop committed as a Merkle root, which is
+	// claimed by the recipients.
+	// Should only be called by core team multisig.
+	AddMerkleAirdrop(context.Context, *MsgAddMerkleAirdrop) (*MsgAddMerkleAirdropResponse, error)
+	// ClaimAirdrop claims from a Merkle airdrop with a Merkle proof.
+	ClaimAirdrop(context.Context, *MsgClaimAirdrop) (*MsgClaimAirdropResponse, error)
 	// SetAllocationAddress sets allocation address of team vesting or
 	// strategic_reserve_custodian.
 	SetAllocationAddress(context.Context, *MsgSetAllocationAddress) (*MsgSetAllocationAddressResponse, error)
+	// FundStrategicReserve funds the strategic reserve pool. The strategic
+	// reserve custodian migrates the funds it holds into the pool by it.
+	FundStrategicReserve(context.Context, *MsgFundStrategicReserve) (*MsgFundStrategicReserveResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -385,12 +920,27 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) AddAirdrops(ctx context.Context, req *MsgAddAirdrops) (*MsgAddAirdropsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddAirdrops not implemented")
 }
+func (*UnimplementedMsgServer) UpdateAirdrop(ctx context.Context, req *MsgUpdateAirdrop) (*MsgUpdateAirdropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAirdrop not implemented")
+}
+func (*UnimplementedMsgServer) CancelAirdrops(ctx context.Context, req *MsgCancelAirdrops) (*MsgCancelAirdropsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAirdrops not implemented")
+}
 func (*UnimplementedMsgServer) ExecuteAirdrops(ctx context.Context, req *MsgExecuteAirdrops) (*MsgExecuteAirdropsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteAirdrops not implemented")
 }
+func (*UnimplementedMsgServer) AddMerkleAirdrop(ctx context.Context, req *MsgAddMerkleAirdrop) (*MsgAddMerkleAirdropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMerkleAirdrop not implemented")
+}
+func (*UnimplementedMsgServer) ClaimAirdrop(ctx context.Context, req *MsgClaimAirdrop) (*MsgClaimAirdropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAirdrop not implemented")
+}
 func (*UnimplementedMsgServer) SetAllocationAddress(ctx context.Context, req *MsgSetAllocationAddress) (*MsgSetAllocationAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetAllocationAddress not implemented")
 }
+func (*UnimplementedMsgServer) FundStrategicReserve(ctx context.Context, req *MsgFundStrategicReserve) (*MsgFundStrategicReserveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundStrategicReserve not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -414,6 +964,42 @@ func _Msg_AddAirdrops_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateAirdrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAirdrop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAirdrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.vesting.v1.Msg/UpdateAirdrop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAirdrop(ctx, req.(*MsgUpdateAirdrop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelAirdrops_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAirdrops)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAirdrops(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.vesting.v1.Msg/CancelAirdrops",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAirdrops(ctx, req.(*MsgCancelAirdrops))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_ExecuteAirdrops_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgExecuteAirdrops)
 	if err := dec(in); err != nil {
@@ -432,6 +1018,42 @@ func _Msg_ExecuteAirdrops_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AddMerkleAirdrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddMerkleAirdrop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddMerkleAirdrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.vesting.v1.Msg/AddMerkleAirdrop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddMerkleAirdrop(ctx, req.(*MsgAddMerkleAirdrop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimAirdrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimAirdrop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimAirdrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.vesting.v1.Msg/ClaimAirdrop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimAirdrop(ctx, req.(*MsgClaimAirdrop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SetAllocationAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSetAllocationAddress)
 	if err := dec(in); err != nil {
@@ -450,6 +1072,24 @@ func _Msg_SetAllocationAddress_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_FundStrategicReserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundStrategicReserve)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundStrategicReserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.vesting.v1.Msg/FundStrategicReserve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundStrategicReserve(ctx, req.(*MsgFundStrategicReserve))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "blackfury.vesting.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -458,14 +1098,34 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddAirdrops",
 			Handler:    _Msg_AddAirdrops_Handler,
 		},
+		{
+			MethodName: "UpdateAirdrop",
+			Handler:    _Msg_UpdateAirdrop_Handler,
+		},
+		{
+			MethodName: "CancelAirdrops",
+			Handler:    _Msg_CancelAirdrops_Handler,
+		},
 		{
 			MethodName: "ExecuteAirdrops",
 			Handler:    _Msg_ExecuteAirdrops_Handler,
 		},
+		{
+			MethodName: "AddMerkleAirdrop",
+			Handler:    _Msg_AddMerkleAirdrop_Handler,
+		},
+		{
+			MethodName: "ClaimAirdrop",
+			Handler:    _Msg_ClaimAirdrop_Handler,
+		},
 		{
 			MethodName: "SetAllocationAddress",
 			Handler:    _Msg_SetAllocationAddress_Handler,
 		},
+		{
+			MethodName: "FundStrategicReserve",
+			Handler:    _Msg_FundStrategicReserve_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "blackfury/vesting/v1/tx.proto",
@@ -538,7 +1198,7 @@ func (m *MsgAddAirdropsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgExecuteAirdrops) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateAirdrop) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -548,21 +1208,26 @@ func (m *MsgExecuteAirdrops) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgExecuteAirdrops) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateAirdrop) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgExecuteAirdrops) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateAirdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MaxCount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MaxCount))
-		i--
-		dAtA[i] = 0x10
+	{
+		size, err := m.Airdrop.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -573,7 +1238,7 @@ func (m *MsgExecuteAirdrops) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgExecuteAirdropsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateAirdropResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -583,12 +1248,12 @@ func (m *MsgExecuteAirdropsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgExecuteAirdropsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateAirdropResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgExecuteAirdropsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateAirdropResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -596,7 +1261,7 @@ func (m *MsgExecuteAirdropsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetAllocationAddress) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelAirdrops) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -606,29 +1271,24 @@ func (m *MsgSetAllocationAddress) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetAllocationAddress) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelAirdrops) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetAllocationAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelAirdrops) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.StrategicReserveCustodianAddr) > 0 {
-		i -= len(m.StrategicReserveCustodianAddr)
-		copy(dAtA[i:], m.StrategicReserveCustodianAddr)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StrategicReserveCustodianAddr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.TeamVestingAddr) > 0 {
-		i -= len(m.TeamVestingAddr)
-		copy(dAtA[i:], m.TeamVestingAddr)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TeamVestingAddr)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.TargetAddrs) > 0 {
+		for iNdEx := len(m.TargetAddrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TargetAddrs[iNdEx])
+			copy(dAtA[i:], m.TargetAddrs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TargetAddrs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
@@ -640,7 +1300,7 @@ func (m *MsgSetAllocationAddress) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetAllocationAddressResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelAirdropsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -650,12 +1310,12 @@ func (m *MsgSetAllocationAddressResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetAllocationAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelAirdropsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetAllocationAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelAirdropsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -663,107 +1323,1601 @@ func (m *MsgSetAllocationAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgExecuteAirdrops) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgAddAirdrops) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgExecuteAirdrops) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteAirdrops) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.MaxCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxCount))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Airdrops) > 0 {
-		for _, e := range m.Airdrops {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddAirdropsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgExecuteAirdropsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteAirdropsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteAirdropsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.FailedTargetAddrs) > 0 {
+		for iNdEx := len(m.FailedTargetAddrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FailedTargetAddrs[iNdEx])
+			copy(dAtA[i:], m.FailedTargetAddrs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.FailedTargetAddrs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgExecuteAirdrops) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddMerkleAirdrop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddMerkleAirdrop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddMerkleAirdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Duration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.MaxCount != 0 {
-		n += 1 + sovTx(uint64(m.MaxCount))
+	if m.Delivery != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Delivery))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ClaimDeadline, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ClaimDeadline):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.TotalAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgExecuteAirdropsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddMerkleAirdropResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSetAllocationAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAddMerkleAirdropResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddMerkleAirdropResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.AirdropId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AirdropId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.TeamVestingAddr)
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAirdrop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAirdrop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAirdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.AirdropId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AirdropId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAirdropResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAirdropResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAirdropResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAllocationAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAllocationAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAllocationAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StrategicReserveCustodianAddr) > 0 {
+		i -= len(m.StrategicReserveCustodianAddr)
+		copy(dAtA[i:], m.StrategicReserveCustodianAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StrategicReserveCustodianAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TeamVestingAddr) > 0 {
+		i -= len(m.TeamVestingAddr)
+		copy(dAtA[i:], m.TeamVestingAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TeamVestingAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAllocationAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAllocationAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAllocationAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundStrategicReserve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundStrategicReserve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundStrategicReserve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundStrategicReserveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundStrategicReserveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundStrategicReserveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddAirdrops) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.StrategicReserveCustodianAddr)
+	if len(m.Airdrops) > 0 {
+		for _, e := range m.Airdrops {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddAirdropsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateAirdrop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Airdrop.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateAirdropResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelAirdrops) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	if len(m.TargetAddrs) > 0 {
+		for _, s := range m.TargetAddrs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCancelAirdropsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExecuteAirdrops) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxCount != 0 {
+		n += 1 + sovTx(uint64(m.MaxCount))
+	}
+	return n
+}
+
+func (m *MsgExecuteAirdropsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FailedTargetAddrs) > 0 {
+		for _, s := range m.FailedTargetAddrs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddMerkleAirdrop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TotalAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ClaimDeadline)
+	n += 1 + l + sovTx(uint64(l))
+	if m.Delivery != 0 {
+		n += 1 + sovTx(uint64(m.Delivery))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovTx(uint64(m.Duration))
+	}
+	return n
+}
+
+func (m *MsgAddMerkleAirdropResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AirdropId != 0 {
+		n += 1 + sovTx(uint64(m.AirdropId))
+	}
+	return n
+}
+
+func (m *MsgClaimAirdrop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AirdropId != 0 {
+		n += 1 + sovTx(uint64(m.AirdropId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Proof) > 0 {
+		for _, s := range m.Proof {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimAirdropResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAllocationAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TeamVestingAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StrategicReserveCustodianAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAllocationAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundStrategicReserve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundStrategicReserveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddAirdrops) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAirdrops: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAirdrops: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Airdrops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Airdrops = append(m.Airdrops, Airdrop{})
+			if err := m.Airdrops[len(m.Airdrops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAirdropsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAirdropsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAirdropsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAirdrop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAirdrop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAirdrop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Airdrop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Airdrop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAirdropResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAirdropResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAirdropResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelAirdrops) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelAirdrops: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelAirdrops: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetAddrs = append(m.TargetAddrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelAirdropsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelAirdropsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelAirdropsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteAirdrops) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteAirdrops: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteAirdrops: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCount", wireType)
+			}
+			m.MaxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteAirdropsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteAirdropsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteAirdropsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedTargetAddrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedTargetAddrs = append(m.FailedTargetAddrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMerkleAirdrop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMerkleAirdrop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMerkleAirdrop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimDeadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ClaimDeadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delivery", wireType)
+			}
+			m.Delivery = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Delivery |= AirdropDelivery(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgSetAllocationAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgAddMerkleAirdropResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMerkleAirdropResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMerkleAirdropResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
+			}
+			m.AirdropId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AirdropId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgAddAirdrops) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimAirdrop) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -786,10 +2940,10 @@ func (m *MsgAddAirdrops) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAirdrops: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimAirdrop: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAirdrops: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimAirdrop: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -825,10 +2979,29 @@ func (m *MsgAddAirdrops) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
+			}
+			m.AirdropId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AirdropId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Airdrops", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -838,26 +3011,58 @@ func (m *MsgAddAirdrops) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Airdrops = append(m.Airdrops, Airdrop{})
-			if err := m.Airdrops[len(m.Airdrops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -879,7 +3084,7 @@ func (m *MsgAddAirdrops) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddAirdropsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimAirdropResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -902,10 +3107,10 @@ func (m *MsgAddAirdropsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAirdropsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimAirdropResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAirdropsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimAirdropResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -929,7 +3134,7 @@ func (m *MsgAddAirdropsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteAirdrops) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAllocationAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -952,10 +3157,10 @@ func (m *MsgExecuteAirdrops) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteAirdrops: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAllocationAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteAirdrops: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAllocationAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -991,10 +3196,10 @@ func (m *MsgExecuteAirdrops) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeamVestingAddr", wireType)
 			}
-			m.MaxCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1004,11 +3209,56 @@ func (m *MsgExecuteAirdrops) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxCount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TeamVestingAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategicReserveCustodianAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StrategicReserveCustodianAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1030,7 +3280,7 @@ func (m *MsgExecuteAirdrops) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteAirdropsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAllocationAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1053,10 +3303,10 @@ func (m *MsgExecuteAirdropsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteAirdropsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAllocationAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteAirdropsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAllocationAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1080,7 +3330,7 @@ func (m *MsgExecuteAirdropsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAllocationAddress) Unmarshal(dAtA []byte) error {
+func (m *MsgFundStrategicReserve) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1103,10 +3353,10 @@ func (m *MsgSetAllocationAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAllocationAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundStrategicReserve: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAllocationAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundStrategicReserve: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1143,9 +3393,9 @@ func (m *MsgSetAllocationAddress) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TeamVestingAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1155,55 +3405,25 @@ func (m *MsgSetAllocationAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TeamVestingAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StrategicReserveCustodianAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.StrategicReserveCustodianAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1226,7 +3446,7 @@ func (m *MsgSetAllocationAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAllocationAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFundStrategicReserveResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1249,10 +3469,10 @@ func (m *MsgSetAllocationAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAllocationAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundStrategicReserveResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAllocationAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundStrategicReserveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
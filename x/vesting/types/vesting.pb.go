@@ -5,18 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -24,9 +29,48 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AirdropDelivery enumerates the ways in which airdropped coins are delivered.
+type AirdropDelivery int32
+
+const (
+	// AIRDROP_DELIVERY_LIQUID delivers the coins as liquid balances.
+	AIRDROP_DELIVERY_LIQUID AirdropDelivery = 0
+	// AIRDROP_DELIVERY_CONTINUOUS_VESTING delivers the coins into a continuous
+	// vesting account, which vests linearly over the duration.
+	AIRDROP_DELIVERY_CONTINUOUS_VESTING AirdropDelivery = 1
+	// AIRDROP_DELIVERY_VE_LOCK delivers the coins locked in a new veNFT, which
+	// is locked for the duration.
+	AIRDROP_DELIVERY_VE_LOCK AirdropDelivery = 2
+)
+
+var AirdropDelivery_name = map[int32]string{
+	0: "AIRDROP_DELIVERY_LIQUID",
+	1: "AIRDROP_DELIVERY_CONTINUOUS_VESTING",
+	2: "AIRDROP_DELIVERY_VE_LOCK",
+}
+
+var AirdropDelivery_value = map[string]int32{
+	"AIRDROP_DELIVERY_LIQUID":             0,
+	"AIRDROP_DELIVERY_CONTINUOUS_VESTING": 1,
+	"AIRDROP_DELIVERY_VE_LOCK":            2,
+}
+
+func (x AirdropDelivery) String() string {
+	return proto.EnumName(AirdropDelivery_name, int32(x))
+}
+
+func (AirdropDelivery) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_66492c15c753ec3e, []int{0}
+}
+
 type Airdrop struct {
-	TargetAddr string     `protobuf:"bytes,1,opt,name=target_addr,json=targetAddr,proto3" json:"target_addr,omitempty"`
-	Amount     types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	TargetAddr string          `protobuf:"bytes,1,opt,name=target_addr,json=targetAddr,proto3" json:"target_addr,omitempty"`
+	Amount     types.Coin      `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	Delivery   AirdropDelivery `protobuf:"varint,3,opt,name=delivery,proto3,enum=blackfury.vesting.v1.AirdropDelivery" json:"delivery,omitempty"`
+	// duration in seconds of vesting or locking; zero for liquid delivery
+	Duration uint64 `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	// height after which the pending airdrop is dropped; zero for no expiry
+	ExpiryHeight uint64 `protobuf:"varint,5,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
 }
 
 func (m *Airdrop) Reset()         { *m = Airdrop{} }
@@ -62,8 +106,449 @@ func (m *Airdrop) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Airdrop proto.InternalMessageInfo
 
+// MerkleAirdrop is an airdrop committed as the Merkle root of its claims,
+// which are claimed by the recipients with Merkle proofs before the deadline.
+type MerkleAirdrop struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// hex encoded Merkle root of the claims
+	MerkleRoot    string     `protobuf:"bytes,2,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	TotalAmount   types.Coin `protobuf:"bytes,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount"`
+	ClaimedAmount types.Coin `protobuf:"bytes,4,opt,name=claimed_amount,json=claimedAmount,proto3" json:"claimed_amount"`
+	// unclaimed amount is swept to the community pool after the deadline
+	ClaimDeadline time.Time       `protobuf:"bytes,5,opt,name=claim_deadline,json=claimDeadline,proto3,stdtime" json:"claim_deadline"`
+	Delivery      AirdropDelivery `protobuf:"varint,6,opt,name=delivery,proto3,enum=blackfury.vesting.v1.AirdropDelivery" json:"delivery,omitempty"`
+	// duration in seconds of vesting or locking; zero for liquid delivery
+	Duration uint64 `protobuf:"varint,7,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (m *MerkleAirdrop) Reset()         { *m = MerkleAirdrop{} }
+func (m *MerkleAirdrop) String() string { return proto.CompactTextString(m) }
+func (*MerkleAirdrop) ProtoMessage()    {}
+func (*MerkleAirdrop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66492c15c753ec3e, []int{1}
+}
+func (m *MerkleAirdrop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MerkleAirdrop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MerkleAirdrop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MerkleAirdrop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerkleAirdrop.Merge(m, src)
+}
+func (m *MerkleAirdrop) XXX_Size() int {
+	return m.Size()
+}
+func (m *MerkleAirdrop) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerkleAirdrop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerkleAirdrop proto.InternalMessageInfo
+
+// VestingBucket is an allocation which vests linearly after the cliff over the
+// duration, and whose vested coins are claimed to the destination every claim
+// period. The unvested coins are held by the account derived from the bucket
+// name, in the same way as a module account.
+type VestingBucket struct {
+	// unique name of the bucket
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// total amount of the base denom to be vested
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// unix time in seconds when vesting starts
+	StartTime int64 `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// cliff in seconds from the start time, before which nothing is vested
+	Cliff uint64 `protobuf:"varint,4,opt,name=cliff,proto3" json:"cliff,omitempty"`
+	// duration in seconds from the start time, over which the amount is vested
+	Duration uint64 `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	// name of the destination module of the vested coins; the distribution
+	// module means the community pool
+	DestinationModule string `protobuf:"bytes,6,opt,name=destination_module,json=destinationModule,proto3" json:"destination_module,omitempty"`
+	// destination address of the vested coins, if no destination module
+	DestinationAddr string `protobuf:"bytes,7,opt,name=destination_addr,json=destinationAddr,proto3" json:"destination_addr,omitempty"`
+	// period in blocks at which the vested coins are claimed
+	ClaimPeriod uint64 `protobuf:"varint,8,opt,name=claim_period,json=claimPeriod,proto3" json:"claim_period,omitempty"`
+	// amount claimed to the destination so far
+	ClaimedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=claimed_amount,json=claimedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"claimed_amount"`
+}
+
+func (m *VestingBucket) Reset()         { *m = VestingBucket{} }
+func (m *VestingBucket) String() string { return proto.CompactTextString(m) }
+func (*VestingBucket) ProtoMessage()    {}
+func (*VestingBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66492c15c753ec3e, []int{2}
+}
+func (m *VestingBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingBucket.Merge(m, src)
+}
+func (m *VestingBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingBucket proto.InternalMessageInfo
+
+// AddVestingBucketProposal is a gov Content type to add a vesting bucket,
+// which is funded from the community pool and starts vesting when the proposal
+// passes.
+type AddVestingBucketProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// unique name of the bucket
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// amount of the base denom to be vested
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// cliff in seconds
+	Cliff uint64 `protobuf:"varint,5,opt,name=cliff,proto3" json:"cliff,omitempty"`
+	// vesting duration in seconds
+	Duration uint64 `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	// name of the destination module
+	DestinationModule string `protobuf:"bytes,7,opt,name=destination_module,json=destinationModule,proto3" json:"destination_module,omitempty"`
+	// destination address, if no destination module
+	DestinationAddr string `protobuf:"bytes,8,opt,name=destination_addr,json=destinationAddr,proto3" json:"destination_addr,omitempty"`
+	// claim period in blocks
+	ClaimPeriod uint64 `protobuf:"varint,9,opt,name=claim_period,json=claimPeriod,proto3" json:"claim_period,omitempty"`
+}
+
+func (m *AddVestingBucketProposal) Reset()         { *m = AddVestingBucketProposal{} }
+func (m *AddVestingBucketProposal) String() string { return proto.CompactTextString(m) }
+func (*AddVestingBucketProposal) ProtoMessage()    {}
+func (*AddVestingBucketProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66492c15c753ec3e, []int{3}
+}
+func (m *AddVestingBucketProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddVestingBucketProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddVestingBucketProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddVestingBucketProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddVestingBucketProposal.Merge(m, src)
+}
+func (m *AddVestingBucketProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddVestingBucketProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddVestingBucketProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddVestingBucketProposal proto.InternalMessageInfo
+
+func (m *AddVestingBucketProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *AddVestingBucketProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *AddVestingBucketProposal) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AddVestingBucketProposal) GetCliff() uint64 {
+	if m != nil {
+		return m.Cliff
+	}
+	return 0
+}
+
+func (m *AddVestingBucketProposal) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *AddVestingBucketProposal) GetDestinationModule() string {
+	if m != nil {
+		return m.DestinationModule
+	}
+	return ""
+}
+
+func (m *AddVestingBucketProposal) GetDestinationAddr() string {
+	if m != nil {
+		return m.DestinationAddr
+	}
+	return ""
+}
+
+func (m *AddVestingBucketProposal) GetClaimPeriod() uint64 {
+	if m != nil {
+		return m.ClaimPeriod
+	}
+	return 0
+}
+
+// SetVestingBucketDestinationProposal is a gov Content type to redirect the
+// vested coins of a vesting bucket to a new destination.
+type SetVestingBucketDestinationProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// name of the bucket
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// name of the new destination module
+	DestinationModule string `protobuf:"bytes,4,opt,name=destination_module,json=destinationModule,proto3" json:"destination_module,omitempty"`
+	// new destination address, if no destination module
+	DestinationAddr string `protobuf:"bytes,5,opt,name=destination_addr,json=destinationAddr,proto3" json:"destination_addr,omitempty"`
+}
+
+func (m *SetVestingBucketDestinationProposal) Reset()         { *m = SetVestingBucketDestinationProposal{} }
+func (m *SetVestingBucketDestinationProposal) String() string { return proto.CompactTextString(m) }
+func (*SetVestingBucketDestinationProposal) ProtoMessage()    {}
+func (*SetVestingBucketDestinationProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66492c15c753ec3e, []int{4}
+}
+func (m *SetVestingBucketDestinationProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetVestingBucketDestinationProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetVestingBucketDestinationProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetVestingBucketDestinationProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetVestingBucketDestinationProposal.Merge(m, src)
+}
+func (m *SetVestingBucketDestinationProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetVestingBucketDestinationProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetVestingBucketDestinationProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetVestingBucketDestinationProposal proto.InternalMessageInfo
+
+func (m *SetVestingBucketDestinationProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *SetVestingBucketDestinationProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *SetVestingBucketDestinationProposal) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SetVestingBucketDestinationProposal) GetDestinationModule() string {
+	if m != nil {
+		return m.DestinationModule
+	}
+	return ""
+}
+
+func (m *SetVestingBucketDestinationProposal) GetDestinationAddr() string {
+	if m != nil {
+		return m.DestinationAddr
+	}
+	return ""
+}
+
+// StrategicReservePayout is a payout from the strategic reserve pool, which is
+// either paid at once or streamed linearly over the duration.
+type StrategicReservePayout struct {
+	Id        uint64                                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Recipient string                                   `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// unix time in seconds when the payout starts
+	StartTime int64 `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// duration in seconds over which the amount is streamed; zero for a one-off
+	// payout
+	Duration uint64 `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	// amount paid to the recipient so far
+	PaidAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=paid_amount,json=paidAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"paid_amount"`
+}
+
+func (m *StrategicReservePayout) Reset()         { *m = StrategicReservePayout{} }
+func (m *StrategicReservePayout) String() string { return proto.CompactTextString(m) }
+func (*StrategicReservePayout) ProtoMessage()    {}
+func (*StrategicReservePayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66492c15c753ec3e, []int{5}
+}
+func (m *StrategicReservePayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StrategicReservePayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StrategicReservePayout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StrategicReservePayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StrategicReservePayout.Merge(m, src)
+}
+func (m *StrategicReservePayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *StrategicReservePayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_StrategicReservePayout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StrategicReservePayout proto.InternalMessageInfo
+
+// StrategicReserveSpendProposal is a gov Content type to spend from the
+// strategic reserve pool, modeled on the community pool spend proposal.
+type StrategicReserveSpendProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// recipient address
+	Recipient string                                   `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// duration in seconds over which the amount is streamed to the recipient;
+	// zero to pay at once
+	Duration uint64 `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (m *StrategicReserveSpendProposal) Reset()         { *m = StrategicReserveSpendProposal{} }
+func (m *StrategicReserveSpendProposal) String() string { return proto.CompactTextString(m) }
+func (*StrategicReserveSpendProposal) ProtoMessage()    {}
+func (*StrategicReserveSpendProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66492c15c753ec3e, []int{6}
+}
+func (m *StrategicReserveSpendProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StrategicReserveSpendProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StrategicReserveSpendProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StrategicReserveSpendProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StrategicReserveSpendProposal.Merge(m, src)
+}
+func (m *StrategicReserveSpendProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *StrategicReserveSpendProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_StrategicReserveSpendProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StrategicReserveSpendProposal proto.InternalMessageInfo
+
+func (m *StrategicReserveSpendProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *StrategicReserveSpendProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *StrategicReserveSpendProposal) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *StrategicReserveSpendProposal) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *StrategicReserveSpendProposal) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("blackfury.vesting.v1.AirdropDelivery", AirdropDelivery_name, AirdropDelivery_value)
 	proto.RegisterType((*Airdrop)(nil), "blackfury.vesting.v1.Airdrop")
+	proto.RegisterType((*MerkleAirdrop)(nil), "blackfury.vesting.v1.MerkleAirdrop")
+	proto.RegisterType((*VestingBucket)(nil), "blackfury.vesting.v1.VestingBucket")
+	proto.RegisterType((*AddVestingBucketProposal)(nil), "blackfury.vesting.v1.AddVestingBucketProposal")
+	proto.RegisterType((*SetVestingBucketDestinationProposal)(nil), "blackfury.vesting.v1.SetVestingBucketDestinationProposal")
+	proto.RegisterType((*StrategicReservePayout)(nil), "blackfury.vesting.v1.StrategicReservePayout")
+	proto.RegisterType((*StrategicReserveSpendProposal)(nil), "blackfury.vesting.v1.StrategicReserveSpendProposal")
 }
 
 func init() {
@@ -71,24 +556,66 @@ func init() {
 }
 
 var fileDescriptor_66492c15c753ec3e = []byte{
-	// 262 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xb1, 0x4e, 0xf3, 0x30,
-	0x14, 0x85, 0xe3, 0x5f, 0x55, 0x7f, 0x91, 0x6e, 0x51, 0x87, 0xd2, 0xc1, 0xa9, 0x3a, 0x75, 0xb2,
-	0x15, 0x3a, 0x20, 0xb1, 0xb5, 0xcc, 0x2c, 0x1d, 0x59, 0x90, 0x1d, 0x1b, 0x63, 0xd1, 0xf8, 0x46,
-	0xf6, 0x4d, 0x44, 0xde, 0x80, 0x91, 0x47, 0xe0, 0x71, 0x3a, 0x76, 0x64, 0x42, 0x28, 0x79, 0x11,
-	0xd4, 0xa6, 0x2d, 0xdb, 0xd1, 0x3d, 0x9f, 0x3e, 0xe9, 0x9e, 0x78, 0x2e, 0xb7, 0x22, 0x7f, 0x7d,
-	0xae, 0x7c, 0xc3, 0x6b, 0x1d, 0xd0, 0x3a, 0xc3, 0xeb, 0xec, 0x1c, 0x59, 0xe9, 0x01, 0x21, 0x19,
-	0x5f, 0x18, 0x76, 0x2e, 0xea, 0x6c, 0x3a, 0x36, 0x60, 0xe0, 0x08, 0xf0, 0x43, 0xea, 0xd9, 0x29,
-	0xcd, 0x21, 0x14, 0x10, 0xb8, 0x14, 0x41, 0xf3, 0x3a, 0x93, 0x1a, 0x45, 0xc6, 0x73, 0xb0, 0xae,
-	0xef, 0xe7, 0x36, 0xfe, 0xbf, 0xb2, 0x5e, 0x79, 0x28, 0x93, 0x34, 0x1e, 0xa1, 0xf0, 0x46, 0xe3,
-	0x93, 0x50, 0xca, 0x4f, 0xc8, 0x8c, 0x2c, 0xae, 0x36, 0x71, 0x7f, 0x5a, 0x29, 0xe5, 0x93, 0xdb,
-	0x78, 0x28, 0x0a, 0xa8, 0x1c, 0x4e, 0xfe, 0xcd, 0xc8, 0x62, 0x74, 0x73, 0xcd, 0x7a, 0x39, 0x3b,
-	0xc8, 0xd9, 0x49, 0xce, 0xee, 0xc1, 0xba, 0xf5, 0x60, 0xf7, 0x9d, 0x46, 0x9b, 0x13, 0x7e, 0x37,
-	0x78, 0xff, 0x4c, 0xa3, 0xf5, 0xc3, 0xae, 0xa5, 0x64, 0xdf, 0x52, 0xf2, 0xd3, 0x52, 0xf2, 0xd1,
-	0xd1, 0x68, 0xdf, 0xd1, 0xe8, 0xab, 0xa3, 0xd1, 0xe3, 0xd2, 0x58, 0x7c, 0xa9, 0x24, 0xcb, 0xa1,
-	0xe0, 0x7a, 0xdb, 0x04, 0x5b, 0x15, 0x01, 0x05, 0x5a, 0x70, 0xfc, 0x6f, 0x8e, 0xb7, 0xcb, 0x20,
-	0xd8, 0x94, 0x3a, 0xc8, 0xe1, 0xf1, 0x81, 0xe5, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x07, 0xb4,
-	0xf8, 0x2b, 0x32, 0x01, 0x00, 0x00,
+	// 937 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6f, 0xdb, 0xb6,
+	0x1b, 0xb7, 0x6c, 0xd9, 0xb1, 0xe9, 0x24, 0xcd, 0x9f, 0x08, 0xfe, 0xf3, 0xbc, 0xd6, 0xf6, 0x12,
+	0x6c, 0xf3, 0x06, 0x54, 0x5a, 0xd2, 0xc3, 0x80, 0xde, 0xec, 0x38, 0xdd, 0x8c, 0xe6, 0x6d, 0x72,
+	0x12, 0x60, 0xbb, 0x08, 0xb2, 0xc8, 0x28, 0x44, 0x24, 0x51, 0xa0, 0x28, 0xa3, 0xc6, 0xbe, 0x40,
+	0x8f, 0x3d, 0xed, 0x3c, 0x60, 0xb7, 0x7d, 0x92, 0x1e, 0xbb, 0xdb, 0xd0, 0x43, 0x3b, 0x24, 0x97,
+	0x5d, 0xf6, 0x0d, 0x86, 0x61, 0x10, 0x45, 0x2b, 0x56, 0x9c, 0x04, 0xee, 0xb2, 0x9e, 0x4c, 0x3e,
+	0x7c, 0xf8, 0x90, 0xbf, 0x97, 0x87, 0x16, 0x58, 0x1b, 0xba, 0x96, 0x7d, 0x76, 0x12, 0xb1, 0xb1,
+	0x3e, 0xc2, 0x21, 0x27, 0xbe, 0xa3, 0x8f, 0x36, 0x26, 0x43, 0x2d, 0x60, 0x94, 0x53, 0xb8, 0x9a,
+	0xe6, 0x68, 0x93, 0x85, 0xd1, 0x46, 0x7d, 0xd5, 0xa1, 0x0e, 0x15, 0x09, 0x7a, 0x3c, 0x4a, 0x72,
+	0xeb, 0x0d, 0x9b, 0x86, 0x1e, 0x0d, 0xf5, 0xa1, 0x15, 0x62, 0x7d, 0xb4, 0x31, 0xc4, 0xdc, 0xda,
+	0xd0, 0x6d, 0x4a, 0x7c, 0xb9, 0xde, 0x74, 0x28, 0x75, 0x5c, 0xac, 0x8b, 0xd9, 0x30, 0x3a, 0xd1,
+	0x39, 0xf1, 0x70, 0xc8, 0x2d, 0x2f, 0x48, 0x12, 0xd6, 0xfe, 0x54, 0xc0, 0x42, 0x87, 0x30, 0xc4,
+	0x68, 0x00, 0x9b, 0xa0, 0xca, 0x2d, 0xe6, 0x60, 0x6e, 0x5a, 0x08, 0xb1, 0x9a, 0xd2, 0x52, 0xda,
+	0x15, 0x03, 0x24, 0xa1, 0x0e, 0x42, 0x0c, 0x7e, 0x05, 0x4a, 0x96, 0x47, 0x23, 0x9f, 0xd7, 0xf2,
+	0x2d, 0xa5, 0x5d, 0xdd, 0xfc, 0x50, 0x4b, 0x8e, 0xd7, 0xe2, 0xe3, 0x35, 0x79, 0xbc, 0xb6, 0x45,
+	0x89, 0xdf, 0x55, 0x5f, 0xbe, 0x69, 0xe6, 0x0c, 0x99, 0x0e, 0x3b, 0xa0, 0x8c, 0xb0, 0x4b, 0x46,
+	0x98, 0x8d, 0x6b, 0x85, 0x96, 0xd2, 0x5e, 0xde, 0xfc, 0x44, 0xbb, 0x0e, 0xa5, 0x26, 0xaf, 0xd2,
+	0x93, 0xc9, 0x46, 0xba, 0x0d, 0xd6, 0x41, 0x19, 0x45, 0xcc, 0xe2, 0x84, 0xfa, 0x35, 0xb5, 0xa5,
+	0xb4, 0x55, 0x23, 0x9d, 0xc3, 0x75, 0xb0, 0x84, 0x9f, 0x05, 0x84, 0x8d, 0xcd, 0x53, 0x4c, 0x9c,
+	0x53, 0x5e, 0x2b, 0x8a, 0x84, 0xc5, 0x24, 0xf8, 0x8d, 0x88, 0x3d, 0x56, 0x9f, 0xff, 0xd4, 0xcc,
+	0xad, 0xfd, 0x9d, 0x07, 0x4b, 0xbb, 0x98, 0x9d, 0xb9, 0x78, 0x82, 0x7a, 0x19, 0xe4, 0x09, 0x12,
+	0x60, 0x55, 0x23, 0x4f, 0x50, 0xcc, 0x82, 0x27, 0x12, 0x4c, 0x46, 0x69, 0x82, 0xb4, 0x62, 0x80,
+	0x24, 0x64, 0x50, 0xca, 0x61, 0x17, 0x2c, 0x72, 0xca, 0x2d, 0xd7, 0x94, 0x5c, 0x14, 0xe6, 0xe3,
+	0xa2, 0x2a, 0x36, 0x75, 0x12, 0x42, 0x9e, 0x80, 0x65, 0xdb, 0xb5, 0x88, 0x87, 0xd1, 0xa4, 0x8a,
+	0x3a, 0x5f, 0x95, 0x25, 0xb9, 0x4d, 0xd6, 0x79, 0x2a, 0xeb, 0x98, 0x08, 0x5b, 0xc8, 0x25, 0x3e,
+	0x16, 0xd0, 0xab, 0x9b, 0x75, 0x2d, 0x11, 0x5e, 0x9b, 0x08, 0xaf, 0x1d, 0x4e, 0x84, 0xef, 0x96,
+	0xe3, 0x42, 0x2f, 0xde, 0x36, 0x15, 0x59, 0xac, 0x27, 0xb7, 0x66, 0x54, 0x2a, 0xdd, 0x5d, 0xa5,
+	0x85, 0xac, 0x4a, 0x52, 0x80, 0x1f, 0x0b, 0x60, 0xe9, 0x38, 0x29, 0xd5, 0x8d, 0xec, 0x33, 0xcc,
+	0x21, 0x04, 0xaa, 0x6f, 0x79, 0x58, 0xfa, 0x4d, 0x8c, 0xe1, 0x93, 0x8c, 0xd3, 0x2a, 0x5d, 0x2d,
+	0xbe, 0xf3, 0xeb, 0x37, 0xcd, 0x4f, 0x1d, 0xc2, 0x4f, 0xa3, 0xa1, 0x66, 0x53, 0x4f, 0x97, 0xd6,
+	0x4f, 0x7e, 0x1e, 0x86, 0xe8, 0x4c, 0xe7, 0xe3, 0x00, 0x87, 0x5a, 0xdf, 0xe7, 0xa9, 0xf1, 0x1e,
+	0x00, 0x10, 0x72, 0x8b, 0x71, 0x33, 0xf6, 0xbd, 0x50, 0xaa, 0x60, 0x54, 0x44, 0x24, 0xe6, 0x03,
+	0xae, 0x82, 0xa2, 0xed, 0x92, 0x93, 0x13, 0xe9, 0xa8, 0x64, 0x92, 0x01, 0x51, 0xbc, 0x62, 0xb5,
+	0x87, 0x00, 0x22, 0x71, 0x7b, 0x31, 0x35, 0x3d, 0x8a, 0x22, 0x17, 0x0b, 0xb6, 0x2a, 0xc6, 0xff,
+	0xa6, 0x56, 0x76, 0xc5, 0x02, 0xfc, 0x1c, 0xac, 0x4c, 0xa7, 0x8b, 0xbe, 0x5a, 0x10, 0xc9, 0xf7,
+	0xa6, 0xe2, 0xa2, 0xb9, 0x3e, 0x06, 0x8b, 0x89, 0x94, 0x01, 0x66, 0x84, 0xa2, 0x5a, 0x59, 0x9c,
+	0x5c, 0x15, 0xb1, 0x03, 0x11, 0x82, 0x47, 0x33, 0xae, 0xa9, 0xfc, 0x2b, 0x76, 0xb2, 0x26, 0x92,
+	0xc2, 0x5c, 0xe4, 0x41, 0xad, 0x83, 0x50, 0x46, 0x9b, 0x03, 0x46, 0x03, 0x1a, 0x5a, 0x6e, 0x4c,
+	0x14, 0x27, 0xdc, 0x9d, 0x88, 0x94, 0x4c, 0x60, 0x0b, 0x54, 0x11, 0x0e, 0x6d, 0x46, 0x02, 0xc1,
+	0x55, 0xd2, 0x2a, 0xd3, 0xa1, 0x54, 0xdb, 0xc2, 0xb5, 0xda, 0xaa, 0x77, 0xd2, 0x36, 0x15, 0xaf,
+	0x78, 0x93, 0x78, 0xa5, 0xb9, 0xc4, 0x5b, 0x78, 0x17, 0xf1, 0xca, 0xf3, 0x89, 0x57, 0x99, 0x11,
+	0xef, 0xb1, 0xfa, 0x47, 0xcc, 0xf2, 0xaf, 0x0a, 0x58, 0x1f, 0x60, 0x9e, 0x61, 0xb9, 0x77, 0x59,
+	0xec, 0xbd, 0x10, 0x7e, 0x3d, 0x6c, 0xf5, 0x5d, 0x60, 0x17, 0xaf, 0x85, 0x2d, 0x31, 0xbd, 0xce,
+	0x83, 0xff, 0x0f, 0x38, 0xb3, 0x38, 0x76, 0x88, 0x6d, 0xe0, 0x10, 0xb3, 0x11, 0x3e, 0xb0, 0xc6,
+	0x34, 0xe2, 0x33, 0x8f, 0xeb, 0x7d, 0x50, 0x61, 0xd8, 0x26, 0x01, 0xc1, 0x93, 0xd6, 0x36, 0x2e,
+	0x03, 0xd0, 0x4e, 0x9d, 0x51, 0x68, 0x15, 0x6e, 0x7f, 0x0d, 0xbf, 0x8c, 0x4d, 0xf3, 0xcb, 0xdb,
+	0x66, 0x7b, 0x0e, 0xd3, 0xc4, 0x1b, 0xc2, 0x1b, 0x9e, 0x04, 0xf5, 0xea, 0x93, 0x70, 0x5b, 0xf3,
+	0xbb, 0xa0, 0x1a, 0x58, 0x24, 0x6d, 0xbe, 0xd2, 0x7f, 0x7f, 0x49, 0x10, 0xd7, 0xcf, 0xb4, 0xe5,
+	0x5f, 0x0a, 0x78, 0x70, 0x95, 0xdc, 0x41, 0x80, 0x7d, 0x74, 0x67, 0xab, 0x64, 0xb4, 0x28, 0xdc,
+	0xac, 0x85, 0xfa, 0xfe, 0xb4, 0xb8, 0x85, 0xec, 0xc4, 0x5b, 0x5f, 0xfc, 0x00, 0xee, 0x5d, 0xf9,
+	0xb7, 0x81, 0x1f, 0x81, 0x0f, 0x3a, 0x7d, 0xa3, 0x67, 0xec, 0x1f, 0x98, 0xbd, 0xed, 0x9d, 0xfe,
+	0xf1, 0xb6, 0xf1, 0x9d, 0xb9, 0xd3, 0xff, 0xf6, 0xa8, 0xdf, 0x5b, 0xc9, 0xc1, 0xcf, 0xc0, 0xfa,
+	0xcc, 0xe2, 0xd6, 0xfe, 0xde, 0x61, 0x7f, 0xef, 0x68, 0xff, 0x68, 0x60, 0x1e, 0x6f, 0x0f, 0x0e,
+	0xfb, 0x7b, 0x5f, 0xaf, 0x28, 0xf0, 0x3e, 0xa8, 0xcd, 0x24, 0x1e, 0x6f, 0x9b, 0x3b, 0xfb, 0x5b,
+	0x4f, 0x57, 0xf2, 0x75, 0xf5, 0xf9, 0xcf, 0x8d, 0x5c, 0x77, 0xf7, 0xe5, 0x79, 0x43, 0x79, 0x75,
+	0xde, 0x50, 0x7e, 0x3f, 0x6f, 0x28, 0x2f, 0x2e, 0x1a, 0xb9, 0x57, 0x17, 0x8d, 0xdc, 0x6f, 0x17,
+	0x8d, 0xdc, 0xf7, 0x8f, 0xa6, 0xa0, 0x62, 0x77, 0x1c, 0x92, 0xc8, 0x0b, 0xb9, 0xb8, 0xb7, 0x7e,
+	0xf9, 0x85, 0xf7, 0x2c, 0xfd, 0xc6, 0x13, 0xd8, 0x87, 0x25, 0xf1, 0x67, 0xfc, 0xe8, 0x9f, 0x01,
+	0x00, 0x39, 0xe7, 0x3e, 0xd9, 0x05, 0x0a, 0x00, 0x00,
 }
 
 func (m *Airdrop) Marshal() (dAtA []byte, err error) {
@@ -111,59 +638,2133 @@ func (m *Airdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Duration != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Delivery != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Delivery))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
-		i -= size
-		i = encodeVarintVesting(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.TargetAddr) > 0 {
-		i -= len(m.TargetAddr)
-		copy(dAtA[i:], m.TargetAddr)
-		i = encodeVarintVesting(dAtA, i, uint64(len(m.TargetAddr)))
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintVesting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TargetAddr) > 0 {
+		i -= len(m.TargetAddr)
+		copy(dAtA[i:], m.TargetAddr)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.TargetAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MerkleAirdrop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MerkleAirdrop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MerkleAirdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Duration != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Delivery != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Delivery))
+		i--
+		dAtA[i] = 0x30
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ClaimDeadline, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ClaimDeadline):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintVesting(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.ClaimedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVesting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.TotalAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVesting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ClaimedAmount.Size()
+		i -= size
+		if _, err := m.ClaimedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVesting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.ClaimPeriod != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.ClaimPeriod))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.DestinationAddr) > 0 {
+		i -= len(m.DestinationAddr)
+		copy(dAtA[i:], m.DestinationAddr)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.DestinationAddr)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.DestinationModule) > 0 {
+		i -= len(m.DestinationModule)
+		copy(dAtA[i:], m.DestinationModule)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.DestinationModule)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Duration != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Cliff != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Cliff))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVesting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddVestingBucketProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddVestingBucketProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddVestingBucketProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClaimPeriod != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.ClaimPeriod))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.DestinationAddr) > 0 {
+		i -= len(m.DestinationAddr)
+		copy(dAtA[i:], m.DestinationAddr)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.DestinationAddr)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.DestinationModule) > 0 {
+		i -= len(m.DestinationModule)
+		copy(dAtA[i:], m.DestinationModule)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.DestinationModule)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Duration != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Cliff != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Cliff))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVesting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetVestingBucketDestinationProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetVestingBucketDestinationProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetVestingBucketDestinationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DestinationAddr) > 0 {
+		i -= len(m.DestinationAddr)
+		copy(dAtA[i:], m.DestinationAddr)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.DestinationAddr)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DestinationModule) > 0 {
+		i -= len(m.DestinationModule)
+		copy(dAtA[i:], m.DestinationModule)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.DestinationModule)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StrategicReservePayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StrategicReservePayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StrategicReservePayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PaidAmount) > 0 {
+		for iNdEx := len(m.PaidAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaidAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Duration != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StrategicReserveSpendProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StrategicReserveSpendProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StrategicReserveSpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Duration != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVesting(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Airdrop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TargetAddr)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovVesting(uint64(l))
+	if m.Delivery != 0 {
+		n += 1 + sovVesting(uint64(m.Delivery))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovVesting(uint64(m.Duration))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovVesting(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *MerkleAirdrop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovVesting(uint64(m.Id))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = m.TotalAmount.Size()
+	n += 1 + l + sovVesting(uint64(l))
+	l = m.ClaimedAmount.Size()
+	n += 1 + l + sovVesting(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ClaimDeadline)
+	n += 1 + l + sovVesting(uint64(l))
+	if m.Delivery != 0 {
+		n += 1 + sovVesting(uint64(m.Delivery))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovVesting(uint64(m.Duration))
+	}
+	return n
+}
+
+func (m *VestingBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovVesting(uint64(l))
+	if m.StartTime != 0 {
+		n += 1 + sovVesting(uint64(m.StartTime))
+	}
+	if m.Cliff != 0 {
+		n += 1 + sovVesting(uint64(m.Cliff))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovVesting(uint64(m.Duration))
+	}
+	l = len(m.DestinationModule)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.DestinationAddr)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if m.ClaimPeriod != 0 {
+		n += 1 + sovVesting(uint64(m.ClaimPeriod))
+	}
+	l = m.ClaimedAmount.Size()
+	n += 1 + l + sovVesting(uint64(l))
+	return n
+}
+
+func (m *AddVestingBucketProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovVesting(uint64(l))
+	if m.Cliff != 0 {
+		n += 1 + sovVesting(uint64(m.Cliff))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovVesting(uint64(m.Duration))
+	}
+	l = len(m.DestinationModule)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.DestinationAddr)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if m.ClaimPeriod != 0 {
+		n += 1 + sovVesting(uint64(m.ClaimPeriod))
+	}
+	return n
+}
+
+func (m *SetVestingBucketDestinationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.DestinationModule)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.DestinationAddr)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	return n
+}
+
+func (m *StrategicReservePayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovVesting(uint64(m.Id))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovVesting(uint64(m.StartTime))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovVesting(uint64(m.Duration))
+	}
+	if len(m.PaidAmount) > 0 {
+		for _, e := range m.PaidAmount {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StrategicReserveSpendProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if m.Duration != 0 {
+		n += 1 + sovVesting(uint64(m.Duration))
+	}
+	return n
+}
+
+func sovVesting(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVesting(x uint64) (n int) {
+	return sovVesting(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Airdrop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Airdrop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Airdrop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delivery", wireType)
+			}
+			m.Delivery = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Delivery |= AirdropDelivery(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MerkleAirdrop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MerkleAirdrop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MerkleAirdrop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimDeadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ClaimDeadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delivery", wireType)
+			}
+			m.Delivery = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Delivery |= AirdropDelivery(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cliff", wireType)
+			}
+			m.Cliff = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cliff |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationModule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationModule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimPeriod", wireType)
+			}
+			m.ClaimPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddVestingBucketProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddVestingBucketProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddVestingBucketProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cliff", wireType)
+			}
+			m.Cliff = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cliff |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationModule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationModule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimPeriod", wireType)
+			}
+			m.ClaimPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetVestingBucketDestinationProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetVestingBucketDestinationProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetVestingBucketDestinationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationModule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationModule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StrategicReservePayout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StrategicReservePayout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StrategicReservePayout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaidAmount = append(m.PaidAmount, types.Coin{})
+			if err := m.PaidAmount[len(m.PaidAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
-	offset -= sovVesting(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Airdrop) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TargetAddr)
-	if l > 0 {
-		n += 1 + l + sovVesting(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovVesting(uint64(l))
-	return n
-}
-
-func sovVesting(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozVesting(x uint64) (n int) {
-	return sovVesting(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Airdrop) Unmarshal(dAtA []byte) error {
+func (m *StrategicReserveSpendProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -186,15 +2787,15 @@ func (m *Airdrop) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Airdrop: wiretype end group for non-group")
+			return fmt.Errorf("proto: StrategicReserveSpendProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Airdrop: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StrategicReserveSpendProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -222,9 +2823,73 @@ func (m *Airdrop) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetAddr = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -253,10 +2918,30 @@ func (m *Airdrop) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVesting(dAtA[iNdEx:])
@@ -27,6 +27,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryLastVotedTime())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -55,3 +56,27 @@ func CmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryLastVotedTime() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "last-voted-time [ve-id]",
+		Short: "shows the last time when the ve voted",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LastVotedTime(context.Background(), &types.QueryLastVotedTimeRequest{VeId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vetypes "github.com/elysiumstation/blackfury/x/ve/types"
 	"github.com/elysiumstation/blackfury/x/voter/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -19,3 +21,26 @@ func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types
 
 	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
 }
+
+func (k Keeper) LastVotedTime(c context.Context, req *types.QueryLastVotedTimeRequest) (*types.QueryLastVotedTimeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	veID := vetypes.Uint64FromVeID(req.VeId)
+	if veID == vetypes.EmptyVeID {
+		return nil, sdkerrors.Wrapf(vetypes.ErrInvalidVeID, "invalid ve id: %s", req.VeId)
+	}
+
+	lastVoted := k.GetLastVotedTime(ctx, veID)
+	var nextVote uint64
+	if lastVoted != 0 {
+		nextVote = vetypes.NextRegulatedUnixTime(vetypes.RegulatedUnixTime(lastVoted))
+	}
+
+	return &types.QueryLastVotedTimeResponse{
+		LastVotedTime: lastVoted,
+		NextVoteTime:  nextVote,
+	}, nil
+}
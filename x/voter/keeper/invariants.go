@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vetypes "github.com/elysiumstation/blackfury/x/ve/types"
+	"github.com/elysiumstation/blackfury/x/voter/types"
+)
+
+// RegisterInvariants registers the voter module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "total-votes", TotalVotesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "voted-ve", VotedVeInvariant(k))
+}
+
+// AllInvariants runs all invariants of the voter module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			TotalVotesInvariant(k),
+			VotedVeInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// TotalVotesInvariant checks that the total votes and the total votes of every user
+// equal the sums of the absolute weighted votes of users for all pools
+func TotalVotesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		poolDenoms := k.gaugeKeeper.GetGauges(ctx)
+
+		sum := sdk.ZeroInt()
+		k.IterateTotalVotesByUser(ctx, func(veID uint64, totalVotesByUser sdk.Int) bool {
+			votes := sdk.ZeroInt()
+			for _, poolDenom := range poolDenoms {
+				votes = votes.Add(k.GetPoolWeightedVotesByUser(ctx, veID, poolDenom).Abs())
+			}
+			if !totalVotesByUser.Equal(votes) {
+				count++
+				msg += fmt.Sprintf("\t%s has total votes %s, but sum of pool weighted votes %s\n",
+					vetypes.VeIDFromUint64(veID), totalVotesByUser, votes)
+			}
+			sum = sum.Add(votes)
+			return false
+		})
+
+		totalVotes := k.GetTotalVotes(ctx)
+		if !totalVotes.Equal(sum) {
+			count++
+			msg += fmt.Sprintf("\ttotal votes %s, but sum of pool weighted votes of all users %s\n", totalVotes, sum)
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "total-votes",
+			fmt.Sprintf("inconsistent total votes found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// VotedVeInvariant checks that every ve marked as voted has nonzero votes
+func VotedVeInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		k.veKeeper.IterateVeVoted(ctx, func(veID uint64) bool {
+			if k.GetTotalVotesByUser(ctx, veID).IsZero() {
+				count++
+				msg += fmt.Sprintf("\t%s is marked as voted but has no votes\n", vetypes.VeIDFromUint64(veID))
+			}
+			return false
+		})
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "voted-ve",
+			fmt.Sprintf("voted ve without votes found %d\n%s", count, msg),
+		), broken
+	}
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tharsis/ethermint/tests"
+
+	"github.com/elysiumstation/blackfury/app"
+	blackfurytypes "github.com/elysiumstation/blackfury/types"
+	vekeeper "github.com/elysiumstation/blackfury/x/ve/keeper"
+	vetypes "github.com/elysiumstation/blackfury/x/ve/types"
+	"github.com/elysiumstation/blackfury/x/voter/keeper"
+)
+
+func TestInvariants(t *testing.T) {
+	blackfury := app.Setup(false)
+	ctx := blackfury.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+	k := blackfury.VoterKeeper
+
+	// a bonded validator as the block proposer is required for mirroring ve NFTs into the EVM
+	valConsPk := simapp.CreateTestPubKeys(1)[0]
+	app.FundTestAddrs(blackfury, ctx, []sdk.AccAddress{sdk.AccAddress(valConsPk.Address())}, sdk.NewInt(1234))
+	ctx = ctx.WithProposer(sdk.ConsAddress(valConsPk.Address()))
+	tstaking := teststaking.NewHelper(t, ctx, blackfury.StakingKeeper.Keeper)
+	tstaking.Denom = blackfurytypes.AttoFuryDenom
+	tstaking.CreateValidator(sdk.ValAddress(valConsPk.Address()), valConsPk, sdk.NewInt(100), true)
+
+	addr, _ := tests.NewAddrKey()
+	sender := sdk.AccAddress(addr.Bytes())
+	amount := sdk.NewCoin("afury", sdk.NewInt(1e18))
+	require.NoError(t, app.FundAccount(blackfury.BankKeeper, ctx, sender, sdk.NewCoins(amount.Add(amount))))
+
+	veServer := vekeeper.NewMsgServerImpl(blackfury.VeKeeper)
+	var veIDs []uint64
+	for i := 0; i < 2; i++ {
+		res, err := veServer.Create(sdk.WrapSDKContext(ctx), &vetypes.MsgCreate{
+			Sender:       sender.String(),
+			Amount:       amount,
+			LockDuration: vetypes.MaxLockTime,
+		})
+		require.NoError(t, err)
+		veIDs = append(veIDs, vetypes.Uint64FromVeID(res.VeId))
+	}
+
+	k.CreateGauge(ctx, "uatom")
+	k.CreateGauge(ctx, "ueth")
+
+	requireNotBroken := func() {
+		msg, broken := keeper.AllInvariants(k)(ctx)
+		require.False(t, broken, msg)
+	}
+	requireNotBroken()
+
+	require.NoError(t, k.Vote(ctx, veIDs[0], map[string]sdk.Dec{"uatom": sdk.NewDecWithPrec(6, 1), "ueth": sdk.NewDecWithPrec(-4, 1)}))
+	require.NoError(t, k.Vote(ctx, veIDs[1], map[string]sdk.Dec{"ueth": sdk.OneDec()}))
+	requireNotBroken()
+
+	// voting power decays
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(30 * 24 * time.Hour))
+	k.Poke(ctx, veIDs[0])
+	requireNotBroken()
+
+	k.Abstain(ctx, veIDs[1])
+	requireNotBroken()
+
+	testCases := []struct {
+		name      string
+		invariant sdk.Invariant
+		malleate  func(ctx sdk.Context)
+	}{
+		{
+			"total votes drifts",
+			keeper.TotalVotesInvariant(k),
+			func(ctx sdk.Context) {
+				k.SetTotalVotes(ctx, k.GetTotalVotes(ctx).AddRaw(1))
+			},
+		},
+		{
+			"user votes drifts",
+			keeper.TotalVotesInvariant(k),
+			func(ctx sdk.Context) {
+				k.SetTotalVotesByUser(ctx, veIDs[0], k.GetTotalVotesByUser(ctx, veIDs[0]).SubRaw(1))
+			},
+		},
+		{
+			"pool weighted votes drifts",
+			keeper.TotalVotesInvariant(k),
+			func(ctx sdk.Context) {
+				k.SetPoolWeightedVotesByUser(ctx, veIDs[0], "ueth", k.GetPoolWeightedVotesByUser(ctx, veIDs[0], "ueth").SubRaw(1))
+			},
+		},
+		{
+			"voted ve without votes",
+			keeper.VotedVeInvariant(k),
+			func(ctx sdk.Context) {
+				blackfury.VeKeeper.SetVeVoted(ctx, veIDs[1], true)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		cacheCtx, _ := ctx.CacheContext()
+		tc.malleate(cacheCtx)
+		_, broken := tc.invariant(cacheCtx)
+		require.True(t, broken, tc.name)
+		_, broken = keeper.AllInvariants(k)(cacheCtx)
+		require.True(t, broken, tc.name)
+	}
+}
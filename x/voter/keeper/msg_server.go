@@ -1,6 +1,11 @@
 package keeper
 
 import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vetypes "github.com/elysiumstation/blackfury/x/ve/types"
 	"github.com/elysiumstation/blackfury/x/voter/types"
 )
 
@@ -15,3 +20,88 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 }
 
 var _ types.MsgServer = msgServer{}
+
+func (m msgServer) Vote(c context.Context, msg *types.MsgVote) (*types.MsgVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	veID, err := m.checkSender(ctx, msg.Sender, msg.VeId)
+	if err != nil {
+		return nil, err
+	}
+
+	votingPower := m.Keeper.veKeeper.GetVotingPower(ctx, veID, uint64(ctx.BlockTime().Unix()), 0)
+	poolWeights := make(map[string]sdk.Dec)
+	for _, pw := range msg.PoolWeights {
+		if !m.Keeper.gaugeKeeper.HasGauge(ctx, pw.PoolDenom) {
+			return nil, sdkerrors.Wrapf(types.ErrGaugeNotFound, "pool denom %s", pw.PoolDenom)
+		}
+		if votingPower.ToDec().Mul(pw.Weight).TruncateInt().IsZero() {
+			return nil, sdkerrors.Wrapf(types.ErrZeroWeightedVotes, "voting power %s with weight %s for pool denom %s", votingPower, pw.Weight, pw.PoolDenom)
+		}
+		poolWeights[pw.PoolDenom] = pw.Weight
+	}
+
+	err = m.Keeper.Vote(ctx, veID, poolWeights)
+	if err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx)
+
+	return &types.MsgVoteResponse{}, nil
+}
+
+func (m msgServer) Abstain(c context.Context, msg *types.MsgAbstain) (*types.MsgAbstainResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	veID, err := m.checkSender(ctx, msg.Sender, msg.VeId)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Keeper.Abstain(ctx, veID)
+
+	emitMessageEvent(ctx)
+
+	return &types.MsgAbstainResponse{}, nil
+}
+
+func (m msgServer) Poke(c context.Context, msg *types.MsgPoke) (*types.MsgPokeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	veID, err := m.checkSender(ctx, msg.Sender, msg.VeId)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.Keeper.GetTotalVotesByUser(ctx, veID).IsPositive() &&
+		!m.Keeper.veKeeper.GetVotingPower(ctx, veID, uint64(ctx.BlockTime().Unix()), 0).IsPositive() {
+		return nil, sdkerrors.Wrapf(types.ErrZeroWeightedVotes, "ve %s has no voting power", msg.VeId)
+	}
+
+	m.Keeper.Poke(ctx, veID)
+
+	emitMessageEvent(ctx)
+
+	return &types.MsgPokeResponse{}, nil
+}
+
+// checkSender checks whether the sender is authorized to vote for ve
+func (m msgServer) checkSender(ctx sdk.Context, senderStr string, veIDStr string) (veID uint64, err error) {
+	sender, err := sdk.AccAddressFromBech32(senderStr)
+	if err != nil {
+		return
+	}
+	veID = vetypes.Uint64FromVeID(veIDStr)
+	err = m.Keeper.veKeeper.CheckVoterAuthorized(ctx, veID, sender)
+	return
+}
+
+func emitMessageEvent(ctx sdk.Context) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+}
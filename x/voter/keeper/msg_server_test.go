@@ -3,9 +3,20 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tharsis/ethermint/tests"
+
+	"github.com/elysiumstation/blackfury/app"
 	keepertest "github.com/elysiumstation/blackfury/testutil/keeper"
+	blackfurytypes "github.com/elysiumstation/blackfury/types"
+	vetypes "github.com/elysiumstation/blackfury/x/ve/types"
 	"github.com/elysiumstation/blackfury/x/voter/keeper"
 	"github.com/elysiumstation/blackfury/x/voter/types"
 )
@@ -14,3 +25,110 @@ func setupMsgServer(t testing.TB) (types.MsgServer, context.Context) {
 	k, ctx := keepertest.VoterKeeper(t)
 	return keeper.NewMsgServerImpl(*k), sdk.WrapSDKContext(ctx)
 }
+
+// setupVoterApp sets up the app with a bonded validator as the block proposer,
+// which is required for mirroring ve NFTs into the EVM
+func setupVoterApp(t *testing.T) (*app.Blackfury, sdk.Context) {
+	blackfury := app.Setup(false)
+	ctx := blackfury.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+
+	valConsPk := simapp.CreateTestPubKeys(1)[0]
+	app.FundTestAddrs(blackfury, ctx, []sdk.AccAddress{sdk.AccAddress(valConsPk.Address())}, sdk.NewInt(1234))
+	ctx = ctx.WithProposer(sdk.ConsAddress(valConsPk.Address()))
+	tstaking := teststaking.NewHelper(t, ctx, blackfury.StakingKeeper.Keeper)
+	tstaking.Denom = blackfurytypes.AttoFuryDenom
+	tstaking.CreateValidator(sdk.ValAddress(valConsPk.Address()), valConsPk, sdk.NewInt(100), true)
+	return blackfury, ctx
+}
+
+func TestMsgServer_VoteByApprovedVoter(t *testing.T) {
+	blackfury, ctx := setupVoterApp(t)
+	k := blackfury.VoterKeeper
+	impl := keeper.NewMsgServerImpl(k)
+
+	newAddr := func() sdk.AccAddress {
+		addr, _ := tests.NewAddrKey()
+		return sdk.AccAddress(addr.Bytes())
+	}
+	owner, voter, operator := newAddr(), newAddr(), newAddr()
+	amount := sdk.NewCoin("afury", sdk.NewInt(1e18))
+	require.NoError(t, app.FundAccount(blackfury.BankKeeper, ctx, owner, sdk.NewCoins(amount)))
+	veID, _, err := blackfury.VeKeeper.CreateLock(ctx, owner, owner, amount, vetypes.MaxLockTime)
+	require.NoError(t, err)
+	id := vetypes.VeIDFromUint64(veID)
+
+	k.CreateGauge(ctx, "uatom")
+	weights := []types.PoolWeight{{PoolDenom: "uatom", Weight: sdk.OneDec()}}
+	c := sdk.WrapSDKContext(ctx)
+
+	_, err = impl.Vote(c, &types.MsgVote{Sender: voter.String(), VeId: id, PoolWeights: weights})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	blackfury.VeKeeper.SetApprovedVoter(ctx, veID, voter)
+	_, err = impl.Vote(c, &types.MsgVote{Sender: voter.String(), VeId: id, PoolWeights: []types.PoolWeight{{PoolDenom: "uosmo", Weight: sdk.OneDec()}}})
+	require.ErrorIs(t, err, types.ErrGaugeNotFound)
+	_, err = impl.Vote(c, &types.MsgVote{Sender: voter.String(), VeId: id, PoolWeights: weights})
+	require.NoError(t, err)
+	require.True(t, blackfury.VeKeeper.GetVeVoted(ctx, veID))
+
+	// operator of the owner can poke and abstain
+	_, err = impl.Poke(c, &types.MsgPoke{Sender: operator.String(), VeId: id})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	blackfury.VeKeeper.SetVoterOperator(ctx, owner, operator, true)
+	_, err = impl.Poke(c, &types.MsgPoke{Sender: operator.String(), VeId: id})
+	require.NoError(t, err)
+	_, err = impl.Abstain(c, &types.MsgAbstain{Sender: operator.String(), VeId: id})
+	require.NoError(t, err)
+	require.False(t, blackfury.VeKeeper.GetVeVoted(ctx, veID))
+}
+
+func TestMsgServer_PokeMultipleGauges(t *testing.T) {
+	blackfury, ctx := setupVoterApp(t)
+	k := blackfury.VoterKeeper
+	impl := keeper.NewMsgServerImpl(k)
+
+	addr, _ := tests.NewAddrKey()
+	owner := sdk.AccAddress(addr.Bytes())
+	amount := sdk.NewCoin("afury", sdk.NewInt(1e18))
+	require.NoError(t, app.FundAccount(blackfury.BankKeeper, ctx, owner, sdk.NewCoins(amount)))
+	veID, _, err := blackfury.VeKeeper.CreateLock(ctx, owner, owner, amount, vetypes.MaxLockTime)
+	require.NoError(t, err)
+	id := vetypes.VeIDFromUint64(veID)
+
+	// thirds do not sum up to one after being rounded, and the pool fine-tuned last votes against
+	third := sdk.OneDec().QuoInt64(3)
+	weights := []types.PoolWeight{
+		{PoolDenom: "uatom", Weight: third},
+		{PoolDenom: "ujuno", Weight: third},
+		{PoolDenom: "uosmo", Weight: sdk.OneDec().Sub(third.MulInt64(2)).Neg()},
+	}
+	for _, pw := range weights {
+		k.CreateGauge(ctx, pw.PoolDenom)
+	}
+	c := sdk.WrapSDKContext(ctx)
+	_, err = impl.Vote(c, &types.MsgVote{Sender: owner.String(), VeId: id, PoolWeights: weights})
+	require.NoError(t, err)
+
+	// poke with the decayed voting power
+	for i := 0; i < 3; i++ {
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(i+1) * 97 * time.Hour))
+		c = sdk.WrapSDKContext(ctx)
+		_, err = impl.Poke(c, &types.MsgPoke{Sender: owner.String(), VeId: id})
+		require.NoError(t, err)
+
+		votingPower := blackfury.VeKeeper.GetVotingPower(ctx, veID, uint64(ctx.BlockTime().Unix()), 0)
+		totalVotesByUser := sdk.ZeroInt()
+		for _, pw := range weights {
+			votes := k.GetPoolWeightedVotesByUser(ctx, veID, pw.PoolDenom)
+			require.Equal(t, pw.Weight.IsNegative(), votes.IsNegative(), pw.PoolDenom)
+			// votes are recomputed from the rounded weights at every poke, which may lose a few units
+			expected := votingPower.ToDec().Mul(pw.Weight).TruncateInt()
+			require.True(t, expected.Sub(votes).Abs().LTE(expected.Abs().QuoRaw(1e12)), "%s votes %s, expected %s", pw.PoolDenom, votes, expected)
+			require.Equal(t, votes, k.GetPoolWeightedVotes(ctx, pw.PoolDenom))
+			totalVotesByUser = totalVotesByUser.Add(votes.Abs())
+		}
+		require.Equal(t, totalVotesByUser, k.GetTotalVotesByUser(ctx, veID))
+		require.Equal(t, totalVotesByUser, k.GetTotalVotes(ctx))
+		require.True(t, blackfury.VeKeeper.GetVeVoted(ctx, veID))
+	}
+}
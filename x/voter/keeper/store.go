@@ -7,7 +7,7 @@ import (
 
 func (k Keeper) SetTotalVotes(ctx sdk.Context, votes sdk.Int) {
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&sdk.IntProto{votes})
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: votes})
 	store.Set(types.TotalVotesKey(), bz)
 }
 
@@ -24,7 +24,7 @@ func (k Keeper) GetTotalVotes(ctx sdk.Context) sdk.Int {
 
 func (k Keeper) SetTotalVotesByUser(ctx sdk.Context, veID uint64, votes sdk.Int) {
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&sdk.IntProto{votes})
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: votes})
 	store.Set(types.TotalVotesByUserKey(veID), bz)
 }
 
@@ -44,9 +44,24 @@ func (k Keeper) DeleteTotalVotesByUser(ctx sdk.Context, veID uint64) {
 	store.Delete(types.TotalVotesByUserKey(veID))
 }
 
+// IterateTotalVotesByUser iterates over the total votes of all users, until the handler returns true
+func (k Keeper) IterateTotalVotesByUser(ctx sdk.Context, handler func(veID uint64, votes sdk.Int) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixTotalVotesByUser)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		veID := sdk.BigEndianToUint64(iter.Key()[len(types.KeyPrefixTotalVotesByUser):])
+		var votes sdk.IntProto
+		k.cdc.MustUnmarshal(iter.Value(), &votes)
+		if handler(veID, votes.Int) {
+			break
+		}
+	}
+}
+
 func (k Keeper) SetPoolWeightedVotes(ctx sdk.Context, poolDenom string, votes sdk.Int) {
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&sdk.IntProto{votes})
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: votes})
 	store.Set(types.PoolWeightedVotesKey(poolDenom), bz)
 }
 
@@ -63,7 +78,7 @@ func (k Keeper) GetPoolWeightedVotes(ctx sdk.Context, poolDenom string) sdk.Int
 
 func (k Keeper) SetPoolWeightedVotesByUser(ctx sdk.Context, veID uint64, poolDenom string, votes sdk.Int) {
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&sdk.IntProto{votes})
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: votes})
 	store.Set(types.PoolWeightedVotesByUserKey(veID, poolDenom), bz)
 }
 
@@ -85,7 +100,7 @@ func (k Keeper) DeletePoolWeightedVotesByUser(ctx sdk.Context, veID uint64, pool
 
 func (k Keeper) SetIndex(ctx sdk.Context, index sdk.Int) {
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&sdk.IntProto{index})
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: index})
 	store.Set(types.IndexKey(), bz)
 }
 
@@ -102,7 +117,7 @@ func (k Keeper) GetIndex(ctx sdk.Context) sdk.Int {
 
 func (k Keeper) SetIndexAtLastUpdatedByGauge(ctx sdk.Context, poolDenom string, index sdk.Int) {
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&sdk.IntProto{index})
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: index})
 	store.Set(types.IndexAtLastUpdatedByGaugeKey(poolDenom), bz)
 }
 
@@ -119,7 +134,7 @@ func (k Keeper) GetIndexAtLastUpdatedByGauge(ctx sdk.Context, poolDenom string)
 
 func (k Keeper) SetClaimableRewardByGauge(ctx sdk.Context, poolDenom string, claimable sdk.Int) {
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&sdk.IntProto{claimable})
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: claimable})
 	store.Set(types.ClaimableRewardByGaugeKey(poolDenom), bz)
 }
 
@@ -133,3 +148,19 @@ func (k Keeper) GetClaimableRewardByGauge(ctx sdk.Context, poolDenom string) sdk
 	k.cdc.MustUnmarshal(bz, &claimable)
 	return claimable.Int
 }
+
+// SetLastVotedTime sets the last time when the ve voted
+func (k Keeper) SetLastVotedTime(ctx sdk.Context, veID uint64, timestamp uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastVotedTimeKey(veID), sdk.Uint64ToBigEndian(timestamp))
+}
+
+// GetLastVotedTime gets the last time when the ve voted
+func (k Keeper) GetLastVotedTime(ctx sdk.Context, veID uint64) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastVotedTimeKey(veID))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
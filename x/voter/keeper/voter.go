@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	vekeeper "github.com/elysiumstation/blackfury/x/ve/keeper"
 	vetypes "github.com/elysiumstation/blackfury/x/ve/types"
 	"github.com/elysiumstation/blackfury/x/voter/types"
@@ -49,7 +50,22 @@ func (k Keeper) Abstain(ctx sdk.Context, veID uint64) {
 	k.veKeeper.SetVeVoted(ctx, veID, false)
 }
 
-func (k Keeper) Vote(ctx sdk.Context, veID uint64, poolWeights map[string]sdk.Dec) {
+// Vote votes for gauges with the voting power of ve.
+// A ve can vote at most once in a regulated week, whoever owns it, since the bribe deposit
+// would be double-counted if it votes again after abstaining and being transferred.
+func (k Keeper) Vote(ctx sdk.Context, veID uint64, poolWeights map[string]sdk.Dec) error {
+	now := uint64(ctx.BlockTime().Unix())
+	lastVoted := k.GetLastVotedTime(ctx, veID)
+	if lastVoted != 0 && vetypes.RegulatedUnixTime(lastVoted) == vetypes.RegulatedUnixTime(now) {
+		return sdkerrors.Wrapf(types.ErrVoteCooldown, "ve %d can vote again from %d", veID, vetypes.NextRegulatedUnixTime(vetypes.RegulatedUnixTime(now)))
+	}
+
+	k.vote(ctx, veID, poolWeights)
+	k.SetLastVotedTime(ctx, veID, now)
+	return nil
+}
+
+func (k Keeper) vote(ctx sdk.Context, veID uint64, poolWeights map[string]sdk.Dec) {
 	// reset voting for user
 	k.Abstain(ctx, veID)
 
@@ -98,7 +114,8 @@ func (k Keeper) Vote(ctx sdk.Context, veID uint64, poolWeights map[string]sdk.De
 	k.veKeeper.SetVeVoted(ctx, veID, true)
 }
 
-// Poke adjusts votes due to updated voting power of user
+// Poke adjusts votes due to updated voting power of user.
+// It is not subject to the voting cooldown, since it keeps the voting weights.
 func (k Keeper) Poke(ctx sdk.Context, veID uint64) {
 	totalVotesByUser := k.GetTotalVotesByUser(ctx, veID)
 	if !totalVotesByUser.IsPositive() {
@@ -119,15 +136,20 @@ func (k Keeper) Poke(ctx sdk.Context, veID uint64) {
 		}
 		weight := weightedVotes.ToDec().QuoInt(totalVotesByUser)
 		poolWeights[poolDenom] = weight
-		totalWeights = totalWeights.Add(weight)
+		// weights are summed in absolute value, as in voting
+		totalWeights = totalWeights.Add(weight.Abs())
 		fineTuning = poolDenom
 	}
-	if totalWeights.Equal(sdk.OneDec()) {
-		// it's ok to compensate for accuracy loss
-		poolWeights[fineTuning] = poolWeights[fineTuning].Add(sdk.OneDec().Sub(totalWeights))
+	if !totalWeights.Equal(sdk.OneDec()) {
+		// it's ok to compensate for accuracy loss, away from zero for negative weight
+		compensation := sdk.OneDec().Sub(totalWeights)
+		if poolWeights[fineTuning].IsNegative() {
+			compensation = compensation.Neg()
+		}
+		poolWeights[fineTuning] = poolWeights[fineTuning].Add(compensation)
 	}
 
-	k.Vote(ctx, veID, poolWeights)
+	k.vote(ctx, veID, poolWeights)
 }
 
 func (k Keeper) DepositReward(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Int) {
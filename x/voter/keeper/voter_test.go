@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	nfttypes "github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tharsis/ethermint/tests"
+
+	"github.com/elysiumstation/blackfury/app"
+	blackfurytypes "github.com/elysiumstation/blackfury/types"
+	vetypes "github.com/elysiumstation/blackfury/x/ve/types"
+	"github.com/elysiumstation/blackfury/x/voter/types"
+)
+
+func TestVoteCooldown(t *testing.T) {
+	blackfury := app.Setup(false)
+	ctx := blackfury.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+	k := blackfury.VoterKeeper
+
+	// a bonded validator as the block proposer is required for mirroring ve NFTs into the EVM
+	valConsPk := simapp.CreateTestPubKeys(1)[0]
+	app.FundTestAddrs(blackfury, ctx, []sdk.AccAddress{sdk.AccAddress(valConsPk.Address())}, sdk.NewInt(1234))
+	ctx = ctx.WithProposer(sdk.ConsAddress(valConsPk.Address()))
+	tstaking := teststaking.NewHelper(t, ctx, blackfury.StakingKeeper.Keeper)
+	tstaking.Denom = blackfurytypes.AttoFuryDenom
+	tstaking.CreateValidator(sdk.ValAddress(valConsPk.Address()), valConsPk, sdk.NewInt(100), true)
+
+	addr, _ := tests.NewAddrKey()
+	owner := sdk.AccAddress(addr.Bytes())
+	addr, _ = tests.NewAddrKey()
+	buyer := sdk.AccAddress(addr.Bytes())
+	amount := sdk.NewCoin("afury", sdk.NewInt(1e18))
+	require.NoError(t, app.FundAccount(blackfury.BankKeeper, ctx, owner, sdk.NewCoins(amount)))
+	veID, _, err := blackfury.VeKeeper.CreateLock(ctx, owner, owner, amount, vetypes.MaxLockTime)
+	require.NoError(t, err)
+
+	k.CreateGauge(ctx, "uatom")
+	weights := map[string]sdk.Dec{"uatom": sdk.OneDec()}
+
+	queryLastVoted := func(ctx sdk.Context) *types.QueryLastVotedTimeResponse {
+		res, err := k.LastVotedTime(sdk.WrapSDKContext(ctx), &types.QueryLastVotedTimeRequest{VeId: vetypes.VeIDFromUint64(veID)})
+		require.NoError(t, err)
+		return res
+	}
+	require.Equal(t, &types.QueryLastVotedTimeResponse{}, queryLastVoted(ctx))
+
+	now := uint64(ctx.BlockTime().Unix())
+	nextWeek := vetypes.NextRegulatedUnixTime(vetypes.RegulatedUnixTime(now))
+	require.NoError(t, k.Vote(ctx, veID, weights))
+	require.Equal(t, &types.QueryLastVotedTimeResponse{LastVotedTime: now, NextVoteTime: nextWeek}, queryLastVoted(ctx))
+
+	// voting again in the same week is not allowed, but poking is
+	require.ErrorIs(t, k.Vote(ctx, veID, weights), types.ErrVoteCooldown)
+	k.Poke(ctx, veID)
+
+	// nor by the new owner after abstaining and transferring
+	k.Abstain(ctx, veID)
+	_, err = blackfury.NftKeeper.Send(sdk.WrapSDKContext(ctx), &nfttypes.MsgSend{
+		ClassId:  vetypes.VeNftClass.Id,
+		Id:       vetypes.VeIDFromUint64(veID),
+		Sender:   owner.String(),
+		Receiver: buyer.String(),
+	})
+	require.NoError(t, err)
+	require.ErrorIs(t, k.Vote(ctx, veID, weights), types.ErrVoteCooldown)
+	require.False(t, blackfury.VeKeeper.GetVeVoted(ctx, veID))
+
+	// the new owner can vote in the next week
+	ctx = ctx.WithBlockTime(time.Unix(int64(nextWeek), 0))
+	require.NoError(t, k.Vote(ctx, veID, weights))
+	require.Equal(t, nextWeek, queryLastVoted(ctx).LastVotedTime)
+
+	_, err = k.LastVotedTime(sdk.WrapSDKContext(ctx), &types.QueryLastVotedTimeRequest{VeId: "xxx"})
+	require.ErrorIs(t, err, vetypes.ErrInvalidVeID)
+}
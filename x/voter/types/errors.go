@@ -8,5 +8,9 @@ import (
 
 // x/voter module sentinel errors
 var (
-	ErrSample = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrSample             = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrVoteCooldown       = sdkerrors.Register(ModuleName, 2, "ve has voted in the current week")
+	ErrInvalidPoolWeights = sdkerrors.Register(ModuleName, 3, "invalid pool weights")
+	ErrGaugeNotFound      = sdkerrors.Register(ModuleName, 4, "gauge not found")
+	ErrZeroWeightedVotes  = sdkerrors.Register(ModuleName, 5, "gauge weighted votes must be nonzero")
 )
@@ -23,6 +23,8 @@ type Vekeeper interface {
 	LockDenom(ctx sdk.Context) string
 	GetVotingPower(ctx sdk.Context, veID uint64, atTime uint64, atBlock int64) sdk.Int
 	SetVeVoted(ctx sdk.Context, veID uint64, voted bool)
+	IterateVeVoted(ctx sdk.Context, cb func(veID uint64) (stop bool))
+	CheckVoterAuthorized(ctx sdk.Context, veID uint64, addr sdk.AccAddress) error
 }
 
 type GaugeKeeper interface {
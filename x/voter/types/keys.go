@@ -27,6 +27,7 @@ const (
 	prefixIndex
 	prefixIndexAtLastUpdatedByGauge
 	prefixClaimableRewardByGauge
+	prefixLastVotedTime
 )
 
 var (
@@ -37,6 +38,7 @@ var (
 	KeyPrefixIndex                     = []byte{prefixIndex}
 	KeyPrefixIndexAtLastUpdatedByGauge = []byte{prefixIndexAtLastUpdatedByGauge}
 	KeyPrefixClaimableRewardByGauge    = []byte{prefixClaimableRewardByGauge}
+	KeyPrefixLastVotedTime             = []byte{prefixLastVotedTime}
 )
 
 func TotalVotesKey() []byte {
@@ -66,3 +68,7 @@ func IndexAtLastUpdatedByGaugeKey(poolDenom string) []byte {
 func ClaimableRewardByGaugeKey(poolDenom string) []byte {
 	return append(KeyPrefixClaimableRewardByGauge, poolDenom...)
 }
+
+func LastVotedTimeKey(veID uint64) []byte {
+	return append(KeyPrefixLastVotedTime, sdk.Uint64ToBigEndian(veID)...)
+}
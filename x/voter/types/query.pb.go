@@ -112,33 +112,145 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryLastVotedTimeRequest is request type for the Query/LastVotedTime RPC
+// method.
+type QueryLastVotedTimeRequest struct {
+	VeId string `protobuf:"bytes,1,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+}
+
+func (m *QueryLastVotedTimeRequest) Reset()         { *m = QueryLastVotedTimeRequest{} }
+func (m *QueryLastVotedTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLastVotedTimeRequest) ProtoMessage()    {}
+func (*QueryLastVotedTimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46d78cd8183b0592, []int{2}
+}
+func (m *QueryLastVotedTimeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastVotedTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastVotedTimeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLastVotedTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastVotedTimeRequest.Merge(m, src)
+}
+func (m *QueryLastVotedTimeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastVotedTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastVotedTimeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLastVotedTimeRequest proto.InternalMessageInfo
+
+func (m *QueryLastVotedTimeRequest) GetVeId() string {
+	if m != nil {
+		return m.VeId
+	}
+	return ""
+}
+
+// QueryLastVotedTimeResponse is response type for the Query/LastVotedTime RPC
+// method.
+type QueryLastVotedTimeResponse struct {
+	// last_voted_time is the unix time of the last vote, or zero if never voted
+	LastVotedTime uint64 `protobuf:"varint,1,opt,name=last_voted_time,json=lastVotedTime,proto3" json:"last_voted_time,omitempty"`
+	// next_vote_time is the earliest unix time when the ve can vote again
+	NextVoteTime uint64 `protobuf:"varint,2,opt,name=next_vote_time,json=nextVoteTime,proto3" json:"next_vote_time,omitempty"`
+}
+
+func (m *QueryLastVotedTimeResponse) Reset()         { *m = QueryLastVotedTimeResponse{} }
+func (m *QueryLastVotedTimeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLastVotedTimeResponse) ProtoMessage()    {}
+func (*QueryLastVotedTimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46d78cd8183b0592, []int{3}
+}
+func (m *QueryLastVotedTimeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastVotedTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastVotedTimeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLastVotedTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastVotedTimeResponse.Merge(m, src)
+}
+func (m *QueryLastVotedTimeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastVotedTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastVotedTimeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLastVotedTimeResponse proto.InternalMessageInfo
+
+func (m *QueryLastVotedTimeResponse) GetLastVotedTime() uint64 {
+	if m != nil {
+		return m.LastVotedTime
+	}
+	return 0
+}
+
+func (m *QueryLastVotedTimeResponse) GetNextVoteTime() uint64 {
+	if m != nil {
+		return m.NextVoteTime
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "blackfury.voter.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "blackfury.voter.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryLastVotedTimeRequest)(nil), "blackfury.voter.v1.QueryLastVotedTimeRequest")
+	proto.RegisterType((*QueryLastVotedTimeResponse)(nil), "blackfury.voter.v1.QueryLastVotedTimeResponse")
 }
 
 func init() { proto.RegisterFile("blackfury/voter/v1/query.proto", fileDescriptor_46d78cd8183b0592) }
 
 var fileDescriptor_46d78cd8183b0592 = []byte{
-	// 287 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xca, 0x49, 0x4c,
-	0xce, 0x4e, 0x2b, 0x2d, 0xaa, 0xd4, 0x2f, 0xcb, 0x2f, 0x49, 0x2d, 0xd2, 0x2f, 0x33, 0xd4, 0x2f,
-	0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0xcb, 0xeb, 0x81,
-	0xe5, 0xf5, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0xd2, 0xfa, 0x20, 0x16, 0x44,
-	0xa5, 0x94, 0x4c, 0x7a, 0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x7e, 0x62, 0x41, 0xa6, 0x7e, 0x62, 0x5e,
-	0x5e, 0x7e, 0x49, 0x62, 0x49, 0x66, 0x7e, 0x5e, 0x31, 0x54, 0x56, 0x01, 0x8b, 0x3d, 0xe9, 0xa9,
-	0x79, 0xa9, 0xc5, 0x99, 0x50, 0x15, 0x4a, 0x22, 0x5c, 0x42, 0x81, 0x20, 0x8b, 0x03, 0x12, 0x8b,
-	0x12, 0x73, 0x8b, 0x83, 0x52, 0x0b, 0x4b, 0x53, 0x8b, 0x4b, 0x94, 0xfc, 0xb9, 0x84, 0x51, 0x44,
-	0x8b, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x85, 0x2c, 0xb8, 0xd8, 0x0a, 0xc0, 0x22, 0x12, 0x8c, 0x0a,
-	0x8c, 0x1a, 0xdc, 0x46, 0x52, 0x7a, 0x98, 0xee, 0xd4, 0x83, 0xe8, 0x71, 0x62, 0x39, 0x71, 0x4f,
-	0x9e, 0x21, 0x08, 0xaa, 0xde, 0xa8, 0x8d, 0x91, 0x8b, 0x15, 0x6c, 0xa2, 0x50, 0x2d, 0x17, 0x1b,
-	0x44, 0x85, 0x90, 0x1a, 0x36, 0xdd, 0x98, 0x8e, 0x91, 0x52, 0x27, 0xa8, 0x0e, 0xe2, 0x3c, 0x25,
-	0xa5, 0xa6, 0xcb, 0x4f, 0x26, 0x33, 0xc9, 0x08, 0x49, 0xe9, 0x63, 0xf1, 0x36, 0xc4, 0x21, 0x4e,
-	0xde, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7,
-	0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x98, 0x9e, 0x59, 0x92,
-	0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x9f, 0x9a, 0x53, 0x59, 0x9c, 0x59, 0x9a, 0x5b, 0x0c,
-	0x09, 0x4d, 0x24, 0xe3, 0x2a, 0xa0, 0x06, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xc3,
-	0xd0, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x6b, 0xe8, 0xbe, 0xe7, 0xcf, 0x01, 0x00, 0x00,
+	// 409 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0xaa, 0xd3, 0x40,
+	0x14, 0x86, 0x93, 0xd2, 0x16, 0x1c, 0xad, 0xc2, 0xb4, 0x0b, 0x0d, 0x25, 0x96, 0x20, 0x55, 0x90,
+	0x66, 0x6c, 0xbb, 0x71, 0xdd, 0x9d, 0x28, 0xa8, 0x41, 0x5c, 0xb8, 0x29, 0xd3, 0xe6, 0x18, 0x47,
+	0x93, 0x4c, 0x9a, 0x99, 0x84, 0x06, 0xe9, 0xc6, 0x27, 0x10, 0x7c, 0x04, 0xb7, 0x3e, 0x48, 0x97,
+	0x05, 0x37, 0xae, 0x44, 0x5a, 0x1f, 0x44, 0x32, 0x13, 0xa4, 0xbd, 0xcd, 0xe5, 0xde, 0xdd, 0x70,
+	0xce, 0x77, 0xce, 0xff, 0xcf, 0x3f, 0x83, 0xec, 0x45, 0x48, 0x97, 0x9f, 0xde, 0x67, 0x69, 0x41,
+	0x72, 0x2e, 0x21, 0x25, 0xf9, 0x98, 0xac, 0x32, 0x48, 0x0b, 0x37, 0x49, 0xb9, 0xe4, 0x18, 0xff,
+	0xef, 0xbb, 0xaa, 0xef, 0xe6, 0x63, 0xab, 0x17, 0xf0, 0x80, 0xab, 0x36, 0x29, 0x4f, 0x9a, 0xb4,
+	0xfa, 0x01, 0xe7, 0x41, 0x08, 0x84, 0x26, 0x8c, 0xd0, 0x38, 0xe6, 0x92, 0x4a, 0xc6, 0x63, 0x51,
+	0x75, 0x07, 0x35, 0x3a, 0x01, 0xc4, 0x20, 0x58, 0x45, 0x38, 0x3d, 0x84, 0x5f, 0x97, 0xc2, 0xaf,
+	0x68, 0x4a, 0x23, 0xe1, 0xc1, 0x2a, 0x03, 0x21, 0x9d, 0x97, 0xa8, 0x7b, 0x52, 0x15, 0x09, 0x8f,
+	0x05, 0xe0, 0xa7, 0xa8, 0x9d, 0xa8, 0xca, 0x5d, 0x73, 0x60, 0x3e, 0xba, 0x39, 0xb1, 0xdc, 0x73,
+	0x9f, 0xae, 0x9e, 0x99, 0x35, 0xb7, 0xbf, 0xef, 0x1b, 0x5e, 0xc5, 0x3b, 0x4f, 0xd0, 0x3d, 0xb5,
+	0xf0, 0x05, 0x15, 0xf2, 0x2d, 0x97, 0xe0, 0xbf, 0x61, 0x11, 0x54, 0x6a, 0xb8, 0x8b, 0x5a, 0x39,
+	0xcc, 0x99, 0xaf, 0xb6, 0xde, 0xf0, 0x9a, 0x39, 0x3c, 0xf3, 0x9d, 0x8f, 0xc8, 0xaa, 0x9b, 0xa8,
+	0x9c, 0x0c, 0xd1, 0x9d, 0x90, 0x0a, 0x39, 0x2f, 0x55, 0xfd, 0xb9, 0x64, 0x11, 0xa8, 0xe1, 0xa6,
+	0xd7, 0x09, 0x8f, 0x79, 0xfc, 0x00, 0xdd, 0x8e, 0x61, 0xad, 0x39, 0x8d, 0x35, 0x14, 0x76, 0xab,
+	0xac, 0x96, 0x58, 0x49, 0x4d, 0x7e, 0x34, 0x50, 0x4b, 0x89, 0xe1, 0x0d, 0x6a, 0x6b, 0xff, 0x78,
+	0x58, 0x77, 0xb7, 0xf3, 0xa8, 0xac, 0x87, 0x57, 0x72, 0xda, 0xb2, 0xe3, 0x7c, 0xf9, 0xf9, 0xf7,
+	0x5b, 0xa3, 0x8f, 0x2d, 0x52, 0xf3, 0x28, 0x3a, 0x26, 0xfc, 0xdd, 0x44, 0x9d, 0x93, 0x0b, 0xe3,
+	0xd1, 0xa5, 0xeb, 0xeb, 0xa2, 0xb4, 0xdc, 0xeb, 0xe2, 0x95, 0xa9, 0xa9, 0x32, 0x35, 0xc2, 0x8f,
+	0xeb, 0x4c, 0x5d, 0x48, 0x98, 0x7c, 0x56, 0xaf, 0xb4, 0x99, 0x3d, 0xdf, 0xee, 0x6d, 0x73, 0xb7,
+	0xb7, 0xcd, 0x3f, 0x7b, 0xdb, 0xfc, 0x7a, 0xb0, 0x8d, 0xdd, 0xc1, 0x36, 0x7e, 0x1d, 0x6c, 0xe3,
+	0xdd, 0x38, 0x60, 0xf2, 0x43, 0xb6, 0x70, 0x97, 0x3c, 0x22, 0x10, 0x16, 0x82, 0x65, 0x91, 0xd0,
+	0x3f, 0xf2, 0x68, 0xff, 0xba, 0x52, 0x90, 0x45, 0x02, 0x62, 0xd1, 0x56, 0xff, 0x70, 0xfa, 0x6f,
+	0x00, 0x43, 0xe4, 0x63, 0x69, 0x13, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -155,6 +267,8 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// LastVotedTime queries the last time when the ve voted.
+	LastVotedTime(ctx context.Context, in *QueryLastVotedTimeRequest, opts ...grpc.CallOption) (*QueryLastVotedTimeResponse, error)
 }
 
 type queryClient struct {
@@ -174,10 +288,21 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) LastVotedTime(ctx context.Context, in *QueryLastVotedTimeRequest, opts ...grpc.CallOption) (*QueryLastVotedTimeResponse, error) {
+	out := new(QueryLastVotedTimeResponse)
+	err := c.cc.Invoke(ctx, "/blackfury.voter.v1.Query/LastVotedTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// LastVotedTime queries the last time when the ve voted.
+	LastVotedTime(context.Context, *QueryLastVotedTimeRequest) (*QueryLastVotedTimeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -187,6 +312,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) LastVotedTime(ctx context.Context, req *QueryLastVotedTimeRequest) (*QueryLastVotedTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastVotedTime not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -210,6 +338,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LastVotedTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastVotedTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastVotedTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blackfury.voter.v1.Query/LastVotedTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastVotedTime(ctx, req.(*QueryLastVotedTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "blackfury.voter.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -218,6 +364,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "LastVotedTime",
+			Handler:    _Query_LastVotedTime_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "blackfury/voter/v1/query.proto",
@@ -279,6 +429,69 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryLastVotedTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastVotedTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastVotedTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastVotedTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastVotedTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastVotedTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextVoteTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextVoteTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LastVotedTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastVotedTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -310,6 +523,34 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryLastVotedTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLastVotedTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastVotedTime != 0 {
+		n += 1 + sovQuery(uint64(m.LastVotedTime))
+	}
+	if m.NextVoteTime != 0 {
+		n += 1 + sovQuery(uint64(m.NextVoteTime))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -449,6 +690,176 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryLastVotedTimeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastVotedTimeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastVotedTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastVotedTimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastVotedTimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastVotedTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastVotedTime", wireType)
+			}
+			m.LastVotedTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastVotedTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextVoteTime", wireType)
+			}
+			m.NextVoteTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextVoteTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0